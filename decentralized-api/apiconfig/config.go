@@ -1,21 +1,34 @@
 package apiconfig
 
+// Config is the node's configuration file. The optional sections from Tokenizer on are read by
+// the package that uses them, which substitutes its own default for every value left unset.
 type Config struct {
-	Api                ApiConfig             `koanf:"api"`
-	Nodes              []InferenceNodeConfig `koanf:"nodes"`
-	NodeConfigIsMerged bool                  `koanf:"merged_node_config"`
-	ChainNode          ChainNodeConfig       `koanf:"chain_node"`
-	UpcomingSeed       SeedInfo              `koanf:"upcoming_seed"`
-	CurrentSeed        SeedInfo              `koanf:"current_seed"`
-	PreviousSeed       SeedInfo              `koanf:"previous_seed"`
-	CurrentHeight      int64                 `koanf:"current_height"`
-	UpgradePlan        UpgradePlan           `koanf:"upgrade_plan"`
-	MLNodeKeyConfig    MLNodeKeyConfig       `koanf:"ml_node_key_config"`
-	NodeVersions       NodeVersionStack      `koanf:"node_versions"`
-	Nats               NatsServerConfig      `koanf:"nats"`
-	CurrentNodeVersion string                `koanf:"current_node_version"`
-	ValidationParams   ValidationParamsCache `koanf:"validation_params"`
-	BandwidthParams    BandwidthParamsCache  `koanf:"bandwidth_params"`
+	Api                ApiConfig               `koanf:"api"`
+	Nodes              []InferenceNodeConfig   `koanf:"nodes"`
+	NodeConfigIsMerged bool                    `koanf:"merged_node_config"`
+	ChainNode          ChainNodeConfig         `koanf:"chain_node"`
+	UpcomingSeed       SeedInfo                `koanf:"upcoming_seed"`
+	CurrentSeed        SeedInfo                `koanf:"current_seed"`
+	PreviousSeed       SeedInfo                `koanf:"previous_seed"`
+	UnclaimedSeeds     []SeedInfo              `koanf:"unclaimed_seeds"`
+	CurrentHeight      int64                   `koanf:"current_height"`
+	UpgradePlan        UpgradePlan             `koanf:"upgrade_plan"`
+	MLNodeKeyConfig    MLNodeKeyConfig         `koanf:"ml_node_key_config"`
+	NodeVersions       NodeVersionStack        `koanf:"node_versions"`
+	Nats               NatsServerConfig        `koanf:"nats"`
+	CurrentNodeVersion string                  `koanf:"current_node_version"`
+	ValidationParams   ValidationParamsCache   `koanf:"validation_params"`
+	BandwidthParams    BandwidthParamsCache    `koanf:"bandwidth_params"`
+	Tokenizer          TokenizerConfig         `koanf:"tokenizer"`
+	ExecutorRetry      ExecutorRetryConfig     `koanf:"executor_retry"`
+	ExecutorSelection  ExecutorSelectionConfig `koanf:"executor_selection"`
+	NodeWaitQueue      NodeWaitQueueConfig     `koanf:"node_wait_queue"`
+	TxBatching         TxBatchingConfig        `koanf:"tx_batching"`
+	PayloadStorage     PayloadStorageConfig    `koanf:"payload_storage"`
+	Subscriptions      SubscriptionsConfig     `koanf:"subscriptions"`
+	NodeConfigReload   NodeConfigReloadConfig  `koanf:"node_config_reload"`
+	// FileManagedNodes are the ids of the nodes added from node definition files, the only ones a reload removes
+	FileManagedNodes []string `koanf:"file_managed_nodes"`
 }
 
 type NatsServerConfig struct {
@@ -156,3 +169,73 @@ type BandwidthParamsCache struct {
 	KbPerInputToken           float64 `koanf:"kb_per_input_token"`
 	KbPerOutputToken          float64 `koanf:"kb_per_output_token"`
 }
+
+type TokenizerConfig struct {
+	Disabled              bool    `koanf:"disabled"`
+	CacheDir              string  `koanf:"cache_dir"`
+	HfBaseUrl             string  `koanf:"hf_base_url"`
+	HfToken               string  `koanf:"hf_token"`
+	FallbackCharsPerToken float64 `koanf:"fallback_chars_per_token"`
+}
+
+// ExecutorRetryConfig bounds how many executors a transfer agent tries for a single
+// request before giving up, 3 by default, and how long each one has to start responding (60s).
+type ExecutorRetryConfig struct {
+	MaxAttempts            int   `koanf:"max_attempts"`
+	ResponseTimeoutSeconds int64 `koanf:"response_timeout_seconds"`
+}
+
+// ExecutorSelectionConfig lets a transfer agent prefer executors in its region and bias the
+// on-chain weights by the latency and error rate it observed for each executor. The bias is
+// bounded by MaxWeightDeviation (e.g. 0.3 keeps every weight within ±30% of the on-chain one).
+// Region is also declared on chain when the participant registers.
+type ExecutorSelectionConfig struct {
+	Enabled            bool    `koanf:"enabled"`
+	MaxWeightDeviation float64 `koanf:"max_weight_deviation"`
+	Region             string  `koanf:"region"`
+}
+
+// NodeWaitQueueConfig limits how many inference requests may wait for a free ML node of a
+// model (64 unless set) and for how long (5s). A negative MaxDepth turns waiting off.
+type NodeWaitQueueConfig struct {
+	MaxDepth      int   `koanf:"max_depth"`
+	MaxWaitMillis int64 `koanf:"max_wait_ms"`
+}
+
+// TxBatchingConfig turns on signing several queued messages as a single transaction of up
+// to MaxMessages (20), flushed WindowMillis (500) after the first one. MessageTypes lists the
+// type URLs that may share a transaction, the inference messages if empty; everything else is
+// still sent on its own.
+type TxBatchingConfig struct {
+	Enabled      bool     `koanf:"enabled"`
+	MaxMessages  int      `koanf:"max_messages"`
+	WindowMillis int64    `koanf:"window_ms"`
+	MessageTypes []string `koanf:"message_types"`
+}
+
+// PayloadStorageConfig sets where prompts and responses of inferences whose payloads are kept
+// off-chain are stored, /root/.dapi/payloads unless Dir is set, and for how long (a week).
+type PayloadStorageConfig struct {
+	Dir            string `koanf:"dir"`
+	RetentionHours int64  `koanf:"retention_hours"`
+}
+
+// SubscriptionsConfig tunes the delivery of inference lifecycle events to webhooks: how often
+// and how long a delivery is attempted, how many webhooks a requester may register and how many
+// workers deliver them.
+type SubscriptionsConfig struct {
+	WebhookMaxAttempts      int   `koanf:"webhook_max_attempts"`
+	WebhookTimeoutSeconds   int64 `koanf:"webhook_timeout_seconds"`
+	MaxWebhooksPerRequester int   `koanf:"max_webhooks_per_requester"`
+	WebhookWorkers          int   `koanf:"webhook_workers"`
+}
+
+// NodeConfigReloadConfig turns on watching NODE_CONFIG_PATH and Dir, a directory of node definitions,
+// and applying their changes to the running broker. Removed nodes get DrainTimeoutSeconds to finish
+// their inferences.
+type NodeConfigReloadConfig struct {
+	Enabled             bool   `koanf:"enabled"`
+	Dir                 string `koanf:"dir"`
+	IntervalSeconds     int64  `koanf:"interval_seconds"`
+	DrainTimeoutSeconds int64  `koanf:"drain_timeout_seconds"`
+}
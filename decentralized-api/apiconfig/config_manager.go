@@ -8,6 +8,8 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -140,6 +142,50 @@ func (cm *ConfigManager) GetBandwidthParams() BandwidthParamsCache {
 	return cm.currentConfig.BandwidthParams
 }
 
+func (cm *ConfigManager) GetTokenizerConfig() TokenizerConfig {
+	return cm.currentConfig.Tokenizer
+}
+
+func (cm *ConfigManager) GetExecutorRetryConfig() ExecutorRetryConfig {
+	return cm.currentConfig.ExecutorRetry
+}
+
+func (cm *ConfigManager) GetExecutorSelectionConfig() ExecutorSelectionConfig {
+	return cm.currentConfig.ExecutorSelection
+}
+
+func (cm *ConfigManager) GetNodeWaitQueueConfig() NodeWaitQueueConfig {
+	return cm.currentConfig.NodeWaitQueue
+}
+
+func (cm *ConfigManager) GetTxBatchingConfig() TxBatchingConfig {
+	return cm.currentConfig.TxBatching
+}
+
+func (cm *ConfigManager) GetPayloadStorageConfig() PayloadStorageConfig {
+	return cm.currentConfig.PayloadStorage
+}
+
+func (cm *ConfigManager) GetSubscriptionsConfig() SubscriptionsConfig {
+	return cm.currentConfig.Subscriptions
+}
+
+func (cm *ConfigManager) GetNodeConfigReloadConfig() NodeConfigReloadConfig {
+	return cm.currentConfig.NodeConfigReload
+}
+
+func (cm *ConfigManager) GetFileManagedNodes() []string {
+	nodeIds := make([]string, len(cm.currentConfig.FileManagedNodes))
+	copy(nodeIds, cm.currentConfig.FileManagedNodes)
+	return nodeIds
+}
+
+func (cm *ConfigManager) SetFileManagedNodes(nodeIds []string) error {
+	cm.currentConfig.FileManagedNodes = nodeIds
+	logging.Info("Setting file managed nodes", types.Config, "node_ids", nodeIds)
+	return writeConfig(cm.currentConfig, cm.WriterProvider.GetWriter())
+}
+
 func (cm *ConfigManager) AddNodeVersion(height int64, version string) error {
 	if !cm.currentConfig.NodeVersions.Insert(height, version) {
 		return nil
@@ -162,6 +208,17 @@ func (cm *ConfigManager) GetPreviousSeed() SeedInfo {
 	return cm.currentConfig.PreviousSeed
 }
 
+// SetUnclaimedSeeds keeps the seeds of epochs whose rewards may still be claimed during the claim grace period
+func (cm *ConfigManager) SetUnclaimedSeeds(seeds []SeedInfo) error {
+	cm.currentConfig.UnclaimedSeeds = seeds
+	logging.Info("Setting unclaimed seeds", types.Config, "count", len(seeds))
+	return writeConfig(cm.currentConfig, cm.WriterProvider.GetWriter())
+}
+
+func (cm *ConfigManager) GetUnclaimedSeeds() []SeedInfo {
+	return cm.currentConfig.UnclaimedSeeds
+}
+
 func (cm *ConfigManager) SetCurrentSeed(seed SeedInfo) error {
 	cm.currentConfig.CurrentSeed = seed
 	logging.Info("Setting current seed", types.Config, "seed", seed)
@@ -377,3 +434,70 @@ func parseInferenceNodesFromNodeConfigJson(nodeConfigPath string) ([]InferenceNo
 
 	return newNodes, nil
 }
+
+// NodeConfigPath is the node config file merged into the config at startup, if any
+func NodeConfigPath() string {
+	return strings.TrimSpace(os.Getenv("NODE_CONFIG_PATH"))
+}
+
+// LoadNodeDefinitions reads the nodes of the node config file and of every *.json file in dir, in
+// file name order. A file in dir holds either a list of nodes or a single node. Either may be empty.
+func LoadNodeDefinitions(nodeConfigPath string, dir string) ([]InferenceNodeConfig, error) {
+	var nodes []InferenceNodeConfig
+	if nodeConfigPath != "" {
+		fileNodes, err := parseInferenceNodesFromNodeConfigJson(nodeConfigPath)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, fileNodes...)
+	}
+
+	if dir != "" {
+		paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			fileNodes, err := parseNodeDefinitionFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("node definition %s: %w", path, err)
+			}
+			nodes = append(nodes, fileNodes...)
+		}
+	}
+
+	seenIds := make(map[string]bool)
+	for _, node := range nodes {
+		if node.Id == "" {
+			return nil, fmt.Errorf("node without ID found in node definitions: %s", node.Host)
+		}
+		if seenIds[node.Id] {
+			return nil, fmt.Errorf("duplicate node ID found in node definitions: %s", node.Id)
+		}
+		seenIds[node.Id] = true
+	}
+	return nodes, nil
+}
+
+func parseNodeDefinitionFile(path string) ([]InferenceNodeConfig, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := strings.TrimSpace(string(bytes))
+	if strings.HasPrefix(trimmed, "[") {
+		var nodes []InferenceNodeConfig
+		if err := json.Unmarshal(bytes, &nodes); err != nil {
+			return nil, err
+		}
+		return nodes, nil
+	}
+
+	var node InferenceNodeConfig
+	if err := json.Unmarshal(bytes, &node); err != nil {
+		return nil, err
+	}
+	return []InferenceNodeConfig{node}, nil
+}
@@ -325,3 +325,26 @@ upgrade_plan:
     height: 0
     binaries: {}
 `
+
+func TestLoadNodeDefinitions(t *testing.T) {
+	dir := t.TempDir()
+	nodeConfigPath := dir + "/node_config.json"
+	require.NoError(t, os.WriteFile(nodeConfigPath, []byte(`[{"id": "node1", "host": "ml-1", "inference_port": 5000}]`), 0o644))
+	definitions := dir + "/nodes"
+	require.NoError(t, os.Mkdir(definitions, 0o755))
+	require.NoError(t, os.WriteFile(definitions+"/b.json", []byte(`{"id": "node3", "host": "ml-3"}`), 0o644))
+	require.NoError(t, os.WriteFile(definitions+"/a.json", []byte(`[{"id": "node2", "host": "ml-2"}]`), 0o644))
+	require.NoError(t, os.WriteFile(definitions+"/notes.txt", []byte(`not a node`), 0o644))
+
+	nodes, err := apiconfig.LoadNodeDefinitions(nodeConfigPath, definitions)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+	require.Equal(t, "node1", nodes[0].Id)
+	require.Equal(t, 5000, nodes[0].InferencePort)
+	require.Equal(t, "node2", nodes[1].Id)
+	require.Equal(t, "node3", nodes[2].Id)
+
+	require.NoError(t, os.WriteFile(definitions+"/c.json", []byte(`{"id": "node1", "host": "ml-4"}`), 0o644))
+	_, err = apiconfig.LoadNodeDefinitions(nodeConfigPath, definitions)
+	require.ErrorContains(t, err, "duplicate node ID")
+}
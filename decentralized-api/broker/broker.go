@@ -116,6 +116,9 @@ type Broker struct {
 	lastEpochIndex       uint64
 	lastEpochPhase       types.EpochPhase
 	statusQueryTrigger   chan struct{}
+	waitQueueConfig      WaitQueueConfig
+	// waitQueues is only touched by the command processor goroutine
+	waitQueues map[string]*waitQueue
 }
 
 const (
@@ -184,6 +187,8 @@ type NodeState struct {
 	FailureReason   string     `json:"failure_reason"`
 	StatusTimestamp time.Time  `json:"status_timestamp"`
 	AdminState      AdminState `json:"admin_state"`
+	// Draining nodes take no new locks, so they can be removed once the ones they hold are released
+	Draining bool `json:"draining"`
 
 	// Epoch-specific data, populated from the chain
 	EpochModels  map[string]types.Model      `json:"epoch_models"`
@@ -283,7 +288,7 @@ type NodeResponse struct {
 	State NodeState `json:"state"`
 }
 
-func NewBroker(chainBridge BrokerChainBridge, phaseTracker *chainphase.ChainPhaseTracker, participantInfo participant.CurrenParticipantInfo, callbackUrl string, clientFactory mlnodeclient.ClientFactory) *Broker {
+func NewBroker(chainBridge BrokerChainBridge, phaseTracker *chainphase.ChainPhaseTracker, participantInfo participant.CurrenParticipantInfo, callbackUrl string, clientFactory mlnodeclient.ClientFactory, waitQueueConfig WaitQueueConfig) *Broker {
 	broker := &Broker{
 		highPriorityCommands: make(chan Command, 100),
 		lowPriorityCommands:  make(chan Command, 10000),
@@ -295,6 +300,8 @@ func NewBroker(chainBridge BrokerChainBridge, phaseTracker *chainphase.ChainPhas
 		mlNodeClientFactory:  clientFactory,
 		reconcileTrigger:     make(chan struct{}, 1),
 		statusQueryTrigger:   make(chan struct{}, 1),
+		waitQueueConfig:      waitQueueConfig,
+		waitQueues:           make(map[string]*waitQueue),
 	}
 
 	// Initialize NodeWorkGroup
@@ -372,6 +379,8 @@ func (b *Broker) executeCommand(command Command) {
 		command.Execute(b)
 	case RemoveNode:
 		command.Execute(b)
+	case DrainNode:
+		command.Execute(b)
 	case GetNodesCommand:
 		command.Execute(b)
 	case SyncNodesCommand:
@@ -392,9 +401,15 @@ func (b *Broker) executeCommand(command Command) {
 		command.Execute(b)
 	case UpdateNodeResultCommand:
 		command.Execute(b)
+	case GetWaitQueueStatsCommand:
+		command.Execute(b)
 	default:
 		logging.Error("Unregistered command type", types.Nodes, "type", reflect.TypeOf(command).String())
 	}
+	// Any command may have freed capacity: a released lock, a node back in INFERENCE, a new node
+	if b.hasWaiters() {
+		b.serveWaiters()
+	}
 }
 
 type InvalidCommandError struct {
@@ -410,7 +425,7 @@ func (b *Broker) QueueMessage(command Command) error {
 	}
 
 	switch command.(type) {
-	case StartPocCommand, InitValidateCommand, InferenceUpAllCommand, UpdateNodeResultCommand, SetNodesActualStatusCommand, SetNodeAdminStateCommand, RegisterNode, RemoveNode, StartTrainingCommand, LockNodesForTrainingCommand, SyncNodesCommand:
+	case StartPocCommand, InitValidateCommand, InferenceUpAllCommand, UpdateNodeResultCommand, SetNodesActualStatusCommand, SetNodeAdminStateCommand, RegisterNode, RemoveNode, DrainNode, StartTrainingCommand, LockNodesForTrainingCommand, SyncNodesCommand:
 		b.highPriorityCommands <- command
 	default:
 		b.lowPriorityCommands <- command
@@ -423,29 +438,54 @@ func (b *Broker) NewNodeClient(node *Node) mlnodeclient.MLNodeClient {
 }
 
 func (b *Broker) lockAvailableNode(command LockAvailableNode) {
-	leastBusyNode := b.getLeastBusyNode(command)
+	if command.Wait != nil {
+		b.lockOrWait(command)
+		return
+	}
 
+	leastBusyNode := b.findNodeToLock(command)
 	if leastBusyNode != nil {
 		leastBusyNode.State.LockCount++
 	}
 	logging.Debug("Locked node", types.Nodes, "node", leastBusyNode)
 	if leastBusyNode == nil {
-		if command.AcceptEarlierVersion {
-			b.lockAvailableNode(
-				LockAvailableNode{
-					Model:                command.Model,
-					Response:             command.Response,
-					AcceptEarlierVersion: false,
-				},
-			)
-		} else {
-			command.Response <- nil
-		}
+		command.Response <- nil
 	} else {
 		command.Response <- &leastBusyNode.Node
 	}
 }
 
+func (b *Broker) lockOrWait(command LockAvailableNode) {
+	if !command.Wait.pending() {
+		// The caller gave up before the command was processed
+		return
+	}
+	if len(b.getWaitQueue(command.Model).waiting) == 0 {
+		leastBusyNode := b.findNodeToLock(command)
+		if leastBusyNode != nil {
+			if command.Wait.claim() {
+				leastBusyNode.State.LockCount++
+				logging.Debug("Locked node", types.Nodes, "node", leastBusyNode)
+				command.Response <- &leastBusyNode.Node
+			}
+			return
+		}
+	}
+	if !b.enqueueWaiter(command) && command.Wait.claim() {
+		command.Response <- nil
+	}
+}
+
+// findNodeToLock picks the least busy node for the command, falling back to any node
+// version if the command accepts an earlier one.
+func (b *Broker) findNodeToLock(command LockAvailableNode) *NodeWithState {
+	leastBusyNode := b.getLeastBusyNode(command)
+	if leastBusyNode == nil && command.AcceptEarlierVersion {
+		leastBusyNode = b.getLeastBusyNode(LockAvailableNode{Model: command.Model})
+	}
+	return leastBusyNode
+}
+
 func (b *Broker) getLeastBusyNode(command LockAvailableNode) *NodeWithState {
 	epochState := b.phaseTracker.GetCurrentEpochState()
 	if epochState.IsNilOrNotSynced() {
@@ -488,6 +528,10 @@ func (b *Broker) nodeAvailable(node *NodeWithState, neededModel string, version
 	}
 	logging.Info("nodeAvailable. Node is not being reconciled, ReconcileInfo == nil", types.Nodes, "nodeId", node.Node.Id)
 
+	if node.State.Draining {
+		return false, "Node is draining before its removal"
+	}
+
 	if node.State.LockCount >= node.Node.MaxConcurrent {
 		return false, fmt.Sprintf("Node is locked too many times: lockCount=%d, maxConcurrent=%d", node.State.LockCount, node.Node.MaxConcurrent)
 	}
@@ -536,7 +580,11 @@ func (b *Broker) releaseNode(command ReleaseNode) {
 
 var ErrNoNodesAvailable = errors.New("no nodes available for inference")
 
+// LockNode locks a node serving the model, runs action on it and releases it afterwards.
+// When every node is busy the call waits in the model's queue until a node frees up, the
+// queue's wait limit passes or ctx is done.
 func LockNode[T any](
+	ctx context.Context,
 	b *Broker,
 	model string,
 	version string,
@@ -545,37 +593,51 @@ func LockNode[T any](
 	var zero T
 
 	nodeChan := make(chan *Node, 2)
+	wait := b.newNodeWait(ctx)
 	err := b.QueueMessage(LockAvailableNode{
 		Model:                model,
 		Response:             nodeChan,
 		Version:              version,
 		AcceptEarlierVersion: true,
+		Wait:                 wait,
 	})
 	if err != nil {
 		return zero, err
 	}
-	node := <-nodeChan
-	if node == nil {
-		return zero, ErrNoNodesAvailable
+	node, err := b.awaitNode(ctx, nodeChan, wait)
+	if err != nil {
+		return zero, err
 	}
 
-	defer func() {
-		queueError := b.QueueMessage(ReleaseNode{
-			NodeId: node.Id,
-			Outcome: InferenceSuccess{
-				Response: nil,
-			},
-			Response: make(chan bool, 2),
-		})
-
-		if queueError != nil {
-			logging.Error("Error releasing node", types.Nodes, "error", queueError)
-		}
-	}()
+	defer b.unlockNode(node.Id)
 
 	return action(node)
 }
 
+func (b *Broker) unlockNode(nodeId string) {
+	queueError := b.QueueMessage(ReleaseNode{
+		NodeId: nodeId,
+		Outcome: InferenceSuccess{
+			Response: nil,
+		},
+		Response: make(chan bool, 2),
+	})
+
+	if queueError != nil {
+		logging.Error("Error releasing node", types.Nodes, "error", queueError)
+	}
+}
+
+// GetWaitQueueStats returns the wait queue metrics of every model that had to wait for a node.
+func (b *Broker) GetWaitQueueStats() ([]WaitQueueStats, error) {
+	command := NewGetWaitQueueStatsCommand()
+	err := b.QueueMessage(command)
+	if err != nil {
+		return nil, err
+	}
+	return <-command.Response, nil
+}
+
 // FIXME: Should return a copy! To avoid modifying state outside of the broker
 func (b *Broker) GetNodes() ([]NodeResponse, error) {
 	command := NewGetNodesCommand()
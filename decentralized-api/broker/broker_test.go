@@ -103,7 +103,7 @@ func NewTestBroker() *Broker {
 	mockChainBridge.On("GetCurrentEpochGroupData").Return(parentEpochData, nil)
 	mockChainBridge.On("GetEpochGroupDataByModelId", uint64(100), "model1").Return(model1EpochData, nil)
 
-	return NewBroker(mockChainBridge, phaseTracker, participantInfo, "", mlnodeclient.NewMockClientFactory())
+	return NewBroker(mockChainBridge, phaseTracker, participantInfo, "", mlnodeclient.NewMockClientFactory(), WaitQueueConfig{})
 }
 
 func TestSingleNode(t *testing.T) {
@@ -120,7 +120,7 @@ func TestSingleNode(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, node)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	runningNode := <-availableNode
 	if runningNode == nil {
 		t.Fatalf("expected node1, got nil")
@@ -128,7 +128,7 @@ func TestSingleNode(t *testing.T) {
 	if runningNode.Id != node.Id {
 		t.Fatalf("expected node1, got: " + runningNode.Id)
 	}
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	if <-availableNode != nil {
 		t.Fatalf("expected nil, got " + runningNode.Id)
 	}
@@ -199,7 +199,7 @@ func TestNodeRemoval(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, node)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	runningNode := <-availableNode
 	if runningNode == nil {
 		t.Fatalf("expected node1, got nil")
@@ -212,7 +212,7 @@ func TestNodeRemoval(t *testing.T) {
 	if !<-release {
 		t.Fatalf("expected true, got false")
 	}
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	if <-availableNode != nil {
 		t.Fatalf("expected nil, got node")
 	}
@@ -232,7 +232,7 @@ func TestModelMismatch(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, node)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model2", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model2", "", false, availableNode, nil})
 	if <-availableNode != nil {
 		t.Fatalf("expected nil, got node1")
 	}
@@ -253,7 +253,7 @@ func TestHighConcurrency(t *testing.T) {
 
 	availableNode := make(chan *Node, 2)
 	for i := 0; i < 100; i++ {
-		queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+		queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 		if <-availableNode == nil {
 			t.Fatalf("expected node1, got nil")
 		}
@@ -284,17 +284,17 @@ func TestVersionFiltering(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, novNode)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model1", "v1", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "v1", false, availableNode, nil})
 	node := <-availableNode
 	require.NotNil(t, node)
 	require.Equal(t, "v1node", node.Id)
-	queueMessage(t, broker, LockAvailableNode{"model1", "v1", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "v1", false, availableNode, nil})
 	node = <-availableNode
 	require.NotNil(t, node)
 	require.Equal(t, "v1node", node.Id)
-	queueMessage(t, broker, LockAvailableNode{"model1", "v2", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "v2", false, availableNode, nil})
 	require.Nil(t, <-availableNode)
-	queueMessage(t, broker, LockAvailableNode{"model1", "v2", true, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "v2", true, availableNode, nil})
 	node = <-availableNode
 	require.NotNil(t, node)
 }
@@ -321,7 +321,7 @@ func TestMultipleNodes(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, node2)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	firstNode := <-availableNode
 	if firstNode == nil {
 		t.Fatalf("expected node1 or node2, got nil")
@@ -330,7 +330,7 @@ func TestMultipleNodes(t *testing.T) {
 	if firstNode.Id != node1.Id && firstNode.Id != node2.Id {
 		t.Fatalf("expected node1 or node2, got: " + firstNode.Id)
 	}
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	secondNode := <-availableNode
 	if secondNode == nil {
 		t.Fatalf("expected another node, got nil")
@@ -361,7 +361,7 @@ func TestReleaseNode(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, node)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	runningNode := <-availableNode
 	if runningNode == nil {
 		t.Fatalf("expected node1, got nil")
@@ -374,7 +374,7 @@ func TestReleaseNode(t *testing.T) {
 	if !<-release {
 		t.Fatalf("expected true, got false")
 	}
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	if <-availableNode == nil {
 		t.Fatalf("expected node1, got nil")
 	}
@@ -396,7 +396,7 @@ func TestRoundTripSegment(t *testing.T) {
 	registerNodeAndSetInferenceStatus(t, broker, node)
 
 	availableNode := make(chan *Node, 2)
-	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode})
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
 	runningNode := <-availableNode
 	if runningNode == nil {
 		t.Fatalf("expected node1, got nil")
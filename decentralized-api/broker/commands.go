@@ -16,6 +16,8 @@ type LockAvailableNode struct {
 	Version              string
 	AcceptEarlierVersion bool
 	Response             chan *Node
+	// Wait queues the command until a node frees up instead of answering nil right away
+	Wait *NodeWait
 }
 
 func (g LockAvailableNode) GetResponseChannelCapacity() int {
@@ -105,6 +107,24 @@ func (c GetNodesCommand) Execute(b *Broker) {
 	c.Response <- nodeResponses
 }
 
+type GetWaitQueueStatsCommand struct {
+	Response chan []WaitQueueStats
+}
+
+func NewGetWaitQueueStatsCommand() GetWaitQueueStatsCommand {
+	return GetWaitQueueStatsCommand{
+		Response: make(chan []WaitQueueStats, 2),
+	}
+}
+
+func (c GetWaitQueueStatsCommand) GetResponseChannelCapacity() int {
+	return cap(c.Response)
+}
+
+func (c GetWaitQueueStatsCommand) Execute(b *Broker) {
+	c.Response <- b.waitQueueStats()
+}
+
 type InferenceResult interface {
 	IsSuccess() bool
 	GetMessage() string
@@ -0,0 +1,68 @@
+package broker
+
+import (
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	nodeLockCountDesc = prometheus.NewDesc(
+		"dapi_broker_node_lock_count",
+		"Inference requests currently holding a lock on the node.",
+		[]string{"node_id"}, nil,
+	)
+	nodeMaxConcurrentDesc = prometheus.NewDesc(
+		"dapi_broker_node_max_concurrent",
+		"Maximum number of concurrent locks the node accepts.",
+		[]string{"node_id"}, nil,
+	)
+	nodeStatusDesc = prometheus.NewDesc(
+		"dapi_broker_node_status",
+		"Set to 1 for the node's current and intended hardware status.",
+		[]string{"node_id", "kind", "status"}, nil,
+	)
+	nodeReconcilingDesc = prometheus.NewDesc(
+		"dapi_broker_node_reconciling",
+		"Set to 1 while a reconciliation task is in flight for the node.",
+		[]string{"node_id"}, nil,
+	)
+)
+
+// MetricsCollector exports the state of every node known to the broker at scrape time.
+func (b *Broker) MetricsCollector() prometheus.Collector {
+	return nodeStateCollector{broker: b}
+}
+
+type nodeStateCollector struct {
+	broker *Broker
+}
+
+func (c nodeStateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nodeLockCountDesc
+	ch <- nodeMaxConcurrentDesc
+	ch <- nodeStatusDesc
+	ch <- nodeReconcilingDesc
+}
+
+func (c nodeStateCollector) Collect(ch chan<- prometheus.Metric) {
+	c.broker.mu.RLock()
+	defer c.broker.mu.RUnlock()
+
+	for _, node := range c.broker.nodes {
+		id := node.Node.Id
+		ch <- prometheus.MustNewConstMetric(nodeLockCountDesc, prometheus.GaugeValue, float64(node.State.LockCount), id)
+		ch <- prometheus.MustNewConstMetric(nodeMaxConcurrentDesc, prometheus.GaugeValue, float64(node.Node.MaxConcurrent), id)
+		for _, status := range types.HardwareNodeStatus_name {
+			ch <- prometheus.MustNewConstMetric(nodeStatusDesc, prometheus.GaugeValue, boolToFloat(node.State.CurrentStatus.String() == status), id, "current", status)
+			ch <- prometheus.MustNewConstMetric(nodeStatusDesc, prometheus.GaugeValue, boolToFloat(node.State.IntendedStatus.String() == status), id, "intended", status)
+		}
+		ch <- prometheus.MustNewConstMetric(nodeReconcilingDesc, prometheus.GaugeValue, boolToFloat(node.State.ReconcileInfo != nil), id)
+	}
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
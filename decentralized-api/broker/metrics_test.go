@@ -0,0 +1,38 @@
+package broker
+
+import (
+	"decentralized-api/apiconfig"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsCollectorExportsNodeState(t *testing.T) {
+	broker := NewTestBroker()
+	registerNodeAndSetInferenceStatus(t, broker, apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 3,
+	})
+
+	response := make(chan *Node, 2)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: response})
+	require.NotNil(t, <-response)
+
+	expected := `
+# HELP dapi_broker_node_lock_count Inference requests currently holding a lock on the node.
+# TYPE dapi_broker_node_lock_count gauge
+dapi_broker_node_lock_count{node_id="node1"} 1
+# HELP dapi_broker_node_max_concurrent Maximum number of concurrent locks the node accepts.
+# TYPE dapi_broker_node_max_concurrent gauge
+dapi_broker_node_max_concurrent{node_id="node1"} 3
+`
+	err := testutil.CollectAndCompare(broker.MetricsCollector(), strings.NewReader(expected),
+		"dapi_broker_node_lock_count", "dapi_broker_node_max_concurrent")
+	require.NoError(t, err)
+}
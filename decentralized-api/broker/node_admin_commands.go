@@ -135,6 +135,67 @@ func (command RemoveNode) Execute(b *Broker) {
 	command.Response <- true
 }
 
+// DrainNode stops handing out a node, so it can be removed without cutting off the inferences it serves
+type DrainNode struct {
+	NodeId   string
+	Response chan bool
+}
+
+func (d DrainNode) GetResponseChannelCapacity() int {
+	return cap(d.Response)
+}
+
+func (command DrainNode) Execute(b *Broker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	node, ok := b.nodes[command.NodeId]
+	if !ok {
+		command.Response <- false
+		return
+	}
+	node.State.Draining = true
+	logging.Info("Draining node", types.Nodes, "node_id", command.NodeId, "lock_count", node.State.LockCount)
+	command.Response <- true
+}
+
+// SyncNodesWithConfig writes the broker's nodes back to the config, so they survive a restart
+func SyncNodesWithConfig(nodeBroker *Broker, config *apiconfig.ConfigManager) {
+	nodes, err := nodeBroker.GetNodes()
+	if err != nil {
+		logging.Error("Error getting nodes", types.Nodes, "error", err)
+		return
+	}
+	iNodes := make([]apiconfig.InferenceNodeConfig, len(nodes))
+	for i, n := range nodes {
+		iNodes[i] = n.Node.toConfig()
+	}
+	err = config.SetNodes(iNodes)
+	if err != nil {
+		logging.Error("Error writing config", types.Nodes, "error", err)
+	}
+}
+
+func (n Node) toConfig() apiconfig.InferenceNodeConfig {
+	models := make(map[string]apiconfig.ModelConfig)
+	for model, cfg := range n.Models {
+		models[model] = apiconfig.ModelConfig{Args: cfg.Args}
+	}
+
+	return apiconfig.InferenceNodeConfig{
+		Host:             n.Host,
+		InferenceSegment: n.InferenceSegment,
+		InferencePort:    n.InferencePort,
+		PoCSegment:       n.PoCSegment,
+		PoCPort:          n.PoCPort,
+		Models:           models,
+		Id:               n.Id,
+		MaxConcurrent:    n.MaxConcurrent,
+		Hardware:         n.Hardware,
+		Version:          n.Version,
+	}
+}
+
 // SetNodeAdminStateCommand enables or disables a node administratively
 type SetNodeAdminStateCommand struct {
 	NodeId   string
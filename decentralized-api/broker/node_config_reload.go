@@ -0,0 +1,318 @@
+package broker
+
+import (
+	"context"
+	"crypto/sha256"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	DefaultNodeConfigReloadInterval = 15 * time.Second
+	DefaultNodeDrainTimeout         = 2 * time.Minute
+	nodeDrainPollInterval           = 500 * time.Millisecond
+)
+
+type NodeConfigReloadOptions struct {
+	NodeConfigPath string
+	Dir            string
+	Interval       time.Duration
+	DrainTimeout   time.Duration
+}
+
+func NewNodeConfigReloadOptions(config apiconfig.NodeConfigReloadConfig) NodeConfigReloadOptions {
+	options := NodeConfigReloadOptions{
+		NodeConfigPath: apiconfig.NodeConfigPath(),
+		Dir:            config.Dir,
+		Interval:       time.Duration(config.IntervalSeconds) * time.Second,
+		DrainTimeout:   time.Duration(config.DrainTimeoutSeconds) * time.Second,
+	}
+	if options.Interval <= 0 {
+		options.Interval = DefaultNodeConfigReloadInterval
+	}
+	if options.DrainTimeout <= 0 {
+		options.DrainTimeout = DefaultNodeDrainTimeout
+	}
+	return options
+}
+
+// NodeConfigChanges lists the ids of the nodes a reload added, updated and removed
+type NodeConfigChanges struct {
+	Added   []string `json:"added"`
+	Updated []string `json:"updated"`
+	Removed []string `json:"removed"`
+}
+
+func (c NodeConfigChanges) Empty() bool {
+	return len(c.Added) == 0 && len(c.Updated) == 0 && len(c.Removed) == 0
+}
+
+// NodeConfigReloader keeps the broker's nodes in line with the node definition files, for fleets
+// whose config management writes files rather than calling the admin API. Nodes are added, updated
+// and removed like the admin handlers do, but a node is drained of its locks before it goes away.
+// Only nodes that came from the files are ever removed, nodes added through the admin API stay. Which
+// nodes came from the files is kept in the config, so a restart still removes a file deleted meanwhile.
+type NodeConfigReloader struct {
+	broker  *Broker
+	config  *apiconfig.ConfigManager
+	options NodeConfigReloadOptions
+
+	lastHash string
+	managed  map[string]bool
+}
+
+func NewNodeConfigReloader(broker *Broker, config *apiconfig.ConfigManager, options NodeConfigReloadOptions) *NodeConfigReloader {
+	managed := make(map[string]bool)
+	for _, nodeId := range config.GetFileManagedNodes() {
+		managed[nodeId] = true
+	}
+	return &NodeConfigReloader{
+		broker:  broker,
+		config:  config,
+		options: options,
+		managed: managed,
+	}
+}
+
+func (r *NodeConfigReloader) Run(ctx context.Context) {
+	logging.Info("Watching node definitions", types.Nodes,
+		"node_config_path", r.options.NodeConfigPath,
+		"dir", r.options.Dir,
+		"interval", r.options.Interval)
+
+	ticker := time.NewTicker(r.options.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := r.Reload(ctx); err != nil {
+			logging.Error("Failed to reload node definitions", types.Nodes, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reload applies the node definitions if they changed since the last successful reload. A failed
+// one is retried on the next tick, a file caught half written fails to parse and is read again then.
+func (r *NodeConfigReloader) Reload(ctx context.Context) (NodeConfigChanges, error) {
+	nodes, err := apiconfig.LoadNodeDefinitions(r.options.NodeConfigPath, r.options.Dir)
+	if err != nil {
+		return NodeConfigChanges{}, err
+	}
+	hash, err := hashNodeDefinitions(nodes)
+	if err != nil {
+		return NodeConfigChanges{}, err
+	}
+	if hash == r.lastHash {
+		return NodeConfigChanges{}, nil
+	}
+
+	changes, err := r.apply(ctx, nodes)
+	if !changes.Empty() {
+		logging.Info("Applied node definitions", types.Nodes, "changes", changes)
+		SyncNodesWithConfig(r.broker, r.config)
+		// Logs the resulting MsgSubmitHardwareDiff and submits it
+		if err := r.broker.QueueMessage(NewSyncNodesCommand()); err != nil {
+			logging.Error("Error syncing nodes", types.Nodes, "error", err)
+		}
+	}
+	if err != nil {
+		return changes, err
+	}
+	r.lastHash = hash
+	return changes, nil
+}
+
+func (r *NodeConfigReloader) apply(ctx context.Context, nodes []apiconfig.InferenceNodeConfig) (NodeConfigChanges, error) {
+	var changes NodeConfigChanges
+	current, err := r.broker.GetNodes()
+	if err != nil {
+		return changes, err
+	}
+	currentNodes := make(map[string]Node, len(current))
+	for _, n := range current {
+		currentNodes[n.Node.Id] = n.Node
+	}
+
+	var errs []error
+	desired := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		desired[node.Id] = true
+		r.managed[node.Id] = true
+
+		existing, found := currentNodes[node.Id]
+		if !found {
+			if err := r.register(node); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			changes.Added = append(changes.Added, node.Id)
+			continue
+		}
+		if nodeMatchesConfig(existing, node) {
+			continue
+		}
+
+		logging.Info("Node definition changed, replacing node", types.Nodes, "node_id", node.Id)
+		if err := r.drainAndRemove(ctx, node.Id); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := r.register(node); err != nil {
+			errs = append(errs, err)
+			changes.Removed = append(changes.Removed, node.Id)
+			continue
+		}
+		changes.Updated = append(changes.Updated, node.Id)
+	}
+
+	for nodeId := range r.managed {
+		if desired[nodeId] {
+			continue
+		}
+		if _, found := currentNodes[nodeId]; !found {
+			// Already removed through the admin API
+			delete(r.managed, nodeId)
+			continue
+		}
+
+		logging.Info("Node definition removed, removing node", types.Nodes, "node_id", nodeId)
+		if err := r.drainAndRemove(ctx, nodeId); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(r.managed, nodeId)
+		changes.Removed = append(changes.Removed, nodeId)
+	}
+	slices.Sort(changes.Removed)
+
+	if err := r.saveManaged(); err != nil {
+		errs = append(errs, err)
+	}
+	return changes, errors.Join(errs...)
+}
+
+// saveManaged persists the ids of the nodes that came from the files, when they changed
+func (r *NodeConfigReloader) saveManaged() error {
+	nodeIds := make([]string, 0, len(r.managed))
+	for nodeId := range r.managed {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	slices.Sort(nodeIds)
+	saved := r.config.GetFileManagedNodes()
+	slices.Sort(saved)
+	if slices.Equal(nodeIds, saved) {
+		return nil
+	}
+	return r.config.SetFileManagedNodes(nodeIds)
+}
+
+func (r *NodeConfigReloader) register(node apiconfig.InferenceNodeConfig) error {
+	response := make(chan *apiconfig.InferenceNodeConfig, 2)
+	if err := r.broker.QueueMessage(RegisterNode{Node: node, Response: response}); err != nil {
+		return err
+	}
+	if <-response == nil {
+		return fmt.Errorf("node %s was not registered", node.Id)
+	}
+	return nil
+}
+
+// drainAndRemove waits for the locks of the node to be released, up to the drain timeout, and
+// removes it. Inferences still running when the timeout passes are cut off like by the admin API.
+func (r *NodeConfigReloader) drainAndRemove(ctx context.Context, nodeId string) error {
+	drained := make(chan bool, 2)
+	if err := r.broker.QueueMessage(DrainNode{NodeId: nodeId, Response: drained}); err != nil {
+		return err
+	}
+	if <-drained {
+		if err := r.waitForLocks(ctx, nodeId); err != nil {
+			return err
+		}
+	}
+
+	removed := make(chan bool, 2)
+	if err := r.broker.QueueMessage(RemoveNode{NodeId: nodeId, Response: removed}); err != nil {
+		return err
+	}
+	<-removed
+	return nil
+}
+
+func (r *NodeConfigReloader) waitForLocks(ctx context.Context, nodeId string) error {
+	deadline := time.Now().Add(r.options.DrainTimeout)
+	ticker := time.NewTicker(nodeDrainPollInterval)
+	defer ticker.Stop()
+	for {
+		lockCount, err := r.lockCount(nodeId)
+		if err != nil {
+			return err
+		}
+		if lockCount == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			logging.Warn("Node not drained in time, removing it anyway", types.Nodes, "node_id", nodeId, "lock_count", lockCount)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *NodeConfigReloader) lockCount(nodeId string) (int, error) {
+	nodes, err := r.broker.GetNodes()
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range nodes {
+		if n.Node.Id == nodeId {
+			return n.State.LockCount, nil
+		}
+	}
+	return 0, nil
+}
+
+func nodeMatchesConfig(node Node, config apiconfig.InferenceNodeConfig) bool {
+	existing := node.toConfig()
+	if existing.Host != config.Host ||
+		existing.InferenceSegment != config.InferenceSegment ||
+		existing.InferencePort != config.InferencePort ||
+		existing.PoCSegment != config.PoCSegment ||
+		existing.PoCPort != config.PoCPort ||
+		existing.MaxConcurrent != config.MaxConcurrent ||
+		existing.Version != config.Version ||
+		!slices.Equal(existing.Hardware, config.Hardware) ||
+		len(existing.Models) != len(config.Models) {
+		return false
+	}
+	for model, args := range config.Models {
+		existingArgs, found := existing.Models[model]
+		if !found || !slices.Equal(existingArgs.Args, args.Args) {
+			return false
+		}
+	}
+	return true
+}
+
+func hashNodeDefinitions(nodes []apiconfig.InferenceNodeConfig) (string, error) {
+	bytes, err := json.Marshal(nodes)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,194 @@
+package broker
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type discardWriterProvider struct{}
+
+func (d discardWriterProvider) GetWriter() apiconfig.WriteCloser { return d }
+func (d discardWriterProvider) Write(data []byte) (int, error)   { return len(data), nil }
+func (d discardWriterProvider) Close() error                     { return nil }
+
+func writeNodeDefinition(t *testing.T, path string, node any) {
+	bytes, err := json.Marshal(node)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bytes, 0o644))
+}
+
+func definedNode(id string, port int, maxConcurrent int) apiconfig.InferenceNodeConfig {
+	return apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: port,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: []string{}}},
+		Id:            id,
+		MaxConcurrent: maxConcurrent,
+	}
+}
+
+func newTestReloader(t *testing.T, broker *Broker) (*NodeConfigReloader, string, chan *types.MsgSubmitHardwareDiff) {
+	bridge := broker.chainBridge.(*MockBrokerChainBridge)
+	bridge.On("GetHardwareNodes").Return(&types.QueryHardwareNodesResponse{Nodes: &types.HardwareNodes{}}, nil)
+	submitted := make(chan *types.MsgSubmitHardwareDiff, 10)
+	bridge.On("SubmitHardwareDiff", mock.Anything).Run(func(args mock.Arguments) {
+		submitted <- args.Get(0).(*types.MsgSubmitHardwareDiff)
+	}).Return(nil)
+
+	dir := t.TempDir()
+	config := &apiconfig.ConfigManager{WriterProvider: discardWriterProvider{}}
+	reloader := NewNodeConfigReloader(broker, config, NodeConfigReloadOptions{
+		Dir:          dir,
+		Interval:     time.Second,
+		DrainTimeout: 5 * time.Second,
+	})
+	return reloader, dir, submitted
+}
+
+func TestNodeConfigReload_AddAndRemove(t *testing.T) {
+	broker := NewTestBroker()
+	reloader, dir, submitted := newTestReloader(t, broker)
+	ctx := context.Background()
+
+	// Added through the admin API, never touched by the reloader
+	queueMessage(t, broker, RegisterNode{definedNode("admin-node", 8000, 1), make(chan *apiconfig.InferenceNodeConfig, 2)})
+
+	writeNodeDefinition(t, filepath.Join(dir, "a.json"), definedNode("node1", 8080, 1))
+	writeNodeDefinition(t, filepath.Join(dir, "b.json"), []apiconfig.InferenceNodeConfig{definedNode("node2", 8081, 1)})
+	changes, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"node1", "node2"}, changes.Added)
+
+	diff := <-submitted
+	require.Len(t, diff.NewOrModified, 3)
+
+	changes, err = reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changes.Empty())
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "b.json")))
+	changes, err = reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"node2"}, changes.Removed)
+
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	var ids []string
+	for _, n := range nodes {
+		ids = append(ids, n.Node.Id)
+	}
+	require.ElementsMatch(t, []string{"admin-node", "node1"}, ids)
+}
+
+func TestNodeConfigReload_RestartKeepsManagedNodes(t *testing.T) {
+	broker := NewTestBroker()
+	reloader, dir, _ := newTestReloader(t, broker)
+	ctx := context.Background()
+
+	queueMessage(t, broker, RegisterNode{definedNode("admin-node", 8000, 1), make(chan *apiconfig.InferenceNodeConfig, 2)})
+	writeNodeDefinition(t, filepath.Join(dir, "a.json"), definedNode("node1", 8080, 1))
+	_, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"node1"}, reloader.config.GetFileManagedNodes())
+
+	// The definition is deleted while the API is down, the restarted reloader still removes the node
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.json")))
+	restarted := NewNodeConfigReloader(broker, reloader.config, reloader.options)
+	changes, err := restarted.Reload(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"node1"}, changes.Removed)
+	require.Empty(t, reloader.config.GetFileManagedNodes())
+
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, "admin-node", nodes[0].Node.Id)
+}
+
+func TestNodeConfigReload_InvalidDefinitionsKeepNodes(t *testing.T) {
+	broker := NewTestBroker()
+	reloader, dir, _ := newTestReloader(t, broker)
+	ctx := context.Background()
+
+	writeNodeDefinition(t, filepath.Join(dir, "a.json"), definedNode("node1", 8080, 1))
+	_, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "node1", "host":`), 0o644))
+	_, err = reloader.Reload(ctx)
+	require.Error(t, err)
+
+	writeNodeDefinition(t, filepath.Join(dir, "b.json"), definedNode("node1", 8081, 1))
+	writeNodeDefinition(t, filepath.Join(dir, "a.json"), definedNode("node1", 8080, 1))
+	_, err = reloader.Reload(ctx)
+	require.ErrorContains(t, err, "duplicate node ID")
+
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, 8080, nodes[0].Node.InferencePort)
+}
+
+func TestNodeConfigReload_UpdateDrainsLocks(t *testing.T) {
+	broker := NewTestBroker()
+	reloader, dir, _ := newTestReloader(t, broker)
+	ctx := context.Background()
+
+	node := definedNode("node1", 8080, 2)
+	writeNodeDefinition(t, filepath.Join(dir, "a.json"), node)
+	_, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+	registerNodeAndSetInferenceStatus(t, broker, node)
+
+	availableNode := make(chan *Node, 2)
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
+	require.NotNil(t, <-availableNode)
+
+	writeNodeDefinition(t, filepath.Join(dir, "a.json"), definedNode("node1", 9090, 2))
+	reloaded := make(chan NodeConfigChanges, 1)
+	reloadErr := make(chan error, 1)
+	go func() {
+		changes, err := reloader.Reload(ctx)
+		reloadErr <- err
+		reloaded <- changes
+	}()
+
+	require.Eventually(t, func() bool {
+		nodes, err := broker.GetNodes()
+		return err == nil && len(nodes) == 1 && nodes[0].State.Draining
+	}, time.Second, 10*time.Millisecond)
+
+	// Draining nodes take no new locks, the one they hold keeps the node until it is released
+	queueMessage(t, broker, LockAvailableNode{"model1", "", false, availableNode, nil})
+	require.Nil(t, <-availableNode)
+	select {
+	case <-reloadErr:
+		t.Fatalf("expected the node to be replaced only once drained")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	release := make(chan bool, 2)
+	queueMessage(t, broker, ReleaseNode{"node1", InferenceSuccess{}, release})
+	require.True(t, <-release)
+
+	require.NoError(t, <-reloadErr)
+	changes := <-reloaded
+	require.Equal(t, []string{"node1"}, changes.Updated)
+
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, 9090, nodes[0].Node.InferencePort)
+	require.False(t, nodes[0].State.Draining)
+	require.Equal(t, 0, nodes[0].State.LockCount)
+}
@@ -3,8 +3,11 @@ package broker
 import (
 	"context"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"decentralized-api/mlnodeclient"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -44,7 +47,7 @@ func (w *NodeWorker) run() {
 	for {
 		select {
 		case item := <-w.commands:
-			result := item.cmd.Execute(item.ctx, w)
+			result := w.execute(item)
 
 			// Queue a command back to the broker to update the state
 			updateCmd := NewUpdateNodeResultCommand(w.nodeId, result)
@@ -58,7 +61,7 @@ func (w *NodeWorker) run() {
 			// Drain remaining commands before shutting down
 			close(w.commands)
 			for item := range w.commands {
-				result := item.cmd.Execute(item.ctx, w)
+				result := w.execute(item)
 				updateCmd := NewUpdateNodeResultCommand(w.nodeId, result)
 				if err := w.broker.QueueMessage(updateCmd); err != nil {
 					logging.Error("Failed to queue node result update command during shutdown", types.Nodes,
@@ -71,6 +74,15 @@ func (w *NodeWorker) run() {
 	}
 }
 
+func (w *NodeWorker) execute(item commandWithContext) NodeResult {
+	start := time.Now()
+	result := item.cmd.Execute(item.ctx, w)
+	metrics.NodeReconcileDuration.
+		WithLabelValues(result.OriginalTarget.String(), strconv.FormatBool(result.Succeeded)).
+		Observe(time.Since(start).Seconds())
+	return result
+}
+
 // Submit queues a command for execution on this node
 func (w *NodeWorker) Submit(ctx context.Context, cmd NodeWorkerCommand) bool {
 	w.wg.Add(1)
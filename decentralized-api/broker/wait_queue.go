@@ -0,0 +1,269 @@
+package broker
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	DefaultWaitQueueMaxDepth = 64
+	DefaultWaitQueueMaxWait  = 5 * time.Second
+)
+
+const (
+	waitPending int32 = iota
+	waitServed
+	waitCancelled
+)
+
+// NodeWait lets a LockAvailableNode command wait in its model's queue until a node frees up.
+// The broker and the caller race to settle it: the broker claims it when it hands over a
+// node, the caller cancels it when the deadline passes or its context is done. Whoever
+// loses the race must respect the winner, so a locked node is never lost.
+type NodeWait struct {
+	Deadline   time.Time
+	enqueuedAt time.Time
+	state      atomic.Int32
+}
+
+func NewNodeWait(deadline time.Time) *NodeWait {
+	return &NodeWait{Deadline: deadline}
+}
+
+func (w *NodeWait) claim() bool {
+	return w.state.CompareAndSwap(waitPending, waitServed)
+}
+
+func (w *NodeWait) cancel() bool {
+	return w.state.CompareAndSwap(waitPending, waitCancelled)
+}
+
+func (w *NodeWait) pending() bool {
+	return w.state.Load() == waitPending
+}
+
+type WaitQueueConfig struct {
+	MaxDepth int
+	MaxWait  time.Duration
+}
+
+func NewWaitQueueConfig(config apiconfig.NodeWaitQueueConfig) WaitQueueConfig {
+	result := WaitQueueConfig{
+		MaxDepth: config.MaxDepth,
+		MaxWait:  time.Duration(config.MaxWaitMillis) * time.Millisecond,
+	}
+	if result.MaxDepth == 0 {
+		result.MaxDepth = DefaultWaitQueueMaxDepth
+	}
+	if result.MaxWait <= 0 {
+		result.MaxWait = DefaultWaitQueueMaxWait
+	}
+	return result
+}
+
+func (c WaitQueueConfig) Enabled() bool {
+	return c.MaxDepth > 0 && c.MaxWait > 0
+}
+
+// WaitQueueStats describes the wait queue of a single model. Counters are cumulative
+// since the broker started.
+type WaitQueueStats struct {
+	Model       string `json:"model"`
+	Depth       int    `json:"depth"`
+	Enqueued    uint64 `json:"enqueued"`
+	Served      uint64 `json:"served"`
+	TimedOut    uint64 `json:"timed_out"`
+	Cancelled   uint64 `json:"cancelled"`
+	Rejected    uint64 `json:"rejected"`
+	TotalWaitMs int64  `json:"total_wait_ms"`
+	MaxWaitMs   int64  `json:"max_wait_ms"`
+}
+
+type waitQueue struct {
+	waiting []LockAvailableNode
+	stats   WaitQueueStats
+}
+
+// newNodeWait returns nil when waiting is disabled, which keeps LockAvailableNode fail-fast.
+func (b *Broker) newNodeWait(ctx context.Context) *NodeWait {
+	if !b.waitQueueConfig.Enabled() {
+		return nil
+	}
+	deadline := time.Now().Add(b.waitQueueConfig.MaxWait)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	return NewNodeWait(deadline)
+}
+
+func (b *Broker) getWaitQueue(model string) *waitQueue {
+	queue, found := b.waitQueues[model]
+	if !found {
+		queue = &waitQueue{stats: WaitQueueStats{Model: model}}
+		b.waitQueues[model] = queue
+	}
+	return queue
+}
+
+func (b *Broker) hasWaiters() bool {
+	for _, queue := range b.waitQueues {
+		if len(queue.waiting) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueueWaiter parks the command behind earlier waiters of the same model. It returns
+// false if the command should be answered right away instead.
+func (b *Broker) enqueueWaiter(command LockAvailableNode) bool {
+	if !b.modelServedByAnyNode(command.Model) {
+		// Nothing will ever free up for a model no node serves
+		return false
+	}
+	queue := b.getWaitQueue(command.Model)
+	b.pruneWaiters(queue, time.Now())
+	if len(queue.waiting) >= b.waitQueueConfig.MaxDepth {
+		queue.stats.Rejected++
+		logging.Warn("Node wait queue is full", types.Nodes, "model", command.Model, "depth", len(queue.waiting))
+		return false
+	}
+	command.Wait.enqueuedAt = time.Now()
+	queue.waiting = append(queue.waiting, command)
+	queue.stats.Enqueued++
+	logging.Debug("Waiting for a free node", types.Nodes, "model", command.Model, "depth", len(queue.waiting))
+	return true
+}
+
+// pruneWaiters drops waiters the caller gave up on or whose deadline passed.
+func (b *Broker) pruneWaiters(queue *waitQueue, now time.Time) {
+	live := queue.waiting[:0]
+	for _, command := range queue.waiting {
+		switch {
+		case !command.Wait.pending():
+			if now.Before(command.Wait.Deadline) {
+				queue.stats.Cancelled++
+			} else {
+				queue.stats.TimedOut++
+			}
+		case !now.Before(command.Wait.Deadline):
+			if command.Wait.claim() {
+				command.Response <- nil
+			}
+			queue.stats.TimedOut++
+		default:
+			live = append(live, command)
+		}
+	}
+	clear(queue.waiting[len(live):])
+	queue.waiting = live
+}
+
+// serveWaiters hands freed nodes to the oldest waiters of each model. A waiter that can't
+// be served yet blocks the ones behind it, so the queue stays first in, first out.
+func (b *Broker) serveWaiters() {
+	now := time.Now()
+	for _, queue := range b.waitQueues {
+		b.pruneWaiters(queue, now)
+		for len(queue.waiting) > 0 {
+			command := queue.waiting[0]
+			node := b.findNodeToLock(command)
+			if node == nil {
+				break
+			}
+			queue.waiting[0] = LockAvailableNode{}
+			queue.waiting = queue.waiting[1:]
+			if !command.Wait.claim() {
+				queue.stats.Cancelled++
+				continue
+			}
+			node.State.LockCount++
+			waited := now.Sub(command.Wait.enqueuedAt).Milliseconds()
+			queue.stats.Served++
+			queue.stats.TotalWaitMs += waited
+			queue.stats.MaxWaitMs = max(queue.stats.MaxWaitMs, waited)
+			logging.Debug("Locked node for waiting request", types.Nodes, "node_id", node.Node.Id, "model", command.Model, "waited_ms", waited)
+			command.Response <- &node.Node
+		}
+	}
+}
+
+func (b *Broker) modelServedByAnyNode(model string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, node := range b.nodes {
+		if _, found := node.Node.Models[model]; found {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Broker) waitQueueStats() []WaitQueueStats {
+	b.pruneAllWaiters()
+	stats := make([]WaitQueueStats, 0, len(b.waitQueues))
+	for _, queue := range b.waitQueues {
+		queueStats := queue.stats
+		queueStats.Depth = len(queue.waiting)
+		stats = append(stats, queueStats)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Model < stats[j].Model
+	})
+	return stats
+}
+
+func (b *Broker) pruneAllWaiters() {
+	now := time.Now()
+	for _, queue := range b.waitQueues {
+		b.pruneWaiters(queue, now)
+	}
+}
+
+// awaitNode waits for the broker's answer to a LockAvailableNode command, giving up when
+// the wait deadline passes or ctx is done.
+func (b *Broker) awaitNode(ctx context.Context, nodeChan chan *Node, wait *NodeWait) (*Node, error) {
+	if wait == nil {
+		node := <-nodeChan
+		if node == nil {
+			return nil, ErrNoNodesAvailable
+		}
+		return node, nil
+	}
+
+	timer := time.NewTimer(time.Until(wait.Deadline))
+	defer timer.Stop()
+
+	select {
+	case node := <-nodeChan:
+		if node == nil {
+			return nil, ErrNoNodesAvailable
+		}
+		return node, nil
+	case <-ctx.Done():
+		if wait.cancel() {
+			return nil, ctx.Err()
+		}
+	case <-timer.C:
+		if wait.cancel() {
+			return nil, ErrNoNodesAvailable
+		}
+	}
+
+	// The broker already handed us a node while we were giving up
+	node := <-nodeChan
+	if node == nil {
+		return nil, ErrNoNodesAvailable
+	}
+	if ctx.Err() != nil {
+		b.unlockNode(node.Id)
+		return nil, ctx.Err()
+	}
+	return node, nil
+}
@@ -0,0 +1,125 @@
+package broker
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newWaitQueueTestBroker(t *testing.T, config WaitQueueConfig) *Broker {
+	broker := NewTestBroker()
+	broker.waitQueueConfig = config
+	registerNodeAndSetInferenceStatus(t, broker, apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 1,
+	})
+	return broker
+}
+
+func lockWithWait(t *testing.T, broker *Broker, deadline time.Time) (chan *Node, *NodeWait) {
+	response := make(chan *Node, 2)
+	wait := NewNodeWait(deadline)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: response, Wait: wait})
+	return response, wait
+}
+
+func getWaitQueueStats(t *testing.T, broker *Broker) WaitQueueStats {
+	stats, err := broker.GetWaitQueueStats()
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	return stats[0]
+}
+
+func TestWaitQueueServesWaitersInOrder(t *testing.T) {
+	broker := newWaitQueueTestBroker(t, WaitQueueConfig{MaxDepth: 10, MaxWait: time.Minute})
+	deadline := time.Now().Add(time.Minute)
+
+	first, _ := lockWithWait(t, broker, deadline)
+	require.NotNil(t, <-first)
+
+	second, _ := lockWithWait(t, broker, deadline)
+	third, _ := lockWithWait(t, broker, deadline)
+	require.Equal(t, 2, getWaitQueueStats(t, broker).Depth)
+
+	broker.unlockNode("node1")
+	require.NotNil(t, <-second)
+	require.Empty(t, third)
+
+	broker.unlockNode("node1")
+	require.NotNil(t, <-third)
+
+	stats := getWaitQueueStats(t, broker)
+	require.Equal(t, 0, stats.Depth)
+	require.Equal(t, uint64(2), stats.Enqueued)
+	require.Equal(t, uint64(2), stats.Served)
+}
+
+func TestWaitQueueRejectsWhenFull(t *testing.T) {
+	broker := newWaitQueueTestBroker(t, WaitQueueConfig{MaxDepth: 1, MaxWait: time.Minute})
+	deadline := time.Now().Add(time.Minute)
+
+	first, _ := lockWithWait(t, broker, deadline)
+	require.NotNil(t, <-first)
+
+	_, _ = lockWithWait(t, broker, deadline)
+	rejected, _ := lockWithWait(t, broker, deadline)
+	require.Nil(t, <-rejected)
+
+	stats := getWaitQueueStats(t, broker)
+	require.Equal(t, 1, stats.Depth)
+	require.Equal(t, uint64(1), stats.Rejected)
+}
+
+func TestLockNodeGivesUpAfterMaxWait(t *testing.T) {
+	broker := newWaitQueueTestBroker(t, WaitQueueConfig{MaxDepth: 10, MaxWait: 50 * time.Millisecond})
+
+	first, _ := lockWithWait(t, broker, time.Now().Add(time.Minute))
+	require.NotNil(t, <-first)
+
+	_, err := LockNode(context.Background(), broker, "model1", "", func(node *Node) (bool, error) {
+		return true, nil
+	})
+	require.ErrorIs(t, err, ErrNoNodesAvailable)
+	require.Equal(t, uint64(1), getWaitQueueStats(t, broker).TimedOut)
+
+	// The lock held by the first request is still the only one
+	broker.unlockNode("node1")
+	ok, err := LockNode(context.Background(), broker, "model1", "", func(node *Node) (bool, error) {
+		return true, nil
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestLockNodeStopsWaitingWhenContextIsDone(t *testing.T) {
+	broker := newWaitQueueTestBroker(t, WaitQueueConfig{MaxDepth: 10, MaxWait: time.Minute})
+
+	first, _ := lockWithWait(t, broker, time.Now().Add(time.Minute))
+	require.NotNil(t, <-first)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	_, err := LockNode(ctx, broker, "model1", "", func(node *Node) (bool, error) {
+		return true, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, uint64(1), getWaitQueueStats(t, broker).Cancelled)
+}
+
+func TestWaitQueueDoesNotWaitForUnservedModel(t *testing.T) {
+	broker := newWaitQueueTestBroker(t, WaitQueueConfig{MaxDepth: 10, MaxWait: time.Minute})
+
+	response := make(chan *Node, 2)
+	queueMessage(t, broker, LockAvailableNode{Model: "model2", Response: response, Wait: NewNodeWait(time.Now().Add(time.Minute))})
+	require.Nil(t, <-response)
+}
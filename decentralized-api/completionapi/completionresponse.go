@@ -48,7 +48,9 @@ func (r *JsonCompletionResponse) GetBodyBytes() ([]byte, error) {
 func (r *JsonCompletionResponse) GetHash() (string, error) {
 	var builder strings.Builder
 	for _, choice := range r.Resp.Choices {
-		builder.WriteString(choice.Message.Content)
+		if content := choice.GetContent(); content != nil {
+			builder.WriteString(*content)
+		}
 	}
 
 	return computeHash(builder.String())
@@ -64,7 +66,10 @@ func (r *JsonCompletionResponse) GetEnforcedStr() (string, error) {
 		logging.Warn("More than one choice in a non-steamed inference response, defaulting to first one", types.Validation, "choices", r.Resp.Choices)
 	}
 
-	content := r.Resp.Choices[0].Message.Content
+	content := ""
+	if c := r.Resp.Choices[0].GetContent(); c != nil {
+		content = *c
+	}
 	if content == "" {
 		logging.Error("Model return empty response", types.Validation, "inference_id", r.Resp.ID)
 		return "", errors.New("JsonResponse has no content")
@@ -110,7 +115,7 @@ func (r *StreamedCompletionResponse) GetUsage() (*Usage, error) {
 	if len(r.Resp.Data) > 0 {
 		for _, d := range r.Resp.Data {
 			if len(d.Choices) != 0 {
-				backupLength += len(d.Choices[0].Logprobs.Content)
+				backupLength += len(d.Choices[0].GetLogprobs())
 			}
 			if d.Usage.IsEmpty() {
 				continue
@@ -138,7 +143,7 @@ func (r *StreamedCompletionResponse) GetHash() (string, error) {
 	var builder strings.Builder
 	for _, choice := range r.Resp.Data {
 		for _, c := range choice.Choices {
-			delta := c.Delta.Content
+			delta := c.GetContent()
 			if delta != nil {
 				builder.WriteString(*delta)
 			}
@@ -162,7 +167,7 @@ func (r *StreamedCompletionResponse) GetEnforcedStr() (string, error) {
 			logging.Warn("More than one choice in a streamed inference response, defaulting to first one", types.Validation, "inferenceId", event.ID, "choices", event.Choices)
 		}
 
-		content := event.Choices[0].Delta.Content
+		content := event.Choices[0].GetContent()
 		if content != nil {
 			stringBuilder.WriteString(*content)
 		}
@@ -181,7 +186,7 @@ func (r *JsonCompletionResponse) ExtractLogits() []Logprob {
 	var logits []Logprob
 	// Concatenate all logrpobs
 	for _, c := range r.Resp.Choices {
-		logits = append(logits, c.Logprobs.Content...)
+		logits = append(logits, c.GetLogprobs()...)
 	}
 	return logits
 }
@@ -190,13 +195,17 @@ func (r *StreamedCompletionResponse) ExtractLogits() []Logprob {
 	var logits []Logprob
 	for _, r := range r.Resp.Data {
 		for _, c := range r.Choices {
-			logits = append(logits, c.Logprobs.Content...)
+			logits = append(logits, c.GetLogprobs()...)
 		}
 	}
 	return logits
 }
 
 func NewCompletionResponseFromBytes(bytes []byte) (CompletionResponse, error) {
+	if isEmbeddingResponse(bytes) {
+		return NewEmbeddingResponseFromBytes(bytes)
+	}
+
 	var response Response
 	if err := json.Unmarshal(bytes, &response); err != nil {
 		logging.Error("Failed to unmarshal json response into completionapi.Response", types.Inferences, "responseString", string(bytes), "err", err)
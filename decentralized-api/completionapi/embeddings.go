@@ -0,0 +1,151 @@
+package completionapi
+
+import (
+	"decentralized-api/logging"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const embeddingListObject = "list"
+
+type EmbeddingResponse struct {
+	ID     string          `json:"id"`
+	Object string          `json:"object"`
+	Model  string          `json:"model"`
+	Data   []EmbeddingData `json:"data"`
+	Usage  Usage           `json:"usage"`
+}
+
+type EmbeddingData struct {
+	Index  int    `json:"index"`
+	Object string `json:"object"`
+	// Embedding is either a JSON array of floats or, with encoding_format=base64,
+	// a base64 string of little-endian float32 values.
+	Embedding json.RawMessage `json:"embedding"`
+}
+
+// JsonEmbeddingResponse is the CompletionResponse of the /v1/embeddings endpoint.
+// Embeddings have no generated text and no logprobs, so they are validated by
+// comparing vectors instead, see GetEmbeddings.
+type JsonEmbeddingResponse struct {
+	Bytes []byte
+	Resp  EmbeddingResponse
+}
+
+func isEmbeddingResponse(bytes []byte) bool {
+	var probe struct {
+		Object string `json:"object"`
+	}
+	if err := json.Unmarshal(bytes, &probe); err != nil {
+		return false
+	}
+	return probe.Object == embeddingListObject
+}
+
+func NewEmbeddingResponseFromBytes(bytes []byte) (*JsonEmbeddingResponse, error) {
+	var response EmbeddingResponse
+	if err := json.Unmarshal(bytes, &response); err != nil {
+		logging.Error("Failed to unmarshal json response into completionapi.EmbeddingResponse", types.Inferences, "responseString", string(bytes), "err", err)
+		return nil, err
+	}
+
+	return &JsonEmbeddingResponse{
+		Bytes: bytes,
+		Resp:  response,
+	}, nil
+}
+
+func (r *JsonEmbeddingResponse) GetModel() (string, error) {
+	return r.Resp.Model, nil
+}
+
+func (r *JsonEmbeddingResponse) GetInferenceId() (string, error) {
+	return r.Resp.ID, nil
+}
+
+func (r *JsonEmbeddingResponse) GetUsage() (*Usage, error) {
+	if r.Resp.Usage.IsEmpty() {
+		return nil, errors.New("JsonEmbeddingResponse: no usage found")
+	}
+	return &r.Resp.Usage, nil
+}
+
+func (r *JsonEmbeddingResponse) GetBodyBytes() ([]byte, error) {
+	return r.Bytes, nil
+}
+
+// GetHash hashes the raw embedding payloads in index order.
+func (r *JsonEmbeddingResponse) GetHash() (string, error) {
+	data := r.sortedData()
+	if len(data) == 0 {
+		return "", errors.New("JsonEmbeddingResponse: can't compute hash, no embeddings")
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return computeHash(string(payload))
+}
+
+func (r *JsonEmbeddingResponse) GetEnforcedStr() (string, error) {
+	return "", errors.New("JsonEmbeddingResponse: embeddings have no enforced string")
+}
+
+func (r *JsonEmbeddingResponse) ExtractLogits() []Logprob {
+	return nil
+}
+
+// GetEmbeddings decodes all embedding vectors, ordered by their index.
+func (r *JsonEmbeddingResponse) GetEmbeddings() ([][]float64, error) {
+	data := r.sortedData()
+	embeddings := make([][]float64, len(data))
+	for i, d := range data {
+		embedding, err := decodeEmbedding(d.Embedding)
+		if err != nil {
+			return nil, fmt.Errorf("embedding %d: %w", d.Index, err)
+		}
+		embeddings[i] = embedding
+	}
+	return embeddings, nil
+}
+
+func (r *JsonEmbeddingResponse) sortedData() []EmbeddingData {
+	data := make([]EmbeddingData, len(r.Resp.Data))
+	copy(data, r.Resp.Data)
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Index < data[j].Index
+	})
+	return data
+}
+
+func decodeEmbedding(raw json.RawMessage) ([]float64, error) {
+	var floats []float64
+	if err := json.Unmarshal(raw, &floats); err == nil {
+		return floats, nil
+	}
+
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err != nil {
+		return nil, errors.New("embedding is neither a float array nor a base64 string")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded)%4 != 0 {
+		return nil, fmt.Errorf("base64 embedding has %d bytes, not a multiple of 4", len(decoded))
+	}
+	floats = make([]float64, len(decoded)/4)
+	for i := range floats {
+		bits := binary.LittleEndian.Uint32(decoded[i*4:])
+		floats[i] = float64(math.Float32frombits(bits))
+	}
+	return floats, nil
+}
@@ -1,5 +1,7 @@
 package completionapi
 
+import "sort"
+
 type Response struct {
 	ID                string   `json:"id"`
 	Object            string   `json:"object"`
@@ -11,16 +13,67 @@ type Response struct {
 }
 
 type Choice struct {
-	Index    int      `json:"index"`
-	Message  *Message `json:"message"`
-	Delta    *Delta   `json:"delta"`
+	Index   int      `json:"index"`
+	Message *Message `json:"message"`
+	Delta   *Delta   `json:"delta"`
+	// Text is set instead of Message/Delta by the legacy /v1/completions endpoint
+	Text     *string `json:"text"`
 	Logprobs struct {
 		Content []Logprob `json:"content"`
+		// Legacy /v1/completions logprobs format
+		Tokens        []string             `json:"tokens"`
+		TokenLogprobs []float64            `json:"token_logprobs"`
+		TopLogprobs   []map[string]float64 `json:"top_logprobs"`
 	} `json:"logprobs"`
 	FinishReason string `json:"finish_reason"`
 	StopReason   string `json:"stop_reason"`
 }
 
+// GetContent returns the generated text of the choice, whichever response shape it came in.
+func (c *Choice) GetContent() *string {
+	if c.Message != nil {
+		return &c.Message.Content
+	}
+	if c.Delta != nil && c.Delta.Content != nil {
+		return c.Delta.Content
+	}
+	return c.Text
+}
+
+// GetLogprobs returns the choice logprobs, converting the legacy completions format
+// into the chat one so both can be compared the same way.
+func (c *Choice) GetLogprobs() []Logprob {
+	if len(c.Logprobs.Content) > 0 || len(c.Logprobs.Tokens) == 0 {
+		return c.Logprobs.Content
+	}
+
+	logprobs := make([]Logprob, len(c.Logprobs.Tokens))
+	for i, token := range c.Logprobs.Tokens {
+		logprobs[i].Token = token
+		if i < len(c.Logprobs.TokenLogprobs) {
+			logprobs[i].Logprob = c.Logprobs.TokenLogprobs[i]
+		}
+		if i < len(c.Logprobs.TopLogprobs) {
+			logprobs[i].TopLogprobs = sortedTopLogprobs(c.Logprobs.TopLogprobs[i])
+		}
+	}
+	return logprobs
+}
+
+func sortedTopLogprobs(top map[string]float64) []TopLogprobs {
+	result := make([]TopLogprobs, 0, len(top))
+	for token, logprob := range top {
+		result = append(result, TopLogprobs{Token: token, Logprob: logprob})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Logprob != result[j].Logprob {
+			return result[i].Logprob > result[j].Logprob
+		}
+		return result[i].Token < result[j].Token
+	})
+	return result
+}
+
 type Message struct {
 	Role    string `json:"role"`
 	Content string `json:"content"`
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
 )
 
 type ModifiedRequest struct {
@@ -13,26 +14,70 @@ type ModifiedRequest struct {
 	OriginalTopLogprobsValue *int
 }
 
+const (
+	ChatCompletionsPath = "/v1/chat/completions"
+	CompletionsPath     = "/v1/completions"
+	EmbeddingsPath      = "/v1/embeddings"
+
+	// minTopLogprobs is the number of alternatives validators need per token
+	minTopLogprobs = 5
+)
+
+// PathForKind returns the OpenAI-compatible path an inference kind is served on,
+// both by the public API and by the ML nodes.
+func PathForKind(kind types.InferenceKind) string {
+	switch kind {
+	case types.InferenceKind_TEXT_COMPLETION:
+		return CompletionsPath
+	case types.InferenceKind_EMBEDDING:
+		return EmbeddingsPath
+	default:
+		return ChatCompletionsPath
+	}
+}
+
 func ModifyRequestBody(requestBytes []byte, defaultSeed int32) (*ModifiedRequest, error) {
+	return ModifyRequestBodyForKind(requestBytes, defaultSeed, types.InferenceKind_CHAT_COMPLETION)
+}
+
+// ModifyRequestBodyForKind makes the request reproducible for validators: it pins the seed
+// and max tokens and asks for the logprobs validation compares. Embeddings are deterministic
+// and have neither, so their body is passed through as is.
+func ModifyRequestBodyForKind(requestBytes []byte, defaultSeed int32, kind types.InferenceKind) (*ModifiedRequest, error) {
 	var requestMap map[string]interface{}
 	if err := json.Unmarshal(requestBytes, &requestMap); err != nil {
 		return nil, err
 	}
 
-	originalLogprobsValue := getOriginalLogprobs(requestMap)
-	if originalLogprobsValue == nil || *originalLogprobsValue == false {
-		requestMap["logprobs"] = true
-	}
-
-	originalTopLogprobsValue := getOriginalTopLogprobs(requestMap)
-	if originalTopLogprobsValue == nil || *originalTopLogprobsValue < 5 {
-		requestMap["top_logprobs"] = 5
+	if kind == types.InferenceKind_EMBEDDING {
+		return &ModifiedRequest{NewBody: requestBytes}, nil
 	}
 
+	var originalLogprobsValue *bool
+	var originalTopLogprobsValue *int
 	maxTokens := getMaxTokens(requestMap)
+	if kind == types.InferenceKind_TEXT_COMPLETION {
+		// Legacy completions take the number of top logprobs in "logprobs" and have no max_completion_tokens
+		originalTopLogprobsValue = getOriginalTopLogprobsCount(requestMap["logprobs"])
+		if originalTopLogprobsValue == nil || *originalTopLogprobsValue < minTopLogprobs {
+			requestMap["logprobs"] = minTopLogprobs
+		}
+		requestMap["max_tokens"] = maxTokens
+	} else {
+		originalLogprobsValue = getOriginalLogprobs(requestMap)
+		if originalLogprobsValue == nil || *originalLogprobsValue == false {
+			requestMap["logprobs"] = true
+		}
+
+		originalTopLogprobsValue = getOriginalTopLogprobs(requestMap)
+		if originalTopLogprobsValue == nil || *originalTopLogprobsValue < minTopLogprobs {
+			requestMap["top_logprobs"] = minTopLogprobs
+		}
+
+		requestMap["max_tokens"] = maxTokens
+		requestMap["max_completion_tokens"] = maxTokens
+	}
 
-	requestMap["max_tokens"] = maxTokens
-	requestMap["max_completion_tokens"] = maxTokens
 	if _, ok := requestMap["seed"]; !ok {
 		requestMap["seed"] = defaultSeed
 	}
@@ -125,3 +170,11 @@ func getOriginalTopLogprobs(requestMap map[string]interface{}) *int {
 	log.Printf("Original request top_logprobs = %v", topLogprobsValue)
 	return nil
 }
+
+func getOriginalTopLogprobsCount(logprobsValue interface{}) *int {
+	if logprobsFloat, ok := logprobsValue.(float64); ok {
+		count := int(logprobsFloat)
+		return &count
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package completionapi
 import (
 	"encoding/json"
 	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/require"
 	"log"
 	"testing"
@@ -152,3 +153,30 @@ func TestMaxTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestModifyRequestBodyForTextCompletion(t *testing.T) {
+	r, err := ModifyRequestBodyForKind([]byte(`{"model": "Qwen/Qwen2.5-7B-Instruct", "prompt": "Hi!", "logprobs": 2, "max_tokens": 50}`), 7, types.InferenceKind_TEXT_COMPLETION)
+	require.NoError(t, err)
+	require.Equal(t, 2, *r.OriginalTopLogprobsValue)
+
+	var requestMap map[string]interface{}
+	require.NoError(t, json.Unmarshal(r.NewBody, &requestMap))
+	require.Equal(t, float64(5), requestMap["logprobs"])
+	require.Equal(t, float64(50), requestMap["max_tokens"])
+	require.Equal(t, float64(7), requestMap["seed"])
+	require.NotContains(t, requestMap, "top_logprobs")
+	require.NotContains(t, requestMap, "max_completion_tokens")
+}
+
+func TestModifyRequestBodyForEmbedding(t *testing.T) {
+	body := []byte(`{"model": "Qwen/Qwen3-Embedding-8B", "input": ["a", "b"]}`)
+	r, err := ModifyRequestBodyForKind(body, 7, types.InferenceKind_EMBEDDING)
+	require.NoError(t, err)
+	require.Equal(t, body, r.NewBody)
+}
+
+func TestPathForKind(t *testing.T) {
+	require.Equal(t, "/v1/chat/completions", PathForKind(types.InferenceKind_CHAT_COMPLETION))
+	require.Equal(t, "/v1/completions", PathForKind(types.InferenceKind_TEXT_COMPLETION))
+	require.Equal(t, "/v1/embeddings", PathForKind(types.InferenceKind_EMBEDDING))
+}
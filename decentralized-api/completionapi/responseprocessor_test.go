@@ -130,3 +130,52 @@ func loadJson(path string) ([]byte, error) {
 
 	return data, nil
 }
+
+const textCompletionResponse = `{"id":"cmpl-1","object":"text_completion","created":1726472629,"model":"Qwen/Qwen2.5-7B-Instruct","choices":[{"index":0,"text":" 1959","logprobs":{"tokens":[" 1","959"],"token_logprobs":[-0.1,-0.2],"top_logprobs":[{" 1":-0.1," 2":-3.0},{"959":-0.2,"960":-2.5}]},"finish_reason":"stop"}],"usage":{"prompt_tokens":7,"completion_tokens":2}}`
+
+func TestTextCompletionResponse(t *testing.T) {
+	processor := NewExecutorResponseProcessor("dummy-id")
+	_, err := processor.ProcessJsonResponse([]byte(textCompletionResponse))
+	require.NoError(t, err)
+
+	response, err := processor.GetResponse()
+	require.NoError(t, err)
+	enforcedStr, err := response.GetEnforcedStr()
+	require.NoError(t, err)
+	require.Equal(t, " 1959", enforcedStr)
+	_, err = response.GetHash()
+	require.NoError(t, err)
+
+	logits := response.ExtractLogits()
+	require.Len(t, logits, 2)
+	require.Equal(t, "959", logits[1].Token)
+	require.Equal(t, -0.2, logits[1].Logprob)
+	require.Equal(t, []TopLogprobs{{Token: "959", Logprob: -0.2}, {Token: "960", Logprob: -2.5}}, logits[1].TopLogprobs)
+}
+
+// "AACAPwAAAEA=" is the base64 of little-endian float32 [1.0, 2.0]
+const embeddingResponse = `{"id":"embd-1","object":"list","model":"Qwen/Qwen3-Embedding-8B","data":[{"index":1,"object":"embedding","embedding":"AACAPwAAAEA="},{"index":0,"object":"embedding","embedding":[0.5,0.25]}],"usage":{"prompt_tokens":4,"total_tokens":4}}`
+
+func TestEmbeddingResponse(t *testing.T) {
+	processor := NewExecutorResponseProcessor("dummy-id")
+	_, err := processor.ProcessJsonResponse([]byte(embeddingResponse))
+	require.NoError(t, err)
+
+	response, err := processor.GetResponse()
+	require.NoError(t, err)
+	embedding, ok := response.(*JsonEmbeddingResponse)
+	require.True(t, ok)
+
+	id, err := embedding.GetInferenceId()
+	require.NoError(t, err)
+	require.Equal(t, "dummy-id", id)
+	usage, err := embedding.GetUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), usage.PromptTokens)
+	_, err = embedding.GetHash()
+	require.NoError(t, err)
+
+	vectors, err := embedding.GetEmbeddings()
+	require.NoError(t, err)
+	require.Equal(t, [][]float64{{0.5, 0.25}, {1, 2}}, vectors)
+}
@@ -0,0 +1,94 @@
+package completionapi
+
+import (
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+)
+
+// BudgetExhaustedFinishReason is reported when a stream is cut because the escrow only pays for
+// the tokens sent so far. Clients handle it like a stop at max_tokens.
+const BudgetExhaustedFinishReason = "length"
+
+// StreamBudget counts the completion tokens of a streamed response against the number of tokens
+// the escrow of the inference pays for. The limit is usually learned while the response is
+// already streaming, when the transfer agent's MsgStartInference lands, so it is set concurrently.
+type StreamBudget struct {
+	limit atomic.Int64 // completion tokens paid for, negative while unknown
+	used  uint64
+	last  Response // last chunk, the closing chunk repeats its id, object and model
+}
+
+func NewStreamBudget() *StreamBudget {
+	budget := &StreamBudget{}
+	budget.limit.Store(-1)
+	return budget
+}
+
+// SetLimit sets the number of completion tokens the escrow pays for
+func (b *StreamBudget) SetLimit(tokens uint64) {
+	b.limit.Store(int64(tokens))
+}
+
+// Used returns the completion tokens counted so far
+func (b *StreamBudget) Used() uint64 {
+	return b.used
+}
+
+// Count adds the completion tokens of an SSE line and reports whether the budget is now exhausted
+func (b *StreamBudget) Count(line string) bool {
+	if chunk, ok := parseChunk(line); ok {
+		b.used += countCompletionTokens(chunk)
+		b.last = chunk
+	}
+	limit := b.limit.Load()
+	return limit >= 0 && b.used >= uint64(limit)
+}
+
+func parseChunk(line string) (Response, bool) {
+	var chunk Response
+	if !strings.HasPrefix(line, DataPrefix) {
+		return chunk, false
+	}
+	trimmed := strings.TrimSpace(strings.TrimPrefix(line, DataPrefix))
+	if strings.HasPrefix(trimmed, "[DONE]") {
+		return chunk, false
+	}
+	if err := json.Unmarshal([]byte(trimmed), &chunk); err != nil {
+		return chunk, false
+	}
+	return chunk, true
+}
+
+// countCompletionTokens counts tokens by their logprobs, which validation makes every executor
+// return, and falls back to one token per chunk with content
+func countCompletionTokens(chunk Response) uint64 {
+	var tokens uint64
+	for _, choice := range chunk.Choices {
+		if logprobs := choice.GetLogprobs(); len(logprobs) > 0 {
+			tokens += uint64(len(logprobs))
+		} else if content := choice.GetContent(); content != nil && *content != "" {
+			tokens++
+		}
+	}
+	return tokens
+}
+
+// ExhaustedLines closes a stream cut at the budget: a last chunk with the finish reason and the
+// usage of the truncated response, then [DONE]. Prompt tokens are left to the executor to count.
+func (b *StreamBudget) ExhaustedLines() ([]string, error) {
+	chunk := map[string]interface{}{
+		"id":      b.last.ID,
+		"object":  b.last.Object,
+		"created": b.last.Created,
+		"model":   b.last.Model,
+		"choices": []map[string]interface{}{{"index": 0, "delta": map[string]interface{}{}, "finish_reason": BudgetExhaustedFinishReason}},
+		"usage":   Usage{CompletionTokens: b.used},
+	}
+	chunkBytes, err := json.Marshal(chunk)
+	if err != nil {
+		return nil, err
+	}
+	// The cut may come right after a data line, the blank line ends its event
+	return []string{"", DataPrefix + string(chunkBytes), "", DataPrefix + "[DONE]", ""}, nil
+}
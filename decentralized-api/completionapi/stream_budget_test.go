@@ -0,0 +1,47 @@
+package completionapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const budgetChunk = `data: {"id":"cmpl-1","object":"chat.completion.chunk","created":1726472629,"model":"Qwen/Qwen2.5-7B-Instruct","choices":[{"index":0,"delta":{"content":"98"},"logprobs":{"content":[{"token":"9","logprob":0.0},{"token":"8","logprob":-0.5}]},"finish_reason":null}]}`
+
+func TestStreamBudget_CountsTokensByLogprobs(t *testing.T) {
+	budget := NewStreamBudget()
+	require.False(t, budget.Count(budgetChunk))
+	require.False(t, budget.Count(""))
+	require.False(t, budget.Count(DataPrefix+"[DONE]"))
+	// Without logprobs a chunk with content counts as one token
+	require.False(t, budget.Count(`data: {"choices":[{"index":0,"delta":{"content":"x"}}]}`))
+	require.Equal(t, uint64(3), budget.Used())
+
+	// Unknown limit never cuts the stream
+	for i := 0; i < 100; i++ {
+		require.False(t, budget.Count(budgetChunk))
+	}
+}
+
+func TestStreamBudget_CutsAtLimit(t *testing.T) {
+	budget := NewStreamBudget()
+	budget.SetLimit(5)
+	require.False(t, budget.Count(budgetChunk))
+	require.False(t, budget.Count(budgetChunk))
+	require.True(t, budget.Count(budgetChunk))
+
+	lines, err := budget.ExhaustedLines()
+	require.NoError(t, err)
+	require.Equal(t, DataPrefix+"[DONE]", lines[len(lines)-2])
+
+	response, err := NewCompletionResponseFromLines(append([]string{budgetChunk, "", budgetChunk, "", budgetChunk}, lines...))
+	require.NoError(t, err)
+	usage, err := response.GetUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), usage.CompletionTokens)
+	model, err := response.GetModel()
+	require.NoError(t, err)
+	require.Equal(t, "Qwen/Qwen2.5-7B-Instruct", model)
+	require.True(t, strings.Contains(lines[1], `"finish_reason":"`+BudgetExhaustedFinishReason+`"`))
+}
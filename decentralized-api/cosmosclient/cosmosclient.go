@@ -147,7 +147,7 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 		return nil, err
 	}
 
-	mn, err := tx_manager.StartTxManager(ctx, &cosmoclient, apiAccount, time.Second*60, natsConn, accAddress)
+	mn, err := tx_manager.StartTxManager(ctx, &cosmoclient, apiAccount, time.Second*60, natsConn, accAddress, tx_manager.NewBatchConfig(config.GetTxBatchingConfig()))
 	if err != nil {
 		return nil, err
 	}
@@ -166,18 +166,23 @@ type CosmosMessageClient interface {
 	EncryptBytes(plaintext []byte) ([]byte, error)
 	StartInference(transaction *inference.MsgStartInference) error
 	FinishInference(transaction *inference.MsgFinishInference) error
+	AbandonInference(transaction *inference.MsgAbandonInference) error
 	ReportValidation(transaction *inference.MsgValidation) error
 	SubmitNewParticipant(transaction *inference.MsgSubmitNewParticipant) error
+	UpdateParticipantRegion(transaction *inference.MsgUpdateParticipantRegion) error
 	SubmitNewUnfundedParticipant(transaction *inference.MsgSubmitNewUnfundedParticipant) error
 	SubmitPocBatch(transaction *inference.MsgSubmitPocBatch) error
 	SubmitPoCValidation(transaction *inference.MsgSubmitPocValidation) error
 	SubmitSeed(transaction *inference.MsgSubmitSeed) error
 	ClaimRewards(transaction *inference.MsgClaimRewards) error
+	BatchClaimRewards(transaction *inference.MsgBatchClaimRewards) error
 	CreateTrainingTask(transaction *inference.MsgCreateTrainingTask) (*inference.MsgCreateTrainingTaskResponse, error)
 	ClaimTrainingTaskForAssignment(transaction *inference.MsgClaimTrainingTaskForAssignment) (*inference.MsgClaimTrainingTaskForAssignmentResponse, error)
 	AssignTrainingTask(transaction *inference.MsgAssignTrainingTask) (*inference.MsgAssignTrainingTaskResponse, error)
 	SubmitUnitOfComputePriceProposal(transaction *inference.MsgSubmitUnitOfComputePriceProposal) error
 	BridgeExchange(transaction *types.MsgBridgeExchange) error
+	AttestBridgeBlock(transaction *types.MsgAttestBridgeBlock) error
+	BridgeDepositProof(transaction *types.MsgBridgeDepositProof) error
 	NewInferenceQueryClient() types.QueryClient
 	NewCometQueryClient() cmtservice.ServiceClient
 	BankBalances(ctx context.Context, address string) ([]sdk.Coin, error)
@@ -292,6 +297,12 @@ func (icc *InferenceCosmosClient) FinishInference(transaction *inference.MsgFini
 	return err
 }
 
+func (icc *InferenceCosmosClient) AbandonInference(transaction *inference.MsgAbandonInference) error {
+	transaction.Creator = icc.Address
+	_, err := icc.manager.SendTransactionAsyncWithRetry(transaction)
+	return err
+}
+
 func (icc *InferenceCosmosClient) ReportValidation(transaction *inference.MsgValidation) error {
 	transaction.Creator = icc.Address
 	logging.Info("Reporting validation", types.Validation, "value", transaction.Value, "type", fmt.Sprintf("%T", transaction), "creator", transaction.Creator)
@@ -305,6 +316,12 @@ func (icc *InferenceCosmosClient) SubmitNewParticipant(transaction *inference.Ms
 	return err
 }
 
+func (icc *InferenceCosmosClient) UpdateParticipantRegion(transaction *inference.MsgUpdateParticipantRegion) error {
+	transaction.Creator = icc.Address
+	_, err := icc.manager.SendTransactionAsyncNoRetry(transaction)
+	return err
+}
+
 func (icc *InferenceCosmosClient) SubmitNewUnfundedParticipant(transaction *inference.MsgSubmitNewUnfundedParticipant) error {
 	transaction.Creator = icc.Address
 	_, err := icc.manager.SendTransactionAsyncNoRetry(transaction)
@@ -317,6 +334,12 @@ func (icc *InferenceCosmosClient) ClaimRewards(transaction *inference.MsgClaimRe
 	return err
 }
 
+func (icc *InferenceCosmosClient) BatchClaimRewards(transaction *inference.MsgBatchClaimRewards) error {
+	transaction.Creator = icc.Address
+	_, err := icc.manager.SendTransactionAsyncNoRetry(transaction)
+	return err
+}
+
 func (icc *InferenceCosmosClient) BankBalances(ctx context.Context, address string) ([]sdk.Coin, error) {
 	return icc.manager.BankBalances(ctx, address)
 }
@@ -387,6 +410,18 @@ func (icc *InferenceCosmosClient) BridgeExchange(transaction *types.MsgBridgeExc
 	return err
 }
 
+func (icc *InferenceCosmosClient) AttestBridgeBlock(transaction *types.MsgAttestBridgeBlock) error {
+	transaction.Validator = icc.Address
+	_, err := icc.manager.SendTransactionAsyncNoRetry(transaction)
+	return err
+}
+
+func (icc *InferenceCosmosClient) BridgeDepositProof(transaction *types.MsgBridgeDepositProof) error {
+	transaction.Creator = icc.Address
+	_, err := icc.manager.SendTransactionAsyncNoRetry(transaction)
+	return err
+}
+
 func (icc *InferenceCosmosClient) SendTransactionAsyncWithRetry(msg sdk.Msg) (*sdk.TxResponse, error) {
 	return icc.manager.SendTransactionAsyncWithRetry(msg)
 }
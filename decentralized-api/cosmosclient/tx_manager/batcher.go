@@ -0,0 +1,141 @@
+package tx_manager
+
+import (
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"decentralized-api/metrics"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	defaultBatchMaxMessages = 20
+	defaultBatchWindow      = 500 * time.Millisecond
+)
+
+// defaultBatchMessageTypes are the messages every busy participant sends once or more per inference
+var defaultBatchMessageTypes = []string{
+	sdk.MsgTypeURL(&types.MsgStartInference{}),
+	sdk.MsgTypeURL(&types.MsgFinishInference{}),
+	sdk.MsgTypeURL(&types.MsgValidation{}),
+	sdk.MsgTypeURL(&types.MsgAbandonInference{}),
+}
+
+// orderedAfter lists messages that must go through the same queue as the message they follow.
+// An abandon sent on its own could overtake the batched start of the same inference.
+var orderedAfter = map[string]string{
+	sdk.MsgTypeURL(&types.MsgAbandonInference{}): sdk.MsgTypeURL(&types.MsgStartInference{}),
+}
+
+type BatchConfig struct {
+	MaxMessages  int
+	Window       time.Duration
+	MessageTypes map[string]bool
+}
+
+// NewBatchConfig returns nil when batching is disabled, which keeps one message per transaction.
+func NewBatchConfig(config apiconfig.TxBatchingConfig) *BatchConfig {
+	if !config.Enabled {
+		return nil
+	}
+	result := &BatchConfig{
+		MaxMessages:  config.MaxMessages,
+		Window:       time.Duration(config.WindowMillis) * time.Millisecond,
+		MessageTypes: make(map[string]bool),
+	}
+	if result.MaxMessages <= 0 {
+		result.MaxMessages = defaultBatchMaxMessages
+	}
+	if result.Window <= 0 {
+		result.Window = defaultBatchWindow
+	}
+	messageTypes := config.MessageTypes
+	if len(messageTypes) == 0 {
+		messageTypes = defaultBatchMessageTypes
+	}
+	for _, messageType := range messageTypes {
+		result.MessageTypes[messageType] = true
+	}
+	for messageType, after := range orderedAfter {
+		if result.MessageTypes[after] {
+			result.MessageTypes[messageType] = true
+		}
+	}
+	return result
+}
+
+func (c *BatchConfig) accepts(msg sdk.Msg) bool {
+	return c != nil && c.MaxMessages > 1 && c.MessageTypes[sdk.MsgTypeURL(msg)]
+}
+
+// queuedTx is a message taken from the send stream that hasn't been acked yet
+type queuedTx struct {
+	msg   *nats.Msg
+	tx    txToSend
+	rawTx sdk.Msg
+}
+
+// runBatcher collects queued messages until the batch is full or the window since its first
+// message has passed, then sends them as one transaction. Messages that are never flushed
+// stay unacked and are redelivered by NATS.
+func (m *manager) runBatcher() {
+	var batch []queuedTx
+	var flush <-chan time.Time
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case queued := <-m.batchQueue:
+			batch = append(batch, queued)
+			if len(batch) == 1 {
+				flush = time.After(m.batchConfig.Window)
+			}
+			if len(batch) < m.batchConfig.MaxMessages {
+				continue
+			}
+		case <-flush:
+		}
+		m.sendBatch(batch)
+		batch = nil
+		flush = nil
+	}
+}
+
+// sendBatch signs the batch as a single transaction. If the batch can't be broadcast, every
+// message falls back to being sent on its own, so one bad message can't hold back the rest.
+func (m *manager) sendBatch(batch []queuedTx) {
+	if len(batch) == 1 {
+		m.sendQueuedTx(batch[0])
+		return
+	}
+
+	id := uuid.New().String()
+	msgs := make([]sdk.Msg, len(batch))
+	for i, queued := range batch {
+		msgs[i] = queued.rawTx
+	}
+
+	logging.Debug("start broadcast batch async", types.Messages, "batch_id", id, "size", len(batch))
+	resp, timeout, err := m.broadcast(id, msgs...)
+	if err != nil || resp.Code != 0 {
+		logging.Warn("Batch broadcast failed, sending messages individually", types.Messages, "batch_id", id, "size", len(batch), "err", err)
+		metrics.TxRetries.WithLabelValues("batch_split").Add(float64(len(batch)))
+		for _, queued := range batch {
+			m.sendQueuedTx(queued)
+		}
+		return
+	}
+
+	metrics.TxBatchSize.Observe(float64(len(batch)))
+	for _, queued := range batch {
+		queued.tx.TxInfo.TxHash = resp.TxHash
+		queued.tx.TxInfo.Timeout = timeout
+		queued.tx.TxInfo.Batched = true
+		queued.tx.Sent = true
+		m.sendQueuedTx(queued)
+	}
+}
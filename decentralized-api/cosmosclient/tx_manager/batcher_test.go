@@ -0,0 +1,252 @@
+package tx_manager
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/internal/nats/server"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ignite/cli/v28/ignite/pkg/cosmosclient/mocks"
+	natssrv "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/productscience/inference/api/inference/inference"
+	testutil "github.com/productscience/inference/testutil/cosmoclient"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatchConfig(t *testing.T) {
+	assert.Nil(t, NewBatchConfig(apiconfig.TxBatchingConfig{}))
+
+	config := NewBatchConfig(apiconfig.TxBatchingConfig{Enabled: true})
+	assert.Equal(t, defaultBatchMaxMessages, config.MaxMessages)
+	assert.Equal(t, defaultBatchWindow, config.Window)
+	assert.True(t, config.accepts(&types.MsgFinishInference{}))
+	assert.True(t, config.accepts(&types.MsgValidation{}))
+	// Messages built from the pulsar api types share the type URL
+	assert.True(t, config.accepts(&inference.MsgFinishInference{}))
+	assert.False(t, config.accepts(&types.MsgSubmitNewParticipant{}))
+
+	config = NewBatchConfig(apiconfig.TxBatchingConfig{
+		Enabled:      true,
+		MaxMessages:  5,
+		WindowMillis: 100,
+		MessageTypes: []string{sdk.MsgTypeURL(&types.MsgSubmitNewParticipant{})},
+	})
+	assert.Equal(t, 5, config.MaxMessages)
+	assert.Equal(t, 100*time.Millisecond, config.Window)
+	assert.True(t, config.accepts(&types.MsgSubmitNewParticipant{}))
+	assert.False(t, config.accepts(&types.MsgFinishInference{}))
+	assert.False(t, config.accepts(&types.MsgAbandonInference{}))
+
+	// An abandon is queued behind the start of the same inference whenever starts are batched
+	config = NewBatchConfig(apiconfig.TxBatchingConfig{
+		Enabled:      true,
+		MessageTypes: []string{sdk.MsgTypeURL(&types.MsgStartInference{})},
+	})
+	assert.True(t, config.accepts(&types.MsgAbandonInference{}))
+}
+
+func TestBatchConfigAcceptsNothingWhenDisabled(t *testing.T) {
+	var config *BatchConfig
+	assert.False(t, config.accepts(&types.MsgFinishInference{}))
+
+	config = NewBatchConfig(apiconfig.TxBatchingConfig{Enabled: true, MaxMessages: 1})
+	assert.False(t, config.accepts(&types.MsgFinishInference{}))
+}
+
+// fakeBroadcaster records every broadcast transaction instead of signing it
+type fakeBroadcaster struct {
+	mu    sync.Mutex
+	calls [][]sdk.Msg
+	fail  func(msgs []sdk.Msg) bool
+}
+
+func (f *fakeBroadcaster) broadcast(id string, rawTxs ...sdk.Msg) (*sdk.TxResponse, time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, rawTxs)
+	if f.fail != nil && f.fail(rawTxs) {
+		return nil, time.Time{}, errors.New("broadcast failed")
+	}
+	return &sdk.TxResponse{TxHash: fmt.Sprintf("%X", len(f.calls))}, time.Now().Add(time.Minute), nil
+}
+
+func (f *fakeBroadcaster) batchSizes() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sizes := make([]int, len(f.calls))
+	for i, call := range f.calls {
+		sizes[i] = len(call)
+	}
+	return sizes
+}
+
+func (f *fakeBroadcaster) call(i int) []sdk.Msg {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[i]
+}
+
+func newTestManager(t *testing.T, batchConfig *BatchConfig, rpc *mocks.RPCClient) (*manager, *fakeBroadcaster) {
+	ns, err := natssrv.NewServer(&natssrv.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	go ns.Start()
+	require.True(t, ns.ReadyForConnections(5*time.Second))
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	js, err := nc.JetStream()
+	require.NoError(t, err)
+	for _, stream := range []string{server.TxsToSendStream, server.TxsToObserveStream} {
+		_, err = js.AddStream(&nats.StreamConfig{Name: stream, Subjects: []string{stream}, Storage: nats.MemoryStorage})
+		require.NoError(t, err)
+	}
+
+	client := testutil.NewMockClient(t, rpc, "cosmos", "cosmosaccount",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "testpass")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	broadcaster := &fakeBroadcaster{}
+	m := &manager{
+		ctx:            ctx,
+		client:         &client,
+		natsConnection: nc,
+		natsJetStream:  js,
+		batchConfig:    batchConfig,
+		broadcast:      broadcaster.broadcast,
+	}
+	if batchConfig != nil {
+		m.batchQueue = make(chan queuedTx, batchConfig.MaxMessages)
+		go m.runBatcher()
+	}
+	return m, broadcaster
+}
+
+func finishMsg(id string) *types.MsgFinishInference {
+	return &types.MsgFinishInference{Creator: "creator", InferenceId: id}
+}
+
+func queueForBatch(m *manager, msgs ...sdk.Msg) {
+	for i, msg := range msgs {
+		m.batchQueue <- queuedTx{
+			msg:   &nats.Msg{},
+			tx:    txToSend{TxInfo: txInfo{Id: fmt.Sprintf("tx-%d", i)}},
+			rawTx: msg,
+		}
+	}
+}
+
+func observedTxs(t *testing.T, m *manager, count int) []txInfo {
+	sub, err := m.natsJetStream.SubscribeSync(server.TxsToObserveStream)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	observed := make([]txInfo, count)
+	for i := range observed {
+		msg, err := sub.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(msg.Data, &observed[i]))
+	}
+	return observed
+}
+
+func TestBatcherFlushesAfterWindow(t *testing.T) {
+	m, broadcaster := newTestManager(t, &BatchConfig{MaxMessages: 10, Window: 50 * time.Millisecond}, mocks.NewRPCClient(t))
+
+	queueForBatch(m, finishMsg("1"), finishMsg("2"))
+	assert.Eventually(t, func() bool { return len(broadcaster.batchSizes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int{2}, broadcaster.batchSizes())
+
+	for _, observed := range observedTxs(t, m, 2) {
+		assert.True(t, observed.Batched)
+		assert.Equal(t, "1", observed.TxHash)
+	}
+}
+
+func TestBatcherFlushesWhenFull(t *testing.T) {
+	m, broadcaster := newTestManager(t, &BatchConfig{MaxMessages: 2, Window: time.Hour}, mocks.NewRPCClient(t))
+
+	queueForBatch(m, finishMsg("1"), finishMsg("2"), finishMsg("3"))
+	assert.Eventually(t, func() bool { return len(broadcaster.batchSizes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	// The third message waits for the window, which is an hour away
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, []int{2}, broadcaster.batchSizes())
+}
+
+func TestSendBatchSplitsAfterFailedBroadcast(t *testing.T) {
+	m, broadcaster := newTestManager(t, nil, mocks.NewRPCClient(t))
+	broadcaster.fail = func(msgs []sdk.Msg) bool { return len(msgs) > 1 }
+
+	batch := []queuedTx{
+		{msg: &nats.Msg{}, tx: txToSend{TxInfo: txInfo{Id: "tx-1"}}, rawTx: finishMsg("1")},
+		{msg: &nats.Msg{}, tx: txToSend{TxInfo: txInfo{Id: "tx-2"}}, rawTx: finishMsg("2")},
+	}
+	m.sendBatch(batch)
+
+	assert.Equal(t, []int{2, 1, 1}, broadcaster.batchSizes())
+	for _, observed := range observedTxs(t, m, 2) {
+		assert.False(t, observed.Batched)
+	}
+}
+
+func TestFailedBatchIsResentAlone(t *testing.T) {
+	rpc := mocks.NewRPCClient(t)
+	rpc.EXPECT().Tx(mock.Anything, mock.Anything, false).Return(&ctypes.ResultTx{TxResult: abci.ExecTxResult{Code: 11}}, nil)
+	m, broadcaster := newTestManager(t, &BatchConfig{
+		MaxMessages:  10,
+		Window:       time.Hour,
+		MessageTypes: map[string]bool{sdk.MsgTypeURL(&types.MsgFinishInference{}): true},
+	}, rpc)
+	require.NoError(t, m.sendTxs())
+	require.NoError(t, m.observeTxs())
+
+	bz, err := m.client.Context().Codec.MarshalInterfaceJSON(finishMsg("1"))
+	require.NoError(t, err)
+	b, err := json.Marshal(&txInfo{Id: "tx-1", RawTx: bz, TxHash: "AB", Timeout: time.Now().Add(time.Minute), Batched: true})
+	require.NoError(t, err)
+	_, err = m.natsJetStream.Publish(server.TxsToObserveStream, b)
+	require.NoError(t, err)
+
+	// Batched again, the message would wait an hour for the window
+	assert.Eventually(t, func() bool { return len(broadcaster.batchSizes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int{1}, broadcaster.batchSizes())
+}
+
+func TestAbandonIsBatchedBehindStart(t *testing.T) {
+	m, broadcaster := newTestManager(t, NewBatchConfig(apiconfig.TxBatchingConfig{
+		Enabled:      true,
+		MaxMessages:  2,
+		WindowMillis: int64(time.Hour / time.Millisecond),
+		MessageTypes: []string{sdk.MsgTypeURL(&types.MsgStartInference{})},
+	}), mocks.NewRPCClient(t))
+	require.NoError(t, m.sendTxs())
+
+	_, err := m.SendTransactionAsyncWithRetry(&types.MsgStartInference{Creator: "creator", InferenceId: "1"})
+	require.NoError(t, err)
+	_, err = m.SendTransactionAsyncWithRetry(&types.MsgAbandonInference{Creator: "creator", InferenceId: "1"})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool { return len(broadcaster.batchSizes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	batch := broadcaster.call(0)
+	require.Len(t, batch, 2)
+	assert.IsType(t, &types.MsgStartInference{}, batch[0])
+	assert.IsType(t, &types.MsgAbandonInference{}, batch[1])
+}
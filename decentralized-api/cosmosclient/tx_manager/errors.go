@@ -16,6 +16,7 @@ var (
 
 	ErrTxFailedToBroadcastAndPutOnRetry = errors.New("failed to broadcast and put on retry")
 	ErrTxNotFound                       = errors.New("tx not found")
+	ErrTxFailedOnChain                  = errors.New("tx failed on-chain")
 )
 
 func isTxErrorCritical(err error) bool {
@@ -0,0 +1,46 @@
+package tx_manager
+
+import (
+	"decentralized-api/internal/nats/server"
+	"decentralized-api/logging"
+
+	"github.com/nats-io/nats.go"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var queueDepthDesc = prometheus.NewDesc(
+	"dapi_tx_manager_queue_depth",
+	"Transactions waiting in the tx manager's JetStream queues.",
+	[]string{"queue"}, nil,
+)
+
+// queueCollector reads the depth of the send and observe queues at scrape time.
+type queueCollector struct {
+	js nats.JetStreamContext
+}
+
+func newQueueCollector(js nats.JetStreamContext) prometheus.Collector {
+	return queueCollector{js: js}
+}
+
+func (c queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+}
+
+func (c queueCollector) Collect(ch chan<- prometheus.Metric) {
+	// Streams keep acknowledged messages, so the depth comes from each durable consumer
+	consumers := []struct{ stream, consumer string }{
+		{server.TxsToSendStream, txSenderConsumer},
+		{server.TxsToObserveStream, txObserverConsumer},
+	}
+	for _, queue := range consumers {
+		info, err := c.js.ConsumerInfo(queue.stream, queue.consumer)
+		if err != nil {
+			logging.Warn("Failed to get tx queue consumer info", types.Messages, "stream", queue.stream, "error", err)
+			continue
+		}
+		depth := info.NumPending + uint64(info.NumAckPending)
+		ch <- prometheus.MustNewConstMetric(queueDepthDesc, prometheus.GaugeValue, float64(depth), queue.stream)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/internal/nats/server"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -66,15 +67,22 @@ type manager struct {
 	defaultTimeout   time.Duration
 	natsConnection   *nats.Conn
 	natsJetStream    nats.JetStreamContext
+	batchConfig      *BatchConfig
+	batchQueue       chan queuedTx
+	broadcast        broadcastFunc
 }
 
+// broadcastFunc signs the messages as a single transaction and broadcasts it
+type broadcastFunc func(id string, rawTxs ...sdk.Msg) (*sdk.TxResponse, time.Time, error)
+
 func StartTxManager(
 	ctx context.Context,
 	client *cosmosclient.Client,
 	account *apiconfig.ApiAccount,
 	defaultTimeout time.Duration,
 	natsConnection *nats.Conn,
-	address string) (*manager, error) {
+	address string,
+	batchConfig *BatchConfig) (*manager, error) {
 	js, err := natsConnection.JetStream()
 	if err != nil {
 		return nil, err
@@ -99,6 +107,13 @@ func StartTxManager(
 		defaultTimeout:   defaultTimeout,
 		natsConnection:   natsConnection,
 		natsJetStream:    js,
+		batchConfig:      batchConfig,
+	}
+	m.broadcast = m.broadcastMessages
+
+	if batchConfig != nil {
+		m.batchQueue = make(chan queuedTx, batchConfig.MaxMessages)
+		go m.runBatcher()
 	}
 
 	if err := m.sendTxs(); err != nil {
@@ -109,21 +124,16 @@ func StartTxManager(
 		return nil, err
 	}
 
-	return &manager{
-		ctx:              ctx,
-		client:           client,
-		address:          address,
-		apiAccount:       account,
-		accountRetriever: authtypes.AccountRetriever{},
-		defaultTimeout:   defaultTimeout,
-		natsConnection:   natsConnection,
-		natsJetStream:    js,
-	}, nil
+	metrics.Register(newQueueCollector(js))
+
+	return m, nil
 }
 
 type txToSend struct {
 	TxInfo txInfo
 	Sent   bool
+	// Single keeps the message out of batches, after a batch it was part of failed on-chain
+	Single bool
 }
 
 type txInfo struct {
@@ -131,6 +141,8 @@ type txInfo struct {
 	RawTx   []byte
 	TxHash  string
 	Timeout time.Time
+	// Batched is set when TxHash belongs to a transaction shared with other messages
+	Batched bool
 }
 
 func (m *manager) GetApiAccount() apiconfig.ApiAccount {
@@ -141,8 +153,19 @@ func (m *manager) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 	return m.client.Status(ctx)
 }
 
+// SendTransactionAsyncWithRetry broadcasts the message and keeps retrying it until it lands.
+// Messages that may be batched are only queued, so the response is nil for them.
 func (m *manager) SendTransactionAsyncWithRetry(rawTx sdk.Msg) (*sdk.TxResponse, error) {
 	id := uuid.New().String()
+	if m.batchConfig.accepts(rawTx) {
+		logging.Debug("SendTransactionAsyncWithRetry: queueing tx for batching", types.Messages, "tx_id", id)
+		if err := m.putOnRetry(id, "", time.Time{}, rawTx, false); err != nil {
+			logging.Error("tx failed to put in queue", types.Messages, "tx_id", id, "err", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
 	logging.Debug("SendTransactionAsyncWithRetry: sending tx", types.Messages, "tx_id", id)
 	resp, timeout, broadcastErr := m.broadcastMessage(id, rawTx)
 	if broadcastErr != nil {
@@ -151,6 +174,7 @@ func (m *manager) SendTransactionAsyncWithRetry(rawTx sdk.Msg) (*sdk.TxResponse,
 			return nil, broadcastErr
 		}
 
+		metrics.TxRetries.WithLabelValues("broadcast_failed").Inc()
 		err := m.putOnRetry(id, "", timeout, rawTx, false)
 		if err != nil {
 			logging.Error("tx failed to broadcast, failed to put in queue", types.Messages, "tx_id", id, "broadcast_err", broadcastErr, "resend_err", err)
@@ -213,13 +237,27 @@ func (m *manager) putOnRetry(
 		id = uuid.New().String()
 	}
 
-	b, err := json.Marshal(&txToSend{
+	return m.publishTxToSend(txToSend{
 		TxInfo: txInfo{
 			Id:      id,
 			RawTx:   bz,
 			TxHash:  txHash,
 			Timeout: timeout,
 		}, Sent: sent})
+}
+
+// putOnRetrySingle queues the message again so that it is sent in a transaction of its own
+func (m *manager) putOnRetrySingle(tx txInfo) error {
+	logging.Debug("putOnRetrySingle: tx with params", types.Messages, "tx_id", tx.Id)
+	return m.publishTxToSend(txToSend{
+		TxInfo: txInfo{
+			Id:    tx.Id,
+			RawTx: tx.RawTx,
+		}, Single: true})
+}
+
+func (m *manager) publishTxToSend(tx txToSend) error {
+	b, err := json.Marshal(&tx)
 	if err != nil {
 		return err
 	}
@@ -227,11 +265,12 @@ func (m *manager) putOnRetry(
 	return err
 }
 
-func (m *manager) putTxToObserve(id string, rawTx sdk.Msg, txHash string, timeout time.Time) error {
+func (m *manager) putTxToObserve(id string, rawTx sdk.Msg, txHash string, timeout time.Time, batched bool) error {
 	logging.Debug(" putTxToObserve: tx with params", types.Messages,
 		"tx_id", id,
 		"tx_hash", txHash,
 		"timeout", timeout.String(),
+		"batched", batched,
 	)
 
 	bz, err := m.client.Context().Codec.MarshalInterfaceJSON(rawTx)
@@ -244,6 +283,7 @@ func (m *manager) putTxToObserve(id string, rawTx sdk.Msg, txHash string, timeou
 		RawTx:   bz,
 		TxHash:  txHash,
 		Timeout: timeout,
+		Batched: batched,
 	})
 	if err != nil {
 		return err
@@ -272,33 +312,49 @@ func (m *manager) sendTxs() error {
 			return
 		}
 
-		if !tx.Sent {
-			logging.Debug("start broadcast tx async", types.Messages, "id", tx.TxInfo.Id)
-			resp, timeout, err := m.broadcastMessage(tx.TxInfo.Id, rawTx)
-			if err != nil {
-				if isTxErrorCritical(err) {
-					logging.Error("got critical error sending tx", types.Messages, "id", tx.TxInfo.Id)
-					msg.Term() // invalid tx, drop it
-					return
-				}
-				msg.NakWithDelay(defaultSenderNackDelay)
-				return
+		queued := queuedTx{msg: msg, tx: tx, rawTx: rawTx}
+		if !tx.Sent && !tx.Single && m.batchConfig.accepts(rawTx) {
+			select {
+			case m.batchQueue <- queued:
+			case <-m.ctx.Done():
 			}
-			tx.TxInfo.Timeout = timeout
-			tx.TxInfo.TxHash = resp.TxHash
-			tx.Sent = true
+			return
 		}
+		m.sendQueuedTx(queued)
+	}, nats.Durable(txSenderConsumer), nats.ManualAck())
+	return err
+}
 
-		logging.Debug("tx broadcast, put to observe", types.Messages, "id", tx.TxInfo.Id, "tx_hash", tx.TxInfo.TxHash, "timeout", tx.TxInfo.Timeout.String())
-
-		if err := m.putTxToObserve(tx.TxInfo.Id, rawTx, tx.TxInfo.TxHash, tx.TxInfo.Timeout); err != nil {
-			logging.Error("error pushing to observe queue", types.Messages, "id", tx.TxInfo.Id, "err", err)
+// sendQueuedTx broadcasts a message from the send stream on its own unless it was already
+// sent, and hands it over to the observer.
+func (m *manager) sendQueuedTx(queued queuedTx) {
+	msg, tx, rawTx := queued.msg, queued.tx, queued.rawTx
+	if !tx.Sent {
+		logging.Debug("start broadcast tx async", types.Messages, "id", tx.TxInfo.Id)
+		resp, timeout, err := m.broadcastMessage(tx.TxInfo.Id, rawTx)
+		if err != nil {
+			if isTxErrorCritical(err) {
+				logging.Error("got critical error sending tx", types.Messages, "id", tx.TxInfo.Id)
+				msg.Term() // invalid tx, drop it
+				return
+			}
+			metrics.TxRetries.WithLabelValues("broadcast_failed").Inc()
 			msg.NakWithDelay(defaultSenderNackDelay)
-		} else {
-			msg.Ack()
+			return
 		}
-	}, nats.Durable(txSenderConsumer), nats.ManualAck())
-	return err
+		tx.TxInfo.Timeout = timeout
+		tx.TxInfo.TxHash = resp.TxHash
+		tx.Sent = true
+	}
+
+	logging.Debug("tx broadcast, put to observe", types.Messages, "id", tx.TxInfo.Id, "tx_hash", tx.TxInfo.TxHash, "timeout", tx.TxInfo.Timeout.String())
+
+	if err := m.putTxToObserve(tx.TxInfo.Id, rawTx, tx.TxInfo.TxHash, tx.TxInfo.Timeout, tx.TxInfo.Batched); err != nil {
+		logging.Error("error pushing to observe queue", types.Messages, "id", tx.TxInfo.Id, "err", err)
+		msg.NakWithDelay(defaultSenderNackDelay)
+	} else {
+		msg.Ack()
+	}
 }
 
 func (m *manager) observeTxs() error {
@@ -319,6 +375,7 @@ func (m *manager) observeTxs() error {
 
 		if tx.TxHash == "" {
 			logging.Warn("tx hash is empty", types.Messages, "tx_id", tx.Id)
+			metrics.TxRetries.WithLabelValues("missing_hash").Inc()
 			if err := m.putOnRetry(tx.Id, "", time.Time{}, rawTx, false); err != nil {
 				msg.NakWithDelay(defaultObserverNackDelay)
 				return
@@ -328,6 +385,17 @@ func (m *manager) observeTxs() error {
 		}
 
 		found, err := m.checkTxStatus(tx.TxHash)
+		if found && tx.Batched && errors.Is(err, ErrTxFailedOnChain) {
+			// A single failing message reverts the whole batch, so the others get another chance on their own
+			logging.Warn("batched tx failed on-chain, resending message alone", types.Messages, "tx_id", tx.Id, "txHash", tx.TxHash)
+			metrics.TxRetries.WithLabelValues("batch_failed").Inc()
+			if err := m.putOnRetrySingle(tx); err != nil {
+				msg.NakWithDelay(defaultObserverNackDelay)
+				return
+			}
+			msg.Ack()
+			return
+		}
 		if found {
 			logging.Debug("tx found, remove tx from observer queue", types.Messages, "tx_id", tx.Id, "txHash", tx.TxHash)
 			if err := msg.Ack(); err != nil {
@@ -344,6 +412,7 @@ func (m *manager) observeTxs() error {
 		if errors.Is(err, ErrTxNotFound) {
 			if time.Now().After(tx.Timeout) {
 				logging.Debug("tx expired", types.Messages, "tx_id", tx.Id, "tx_hash", tx.TxHash)
+				metrics.TxRetries.WithLabelValues("expired").Inc()
 				if err := m.putOnRetry(tx.Id, "", time.Time{}, rawTx, false); err != nil {
 					msg.NakWithDelay(defaultObserverNackDelay)
 					return
@@ -378,10 +447,11 @@ func (m *manager) checkTxStatus(hash string) (bool, error) {
 		return false, err
 	}
 
+	logging.Debug("checkTxStatus: found tx result", types.Messages, "txHash", hash, "resp", resp)
 	if resp.TxResult.Code != 0 {
 		logging.Error("checkTxStatus: tx failed on-chain", types.Messages, "txHash", hash, "code", resp.TxResult.Code, "codespace", resp.TxResult.Codespace, "rawLog", resp.TxResult.Log)
+		return true, ErrTxFailedOnChain
 	}
-	logging.Debug("checkTxStatus: found tx result", types.Messages, "txHash", hash, "resp", resp)
 	return true, nil
 }
 
@@ -408,25 +478,30 @@ func (m *manager) BankBalances(ctx context.Context, address string) ([]sdk.Coin,
 }
 
 func (m *manager) broadcastMessage(id string, rawTx sdk.Msg) (*sdk.TxResponse, time.Time, error) {
+	return m.broadcast(id, rawTx)
+}
+
+// broadcastMessages signs all messages as a single transaction
+func (m *manager) broadcastMessages(id string, rawTxs ...sdk.Msg) (*sdk.TxResponse, time.Time, error) {
 	factory, err := m.getFactory(id)
 	if err != nil {
 		return nil, time.Time{}, err
 	}
 
-	var finalMsg sdk.Msg = rawTx
-	originalMsgType := sdk.MsgTypeURL(rawTx)
+	finalMsgs := rawTxs
+	originalMsgType := sdk.MsgTypeURL(rawTxs[0])
 	if !m.apiAccount.IsSignerTheMainAccount() {
 		granteeAddress, err := m.apiAccount.SignerAddress()
 		if err != nil {
 			return nil, time.Time{}, fmt.Errorf("failed to get signer address: %w", err)
 		}
 
-		execMsg := authztypes.NewMsgExec(granteeAddress, []sdk.Msg{rawTx})
-		finalMsg = &execMsg
-		logging.Info("Using authz MsgExec", types.Messages, "grantee", granteeAddress.String(), "originalMsgType", originalMsgType)
+		execMsg := authztypes.NewMsgExec(granteeAddress, rawTxs)
+		finalMsgs = []sdk.Msg{&execMsg}
+		logging.Info("Using authz MsgExec", types.Messages, "grantee", granteeAddress.String(), "originalMsgType", originalMsgType, "msgCount", len(rawTxs))
 	}
 
-	unsignedTx, err := factory.BuildUnsignedTx(finalMsg)
+	unsignedTx, err := factory.BuildUnsignedTx(finalMsgs...)
 	if err != nil {
 		return nil, time.Time{}, err
 	}
@@ -437,10 +512,14 @@ func (m *manager) broadcastMessage(id string, rawTx sdk.Msg) (*sdk.TxResponse, t
 
 	resp, err := m.client.Context().BroadcastTxSync(txBytes)
 	if err != nil {
+		metrics.TxBroadcasts.WithLabelValues("error").Inc()
 		return nil, time.Time{}, err
 	}
 	if resp.Code != 0 {
+		metrics.TxBroadcasts.WithLabelValues("rejected").Inc()
 		logging.Error("Broadcast failed immediately", types.Messages, "code", resp.Code, "rawLog", resp.RawLog, "tx_id", id, "originalMsgType", originalMsgType)
+	} else {
+		metrics.TxBroadcasts.WithLabelValues("accepted").Inc()
 	}
 	return resp, timestamp, nil
 }
@@ -0,0 +1,75 @@
+package authkeys
+
+import "sync"
+
+// MemoryStore keeps used AuthKeys in process memory. It is only safe for a single API
+// instance and forgets every key on restart, so it is meant for tests and local runs.
+type MemoryStore struct {
+	// Map for O(1) lookup of existing AuthKeys and their contexts
+	usedAuthKeys map[string]Context
+
+	// Map for O(1) lookup of what to remove, organized by block height
+	authKeysByBlock map[int64][]string
+
+	// Track the oldest block height we're storing
+	oldestBlockHeight int64
+
+	expirationBlocks func() int64
+	mu               sync.Mutex
+}
+
+func NewMemoryStore(expirationBlocks func() int64) *MemoryStore {
+	return &MemoryStore{
+		usedAuthKeys:     make(map[string]Context),
+		authKeysByBlock:  make(map[int64][]string),
+		expirationBlocks: expirationBlocks,
+	}
+}
+
+func (s *MemoryStore) CheckAndRecord(authKey string, blockHeight int64, context Context) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existingContext, exists := s.usedAuthKeys[authKey]
+	if exists {
+		// If the key exists, check if it's been used in the current context
+		if existingContext&context != 0 {
+			return true, nil
+		}
+		// Key exists but hasn't been used in this context, update the context
+		s.usedAuthKeys[authKey] = existingContext | context
+		return false, nil
+	}
+
+	s.usedAuthKeys[authKey] = context
+	s.authKeysByBlock[blockHeight] = append(s.authKeysByBlock[blockHeight], authKey)
+
+	if s.oldestBlockHeight == 0 {
+		s.oldestBlockHeight = blockHeight
+	}
+
+	s.cleanupExpired(blockHeight)
+	return false, nil
+}
+
+// cleanupExpired removes auth keys recorded more than expirationBlocks ago
+func (s *MemoryStore) cleanupExpired(currentBlockHeight int64) {
+	expirationHeight := currentBlockHeight - s.expirationBlocks()
+
+	for height := s.oldestBlockHeight; height < expirationHeight; height++ {
+		keys, exists := s.authKeysByBlock[height]
+		if !exists {
+			continue
+		}
+
+		for _, key := range keys {
+			delete(s.usedAuthKeys, key)
+		}
+
+		delete(s.authKeysByBlock, height)
+	}
+
+	if s.oldestBlockHeight < expirationHeight {
+		s.oldestBlockHeight = expirationHeight
+	}
+}
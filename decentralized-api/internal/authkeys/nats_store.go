@@ -0,0 +1,112 @@
+package authkeys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Bucket is the JetStream key-value bucket shared by every API instance of a participant
+	Bucket = "auth_keys"
+	// BucketTTL only garbage-collects keys; expiry is decided by block height. It has to be
+	// longer than the expiration window of any realistic timestamp_expiration.
+	BucketTTL = time.Hour
+
+	maxUpdateAttempts = 5
+)
+
+var ErrStoreContention = errors.New("too many concurrent updates of the same AuthKey")
+
+// NatsStore keeps used AuthKeys in a JetStream key-value bucket, so they survive restarts
+// and are shared by all API instances connected to the same NATS server. Every update is
+// a compare-and-set on the key's revision, which makes concurrent requests carrying the
+// same AuthKey on different instances settle on a single winner.
+type NatsStore struct {
+	kv               nats.KeyValue
+	expirationBlocks func() int64
+}
+
+// record is the value stored for an AuthKey: the height of the window it was first seen in
+// and the contexts it has been used in since.
+type record struct {
+	Height   int64   `json:"height"`
+	Contexts Context `json:"contexts"`
+}
+
+func NewNatsStore(js nats.JetStreamContext, expirationBlocks func() int64) (*NatsStore, error) {
+	kv, err := js.KeyValue(Bucket)
+	if errors.Is(err, nats.ErrBucketNotFound) {
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:  Bucket,
+			TTL:     BucketTTL,
+			Storage: nats.FileStorage,
+		})
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open auth key bucket")
+	}
+	return &NatsStore{kv: kv, expirationBlocks: expirationBlocks}, nil
+}
+
+func (s *NatsStore) CheckAndRecord(authKey string, blockHeight int64, context Context) (bool, error) {
+	key := bucketKey(authKey)
+	expirationHeight := blockHeight - s.expirationBlocks()
+
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		entry, err := s.kv.Get(key)
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			_, err = s.kv.Create(key, encodeRecord(record{Height: blockHeight, Contexts: context}))
+			if errors.Is(err, nats.ErrKeyExists) {
+				// Another instance recorded the key first, look at what it wrote
+				continue
+			}
+			if err != nil {
+				return false, errors.Wrap(err, "failed to record auth key")
+			}
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "failed to read auth key")
+		}
+
+		var existing record
+		if err := json.Unmarshal(entry.Value(), &existing); err != nil {
+			return false, errors.Wrap(err, "failed to decode auth key record")
+		}
+
+		next := record{Height: blockHeight, Contexts: context}
+		if existing.Height >= expirationHeight {
+			if existing.Contexts&context != 0 {
+				return true, nil
+			}
+			next = record{Height: existing.Height, Contexts: existing.Contexts | context}
+		}
+
+		_, err = s.kv.Update(key, encodeRecord(next), entry.Revision())
+		if errors.Is(err, nats.ErrKeyExists) {
+			continue
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "failed to record auth key")
+		}
+		return false, nil
+	}
+	return false, ErrStoreContention
+}
+
+// bucketKey hashes the AuthKey, since signatures contain characters that aren't valid in
+// key-value keys.
+func bucketKey(authKey string) string {
+	hash := sha256.Sum256([]byte(authKey))
+	return hex.EncodeToString(hash[:])
+}
+
+func encodeRecord(r record) []byte {
+	data, _ := json.Marshal(r)
+	return data
+}
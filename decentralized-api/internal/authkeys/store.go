@@ -0,0 +1,53 @@
+package authkeys
+
+import (
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Context represents the context in which an AuthKey was used
+type Context int
+
+const (
+	// TransferContext indicates the AuthKey was used for a transfer request
+	TransferContext Context = 1
+	// ExecutorContext indicates the AuthKey was used for an executor request
+	ExecutorContext Context = 2
+	// BothContexts indicates the AuthKey was used for both transfer and executor requests
+	BothContexts = TransferContext | ExecutorContext
+)
+
+// Store prevents signed requests from being replayed. A key is remembered for a window of
+// blocks after its first use, which must outlive the request timestamp expiration.
+type Store interface {
+	// CheckAndRecord records that authKey was used in the given context at blockHeight.
+	// It returns true if the key was already used in that context within the window.
+	CheckAndRecord(authKey string, blockHeight int64, context Context) (bool, error)
+}
+
+// ExpirationBlocks returns how many blocks an AuthKey is remembered for, based on the
+// timestamp_expiration validation parameter.
+func ExpirationBlocks(configManager *apiconfig.ConfigManager) int64 {
+	// Default expiration is 4 blocks if configManager is not set
+	expirationBlocks := int64(4)
+	if configManager == nil {
+		return expirationBlocks
+	}
+
+	timestampExpiration := configManager.GetValidationParams().TimestampExpiration
+	// Use default value if parameter is not set
+	if timestampExpiration == 0 {
+		timestampExpiration = 10 // Default 10 seconds
+	}
+
+	// Use twice the timestamp_expiration value (converted to blocks)
+	// Ensure we keep at least 4 blocks for safety
+	expirationBlocks = max((timestampExpiration*2)/4, 4)
+
+	logging.Debug("Auth key expiration", types.Inferences,
+		"timestampExpiration", timestampExpiration,
+		"expirationBlocks", expirationBlocks)
+	return expirationBlocks
+}
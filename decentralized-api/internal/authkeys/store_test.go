@@ -0,0 +1,103 @@
+package authkeys
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	natssrv "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+)
+
+func fixedExpiration(blocks int64) func() int64 {
+	return func() int64 { return blocks }
+}
+
+func startNatsServer(t *testing.T) string {
+	ns, err := natssrv.NewServer(&natssrv.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	go ns.Start()
+	require.True(t, ns.ReadyForConnections(5*time.Second))
+	t.Cleanup(ns.Shutdown)
+	return ns.ClientURL()
+}
+
+// newNatsStore connects a new client, the way a separate API instance would
+func newNatsStore(t *testing.T, url string) *NatsStore {
+	nc, err := nats.Connect(url)
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	js, err := nc.JetStream()
+	require.NoError(t, err)
+	store, err := NewNatsStore(js, fixedExpiration(4))
+	require.NoError(t, err)
+	return store
+}
+
+func requireReuse(t *testing.T, store Store, authKey string, height int64, context Context, expected bool) {
+	reused, err := store.CheckAndRecord(authKey, height, context)
+	require.NoError(t, err)
+	require.Equal(t, expected, reused)
+}
+
+func testStoreSemantics(t *testing.T, store Store) {
+	requireReuse(t, store, "key1", 100, TransferContext, false)
+	requireReuse(t, store, "key1", 101, TransferContext, true)
+
+	// The same key may be used once as executor request
+	requireReuse(t, store, "key1", 101, ExecutorContext, false)
+	requireReuse(t, store, "key1", 102, ExecutorContext, true)
+
+	// Keys are forgotten once they leave the expiration window
+	requireReuse(t, store, "key2", 110, TransferContext, false)
+	requireReuse(t, store, "key1", 110, TransferContext, false)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStoreSemantics(t, NewMemoryStore(fixedExpiration(4)))
+}
+
+func TestNatsStore(t *testing.T) {
+	testStoreSemantics(t, newNatsStore(t, startNatsServer(t)))
+}
+
+func TestNatsStoreIsSharedBetweenInstances(t *testing.T) {
+	url := startNatsServer(t)
+	first := newNatsStore(t, url)
+	second := newNatsStore(t, url)
+
+	requireReuse(t, first, "key1", 100, TransferContext, false)
+	requireReuse(t, second, "key1", 100, TransferContext, true)
+
+	// A restarted instance still remembers the key
+	restarted := newNatsStore(t, url)
+	requireReuse(t, restarted, "key1", 101, TransferContext, true)
+}
+
+func TestNatsStoreAcceptsConcurrentReplayOnce(t *testing.T) {
+	url := startNatsServer(t)
+	stores := []*NatsStore{newNatsStore(t, url), newNatsStore(t, url)}
+
+	var accepted atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(store *NatsStore) {
+			defer wg.Done()
+			reused, err := store.CheckAndRecord("key1", 100, ExecutorContext)
+			require.NoError(t, err)
+			if !reused {
+				accepted.Add(1)
+			}
+		}(stores[i%len(stores)])
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), accepted.Load())
+}
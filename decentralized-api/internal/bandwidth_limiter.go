@@ -5,6 +5,7 @@ import (
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"sync"
 	"time"
 
@@ -143,6 +144,7 @@ func (bl *BandwidthLimiter) updateWeightBasedLimit(currentEpochIndex uint64) {
 
 	if bl.limitsPerBlockKB != newLimit {
 		bl.limitsPerBlockKB = newLimit
+		metrics.BandwidthLimitKb.Set(float64(newLimit))
 		logging.Info("Updated bandwidth limit", types.Config,
 			"newLimit", newLimit, "epoch", currentEpochIndex)
 	}
@@ -210,6 +212,7 @@ func (bl *BandwidthLimiter) RecordRequest(startBlockHeight int64, estimatedKB fl
 
 	completionBlock := startBlockHeight + bl.requestLifespanBlocks
 	bl.usagePerBlock[completionBlock] += estimatedKB
+	metrics.BandwidthUsageKb.Add(estimatedKB)
 }
 
 func (bl *BandwidthLimiter) ReleaseRequest(startBlockHeight int64, estimatedKB float64) {
@@ -218,6 +221,7 @@ func (bl *BandwidthLimiter) ReleaseRequest(startBlockHeight int64, estimatedKB f
 
 	completionBlock := startBlockHeight + bl.requestLifespanBlocks
 	bl.usagePerBlock[completionBlock] -= estimatedKB
+	metrics.BandwidthUsageKb.Sub(estimatedKB)
 
 	if bl.usagePerBlock[completionBlock] <= 0 {
 		delete(bl.usagePerBlock, completionBlock)
@@ -296,6 +300,7 @@ func NewBandwidthLimiterFromConfig(configManager ConfigManager, recorder cosmosc
 	if recorder != nil && phaseTracker != nil {
 		bl.epochCache = NewEpochGroupDataCache(recorder)
 	}
+	metrics.BandwidthLimitKb.Set(float64(limitsPerBlockKB))
 
 	logging.Info("Bandwidth limiter initialized", types.Config,
 		"limit", limitsPerBlockKB, "lifespan", requestLifespanBlocks,
@@ -9,8 +9,10 @@ import (
 	"decentralized-api/internal/bls"
 	"decentralized-api/internal/event_listener/chainevents"
 	"decentralized-api/internal/poc"
+	"decentralized-api/internal/subscriptions"
 	"decentralized-api/internal/validation"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"decentralized-api/training"
 	"decentralized-api/upgrade"
 	"encoding/json"
@@ -44,6 +46,7 @@ type EventListener struct {
 	transactionRecorder cosmosclient.InferenceCosmosClient
 	trainingExecutor    *training.Executor
 	blsManager          *bls.BlsManager
+	lifecycleEvents     *subscriptions.Hub
 	nodeCaughtUp        atomic.Bool
 	phaseTracker        *chainphase.ChainPhaseTracker
 	dispatcher          *OnNewBlockDispatcher
@@ -64,6 +67,7 @@ func NewEventListener(
 	phaseTracker *chainphase.ChainPhaseTracker,
 	cancelFunc context.CancelFunc,
 	blsManager *bls.BlsManager,
+	lifecycleEvents *subscriptions.Hub,
 ) *EventListener {
 	// Create the new block dispatcher
 	dispatcher := NewOnNewBlockDispatcherFromCosmosClient(
@@ -81,6 +85,7 @@ func NewEventListener(
 		&InferenceValidationEventHandler{},
 		&SubmitProposalEventHandler{},
 		&TrainingTaskAssignedEventHandler{},
+		&InferenceLifecycleEventHandler{},
 	}
 
 	return &EventListener{
@@ -93,6 +98,7 @@ func NewEventListener(
 		dispatcher:          dispatcher,
 		cancelFunc:          cancelFunc,
 		blsManager:          blsManager,
+		lifecycleEvents:     lifecycleEvents,
 		eventHandlers:       eventHandlers,
 	}
 }
@@ -258,6 +264,8 @@ func (el *EventListener) startSyncStatusChecker() {
 		// The node is "synced" if it's NOT catching up.
 		isSynced := !status.SyncInfo.CatchingUp
 		el.updateNodeSyncStatus(isSynced)
+		metrics.ChainBlockHeight.Set(float64(status.SyncInfo.LatestBlockHeight))
+		metrics.EventListenerLagBlocks.Set(float64(status.SyncInfo.LatestBlockHeight - el.configManager.GetHeight()))
 		// Note: Sync status is now handled by the dispatcher during block processing
 		logging.Debug("Updated sync status", types.EventProcessing, "caughtUp", isSynced, "height", status.SyncInfo.LatestBlockHeight)
 	}
@@ -289,6 +297,9 @@ func (el *EventListener) processEvent(event *chainevents.JSONRPCResponse, worker
 			return
 		}
 
+		// Inferences expire in EndBlock, so their events only arrive with the block
+		el.lifecycleEvents.Publish(subscriptions.ParseChainEvents(event.Result.Events, blockInfo.Height)...)
+
 		// Process using the new dispatcher
 		ctx := context.Background() // We could pass this from caller if needed
 		err = el.dispatcher.ProcessNewBlock(ctx, *blockInfo)
@@ -465,6 +476,27 @@ func (e *TrainingTaskAssignedEventHandler) Handle(event *chainevents.JSONRPCResp
 	return nil
 }
 
+// InferenceLifecycleEventHandler publishes finish, validation and invalidation events to the
+// subscribers of the inferences' requesters
+type InferenceLifecycleEventHandler struct{}
+
+func (e *InferenceLifecycleEventHandler) GetName() string {
+	return "inference_lifecycle"
+}
+
+func (e *InferenceLifecycleEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
+	return subscriptions.HasLifecycleEvents(event.Result.Events)
+}
+
+func (e *InferenceLifecycleEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
+	height, err := strconv.ParseInt(event.Result.Events["tx.height"][0], 10, 64)
+	if err != nil {
+		return err
+	}
+	el.lifecycleEvents.Publish(subscriptions.ParseChainEvents(event.Result.Events, height)...)
+	return nil
+}
+
 func waitForEventHeight(event *chainevents.JSONRPCResponse, currentConfig *apiconfig.ConfigManager, name string) bool {
 	heightString := event.Result.Events["tx.height"][0]
 	expectedHeight, err := strconv.ParseInt(heightString, 10, 64)
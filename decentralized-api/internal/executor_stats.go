@@ -0,0 +1,148 @@
+package internal
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	// executorStatsAlpha is the weight of the newest observation in the moving averages
+	executorStatsAlpha = 0.2
+	// executorStatsMaxAge drops executors that have not been used for a while, they may have
+	// left the epoch or changed their setup since
+	executorStatsMaxAge = time.Hour
+)
+
+// ExecutorStats keeps exponentially weighted latency and error rate per executor, as observed
+// by this transfer agent, and biases the on-chain executor weights with them.
+type ExecutorStats struct {
+	mu    sync.Mutex
+	stats map[string]*executorStat
+	now   func() time.Time
+}
+
+type executorStat struct {
+	latencyMs float64
+	errorRate float64
+	updatedAt time.Time
+}
+
+// WeightedExecutor is an executor address with its on-chain selection weight
+type WeightedExecutor struct {
+	Address string
+	Weight  int64
+}
+
+func NewExecutorStats() *ExecutorStats {
+	return &ExecutorStats{
+		stats: make(map[string]*executorStat),
+		now:   time.Now,
+	}
+}
+
+// RecordSuccess records the time an executor took to send its response headers
+func (es *ExecutorStats) RecordSuccess(address string, latency time.Duration) {
+	es.record(address, float64(latency.Milliseconds()), 0)
+}
+
+// RecordFailure records a failed attempt, the elapsed time counts as latency
+func (es *ExecutorStats) RecordFailure(address string, elapsed time.Duration) {
+	es.record(address, float64(elapsed.Milliseconds()), 1)
+}
+
+func (es *ExecutorStats) record(address string, latencyMs float64, failed float64) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	now := es.now()
+	stat, found := es.stats[address]
+	if !found || now.Sub(stat.updatedAt) > executorStatsMaxAge {
+		es.stats[address] = &executorStat{latencyMs: latencyMs, errorRate: failed, updatedAt: now}
+		return
+	}
+	stat.latencyMs += executorStatsAlpha * (latencyMs - stat.latencyMs)
+	stat.errorRate += executorStatsAlpha * (failed - stat.errorRate)
+	stat.updatedAt = now
+}
+
+// Factors returns the multiplier applied to the on-chain weight of each candidate. Executors
+// are scored against the average of the known candidates: faster and more reliable ones get a
+// factor above 1, slower or failing ones below 1, never outside [1-maxDeviation, 1+maxDeviation].
+// Executors without recent observations keep their on-chain weight.
+func (es *ExecutorStats) Factors(candidates []WeightedExecutor, maxDeviation float64) []float64 {
+	factors := make([]float64, len(candidates))
+	for i := range factors {
+		factors[i] = 1
+	}
+	maxDeviation = math.Max(0, math.Min(maxDeviation, 1))
+	if maxDeviation == 0 {
+		return factors
+	}
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	now := es.now()
+	scores := make(map[int]float64)
+	totalScore := 0.0
+	for i, candidate := range candidates {
+		stat, found := es.stats[candidate.Address]
+		if !found || now.Sub(stat.updatedAt) > executorStatsMaxAge {
+			continue
+		}
+		// Lower is better: a request that fails costs a retry on top of its latency
+		score := (1 + stat.latencyMs) * (1 + stat.errorRate)
+		scores[i] = score
+		totalScore += score
+	}
+	if len(scores) < 2 {
+		return factors
+	}
+
+	averageScore := totalScore / float64(len(scores))
+	for i, score := range scores {
+		// relative is 0 for an average executor, positive when it is better than average
+		relative := (averageScore - score) / math.Max(averageScore, score)
+		factors[i] = 1 + relative*maxDeviation
+	}
+	return factors
+}
+
+// Pick selects a candidate at random, proportionally to its on-chain weight biased by Factors.
+// It returns -1 when there is no candidate with a positive weight.
+func (es *ExecutorStats) Pick(candidates []WeightedExecutor, maxDeviation float64) int {
+	factors := es.Factors(candidates, maxDeviation)
+
+	weights := make([]float64, len(candidates))
+	total := 0.0
+	for i, candidate := range candidates {
+		if candidate.Weight <= 0 {
+			continue
+		}
+		weights[i] = float64(candidate.Weight) * factors[i]
+		total += weights[i]
+	}
+	if total <= 0 {
+		return -1
+	}
+
+	target := rand.Float64() * total
+	for i, weight := range weights {
+		if weight == 0 {
+			continue
+		}
+		if target < weight {
+			return i
+		}
+		target -= weight
+	}
+	// Floating point rounding, fall back to the last candidate with a weight
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return i
+		}
+	}
+	return -1
+}
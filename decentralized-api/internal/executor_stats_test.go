@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutorStats_FactorsStayWithinDeviation(t *testing.T) {
+	es := NewExecutorStats()
+	es.RecordSuccess("fast", 100*time.Millisecond)
+	es.RecordSuccess("slow", 5*time.Second)
+	es.RecordFailure("failing", 30*time.Second)
+
+	candidates := []WeightedExecutor{
+		{Address: "fast", Weight: 10},
+		{Address: "slow", Weight: 10},
+		{Address: "failing", Weight: 10},
+		{Address: "unknown", Weight: 10},
+	}
+	factors := es.Factors(candidates, 0.3)
+
+	require.Greater(t, factors[0], 1.0)
+	require.Less(t, factors[2], factors[1])
+	require.Equal(t, 1.0, factors[3])
+	for _, factor := range factors {
+		require.GreaterOrEqual(t, factor, 0.7)
+		require.LessOrEqual(t, factor, 1.3)
+	}
+}
+
+func TestExecutorStats_NoBiasWithoutComparison(t *testing.T) {
+	es := NewExecutorStats()
+	es.RecordFailure("failing", time.Second)
+
+	candidates := []WeightedExecutor{{Address: "failing", Weight: 10}, {Address: "unknown", Weight: 10}}
+	require.Equal(t, []float64{1, 1}, es.Factors(candidates, 0.3))
+
+	es.RecordSuccess("unknown", time.Second)
+	require.Equal(t, []float64{1, 1}, es.Factors(candidates, 0))
+}
+
+func TestExecutorStats_ForgetsStaleExecutors(t *testing.T) {
+	now := time.Now()
+	es := NewExecutorStats()
+	es.now = func() time.Time { return now }
+	es.RecordFailure("a", time.Second)
+	es.RecordSuccess("b", time.Second)
+
+	now = now.Add(executorStatsMaxAge + time.Minute)
+	candidates := []WeightedExecutor{{Address: "a", Weight: 1}, {Address: "b", Weight: 1}}
+	require.Equal(t, []float64{1, 1}, es.Factors(candidates, 0.5))
+}
+
+func TestExecutorStats_Pick(t *testing.T) {
+	es := NewExecutorStats()
+	require.Equal(t, -1, es.Pick(nil, 0.3))
+	require.Equal(t, -1, es.Pick([]WeightedExecutor{{Address: "a", Weight: 0}}, 0.3))
+
+	candidates := []WeightedExecutor{{Address: "a", Weight: 0}, {Address: "b", Weight: 5}}
+	for i := 0; i < 100; i++ {
+		require.Equal(t, 1, es.Pick(candidates, 0.3))
+	}
+}
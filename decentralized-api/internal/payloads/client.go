@@ -0,0 +1,87 @@
+package payloads
+
+import (
+	"context"
+	"decentralized-api/utils"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/productscience/inference/x/inference/calculations"
+)
+
+const (
+	// Path is where the public API serves stored payloads
+	Path = "/v1/payloads"
+
+	fetchTimeout = 30 * time.Second
+	// MaxPayloadSize bounds how much of a fetched payload is read
+	MaxPayloadSize = 32 << 20
+)
+
+var ErrHashMismatch = errors.New("payload does not match its hash")
+
+// SignFunc signs the components of a payload request with the participant's key
+type SignFunc func(components calculations.SignatureComponents) (string, error)
+
+// RequestComponents are what a participant signs to fetch a payload. The signature is
+// checked the same way as a developer's request signature, with the requester as address.
+func RequestComponents(hash string, timestamp int64, requesterAddress string) calculations.SignatureComponents {
+	return calculations.SignatureComponents{
+		Payload:         hash,
+		Timestamp:       timestamp,
+		TransferAddress: requesterAddress,
+	}
+}
+
+// Locator is where the payloads stored by the node with the given public URL are fetched from
+func Locator(publicUrl string) string {
+	return publicUrl + Path
+}
+
+// Fetch downloads a payload from the store at locator and checks it against its hash
+func Fetch(ctx context.Context, locator string, hash string, requesterAddress string, sign SignFunc) ([]byte, error) {
+	payloadUrl, err := url.JoinPath(locator, hash)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := time.Now().UnixNano()
+	signature, err := sign(RequestComponents(hash, timestamp, requesterAddress))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign payload request")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, payloadUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(utils.AuthorizationHeader, signature)
+	req.Header.Set(utils.XTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(utils.XRequesterAddressHeader, requesterAddress)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("payload request failed with status: %d", resp.StatusCode)
+	}
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, MaxPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > MaxPayloadSize {
+		return nil, fmt.Errorf("payload is larger than %d bytes", MaxPayloadSize)
+	}
+	if utils.GenerateSHA256Hash(string(payload)) != hash {
+		return nil, ErrHashMismatch
+	}
+	return payload, nil
+}
@@ -0,0 +1,150 @@
+package payloads
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	DefaultDir       = "/root/.dapi/payloads"
+	DefaultRetention = 7 * 24 * time.Hour
+
+	pruneInterval = time.Hour
+)
+
+var (
+	ErrNotFound    = errors.New("payload not found")
+	ErrInvalidHash = errors.New("invalid payload hash")
+)
+
+// FileStore keeps inference payloads whose hashes are recorded on chain. Payloads are
+// content-addressed by their SHA-256, so whoever fetches one can check it against the chain.
+type FileStore struct {
+	dir       string
+	retention time.Duration
+}
+
+func NewFileStore(dir string, retention time.Duration) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create payload directory")
+	}
+	return &FileStore{dir: dir, retention: retention}, nil
+}
+
+func NewFileStoreFromConfig(config apiconfig.PayloadStorageConfig) (*FileStore, error) {
+	dir := config.Dir
+	if dir == "" {
+		dir = DefaultDir
+	}
+	retention := time.Duration(config.RetentionHours) * time.Hour
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return NewFileStore(dir, retention)
+}
+
+// Put stores the payload and returns its hash. Storing the same payload twice is a no-op.
+func (s *FileStore) Put(payload []byte) (string, error) {
+	hash := utils.GenerateSHA256Hash(string(payload))
+	path := s.path(hash)
+	if _, err := os.Stat(path); err == nil {
+		return hash, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", errors.Wrap(err, "failed to create payload directory")
+	}
+
+	// Written to a temporary file first, so a half written payload is never served
+	tmp, err := os.CreateTemp(filepath.Dir(path), hash+".*.tmp")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create payload file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(payload); err != nil {
+		tmp.Close()
+		return "", errors.Wrap(err, "failed to write payload")
+	}
+	if err := tmp.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to write payload")
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", errors.Wrap(err, "failed to store payload")
+	}
+	return hash, nil
+}
+
+func (s *FileStore) Get(hash string) ([]byte, error) {
+	if !isValidHash(hash) {
+		return nil, ErrInvalidHash
+	}
+	payload, err := os.ReadFile(s.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read payload")
+	}
+	return payload, nil
+}
+
+// Prune removes payloads stored before olderThan and returns how many were removed
+func (s *FileStore) Prune(olderThan time.Time) (int, error) {
+	removed := 0
+	err := filepath.WalkDir(s.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(olderThan) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// RunPruning removes payloads older than the retention period until ctx is done. Validators
+// only fetch payloads while an inference can still be validated, so old ones are never needed.
+func (s *FileStore) RunPruning(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := s.Prune(time.Now().Add(-s.retention))
+			if err != nil {
+				logging.Error("Failed to prune payloads", types.Inferences, "error", err)
+				continue
+			}
+			logging.Debug("Pruned payloads", types.Inferences, "removed", removed)
+		}
+	}
+}
+
+// path spreads payloads over subdirectories by the first byte of their hash
+func (s *FileStore) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+func isValidHash(hash string) bool {
+	decoded, err := hex.DecodeString(hash)
+	return err == nil && len(decoded) == 32
+}
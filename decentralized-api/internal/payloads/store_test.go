@@ -0,0 +1,82 @@
+package payloads
+
+import (
+	"context"
+	"decentralized-api/utils"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	store, err := NewFileStore(t.TempDir(), time.Hour)
+	require.NoError(t, err)
+
+	payload := []byte(`{"model":"model1","messages":[{"content":"hi"}]}`)
+	hash, err := store.Put(payload)
+	require.NoError(t, err)
+	require.Equal(t, utils.GenerateSHA256Hash(string(payload)), hash)
+
+	// Storing the same payload again keeps a single copy
+	again, err := store.Put(payload)
+	require.NoError(t, err)
+	require.Equal(t, hash, again)
+
+	stored, err := store.Get(hash)
+	require.NoError(t, err)
+	require.Equal(t, payload, stored)
+
+	_, err = store.Get(utils.GenerateSHA256Hash("missing"))
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = store.Get("../../etc/passwd")
+	require.ErrorIs(t, err, ErrInvalidHash)
+}
+
+func TestFileStorePrune(t *testing.T) {
+	store, err := NewFileStore(t.TempDir(), time.Hour)
+	require.NoError(t, err)
+	hash, err := store.Put([]byte("payload"))
+	require.NoError(t, err)
+
+	removed, err := store.Prune(time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 0, removed)
+
+	removed, err = store.Prune(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	_, err = store.Get(hash)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFetchVerifiesHash(t *testing.T) {
+	payload := "payload"
+	hash := utils.GenerateSHA256Hash(payload)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "signature", r.Header.Get(utils.AuthorizationHeader))
+		require.Equal(t, "requester", r.Header.Get(utils.XRequesterAddressHeader))
+		if strings.HasSuffix(r.URL.Path, hash) {
+			w.Write([]byte(payload))
+			return
+		}
+		w.Write([]byte("tampered"))
+	}))
+	defer server.Close()
+
+	sign := func(components calculations.SignatureComponents) (string, error) {
+		require.Equal(t, "requester", components.TransferAddress)
+		return "signature", nil
+	}
+
+	fetched, err := Fetch(context.Background(), server.URL+"/v1/payloads", hash, "requester", sign)
+	require.NoError(t, err)
+	require.Equal(t, []byte(payload), fetched)
+
+	_, err = Fetch(context.Background(), server.URL+"/v1/payloads", utils.GenerateSHA256Hash("other"), "requester", sign)
+	require.ErrorIs(t, err, ErrHashMismatch)
+}
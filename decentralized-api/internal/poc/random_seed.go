@@ -58,6 +58,12 @@ func (rsm *RandomSeedManagerImpl) GenerateSeed(epochIndex uint64) {
 
 func (rsm *RandomSeedManagerImpl) ChangeCurrentSeed() {
 	configManager := rsm.configManager
+	// The outgoing previous seed is kept until its claim is done, the node may have missed the claim stage
+	seeds := rememberSeed(configManager.GetUnclaimedSeeds(), configManager.GetPreviousSeed())
+	if err := configManager.SetUnclaimedSeeds(seeds); err != nil {
+		logging.Error("Failed to set unclaimed seeds", types.Claims, "error", err)
+		return
+	}
 	err := configManager.SetPreviousSeed(configManager.GetCurrentSeed())
 	if err != nil {
 		logging.Error("Failed to set previous seed", types.Claims, "error", err)
@@ -80,7 +86,43 @@ func (rsm *RandomSeedManagerImpl) RequestMoney() {
 	//  e.g. generation fails a few times in a row for some reason
 	//  Solution: query seed here?
 	seed := rsm.configManager.GetPreviousSeed()
+	seeds := rememberSeed(rsm.configManager.GetUnclaimedSeeds(), seed)
+
+	pending, err := rsm.transactionRecorder.NewInferenceQueryClient().PendingClaims(
+		rsm.transactionRecorder.GetContext(),
+		&types.QueryPendingClaimsRequest{Participant: rsm.transactionRecorder.GetAddress()},
+	)
+	if err != nil {
+		logging.Error("Failed to query pending claims, claiming the previous epoch only", types.Claims, "error", err)
+		if err := rsm.configManager.SetUnclaimedSeeds(seeds); err != nil {
+			logging.Error("Failed to set unclaimed seeds", types.Claims, "error", err)
+		}
+		rsm.claimPreviousEpoch(seed)
+		return
+	}
+
+	claims, kept := matchPendingClaims(pending.Claims, seeds)
+	if err := rsm.configManager.SetUnclaimedSeeds(kept); err != nil {
+		logging.Error("Failed to set unclaimed seeds", types.Claims, "error", err)
+	}
+	if len(claims) == 0 {
+		logging.Info("IsSetNewValidatorsStage: no pending claims with a known seed", types.Claims, "pending", len(pending.Claims))
+		return
+	}
+
+	for start := 0; start < len(claims); start += types.MaxClaimsPerBatch {
+		batch := claims[start:min(start+types.MaxClaimsPerBatch, len(claims))]
+		logging.Info("IsSetNewValidatorsStage: sending BatchClaimRewards transaction", types.Claims, "claims", len(batch))
+		err = rsm.transactionRecorder.BatchClaimRewards(&inference.MsgBatchClaimRewards{
+			Claims: batch,
+		})
+		if err != nil {
+			logging.Error("Failed to send BatchClaimRewards transaction", types.Claims, "error", err)
+		}
+	}
+}
 
+func (rsm *RandomSeedManagerImpl) claimPreviousEpoch(seed apiconfig.SeedInfo) {
 	logging.Info("IsSetNewValidatorsStage: sending ClaimRewards transaction", types.Claims, "seed", seed)
 	err := rsm.transactionRecorder.ClaimRewards(&inference.MsgClaimRewards{
 		Seed:       seed.Seed,
@@ -91,6 +133,45 @@ func (rsm *RandomSeedManagerImpl) RequestMoney() {
 	}
 }
 
+// rememberSeed adds seed to the unclaimed seeds unless a seed for its epoch is already known
+func rememberSeed(seeds []apiconfig.SeedInfo, seed apiconfig.SeedInfo) []apiconfig.SeedInfo {
+	if seed.EpochIndex == 0 {
+		return seeds
+	}
+	for _, s := range seeds {
+		if s.EpochIndex == seed.EpochIndex {
+			return seeds
+		}
+	}
+	return append(seeds, seed)
+}
+
+// matchPendingClaims pairs the pending claims on chain with the seeds we generated for their epochs.
+// Seeds of epochs that are no longer pending were claimed or burned and are dropped.
+func matchPendingClaims(
+	pending []types.PendingClaim,
+	seeds []apiconfig.SeedInfo,
+) ([]*inference.RewardClaim, []apiconfig.SeedInfo) {
+	seedsByEpoch := make(map[uint64]apiconfig.SeedInfo, len(seeds))
+	for _, s := range seeds {
+		seedsByEpoch[s.EpochIndex] = s
+	}
+
+	var claims []*inference.RewardClaim
+	var kept []apiconfig.SeedInfo
+	for _, claim := range pending {
+		epochIndex := claim.SettleAmount.EpochIndex
+		s, found := seedsByEpoch[epochIndex]
+		if !found {
+			logging.Warn("No seed known for pending claim, it will expire", types.Claims, "epochIndex", epochIndex, "expiresAtEpoch", claim.ExpiresAtEpoch)
+			continue
+		}
+		claims = append(claims, &inference.RewardClaim{Seed: s.Seed, EpochIndex: epochIndex})
+		kept = append(kept, s)
+	}
+	return claims, kept
+}
+
 func createNewSeed(
 	epoch uint64,
 	transactionRecorder cosmosclient.CosmosMessageClient,
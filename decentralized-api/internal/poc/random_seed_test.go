@@ -0,0 +1,60 @@
+package poc
+
+import (
+	"decentralized-api/apiconfig"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRememberSeed(t *testing.T) {
+	seeds := []apiconfig.SeedInfo{{Seed: 1, EpochIndex: 3}}
+
+	require.Len(t, rememberSeed(seeds, apiconfig.SeedInfo{Seed: 1, EpochIndex: 3}), 1)
+	require.Len(t, rememberSeed(seeds, apiconfig.SeedInfo{}), 1)
+	require.Len(t, rememberSeed(seeds, apiconfig.SeedInfo{Seed: 2, EpochIndex: 4}), 2)
+}
+
+type discardWriterProvider struct{}
+
+func (d discardWriterProvider) GetWriter() apiconfig.WriteCloser { return d }
+func (d discardWriterProvider) Write(data []byte) (int, error)   { return len(data), nil }
+func (d discardWriterProvider) Close() error                     { return nil }
+
+func TestChangeCurrentSeed_KeepsOutgoingPreviousSeed(t *testing.T) {
+	config := &apiconfig.ConfigManager{WriterProvider: discardWriterProvider{}}
+	require.NoError(t, config.SetPreviousSeed(apiconfig.SeedInfo{Seed: 11, EpochIndex: 1}))
+	require.NoError(t, config.SetCurrentSeed(apiconfig.SeedInfo{Seed: 12, EpochIndex: 2}))
+	require.NoError(t, config.SetUpcomingSeed(apiconfig.SeedInfo{Seed: 13, EpochIndex: 3}))
+	rsm := NewRandomSeedManager(nil, config)
+
+	// The claim stage of epoch 1 was missed
+	rsm.ChangeCurrentSeed()
+
+	require.Equal(t, apiconfig.SeedInfo{Seed: 12, EpochIndex: 2}, config.GetPreviousSeed())
+	require.Equal(t, apiconfig.SeedInfo{Seed: 13, EpochIndex: 3}, config.GetCurrentSeed())
+	require.Equal(t, []apiconfig.SeedInfo{{Seed: 11, EpochIndex: 1}}, config.GetUnclaimedSeeds())
+}
+
+func TestMatchPendingClaims(t *testing.T) {
+	seeds := []apiconfig.SeedInfo{
+		{Seed: 11, EpochIndex: 1}, // claimed or burned, no longer pending
+		{Seed: 12, EpochIndex: 2},
+		{Seed: 13, EpochIndex: 3},
+	}
+	pending := []types.PendingClaim{
+		{SettleAmount: types.SettleAmount{EpochIndex: 2}},
+		{SettleAmount: types.SettleAmount{EpochIndex: 3}},
+		{SettleAmount: types.SettleAmount{EpochIndex: 5}}, // seed lost
+	}
+
+	claims, kept := matchPendingClaims(pending, seeds)
+
+	require.Len(t, claims, 2)
+	require.Equal(t, uint64(2), claims[0].EpochIndex)
+	require.Equal(t, int64(12), claims[0].Seed)
+	require.Equal(t, uint64(3), claims[1].EpochIndex)
+	require.Equal(t, int64(13), claims[1].Seed)
+	require.Equal(t, []apiconfig.SeedInfo{{Seed: 12, EpochIndex: 2}, {Seed: 13, EpochIndex: 3}}, kept)
+}
@@ -20,6 +20,15 @@ func (s *Server) getNodes(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, nodes)
 }
 
+func (s *Server) getNodeWaitQueues(ctx echo.Context) error {
+	stats, err := s.nodeBroker.GetWaitQueueStats()
+	if err != nil {
+		logging.Error("Error getting node wait queues", types.Nodes, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, stats)
+}
+
 func (s *Server) deleteNode(ctx echo.Context) error {
 	nodeId := ctx.Param("id")
 	logging.Info("Deleting node", types.Nodes, "node", nodeId)
@@ -34,40 +43,11 @@ func (s *Server) deleteNode(ctx echo.Context) error {
 		return err
 	}
 	node := <-response
-	syncNodesWithConfig(s.nodeBroker, s.configManager)
+	broker.SyncNodesWithConfig(s.nodeBroker, s.configManager)
 
 	return ctx.JSON(http.StatusOK, node)
 }
 
-func syncNodesWithConfig(nodeBroker *broker.Broker, config *apiconfig.ConfigManager) {
-	nodes, err := nodeBroker.GetNodes()
-	iNodes := make([]apiconfig.InferenceNodeConfig, len(nodes))
-	for i, n := range nodes {
-		node := n.Node
-
-		models := make(map[string]apiconfig.ModelConfig)
-		for model, cfg := range node.Models {
-			models[model] = apiconfig.ModelConfig{Args: cfg.Args}
-		}
-
-		iNodes[i] = apiconfig.InferenceNodeConfig{
-			Host:             node.Host,
-			InferenceSegment: node.InferenceSegment,
-			InferencePort:    node.InferencePort,
-			PoCSegment:       node.PoCSegment,
-			PoCPort:          node.PoCPort,
-			Models:           models,
-			Id:               node.Id,
-			MaxConcurrent:    node.MaxConcurrent,
-			Hardware:         node.Hardware,
-		}
-	}
-	err = config.SetNodes(iNodes)
-	if err != nil {
-		logging.Error("Error writing config", types.Nodes, "error", err)
-	}
-}
-
 func (s *Server) createNewNodes(ctx echo.Context) error {
 	var newNodes []apiconfig.InferenceNodeConfig
 	if err := ctx.Bind(&newNodes); err != nil {
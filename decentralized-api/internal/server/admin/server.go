@@ -5,6 +5,7 @@ import (
 	"decentralized-api/broker"
 	cosmos_client "decentralized-api/cosmosclient"
 	"decentralized-api/internal/server/middleware"
+	"decentralized-api/metrics"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	blstypes "github.com/productscience/inference/x/bls/types"
@@ -45,11 +46,14 @@ func NewServer(
 	}
 
 	e.Use(middleware.LoggingMiddleware)
+	e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+
 	g := e.Group("/admin/v1/")
 
 	g.POST("nodes", s.createNewNode)
 	g.POST("nodes/batch", s.createNewNodes)
 	g.GET("nodes", s.getNodes)
+	g.GET("nodes/queue", s.getNodeWaitQueues)
 	g.DELETE("nodes/:id", s.deleteNode)
 	g.POST("nodes/:id/enable", s.enableNode)
 	g.POST("nodes/:id/disable", s.disableNode)
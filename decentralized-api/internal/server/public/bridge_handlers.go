@@ -3,6 +3,7 @@ package public
 import (
 	"decentralized-api/cosmosclient"
 	cosmos_client "decentralized-api/cosmosclient"
+	"encoding/hex"
 
 	"io"
 	"log/slog"
@@ -16,6 +17,19 @@ import (
 	"github.com/productscience/inference/x/inference/types"
 )
 
+const (
+	// proofRetryInterval is how often blocks waiting to have their deposits proven are looked at again
+	proofRetryInterval = 30 * time.Second
+	// maxHeaderWaitRounds bounds the wait for a majority to attest a block. The deposits of a block
+	// that never gets there are confirmed by a validator majority per receipt instead.
+	maxHeaderWaitRounds = 20
+	// fallbackSubmitRounds is how many rounds after a block is attested the other validators leave
+	// to its designated submitter before submitting the deposits that are still unproven themselves
+	fallbackSubmitRounds = 4
+	// maxSubmitRounds bounds how long unproven deposits of an attested block are retried
+	maxSubmitRounds = 20
+)
+
 // BlockQueue manages a queue of blocks to be processed
 type BridgeQueue struct {
 	pendingBlocks             map[string]*BridgeBlock // Key is blockNumber
@@ -23,6 +37,17 @@ type BridgeQueue struct {
 	minBlocksBeforeProcessing int // Minimum number of blocks needed before starting processing
 	recorder                  cosmosclient.CosmosMessageClient
 	processCh                 chan struct{} // Channel to signal processing is needed
+	// awaitingProof holds the blocks whose deposits are proven once their header is attested,
+	// keyed by origin chain and block number. Only the queue processor goroutine uses it.
+	awaitingProof map[string]*provenBlock
+}
+
+// provenBlock is a block whose receipts all came with a proof
+type provenBlock struct {
+	block       BridgeBlock
+	proofs      []types.ReceiptProof
+	waitRounds  int // rounds spent waiting for the header to be attested
+	submitRound int // rounds since the header was attested
 }
 
 // NewBlockQueue creates a new queue for blocks with receipts
@@ -32,6 +57,7 @@ func NewBlockQueue(recorder cosmosclient.CosmosMessageClient) *BridgeQueue {
 		minBlocksBeforeProcessing: 6,
 		recorder:                  recorder,
 		processCh:                 make(chan struct{}, 1), // Buffered channel to prevent blocking
+		awaitingProof:             make(map[string]*provenBlock),
 	}
 
 	// Start the queue processor
@@ -89,9 +115,13 @@ func (q *BridgeQueue) GetPendingBlocks() []BridgeBlock {
 func (q *BridgeQueue) init() {
 	ticker := time.NewTicker(5 * time.Minute) // Process every 5 minutes regardless
 	defer ticker.Stop()
+	proofTicker := time.NewTicker(proofRetryInterval)
+	defer proofTicker.Stop()
 
 	for {
 		select {
+		case <-proofTicker.C:
+			q.submitReceiptProofs()
 		case <-ticker.C:
 			slog.Info("Bridge queue: Processing blocks due to timeout")
 			q.processBlocks()
@@ -118,12 +148,176 @@ func (q *BridgeQueue) processBlocks() {
 			"receiptsRoot", block.ReceiptsRoot,
 			"receiptsCount", len(block.Receipts))
 
+		q.attestBlock(block)
+
+		// Deposits with receipt proofs are minted once the block header is attested,
+		// the rest still need a validator majority
+		if proofs, ok := buildReceiptProofs(block); ok {
+			q.awaitingProof[block.OriginChain+"/"+block.BlockNumber] = &provenBlock{block: block, proofs: proofs}
+			continue
+		}
+
 		// Process each receipt in the block
 		for _, receipt := range block.Receipts {
 			// Process the receipt with block information
 			q.processReceipt(receipt, block)
 		}
 	}
+	q.submitReceiptProofs()
+}
+
+// attestBlock submits this validator's view of the block's receiptsRoot
+func (q *BridgeQueue) attestBlock(block BridgeBlock) {
+	msg := &types.MsgAttestBridgeBlock{
+		Validator:    q.recorder.GetAccountAddress(),
+		OriginChain:  block.OriginChain,
+		BlockNumber:  block.BlockNumber,
+		ReceiptsRoot: strings.ToLower(block.ReceiptsRoot),
+	}
+	if err := q.recorder.AttestBridgeBlock(msg); err != nil {
+		slog.Error("Error attesting bridge block",
+			"error", err,
+			"blockNumber", block.BlockNumber,
+			"originChain", block.OriginChain)
+	}
+}
+
+// buildReceiptProofs decodes the proofs of all receipts of the block. It returns false when a
+// receipt comes without a proof.
+func buildReceiptProofs(block BridgeBlock) ([]types.ReceiptProof, bool) {
+	if len(block.Receipts) == 0 {
+		return nil, false
+	}
+
+	proofs := make([]types.ReceiptProof, 0, len(block.Receipts))
+	for _, receipt := range block.Receipts {
+		if receipt.Receipt == "" || len(receipt.Proof) == 0 {
+			return nil, false
+		}
+		receiptBytes, err := decodeHex(receipt.Receipt)
+		if err != nil {
+			slog.Error("Invalid receipt encoding", "error", err, "blockNumber", block.BlockNumber, "receiptIndex", receipt.ReceiptIndex)
+			return nil, false
+		}
+		proof := make([][]byte, 0, len(receipt.Proof))
+		for _, node := range receipt.Proof {
+			nodeBytes, err := decodeHex(node)
+			if err != nil {
+				slog.Error("Invalid proof node encoding", "error", err, "blockNumber", block.BlockNumber, "receiptIndex", receipt.ReceiptIndex)
+				return nil, false
+			}
+			proof = append(proof, nodeBytes)
+		}
+		proofs = append(proofs, types.ReceiptProof{
+			ReceiptIndex: receipt.ReceiptIndex,
+			Receipt:      receiptBytes,
+			Proof:        proof,
+		})
+	}
+	return proofs, true
+}
+
+// submitReceiptProofs moves every block waiting to have its deposits proven one round forward
+func (q *BridgeQueue) submitReceiptProofs() {
+	for key, pending := range q.awaitingProof {
+		if q.submitBlockProofs(pending) {
+			delete(q.awaitingProof, key)
+		}
+	}
+}
+
+// submitBlockProofs submits the deposits of a block once its header is attested, and reports
+// whether the block is done with. The first validator that attested the header submits them, the
+// others only step in when the deposits are still unproven fallbackSubmitRounds later. A block
+// whose header never reaches a majority falls back to the per-receipt majority.
+func (q *BridgeQueue) submitBlockProofs(pending *provenBlock) bool {
+	block := pending.block
+	queryClient := q.recorder.NewInferenceQueryClient()
+	response, err := queryClient.BridgeBlockHeader(q.recorder.GetContext(), &types.QueryGetBridgeBlockHeaderRequest{
+		OriginChain: block.OriginChain,
+		BlockNumber: block.BlockNumber,
+	})
+	if err != nil {
+		pending.waitRounds++
+		if pending.waitRounds < maxHeaderWaitRounds {
+			return false
+		}
+		slog.Warn("Bridge block was not attested by a majority, confirming its receipts by majority",
+			"blockNumber", block.BlockNumber,
+			"originChain", block.OriginChain,
+			"error", err)
+		for _, receipt := range block.Receipts {
+			q.processReceipt(receipt, block)
+		}
+		return true
+	}
+	header := response.Header
+	if !strings.EqualFold(header.ReceiptsRoot, block.ReceiptsRoot) {
+		slog.Error("Bridge block was attested with a different receipts root, its proofs can't be verified",
+			"blockNumber", block.BlockNumber,
+			"originChain", block.OriginChain,
+			"attestedRoot", header.ReceiptsRoot,
+			"receiptsRoot", block.ReceiptsRoot)
+		return true
+	}
+
+	pending.submitRound++
+	unproven := q.unprovenReceipts(block, pending.proofs)
+	if len(unproven) == 0 {
+		return true
+	}
+	if pending.submitRound > maxSubmitRounds {
+		slog.Error("Giving up on bridge deposit proofs",
+			"blockNumber", block.BlockNumber,
+			"originChain", block.OriginChain,
+			"unproven", len(unproven))
+		return true
+	}
+	designated := len(header.Validators) > 0 && header.Validators[0] == q.recorder.GetAccountAddress()
+	if !designated && pending.submitRound <= fallbackSubmitRounds {
+		return false
+	}
+
+	// The chain bounds the number of receipts per message
+	for start := 0; start < len(unproven); start += types.MaxReceiptsPerDepositProof {
+		end := min(start+types.MaxReceiptsPerDepositProof, len(unproven))
+		msg := &types.MsgBridgeDepositProof{
+			Creator:     q.recorder.GetAccountAddress(),
+			OriginChain: block.OriginChain,
+			BlockNumber: block.BlockNumber,
+			Receipts:    unproven[start:end],
+		}
+		if err := q.recorder.BridgeDepositProof(msg); err != nil {
+			slog.Error("Error submitting bridge deposit proof",
+				"error", err,
+				"blockNumber", block.BlockNumber,
+				"receiptsCount", len(msg.Receipts))
+		}
+	}
+	// Kept until the chain shows the deposits as completed, so a failed submission is retried
+	return false
+}
+
+// unprovenReceipts returns the proofs of the deposits the chain hasn't completed yet
+func (q *BridgeQueue) unprovenReceipts(block BridgeBlock, proofs []types.ReceiptProof) []types.ReceiptProof {
+	queryClient := q.recorder.NewInferenceQueryClient()
+	unproven := make([]types.ReceiptProof, 0, len(proofs))
+	for _, proof := range proofs {
+		response, err := queryClient.BridgeTransaction(q.recorder.GetContext(), &types.QueryGetBridgeTransactionRequest{
+			OriginChain:  block.OriginChain,
+			BlockNumber:  block.BlockNumber,
+			ReceiptIndex: proof.ReceiptIndex,
+		})
+		if err == nil && response.BridgeTransaction.Status == types.BridgeTransactionStatus_BRIDGE_COMPLETED {
+			continue
+		}
+		unproven = append(unproven, proof)
+	}
+	return unproven
+}
+
+func decodeHex(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X"))
 }
 
 // getNextBlock retrieves and removes the earliest block from the queue
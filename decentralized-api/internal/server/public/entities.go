@@ -1,6 +1,8 @@
 package public
 
 import (
+	"decentralized-api/utils"
+	"encoding/json"
 	"net/http"
 
 	cryptotypes "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -16,9 +18,30 @@ type ChatRequest struct {
 	Seed              string
 	InferenceId       string
 	RequesterAddress  string // address of participant, who signed inference request
+	SubKey            string // developer sub-key that signed instead of the requester's account key
 	TransferAddress   string
 	Timestamp         int64  // timestamp of the request
 	TransferSignature string // signature of the transfer address
+	Kind              types.InferenceKind
+	// PayloadOffChain is set by the X-Payload-Off-Chain header. The developer then signs the hash
+	// of the body instead of the body, and the prompt and response are kept off-chain.
+	PayloadOffChain bool
+}
+
+// signedPayload is what the developer, transfer agent and executor signatures cover
+func (r *ChatRequest) signedPayload() string {
+	if r.PayloadOffChain {
+		return utils.GenerateSHA256Hash(string(r.Body))
+	}
+	return string(r.Body)
+}
+
+// maxTokens is the completion budget reserved for the request. Embeddings generate no tokens.
+func (r *ChatRequest) maxTokens() int {
+	if r.Kind == types.InferenceKind_EMBEDDING {
+		return 0
+	}
+	return int(r.OpenAiRequest.MaxTokens)
 }
 
 type OpenAiRequest struct {
@@ -26,7 +49,43 @@ type OpenAiRequest struct {
 	Seed                int32     `json:"seed"`
 	MaxTokens           int32     `json:"max_tokens"`
 	MaxCompletionTokens int32     `json:"max_completion_tokens"`
+	Stream              bool      `json:"stream"`
 	Messages            []Message `json:"messages"`
+	// Prompt is set by /v1/completions and Input by /v1/embeddings. Both are either
+	// a string or an array of strings (token id arrays aren't counted).
+	Prompt json.RawMessage `json:"prompt"`
+	Input  json.RawMessage `json:"input"`
+}
+
+// PromptText returns the text the prompt tokens are counted from, whichever endpoint
+// the request was made for.
+func (r *OpenAiRequest) PromptText() string {
+	promptText := ""
+	for _, message := range r.Messages {
+		promptText += message.Content + "\n"
+	}
+	for _, text := range rawTextInputs(r.Prompt) {
+		promptText += text + "\n"
+	}
+	for _, text := range rawTextInputs(r.Input) {
+		promptText += text + "\n"
+	}
+	return promptText
+}
+
+func rawTextInputs(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return []string{text}
+	}
+	var texts []string
+	if err := json.Unmarshal(raw, &texts); err == nil {
+		return texts
+	}
+	return nil
 }
 
 type Message struct {
@@ -70,6 +129,8 @@ type ParticipantsDto struct {
 type StartTrainingDto struct {
 	HardwareResources []HardwareResourcesDto `json:"hardware_resources"`
 	Config            TrainingConfigDto      `json:"config"`
+	Budget            uint64                 `json:"budget"`
+	OuterStepPayout   uint64                 `json:"outer_step_payout"`
 }
 
 type HardwareResourcesDto struct {
@@ -148,4 +209,8 @@ type BridgeReceipt struct {
 	OwnerPubKey     string `json:"publicKey"`    // Public key of the token owner on the origin chain
 	Amount          string `json:"amount"`       // Amount of tokens to be bridged
 	ReceiptIndex    string `json:"receiptIndex"` // Index of the transaction receipt in the block
+	// Optional proof of the receipt against the block's receiptsRoot. When every receipt of a block
+	// carries one, the deposits are proven on chain instead of being confirmed by a validator majority
+	Receipt string   `json:"receipt,omitempty"` // 0x-prefixed hex of the consensus encoded receipt
+	Proof   []string `json:"proof,omitempty"`   // 0x-prefixed hex trie nodes, from the root to the receipt
 }
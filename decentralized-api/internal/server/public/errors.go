@@ -9,6 +9,7 @@ var (
 	ErrRequestAuth                  = echo.NewHTTPError(http.StatusUnauthorized, "Authorization is required")
 	ErrInferenceParticipantNotFound = echo.NewHTTPError(http.StatusNotFound, "Inference participant not found")
 	ErrInsufficientBalance          = echo.NewHTTPError(http.StatusPaymentRequired, "Insufficient balance")
+	ErrSubKeyBudgetExceeded         = echo.NewHTTPError(http.StatusPaymentRequired, "Developer sub-key spending limit for this epoch reached")
 
 	ErrIdRequired           = echo.NewHTTPError(http.StatusBadRequest, "Id is required")
 	ErrAddressRequired      = echo.NewHTTPError(http.StatusBadRequest, "Address is required")
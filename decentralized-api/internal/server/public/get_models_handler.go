@@ -1,6 +1,9 @@
 package public
 
 import (
+	"context"
+	"decentralized-api/internal/tokenizer"
+	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -55,3 +58,18 @@ func (s *Server) getGovernanceModels(ctx echo.Context) error {
 		Models: modelsResponse.Model,
 	})
 }
+
+func (s *Server) getGovernanceModel(ctx context.Context, modelId string) (*types.Model, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	modelsResponse, err := queryClient.ModelsAll(ctx, &types.QueryModelsAllRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range modelsResponse.Model {
+		if modelsResponse.Model[i].Id == modelId {
+			return &modelsResponse.Model[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", tokenizer.ErrModelNotFound, modelId)
+}
@@ -0,0 +1,175 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/internal/payloads"
+	"decentralized-api/internal/validation"
+	"decentralized-api/logging"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxReportFetches bounds how many validators are asked for their reports at once
+	maxReportFetches = 4
+	// reportFetchTimeout bounds the time spent fetching all reports of one inference
+	reportFetchTimeout = 20 * time.Second
+)
+
+var errReportLocatorMismatch = errors.New("report locator does not match the validator's registered URL")
+
+type ValidationReportResponse struct {
+	InferenceId string                  `json:"inference_id"`
+	Status      string                  `json:"status"`
+	ExecutedBy  string                  `json:"executed_by"`
+	Validations []ValidationReportEntry `json:"validations"`
+}
+
+// ValidationReportEntry is one validation of the inference: the diagnostics recorded on chain
+// and the report the validator kept off-chain, if it could be fetched.
+type ValidationReportEntry struct {
+	Validator           string             `json:"validator"`
+	Strategy            string             `json:"strategy"`
+	Value               float64            `json:"value"`
+	Passed              bool               `json:"passed"`
+	ComparedTokens      uint32             `json:"compared_tokens"`
+	MismatchedTokens    uint32             `json:"mismatched_tokens"`
+	MaxPositionDistance float64            `json:"max_position_distance"`
+	Reason              string             `json:"reason,omitempty"`
+	ReportHash          string             `json:"report_hash,omitempty"`
+	Report              *validation.Report `json:"report,omitempty"`
+	ReportError         string             `json:"report_error,omitempty"`
+}
+
+// getValidationReport explains the validations of an inference, so operators can debug an
+// invalidation before it escalates to a vote. Reports are fetched from the validators that keep them,
+// so only participants of the current epoch may ask, by signing the inference ID.
+func (s *Server) getValidationReport(ctx echo.Context) error {
+	encodedId := ctx.Param("id")
+	if encodedId == "" {
+		return ErrIdRequired
+	}
+	id, err := url.QueryUnescape(encodedId)
+	if err != nil {
+		logging.Error("Failed to decode inference ID", types.Validation, "encodedId", encodedId, "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid inference ID")
+	}
+	if err := s.authenticateParticipant(ctx, id); err != nil {
+		return err
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	inferenceResponse, err := queryClient.Inference(ctx.Request().Context(), &types.QueryGetInferenceRequest{Index: id})
+	if err != nil {
+		if grpcStatus, ok := status.FromError(err); ok && grpcStatus.Code() == codes.NotFound {
+			return ErrInferenceNotFound
+		}
+		logging.Error("Failed to get inference", types.Validation, "id", id, "error", err)
+		return err
+	}
+	inference := inferenceResponse.Inference
+
+	response := ValidationReportResponse{
+		InferenceId: inference.InferenceId,
+		Status:      inference.Status.String(),
+		ExecutedBy:  inference.ExecutedBy,
+		Validations: []ValidationReportEntry{},
+	}
+	detailsResponse, err := queryClient.InferenceValidationDetails(ctx.Request().Context(), &types.QueryGetInferenceValidationDetailsRequest{
+		EpochId:     inference.EpochId,
+		InferenceId: inference.InferenceId,
+	})
+	if err != nil {
+		if grpcStatus, ok := status.FromError(err); ok && grpcStatus.Code() == codes.NotFound {
+			// Not finished yet, or sampled so long ago the details were pruned
+			return ctx.JSON(http.StatusOK, response)
+		}
+		logging.Error("Failed to get inference validation details", types.Validation, "id", id, "error", err)
+		return err
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx.Request().Context(), reportFetchTimeout)
+	defer cancel()
+	semaphore := make(chan struct{}, maxReportFetches)
+	var wg sync.WaitGroup
+	validations := detailsResponse.InferenceValidationDetails.Validations
+	response.Validations = make([]ValidationReportEntry, len(validations))
+	for i, diagnostics := range validations {
+		response.Validations[i] = ValidationReportEntry{
+			Validator:           diagnostics.Validator,
+			Strategy:            diagnostics.Strategy.String(),
+			Value:               diagnostics.Value,
+			Passed:              diagnostics.Passed,
+			ComparedTokens:      diagnostics.ComparedTokens,
+			MismatchedTokens:    diagnostics.MismatchedTokens,
+			MaxPositionDistance: diagnostics.MaxPositionDistance,
+			Reason:              diagnostics.Reason,
+			ReportHash:          diagnostics.ReportHash,
+		}
+		if diagnostics.ReportHash == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(entry *ValidationReportEntry, diagnostics *types.ValidationDiagnostics) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-fetchCtx.Done():
+				entry.ReportError = fetchCtx.Err().Error()
+				return
+			}
+			report, err := s.fetchValidationReport(fetchCtx, queryClient, diagnostics)
+			if err != nil {
+				logging.Warn("Failed to fetch validation report", types.Validation, "id", id, "validator", diagnostics.Validator, "error", err)
+				entry.ReportError = err.Error()
+				return
+			}
+			entry.Report = report
+		}(&response.Validations[i], diagnostics)
+	}
+	wg.Wait()
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// fetchValidationReport reads the report from this node's store when it was the validator,
+// otherwise from the validator's, checked against the hash on chain either way. Reports are only
+// fetched from the URL the validator registered, never from an arbitrary locator.
+func (s *Server) fetchValidationReport(ctx context.Context, queryClient types.QueryClient, diagnostics *types.ValidationDiagnostics) (*validation.Report, error) {
+	reportBytes, err := s.payloads.Get(diagnostics.ReportHash)
+	if errors.Is(err, payloads.ErrNotFound) {
+		participantResponse, queryErr := queryClient.Participant(ctx, &types.QueryGetParticipantRequest{Index: diagnostics.Validator})
+		if queryErr != nil {
+			return nil, queryErr
+		}
+		locator := payloads.Locator(participantResponse.Participant.InferenceUrl)
+		if diagnostics.ReportLocator != locator {
+			return nil, errReportLocatorMismatch
+		}
+		reportBytes, err = payloads.Fetch(
+			ctx,
+			locator,
+			diagnostics.ReportHash,
+			s.recorder.GetAccountAddress(),
+			validation.PayloadSigner(s.recorder),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var report validation.Report
+	if err := json.Unmarshal(reportBytes, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
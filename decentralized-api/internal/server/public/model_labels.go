@@ -0,0 +1,88 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"decentralized-api/metrics"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	modelLabelsRefreshInterval = time.Minute
+	modelLabelsFetchTimeout    = 10 * time.Second
+)
+
+// modelLabels maps client-supplied model names to metric labels. Only models registered on
+// chain get their own label, so callers can't create new metric series at will.
+type modelLabels struct {
+	mu        sync.Mutex
+	known     map[string]bool
+	fetchedAt time.Time
+	fetch     func(ctx context.Context) ([]string, error)
+}
+
+func newModelLabels(fetch func(ctx context.Context) ([]string, error)) *modelLabels {
+	return &modelLabels{fetch: fetch}
+}
+
+func (m *modelLabels) label(model string) string {
+	if m.isKnown(model) {
+		return model
+	}
+	return metrics.UnknownModel
+}
+
+// isKnown reports whether the model is registered on chain, refreshing the set if it is stale.
+func (m *modelLabels) isKnown(model string) bool {
+	m.refreshIfStale()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.known[model]
+}
+
+// refreshIfStale fetches the models without holding the lock, so requests don't queue behind a slow
+// query. The fetch isn't tied to any request, whose cancellation would otherwise fail the refresh.
+func (m *modelLabels) refreshIfStale() {
+	m.mu.Lock()
+	if time.Since(m.fetchedAt) < modelLabelsRefreshInterval {
+		m.mu.Unlock()
+		return
+	}
+	// Only one caller refreshes, a failed refresh keeps the previous set and is retried after the interval
+	m.fetchedAt = time.Now()
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), modelLabelsFetchTimeout)
+	defer cancel()
+	ids, err := m.fetch(ctx)
+	if err != nil {
+		logging.Warn("Failed to refresh models for metric labels", types.Server, "error", err)
+		return
+	}
+	known := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		known[id] = true
+	}
+
+	m.mu.Lock()
+	m.known = known
+	m.mu.Unlock()
+}
+
+func (s *Server) getGovernanceModelIds(ctx context.Context) ([]string, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	modelsResponse, err := queryClient.ModelsAll(ctx, &types.QueryModelsAllRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(modelsResponse.Model))
+	for i, model := range modelsResponse.Model {
+		ids[i] = model.Id
+	}
+	return ids, nil
+}
@@ -0,0 +1,124 @@
+package public
+
+import (
+	"decentralized-api/internal/payloads"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getPayload serves prompts and responses of inferences whose payloads are kept off-chain.
+// Only participants of the current epoch may fetch them, by signing the hash with their
+// account key or a grantee.
+func (s *Server) getPayload(ctx echo.Context) error {
+	hash := ctx.Param("hash")
+	if err := s.authenticateParticipant(ctx, hash); err != nil {
+		return err
+	}
+
+	payload, err := s.payloads.Get(hash)
+	if errors.Is(err, payloads.ErrInvalidHash) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if errors.Is(err, payloads.ErrNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		logging.Error("Failed to read payload", types.Inferences, "hash", hash, "error", err)
+		return err
+	}
+	return ctx.Blob(http.StatusOK, echo.MIMEOctetStream, payload)
+}
+
+// authenticateParticipant checks that the request was signed over value, at a recent timestamp,
+// by a participant of the current epoch or one of its grantees.
+func (s *Server) authenticateParticipant(ctx echo.Context, value string) error {
+	requesterAddress := ctx.Request().Header.Get(utils.XRequesterAddressHeader)
+	signature := ctx.Request().Header.Get(utils.AuthorizationHeader)
+	if requesterAddress == "" || signature == "" {
+		return ErrRequestAuth
+	}
+	timestamp, err := strconv.ParseInt(ctx.Request().Header.Get(utils.XTimestampHeader), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid timestamp")
+	}
+	if err := s.validatePayloadTimestamp(timestamp); err != nil {
+		return err
+	}
+
+	if !s.isCurrentEpochParticipant(requesterAddress) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Requester is not a participant of the current epoch")
+	}
+	pubkeys, err := s.getAllowedPubKeys(ctx, requesterAddress)
+	if err != nil {
+		logging.Warn("Unable to get pubkeys of requester", types.Inferences, "address", requesterAddress, "error", err)
+		return echo.NewHTTPError(http.StatusUnauthorized, "Requester is not a participant")
+	}
+	components := payloads.RequestComponents(value, timestamp, requesterAddress)
+	if err := calculations.ValidateSignatureWithGrantees(components, calculations.TransferAgent, pubkeys, signature); err != nil {
+		logging.Warn("Invalid request signature", types.Inferences, "address", requesterAddress, "error", err)
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unable to validate request against PubKey:"+err.Error())
+	}
+	return nil
+}
+
+func (s *Server) isCurrentEpochParticipant(address string) bool {
+	epochState := s.phaseTracker.GetCurrentEpochState()
+	if epochState == nil {
+		return false
+	}
+	epochGroupData, err := s.epochGroups.GetCurrentEpochGroupData(epochState.LatestEpoch.EpochIndex)
+	if err != nil {
+		logging.Warn("Unable to get current epoch group data", types.Inferences, "error", err)
+		return false
+	}
+	for _, weight := range epochGroupData.ValidationWeights {
+		if weight.MemberAddress == address {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) validatePayloadTimestamp(timestamp int64) error {
+	validationParams := s.configManager.GetValidationParams()
+	expiration := time.Duration(validationParams.TimestampExpiration) * time.Second
+	if expiration == 0 {
+		expiration = 10 * time.Second
+	}
+	advance := time.Duration(validationParams.TimestampAdvance) * time.Second
+	if advance == 0 {
+		advance = 10 * time.Second
+	}
+
+	offset := time.Since(time.Unix(0, timestamp))
+	if offset > expiration {
+		return echo.NewHTTPError(http.StatusBadRequest, "Request timestamp is too old")
+	}
+	if offset < -advance {
+		return echo.NewHTTPError(http.StatusBadRequest, "Request timestamp is in the future")
+	}
+	return nil
+}
+
+// storePromptPayload keeps the canonical prompt, which is what prompt_hash on chain covers
+func (s *Server) storePromptPayload(modifiedRequestBody []byte) error {
+	_, promptPayload, err := getPromptHash(modifiedRequestBody)
+	if err != nil {
+		return err
+	}
+	_, err = s.payloads.Put([]byte(promptPayload))
+	return err
+}
+
+// payloadLocator is where validators fetch the payloads of inferences executed by this node
+func (s *Server) payloadLocator() string {
+	return payloads.Locator(s.configManager.GetApiConfig().PublicUrl)
+}
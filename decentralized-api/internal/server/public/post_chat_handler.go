@@ -6,7 +6,11 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/completionapi"
+	"decentralized-api/internal"
+	"decentralized-api/internal/authkeys"
+	"decentralized-api/internal/tokenizer"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"decentralized-api/utils"
 	"encoding/json"
 	"fmt"
@@ -15,7 +19,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -28,144 +32,63 @@ import (
 	"github.com/productscience/inference/x/inference/types"
 )
 
-// AuthKeyContext represents the context in which an AuthKey was used
-type AuthKeyContext int
-
-const (
-	// TransferContext indicates the AuthKey was used for a transfer request
-	TransferContext AuthKeyContext = 1
-	// ExecutorContext indicates the AuthKey was used for an executor request
-	ExecutorContext AuthKeyContext = 2
-	// BothContexts indicates the AuthKey was used for both transfer and executor requests
-	BothContexts = TransferContext | ExecutorContext
-)
-
-// Package-level variables for AuthKey reuse prevention
-var (
-	// Map for O(1) lookup of existing AuthKeys and their contexts
-	usedAuthKeys = make(map[string]AuthKeyContext)
-
-	// Map for O(1) lookup of what to remove, organized by block height
-	authKeysByBlock = make(map[int64][]string)
-
-	// Track the oldest block height we're storing
-	oldestBlockHeight int64
-
-	// Mutex for thread safety
-	authKeysMutex sync.RWMutex
-
-	// Reference to the config manager for accessing validation parameters
-	configManagerRef *apiconfig.ConfigManager
-)
-
-// checkAndRecordAuthKey checks if an AuthKey has been used before and records it if not
-// Returns true if the key has been used before in the specified context, false otherwise
-func checkAndRecordAuthKey(authKey string, currentBlockHeight int64, context AuthKeyContext) bool {
-	authKeysMutex.RLock()
-	existingContext, exists := usedAuthKeys[authKey]
-	authKeysMutex.RUnlock()
-
-	if exists {
-		// If the key exists, check if it's been used in the current context
-		if existingContext&context != 0 {
-			return true // Key was used before in this context
-		}
-
-		// Key exists but hasn't been used in this context, update the context
-		authKeysMutex.Lock()
-		defer authKeysMutex.Unlock()
-
-		// Update the context to include the new context
-		usedAuthKeys[authKey] = existingContext | context
-		return false // Key wasn't used before in this context
-	}
-
-	// Key doesn't exist, add it with the current context
-	authKeysMutex.Lock()
-	defer authKeysMutex.Unlock()
-
-	usedAuthKeys[authKey] = context
-
-	authKeysByBlock[currentBlockHeight] = append(authKeysByBlock[currentBlockHeight], authKey)
-
-	if oldestBlockHeight == 0 {
-		oldestBlockHeight = currentBlockHeight
+// isAuthKeyReused records the AuthKey and fails if it has already been used in the context
+func (s *Server) isAuthKeyReused(authKey string, currentBlockHeight int64, context authkeys.Context) error {
+	reused, err := s.authKeys.CheckAndRecord(authKey, currentBlockHeight, context)
+	if err != nil {
+		logging.Error("Failed to check AuthKey reuse", types.Inferences, "error", err)
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Unable to verify AuthKey")
 	}
-
-	cleanupExpiredAuthKeys(currentBlockHeight)
-
-	return false // Key wasn't used before
-}
-
-// cleanupExpiredAuthKeys removes auth keys from block heights based on timestamp_expiration parameter
-func cleanupExpiredAuthKeys(currentBlockHeight int64) {
-	// Default expiration is 4 blocks if configManager is not set
-	expirationBlocks := int64(4)
-
-	// If configManager is available, use twice the timestamp_expiration value
-	if configManagerRef != nil {
-		validationParams := configManagerRef.GetValidationParams()
-		timestampExpiration := validationParams.TimestampExpiration
-
-		// Use default value if parameter is not set
-		if timestampExpiration == 0 {
-			timestampExpiration = 10 // Default 10 seconds
-		}
-
-		// Use twice the timestamp_expiration value (converted to blocks)
-		// Assuming average block time of 5 seconds
-		expirationBlocks = (timestampExpiration * 2) / 4
-
-		// Ensure we keep at least 4 blocks for safety
-		if expirationBlocks < 4 {
-			expirationBlocks = 4
+	if reused {
+		logging.Warn("AuthKey reuse detected", types.Inferences, "authKey", authKey, "context", context)
+		if context == authkeys.TransferContext {
+			return echo.NewHTTPError(http.StatusBadRequest, "AuthKey has already been used for a transfer request")
 		}
-
-		logging.Debug("Auth key expiration", types.Inferences,
-			"timestampExpiration", timestampExpiration,
-			"expirationBlocks", expirationBlocks)
+		return echo.NewHTTPError(http.StatusBadRequest, "AuthKey has already been used for an executor request")
 	}
+	return nil
+}
 
-	expirationHeight := currentBlockHeight - expirationBlocks
-
-	for height := oldestBlockHeight; height < expirationHeight; height++ {
-		keys, exists := authKeysByBlock[height]
-		if !exists {
-			continue
-		}
-
-		for _, key := range keys {
-			delete(usedAuthKeys, key)
-		}
+func (s *Server) postChat(ctx echo.Context) error {
+	return s.postInference(ctx, types.InferenceKind_CHAT_COMPLETION)
+}
 
-		delete(authKeysByBlock, height)
-	}
+func (s *Server) postCompletions(ctx echo.Context) error {
+	return s.postInference(ctx, types.InferenceKind_TEXT_COMPLETION)
+}
 
-	if oldestBlockHeight < expirationHeight {
-		oldestBlockHeight = expirationHeight
-	}
+func (s *Server) postEmbeddings(ctx echo.Context) error {
+	return s.postInference(ctx, types.InferenceKind_EMBEDDING)
 }
 
-func (s *Server) postChat(ctx echo.Context) error {
-	logging.Debug("PostChat. Received request", types.Inferences, "path", ctx.Request().URL.Path)
+// postInference handles every OpenAI-compatible inference endpoint. The kind comes from
+// the route and is carried through the transfer and executor hops, and onto the chain.
+func (s *Server) postInference(ctx echo.Context, kind types.InferenceKind) error {
+	logging.Debug("PostInference. Received request", types.Inferences, "path", ctx.Request().URL.Path, "kind", kind)
 
 	chatRequest, err := readRequest(ctx.Request(), s.recorder.GetAccountAddress())
 	if err != nil {
 		return err
 	}
+	chatRequest.Kind = kind
 
 	if chatRequest.AuthKey == "" {
 		logging.Warn("Request without authorization", types.Server, "path", ctx.Request().URL.Path)
 		return ErrRequestAuth
 	}
 
+	start := time.Now()
+	modelLabel := s.modelLabels.label(chatRequest.OpenAiRequest.Model)
 	if chatRequest.InferenceId != "" && chatRequest.Seed != "" {
 		logging.Info("Executor request", types.Inferences, "inferenceId", chatRequest.InferenceId, "seed", chatRequest.Seed)
-		return s.handleExecutorRequest(ctx, chatRequest, ctx.Response().Writer)
+		err = s.handleExecutorRequest(ctx, chatRequest, ctx.Response().Writer)
+		metrics.ObserveInference(modelLabel, "executor", start, err)
 	} else {
 		logging.Info("Transfer request", types.Inferences, "requesterAddress", chatRequest.RequesterAddress)
-		return s.handleTransferRequest(ctx, chatRequest)
+		err = s.handleTransferRequest(ctx, chatRequest)
+		metrics.ObserveInference(modelLabel, "transfer", start, err)
 	}
+	return err
 }
 
 func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) error {
@@ -178,10 +101,7 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		return err
 	}
 
-	promptText := ""
-	for _, message := range request.OpenAiRequest.Messages {
-		promptText += message.Content + "\n"
-	}
+	promptText := request.OpenAiRequest.PromptText()
 
 	promptTokenCount, err := s.getPromptTokenEstimation(promptText, request.OpenAiRequest.Model)
 
@@ -202,12 +122,12 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		return err
 	}
 
-	if err := validateRequest(request, status, s.configManager); err != nil {
+	if err := s.validateRequest(request, status); err != nil {
 		return err
 	}
 
 	requestBlockHeight := status.SyncInfo.LatestBlockHeight
-	can, estimatedKB := s.bandwidthLimiter.CanAcceptRequest(requestBlockHeight, int(promptTokenCount), int(request.OpenAiRequest.MaxTokens))
+	can, estimatedKB := s.bandwidthLimiter.CanAcceptRequest(requestBlockHeight, int(promptTokenCount), request.maxTokens())
 	if !can {
 		logging.Warn("Bandwidth limit exceeded", types.Inferences, "address", request.RequesterAddress)
 		url := s.configManager.GetApiConfig().PublicUrl
@@ -217,21 +137,178 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 	s.bandwidthLimiter.RecordRequest(requestBlockHeight, estimatedKB)
 	defer s.bandwidthLimiter.ReleaseRequest(requestBlockHeight, estimatedKB)
 
-	executor, err := s.getExecutorForRequest(ctx.Request().Context(), request.OpenAiRequest.Model)
-	if err != nil {
-		logging.Error("Failed to get executor", types.Inferences, "error", err)
-		return err
+	maxAttempts, responseTimeout := s.executorRetryBudget()
+	// Executors reject the request once its timestamp is older than the expiration window, so
+	// there is no point in handing it to another executor after that.
+	timestampExpiration := time.Duration(s.configManager.GetValidationParams().TimestampExpiration) * time.Second
+	if timestampExpiration == 0 {
+		timestampExpiration = 10 * time.Second
+	}
+	forwarding := &executorForwarding{
+		inferenceId:       request.AuthKey,
+		maxAttempts:       maxAttempts,
+		responseTimeout:   responseTimeout,
+		timestampDeadline: time.Unix(0, request.Timestamp).Add(timestampExpiration),
+		localUrl:          s.configManager.GetApiConfig().PublicUrl,
+		stats:             s.executorStats,
+		pickExecutor: func(exclude []string) (*ExecutorDestination, error) {
+			return s.getExecutorForRequest(ctx.Request().Context(), request.OpenAiRequest.Model, exclude)
+		},
+		start: func(executor *ExecutorDestination) (*executorAttempt, error) {
+			seed := rand.Int31()
+			inferenceRequest, err := createInferenceStartRequest(s, request, seed, request.AuthKey, executor, s.configManager.GetCurrentNodeVersion(), promptTokenCount)
+			if err != nil {
+				logging.Error("Failed to create inference start request", types.Inferences, "error", err)
+				return nil, err
+			}
+			return &executorAttempt{
+				executor:          executor,
+				seed:              seed,
+				transferSignature: inferenceRequest.TransferSignature,
+				startSubmitted:    s.submitStartInference(request, inferenceRequest),
+			}, nil
+		},
+		abandon: func(reason string) {
+			s.abandonInference(request.AuthKey, reason)
+		},
+		send: func(reqCtx context.Context, attempt *executorAttempt, timeout time.Duration) (*http.Response, context.CancelFunc, error) {
+			return s.sendToExecutor(reqCtx, request, attempt.executor, attempt.seed, attempt.transferSignature, timeout)
+		},
+		serveLocally: func(attempt *executorAttempt) error {
+			request.InferenceId = request.AuthKey
+			request.Seed = strconv.Itoa(int(attempt.seed))
+			request.TransferAddress = s.recorder.GetAccountAddress()
+			request.TransferSignature = attempt.transferSignature
+
+			logging.Info("Execute request on same node, fill request with extra data", types.Inferences, "inferenceId", request.InferenceId, "seed", request.Seed)
+			return s.handleExecutorRequest(ctx, request, ctx.Response().Writer)
+		},
+	}
+	return forwarding.forward(ctx.Request().Context(), ctx.Response().Writer)
+}
+
+// executorAttempt is one hand-off of a transfer request to an executor, with MsgStartInference
+// already on its way to the chain.
+type executorAttempt struct {
+	executor          *ExecutorDestination
+	seed              int32
+	transferSignature string
+	startSubmitted    <-chan struct{}
+}
+
+// executorForwarding hands a transfer request to executors until one responds. The chain-facing
+// steps are plain functions so the retry policy can run without a node behind it.
+type executorForwarding struct {
+	inferenceId       string
+	maxAttempts       int
+	responseTimeout   time.Duration
+	timestampDeadline time.Time
+	localUrl          string
+	stats             *internal.ExecutorStats
+
+	pickExecutor func(exclude []string) (*ExecutorDestination, error)
+	start        func(executor *ExecutorDestination) (*executorAttempt, error)
+	abandon      func(reason string)
+	send         func(ctx context.Context, attempt *executorAttempt, timeout time.Duration) (*http.Response, context.CancelFunc, error)
+	serveLocally func(attempt *executorAttempt) error
+}
+
+func (f *executorForwarding) forward(ctx context.Context, w http.ResponseWriter) error {
+	var excluded []string
+	for attempt := 1; ; attempt++ {
+		executor, err := f.pickExecutor(excluded)
+		if err != nil {
+			logging.Error("Failed to get executor", types.Inferences, "error", err, "attempt", attempt)
+			return err
+		}
+
+		started, err := f.start(executor)
+		if err != nil {
+			return err
+		}
+
+		// It's important here to send the ORIGINAL body, not the finalRequest body. The executor will AGAIN go through
+		// the same process to create the same final request body
+		logging.Debug("Sending request to executor", types.Inferences, "url", executor.Url, "seed", started.seed, "inferenceId", f.inferenceId, "attempt", attempt)
+
+		if f.localUrl == executor.Url {
+			// node found itself as executor
+			return f.serveLocally(started)
+		}
+
+		sentAt := time.Now()
+		resp, cancel, err := f.send(ctx, started, f.responseTimeout)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			// A 4xx is passed on to the client as is, but still counts against the executor
+			if resp.StatusCode >= http.StatusBadRequest {
+				f.stats.RecordFailure(executor.Address, time.Since(sentAt))
+			} else {
+				f.stats.RecordSuccess(executor.Address, time.Since(sentAt))
+			}
+			defer cancel()
+			defer resp.Body.Close()
+			logging.Info("Proxying response from executor", types.Inferences,
+				"inferenceId", f.inferenceId,
+				"executor", executor.Address)
+			proxyResponse(resp, w, false, nil, f.inferenceId, nil)
+			return nil
+		}
+
+		// Nothing has been streamed to the client yet, so the attempt can be released on chain
+		// and the request handed to another executor.
+		f.stats.RecordFailure(executor.Address, time.Since(sentAt))
+		reason := executorFailureReason(resp, err)
+		logging.Warn("Executor failed before responding", types.Inferences,
+			"inferenceId", f.inferenceId,
+			"executor", executor.Address,
+			"url", executor.Url,
+			"attempt", attempt,
+			"reason", reason)
+		<-started.startSubmitted
+		f.abandon(reason)
+		excluded = append(excluded, executor.Address)
+
+		// A client that went away or a timestamp the next executor would reject ends the retries
+		if attempt >= f.maxAttempts || ctx.Err() != nil || !time.Now().Before(f.timestampDeadline) {
+			if err != nil {
+				return err
+			}
+			defer cancel()
+			defer resp.Body.Close()
+			proxyResponse(resp, w, false, nil, f.inferenceId, nil)
+			return nil
+		}
+		if resp != nil {
+			resp.Body.Close()
+			cancel()
+		}
 	}
+}
 
-	seed := rand.Int31()
-	inferenceUUID := request.AuthKey
-	inferenceRequest, err := createInferenceStartRequest(s, request, seed, request.AuthKey, executor, s.configManager.GetCurrentNodeVersion(), promptTokenCount)
-	if err != nil {
-		logging.Error("Failed to create inference start request", types.Inferences, "error", err)
-		return err
+const (
+	defaultExecutorMaxAttempts     = 3
+	defaultExecutorResponseTimeout = 60 * time.Second
+)
+
+func (s *Server) executorRetryBudget() (int, time.Duration) {
+	retryConfig := s.configManager.GetExecutorRetryConfig()
+	maxAttempts := retryConfig.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultExecutorMaxAttempts
+	}
+	responseTimeout := time.Duration(retryConfig.ResponseTimeoutSeconds) * time.Second
+	if responseTimeout <= 0 {
+		responseTimeout = defaultExecutorResponseTimeout
 	}
+	return maxAttempts, responseTimeout
+}
 
+// submitStartInference sends MsgStartInference in the background. The returned channel is closed
+// once the message is queued, so an abandon for the same attempt is never sent ahead of it.
+func (s *Server) submitStartInference(request *ChatRequest, inferenceRequest *inference.MsgStartInference) <-chan struct{} {
+	submitted := make(chan struct{})
 	go func() {
+		defer close(submitted)
 		logging.Debug("Starting inference", types.Inferences, "id", inferenceRequest.InferenceId)
 		if s.configManager.GetApiConfig().TestMode && request.OpenAiRequest.Seed == 8675309 {
 			time.Sleep(10 * time.Second)
@@ -243,63 +320,97 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 			logging.Debug("Submitted MsgStartInference", types.Inferences, "id", inferenceRequest.InferenceId)
 		}
 	}()
+	return submitted
+}
 
-	// It's important here to send the ORIGINAL body, not the finalRequest body. The executor will AGAIN go through
-	// the same process to create the same final request body
-	logging.Debug("Sending request to executor", types.Inferences, "url", executor.Url, "seed", seed, "inferenceId", inferenceUUID)
-
-	if s.configManager.GetApiConfig().PublicUrl == executor.Url {
-		// node found itself as executor
-
-		request.InferenceId = inferenceUUID
-		request.Seed = strconv.Itoa(int(seed))
-		request.TransferAddress = s.recorder.GetAccountAddress()
-		request.TransferSignature = inferenceRequest.TransferSignature
-
-		logging.Info("Execute request on same node, fill request with extra data", types.Inferences, "inferenceId", request.InferenceId, "seed", request.Seed)
-		return s.handleExecutorRequest(ctx, request, ctx.Response().Writer)
+func (s *Server) abandonInference(inferenceId string, reason string) {
+	err := s.recorder.AbandonInference(&inference.MsgAbandonInference{
+		InferenceId: inferenceId,
+		Reason:      reason,
+	})
+	if err != nil {
+		logging.Error("Failed to submit MsgAbandonInference", types.Inferences, "id", inferenceId, "error", err)
+	} else {
+		logging.Debug("Submitted MsgAbandonInference", types.Inferences, "id", inferenceId, "reason", reason)
 	}
+}
 
-	req, err := http.NewRequest(http.MethodPost, executor.Url+"/v1/chat/completions", bytes.NewReader(request.Body))
+// sendToExecutor forwards the original request to the executor. On success the returned cancel
+// must be called once the body has been proxied, it releases the request tied to the client's context.
+func (s *Server) sendToExecutor(ctx context.Context, request *ChatRequest, executor *ExecutorDestination, seed int32, transferSignature string, responseTimeout time.Duration) (*http.Response, context.CancelFunc, error) {
+	reqCtx, cancel := context.WithCancel(ctx)
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, executor.Url+completionapi.PathForKind(request.Kind), bytes.NewReader(request.Body))
 	if err != nil {
+		cancel()
 		logging.Error("handleTransferRequest. Failed to create request to the executor node", types.Inferences, "error", err)
-		return err
+		return nil, nil, err
 	}
 
 	// TODO use echo.Redirect?
-	req.Header.Set(utils.XInferenceIdHeader, inferenceUUID)
+	req.Header.Set(utils.XInferenceIdHeader, request.AuthKey)
 	req.Header.Set(utils.XSeedHeader, strconv.Itoa(int(seed)))
 	req.Header.Set(utils.AuthorizationHeader, request.AuthKey)
 	req.Header.Set(utils.XTimestampHeader, strconv.FormatInt(request.Timestamp, 10))
 	req.Header.Set(utils.XTransferAddressHeader, request.TransferAddress)
 	req.Header.Set(utils.XRequesterAddressHeader, request.RequesterAddress)
-	req.Header.Set(utils.XTASignatureHeader, inferenceRequest.TransferSignature)
+	req.Header.Set(utils.XTASignatureHeader, transferSignature)
+	if request.SubKey != "" {
+		req.Header.Set(utils.XDeveloperKeyHeader, request.SubKey)
+	}
+	if request.PayloadOffChain {
+		req.Header.Set(utils.XPayloadOffChainHeader, "true")
+	}
 	req.Header.Set("Content-Type", request.Request.Header.Get("Content-Type"))
 
-	resp, err := http.DefaultClient.Do(req)
+	// Only the wait for response headers is bounded; a long streamed body must not be cut off.
+	// Whichever of the timer and the response gets here first decides, so a timer firing
+	// right after the headers arrived can't cancel the body.
+	var headersDecided atomic.Bool
+	headerTimer := time.AfterFunc(responseTimeout, func() {
+		if headersDecided.CompareAndSwap(false, true) {
+			cancel()
+		}
+	})
+	resp, err := s.executorClient.Do(req)
+	headerTimer.Stop()
+	if !headersDecided.CompareAndSwap(false, true) {
+		if err == nil {
+			resp.Body.Close()
+		}
+		err = fmt.Errorf("executor didn't respond within %s: %w", responseTimeout, context.DeadlineExceeded)
+	}
 	if err != nil {
+		cancel()
 		logging.Error("Failed to make http request to executor", types.Inferences, "error", err, "url", executor.Url)
-		return err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
+	return resp, cancel, nil
+}
 
-	logging.Info("Proxying response from executor", types.Inferences,
-		"inferenceId", inferenceUUID,
-		"executor", executor.Address)
-	proxyResponse(resp, ctx.Response().Writer, false, nil, inferenceUUID)
-	return nil
+func executorFailureReason(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return "executor responded with " + resp.Status
 }
 
+func isHeuristicTokenizer(t tokenizer.Tokenizer) bool {
+	_, heuristic := t.(*tokenizer.HeuristicTokenizer)
+	return heuristic
+}
+
+// getPromptTokenEstimation counts prompt tokens locally with the model's vocabulary,
+// so escrow and bandwidth checks run on tokens rather than characters.
 func (s *Server) getPromptTokenEstimation(text string, model string) (int, error) {
-	return len(text), nil
+	return s.tokenizers.CountTokens(model, text), nil
 }
 
-func validateRequest(request *ChatRequest, status *coretypes.ResultStatus, configManager *apiconfig.ConfigManager) error {
+func (s *Server) validateRequest(request *ChatRequest, status *coretypes.ResultStatus) error {
 	lastHeightTime := status.SyncInfo.LatestBlockTime.UnixNano()
 	currentBlockHeight := status.SyncInfo.LatestBlockHeight
 
 	// Get validation parameters from config
-	validationParams := configManager.GetValidationParams()
+	validationParams := s.configManager.GetValidationParams()
 	timestampExpirationNs := validationParams.TimestampExpiration * int64(time.Second)
 	timestampAdvanceNs := validationParams.TimestampAdvance * int64(time.Second)
 
@@ -334,12 +445,7 @@ func validateRequest(request *ChatRequest, status *coretypes.ResultStatus, confi
 	}
 
 	// Check if AuthKey has been used before for a transfer request
-	if checkAndRecordAuthKey(request.AuthKey, currentBlockHeight, TransferContext) {
-		logging.Warn("AuthKey reuse detected for transfer request", types.Inferences, "authKey", request.AuthKey)
-		return echo.NewHTTPError(http.StatusBadRequest, "AuthKey has already been used for a transfer request")
-	}
-
-	return nil
+	return s.isAuthKeyReused(request.AuthKey, currentBlockHeight, authkeys.TransferContext)
 }
 
 func (s *Server) getPromptTokenCount(text string, model string) (int, error) {
@@ -351,7 +457,7 @@ func (s *Server) getPromptTokenCount(text string, model string) (int, error) {
 		TokenCount int `json:"count"`
 	}
 
-	response, err := broker.LockNode(s.nodeBroker, model, s.configManager.GetCurrentNodeVersion(), func(node *broker.Node) (*http.Response, error) {
+	response, err := broker.LockNode(context.Background(), s.nodeBroker, model, s.configManager.GetCurrentNodeVersion(), func(node *broker.Node) (*http.Response, error) {
 		tokenizeUrl, err := url.JoinPath(node.InferenceUrl(), "/tokenize")
 		if err != nil {
 			return nil, err
@@ -397,11 +503,7 @@ func (s *Server) extractPromptTextFromRequest(requestBytes []byte) (string, erro
 		return "", err
 	}
 
-	promptText := ""
-	for _, message := range openAiRequest.Messages {
-		promptText += message.Content + "\n"
-	}
-	return promptText, nil
+	return openAiRequest.PromptText(), nil
 }
 
 func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w http.ResponseWriter) error {
@@ -417,19 +519,27 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 		return echo.ErrBadRequest
 	}
 
-	modifiedRequestBody, err := completionapi.ModifyRequestBody(request.Body, int32(seed))
+	modifiedRequestBody, err := completionapi.ModifyRequestBodyForKind(request.Body, int32(seed), request.Kind)
 	if err != nil {
 		logging.Warn("Unable to modify request body", types.Inferences, "error", err)
 		return err
 	}
 
+	if request.PayloadOffChain {
+		// Validators fetch the prompt by the prompt_hash the transfer agent put on chain
+		if err := s.storePromptPayload(modifiedRequestBody.NewBody); err != nil {
+			logging.Error("Failed to store prompt payload", types.Inferences, "inferenceId", inferenceId, "error", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "Unable to store prompt payload")
+		}
+	}
+
 	logging.Info("Attempting to lock node for inference", types.Inferences,
 		"inferenceId", inferenceId, "nodeVersion", s.configManager.GetCurrentNodeVersion())
-	resp, err := broker.LockNode(s.nodeBroker, request.OpenAiRequest.Model, s.configManager.GetCurrentNodeVersion(), func(node *broker.Node) (*http.Response, error) {
+	resp, err := broker.LockNode(ctx.Request().Context(), s.nodeBroker, request.OpenAiRequest.Model, s.configManager.GetCurrentNodeVersion(), func(node *broker.Node) (*http.Response, error) {
 		logging.Info("Successfully acquired node lock for inference", types.Inferences,
 			"inferenceId", inferenceId, "node", node.Id, "url", node.InferenceUrl())
 
-		completionsUrl, err := url.JoinPath(node.InferenceUrl(), "/v1/chat/completions")
+		completionsUrl, err := url.JoinPath(node.InferenceUrl(), completionapi.PathForKind(request.Kind))
 		if err != nil {
 			return nil, err
 		}
@@ -454,9 +564,18 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 		return echo.NewHTTPError(http.StatusInternalServerError, msg)
 	}
 
+	// Streams without the escrow to pay for them are cut, the limit is learned from the chain
+	var budget *completionapi.StreamBudget
+	if request.OpenAiRequest.Stream {
+		budget = completionapi.NewStreamBudget()
+		budgetCtx, cancelBudget := context.WithCancel(ctx.Request().Context())
+		defer cancelBudget()
+		go s.watchStreamBudget(budgetCtx, inferenceId, budget)
+	}
+
 	responseProcessor := completionapi.NewExecutorResponseProcessor(request.InferenceId)
 	logging.Debug("Proxying response from inference node", types.Inferences, "inferenceId", request.InferenceId)
-	proxyResponse(resp, w, true, responseProcessor, inferenceId)
+	proxyResponse(resp, w, true, responseProcessor, inferenceId, budget)
 
 	logging.Debug("Processing response from inference node", types.Inferences, "inferenceId", request.InferenceId)
 	completionResponse, err := responseProcessor.GetResponse()
@@ -515,7 +634,12 @@ func (s *Server) validateFullRequest(ctx echo.Context, request *ChatRequest) err
 	}
 	logging.Info("Transfer pubkeys", types.Inferences, "pubkeys", transferPubkeys)
 
-	if err := validateTransferRequest(request, dev.Pubkey); err != nil {
+	devPubKey, _, err := s.requesterPubKey(ctx.Request().Context(), request, dev)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTransferRequest(request, devPubKey); err != nil {
 		logging.Error("Unable to validate request against PubKey", types.Inferences, "error", err)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unable to validate request against PubKey:"+err.Error())
 	}
@@ -575,17 +699,24 @@ func (s *Server) validateTimestampNonce(request *ChatRequest) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Request timestamp is in the future")
 	}
 
-	if checkAndRecordAuthKey(request.AuthKey, currentBlockHeight, ExecutorContext) {
-		logging.Warn("AuthKey reuse detected for executor request", types.Inferences, "authKey", request.AuthKey)
-		return echo.NewHTTPError(http.StatusBadRequest, "AuthKey has already been used for an executor request")
-	}
-	return nil
+	return s.isAuthKeyReused(request.AuthKey, currentBlockHeight, authkeys.ExecutorContext)
 }
 
-func (s *Server) getExecutorForRequest(ctx context.Context, model string) (*ExecutorDestination, error) {
+func (s *Server) getExecutorForRequest(ctx context.Context, model string, exclude []string) (*ExecutorDestination, error) {
+	selectionConfig := s.configManager.GetExecutorSelectionConfig()
+	if selectionConfig.Enabled {
+		executor, err := s.pickExecutorByStats(ctx, model, exclude, selectionConfig)
+		if err == nil {
+			return executor, nil
+		}
+		logging.Warn("Failed to pick executor by observed stats, falling back to random executor", types.Inferences, "model", model, "error", err)
+	}
+
 	queryClient := s.recorder.NewInferenceQueryClient()
 	response, err := queryClient.GetRandomExecutor(ctx, &types.QueryGetRandomExecutorRequest{
-		Model: model,
+		Model:   model,
+		Exclude: exclude,
+		Region:  selectionConfig.Region,
 	})
 	if err != nil {
 		return nil, err
@@ -598,6 +729,36 @@ func (s *Server) getExecutorForRequest(ctx context.Context, model string) (*Exec
 	}, nil
 }
 
+// pickExecutorByStats selects among the same candidates as GetRandomExecutor, with the on-chain
+// weights biased by the latency and error rate this node observed, within the configured bound.
+func (s *Server) pickExecutorByStats(ctx context.Context, model string, exclude []string, selectionConfig apiconfig.ExecutorSelectionConfig) (*ExecutorDestination, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	response, err := queryClient.ExecutorCandidates(ctx, &types.QueryExecutorCandidatesRequest{
+		Model:   model,
+		Exclude: exclude,
+		Region:  selectionConfig.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]internal.WeightedExecutor, len(response.Candidates))
+	for i, candidate := range response.Candidates {
+		candidates[i] = internal.WeightedExecutor{Address: candidate.Executor.Address, Weight: candidate.Weight}
+	}
+	index := s.executorStats.Pick(candidates, selectionConfig.MaxWeightDeviation)
+	if index < 0 {
+		return nil, fmt.Errorf("no executor candidate with a positive weight for model %s", model)
+	}
+
+	executor := response.Candidates[index].Executor
+	logging.Info("Executor selected by observed stats", types.Inferences, "address", executor.Address, "url", executor.InferenceUrl, "candidates", len(candidates))
+	return &ExecutorDestination{
+		Url:     executor.InferenceUrl,
+		Address: executor.Address,
+	}, nil
+}
+
 // calculateSignature calculates a signature for the given components and agent type
 func (s *Server) calculateSignature(payload string, timestamp int64, transferAddress string, executorAddress string, agentType calculations.SignatureType) (string, error) {
 	components := calculations.SignatureComponents{
@@ -659,7 +820,14 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 			model, _ := response.GetModel()
 			actualPromptTokens, err := s.getPromptTokenCount(promptText, model)
 			if err != nil {
-				logging.Warn("Failed to get actual prompt token count", types.Inferences, "error", err)
+				// The heuristic over-estimates on purpose, so it is only good for escrow checks
+				// and never replaces what the executor reported
+				if localTokenizer := s.tokenizers.Get(model); !isHeuristicTokenizer(localTokenizer) {
+					logging.Warn("Failed to get actual prompt token count, using local tokenizer", types.Inferences, "error", err)
+					usage.PromptTokens = uint64(localTokenizer.CountTokens(promptText))
+				} else {
+					logging.Warn("Failed to get actual prompt token count, keeping reported usage", types.Inferences, "error", err)
+				}
 			} else {
 				logging.Info("Updated prompt tokens via tokenization", types.Inferences, "inferenceId", inferenceId, "tokens", actualPromptTokens)
 				usage.PromptTokens = uint64(actualPromptTokens)
@@ -676,7 +844,7 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 
 	if s.recorder != nil {
 		// Calculate executor signature
-		executorSignature, err := s.calculateSignature(string(request.Body), request.Timestamp, request.TransferAddress, executorAddress, calculations.ExecutorAgent)
+		executorSignature, err := s.calculateSignature(request.signedPayload(), request.Timestamp, request.TransferAddress, executorAddress, calculations.ExecutorAgent)
 		if err != nil {
 			return err
 		}
@@ -696,8 +864,23 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 			RequestedBy:          request.RequesterAddress,
 			OriginalPrompt:       string(request.Body),
 			Model:                model,
+			Kind:                 inference.InferenceKind(request.Kind),
+			SubKey:               request.SubKey,
+		}
+		if request.PayloadOffChain {
+			responsePayloadHash, err := s.payloads.Put(bodyBytes)
+			if err != nil {
+				logging.Error("Failed to store response payload", types.Inferences, "inferenceId", inferenceId, "error", err)
+				return err
+			}
+			message.ResponsePayload = ""
+			message.OriginalPrompt = ""
+			message.OriginalPromptHash = request.signedPayload()
+			message.ResponsePayloadHash = responsePayloadHash
+			message.PayloadLocator = s.payloadLocator()
 		}
 
+		metrics.ObserveInferenceTokens(s.modelLabels.label(model), usage.PromptTokens, usage.CompletionTokens)
 		logging.Info("Submitting MsgFinishInference", types.Inferences, "inferenceId", inferenceId)
 		err = s.recorder.FinishInference(message)
 		if err != nil {
@@ -720,7 +903,7 @@ func getPromptHash(requestBytes []byte) (string, string, error) {
 }
 
 func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, inferenceId string, executor *ExecutorDestination, nodeVersion string, promptTokenCount int) (*inference.MsgStartInference, error) {
-	finalRequest, err := completionapi.ModifyRequestBody(request.Body, seed)
+	finalRequest, err := completionapi.ModifyRequestBodyForKind(request.Body, seed, request.Kind)
 	if err != nil {
 		return nil, err
 	}
@@ -734,6 +917,10 @@ func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, in
 	} else if request.OpenAiRequest.MaxTokens > 0 {
 		maxTokens = int(request.OpenAiRequest.MaxTokens)
 	}
+	// Embeddings generate no tokens, only the prompt is paid for
+	if request.Kind == types.InferenceKind_EMBEDDING {
+		maxTokens = 0
+	}
 	transaction := &inference.MsgStartInference{
 		InferenceId:      inferenceId,
 		PromptHash:       promptHash,
@@ -746,9 +933,23 @@ func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, in
 		PromptTokenCount: uint64(promptTokenCount),
 		RequestTimestamp: request.Timestamp,
 		OriginalPrompt:   string(request.Body),
+		Kind:             inference.InferenceKind(request.Kind),
+		SubKey:           request.SubKey,
+	}
+	if request.PayloadOffChain {
+		// The transfer agent keeps its own copy, the chain only gets the hashes
+		if _, err := s.payloads.Put(request.Body); err != nil {
+			return nil, err
+		}
+		if _, err := s.payloads.Put([]byte(promptPayload)); err != nil {
+			return nil, err
+		}
+		transaction.PromptPayload = ""
+		transaction.OriginalPrompt = ""
+		transaction.OriginalPromptHash = request.signedPayload()
 	}
 
-	signature, err := s.calculateSignature(string(request.Body), request.Timestamp, request.TransferAddress, executor.Address, calculations.TransferAgent)
+	signature, err := s.calculateSignature(request.signedPayload(), request.Timestamp, request.TransferAddress, executor.Address, calculations.TransferAgent)
 	if err != nil {
 		return nil, err
 	}
@@ -797,9 +998,11 @@ func readRequest(request *http.Request, transferAddress string) (*ChatRequest, e
 		Seed:              request.Header.Get(utils.XSeedHeader),
 		InferenceId:       request.Header.Get(utils.XInferenceIdHeader),
 		RequesterAddress:  request.Header.Get(utils.XRequesterAddressHeader),
+		SubKey:            request.Header.Get(utils.XDeveloperKeyHeader),
 		Timestamp:         timestamp,
 		TransferAddress:   transferAddress,
 		TransferSignature: request.Header.Get(utils.XTASignatureHeader),
+		PayloadOffChain:   request.Header.Get(utils.XPayloadOffChainHeader) == "true",
 	}, nil
 }
 
@@ -819,7 +1022,12 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 		return ErrInferenceParticipantNotFound
 	}
 
-	err := validateTransferRequest(request, requester.Pubkey)
+	devPubKey, subKey, err := s.requesterPubKey(ctx, request, requester)
+	if err != nil {
+		return err
+	}
+
+	err = validateTransferRequest(request, devPubKey)
 	if err != nil {
 		logging.Error("Unable to validate request against PubKey", types.Inferences, "error", err)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unable to validate request against PubKey:"+err.Error())
@@ -855,19 +1063,50 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 	}
 
 	// Calculate escrow using consistent formula: (PromptTokens + MaxTokens) × PerTokenPrice
-	totalTokens := uint64(promptTokenCount) + uint64(request.OpenAiRequest.MaxTokens)
+	totalTokens := uint64(promptTokenCount) + uint64(request.maxTokens())
 	escrowNeeded = totalTokens * perTokenPrice
 
 	logging.Debug("Escrow calculation", types.Inferences,
 		"escrowNeeded", escrowNeeded,
 		"perTokenPrice", perTokenPrice,
 		"promptTokens", promptTokenCount,
-		"maxTokens", request.OpenAiRequest.MaxTokens,
+		"maxTokens", request.maxTokens(),
 		"totalTokens", totalTokens)
 
+	// The chain enforces the same limits on MsgStartInference, checking them here saves the
+	// executor from running an inference that will not be paid
+	if subKey != nil {
+		if err := subKey.SubKey.CheckRequest(time.Now().Unix(), request.OpenAiRequest.Model, uint64(request.maxTokens())); err != nil {
+			logging.Warn("Request not allowed for developer sub-key", types.Inferences, "subKey", request.SubKey, "error", err)
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		if limit := subKey.SubKey.MaxCoinsPerEpoch; limit > 0 && subKey.SpentThisEpoch+escrowNeeded > limit {
+			logging.Warn("Developer sub-key spending limit reached", types.Inferences,
+				"subKey", request.SubKey, "spent", subKey.SpentThisEpoch, "limit", limit, "escrowNeeded", escrowNeeded)
+			return ErrSubKeyBudgetExceeded
+		}
+	}
+
 	logging.Debug("Client balance", types.Inferences, "balance", requester.Balance)
 	if requester.Balance < int64(escrowNeeded) {
 		return ErrInsufficientBalance
 	}
 	return nil
 }
+
+// requesterPubKey returns the key the developer signature is checked against: the developer
+// sub-key named in the request, returned as well, or the requester's account key
+func (s *Server) requesterPubKey(ctx context.Context, request *ChatRequest, requester *types.QueryInferenceParticipantResponse) (string, *types.QueryGetDeveloperSubKeyResponse, error) {
+	if request.SubKey == "" {
+		return requester.Pubkey, nil, nil
+	}
+	subKey, err := s.recorder.NewInferenceQueryClient().DeveloperSubKey(ctx, &types.QueryGetDeveloperSubKeyRequest{
+		Developer: request.RequesterAddress,
+		Name:      request.SubKey,
+	})
+	if err != nil {
+		logging.Warn("Developer sub-key not found", types.Inferences, "address", request.RequesterAddress, "subKey", request.SubKey, "error", err)
+		return "", nil, echo.NewHTTPError(http.StatusUnauthorized, "Unknown developer sub-key: "+request.SubKey)
+	}
+	return subKey.SubKey.PubKey, subKey, nil
+}
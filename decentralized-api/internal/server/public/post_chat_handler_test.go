@@ -0,0 +1,122 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/internal"
+	"decentralized-api/utils"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestForwarding(s *Server, executors []*ExecutorDestination, abandoned *[]string) *executorForwarding {
+	request := &ChatRequest{
+		Body:      []byte(`{"model":"test-model"}`),
+		Request:   httptest.NewRequest(http.MethodPost, "/v1/chat/completions", nil),
+		AuthKey:   "inference-1",
+		Timestamp: time.Now().UnixNano(),
+	}
+	return &executorForwarding{
+		inferenceId:       request.AuthKey,
+		maxAttempts:       3,
+		responseTimeout:   time.Second,
+		timestampDeadline: time.Now().Add(time.Minute),
+		stats:             internal.NewExecutorStats(),
+		pickExecutor: func(exclude []string) (*ExecutorDestination, error) {
+			for _, executor := range executors {
+				if !slices.Contains(exclude, executor.Address) {
+					return executor, nil
+				}
+			}
+			return nil, context.DeadlineExceeded
+		},
+		start: func(executor *ExecutorDestination) (*executorAttempt, error) {
+			submitted := make(chan struct{})
+			close(submitted)
+			return &executorAttempt{executor: executor, seed: 1, startSubmitted: submitted}, nil
+		},
+		abandon: func(reason string) {
+			*abandoned = append(*abandoned, reason)
+		},
+		send: func(ctx context.Context, attempt *executorAttempt, timeout time.Duration) (*http.Response, context.CancelFunc, error) {
+			return s.sendToExecutor(ctx, request, attempt.executor, attempt.seed, attempt.transferSignature, timeout)
+		},
+	}
+}
+
+func TestForwardRetriesWhenExecutorRefusesConnections(t *testing.T) {
+	refusing := httptest.NewServer(http.NotFoundHandler())
+	refusingUrl := refusing.URL
+	refusing.Close()
+
+	served := 0
+	serving := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		require.Equal(t, "inference-1", r.Header.Get(utils.XInferenceIdHeader))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"inference-1"}`))
+	}))
+	defer serving.Close()
+
+	s := &Server{executorClient: &http.Client{}}
+	var abandoned []string
+	forwarding := newTestForwarding(s, []*ExecutorDestination{
+		{Url: refusingUrl, Address: "executor-1"},
+		{Url: serving.URL, Address: "executor-2"},
+	}, &abandoned)
+
+	recorder := httptest.NewRecorder()
+	require.NoError(t, forwarding.forward(context.Background(), recorder))
+
+	require.Equal(t, 1, served)
+	require.Len(t, abandoned, 1)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"id":"inference-1"}`, recorder.Body.String())
+}
+
+func TestForwardStopsRetryingAfterTimestampExpires(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	s := &Server{executorClient: &http.Client{}}
+	var abandoned []string
+	forwarding := newTestForwarding(s, []*ExecutorDestination{
+		{Url: failing.URL, Address: "executor-1"},
+		{Url: failing.URL, Address: "executor-2"},
+	}, &abandoned)
+	forwarding.timestampDeadline = time.Now().Add(-time.Second)
+
+	recorder := httptest.NewRecorder()
+	require.NoError(t, forwarding.forward(context.Background(), recorder))
+
+	require.Len(t, abandoned, 1)
+	require.Equal(t, http.StatusBadGateway, recorder.Code)
+}
+
+func TestSendToExecutorBoundsOnlyTheHeaders(t *testing.T) {
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte("done"))
+	}))
+	defer executor.Close()
+
+	s := &Server{executorClient: &http.Client{}}
+	request := &ChatRequest{Body: []byte(`{}`), Request: httptest.NewRequest(http.MethodPost, "/", nil)}
+	resp, cancel, err := s.sendToExecutor(context.Background(), request, &ExecutorDestination{Url: executor.URL}, 1, "", 50*time.Millisecond)
+	require.NoError(t, err)
+	defer cancel()
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "done", string(body))
+}
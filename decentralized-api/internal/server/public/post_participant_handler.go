@@ -29,6 +29,7 @@ func (s *Server) submitNewParticipantHandler(ctx echo.Context) error {
 		Url:          body.Url,
 		ValidatorKey: body.ValidatorKey,
 		WorkerKey:    body.WorkerKey,
+		Region:       body.Region,
 	}
 
 	logging.Info("ValidatorKey in dapi", types.Participants, "key", body.ValidatorKey)
@@ -50,6 +51,7 @@ func (s *Server) submitNewUnfundedParticipant(body public_entities.SubmitUnfunde
 		ValidatorKey: body.ValidatorKey,
 		PubKey:       body.PubKey,
 		WorkerKey:    body.WorkerKey,
+		Region:       body.Region,
 	}
 
 	logging.Debug("Submitting NewUnfundedParticipant", types.Participants, "message", msg)
@@ -18,6 +18,7 @@ func proxyResponse(
 	excludeContentLength bool,
 	responseProcessor completionapi.ResponseProcessor,
 	inferenceId string,
+	budget *completionapi.StreamBudget,
 ) {
 	// Make sure to copy response headers to the client
 	for key, values := range resp.Header {
@@ -34,14 +35,16 @@ func proxyResponse(
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "text/event-stream") {
 		logging.Debug("Proxying text/event-stream response", types.Inferences, "status_code", resp.StatusCode, "content_type", contentType, "inference_id", inferenceId)
-		proxyTextStreamResponse(resp, w, responseProcessor, inferenceId)
+		proxyTextStreamResponse(resp, w, responseProcessor, inferenceId, budget)
 	} else {
 		logging.Debug("Proxying JSON response", types.Inferences, "status_code", resp.StatusCode, "content_type", contentType, "inference_id", inferenceId)
 		proxyJsonResponse(resp, w, responseProcessor, inferenceId)
 	}
 }
 
-func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string) {
+// proxyTextStreamResponse forwards the event stream line by line. With a budget, the stream is cut
+// once the completion tokens sent reach what the escrow pays for.
+func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string, budget *completionapi.StreamBudget) {
 	w.WriteHeader(resp.StatusCode)
 
 	// Stream the response from the completion server to the client
@@ -80,6 +83,14 @@ func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, respons
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+
+		if budget != nil && budget.Count(lineToProxy) {
+			logging.Warn("Escrow exhausted, cutting the stream", types.Inferences, "inferenceId", inferenceId, "completionTokens", budget.Used())
+			// Closing the body makes the inference node abort the generation
+			resp.Body.Close()
+			finishStreamAtBudget(w, responseProcessor, inferenceId, budget)
+			return
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -87,6 +98,29 @@ func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, respons
 	}
 }
 
+// finishStreamAtBudget ends a cut stream with a closing chunk carrying the finish reason and usage,
+// passed through the response processor so the executor records the truncated response
+func finishStreamAtBudget(w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string, budget *completionapi.StreamBudget) {
+	lines, err := budget.ExhaustedLines()
+	if err != nil {
+		logging.Error("Failed to build the closing chunk", types.Inferences, "inferenceId", inferenceId, "error", err)
+		return
+	}
+	for _, line := range lines {
+		if responseProcessor != nil {
+			line, err = responseProcessor.ProcessStreamedResponse(line)
+			if err != nil {
+				logging.Error("Failed to process the closing chunk", types.Inferences, "inferenceId", inferenceId, "error", err)
+				return
+			}
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			logging.Warn("Unable to send the closing chunk", types.Inferences, "inferenceId", inferenceId, "error", err)
+			return
+		}
+	}
+}
+
 func proxyJsonResponse(resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string) {
 	var bodyBytes, err = io.ReadAll(resp.Body)
 	if err != nil {
@@ -6,7 +6,11 @@ import (
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal"
+	"decentralized-api/internal/authkeys"
+	"decentralized-api/internal/payloads"
 	"decentralized-api/internal/server/middleware"
+	"decentralized-api/internal/subscriptions"
+	"decentralized-api/internal/tokenizer"
 	"decentralized-api/training"
 	"net/http"
 
@@ -21,6 +25,16 @@ type Server struct {
 	trainingExecutor *training.Executor
 	blockQueue       *BridgeQueue
 	bandwidthLimiter *internal.BandwidthLimiter
+	executorStats    *internal.ExecutorStats
+	phaseTracker     *chainphase.ChainPhaseTracker
+	epochGroups      *internal.EpochGroupDataCache
+	tokenizers       *tokenizer.Manager
+	modelLabels      *modelLabels
+	authKeys         authkeys.Store
+	payloads         *payloads.FileStore
+	lifecycleEvents  *subscriptions.Hub
+	webhooks         subscriptions.WebhookStore
+	executorClient   *http.Client
 }
 
 // TODO: think about rate limits
@@ -30,13 +44,14 @@ func NewServer(
 	recorder cosmosclient.CosmosMessageClient,
 	trainingExecutor *training.Executor,
 	blockQueue *BridgeQueue,
-	phaseTracker *chainphase.ChainPhaseTracker) *Server {
+	phaseTracker *chainphase.ChainPhaseTracker,
+	authKeys authkeys.Store,
+	payloadStore *payloads.FileStore,
+	lifecycleEvents *subscriptions.Hub,
+	webhooks subscriptions.WebhookStore) *Server {
 	e := echo.New()
 	e.HTTPErrorHandler = middleware.TransparentErrorHandler
 
-	// Set the package-level configManagerRef
-	configManagerRef = configManager
-
 	s := &Server{
 		e:                e,
 		nodeBroker:       nodeBroker,
@@ -44,9 +59,19 @@ func NewServer(
 		recorder:         recorder,
 		trainingExecutor: trainingExecutor,
 		blockQueue:       blockQueue,
+		phaseTracker:     phaseTracker,
+		epochGroups:      internal.NewEpochGroupDataCache(recorder),
+		authKeys:         authKeys,
+		payloads:         payloadStore,
+		lifecycleEvents:  lifecycleEvents,
+		webhooks:         webhooks,
+		executorClient:   &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()},
 	}
 
 	s.bandwidthLimiter = internal.NewBandwidthLimiterFromConfig(configManager, recorder, phaseTracker)
+	s.executorStats = internal.NewExecutorStats()
+	s.modelLabels = newModelLabels(s.getGovernanceModelIds)
+	s.tokenizers = tokenizer.NewManager(configManager, s.getGovernanceModel, s.modelLabels.isKnown)
 
 	e.Use(middleware.LoggingMiddleware)
 	g := e.Group("/v1/")
@@ -54,7 +79,16 @@ func NewServer(
 	g.GET("status", s.getStatus)
 
 	g.POST("chat/completions", s.postChat)
+	g.POST("completions", s.postCompletions)
+	g.POST("embeddings", s.postEmbeddings)
 	g.GET("chat/completions/:id", s.getChatById)
+	g.GET("inferences/:id/validation-report", s.getValidationReport)
+	g.GET("payloads/:hash", s.getPayload)
+
+	g.GET("inferences/events", s.streamInferenceEvents)
+	g.POST("webhooks", s.postWebhook)
+	g.GET("webhooks", s.getWebhooks)
+	g.DELETE("webhooks/:id", s.deleteWebhook)
 
 	g.GET("participants/:address", s.getInferenceParticipantByAddress)
 	g.GET("participants", s.getAllParticipants)
@@ -63,6 +97,7 @@ func NewServer(
 	g.POST("training/tasks", s.postTrainingTask)
 	g.GET("training/tasks", s.getTrainingTasks)
 	g.GET("training/tasks/:id", s.getTrainingTask)
+	g.GET("training/tasks/:id/checkpoints", s.getTrainingTaskCheckpoints)
 	g.POST("training/lock-nodes", s.lockTrainingNodes)
 
 	g.POST("verify-proof", s.postVerifyProof)
@@ -0,0 +1,52 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/completionapi"
+	"decentralized-api/logging"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const streamBudgetPollInterval = 2 * time.Second
+
+// watchStreamBudget sets the limit of budget from the escrow of the inference, once the transfer
+// agent's MsgStartInference is on chain. It stops when ctx is done.
+func (s *Server) watchStreamBudget(ctx context.Context, inferenceId string, budget *completionapi.StreamBudget) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	ticker := time.NewTicker(streamBudgetPollInterval)
+	defer ticker.Stop()
+
+	for {
+		response, err := queryClient.Inference(ctx, &types.QueryGetInferenceRequest{Index: inferenceId})
+		if err == nil && response.Inference.StartProcessed() {
+			inference := response.Inference
+			// Nothing is escrowed while inferences are free
+			if inference.PerTokenPrice == 0 {
+				return
+			}
+			limit := completionTokensPaid(inference)
+			logging.Debug("Stream budget known", types.Inferences, "inferenceId", inferenceId,
+				"escrow", inference.EscrowAmount, "perTokenPrice", inference.PerTokenPrice, "completionTokens", limit)
+			budget.SetLimit(limit)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// completionTokensPaid returns the completion tokens the escrow pays for at the locked price,
+// after the prompt tokens the transfer agent counted
+func completionTokensPaid(inference types.Inference) uint64 {
+	if inference.EscrowAmount <= 0 {
+		return 0
+	}
+	paid := uint64(inference.EscrowAmount) / inference.PerTokenPrice
+	return paid - min(paid, inference.PromptTokenCount)
+}
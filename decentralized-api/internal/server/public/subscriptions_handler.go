@@ -0,0 +1,222 @@
+package public
+
+import (
+	"crypto/rand"
+	"decentralized-api/internal/subscriptions"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// streamHeartbeatInterval keeps idle event streams from being closed by proxies
+const streamHeartbeatInterval = 15 * time.Second
+
+type RegisterWebhookRequest struct {
+	Url string `json:"url"`
+	// Secret signs the deliveries, one is generated when empty
+	Secret     string                    `json:"secret"`
+	EventTypes []subscriptions.EventType `json:"event_types"`
+}
+
+// authenticateRequester checks that the request is signed by the requester's account key or one of
+// its grantees. The signed payload is the request body, or the request path when there is no body.
+func (s *Server) authenticateRequester(ctx echo.Context, body []byte) (string, error) {
+	requesterAddress := ctx.Request().Header.Get(utils.XRequesterAddressHeader)
+	signature := ctx.Request().Header.Get(utils.AuthorizationHeader)
+	if requesterAddress == "" || signature == "" {
+		return "", ErrRequestAuth
+	}
+	timestamp, err := strconv.ParseInt(ctx.Request().Header.Get(utils.XTimestampHeader), 10, 64)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "Invalid timestamp")
+	}
+	if err := s.validatePayloadTimestamp(timestamp); err != nil {
+		return "", err
+	}
+
+	pubkeys, err := s.getAllowedPubKeys(ctx, requesterAddress)
+	if err != nil {
+		logging.Warn("Unable to get pubkeys of requester", types.Inferences, "address", requesterAddress, "error", err)
+		return "", echo.NewHTTPError(http.StatusUnauthorized, "Requester is not a participant")
+	}
+	payload := string(body)
+	if len(body) == 0 {
+		payload = ctx.Request().URL.Path
+	}
+	components := calculations.SignatureComponents{
+		Payload:         payload,
+		Timestamp:       timestamp,
+		TransferAddress: requesterAddress,
+	}
+	if err := calculations.ValidateSignatureWithGrantees(components, calculations.Developer, pubkeys, signature); err != nil {
+		logging.Warn("Invalid subscription request signature", types.Inferences, "address", requesterAddress, "error", err)
+		return "", echo.NewHTTPError(http.StatusUnauthorized, "Unable to validate request against PubKey:"+err.Error())
+	}
+	return requesterAddress, nil
+}
+
+// streamInferenceEvents sends the lifecycle events of the requester's inferences as Server-Sent Events
+func (s *Server) streamInferenceEvents(ctx echo.Context) error {
+	requester, err := s.authenticateRequester(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var requestedTypes []subscriptions.EventType
+	for _, eventType := range ctx.QueryParams()["type"] {
+		requestedTypes = append(requestedTypes, subscriptions.EventType(eventType))
+	}
+	eventTypes, err := parseEventTypes(requestedTypes)
+	if err != nil {
+		return err
+	}
+
+	events, unsubscribe := s.lifecycleEvents.Subscribe(requester)
+	defer unsubscribe()
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := io.WriteString(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			response.Flush()
+		case event := <-events:
+			if len(eventTypes) > 0 && !eventTypes[event.Type] {
+				continue
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				logging.Error("Failed to encode lifecycle event", types.Inferences, "id", event.Id, "error", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(response, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data); err != nil {
+				return nil
+			}
+			response.Flush()
+		}
+	}
+}
+
+func (s *Server) postWebhook(ctx echo.Context) error {
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Unable to read request body")
+	}
+	requester, err := s.authenticateRequester(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	var request RegisterWebhookRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+	webhookUrl, err := url.Parse(request.Url)
+	if err != nil || (webhookUrl.Scheme != "http" && webhookUrl.Scheme != "https") || webhookUrl.Host == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Webhook url must be an absolute http(s) url")
+	}
+	if _, err := parseEventTypes(request.EventTypes); err != nil {
+		return err
+	}
+
+	webhook := subscriptions.Webhook{
+		Id:         randomHex(16),
+		Requester:  requester,
+		Url:        request.Url,
+		Secret:     request.Secret,
+		EventTypes: request.EventTypes,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if webhook.Secret == "" {
+		webhook.Secret = randomHex(32)
+	}
+	maxWebhooks := s.configManager.GetSubscriptionsConfig().MaxWebhooksPerRequester
+	if maxWebhooks == 0 {
+		maxWebhooks = subscriptions.DefaultMaxWebhooksPerRequester
+	}
+	err = s.webhooks.Add(webhook, maxWebhooks)
+	if errors.Is(err, subscriptions.ErrTooManyWebhooks) {
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+	if err != nil {
+		logging.Error("Failed to register webhook", types.Inferences, "requester", requester, "error", err)
+		return err
+	}
+	logging.Info("Webhook registered", types.Inferences, "requester", requester, "id", webhook.Id, "url", webhook.Url)
+	// The secret is only returned here, so the requester can verify deliveries
+	return ctx.JSON(http.StatusCreated, webhook)
+}
+
+func (s *Server) getWebhooks(ctx echo.Context) error {
+	requester, err := s.authenticateRequester(ctx, nil)
+	if err != nil {
+		return err
+	}
+	webhooks, err := s.webhooks.List(requester)
+	if err != nil {
+		logging.Error("Failed to list webhooks", types.Inferences, "requester", requester, "error", err)
+		return err
+	}
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+	return ctx.JSON(http.StatusOK, struct {
+		Webhooks []subscriptions.Webhook `json:"webhooks"`
+	}{Webhooks: webhooks})
+}
+
+func (s *Server) deleteWebhook(ctx echo.Context) error {
+	requester, err := s.authenticateRequester(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = s.webhooks.Remove(requester, ctx.Param("id"))
+	if errors.Is(err, subscriptions.ErrWebhookNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		logging.Error("Failed to remove webhook", types.Inferences, "requester", requester, "error", err)
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func parseEventTypes(values []subscriptions.EventType) (map[subscriptions.EventType]bool, error) {
+	eventTypes := make(map[subscriptions.EventType]bool, len(values))
+	for _, eventType := range values {
+		if !subscriptions.IsValidEventType(eventType) {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Unknown event type: "+string(eventType))
+		}
+		eventTypes[eventType] = true
+	}
+	return eventTypes, nil
+}
+
+func randomHex(size int) string {
+	bytes := make([]byte, size)
+	_, _ = rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
@@ -32,6 +32,8 @@ func (s *Server) postTrainingTask(ctx echo.Context) error {
 			},
 			NumUocEstimationSteps: body.Config.NumUocEstimationSteps,
 		},
+		Budget:          body.Budget,
+		OuterStepPayout: body.OuterStepPayout,
 	}
 
 	msgResponse, err := s.recorder.CreateTrainingTask(msg)
@@ -67,6 +69,22 @@ func (s *Server) getTrainingTask(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, task)
 }
 
+func (s *Server) getTrainingTaskCheckpoints(ctx echo.Context) error {
+	idParam := ctx.Param("id")
+	uintId, err := strconv.ParseUint(idParam, 10, 64)
+	if err != nil {
+		return ErrInvalidTrainingJobId
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	checkpoints, err := queryClient.TrainingCheckpoints(s.recorder.GetContext(), &types.QueryTrainingCheckpointsRequest{TaskId: uintId})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, checkpoints)
+}
+
 func (s *Server) lockTrainingNodes(ctx echo.Context) error {
 	var body LockTrainingNodesDto
 	if err := ctx.Bind(&body); err != nil {
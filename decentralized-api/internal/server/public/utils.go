@@ -4,9 +4,11 @@ import (
 	"github.com/productscience/inference/x/inference/calculations"
 )
 
+// validateTransferRequest checks the developer's signature. Requests that opted into keeping
+// their payloads off-chain are signed over the hash of the body instead of the body itself.
 func validateTransferRequest(request *ChatRequest, devPubkey string) error {
 	components := calculations.SignatureComponents{
-		Payload:         string(request.Body),
+		Payload:         request.signedPayload(),
 		Timestamp:       request.Timestamp,
 		TransferAddress: request.TransferAddress,
 		ExecutorAddress: "",
@@ -16,7 +18,7 @@ func validateTransferRequest(request *ChatRequest, devPubkey string) error {
 
 func validateExecuteRequestWithGrantees(request *ChatRequest, transferPubkeys []string, executorAddress string, transferSignature string) error {
 	components := calculations.SignatureComponents{
-		Payload:         string(request.Body),
+		Payload:         request.signedPayload(),
 		Timestamp:       request.Timestamp,
 		TransferAddress: request.TransferAddress,
 		ExecutorAddress: executorAddress,
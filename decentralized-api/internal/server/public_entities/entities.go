@@ -6,4 +6,5 @@ type SubmitUnfundedNewParticipantDto struct {
 	ValidatorKey string `json:"validator_key"`
 	PubKey       string `json:"pub_key"`
 	WorkerKey    string `json:"worker_key"`
+	Region       string `json:"region,omitempty"`
 }
@@ -0,0 +1,190 @@
+package subscriptions
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	DefaultWebhookMaxAttempts      = 6
+	DefaultWebhookTimeout          = 10 * time.Second
+	DefaultMaxWebhooksPerRequester = 5
+	DefaultWebhookWorkers          = 8
+
+	initialRetryDelay = time.Second
+	// deliveryQueueSize bounds the deliveries waiting for a worker, more are dropped
+	deliveryQueueSize = 1024
+)
+
+var ErrPrivateWebhookAddress = errors.New("webhook resolves to a private address")
+
+// Dispatcher delivers events to webhooks, retrying failed deliveries with exponential backoff.
+// Every API instance delivers on its own, so a receiver behind several instances should drop
+// duplicates by event id.
+type Dispatcher struct {
+	store       WebhookStore
+	client      *http.Client
+	maxAttempts int
+	retryDelay  time.Duration
+	deliveries  chan delivery
+}
+
+type delivery struct {
+	webhook Webhook
+	eventId string
+	body    []byte
+}
+
+func NewDispatcher(store WebhookStore, maxAttempts int, timeout time.Duration, workers int) *Dispatcher {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultWebhookMaxAttempts
+	}
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	if workers <= 0 {
+		workers = DefaultWebhookWorkers
+	}
+	d := &Dispatcher{
+		store:       store,
+		client:      newPublicClient(timeout),
+		maxAttempts: maxAttempts,
+		retryDelay:  initialRetryDelay,
+		deliveries:  make(chan delivery, deliveryQueueSize),
+	}
+	for i := 0; i < workers; i++ {
+		go d.work()
+	}
+	return d
+}
+
+func NewDispatcherFromConfig(store WebhookStore, config apiconfig.SubscriptionsConfig) *Dispatcher {
+	return NewDispatcher(store, config.WebhookMaxAttempts, time.Duration(config.WebhookTimeoutSeconds)*time.Second, config.WebhookWorkers)
+}
+
+// newPublicClient only connects to public addresses. The check runs on the address actually
+// dialed, so neither DNS rebinding nor a redirect can point a webhook into the node's network.
+func newPublicClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%w: %s", ErrPrivateWebhookAddress, host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// Sign is the value of the X-Webhook-Signature header: the hex HMAC-SHA256 of the timestamp
+// header, a dot and the body, keyed by the webhook secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatch queues the event for delivery to every webhook of its requester that accepts it
+func (d *Dispatcher) Dispatch(event Event) {
+	webhooks, err := d.store.List(event.RequestedBy)
+	if err != nil {
+		logging.Error("Failed to list webhooks", types.EventProcessing, "requester", event.RequestedBy, "error", err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		logging.Error("Failed to encode lifecycle event", types.EventProcessing, "id", event.Id, "error", err)
+		return
+	}
+	for _, webhook := range webhooks {
+		if !webhook.Accepts(event.Type) {
+			continue
+		}
+		select {
+		case d.deliveries <- delivery{webhook: webhook, eventId: event.Id, body: body}:
+		default:
+			logging.Error("Webhook delivery queue is full, dropping event", types.EventProcessing, "webhook", webhook.Id, "event", event.Id)
+		}
+	}
+}
+
+func (d *Dispatcher) work() {
+	for delivery := range d.deliveries {
+		d.deliver(delivery.webhook, delivery.eventId, delivery.body)
+	}
+}
+
+func (d *Dispatcher) deliver(webhook Webhook, eventId string, body []byte) {
+	delay := d.retryDelay
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		err := d.post(webhook, eventId, body)
+		if err == nil {
+			return
+		}
+		logging.Warn("Webhook delivery failed", types.EventProcessing,
+			"webhook", webhook.Id, "url", webhook.Url, "event", eventId, "attempt", attempt, "error", err)
+		if attempt < d.maxAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	logging.Error("Giving up on webhook delivery", types.EventProcessing, "webhook", webhook.Id, "url", webhook.Url, "event", eventId)
+}
+
+func (d *Dispatcher) post(webhook Webhook, eventId string, body []byte) error {
+	// Each attempt is signed with a fresh timestamp, so receivers can reject stale replays
+	timestamp := time.Now().Unix()
+	request, err := http.NewRequest(http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(utils.XWebhookIdHeader, webhook.Id)
+	request.Header.Set(utils.XWebhookEventIdHeader, eventId)
+	request.Header.Set(utils.XWebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(utils.XWebhookSignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package subscriptions
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type EventType string
+
+const (
+	EventFinished              EventType = "finished"
+	EventValidated             EventType = "validated"
+	EventRevalidationRequested EventType = "revalidation_requested"
+	EventRevalidated           EventType = "revalidated"
+	EventInvalidated           EventType = "invalidated"
+	EventExpired               EventType = "expired"
+)
+
+var AllEventTypes = []EventType{
+	EventFinished,
+	EventValidated,
+	EventRevalidationRequested,
+	EventRevalidated,
+	EventInvalidated,
+	EventExpired,
+}
+
+func IsValidEventType(eventType EventType) bool {
+	for _, t := range AllEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a change in the lifecycle of an inference, as seen on chain
+type Event struct {
+	// Id is unique per event, subscribers use it to drop duplicate deliveries
+	Id          string    `json:"id"`
+	Type        EventType `json:"type"`
+	InferenceId string    `json:"inference_id"`
+	RequestedBy string    `json:"requested_by"`
+	Height      int64     `json:"height"`
+	Executor    string    `json:"executor,omitempty"`
+	Validator   string    `json:"validator,omitempty"`
+	// Refund is the amount returned to the requester, set for invalidated and expired inferences
+	Refund int64 `json:"refund,omitempty"`
+}
+
+// Chain event names and attributes the lifecycle events are built from
+const (
+	chainInferenceFinished    = "inference_finished"
+	chainInferenceValidation  = "inference_validation"
+	chainInferenceRevalidated = "inference_revalidated"
+	chainInferenceInvalidated = "inference_invalidated"
+	chainInferenceExpired     = "inference_expired"
+)
+
+var chainEventTypes = []string{
+	chainInferenceFinished,
+	chainInferenceValidation,
+	chainInferenceRevalidated,
+	chainInferenceInvalidated,
+	chainInferenceExpired,
+}
+
+// HasLifecycleEvents reports whether the flattened chain events contain any inference lifecycle event
+func HasLifecycleEvents(events map[string][]string) bool {
+	for _, chainEvent := range chainEventTypes {
+		if len(events[chainEvent+".inference_id"]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseChainEvents builds lifecycle events from the flattened events of a transaction or a block.
+// Attributes of events of the same kind are listed in emission order, so the n-th value of each
+// attribute belongs to the n-th event.
+func ParseChainEvents(events map[string][]string, height int64) []Event {
+	var result []Event
+	for _, chainEvent := range chainEventTypes {
+		attribute := func(name string, i int) string {
+			values := events[chainEvent+"."+name]
+			if i < len(values) {
+				return values[i]
+			}
+			return ""
+		}
+		for i, inferenceId := range events[chainEvent+".inference_id"] {
+			event := Event{
+				InferenceId: inferenceId,
+				RequestedBy: attribute("requested_by", i),
+				Height:      height,
+				Executor:    attribute("executor", i),
+			}
+			switch chainEvent {
+			case chainInferenceFinished:
+				event.Type = EventFinished
+			case chainInferenceValidation:
+				event.Validator = attribute("validator", i)
+				if attribute("needs_revalidation", i) == "true" {
+					event.Type = EventRevalidationRequested
+				} else {
+					event.Type = EventValidated
+				}
+			case chainInferenceRevalidated:
+				event.Type = EventRevalidated
+			case chainInferenceInvalidated:
+				event.Type = EventInvalidated
+			case chainInferenceExpired:
+				event.Type = EventExpired
+			}
+			if refund := attribute("refund", i); refund != "" {
+				event.Refund, _ = strconv.ParseInt(refund, 10, 64)
+			}
+			event.Id = fmt.Sprintf("%d-%s-%s", height, event.Type, inferenceId)
+			if event.Validator != "" {
+				event.Id += "-" + event.Validator
+			}
+			result = append(result, event)
+		}
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package subscriptions
+
+import (
+	"decentralized-api/logging"
+	"sync"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// streamBufferSize is how many events a stream subscriber may fall behind before events are dropped
+const streamBufferSize = 64
+
+type streamSubscriber struct {
+	requester string
+	events    chan Event
+}
+
+// Hub fans the lifecycle events out to the stream subscribers and the webhooks of their requester
+type Hub struct {
+	dispatcher *Dispatcher
+
+	mu          sync.RWMutex
+	subscribers map[*streamSubscriber]struct{}
+}
+
+func NewHub(dispatcher *Dispatcher) *Hub {
+	return &Hub{
+		dispatcher:  dispatcher,
+		subscribers: make(map[*streamSubscriber]struct{}),
+	}
+}
+
+// Subscribe streams the events of the inferences requested by requester until unsubscribe is called
+func (h *Hub) Subscribe(requester string) (events <-chan Event, unsubscribe func()) {
+	subscriber := &streamSubscriber{requester: requester, events: make(chan Event, streamBufferSize)}
+	h.mu.Lock()
+	h.subscribers[subscriber] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return subscriber.events, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers, subscriber)
+			h.mu.Unlock()
+		})
+	}
+}
+
+func (h *Hub) Publish(events ...Event) {
+	for _, event := range events {
+		if event.RequestedBy == "" {
+			logging.Debug("Lifecycle event without requester, not published", types.EventProcessing, "id", event.Id)
+			continue
+		}
+		h.publishToStreams(event)
+		if h.dispatcher != nil {
+			h.dispatcher.Dispatch(event)
+		}
+	}
+}
+
+func (h *Hub) publishToStreams(event Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for subscriber := range h.subscribers {
+		if subscriber.requester != event.RequestedBy {
+			continue
+		}
+		select {
+		case subscriber.events <- event:
+		default:
+			// A slow client must not hold up the event listener
+			logging.Warn("Stream subscriber is falling behind, dropping event", types.EventProcessing, "requester", subscriber.requester, "id", event.Id)
+		}
+	}
+}
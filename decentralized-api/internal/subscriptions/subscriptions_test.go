@@ -0,0 +1,175 @@
+package subscriptions
+
+import (
+	"decentralized-api/utils"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	natssrv "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChainEvents(t *testing.T) {
+	events := map[string][]string{
+		"tx.height":                               {"42"},
+		"inference_validation.inference_id":       {"inf-1", "inf-2"},
+		"inference_validation.validator":          {"val-1", "val-2"},
+		"inference_validation.needs_revalidation": {"false", "true"},
+		"inference_validation.passed":             {"true", "false"},
+		"inference_validation.requested_by":       {"dev-1", "dev-2"},
+		"inference_invalidated.inference_id":      {"inf-3"},
+		"inference_invalidated.executor":          {"exec-1"},
+		"inference_invalidated.requested_by":      {"dev-1"},
+		"inference_invalidated.refund":            {"150"},
+	}
+	require.True(t, HasLifecycleEvents(events))
+	require.False(t, HasLifecycleEvents(map[string][]string{"tx.height": {"42"}}))
+
+	parsed := ParseChainEvents(events, 42)
+	require.Len(t, parsed, 3)
+
+	require.Equal(t, Event{
+		Id: "42-validated-inf-1-val-1", Type: EventValidated, InferenceId: "inf-1", RequestedBy: "dev-1", Height: 42, Validator: "val-1",
+	}, parsed[0])
+	require.Equal(t, EventRevalidationRequested, parsed[1].Type)
+	require.Equal(t, "dev-2", parsed[1].RequestedBy)
+	require.Equal(t, Event{
+		Id: "42-invalidated-inf-3", Type: EventInvalidated, InferenceId: "inf-3", RequestedBy: "dev-1", Height: 42, Executor: "exec-1", Refund: 150,
+	}, parsed[2])
+}
+
+func TestHubFiltersByRequester(t *testing.T) {
+	hub := NewHub(nil)
+	aliceEvents, unsubscribeAlice := hub.Subscribe("alice")
+	bobEvents, unsubscribeBob := hub.Subscribe("bob")
+	defer unsubscribeBob()
+
+	hub.Publish(Event{Id: "1", Type: EventFinished, RequestedBy: "alice"}, Event{Id: "2", Type: EventExpired, RequestedBy: "bob"})
+	require.Equal(t, "1", (<-aliceEvents).Id)
+	require.Equal(t, "2", (<-bobEvents).Id)
+	require.Empty(t, aliceEvents)
+
+	unsubscribeAlice()
+	unsubscribeAlice()
+	hub.Publish(Event{Id: "3", Type: EventFinished, RequestedBy: "alice"})
+	require.Empty(t, aliceEvents)
+}
+
+func TestDispatcherSignsAndRetries(t *testing.T) {
+	var attempts atomic.Int32
+	delivered := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		timestamp, err := strconv.ParseInt(r.Header.Get(utils.XWebhookTimestampHeader), 10, 64)
+		require.NoError(t, err)
+		require.Equal(t, Sign("secret", timestamp, body), r.Header.Get(utils.XWebhookSignatureHeader))
+		require.Equal(t, "hook-1", r.Header.Get(utils.XWebhookIdHeader))
+
+		var event Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		require.Equal(t, event.Id, r.Header.Get(utils.XWebhookEventIdHeader))
+		delivered <- event
+	}))
+	defer server.Close()
+
+	store := NewMemoryWebhookStore()
+	require.NoError(t, store.Add(Webhook{Id: "hook-1", Requester: "alice", Url: server.URL, Secret: "secret", EventTypes: []EventType{EventInvalidated}}, 5))
+	dispatcher := NewDispatcher(store, 3, time.Second, 1)
+	dispatcher.retryDelay = time.Millisecond
+	// The test server listens on loopback, which the default client refuses
+	dispatcher.client = server.Client()
+
+	// Filtered out by the webhook's event types
+	dispatcher.Dispatch(Event{Id: "skipped", Type: EventFinished, RequestedBy: "alice"})
+	dispatcher.Dispatch(Event{Id: "invalidated", Type: EventInvalidated, RequestedBy: "alice"})
+
+	select {
+	case event := <-delivered:
+		require.Equal(t, "invalidated", event.Id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	require.Equal(t, int32(3), attempts.Load())
+}
+
+func TestDispatcherRefusesPrivateAddresses(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher(NewMemoryWebhookStore(), 1, time.Second, 1)
+	err := dispatcher.post(Webhook{Id: "hook-1", Url: server.URL, Secret: "secret"}, "event", []byte("{}"))
+	require.ErrorIs(t, err, ErrPrivateWebhookAddress)
+	require.Zero(t, hits.Load())
+
+	for _, ip := range []string{"10.0.0.1", "192.168.1.1", "169.254.169.254", "::1", "fe80::1", "0.0.0.0"} {
+		require.False(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+	require.True(t, isPublicIP(net.ParseIP("8.8.8.8")))
+}
+
+func TestMemoryWebhookStore(t *testing.T) {
+	store := NewMemoryWebhookStore()
+	require.NoError(t, store.Add(Webhook{Id: "1", Requester: "alice"}, 2))
+	require.NoError(t, store.Add(Webhook{Id: "2", Requester: "alice"}, 2))
+	require.ErrorIs(t, store.Add(Webhook{Id: "3", Requester: "alice"}, 2), ErrTooManyWebhooks)
+
+	require.NoError(t, store.Remove("alice", "1"))
+	require.ErrorIs(t, store.Remove("alice", "1"), ErrWebhookNotFound)
+	require.ErrorIs(t, store.Remove("bob", "2"), ErrWebhookNotFound)
+
+	webhooks, err := store.List("alice")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	require.Equal(t, "2", webhooks[0].Id)
+}
+
+func TestNatsWebhookStoreIsShared(t *testing.T) {
+	ns, err := natssrv.NewServer(&natssrv.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	require.NoError(t, err)
+	go ns.Start()
+	require.True(t, ns.ReadyForConnections(5*time.Second))
+	t.Cleanup(ns.Shutdown)
+
+	newStore := func() *NatsWebhookStore {
+		nc, err := nats.Connect(ns.ClientURL())
+		require.NoError(t, err)
+		t.Cleanup(nc.Close)
+		js, err := nc.JetStream()
+		require.NoError(t, err)
+		store, err := NewNatsWebhookStore(js)
+		require.NoError(t, err)
+		return store
+	}
+	first, second := newStore(), newStore()
+
+	require.NoError(t, first.Add(Webhook{Id: "1", Requester: "alice", Url: "https://billing.example/hook"}, 2))
+	require.NoError(t, second.Add(Webhook{Id: "2", Requester: "alice"}, 2))
+	require.ErrorIs(t, first.Add(Webhook{Id: "3", Requester: "alice"}, 2), ErrTooManyWebhooks)
+
+	webhooks, err := second.List("alice")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	require.Equal(t, "https://billing.example/hook", webhooks[0].Url)
+
+	require.NoError(t, second.Remove("alice", "1"))
+	require.ErrorIs(t, first.Remove("alice", "1"), ErrWebhookNotFound)
+	webhooks, err = first.List("bob")
+	require.NoError(t, err)
+	require.Empty(t, webhooks)
+}
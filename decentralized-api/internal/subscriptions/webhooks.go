@@ -0,0 +1,194 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WebhookBucket is the JetStream key-value bucket holding webhooks, shared by every API instance
+	WebhookBucket = "webhooks"
+
+	maxUpdateAttempts = 5
+)
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+	ErrTooManyWebhooks = errors.New("too many webhooks registered for the requester")
+	ErrStoreContention = errors.New("too many concurrent updates of the same requester's webhooks")
+)
+
+// Webhook receives the lifecycle events of the inferences requested by Requester
+type Webhook struct {
+	Id        string `json:"id"`
+	Requester string `json:"requester"`
+	Url       string `json:"url"`
+	// Secret signs the deliveries, see Sign
+	Secret string `json:"secret"`
+	// EventTypes limits the deliveries to these types, all types are delivered when empty
+	EventTypes []EventType `json:"event_types,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+func (w Webhook) Accepts(eventType EventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookStore keeps the webhooks registered by requesters
+type WebhookStore interface {
+	// Add registers a webhook, failing with ErrTooManyWebhooks when the requester already has maxPerRequester
+	Add(webhook Webhook, maxPerRequester int) error
+	Remove(requester string, id string) error
+	List(requester string) ([]Webhook, error)
+}
+
+// MemoryWebhookStore forgets every webhook on restart, it is meant for tests and local runs
+type MemoryWebhookStore struct {
+	webhooks map[string][]Webhook
+	mu       sync.Mutex
+}
+
+func NewMemoryWebhookStore() *MemoryWebhookStore {
+	return &MemoryWebhookStore{webhooks: make(map[string][]Webhook)}
+}
+
+func (s *MemoryWebhookStore) Add(webhook Webhook, maxPerRequester int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.webhooks[webhook.Requester]) >= maxPerRequester {
+		return ErrTooManyWebhooks
+	}
+	s.webhooks[webhook.Requester] = append(s.webhooks[webhook.Requester], webhook)
+	return nil
+}
+
+func (s *MemoryWebhookStore) Remove(requester string, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	webhooks, err := withoutWebhook(s.webhooks[requester], id)
+	if err != nil {
+		return err
+	}
+	s.webhooks[requester] = webhooks
+	return nil
+}
+
+func (s *MemoryWebhookStore) List(requester string) ([]Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Webhook(nil), s.webhooks[requester]...), nil
+}
+
+// NatsWebhookStore keeps the webhooks of each requester as one value of a JetStream key-value
+// bucket, so they survive restarts and are shared by all API instances. Updates are a
+// compare-and-set on the value's revision.
+type NatsWebhookStore struct {
+	kv nats.KeyValue
+}
+
+func NewNatsWebhookStore(js nats.JetStreamContext) (*NatsWebhookStore, error) {
+	kv, err := js.KeyValue(WebhookBucket)
+	if errors.Is(err, nats.ErrBucketNotFound) {
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:  WebhookBucket,
+			Storage: nats.FileStorage,
+		})
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open webhook bucket")
+	}
+	return &NatsWebhookStore{kv: kv}, nil
+}
+
+func (s *NatsWebhookStore) Add(webhook Webhook, maxPerRequester int) error {
+	return s.update(webhook.Requester, func(webhooks []Webhook) ([]Webhook, error) {
+		if len(webhooks) >= maxPerRequester {
+			return nil, ErrTooManyWebhooks
+		}
+		return append(webhooks, webhook), nil
+	})
+}
+
+func (s *NatsWebhookStore) Remove(requester string, id string) error {
+	return s.update(requester, func(webhooks []Webhook) ([]Webhook, error) {
+		return withoutWebhook(webhooks, id)
+	})
+}
+
+func (s *NatsWebhookStore) List(requester string) ([]Webhook, error) {
+	entry, err := s.kv.Get(requester)
+	if errors.Is(err, nats.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read webhooks")
+	}
+	var webhooks []Webhook
+	if err := json.Unmarshal(entry.Value(), &webhooks); err != nil {
+		return nil, errors.Wrap(err, "failed to decode webhooks")
+	}
+	return webhooks, nil
+}
+
+func (s *NatsWebhookStore) update(requester string, apply func([]Webhook) ([]Webhook, error)) error {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		var webhooks []Webhook
+		var revision uint64
+		entry, err := s.kv.Get(requester)
+		switch {
+		case errors.Is(err, nats.ErrKeyNotFound):
+		case err != nil:
+			return errors.Wrap(err, "failed to read webhooks")
+		default:
+			revision = entry.Revision()
+			if err := json.Unmarshal(entry.Value(), &webhooks); err != nil {
+				return errors.Wrap(err, "failed to decode webhooks")
+			}
+		}
+
+		webhooks, err = apply(webhooks)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(webhooks)
+		if err != nil {
+			return err
+		}
+
+		if revision == 0 {
+			_, err = s.kv.Create(requester, value)
+		} else {
+			_, err = s.kv.Update(requester, value, revision)
+		}
+		if errors.Is(err, nats.ErrKeyExists) {
+			// Another instance changed the list in between, apply the change to what it wrote
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to store webhooks")
+		}
+		return nil
+	}
+	return ErrStoreContention
+}
+
+func withoutWebhook(webhooks []Webhook, id string) ([]Webhook, error) {
+	for i, webhook := range webhooks {
+		if webhook.Id == id {
+			return append(webhooks[:i:i], webhooks[i+1:]...), nil
+		}
+	}
+	return nil, ErrWebhookNotFound
+}
@@ -0,0 +1,532 @@
+package tokenizer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	metaspace         = "▁"
+	maxWordCacheItems = 100_000
+	// maxBPEWordRunes caps the symbols merged at once; merging is quadratic in the word length.
+	maxBPEWordRunes = 256
+)
+
+var ErrUnsupportedTokenizer = errors.New("unsupported tokenizer model")
+
+type preTokenizeMode int
+
+const (
+	byteLevelMode preTokenizeMode = iota
+	metaspaceMode
+)
+
+// hfTokenizerFile mirrors the subset of the HuggingFace tokenizer.json format we need for counting.
+type hfTokenizerFile struct {
+	AddedTokens []struct {
+		Id      int    `json:"id"`
+		Content string `json:"content"`
+	} `json:"added_tokens"`
+	Normalizer   *hfComponent `json:"normalizer"`
+	PreTokenizer *hfComponent `json:"pre_tokenizer"`
+	Model        struct {
+		Type         string          `json:"type"`
+		Vocab        map[string]int  `json:"vocab"`
+		Merges       json.RawMessage `json:"merges"`
+		ByteFallback bool            `json:"byte_fallback"`
+		IgnoreMerges bool            `json:"ignore_merges"`
+	} `json:"model"`
+}
+
+type hfComponent struct {
+	Type           string          `json:"type"`
+	AddPrefixSpace *bool           `json:"add_prefix_space"`
+	PrependScheme  string          `json:"prepend_scheme"`
+	Replacement    string          `json:"replacement"`
+	Content        string          `json:"content"`
+	Pattern        json.RawMessage `json:"pattern"`
+	Normalizers    []hfComponent   `json:"normalizers"`
+	PreTokenizers  []hfComponent   `json:"pretokenizers"`
+}
+
+// walk visits the component and all nested sequence members.
+func (c *hfComponent) walk(visit func(*hfComponent)) {
+	if c == nil {
+		return
+	}
+	visit(c)
+	for i := range c.Normalizers {
+		c.Normalizers[i].walk(visit)
+	}
+	for i := range c.PreTokenizers {
+		c.PreTokenizers[i].walk(visit)
+	}
+}
+
+type mergePair struct {
+	left  string
+	right string
+}
+
+// BPETokenizer counts tokens using a byte-level (GPT-2, Qwen, Llama 3) or
+// SentencePiece-style (Llama 2, Mistral) BPE vocabulary loaded from a
+// HuggingFace tokenizer.json file. It only counts tokens, it never produces ids.
+type BPETokenizer struct {
+	name           string
+	mode           preTokenizeMode
+	vocab          map[string]int
+	ranks          map[mergePair]int
+	addedTokens    *addedTokenTrie
+	byteFallback   bool
+	ignoreMerges   bool
+	addPrefixSpace bool
+	maxDigits      int
+
+	cacheMu   sync.RWMutex
+	wordCache map[string]int
+}
+
+// LoadHuggingFaceTokenizer parses a tokenizer.json document.
+func LoadHuggingFaceTokenizer(name string, r io.Reader) (*BPETokenizer, error) {
+	var file hfTokenizerFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to decode tokenizer.json: %w", err)
+	}
+	if file.Model.Type != "" && file.Model.Type != "BPE" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedTokenizer, file.Model.Type)
+	}
+	if len(file.Model.Vocab) == 0 {
+		return nil, fmt.Errorf("%w: empty vocabulary", ErrUnsupportedTokenizer)
+	}
+
+	merges, err := parseMerges(file.Model.Merges)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &BPETokenizer{
+		name:         name,
+		mode:         metaspaceMode,
+		vocab:        file.Model.Vocab,
+		ranks:        make(map[mergePair]int, len(merges)),
+		byteFallback: file.Model.ByteFallback,
+		ignoreMerges: file.Model.IgnoreMerges,
+		wordCache:    make(map[string]int),
+	}
+	for rank, pair := range merges {
+		if _, exists := t.ranks[pair]; !exists {
+			t.ranks[pair] = rank
+		}
+	}
+	for _, added := range file.AddedTokens {
+		if added.Content != "" {
+			if t.addedTokens == nil {
+				t.addedTokens = &addedTokenTrie{}
+			}
+			t.addedTokens.insert(added.Content)
+		}
+	}
+
+	file.PreTokenizer.walk(func(c *hfComponent) {
+		switch c.Type {
+		case "ByteLevel":
+			t.mode = byteLevelMode
+			if c.AddPrefixSpace != nil && *c.AddPrefixSpace {
+				t.addPrefixSpace = true
+			}
+		case "Metaspace":
+			t.addPrefixSpace = c.PrependScheme != "never" && (c.AddPrefixSpace == nil || *c.AddPrefixSpace)
+		case "Split":
+			t.maxDigits = maxDigitsFromPattern(c.Pattern)
+		}
+	})
+	file.Normalizer.walk(func(c *hfComponent) {
+		if c.Type == "Prepend" && c.Content == metaspace {
+			t.addPrefixSpace = true
+		}
+	})
+
+	return t, nil
+}
+
+func parseMerges(raw json.RawMessage) ([]mergePair, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var joined []string
+	if err := json.Unmarshal(raw, &joined); err == nil {
+		pairs := make([]mergePair, 0, len(joined))
+		for _, merge := range joined {
+			left, right, found := strings.Cut(merge, " ")
+			if !found {
+				return nil, fmt.Errorf("malformed merge entry %q", merge)
+			}
+			pairs = append(pairs, mergePair{left: left, right: right})
+		}
+		return pairs, nil
+	}
+
+	var split [][]string
+	if err := json.Unmarshal(raw, &split); err != nil {
+		return nil, fmt.Errorf("failed to decode merges: %w", err)
+	}
+	pairs := make([]mergePair, 0, len(split))
+	for _, merge := range split {
+		if len(merge) != 2 {
+			return nil, fmt.Errorf("malformed merge entry %v", merge)
+		}
+		pairs = append(pairs, mergePair{left: merge[0], right: merge[1]})
+	}
+	return pairs, nil
+}
+
+// maxDigitsFromPattern detects how digits are grouped by the Split regex:
+// Llama 3 uses \p{N}{1,3}, Qwen splits every digit, GPT-2 keeps whole runs.
+func maxDigitsFromPattern(raw json.RawMessage) int {
+	pattern := string(raw)
+	switch {
+	case strings.Contains(pattern, `\\p{N}{1,3}`):
+		return 3
+	case strings.Contains(pattern, `\\p{N}|`), strings.Contains(pattern, `\\p{N}"`):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (t *BPETokenizer) Name() string {
+	return t.name
+}
+
+func (t *BPETokenizer) CountTokens(text string) int {
+	count := 0
+	first := true
+	for _, segment := range t.splitAddedTokens(text) {
+		if segment.added {
+			count++
+			continue
+		}
+		for _, word := range t.preTokenize(segment.text, first) {
+			count += t.countWord(word)
+		}
+		first = false
+	}
+	return count
+}
+
+type segment struct {
+	text  string
+	added bool
+}
+
+// splitAddedTokens separates special/added tokens (e.g. <|im_start|>) which
+// are always encoded as a single token and never participate in merges.
+// The text is scanned once, taking the longest added token at the leftmost position.
+func (t *BPETokenizer) splitAddedTokens(text string) []segment {
+	if t.addedTokens == nil {
+		return []segment{{text: text}}
+	}
+
+	var segments []segment
+	start := 0
+	for i := 0; i < len(text); {
+		length := t.addedTokens.longestPrefix(text[i:])
+		if length == 0 {
+			i++
+			continue
+		}
+		if i > start {
+			segments = append(segments, segment{text: text[start:i]})
+		}
+		segments = append(segments, segment{text: text[i : i+length], added: true})
+		i += length
+		start = i
+	}
+	if start < len(text) {
+		segments = append(segments, segment{text: text[start:]})
+	}
+	return segments
+}
+
+// addedTokenTrie is a byte trie over the added tokens. Matching at a position
+// costs at most the length of the longest added token.
+type addedTokenTrie struct {
+	children map[byte]*addedTokenTrie
+	terminal bool
+}
+
+func (n *addedTokenTrie) insert(token string) {
+	for i := 0; i < len(token); i++ {
+		child, ok := n.children[token[i]]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[byte]*addedTokenTrie)
+			}
+			child = &addedTokenTrie{}
+			n.children[token[i]] = child
+		}
+		n = child
+	}
+	n.terminal = true
+}
+
+// longestPrefix returns the length of the longest added token text starts with, or 0.
+func (n *addedTokenTrie) longestPrefix(text string) int {
+	longest := 0
+	for i := 0; i < len(text); i++ {
+		n = n.children[text[i]]
+		if n == nil {
+			break
+		}
+		if n.terminal {
+			longest = i + 1
+		}
+	}
+	return longest
+}
+
+func (t *BPETokenizer) preTokenize(text string, first bool) []string {
+	if text == "" {
+		return nil
+	}
+	switch t.mode {
+	case byteLevelMode:
+		if t.addPrefixSpace && first && !strings.HasPrefix(text, " ") {
+			text = " " + text
+		}
+		pieces := splitWords(text, t.maxDigits)
+		for i, piece := range pieces {
+			pieces[i] = byteLevelEncode(piece)
+		}
+		return pieces
+	default:
+		text = strings.ReplaceAll(text, " ", metaspace)
+		if t.addPrefixSpace && first && !strings.HasPrefix(text, metaspace) {
+			text = metaspace + text
+		}
+		return splitMetaspace(text)
+	}
+}
+
+// splitMetaspace cuts text before every ▁ so each word carries its leading
+// space marker, matching the Metaspace pre-tokenizer with split enabled.
+func splitMetaspace(text string) []string {
+	var words []string
+	for len(text) > 0 {
+		offset := 0
+		if strings.HasPrefix(text, metaspace) {
+			offset = len(metaspace)
+		}
+		next := strings.Index(text[offset:], metaspace)
+		if next < 0 {
+			words = append(words, text)
+			break
+		}
+		words = append(words, text[:offset+next])
+		text = text[offset+next:]
+	}
+	return words
+}
+
+func (t *BPETokenizer) countWord(word string) int {
+	if utf8.RuneCountInString(word) > maxBPEWordRunes {
+		return t.countLongWord(word)
+	}
+
+	t.cacheMu.RLock()
+	count, ok := t.wordCache[word]
+	t.cacheMu.RUnlock()
+	if ok {
+		return count
+	}
+
+	count = t.bpeCount(word)
+
+	t.cacheMu.Lock()
+	if len(t.wordCache) >= maxWordCacheItems {
+		t.wordCache = make(map[string]int)
+	}
+	t.wordCache[word] = count
+	t.cacheMu.Unlock()
+	return count
+}
+
+// countLongWord counts a word in chunks of maxBPEWordRunes runes. Merges across
+// chunk boundaries are lost, which slightly overestimates such (rare) words.
+// Chunks aren't cached since long words are unlikely to repeat.
+func (t *BPETokenizer) countLongWord(word string) int {
+	count := 0
+	for len(word) > 0 {
+		end, runes := 0, 0
+		for end < len(word) && runes < maxBPEWordRunes {
+			_, size := utf8.DecodeRuneInString(word[end:])
+			end += size
+			runes++
+		}
+		count += t.bpeCount(word[:end])
+		word = word[end:]
+	}
+	return count
+}
+
+func (t *BPETokenizer) bpeCount(word string) int {
+	if t.ignoreMerges {
+		if _, ok := t.vocab[word]; ok {
+			return 1
+		}
+	}
+
+	symbols := make([]string, 0, utf8.RuneCountInString(word))
+	for _, r := range word {
+		symbols = append(symbols, string(r))
+	}
+
+	for len(symbols) > 1 {
+		bestIdx, bestRank := -1, 0
+		for i := 0; i < len(symbols)-1; i++ {
+			rank, ok := t.ranks[mergePair{left: symbols[i], right: symbols[i+1]}]
+			if ok && (bestIdx < 0 || rank < bestRank) {
+				bestIdx, bestRank = i, rank
+			}
+		}
+		if bestIdx < 0 {
+			break
+		}
+		symbols[bestIdx] = symbols[bestIdx] + symbols[bestIdx+1]
+		symbols = append(symbols[:bestIdx+1], symbols[bestIdx+2:]...)
+	}
+
+	count := 0
+	for _, symbol := range symbols {
+		if _, ok := t.vocab[symbol]; !ok && t.byteFallback {
+			count += len(symbol)
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// splitWords approximates the GPT-2 family pre-tokenizer regex
+// 's|'t|'re|'ve|'m|'ll|'d| ?\p{L}+| ?\p{N}+| ?[^\s\p{L}\p{N}]+|\s+(?!\S)|\s+
+// without requiring look-ahead support. maxDigits > 0 caps digit groups.
+func splitWords(text string, maxDigits int) []string {
+	runes := []rune(text)
+	var words []string
+	i := 0
+	for i < len(runes) {
+		start := i
+		r := runes[i]
+
+		if r == '\'' {
+			if n := contractionLength(runes[i+1:]); n > 0 {
+				i += n + 1
+				words = append(words, string(runes[start:i]))
+				continue
+			}
+		}
+
+		if r == ' ' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			i++
+			r = runes[i]
+		}
+
+		switch {
+		case isLetter(r):
+			for i < len(runes) && isLetter(runes[i]) {
+				i++
+			}
+		case unicode.IsNumber(r):
+			digits := 0
+			for i < len(runes) && unicode.IsNumber(runes[i]) && (maxDigits == 0 || digits < maxDigits) {
+				i++
+				digits++
+			}
+		case !unicode.IsSpace(r):
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !isLetter(runes[i]) && !unicode.IsNumber(runes[i]) {
+				i++
+			}
+		default:
+			for i < len(runes) && unicode.IsSpace(runes[i]) {
+				i++
+			}
+			// \s+(?!\S): leave the last whitespace char for the following word
+			if i < len(runes) && i-start > 1 {
+				i--
+				if runes[i] != ' ' {
+					words = append(words, string(runes[start:i]))
+					start = i
+					i++
+				}
+			}
+		}
+		words = append(words, string(runes[start:i]))
+	}
+	return words
+}
+
+func isLetter(r rune) bool {
+	return unicode.IsLetter(r) || unicode.Is(unicode.M, r)
+}
+
+func contractionLength(rest []rune) int {
+	if len(rest) == 0 {
+		return 0
+	}
+	first := unicode.ToLower(rest[0])
+	switch first {
+	case 's', 't', 'm', 'd':
+		return 1
+	}
+	if len(rest) < 2 {
+		return 0
+	}
+	second := unicode.ToLower(rest[1])
+	switch {
+	case first == 'r' && second == 'e', first == 'v' && second == 'e', first == 'l' && second == 'l':
+		return 2
+	}
+	return 0
+}
+
+var byteToUnicode = buildByteToUnicode()
+
+// buildByteToUnicode reproduces the GPT-2 bytes_to_unicode table that maps
+// every byte to a printable rune so byte-level vocabularies stay valid UTF-8.
+func buildByteToUnicode() [256]rune {
+	var table [256]rune
+	assigned := [256]bool{}
+	for b := '!'; b <= '~'; b++ {
+		table[b], assigned[b] = b, true
+	}
+	for b := '¡'; b <= '¬'; b++ {
+		table[b], assigned[b] = b, true
+	}
+	for b := '®'; b <= 'ÿ'; b++ {
+		table[b], assigned[b] = b, true
+	}
+	next := rune(256)
+	for b := 0; b < 256; b++ {
+		if !assigned[b] {
+			table[b] = next
+			next++
+		}
+	}
+	return table
+}
+
+func byteLevelEncode(word string) string {
+	var sb strings.Builder
+	sb.Grow(len(word) * 2)
+	for i := 0; i < len(word); i++ {
+		sb.WriteRune(byteToUnicode[word[i]])
+	}
+	return sb.String()
+}
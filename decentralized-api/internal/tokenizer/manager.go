@@ -0,0 +1,220 @@
+package tokenizer
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	DefaultHuggingFaceUrl = "https://huggingface.co"
+	defaultRetryInterval  = 5 * time.Minute
+	defaultLoadTimeout    = 2 * time.Minute
+	tokenizerFileName     = "tokenizer.json"
+	maxManagerEntries     = 64
+)
+
+var ErrModelNotFound = errors.New("model not found")
+
+// ModelResolver returns the governance model definition for a model id.
+type ModelResolver func(ctx context.Context, modelId string) (*types.Model, error)
+
+// ModelFilter reports whether a model id is registered on chain. Get consults
+// it before caching anything, so client-supplied names can't grow the cache.
+type ModelFilter func(modelId string) bool
+
+type ConfigProvider interface {
+	GetTokenizerConfig() apiconfig.TokenizerConfig
+}
+
+type entry struct {
+	tokenizer Tokenizer
+	loading   bool
+	failedAt  time.Time
+}
+
+// Manager owns one tokenizer per model. Vocabularies are resolved from the
+// governance Model (hf_repo/hf_commit), downloaded once into a local cache
+// directory and loaded in the background. Until a vocabulary is ready, or if
+// it can't be obtained, callers get the heuristic fallback.
+type Manager struct {
+	resolveModel  ModelResolver
+	isKnownModel  ModelFilter
+	config        apiconfig.TokenizerConfig
+	fallback      Tokenizer
+	httpClient    *http.Client
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// NewManager creates a Manager. A nil isKnownModel accepts every model id.
+func NewManager(configProvider ConfigProvider, resolveModel ModelResolver, isKnownModel ModelFilter) *Manager {
+	config := configProvider.GetTokenizerConfig()
+	if config.HfBaseUrl == "" {
+		config.HfBaseUrl = DefaultHuggingFaceUrl
+	}
+	if config.CacheDir == "" {
+		config.CacheDir = filepath.Join(os.TempDir(), "dapi-tokenizers")
+	}
+	return &Manager{
+		resolveModel:  resolveModel,
+		isKnownModel:  isKnownModel,
+		config:        config,
+		fallback:      NewHeuristicTokenizer(config.FallbackCharsPerToken),
+		httpClient:    &http.Client{Timeout: defaultLoadTimeout},
+		retryInterval: defaultRetryInterval,
+		entries:       make(map[string]*entry),
+	}
+}
+
+// CountTokens counts tokens for the model, falling back to the heuristic
+// while the model vocabulary isn't available.
+func (m *Manager) CountTokens(model string, text string) int {
+	return m.Get(model).CountTokens(text)
+}
+
+// Get returns the tokenizer for the model without blocking. The first call for
+// a model schedules the vocabulary download. Unknown models, and new models
+// once the cache is full, always get the fallback.
+func (m *Manager) Get(model string) Tokenizer {
+	if m.config.Disabled || m.resolveModel == nil {
+		return m.fallback
+	}
+	if m.isKnownModel != nil && !m.isKnownModel(model) {
+		return m.fallback
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, found := m.entries[model]
+	if !found {
+		if len(m.entries) >= maxManagerEntries {
+			return m.fallback
+		}
+		e = &entry{}
+		m.entries[model] = e
+	}
+	if e.tokenizer != nil {
+		return e.tokenizer
+	}
+	if !e.loading && (e.failedAt.IsZero() || time.Since(e.failedAt) >= m.retryInterval) {
+		e.loading = true
+		go m.load(model)
+	}
+	return m.fallback
+}
+
+func (m *Manager) load(model string) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultLoadTimeout)
+	defer cancel()
+
+	tokenizer, err := m.loadTokenizer(ctx, model)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := m.entries[model]
+	e.loading = false
+	if err != nil {
+		logging.Warn("Failed to load tokenizer, using heuristic estimation", types.Inferences,
+			"model", model, "error", err)
+		e.failedAt = time.Now()
+		return
+	}
+	logging.Info("Tokenizer loaded", types.Inferences, "model", model, "tokenizer", tokenizer.Name())
+	e.tokenizer = tokenizer
+}
+
+func (m *Manager) loadTokenizer(ctx context.Context, modelId string) (Tokenizer, error) {
+	model, err := m.resolveModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+	if model.HfRepo == "" {
+		return nil, fmt.Errorf("model %s has no hf_repo", modelId)
+	}
+
+	path, err := m.ensureDownloaded(ctx, model.HfRepo, model.HfCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadHuggingFaceTokenizer(model.HfRepo, file)
+}
+
+// ensureDownloaded returns the path of the cached tokenizer.json, downloading
+// it first if needed. Files are pinned by commit so cached copies never go stale.
+func (m *Manager) ensureDownloaded(ctx context.Context, repo string, commit string) (string, error) {
+	revision := commit
+	if revision == "" {
+		revision = "main"
+	}
+	dir := filepath.Join(m.config.CacheDir, strings.ReplaceAll(repo, "/", "--"), revision)
+	path := filepath.Join(dir, tokenizerFileName)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	downloadUrl, err := url.JoinPath(m.config.HfBaseUrl, repo, "resolve", revision, tokenizerFileName)
+	if err != nil {
+		return "", err
+	}
+	logging.Info("Downloading tokenizer", types.Inferences, "url", downloadUrl)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	if m.config.HfToken != "" {
+		req.Header.Set("Authorization", "Bearer "+m.config.HfToken)
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("tokenizer download failed with status: %d", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	tmp, err := os.CreateTemp(dir, tokenizerFileName+".*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,43 @@
+package tokenizer
+
+import (
+	"math"
+	"unicode/utf8"
+)
+
+// Tokenizer counts the tokens a model would produce for a piece of text.
+// Implementations must be safe for concurrent use.
+type Tokenizer interface {
+	CountTokens(text string) int
+	Name() string
+}
+
+// DefaultCharsPerToken is a deliberately conservative ratio: real BPE
+// vocabularies average ~4 characters per token for English text, so
+// dividing by 3 slightly over-estimates and keeps escrow checks safe.
+const DefaultCharsPerToken = 3.0
+
+// HeuristicTokenizer estimates token counts from the text length. It is used
+// while a real vocabulary is loading or when it cannot be obtained at all.
+type HeuristicTokenizer struct {
+	CharsPerToken float64
+}
+
+func NewHeuristicTokenizer(charsPerToken float64) *HeuristicTokenizer {
+	if charsPerToken <= 0 {
+		charsPerToken = DefaultCharsPerToken
+	}
+	return &HeuristicTokenizer{CharsPerToken: charsPerToken}
+}
+
+func (h *HeuristicTokenizer) CountTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	chars := utf8.RuneCountInString(text)
+	return int(math.Ceil(float64(chars) / h.CharsPerToken))
+}
+
+func (h *HeuristicTokenizer) Name() string {
+	return "heuristic"
+}
@@ -0,0 +1,241 @@
+package tokenizer
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+const byteLevelTokenizerJson = `{
+  "added_tokens": [{"id": 100, "content": "<|im_start|>"}, {"id": 101, "content": "<|im_end|>"}],
+  "normalizer": null,
+  "pre_tokenizer": {"type": "Sequence", "pretokenizers": [
+    {"type": "Split", "pattern": {"Regex": "(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\\r\\n\\p{L}\\p{N}]?\\p{L}+|\\p{N}| ?[^\\s\\p{L}\\p{N}]+[\\r\\n]*|\\s*[\\r\\n]+|\\s+(?!\\S)|\\s+"}},
+    {"type": "ByteLevel", "add_prefix_space": false, "use_regex": false}
+  ]},
+  "model": {
+    "type": "BPE",
+    "vocab": {"h": 0, "e": 1, "l": 2, "o": 3, "Ġ": 4, "w": 5, "r": 6, "d": 7, "he": 8, "ll": 9, "hell": 10,
+              "hello": 11, "Ġw": 12, "or": 13, "Ġwor": 14, "ld": 15, "Ġworld": 16, "1": 17, "2": 18},
+    "merges": ["h e", "l l", "he ll", "hell o", "Ġ w", "o r", "Ġw or", "l d", "Ġwor ld"]
+  }
+}`
+
+const metaspaceTokenizerJson = `{
+  "added_tokens": [{"id": 0, "content": "<s>"}],
+  "normalizer": {"type": "Sequence", "normalizers": [
+    {"type": "Prepend", "prepend": "▁", "content": "▁"},
+    {"type": "Replace", "pattern": {"String": " "}, "content": "▁"}
+  ]},
+  "pre_tokenizer": null,
+  "model": {
+    "type": "BPE",
+    "byte_fallback": true,
+    "vocab": {"▁": 1, "h": 2, "i": 3, "▁h": 4, "▁hi": 5, "<0xE2>": 6, "<0x98>": 7, "<0x83>": 8},
+    "merges": [["▁", "h"], ["▁h", "i"]]
+  }
+}`
+
+func loadTestTokenizer(t *testing.T, doc string) *BPETokenizer {
+	tok, err := LoadHuggingFaceTokenizer("test", strings.NewReader(doc))
+	require.NoError(t, err)
+	return tok
+}
+
+func TestHeuristicTokenizer(t *testing.T) {
+	tok := NewHeuristicTokenizer(0)
+	require.Equal(t, 0, tok.CountTokens(""))
+	require.Equal(t, 4, tok.CountTokens("hello world"))
+	require.Equal(t, 1, tok.CountTokens("☃"))
+}
+
+func TestSplitWords(t *testing.T) {
+	require.Equal(t,
+		[]string{"Hello", ",", " world", "!", " ", " It", "'s", " 123", "45"},
+		splitWords("Hello, world!  It's 12345", 3))
+	require.Equal(t, []string{"a", "\n", "\n", "b"}, splitWords("a\n\nb", 0))
+}
+
+func TestByteLevelBPE(t *testing.T) {
+	tok := loadTestTokenizer(t, byteLevelTokenizerJson)
+	require.Equal(t, byteLevelMode, tok.mode)
+	require.Equal(t, 1, tok.maxDigits)
+
+	require.Equal(t, 2, tok.CountTokens("hello world"))
+	require.Equal(t, 3, tok.CountTokens("<|im_start|>hello world"))
+	// "12" is split digit by digit, each digit is its own token
+	require.Equal(t, 4, tok.CountTokens("hello world12"))
+	// no merges apply to "dr", so it stays as two byte-level symbols
+	require.Equal(t, 2, tok.CountTokens("dr"))
+}
+
+func TestMetaspaceBPEWithByteFallback(t *testing.T) {
+	tok := loadTestTokenizer(t, metaspaceTokenizerJson)
+	require.Equal(t, metaspaceMode, tok.mode)
+
+	require.Equal(t, 2, tok.CountTokens("hi hi"))
+	// "☃" isn't in the vocabulary and falls back to its three UTF-8 bytes
+	require.Equal(t, 5, tok.CountTokens("hi ☃"))
+	require.Equal(t, 2, tok.CountTokens("<s>hi"))
+}
+
+func TestUnsupportedTokenizerModel(t *testing.T) {
+	_, err := LoadHuggingFaceTokenizer("test", strings.NewReader(`{"model": {"type": "Unigram", "vocab": {"a": 1}}}`))
+	require.ErrorIs(t, err, ErrUnsupportedTokenizer)
+}
+
+type testConfigProvider struct {
+	config apiconfig.TokenizerConfig
+}
+
+func (p testConfigProvider) GetTokenizerConfig() apiconfig.TokenizerConfig {
+	return p.config
+}
+
+func TestManagerDownloadsAndCachesTokenizer(t *testing.T) {
+	requests := 0
+	hf := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/org/model/resolve/abc123/tokenizer.json", r.URL.Path)
+		requests++
+		_, _ = w.Write([]byte(byteLevelTokenizerJson))
+	}))
+	defer hf.Close()
+
+	cacheDir := t.TempDir()
+	resolver := func(ctx context.Context, modelId string) (*types.Model, error) {
+		return &types.Model{Id: modelId, HfRepo: "org/model", HfCommit: "abc123"}, nil
+	}
+	manager := NewManager(testConfigProvider{config: apiconfig.TokenizerConfig{
+		CacheDir:  cacheDir,
+		HfBaseUrl: hf.URL,
+	}}, resolver, nil)
+
+	// The first call returns the heuristic while the vocabulary loads in the background
+	require.Equal(t, "heuristic", manager.Get("org/model").Name())
+	require.Eventually(t, func() bool {
+		return manager.Get("org/model").Name() == "org/model"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 2, manager.CountTokens("org/model", "hello world"))
+
+	_, err := os.Stat(filepath.Join(cacheDir, "org--model", "abc123", "tokenizer.json"))
+	require.NoError(t, err)
+
+	// A second manager reuses the cached file instead of downloading again
+	second := NewManager(testConfigProvider{config: apiconfig.TokenizerConfig{
+		CacheDir:  cacheDir,
+		HfBaseUrl: hf.URL,
+	}}, resolver, nil)
+	second.Get("org/model")
+	require.Eventually(t, func() bool {
+		return second.Get("org/model").Name() == "org/model"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, requests)
+}
+
+func TestManagerFallsBackWhenModelUnavailable(t *testing.T) {
+	resolver := func(ctx context.Context, modelId string) (*types.Model, error) {
+		return nil, ErrModelNotFound
+	}
+	manager := NewManager(testConfigProvider{config: apiconfig.TokenizerConfig{
+		CacheDir:              t.TempDir(),
+		FallbackCharsPerToken: 4,
+	}}, resolver, nil)
+
+	require.Equal(t, 3, manager.CountTokens("unknown", "hello world"))
+	require.Eventually(t, func() bool {
+		manager.mu.Lock()
+		defer manager.mu.Unlock()
+		e := manager.entries["unknown"]
+		return !e.loading && !e.failedAt.IsZero()
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, "heuristic", manager.Get("unknown").Name())
+}
+
+func TestManagerDisabled(t *testing.T) {
+	manager := NewManager(testConfigProvider{config: apiconfig.TokenizerConfig{Disabled: true}}, nil, nil)
+	require.Equal(t, "heuristic", manager.Get("any").Name())
+}
+
+func TestManagerIgnoresUnknownModels(t *testing.T) {
+	resolved := 0
+	resolver := func(ctx context.Context, modelId string) (*types.Model, error) {
+		resolved++
+		return nil, ErrModelNotFound
+	}
+	isKnown := func(modelId string) bool { return modelId == "org/model" }
+	manager := NewManager(testConfigProvider{config: apiconfig.TokenizerConfig{CacheDir: t.TempDir()}}, resolver, isKnown)
+
+	for i := 0; i < 10; i++ {
+		require.Equal(t, "heuristic", manager.Get(fmt.Sprintf("client-model-%d", i)).Name())
+	}
+	manager.mu.Lock()
+	require.Empty(t, manager.entries)
+	manager.mu.Unlock()
+	require.Zero(t, resolved)
+}
+
+func TestManagerBoundsEntries(t *testing.T) {
+	resolver := func(ctx context.Context, modelId string) (*types.Model, error) {
+		return nil, ErrModelNotFound
+	}
+	manager := NewManager(testConfigProvider{config: apiconfig.TokenizerConfig{CacheDir: t.TempDir()}}, resolver, nil)
+
+	for i := 0; i < maxManagerEntries*2; i++ {
+		manager.Get(fmt.Sprintf("model-%d", i))
+	}
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	require.Len(t, manager.entries, maxManagerEntries)
+}
+
+func TestLongWordIsCountedInChunks(t *testing.T) {
+	tok := loadTestTokenizer(t, byteLevelTokenizerJson)
+	word := strings.Repeat("l", maxBPEWordRunes*4)
+	// Every chunk has an even length, so all pairs still merge into "ll"
+	require.Equal(t, maxBPEWordRunes*2, tok.CountTokens(word))
+}
+
+func TestAddedTokensPreferTheLongestMatch(t *testing.T) {
+	tok := loadTestTokenizer(t, `{
+  "added_tokens": [{"id": 0, "content": "<a>"}, {"id": 1, "content": "<a><b>"}],
+  "model": {"type": "BPE", "vocab": {"x": 0}, "merges": []}
+}`)
+	require.Equal(t,
+		[]segment{{text: "x"}, {text: "<a><b>", added: true}, {text: "<a>", added: true}, {text: "<a"}},
+		tok.splitAddedTokens("x<a><b><a><a"))
+}
+
+// adversarialAddedTokenPrompt repeats near misses of every added token, so
+// each position starts a match that fails on its last byte.
+func adversarialAddedTokenPrompt(size int) string {
+	return strings.Repeat("<|im_start|<|im_end|", size/20+1)[:size]
+}
+
+func TestAddedTokensOnAdversarialPrompt(t *testing.T) {
+	tok := loadTestTokenizer(t, byteLevelTokenizerJson)
+	prompt := adversarialAddedTokenPrompt(1 << 20)
+	segments := tok.splitAddedTokens(prompt + "<|im_end|>")
+	require.Len(t, segments, 2)
+	require.Equal(t, prompt, segments[0].text)
+	require.True(t, segments[1].added)
+}
+
+func BenchmarkSplitAddedTokensAdversarial(b *testing.B) {
+	tok, err := LoadHuggingFaceTokenizer("test", strings.NewReader(byteLevelTokenizerJson))
+	require.NoError(b, err)
+	prompt := adversarialAddedTokenPrompt(1 << 20)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tok.splitAddedTokens(prompt)
+	}
+}
@@ -2,13 +2,16 @@ package validation
 
 import (
 	"bytes"
+	"context"
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/completionapi"
 	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/payloads"
 	"decentralized-api/internal/utils"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,18 +33,21 @@ type InferenceValidator struct {
 	nodeBroker    *broker.Broker
 	configManager *apiconfig.ConfigManager
 	phaseTracker  *chainphase.ChainPhaseTracker
+	payloads      *payloads.FileStore
 }
 
 func NewInferenceValidator(
 	nodeBroker *broker.Broker,
 	configManager *apiconfig.ConfigManager,
 	recorder cosmosclient.CosmosMessageClient,
-	phaseTracker *chainphase.ChainPhaseTracker) *InferenceValidator {
+	phaseTracker *chainphase.ChainPhaseTracker,
+	payloadStore *payloads.FileStore) *InferenceValidator {
 	return &InferenceValidator{
 		nodeBroker:    nodeBroker,
 		configManager: configManager,
 		recorder:      recorder,
 		phaseTracker:  phaseTracker,
+		payloads:      payloadStore,
 	}
 }
 
@@ -165,8 +171,11 @@ func logInferencesToValidate(toValidate []string) {
 }
 
 func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Inference, transactionRecorder cosmosclient.InferenceCosmosClient, revalidation bool) {
-	valResult, err := broker.LockNode(s.nodeBroker, inf.Model, inf.NodeVersion, func(node *broker.Node) (ValidationResult, error) {
-		return s.validate(inf, node)
+	strategy := StrategyFor(modelValidationStrategy(inf.Model, transactionRecorder), inf.Kind)
+	var validatingNode *broker.Node
+	valResult, err := broker.LockNode(context.Background(), s.nodeBroker, inf.Model, inf.NodeVersion, func(node *broker.Node) (ValidationResult, error) {
+		validatingNode = node
+		return s.validate(inf, strategy, node)
 	})
 
 	if err != nil && errors.Is(err, broker.ErrNoNodesAvailable) {
@@ -176,8 +185,10 @@ func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Infere
 		}
 	} else if err != nil {
 		logging.Error("Failed to validate inference.", types.Validation, "id", inf.InferenceId, "error", err)
+		metrics.Validations.WithLabelValues(inf.Model, "error").Inc()
 		return
 	}
+	metrics.Validations.WithLabelValues(inf.Model, validationOutcome(valResult)).Inc()
 
 	msgValidation, err := ToMsgValidation(valResult)
 	if err != nil {
@@ -185,6 +196,17 @@ func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Infere
 		return
 	}
 	msgValidation.Revalidation = revalidation
+	if validatingNode != nil {
+		s.storeReport(msgValidation, NewReport(inf, validatingNode, valResult, msgValidation.Value))
+	}
+
+	if inf.OriginalPromptHash != "" && s.payloads != nil {
+		// Keep the validation response next to the payloads it was checked against, only its hash goes on chain
+		if _, err := s.payloads.Put([]byte(msgValidation.ResponsePayload)); err != nil {
+			logging.Warn("Failed to store validation response", types.Validation, "id", inf.InferenceId, "error", err)
+		}
+		msgValidation.ResponsePayload = ""
+	}
 
 	if err = transactionRecorder.ReportValidation(msgValidation); err != nil {
 		logging.Error("Failed to report validation.", types.Validation, "id", inf.InferenceId, "error", err)
@@ -194,14 +216,82 @@ func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Infere
 	logging.Info("Successfully validated inference", types.Validation, "id", inf.InferenceId)
 }
 
-func (s *InferenceValidator) validate(inference types.Inference, inferenceNode *broker.Node) (ValidationResult, error) {
-	logging.Debug("Validating inference", types.Validation, "id", inference.InferenceId)
+// modelValidationStrategy returns the strategy governance set for a model. Validation goes on with the
+// default one if the model can't be looked up, the threshold on chain still applies.
+func modelValidationStrategy(modelId string, transactionRecorder cosmosclient.InferenceCosmosClient) types.ValidationStrategy {
+	queryClient := transactionRecorder.NewInferenceQueryClient()
+	response, err := queryClient.ModelsAll(transactionRecorder.GetContext(), &types.QueryModelsAllRequest{})
+	if err != nil {
+		logging.Warn("Failed to query models for the validation strategy", types.Validation, "model", modelId, "error", err)
+		return types.ValidationStrategy_DEFAULT_VALIDATION
+	}
+	for _, model := range response.Model {
+		if model.Id == modelId {
+			return model.ValidationStrategy
+		}
+	}
+	logging.Warn("Model not found for the validation strategy", types.Validation, "model", modelId)
+	return types.ValidationStrategy_DEFAULT_VALIDATION
+}
+
+// storeReport keeps the per-position report with the payloads this node serves and points the
+// validation's diagnostics to it. Validation goes on without a report if it can't be stored.
+func (s *InferenceValidator) storeReport(msgValidation *inference.MsgValidation, report Report) {
+	publicUrl := s.configManager.GetApiConfig().PublicUrl
+	if s.payloads == nil || publicUrl == "" {
+		return
+	}
+	reportBytes, err := json.Marshal(report)
+	if err != nil {
+		logging.Warn("Failed to marshal validation report", types.Validation, "id", report.InferenceId, "error", err)
+		return
+	}
+	hash, err := s.payloads.Put(reportBytes)
+	if err != nil {
+		logging.Warn("Failed to store validation report", types.Validation, "id", report.InferenceId, "error", err)
+		return
+	}
+	msgValidation.Diagnostics.ReportHash = hash
+	msgValidation.Diagnostics.ReportLocator = payloads.Locator(publicUrl)
+}
+
+func validationOutcome(result ValidationResult) string {
+	if _, ok := result.(ModelNotSupportedValidationResult); ok {
+		return "model_not_supported"
+	}
+	if result.IsSuccessful() {
+		return "valid"
+	}
+	return "invalid"
+}
+
+func (s *InferenceValidator) validate(inference types.Inference, strategy ValidationStrategy, inferenceNode *broker.Node) (ValidationResult, error) {
+	logging.Debug("Validating inference", types.Validation, "id", inference.InferenceId, "strategy", strategy.Strategy().String())
 
 	if inference.Status == types.InferenceStatus_STARTED {
 		logging.Error("Inference not finished", types.Validation, "status", inference.Status, "inference", inference)
 		return nil, errors.New("Inference is not finished. id = " + inference.InferenceId)
 	}
 
+	if inference.OriginalPromptHash != "" && inference.PromptPayload == "" {
+		if err := s.fetchPayloads(&inference); err != nil {
+			logging.Error("Failed to fetch off-chain payloads", types.Validation, "id", inference.InferenceId, "executor", inference.ExecutedBy, "error", err)
+			if errors.Is(err, errResponseUnavailable) {
+				// Withholding the response must not keep the executor from being invalidated
+				return &PayloadUnavailableValidationResult{BaseValidationResult: BaseValidationResult{
+					InferenceId:   inference.InferenceId,
+					ResponseBytes: []byte("{}"),
+					Diagnostics: Diagnostics{
+						Strategy:               strategy.Strategy(),
+						Reason:                 ReasonPayloadUnavailable,
+						FirstDivergentPosition: NoDivergence,
+					},
+				}}, nil
+			}
+			return nil, err
+		}
+	}
+
 	var requestMap map[string]interface{}
 	if err := json.Unmarshal([]byte(inference.PromptPayload), &requestMap); err != nil {
 		logging.Error("Failed to unmarshal inference.PromptPayload.", types.Validation, "id", inference.InferenceId, "error", err)
@@ -214,60 +304,94 @@ func (s *InferenceValidator) validate(inference types.Inference, inferenceNode *
 		return nil, err
 	}
 
-	enforcedStr, err := originalResponse.GetEnforcedStr()
+	path, err := strategy.PrepareRequest(requestMap, originalResponse)
 	if err != nil {
 		return nil, err
 	}
-	requestMap["enforced_str"] = enforcedStr
-	// A hack to simplify processing the response:
-	requestMap["stream"] = false
-	delete(requestMap, "stream_options")
 
-	// Serialize requestMap to JSON
-	requestBody, err := json.Marshal(requestMap)
+	respBodyBytes, err := postToInferenceNode(inferenceNode, path, requestMap)
 	if err != nil {
 		return nil, err
 	}
 
-	completionsUrl, err := url.JoinPath(inferenceNode.InferenceUrl(), "v1/chat/completions")
+	logging.Debug("responseValidation", types.Validation, "validation", string(respBodyBytes))
+	baseResult := BaseValidationResult{
+		InferenceId:   inference.InferenceId,
+		ResponseBytes: respBodyBytes,
+	}
+	return strategy.Compare(originalResponse, respBodyBytes, baseResult)
+}
+
+var errResponseUnavailable = errors.New("executor did not serve the response payload")
+
+// fetchPayloads fills in the prompt and response of an inference whose payloads are kept
+// off-chain. Both are checked against the hashes the executor and transfer agent put on chain,
+// and fetched only from the participants' registered URLs, never from a locator they report.
+// The transfer agent keeps a copy of the prompt, but only the executor has the response: once
+// the prompt could be fetched, a response that is missing or doesn't match its hash is the
+// executor's fault and fails with errResponseUnavailable.
+func (s *InferenceValidator) fetchPayloads(inference *types.Inference) error {
+	requesterAddress := s.recorder.GetAccountAddress()
+	sign := PayloadSigner(s.recorder)
+	executorLocator, err := s.participantPayloadLocator(inference.ExecutedBy)
 	if err != nil {
-		logging.Error("Failed to join url", types.Validation, "url", inferenceNode.InferenceUrl(), "error", err)
-		return nil, err
+		return fmt.Errorf("failed to get executor payload locator: %w", err)
 	}
 
-	resp, err := http.Post(
-		completionsUrl,
-		"application/json",
-		bytes.NewReader(requestBody),
-	)
+	promptPayload, err := payloads.Fetch(context.Background(), executorLocator, inference.PromptHash, requesterAddress, sign)
 	if err != nil {
-		return nil, err
+		logging.Warn("Failed to fetch prompt payload from the executor, trying the transfer agent", types.Validation, "id", inference.InferenceId, "error", err)
+		transferAgentLocator, locatorErr := s.participantPayloadLocator(inference.TransferredBy)
+		if locatorErr != nil {
+			return fmt.Errorf("failed to fetch prompt payload: %w", locatorErr)
+		}
+		promptPayload, err = payloads.Fetch(context.Background(), transferAgentLocator, inference.PromptHash, requesterAddress, sign)
+		if err != nil {
+			return fmt.Errorf("failed to fetch prompt payload: %w", err)
+		}
+	}
+	responsePayload, err := payloads.Fetch(context.Background(), executorLocator, inference.ResponsePayloadHash, requesterAddress, sign)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errResponseUnavailable, err)
 	}
+	inference.PromptPayload = string(promptPayload)
+	inference.ResponsePayload = string(responsePayload)
+	return nil
+}
 
-	respBodyBytes, err := io.ReadAll(resp.Body)
+// participantPayloadLocator is where the payloads stored by a participant's API node are served
+func (s *InferenceValidator) participantPayloadLocator(address string) (string, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	response, err := queryClient.Participant(s.recorder.GetContext(), &types.QueryGetParticipantRequest{Index: address})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	return payloads.Locator(response.Participant.InferenceUrl), nil
+}
 
-	logging.Debug("responseValidation", types.Validation, "validation", string(respBodyBytes))
-	responseValidation, err := completionapi.NewCompletionResponseFromBytes(respBodyBytes)
+func postToInferenceNode(inferenceNode *broker.Node, path string, requestMap map[string]interface{}) ([]byte, error) {
+	requestBody, err := json.Marshal(requestMap)
 	if err != nil {
-		logging.Error("Failed to unmarshal responseValidation", types.Validation, "id", inference.InferenceId, "error", err)
 		return nil, err
 	}
 
-	originalLogits := originalResponse.ExtractLogits()
-	validationLogits := responseValidation.ExtractLogits()
-	baseResult := BaseValidationResult{
-		InferenceId:   inference.InferenceId,
-		ResponseBytes: respBodyBytes,
+	completionsUrl, err := url.JoinPath(inferenceNode.InferenceUrl(), path)
+	if err != nil {
+		logging.Error("Failed to join url", types.Validation, "url", inferenceNode.InferenceUrl(), "error", err)
+		return nil, err
 	}
-	if len(originalLogits) == 0 || len(validationLogits) == 0 {
-		logging.Error("No logits found in original or validation response", types.Validation, "id", inference.InferenceId, "originalLogits", originalLogits, "validationLogits", validationLogits)
-		return nil, errors.New("no logits found in original or validation response")
+
+	resp, err := http.Post(
+		completionsUrl,
+		"application/json",
+		bytes.NewReader(requestBody),
+	)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	return compareLogits(originalLogits, validationLogits, baseResult), nil
+	return io.ReadAll(resp.Body)
 }
 
 func unmarshalResponse(inference *types.Inference) (completionapi.CompletionResponse, error) {
@@ -282,6 +406,8 @@ func unmarshalResponse(inference *types.Inference) (completionapi.CompletionResp
 		logging.Info("Unmarshalled inference.ResponsePayload into StreamedResponse", types.Validation, "id", inference.InferenceId)
 	case *completionapi.JsonCompletionResponse:
 		logging.Info("Unmarshalled inference.ResponsePayload into JsonResponse", types.Validation, "id", inference.InferenceId)
+	case *completionapi.JsonEmbeddingResponse:
+		logging.Info("Unmarshalled inference.ResponsePayload into EmbeddingResponse", types.Validation, "id", inference.InferenceId)
 	default:
 		logging.Error("Failed to unmarshal inference.ResponsePayload into StreamedResponse or JsonResponse", types.Validation, "id", inference.InferenceId)
 	}
@@ -294,12 +420,41 @@ type ValidationResult interface {
 
 	GetValidationResponseBytes() []byte
 
+	GetDiagnostics() Diagnostics
+
 	IsSuccessful() bool
 }
 
+const (
+	ReasonDifferentLength   = "different_length"
+	ReasonDifferentTokens   = "different_tokens"
+	ReasonDifferentShape    = "different_shape"
+	ReasonModelNotSupported = "model_not_supported"
+	// ReasonPayloadUnavailable means the executor didn't serve a response matching its hash
+	ReasonPayloadUnavailable = "payload_unavailable"
+)
+
+// NoDivergence is the first divergent position of a rerun that produced the original tokens
+const NoDivergence = -1
+
+// Diagnostics summarizes how a validation result was reached. The summary goes on chain with the
+// validation, the positions only go into the off-chain report.
+type Diagnostics struct {
+	Strategy            types.ValidationStrategy
+	ComparedTokens      int
+	MismatchedTokens    int
+	MaxPositionDistance float64
+	Reason              string
+
+	FirstDivergentPosition int
+	// PositionDistances are per token, or per vector for embeddings, in the units of the strategy
+	PositionDistances []float64
+}
+
 type BaseValidationResult struct {
 	InferenceId   string
 	ResponseBytes []byte
+	Diagnostics   Diagnostics
 }
 
 func (r BaseValidationResult) GetInferenceId() string {
@@ -310,6 +465,10 @@ func (r BaseValidationResult) GetValidationResponseBytes() []byte {
 	return r.ResponseBytes
 }
 
+func (r BaseValidationResult) GetDiagnostics() Diagnostics {
+	return r.Diagnostics
+}
+
 type DifferentLengthValidationResult struct {
 	BaseValidationResult
 }
@@ -335,24 +494,96 @@ func (r SimilarityValidationResult) IsSuccessful() bool {
 	return r.Value > 0.99
 }
 
-func compareLogits(
-	originalLogits []completionapi.Logprob,
-	validationLogits []completionapi.Logprob,
+type ExactMatchValidationResult struct {
+	BaseValidationResult
+	Value float64
+}
+
+func (r ExactMatchValidationResult) IsSuccessful() bool {
+	return r.Value == 1
+}
+
+type DivergenceValidationResult struct {
+	BaseValidationResult
+	Value float64
+}
+
+func (r DivergenceValidationResult) IsSuccessful() bool {
+	return r.Value > 0.99
+}
+
+type EmbeddingSimilarityValidationResult struct {
+	BaseValidationResult
+	Value float64
+}
+
+func (r EmbeddingSimilarityValidationResult) IsSuccessful() bool {
+	return r.Value > 0.99
+}
+
+// compareEmbeddings returns the lowest cosine similarity between matching vectors.
+// A different number of vectors or dimensions means nothing matches.
+func compareEmbeddings(
+	originalEmbeddings [][]float64,
+	validationEmbeddings [][]float64,
 	baseComparisonResult BaseValidationResult,
 ) ValidationResult {
-	if len(originalLogits) != len(validationLogits) {
-		return &DifferentLengthValidationResult{baseComparisonResult}
+	baseComparisonResult.Diagnostics.Strategy = types.ValidationStrategy_EMBEDDING_COSINE
+	baseComparisonResult.Diagnostics.FirstDivergentPosition = NoDivergence
+	if len(originalEmbeddings) == 0 || len(originalEmbeddings) != len(validationEmbeddings) {
+		baseComparisonResult.Diagnostics.Reason = ReasonDifferentShape
+		return &EmbeddingSimilarityValidationResult{BaseValidationResult: baseComparisonResult, Value: 0}
+	}
+
+	minSimilarity := 1.0
+	distances := make([]float64, len(originalEmbeddings))
+	for i := range originalEmbeddings {
+		if len(originalEmbeddings[i]) != len(validationEmbeddings[i]) {
+			baseComparisonResult.Diagnostics.FirstDivergentPosition = i
+			baseComparisonResult.Diagnostics.Reason = ReasonDifferentShape
+			return &EmbeddingSimilarityValidationResult{BaseValidationResult: baseComparisonResult, Value: 0}
+		}
+		similarity := cosineSimilarity(originalEmbeddings[i], validationEmbeddings[i])
+		distances[i] = 1 - similarity
+		minSimilarity = math.Min(minSimilarity, similarity)
 	}
+	baseComparisonResult.Diagnostics.PositionDistances = distances
+	baseComparisonResult.Diagnostics.MaxPositionDistance = 1 - minSimilarity
+	return &EmbeddingSimilarityValidationResult{BaseValidationResult: baseComparisonResult, Value: max(minSimilarity, 0)}
+}
 
-	for i := range originalLogits {
-		o := originalLogits[i]
-		v := validationLogits[i]
-		if o.Token != v.Token {
-			return &DifferentTokensValidationResult{baseComparisonResult}
+func cosineSimilarity(a []float64, b []float64) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		if normA == normB {
+			return 1
 		}
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+func compareLogits(
+	originalLogits []completionapi.Logprob,
+	validationLogits []completionapi.Logprob,
+	baseComparisonResult BaseValidationResult,
+) ValidationResult {
+	baseComparisonResult.Diagnostics.Strategy = types.ValidationStrategy_LOGPROB_SIMILARITY
+	if result := checkAlignment(originalLogits, validationLogits, baseComparisonResult); result != nil {
+		return result
 	}
 	similarity := customSimilarity(originalLogits, validationLogits)
 
+	distances := positionDistances(originalLogits, validationLogits)
+	baseComparisonResult.Diagnostics.ComparedTokens = len(originalLogits)
+	baseComparisonResult.Diagnostics.FirstDivergentPosition = NoDivergence
+	baseComparisonResult.Diagnostics.PositionDistances = distances
+	baseComparisonResult.Diagnostics.MaxPositionDistance = maxOf(distances)
 	return &SimilarityValidationResult{BaseValidationResult: baseComparisonResult, Value: similarity}
 }
 
@@ -393,6 +624,22 @@ func customDistance(
 	return distance / float64(totalLogprobs), nil
 }
 
+// positionDistances are the distances of single positions, normalized by their number of top logprobs.
+func positionDistances(
+	originalLogprobs []completionapi.Logprob,
+	validationLogprobs []completionapi.Logprob,
+) []float64 {
+	distances := make([]float64, len(originalLogprobs))
+	for i := range originalLogprobs {
+		posDistance, err := positionDistance(originalLogprobs[i].TopLogprobs, validationLogprobs[i].TopLogprobs)
+		if err != nil {
+			continue
+		}
+		distances[i] = posDistance / float64(len(validationLogprobs[i].TopLogprobs))
+	}
+	return distances
+}
+
 func positionDistance(
 	originalLogprobs []completionapi.TopLogprobs,
 	validationLogprobs []completionapi.TopLogprobs,
@@ -440,6 +687,14 @@ func positionDistance(
 	return distance, nil
 }
 
+type PayloadUnavailableValidationResult struct {
+	BaseValidationResult
+}
+
+func (PayloadUnavailableValidationResult) IsSuccessful() bool {
+	return false
+}
+
 type ModelNotSupportedValidationResult struct {
 	InferenceId string
 }
@@ -452,6 +707,10 @@ func (r ModelNotSupportedValidationResult) GetValidationResponseBytes() []byte {
 	return nil
 }
 
+func (r ModelNotSupportedValidationResult) GetDiagnostics() Diagnostics {
+	return Diagnostics{Reason: ReasonModelNotSupported}
+}
+
 func (ModelNotSupportedValidationResult) IsSuccessful() bool {
 	return false
 }
@@ -471,6 +730,18 @@ func ToMsgValidation(result ValidationResult) (*inference.MsgValidation, error)
 	case *SimilarityValidationResult:
 		simVal = result.(*SimilarityValidationResult).Value
 		logging.Info("Cosine similarity validation result", types.Validation, "cosineSimValue", simVal)
+	case *ExactMatchValidationResult:
+		simVal = result.(*ExactMatchValidationResult).Value
+		logging.Info("Exact token match validation result", types.Validation, "matchedShare", simVal)
+	case *DivergenceValidationResult:
+		simVal = result.(*DivergenceValidationResult).Value
+		logging.Info("JS divergence validation result", types.Validation, "value", simVal)
+	case *EmbeddingSimilarityValidationResult:
+		simVal = result.(*EmbeddingSimilarityValidationResult).Value
+		logging.Info("Embedding cosine similarity validation result", types.Validation, "cosineSimValue", simVal)
+	case *PayloadUnavailableValidationResult:
+		simVal = 0
+		logging.Info("Payload unavailable validation result", types.Validation, "inference_id", result.GetInferenceId())
 	case ModelNotSupportedValidationResult:
 		simVal = 1
 		logging.Info("Model not supported validation result. Assuming is valid", types.Validation, "inference_id", result.GetInferenceId())
@@ -484,11 +755,19 @@ func ToMsgValidation(result ValidationResult) (*inference.MsgValidation, error)
 		return nil, err
 	}
 
+	diagnostics := result.GetDiagnostics()
 	return &inference.MsgValidation{
 		Id:              uuid.New().String(),
 		InferenceId:     result.GetInferenceId(),
 		ResponsePayload: string(result.GetValidationResponseBytes()),
 		ResponseHash:    responseHash,
 		Value:           simVal,
+		Diagnostics: &inference.ValidationDiagnostics{
+			Strategy:            inference.ValidationStrategy(diagnostics.Strategy),
+			ComparedTokens:      uint32(diagnostics.ComparedTokens),
+			MismatchedTokens:    uint32(diagnostics.MismatchedTokens),
+			MaxPositionDistance: diagnostics.MaxPositionDistance,
+			Reason:              diagnostics.Reason,
+		},
 	}, nil
 }
@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"decentralized-api/broker"
+	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/payloads"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/cmd/inferenced/cmd"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Report is what a validator keeps off-chain about a validation, for the executor's operators to tell
+// a config drift from a real bug before an invalidation goes to a vote. Only its hash goes on chain.
+// It holds positions and numbers, never text of the inference, so it can be served to anyone.
+type Report struct {
+	InferenceId            string    `json:"inference_id"`
+	Model                  string    `json:"model"`
+	Strategy               string    `json:"strategy"`
+	Value                  float64   `json:"value"`
+	Reason                 string    `json:"reason,omitempty"`
+	ComparedTokens         int       `json:"compared_tokens"`
+	MismatchedTokens       int       `json:"mismatched_tokens"`
+	FirstDivergentPosition int       `json:"first_divergent_position"`
+	PositionDistances      []float64 `json:"position_distances"`
+	NodeVersion            string    `json:"node_version"`
+	ModelArgs              []string  `json:"model_args"`
+}
+
+// reportPrecision is enough to compare distances and keeps reports of long completions small
+const reportPrecision = 1e6
+
+func NewReport(inference types.Inference, node *broker.Node, result ValidationResult, value float64) Report {
+	diagnostics := result.GetDiagnostics()
+	distances := make([]float64, len(diagnostics.PositionDistances))
+	for i, d := range diagnostics.PositionDistances {
+		distances[i] = math.Round(d*reportPrecision) / reportPrecision
+	}
+
+	report := Report{
+		InferenceId:            inference.InferenceId,
+		Model:                  inference.Model,
+		Strategy:               diagnostics.Strategy.String(),
+		Value:                  value,
+		Reason:                 diagnostics.Reason,
+		ComparedTokens:         diagnostics.ComparedTokens,
+		MismatchedTokens:       diagnostics.MismatchedTokens,
+		FirstDivergentPosition: diagnostics.FirstDivergentPosition,
+		PositionDistances:      distances,
+	}
+	if node != nil {
+		report.NodeVersion = node.Version
+		report.ModelArgs = node.Models[inference.Model].Args
+	}
+	return report
+}
+
+// PayloadSigner signs requests for payloads stored by other participants with this participant's key
+func PayloadSigner(recorder cosmosclient.CosmosMessageClient) payloads.SignFunc {
+	return func(components calculations.SignatureComponents) (string, error) {
+		signerAddress, err := sdk.AccAddressFromBech32(recorder.GetSignerAddress())
+		if err != nil {
+			return "", err
+		}
+		accountSigner := &cmd.AccountSigner{
+			Addr:    signerAddress,
+			Keyring: recorder.GetKeyring(),
+		}
+		return calculations.Sign(accountSigner, components, calculations.TransferAgent)
+	}
+}
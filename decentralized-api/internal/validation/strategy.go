@@ -0,0 +1,307 @@
+package validation
+
+import (
+	"decentralized-api/completionapi"
+	"decentralized-api/logging"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ValidationStrategy reruns an inference on a validating node and compares the rerun with the original.
+// Which one is used is set per model by governance, since quantizations and model families differ a lot
+// in how close two runs of the same prompt get.
+type ValidationStrategy interface {
+	Strategy() types.ValidationStrategy
+
+	// PrepareRequest turns the original request into the one the validating node reruns
+	// and returns the path to send it to.
+	PrepareRequest(requestMap map[string]interface{}, originalResponse completionapi.CompletionResponse) (string, error)
+
+	Compare(originalResponse completionapi.CompletionResponse, responseBytes []byte, baseResult BaseValidationResult) (ValidationResult, error)
+}
+
+// StrategyFor returns the strategy to validate an inference of the given kind with. Embeddings carry
+// no logprobs and completions no vectors, so a strategy that can't apply to the kind falls back to the default.
+func StrategyFor(strategy types.ValidationStrategy, kind types.InferenceKind) ValidationStrategy {
+	if kind == types.InferenceKind_EMBEDDING {
+		if strategy != types.ValidationStrategy_DEFAULT_VALIDATION && strategy != types.ValidationStrategy_EMBEDDING_COSINE {
+			logging.Warn("Validation strategy doesn't apply to embeddings, using embedding cosine", types.Validation, "strategy", strategy.String())
+		}
+		return embeddingCosineStrategy{}
+	}
+
+	switch strategy {
+	case types.ValidationStrategy_EXACT_TOKEN_MATCH:
+		return logitsStrategy{strategy: strategy, kind: kind, compare: compareTopTokens}
+	case types.ValidationStrategy_JS_DIVERGENCE:
+		return logitsStrategy{strategy: strategy, kind: kind, compare: compareDivergence}
+	case types.ValidationStrategy_DEFAULT_VALIDATION, types.ValidationStrategy_LOGPROB_SIMILARITY:
+	default:
+		logging.Warn("Validation strategy doesn't apply to completions, using logprob similarity", types.Validation, "strategy", strategy.String())
+	}
+	return logitsStrategy{strategy: types.ValidationStrategy_LOGPROB_SIMILARITY, kind: kind, compare: compareLogits}
+}
+
+// logitsStrategy reruns a completion forced to produce the original text and compares the logprobs
+// of both runs position by position.
+type logitsStrategy struct {
+	strategy types.ValidationStrategy
+	kind     types.InferenceKind
+	compare  func(originalLogits []completionapi.Logprob, validationLogits []completionapi.Logprob, baseResult BaseValidationResult) ValidationResult
+}
+
+func (s logitsStrategy) Strategy() types.ValidationStrategy {
+	return s.strategy
+}
+
+func (s logitsStrategy) PrepareRequest(requestMap map[string]interface{}, originalResponse completionapi.CompletionResponse) (string, error) {
+	enforcedStr, err := originalResponse.GetEnforcedStr()
+	if err != nil {
+		return "", err
+	}
+	requestMap["enforced_str"] = enforcedStr
+	// A hack to simplify processing the response:
+	requestMap["stream"] = false
+	delete(requestMap, "stream_options")
+	return completionapi.PathForKind(s.kind), nil
+}
+
+func (s logitsStrategy) Compare(originalResponse completionapi.CompletionResponse, responseBytes []byte, baseResult BaseValidationResult) (ValidationResult, error) {
+	responseValidation, err := completionapi.NewCompletionResponseFromBytes(responseBytes)
+	if err != nil {
+		logging.Error("Failed to unmarshal responseValidation", types.Validation, "id", baseResult.InferenceId, "error", err)
+		return nil, err
+	}
+
+	originalLogits := originalResponse.ExtractLogits()
+	validationLogits := responseValidation.ExtractLogits()
+	if len(originalLogits) == 0 || len(validationLogits) == 0 {
+		logging.Error("No logits found in original or validation response", types.Validation, "id", baseResult.InferenceId, "originalLogits", originalLogits, "validationLogits", validationLogits)
+		return nil, errors.New("no logits found in original or validation response")
+	}
+
+	baseResult.Diagnostics.Strategy = s.strategy
+	return s.compare(originalLogits, validationLogits, baseResult), nil
+}
+
+// embeddingCosineStrategy recomputes the embeddings and compares them with the original ones
+// by cosine similarity.
+type embeddingCosineStrategy struct{}
+
+func (embeddingCosineStrategy) Strategy() types.ValidationStrategy {
+	return types.ValidationStrategy_EMBEDDING_COSINE
+}
+
+func (embeddingCosineStrategy) PrepareRequest(requestMap map[string]interface{}, _ completionapi.CompletionResponse) (string, error) {
+	// Floats are easier to compare and don't change the values, whatever the original encoding was
+	requestMap["encoding_format"] = "float"
+	return completionapi.EmbeddingsPath, nil
+}
+
+func (embeddingCosineStrategy) Compare(originalResponse completionapi.CompletionResponse, responseBytes []byte, baseResult BaseValidationResult) (ValidationResult, error) {
+	original, ok := originalResponse.(*completionapi.JsonEmbeddingResponse)
+	if !ok {
+		logging.Error("Embedding inference has a non-embedding response", types.Validation, "id", baseResult.InferenceId, "type", fmt.Sprintf("%T", originalResponse))
+		return nil, errors.New("embedding inference has a non-embedding response. id = " + baseResult.InferenceId)
+	}
+	originalEmbeddings, err := original.GetEmbeddings()
+	if err != nil {
+		logging.Error("Failed to decode original embeddings", types.Validation, "id", baseResult.InferenceId, "error", err)
+		return nil, err
+	}
+
+	responseValidation, err := completionapi.NewEmbeddingResponseFromBytes(responseBytes)
+	if err != nil {
+		logging.Error("Failed to unmarshal responseValidation", types.Validation, "id", baseResult.InferenceId, "error", err)
+		return nil, err
+	}
+	validationEmbeddings, err := responseValidation.GetEmbeddings()
+	if err != nil {
+		logging.Error("Failed to decode validation embeddings", types.Validation, "id", baseResult.InferenceId, "error", err)
+		return nil, err
+	}
+
+	return compareEmbeddings(originalEmbeddings, validationEmbeddings, baseResult), nil
+}
+
+// checkAlignment returns a failed result when the rerun didn't reproduce the original tokens,
+// position by position comparisons only make sense for the same tokens.
+func checkAlignment(
+	originalLogits []completionapi.Logprob,
+	validationLogits []completionapi.Logprob,
+	baseComparisonResult BaseValidationResult,
+) ValidationResult {
+	compared := min(len(originalLogits), len(validationLogits))
+	mismatched := 0
+	firstMismatch := NoDivergence
+	for i := 0; i < compared; i++ {
+		if originalLogits[i].Token != validationLogits[i].Token {
+			mismatched++
+			if firstMismatch == NoDivergence {
+				firstMismatch = i
+			}
+		}
+	}
+
+	baseComparisonResult.Diagnostics.ComparedTokens = compared
+	baseComparisonResult.Diagnostics.MismatchedTokens = mismatched
+	if len(originalLogits) != len(validationLogits) {
+		if firstMismatch == NoDivergence {
+			firstMismatch = compared
+		}
+		baseComparisonResult.Diagnostics.FirstDivergentPosition = firstMismatch
+		baseComparisonResult.Diagnostics.Reason = ReasonDifferentLength
+		return &DifferentLengthValidationResult{baseComparisonResult}
+	}
+	if mismatched > 0 {
+		baseComparisonResult.Diagnostics.FirstDivergentPosition = firstMismatch
+		baseComparisonResult.Diagnostics.Reason = ReasonDifferentTokens
+		return &DifferentTokensValidationResult{baseComparisonResult}
+	}
+	return nil
+}
+
+// compareTopTokens is meant for deterministic configs, where the original tokens are the most
+// likely ones. It returns the share of positions where the rerun agrees on that.
+func compareTopTokens(
+	originalLogits []completionapi.Logprob,
+	validationLogits []completionapi.Logprob,
+	baseComparisonResult BaseValidationResult,
+) ValidationResult {
+	if result := checkAlignment(originalLogits, validationLogits, baseComparisonResult); result != nil {
+		return result
+	}
+
+	matched := 0
+	firstMismatch := NoDivergence
+	// How much more likely the rerun found its own choice than the original token, in nats
+	gaps := make([]float64, len(validationLogits))
+	for i := range validationLogits {
+		v := validationLogits[i]
+		top, found := topLogprob(v.TopLogprobs)
+		if found && top.Token == v.Token {
+			matched++
+			continue
+		}
+		if firstMismatch == NoDivergence {
+			firstMismatch = i
+		}
+		if found {
+			gaps[i] = top.Logprob - v.Logprob
+		}
+	}
+
+	baseComparisonResult.Diagnostics.ComparedTokens = len(validationLogits)
+	baseComparisonResult.Diagnostics.MismatchedTokens = len(validationLogits) - matched
+	baseComparisonResult.Diagnostics.FirstDivergentPosition = firstMismatch
+	baseComparisonResult.Diagnostics.PositionDistances = gaps
+	baseComparisonResult.Diagnostics.MaxPositionDistance = maxOf(gaps)
+	return &ExactMatchValidationResult{
+		BaseValidationResult: baseComparisonResult,
+		Value:                float64(matched) / float64(len(validationLogits)),
+	}
+}
+
+func maxOf(values []float64) float64 {
+	result := 0.0
+	for _, v := range values {
+		result = math.Max(result, v)
+	}
+	return result
+}
+
+func topLogprob(logprobs []completionapi.TopLogprobs) (completionapi.TopLogprobs, bool) {
+	if len(logprobs) == 0 {
+		return completionapi.TopLogprobs{}, false
+	}
+	top := logprobs[0]
+	for _, l := range logprobs[1:] {
+		if l.Logprob > top.Logprob {
+			top = l
+		}
+	}
+	return top, true
+}
+
+// compareDivergence returns one minus the mean Jensen-Shannon divergence between the top logprob
+// distributions of both runs. Unlike logprob similarity it weighs tokens by their probability, so
+// noise in unlikely alternatives, common with low-bit quantizations, matters little.
+func compareDivergence(
+	originalLogits []completionapi.Logprob,
+	validationLogits []completionapi.Logprob,
+	baseComparisonResult BaseValidationResult,
+) ValidationResult {
+	if result := checkAlignment(originalLogits, validationLogits, baseComparisonResult); result != nil {
+		return result
+	}
+
+	total := 0.0
+	divergences := make([]float64, len(originalLogits))
+	for i := range originalLogits {
+		divergences[i] = jsDivergence(originalLogits[i].TopLogprobs, validationLogits[i].TopLogprobs)
+		total += divergences[i]
+	}
+
+	baseComparisonResult.Diagnostics.ComparedTokens = len(originalLogits)
+	baseComparisonResult.Diagnostics.FirstDivergentPosition = NoDivergence
+	baseComparisonResult.Diagnostics.PositionDistances = divergences
+	baseComparisonResult.Diagnostics.MaxPositionDistance = maxOf(divergences)
+	return &DivergenceValidationResult{
+		BaseValidationResult: baseComparisonResult,
+		Value:                max(1-total/float64(len(originalLogits)), 0),
+	}
+}
+
+// jsDivergence is the Jensen-Shannon divergence in bits, so within [0, 1], between two top logprob lists.
+// The probability mass outside of each list goes to a shared "other" outcome, a token missing from one
+// list is counted as part of that mass.
+func jsDivergence(original []completionapi.TopLogprobs, validation []completionapi.TopLogprobs) float64 {
+	p := topDistribution(original)
+	q := topDistribution(validation)
+
+	divergence := 0.0
+	for token := range union(p, q) {
+		pi, qi := p[token], q[token]
+		m := (pi + qi) / 2
+		if pi > 0 {
+			divergence += pi * math.Log2(pi/m) / 2
+		}
+		if qi > 0 {
+			divergence += qi * math.Log2(qi/m) / 2
+		}
+	}
+	return math.Min(math.Max(divergence, 0), 1)
+}
+
+// otherTokens keys the mass outside of a top logprob list, it can't clash with a token since those are strings
+// the model produced and this one isn't valid UTF-8.
+const otherTokens = "\xff"
+
+func topDistribution(logprobs []completionapi.TopLogprobs) map[string]float64 {
+	distribution := make(map[string]float64, len(logprobs)+1)
+	sum := 0.0
+	for _, l := range logprobs {
+		if _, exists := distribution[l.Token]; exists {
+			continue
+		}
+		prob := math.Exp(l.Logprob)
+		distribution[l.Token] = prob
+		sum += prob
+	}
+	distribution[otherTokens] = math.Max(1-sum, 0)
+	return distribution
+}
+
+func union(a map[string]float64, b map[string]float64) map[string]struct{} {
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = struct{}{}
+	}
+	for k := range b {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
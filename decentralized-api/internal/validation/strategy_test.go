@@ -0,0 +1,196 @@
+package validation
+
+import (
+	"decentralized-api/broker"
+	"decentralized-api/completionapi"
+	"math"
+	"testing"
+
+	"github.com/productscience/inference/api/inference/inference"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func logprob(token string, top ...completionapi.TopLogprobs) completionapi.Logprob {
+	l := completionapi.Logprob{Token: token, TopLogprobs: top}
+	for _, t := range top {
+		if t.Token == token {
+			l.Logprob = t.Logprob
+		}
+	}
+	return l
+}
+
+func alt(token string, prob float64) completionapi.TopLogprobs {
+	return completionapi.TopLogprobs{Token: token, Logprob: math.Log(prob)}
+}
+
+func TestStrategyFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy types.ValidationStrategy
+		kind     types.InferenceKind
+		expected types.ValidationStrategy
+	}{
+		{"default chat", types.ValidationStrategy_DEFAULT_VALIDATION, types.InferenceKind_CHAT_COMPLETION, types.ValidationStrategy_LOGPROB_SIMILARITY},
+		{"default embedding", types.ValidationStrategy_DEFAULT_VALIDATION, types.InferenceKind_EMBEDDING, types.ValidationStrategy_EMBEDDING_COSINE},
+		{"exact match", types.ValidationStrategy_EXACT_TOKEN_MATCH, types.InferenceKind_TEXT_COMPLETION, types.ValidationStrategy_EXACT_TOKEN_MATCH},
+		{"divergence", types.ValidationStrategy_JS_DIVERGENCE, types.InferenceKind_CHAT_COMPLETION, types.ValidationStrategy_JS_DIVERGENCE},
+		{"logprobs for embeddings", types.ValidationStrategy_JS_DIVERGENCE, types.InferenceKind_EMBEDDING, types.ValidationStrategy_EMBEDDING_COSINE},
+		{"cosine for completions", types.ValidationStrategy_EMBEDDING_COSINE, types.InferenceKind_CHAT_COMPLETION, types.ValidationStrategy_LOGPROB_SIMILARITY},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StrategyFor(tt.strategy, tt.kind).Strategy(); got != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompareTopTokens(t *testing.T) {
+	baseResult := BaseValidationResult{InferenceId: "1"}
+	original := []completionapi.Logprob{
+		logprob("a", alt("a", 0.9), alt("b", 0.1)),
+		logprob("c", alt("c", 0.6), alt("d", 0.4)),
+	}
+
+	same := compareTopTokens(original, original, baseResult)
+	if !same.IsSuccessful() || same.(*ExactMatchValidationResult).Value != 1 {
+		t.Fatalf("expected identical runs to match, got %v", same)
+	}
+
+	validation := []completionapi.Logprob{
+		logprob("a", alt("a", 0.9), alt("b", 0.1)),
+		logprob("c", alt("c", 0.3), alt("d", 0.6)),
+	}
+	different := compareTopTokens(original, validation, baseResult)
+	if different.IsSuccessful() || different.(*ExactMatchValidationResult).Value != 0.5 {
+		t.Fatalf("expected half of the tokens to match, got %v", different)
+	}
+	diagnostics := different.GetDiagnostics()
+	if diagnostics.ComparedTokens != 2 || diagnostics.MismatchedTokens != 1 {
+		t.Fatalf("unexpected diagnostics %+v", diagnostics)
+	}
+	if math.Abs(diagnostics.MaxPositionDistance-math.Log(2)) > 1e-9 {
+		t.Fatalf("expected the gap to the top token to be log(2), got %v", diagnostics.MaxPositionDistance)
+	}
+}
+
+func TestCompareDivergence(t *testing.T) {
+	baseResult := BaseValidationResult{InferenceId: "1"}
+	original := []completionapi.Logprob{
+		logprob("a", alt("a", 0.7), alt("b", 0.2)),
+		logprob("c", alt("c", 0.5), alt("d", 0.5)),
+	}
+
+	same := compareDivergence(original, original, baseResult)
+	if math.Abs(same.(*DivergenceValidationResult).Value-1) > 1e-9 {
+		t.Fatalf("expected identical runs to have no divergence, got %v", same)
+	}
+
+	nearby := []completionapi.Logprob{
+		logprob("a", alt("a", 0.68), alt("b", 0.22)),
+		logprob("c", alt("c", 0.52), alt("d", 0.48)),
+	}
+	far := []completionapi.Logprob{
+		logprob("a", alt("a", 0.1), alt("e", 0.85)),
+		logprob("c", alt("c", 0.05), alt("f", 0.9)),
+	}
+	nearbyValue := compareDivergence(original, nearby, baseResult).(*DivergenceValidationResult).Value
+	farResult := compareDivergence(original, far, baseResult).(*DivergenceValidationResult)
+	if nearbyValue <= farResult.Value || nearbyValue < 0.99 || farResult.Value > 0.5 {
+		t.Fatalf("expected nearby runs to score higher than far ones, got %v and %v", nearbyValue, farResult.Value)
+	}
+	if d := farResult.GetDiagnostics(); d.MaxPositionDistance <= 0.5 || d.MaxPositionDistance > 1 {
+		t.Fatalf("unexpected max divergence %v", d.MaxPositionDistance)
+	}
+}
+
+func TestCheckAlignment(t *testing.T) {
+	baseResult := BaseValidationResult{InferenceId: "1"}
+	original := []completionapi.Logprob{logprob("a", alt("a", 1)), logprob("b", alt("b", 1))}
+
+	shorter := compareDivergence(original, original[:1], baseResult)
+	if _, ok := shorter.(*DifferentLengthValidationResult); !ok || shorter.GetDiagnostics().Reason != ReasonDifferentLength || shorter.GetDiagnostics().FirstDivergentPosition != 1 {
+		t.Fatalf("expected a different length result, got %v", shorter)
+	}
+
+	otherTokens := []completionapi.Logprob{logprob("a", alt("a", 1)), logprob("x", alt("x", 1))}
+	different := compareLogits(original, otherTokens, baseResult)
+	diagnostics := different.GetDiagnostics()
+	if _, ok := different.(*DifferentTokensValidationResult); !ok || diagnostics.MismatchedTokens != 1 || diagnostics.Reason != ReasonDifferentTokens || diagnostics.FirstDivergentPosition != 1 {
+		t.Fatalf("expected a different tokens result, got %v", different)
+	}
+}
+
+func TestToMsgValidation_Diagnostics(t *testing.T) {
+	baseResult := BaseValidationResult{InferenceId: "1", ResponseBytes: []byte(`{}`)}
+	original := []completionapi.Logprob{
+		logprob("a", alt("a", 0.9), alt("b", 0.1)),
+		logprob("c", alt("c", 0.6), alt("d", 0.4)),
+	}
+	result := StrategyFor(types.ValidationStrategy_EXACT_TOKEN_MATCH, types.InferenceKind_CHAT_COMPLETION).(logitsStrategy)
+	baseResult.Diagnostics.Strategy = result.Strategy()
+
+	msg, err := ToMsgValidation(result.compare(original, original, baseResult))
+	if err != nil {
+		t.Fatalf("Failed to convert to MsgValidation: %v", err)
+	}
+	if msg.Value != 1 {
+		t.Fatalf("expected MsgValidation value 1, got %v", msg.Value)
+	}
+	if msg.Diagnostics.Strategy != inference.ValidationStrategy_EXACT_TOKEN_MATCH || msg.Diagnostics.ComparedTokens != 2 {
+		t.Fatalf("unexpected diagnostics %v", msg.Diagnostics)
+	}
+}
+
+func TestToMsgValidation_PayloadUnavailable(t *testing.T) {
+	result := &PayloadUnavailableValidationResult{BaseValidationResult: BaseValidationResult{
+		InferenceId:   "1",
+		ResponseBytes: []byte(`{}`),
+		Diagnostics:   Diagnostics{Reason: ReasonPayloadUnavailable, FirstDivergentPosition: NoDivergence},
+	}}
+
+	msg, err := ToMsgValidation(result)
+	if err != nil {
+		t.Fatalf("Failed to convert to MsgValidation: %v", err)
+	}
+	if msg.Value != 0 || result.IsSuccessful() {
+		t.Fatalf("expected an unavailable payload to fail validation, got value %v", msg.Value)
+	}
+	if msg.Diagnostics.Reason != ReasonPayloadUnavailable {
+		t.Fatalf("unexpected diagnostics %v", msg.Diagnostics)
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	baseResult := BaseValidationResult{InferenceId: "1"}
+	original := []completionapi.Logprob{
+		logprob("a", alt("a", 0.9), alt("b", 0.1)),
+		logprob("c", alt("c", 0.6), alt("d", 0.4)),
+		logprob("e", alt("e", 0.5), alt("f", 0.5)),
+	}
+	validation := []completionapi.Logprob{
+		logprob("a", alt("a", 0.9), alt("b", 0.1)),
+		logprob("c", alt("c", 0.3), alt("d", 0.6)),
+		logprob("e", alt("e", 0.2), alt("f", 0.8)),
+	}
+	baseResult.Diagnostics.Strategy = types.ValidationStrategy_EXACT_TOKEN_MATCH
+	result := compareTopTokens(original, validation, baseResult)
+
+	node := &broker.Node{
+		Version: "v3.0.8",
+		Models:  map[string]broker.ModelArgs{"model1": {Args: []string{"--quantization", "fp8"}}},
+	}
+	report := NewReport(types.Inference{InferenceId: "1", Model: "model1"}, node, result, 1.0/3)
+
+	if report.FirstDivergentPosition != 1 || report.MismatchedTokens != 2 || report.Strategy != "EXACT_TOKEN_MATCH" {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if len(report.PositionDistances) != 3 || report.PositionDistances[0] != 0 || report.PositionDistances[1] != 0.693147 {
+		t.Fatalf("expected rounded per position distances, got %v", report.PositionDistances)
+	}
+	if report.NodeVersion != "v3.0.8" || len(report.ModelArgs) != 2 {
+		t.Fatalf("expected the validating node's config, got %+v", report)
+	}
+}
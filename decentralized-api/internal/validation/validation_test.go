@@ -66,3 +66,34 @@ func TestValidationQuant(t *testing.T) {
 	val := compareLogits(inferenceResponse.Choices[0].Logprobs.Content, validationResponse.Choices[0].Logprobs.Content, baseResult)
 	t.Logf("Validation result: %v", val)
 }
+
+func TestCompareEmbeddings(t *testing.T) {
+	baseResult := BaseValidationResult{
+		InferenceId:   "1",
+		ResponseBytes: []byte(`{"object":"list"}`),
+	}
+
+	original := [][]float64{{1, 0}, {0.6, 0.8}}
+	same := compareEmbeddings(original, [][]float64{{2, 0}, {0.6, 0.8}}, baseResult)
+	if !same.IsSuccessful() {
+		t.Fatalf("expected equal embeddings to pass, got %v", same)
+	}
+
+	different := compareEmbeddings(original, [][]float64{{1, 0}, {0.8, 0.6}}, baseResult)
+	if different.IsSuccessful() || different.(*EmbeddingSimilarityValidationResult).Value != 0.96 {
+		t.Fatalf("expected the worst vector to decide the similarity, got %v", different)
+	}
+
+	missing := compareEmbeddings(original, [][]float64{{1, 0}}, baseResult)
+	if missing.(*EmbeddingSimilarityValidationResult).Value != 0 {
+		t.Fatalf("expected a different number of embeddings to fail, got %v", missing)
+	}
+
+	msg, err := ToMsgValidation(different)
+	if err != nil {
+		t.Fatalf("Failed to convert to MsgValidation: %v", err)
+	}
+	if msg.Value != 0.96 {
+		t.Fatalf("expected MsgValidation value 0.96, got %v", msg.Value)
+	}
+}
@@ -6,13 +6,17 @@ import (
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/authkeys"
 	"decentralized-api/internal/bls"
 	"decentralized-api/internal/event_listener"
+	natsclient "decentralized-api/internal/nats/client"
 	"decentralized-api/internal/nats/server"
+	"decentralized-api/internal/payloads"
 	"decentralized-api/internal/poc"
 	adminserver "decentralized-api/internal/server/admin"
 	mlserver "decentralized-api/internal/server/mlnode"
 	pserver "decentralized-api/internal/server/public"
+	"decentralized-api/internal/subscriptions"
 	"decentralized-api/mlnodeclient"
 	"net"
 
@@ -22,6 +26,7 @@ import (
 
 	"decentralized-api/internal/validation"
 	"decentralized-api/logging"
+	"decentralized-api/metrics"
 	"decentralized-api/participant"
 	"decentralized-api/training"
 	"encoding/json"
@@ -93,7 +98,8 @@ func main() {
 		return
 	}
 	chainBridge := broker.NewBrokerChainBridgeImpl(recorder, config.GetChainNodeConfig().Url)
-	nodeBroker := broker.NewBroker(chainBridge, chainPhaseTracker, participantInfo, config.GetApiConfig().PoCCallbackUrl, &mlnodeclient.HttpClientFactory{})
+	nodeBroker := broker.NewBroker(chainBridge, chainPhaseTracker, participantInfo, config.GetApiConfig().PoCCallbackUrl, &mlnodeclient.HttpClientFactory{}, broker.NewWaitQueueConfig(config.GetNodeWaitQueueConfig()))
+	metrics.Register(nodeBroker.MetricsCollector())
 	nodes := config.GetNodes()
 	for _, node := range nodes {
 		nodeBroker.LoadNodeToBroker(&node)
@@ -103,6 +109,9 @@ func main() {
 		logging.Error("Failed to register participant", types.Participants, "error", err)
 		return
 	}
+	if err := participant.UpdateRegionIfChanged(recorder, config); err != nil {
+		logging.Warn("Failed to update participant region", types.Participants, "error", err)
+	}
 
 	logging.Debug("Initializing PoC orchestrator",
 		types.PoC, "name", recorder.GetApiAccount().SignerAccount.Name,
@@ -129,9 +138,28 @@ func main() {
 	training.NewAssigner(recorder, &tendermintClient, ctx)
 	trainingExecutor := training.NewExecutor(ctx, nodeBroker, recorder)
 
-	validator := validation.NewInferenceValidator(nodeBroker, config, recorder, chainPhaseTracker)
+	payloadStore, err := payloads.NewFileStoreFromConfig(config.GetPayloadStorageConfig())
+	if err != nil {
+		logging.Error("Failed to open payload store", types.Server, "error", err)
+		return
+	}
+	go payloadStore.RunPruning(ctx)
+
+	if config.GetNodeConfigReloadConfig().Enabled {
+		nodeConfigReloader := broker.NewNodeConfigReloader(nodeBroker, config, broker.NewNodeConfigReloadOptions(config.GetNodeConfigReloadConfig()))
+		go nodeConfigReloader.Run(ctx)
+	}
+
+	webhookStore, err := newWebhookStore(config)
+	if err != nil {
+		logging.Error("Failed to open webhook store", types.Server, "error", err)
+		return
+	}
+	lifecycleEvents := subscriptions.NewHub(subscriptions.NewDispatcherFromConfig(webhookStore, config.GetSubscriptionsConfig()))
+
+	validator := validation.NewInferenceValidator(nodeBroker, config, recorder, chainPhaseTracker, payloadStore)
 	blsManager := bls.NewBlsManager(*recorder)
-	listener := event_listener.NewEventListener(config, nodePocOrchestrator, nodeBroker, validator, *recorder, trainingExecutor, chainPhaseTracker, cancel, blsManager)
+	listener := event_listener.NewEventListener(config, nodePocOrchestrator, nodeBroker, validator, *recorder, trainingExecutor, chainPhaseTracker, cancel, blsManager, lifecycleEvents)
 	// TODO: propagate trainingExecutor
 	go listener.Start(ctx)
 
@@ -141,7 +169,13 @@ func main() {
 	// Bridge external block queue
 	blockQueue := pserver.NewBlockQueue(recorder)
 
-	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker)
+	authKeyStore, err := newAuthKeyStore(config)
+	if err != nil {
+		logging.Error("Failed to open AuthKey store", types.Server, "error", err)
+		return
+	}
+
+	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker, authKeyStore, payloadStore, lifecycleEvents, webhookStore)
 	publicServer.Start(addr)
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().MLServerPort)
@@ -181,6 +215,38 @@ func main() {
 	os.Exit(1) // Exit with an error for cosmovisor to restart the process
 }
 
+// newAuthKeyStore keeps used AuthKeys in NATS, so replay protection survives restarts and is
+// shared by every API instance pointed at the same NATS server.
+func newAuthKeyStore(config *apiconfig.ConfigManager) (authkeys.Store, error) {
+	natsConfig := config.GetNatsConfig()
+	natsConn, err := natsclient.ConnectToNats(natsConfig.Host, natsConfig.Port, "auth_keys")
+	if err != nil {
+		return nil, err
+	}
+	js, err := natsConn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	return authkeys.NewNatsStore(js, func() int64 {
+		return authkeys.ExpirationBlocks(config)
+	})
+}
+
+// newWebhookStore keeps the webhooks registered for inference lifecycle events in NATS, so they
+// survive restarts and every API instance delivers to them.
+func newWebhookStore(config *apiconfig.ConfigManager) (subscriptions.WebhookStore, error) {
+	natsConfig := config.GetNatsConfig()
+	natsConn, err := natsclient.ConnectToNats(natsConfig.Host, natsConfig.Port, "webhooks")
+	if err != nil {
+		return nil, err
+	}
+	js, err := natsConn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions.NewNatsWebhookStore(js)
+}
+
 func returnStatus(config *apiconfig.ConfigManager) {
 	height := config.GetHeight()
 	status := map[string]interface{}{
@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"decentralized-api/logging"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dapi"
+
+// UnknownModel labels inferences for models that aren't registered on chain
+const UnknownModel = "unknown"
+
+// Registry holds every metric exported on the admin server's /metrics endpoint.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+var (
+	InferenceDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "inference",
+		Name:      "duration_seconds",
+		Help:      "Time to serve an inference request, by model, role (transfer or executor) and outcome.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 40, 80, 160},
+	}, []string{"model", "role", "outcome"})
+
+	InferenceTokens = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "inference",
+		Name:      "tokens",
+		Help:      "Tokens per executed inference, by model and type (prompt or completion).",
+		Buckets:   prometheus.ExponentialBuckets(16, 2, 12),
+	}, []string{"model", "type"})
+
+	NodeReconcileDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "broker",
+		Name:      "reconcile_duration_seconds",
+		Help:      "Time an ML node took to reach its intended status, by target status and result.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+	}, []string{"target", "succeeded"})
+
+	TxBroadcasts = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tx_manager",
+		Name:      "broadcasts_total",
+		Help:      "Transactions broadcast to the chain node, by result.",
+	}, []string{"result"})
+
+	TxRetries = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tx_manager",
+		Name:      "retries_total",
+		Help:      "Transactions put back on the send queue, by reason.",
+	}, []string{"reason"})
+
+	TxBatchSize = factory.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tx_manager",
+		Name:      "batch_size",
+		Help:      "Messages signed together in a successfully broadcast batch transaction.",
+		Buckets:   []float64{2, 4, 8, 16, 32, 64},
+	})
+
+	BandwidthUsageKb = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "bandwidth",
+		Name:      "usage_kb",
+		Help:      "Estimated KB of inferences currently accepted by the bandwidth limiter.",
+	})
+
+	BandwidthLimitKb = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "bandwidth",
+		Name:      "limit_per_block_kb",
+		Help:      "Bandwidth limit per block applied to new inferences.",
+	})
+
+	Validations = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "validation",
+		Name:      "results_total",
+		Help:      "Inference validations performed, by model and outcome.",
+	}, []string{"model", "outcome"})
+
+	ChainBlockHeight = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "event_listener",
+		Name:      "chain_block_height",
+		Help:      "Latest block height reported by the chain node.",
+	})
+
+	EventListenerLagBlocks = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "event_listener",
+		Name:      "lag_blocks",
+		Help:      "Blocks between the chain node's latest block and the last block processed by the event listener.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Register adds a collector owned by another component. Registering the same collector
+// twice is not an error, so components may register from their constructors.
+func Register(collector prometheus.Collector) {
+	err := Registry.Register(collector)
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if err != nil && !errors.As(err, &alreadyRegistered) {
+		logging.Error("Failed to register metrics collector", types.System, "error", err)
+	}
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+func ObserveInference(model string, role string, start time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	InferenceDuration.WithLabelValues(model, role, outcome).Observe(time.Since(start).Seconds())
+}
+
+func ObserveInferenceTokens(model string, promptTokens uint64, completionTokens uint64) {
+	InferenceTokens.WithLabelValues(model, "prompt").Observe(float64(promptTokens))
+	InferenceTokens.WithLabelValues(model, "completion").Observe(float64(completionTokens))
+}
@@ -101,6 +101,25 @@ func RegisterParticipantIfNeeded(recorder cosmosclient.CosmosMessageClient, conf
 	}
 }
 
+// UpdateRegionIfChanged declares the configured region on chain when it differs from the
+// registered one, so a participant can move regions without registering again
+func UpdateRegionIfChanged(recorder cosmosclient.CosmosMessageClient, config *apiconfig.ConfigManager) error {
+	queryClient := recorder.NewInferenceQueryClient()
+	response, err := queryClient.Participant(recorder.GetContext(), &types.QueryGetParticipantRequest{Index: recorder.GetAccountAddress()})
+	if err != nil {
+		if strings.Contains(err.Error(), "code = NotFound") {
+			return nil
+		}
+		return err
+	}
+	region := config.GetExecutorSelectionConfig().Region
+	if response.Participant.Region == region {
+		return nil
+	}
+	logging.Info("Updating participant region", types.Participants, "from", response.Participant.Region, "to", region)
+	return recorder.UpdateParticipantRegion(&inference.MsgUpdateParticipantRegion{Region: region})
+}
+
 func registerGenesisParticipant(recorder cosmosclient.CosmosMessageClient, configManager *apiconfig.ConfigManager) error {
 	if exists, err := participantExistsWithWait(recorder, configManager.GetChainNodeConfig().Url); exists {
 		logging.Info("Genesis participant already exists", types.Participants)
@@ -126,6 +145,7 @@ func registerGenesisParticipant(recorder cosmosclient.CosmosMessageClient, confi
 		Url:          publicUrl,
 		ValidatorKey: validatorKeyString,
 		WorkerKey:    workerPublicKey,
+		Region:       configManager.GetExecutorSelectionConfig().Region,
 	}
 
 	return recorder.SubmitNewParticipant(msg)
@@ -168,6 +188,7 @@ func registerJoiningParticipant(recorder cosmosclient.CosmosMessageClient, confi
 		ValidatorKey: validatorKeyString,
 		PubKey:       pubKeyString,
 		WorkerKey:    workerKey,
+		Region:       configManager.GetExecutorSelectionConfig().Region,
 	}
 
 	requestUrl, err := url.JoinPath(configManager.GetChainNodeConfig().SeedApiUrl, "/v1/participants")
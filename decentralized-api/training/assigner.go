@@ -8,10 +8,10 @@ import (
 	"fmt"
 	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/productscience/inference/api/inference/inference"
-	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
 	"log/slog"
 	"net/http"
+	"slices"
 	"sort"
 	"time"
 )
@@ -79,19 +79,47 @@ func (a *Assigner) tryClaimingTaskToAssign() {
 	blockHeight := chainStatus.SyncInfo.LatestBlockHeight
 	queryClient := a.cosmosClient.NewInferenceQueryClient()
 
+	paramsResp, err := queryClient.Params(a.cosmosClient.GetContext(), &types.QueryParamsRequest{})
+	if err != nil {
+		slog.Error(logTag+"Error querying for params", "err", err)
+		return
+	}
+	assignmentDeadline := paramsResp.Params.TrainingParamsOrDefault().AssignmentDeadlineBlocks
+
 	req := &types.QueryQueuedTrainingTasksRequest{}
 	resp, err := queryClient.QueuedTrainingTasks(a.cosmosClient.GetContext(), req)
 	if err != nil {
 		slog.Error(logTag+"Error querying for training tasks", "err", err)
 		return
 	}
+	tasks := resp.Tasks
 
-	task := a.chooseTrainingTask(resp.Tasks, blockHeight)
+	// In-progress tasks that lost nodes wait for replacements
+	inProgressResp, err := queryClient.InProgressTrainingTasks(a.cosmosClient.GetContext(), &types.QueryInProgressTrainingTasksRequest{})
+	if err != nil {
+		slog.Error(logTag+"Error querying for in-progress training tasks", "err", err)
+		return
+	}
+	for _, task := range inProgressResp.Tasks {
+		if needsReplacementNodes(task) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	task := a.chooseTrainingTask(tasks, blockHeight, assignmentDeadline)
 	if task == nil {
 		// slog.Info(logTag + "No training tasks to claim for assignment")
 		return
 	}
 
+	// A fresh claim is kept as it is, e.g. the one the chain renews for replacing lost nodes
+	if task.Assigner == a.cosmosClient.GetAccountAddress() && blockHeight-int64(task.ClaimedByAssignerAtBlockHeight) <= assignmentDeadline {
+		a.task = &taskToAssignState{
+			task: task,
+		}
+		return
+	}
+
 	msg := inference.MsgClaimTrainingTaskForAssignment{
 		TaskId: task.Id,
 	}
@@ -117,7 +145,7 @@ func (a *Assigner) findAlreadyClaimedTask(tasks []*types.TrainingTask) *types.Tr
 	return nil
 }
 
-func (a *Assigner) chooseTrainingTask(tasks []*types.TrainingTask, currentBlockHeight int64) *types.TrainingTask {
+func (a *Assigner) chooseTrainingTask(tasks []*types.TrainingTask, currentBlockHeight int64, assignmentDeadline int64) *types.TrainingTask {
 	// This check handles the case of the network node being restarted while the task was already claimed by it
 	taskAlreadyAssignedToMe := a.findAlreadyClaimedTask(tasks)
 	if taskAlreadyAssignedToMe != nil {
@@ -127,7 +155,8 @@ func (a *Assigner) chooseTrainingTask(tasks []*types.TrainingTask, currentBlockH
 
 	unclaimedTasks := make([]*types.TrainingTask, 0)
 	for _, task := range tasks {
-		if task.AssignedAtBlockHeight == 0 && (task.Assigner == "" || (uint64(currentBlockHeight)-task.ClaimedByAssignerAtBlockHeight) > keeper.TrainingTaskAssignmentDeadline) {
+		// Replacement nodes are picked only by the task's own assigner, found above
+		if task.AssignedAtBlockHeight == 0 && (task.Assigner == "" || (currentBlockHeight-int64(task.ClaimedByAssignerAtBlockHeight)) > assignmentDeadline) {
 			unclaimedTasks = append(unclaimedTasks, task)
 		}
 	}
@@ -148,7 +177,14 @@ func (a *Assigner) assignTask(task types.TrainingTask) {
 		return
 	}
 
-	selectedParticipants, err := getParticipantListMatchingHardwareSpec(task.HardwareResources, participants)
+	hardwareResources := task.HardwareResources
+	if needsReplacementNodes(&task) {
+		// Only the hardware of the lost nodes is assigned, on nodes not already training the task
+		hardwareResources = task.ReplacementResources
+		participants = excludeAssignedNodes(participants, task.Assignees)
+	}
+
+	selectedParticipants, err := getParticipantListMatchingHardwareSpec(hardwareResources, participants)
 	if err != nil {
 		// FIXME: Returning and sleeping 60 more secs. Not sure if it's the best strategy
 		//  We need to be able to distinguish between:
@@ -224,12 +260,40 @@ func (a *Assigner) checkTaskIsStillClaimed() {
 		a.task = nil
 	}
 
-	if resp.Task.AssignedAtBlockHeight > 0 {
+	if resp.Task.AssignedAtBlockHeight > 0 && !needsReplacementNodes(resp.Task) {
 		slog.Info(logTag+"Task is already assigned", "taskId", a.task.task.Id)
 		a.task = nil
 	}
 }
 
+// needsReplacementNodes reports whether an assigned task lost nodes that are yet to be replaced
+func needsReplacementNodes(task *types.TrainingTask) bool {
+	return task.AssignedAtBlockHeight > 0 && len(task.ReplacementResources) > 0
+}
+
+func excludeAssignedNodes(participants map[string]participantHardwareNodes, assignees []*types.TrainingTaskAssignee) map[string]participantHardwareNodes {
+	filtered := make(map[string]participantHardwareNodes, len(participants))
+	for address, p := range participants {
+		filtered[address] = p
+		if p.hardware == nil {
+			continue
+		}
+		idx := slices.IndexFunc(assignees, func(a *types.TrainingTaskAssignee) bool { return a.Participant == address })
+		if idx == -1 {
+			continue
+		}
+		var nodes []*types.HardwareNode
+		for _, node := range p.hardware.HardwareNodes {
+			if !slices.Contains(assignees[idx].NodeIds, node.LocalId) {
+				nodes = append(nodes, node)
+			}
+		}
+		p.hardware = &types.HardwareNodes{Participant: p.hardware.Participant, HardwareNodes: nodes}
+		filtered[address] = p
+	}
+	return filtered
+}
+
 type participantHardwareNodes struct {
 	participant string
 	weight      int64
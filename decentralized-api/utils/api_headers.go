@@ -8,4 +8,11 @@ const (
 	XTimestampHeader        = "X-Timestamp"
 	XTransferAddressHeader  = "X-Transfer-Address"
 	XTASignatureHeader      = "X-TA-Signature"
+	XDeveloperKeyHeader     = "X-Developer-Key"
+	XPayloadOffChainHeader  = "X-Payload-Off-Chain"
+
+	XWebhookIdHeader        = "X-Webhook-Id"
+	XWebhookEventIdHeader   = "X-Webhook-Event-Id"
+	XWebhookTimestampHeader = "X-Webhook-Timestamp"
+	XWebhookSignatureHeader = "X-Webhook-Signature"
 )
@@ -4,6 +4,7 @@ package collateral
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -15,14 +16,24 @@ import (
 )
 
 var (
-	md_Params                         protoreflect.MessageDescriptor
-	fd_Params_unbonding_period_epochs protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_unbonding_period_epochs        protoreflect.FieldDescriptor
+	fd_Params_slash_burn_share               protoreflect.FieldDescriptor
+	fd_Params_slash_validators_share         protoreflect.FieldDescriptor
+	fd_Params_slash_community_pool_share     protoreflect.FieldDescriptor
+	fd_Params_min_delegation                 protoreflect.FieldDescriptor
+	fd_Params_max_delegators_per_participant protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_inference_collateral_params_proto_init()
 	md_Params = File_inference_collateral_params_proto.Messages().ByName("Params")
 	fd_Params_unbonding_period_epochs = md_Params.Fields().ByName("unbonding_period_epochs")
+	fd_Params_slash_burn_share = md_Params.Fields().ByName("slash_burn_share")
+	fd_Params_slash_validators_share = md_Params.Fields().ByName("slash_validators_share")
+	fd_Params_slash_community_pool_share = md_Params.Fields().ByName("slash_community_pool_share")
+	fd_Params_min_delegation = md_Params.Fields().ByName("min_delegation")
+	fd_Params_max_delegators_per_participant = md_Params.Fields().ByName("max_delegators_per_participant")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -96,6 +107,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SlashBurnShare != "" {
+		value := protoreflect.ValueOfString(x.SlashBurnShare)
+		if !f(fd_Params_slash_burn_share, value) {
+			return
+		}
+	}
+	if x.SlashValidatorsShare != "" {
+		value := protoreflect.ValueOfString(x.SlashValidatorsShare)
+		if !f(fd_Params_slash_validators_share, value) {
+			return
+		}
+	}
+	if x.SlashCommunityPoolShare != "" {
+		value := protoreflect.ValueOfString(x.SlashCommunityPoolShare)
+		if !f(fd_Params_slash_community_pool_share, value) {
+			return
+		}
+	}
+	if x.MinDelegation != "" {
+		value := protoreflect.ValueOfString(x.MinDelegation)
+		if !f(fd_Params_min_delegation, value) {
+			return
+		}
+	}
+	if x.MaxDelegatorsPerParticipant != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxDelegatorsPerParticipant)
+		if !f(fd_Params_max_delegators_per_participant, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +154,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "inference.collateral.Params.unbonding_period_epochs":
 		return x.UnbondingPeriodEpochs != uint64(0)
+	case "inference.collateral.Params.slash_burn_share":
+		return x.SlashBurnShare != ""
+	case "inference.collateral.Params.slash_validators_share":
+		return x.SlashValidatorsShare != ""
+	case "inference.collateral.Params.slash_community_pool_share":
+		return x.SlashCommunityPoolShare != ""
+	case "inference.collateral.Params.min_delegation":
+		return x.MinDelegation != ""
+	case "inference.collateral.Params.max_delegators_per_participant":
+		return x.MaxDelegatorsPerParticipant != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.collateral.Params"))
@@ -131,6 +182,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "inference.collateral.Params.unbonding_period_epochs":
 		x.UnbondingPeriodEpochs = uint64(0)
+	case "inference.collateral.Params.slash_burn_share":
+		x.SlashBurnShare = ""
+	case "inference.collateral.Params.slash_validators_share":
+		x.SlashValidatorsShare = ""
+	case "inference.collateral.Params.slash_community_pool_share":
+		x.SlashCommunityPoolShare = ""
+	case "inference.collateral.Params.min_delegation":
+		x.MinDelegation = ""
+	case "inference.collateral.Params.max_delegators_per_participant":
+		x.MaxDelegatorsPerParticipant = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.collateral.Params"))
@@ -150,6 +211,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "inference.collateral.Params.unbonding_period_epochs":
 		value := x.UnbondingPeriodEpochs
 		return protoreflect.ValueOfUint64(value)
+	case "inference.collateral.Params.slash_burn_share":
+		value := x.SlashBurnShare
+		return protoreflect.ValueOfString(value)
+	case "inference.collateral.Params.slash_validators_share":
+		value := x.SlashValidatorsShare
+		return protoreflect.ValueOfString(value)
+	case "inference.collateral.Params.slash_community_pool_share":
+		value := x.SlashCommunityPoolShare
+		return protoreflect.ValueOfString(value)
+	case "inference.collateral.Params.min_delegation":
+		value := x.MinDelegation
+		return protoreflect.ValueOfString(value)
+	case "inference.collateral.Params.max_delegators_per_participant":
+		value := x.MaxDelegatorsPerParticipant
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.collateral.Params"))
@@ -172,6 +248,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "inference.collateral.Params.unbonding_period_epochs":
 		x.UnbondingPeriodEpochs = value.Uint()
+	case "inference.collateral.Params.slash_burn_share":
+		x.SlashBurnShare = value.Interface().(string)
+	case "inference.collateral.Params.slash_validators_share":
+		x.SlashValidatorsShare = value.Interface().(string)
+	case "inference.collateral.Params.slash_community_pool_share":
+		x.SlashCommunityPoolShare = value.Interface().(string)
+	case "inference.collateral.Params.min_delegation":
+		x.MinDelegation = value.Interface().(string)
+	case "inference.collateral.Params.max_delegators_per_participant":
+		x.MaxDelegatorsPerParticipant = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.collateral.Params"))
@@ -194,6 +280,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "inference.collateral.Params.unbonding_period_epochs":
 		panic(fmt.Errorf("field unbonding_period_epochs of message inference.collateral.Params is not mutable"))
+	case "inference.collateral.Params.slash_burn_share":
+		panic(fmt.Errorf("field slash_burn_share of message inference.collateral.Params is not mutable"))
+	case "inference.collateral.Params.slash_validators_share":
+		panic(fmt.Errorf("field slash_validators_share of message inference.collateral.Params is not mutable"))
+	case "inference.collateral.Params.slash_community_pool_share":
+		panic(fmt.Errorf("field slash_community_pool_share of message inference.collateral.Params is not mutable"))
+	case "inference.collateral.Params.min_delegation":
+		panic(fmt.Errorf("field min_delegation of message inference.collateral.Params is not mutable"))
+	case "inference.collateral.Params.max_delegators_per_participant":
+		panic(fmt.Errorf("field max_delegators_per_participant of message inference.collateral.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.collateral.Params"))
@@ -209,6 +305,16 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "inference.collateral.Params.unbonding_period_epochs":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.collateral.Params.slash_burn_share":
+		return protoreflect.ValueOfString("")
+	case "inference.collateral.Params.slash_validators_share":
+		return protoreflect.ValueOfString("")
+	case "inference.collateral.Params.slash_community_pool_share":
+		return protoreflect.ValueOfString("")
+	case "inference.collateral.Params.min_delegation":
+		return protoreflect.ValueOfString("")
+	case "inference.collateral.Params.max_delegators_per_participant":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.collateral.Params"))
@@ -281,6 +387,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.UnbondingPeriodEpochs != 0 {
 			n += 1 + runtime.Sov(uint64(x.UnbondingPeriodEpochs))
 		}
+		l = len(x.SlashBurnShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashValidatorsShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashCommunityPoolShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinDelegation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDelegatorsPerParticipant != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDelegatorsPerParticipant))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +435,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxDelegatorsPerParticipant != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelegatorsPerParticipant))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MinDelegation) > 0 {
+			i -= len(x.MinDelegation)
+			copy(dAtA[i:], x.MinDelegation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinDelegation)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SlashCommunityPoolShare) > 0 {
+			i -= len(x.SlashCommunityPoolShare)
+			copy(dAtA[i:], x.SlashCommunityPoolShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashCommunityPoolShare)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SlashValidatorsShare) > 0 {
+			i -= len(x.SlashValidatorsShare)
+			copy(dAtA[i:], x.SlashValidatorsShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashValidatorsShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SlashBurnShare) > 0 {
+			i -= len(x.SlashBurnShare)
+			copy(dAtA[i:], x.SlashBurnShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashBurnShare)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.UnbondingPeriodEpochs != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingPeriodEpochs))
 			i--
@@ -383,6 +541,153 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashBurnShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashBurnShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashValidatorsShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashValidatorsShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashCommunityPoolShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashCommunityPoolShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDelegation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatorsPerParticipant", wireType)
+				}
+				x.MaxDelegatorsPerParticipant = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDelegatorsPerParticipant |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -439,6 +744,17 @@ type Params struct {
 
 	// UnbondingPeriodEpochs is the number of epochs a withdrawal must wait before being released
 	UnbondingPeriodEpochs uint64 `protobuf:"varint,1,opt,name=unbonding_period_epochs,json=unbondingPeriodEpochs,proto3" json:"unbonding_period_epochs,omitempty"`
+	// SlashBurnShare is the part of slashed collateral that is burned. The shares add up to one.
+	SlashBurnShare string `protobuf:"bytes,2,opt,name=slash_burn_share,json=slashBurnShare,proto3" json:"slash_burn_share,omitempty"`
+	// SlashValidatorsShare is the part of slashed collateral paid to the validators whose votes
+	// invalidated the participant. It is burned when no validator caused the slash.
+	SlashValidatorsShare string `protobuf:"bytes,3,opt,name=slash_validators_share,json=slashValidatorsShare,proto3" json:"slash_validators_share,omitempty"`
+	// SlashCommunityPoolShare is the part of slashed collateral sent to the community pool
+	SlashCommunityPoolShare string `protobuf:"bytes,4,opt,name=slash_community_pool_share,json=slashCommunityPoolShare,proto3" json:"slash_community_pool_share,omitempty"`
+	// MinDelegation is the smallest collateral a delegator may keep delegated to a participant
+	MinDelegation string `protobuf:"bytes,5,opt,name=min_delegation,json=minDelegation,proto3" json:"min_delegation,omitempty"`
+	// MaxDelegatorsPerParticipant bounds the delegations iterated when a participant is slashed or paid
+	MaxDelegatorsPerParticipant uint32 `protobuf:"varint,6,opt,name=max_delegators_per_participant,json=maxDelegatorsPerParticipant,proto3" json:"max_delegators_per_participant,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -468,6 +784,41 @@ func (x *Params) GetUnbondingPeriodEpochs() uint64 {
 	return 0
 }
 
+func (x *Params) GetSlashBurnShare() string {
+	if x != nil {
+		return x.SlashBurnShare
+	}
+	return ""
+}
+
+func (x *Params) GetSlashValidatorsShare() string {
+	if x != nil {
+		return x.SlashValidatorsShare
+	}
+	return ""
+}
+
+func (x *Params) GetSlashCommunityPoolShare() string {
+	if x != nil {
+		return x.SlashCommunityPoolShare
+	}
+	return ""
+}
+
+func (x *Params) GetMinDelegation() string {
+	if x != nil {
+		return x.MinDelegation
+	}
+	return ""
+}
+
+func (x *Params) GetMaxDelegatorsPerParticipant() uint32 {
+	if x != nil {
+		return x.MaxDelegatorsPerParticipant
+	}
+	return 0
+}
+
 var File_inference_collateral_params_proto protoreflect.FileDescriptor
 
 var file_inference_collateral_params_proto_rawDesc = []byte{
@@ -475,28 +826,59 @@ var file_inference_collateral_params_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x63,
 	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
-	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x68, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x17,
-	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x75,
-	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x73, 0x3a, 0x26, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xbf, 0x01, 0x0a,
-	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x63,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0xa2,
-	0x02, 0x03, 0x49, 0x43, 0x58, 0xaa, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0xca, 0x02, 0x14, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0xe2, 0x02, 0x20, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x3a, 0x3a, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x04,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x12, 0x5b, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x42, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x67, 0x0a,
+	0x16, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x14, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x6e, 0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x1e, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1b, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x3a,
+	0x26, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xbf, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0xa2, 0x02, 0x03, 0x49, 0x43, 0x58,
+	0xaa, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0xca, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5c, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0xe2, 0x02,
+	0x20, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x15, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -24,6 +24,9 @@ const (
 	Query_AllCollaterals_FullMethodName          = "/inference.collateral.Query/AllCollaterals"
 	Query_UnbondingCollateral_FullMethodName     = "/inference.collateral.Query/UnbondingCollateral"
 	Query_AllUnbondingCollaterals_FullMethodName = "/inference.collateral.Query/AllUnbondingCollaterals"
+	Query_SlashHistory_FullMethodName            = "/inference.collateral.Query/SlashHistory"
+	Query_CollateralDelegations_FullMethodName   = "/inference.collateral.Query/CollateralDelegations"
+	Query_DelegatedCollateral_FullMethodName     = "/inference.collateral.Query/DelegatedCollateral"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +43,12 @@ type QueryClient interface {
 	UnbondingCollateral(ctx context.Context, in *QueryUnbondingCollateralRequest, opts ...grpc.CallOption) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(ctx context.Context, in *QueryAllUnbondingCollateralsRequest, opts ...grpc.CallOption) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the history of slashes and how the slashed collateral was distributed, optionally of one participant.
+	SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error)
+	// Queries collateral delegations, optionally of one participant and/or one delegator.
+	CollateralDelegations(ctx context.Context, in *QueryCollateralDelegationsRequest, opts ...grpc.CallOption) (*QueryCollateralDelegationsResponse, error)
+	// Queries a participant's delegated collateral and the reward share it pays its delegators.
+	DelegatedCollateral(ctx context.Context, in *QueryDelegatedCollateralRequest, opts ...grpc.CallOption) (*QueryDelegatedCollateralResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +104,33 @@ func (c *queryClient) AllUnbondingCollaterals(ctx context.Context, in *QueryAllU
 	return out, nil
 }
 
+func (c *queryClient) SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error) {
+	out := new(QuerySlashHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_SlashHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollateralDelegations(ctx context.Context, in *QueryCollateralDelegationsRequest, opts ...grpc.CallOption) (*QueryCollateralDelegationsResponse, error) {
+	out := new(QueryCollateralDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_CollateralDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedCollateral(ctx context.Context, in *QueryDelegatedCollateralRequest, opts ...grpc.CallOption) (*QueryDelegatedCollateralResponse, error) {
+	out := new(QueryDelegatedCollateralResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatedCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +145,12 @@ type QueryServer interface {
 	UnbondingCollateral(context.Context, *QueryUnbondingCollateralRequest) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the history of slashes and how the slashed collateral was distributed, optionally of one participant.
+	SlashHistory(context.Context, *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error)
+	// Queries collateral delegations, optionally of one participant and/or one delegator.
+	CollateralDelegations(context.Context, *QueryCollateralDelegationsRequest) (*QueryCollateralDelegationsResponse, error)
+	// Queries a participant's delegated collateral and the reward share it pays its delegators.
+	DelegatedCollateral(context.Context, *QueryDelegatedCollateralRequest) (*QueryDelegatedCollateralResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +173,15 @@ func (UnimplementedQueryServer) UnbondingCollateral(context.Context, *QueryUnbon
 func (UnimplementedQueryServer) AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllUnbondingCollaterals not implemented")
 }
+func (UnimplementedQueryServer) SlashHistory(context.Context, *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashHistory not implemented")
+}
+func (UnimplementedQueryServer) CollateralDelegations(context.Context, *QueryCollateralDelegationsRequest) (*QueryCollateralDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralDelegations not implemented")
+}
+func (UnimplementedQueryServer) DelegatedCollateral(context.Context, *QueryDelegatedCollateralRequest) (*QueryDelegatedCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatedCollateral not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +285,60 @@ func _Query_AllUnbondingCollaterals_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashHistory(ctx, req.(*QuerySlashHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollateralDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CollateralDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralDelegations(ctx, req.(*QueryCollateralDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatedCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatedCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedCollateral(ctx, req.(*QueryDelegatedCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +366,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllUnbondingCollaterals",
 			Handler:    _Query_AllUnbondingCollaterals_Handler,
 		},
+		{
+			MethodName: "SlashHistory",
+			Handler:    _Query_SlashHistory_Handler,
+		},
+		{
+			MethodName: "CollateralDelegations",
+			Handler:    _Query_CollateralDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatedCollateral",
+			Handler:    _Query_DelegatedCollateral_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/query.proto",
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName       = "/inference.collateral.Msg/UpdateParams"
-	Msg_DepositCollateral_FullMethodName  = "/inference.collateral.Msg/DepositCollateral"
-	Msg_WithdrawCollateral_FullMethodName = "/inference.collateral.Msg/WithdrawCollateral"
+	Msg_UpdateParams_FullMethodName            = "/inference.collateral.Msg/UpdateParams"
+	Msg_DepositCollateral_FullMethodName       = "/inference.collateral.Msg/DepositCollateral"
+	Msg_WithdrawCollateral_FullMethodName      = "/inference.collateral.Msg/WithdrawCollateral"
+	Msg_DelegateCollateral_FullMethodName      = "/inference.collateral.Msg/DelegateCollateral"
+	Msg_UndelegateCollateral_FullMethodName    = "/inference.collateral.Msg/UndelegateCollateral"
+	Msg_SetDelegatorRewardShare_FullMethodName = "/inference.collateral.Msg/SetDelegatorRewardShare"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,12 @@ type MsgClient interface {
 	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	// DelegateCollateral deposits collateral on behalf of a participant
+	DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral initiates withdrawal of delegated collateral (subject to unbonding period)
+	UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error)
+	// SetDelegatorRewardShare sets the part of the participant's rewards paid to its delegators
+	SetDelegatorRewardShare(ctx context.Context, in *MsgSetDelegatorRewardShare, opts ...grpc.CallOption) (*MsgSetDelegatorRewardShareResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +81,33 @@ func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawColla
 	return out, nil
 }
 
+func (c *msgClient) DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error) {
+	out := new(MsgDelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error) {
+	out := new(MsgUndelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDelegatorRewardShare(ctx context.Context, in *MsgSetDelegatorRewardShare, opts ...grpc.CallOption) (*MsgSetDelegatorRewardShareResponse, error) {
+	out := new(MsgSetDelegatorRewardShareResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDelegatorRewardShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +119,12 @@ type MsgServer interface {
 	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	// DelegateCollateral deposits collateral on behalf of a participant
+	DelegateCollateral(context.Context, *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral initiates withdrawal of delegated collateral (subject to unbonding period)
+	UndelegateCollateral(context.Context, *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error)
+	// SetDelegatorRewardShare sets the part of the participant's rewards paid to its delegators
+	SetDelegatorRewardShare(context.Context, *MsgSetDelegatorRewardShare) (*MsgSetDelegatorRewardShareResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +141,15 @@ func (UnimplementedMsgServer) DepositCollateral(context.Context, *MsgDepositColl
 func (UnimplementedMsgServer) WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
 }
+func (UnimplementedMsgServer) DelegateCollateral(context.Context, *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateCollateral not implemented")
+}
+func (UnimplementedMsgServer) UndelegateCollateral(context.Context, *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateCollateral not implemented")
+}
+func (UnimplementedMsgServer) SetDelegatorRewardShare(context.Context, *MsgSetDelegatorRewardShare) (*MsgSetDelegatorRewardShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegatorRewardShare not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +217,60 @@ func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateCollateral(ctx, req.(*MsgDelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateCollateral(ctx, req.(*MsgUndelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegatorRewardShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegatorRewardShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegatorRewardShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDelegatorRewardShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegatorRewardShare(ctx, req.(*MsgSetDelegatorRewardShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +290,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCollateral",
 			Handler:    _Msg_WithdrawCollateral_Handler,
 		},
+		{
+			MethodName: "DelegateCollateral",
+			Handler:    _Msg_DelegateCollateral_Handler,
+		},
+		{
+			MethodName: "UndelegateCollateral",
+			Handler:    _Msg_UndelegateCollateral_Handler,
+		},
+		{
+			MethodName: "SetDelegatorRewardShare",
+			Handler:    _Msg_SetDelegatorRewardShare_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/tx.proto",
@@ -1260,6 +1260,1868 @@ func (x *fastReflection_BridgeTransaction) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_BridgeWithdrawal                  protoreflect.MessageDescriptor
+	fd_BridgeWithdrawal_id               protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_creator          protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_destinationChain protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_contractAddress  protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_recipient        protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_amount           protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_blockHeight      protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_timestamp        protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_epochId          protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_requestId        protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_status           protoreflect.FieldDescriptor
+	fd_BridgeWithdrawal_cw20Contract     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_bridge_transaction_proto_init()
+	md_BridgeWithdrawal = File_inference_inference_bridge_transaction_proto.Messages().ByName("BridgeWithdrawal")
+	fd_BridgeWithdrawal_id = md_BridgeWithdrawal.Fields().ByName("id")
+	fd_BridgeWithdrawal_creator = md_BridgeWithdrawal.Fields().ByName("creator")
+	fd_BridgeWithdrawal_destinationChain = md_BridgeWithdrawal.Fields().ByName("destinationChain")
+	fd_BridgeWithdrawal_contractAddress = md_BridgeWithdrawal.Fields().ByName("contractAddress")
+	fd_BridgeWithdrawal_recipient = md_BridgeWithdrawal.Fields().ByName("recipient")
+	fd_BridgeWithdrawal_amount = md_BridgeWithdrawal.Fields().ByName("amount")
+	fd_BridgeWithdrawal_blockHeight = md_BridgeWithdrawal.Fields().ByName("blockHeight")
+	fd_BridgeWithdrawal_timestamp = md_BridgeWithdrawal.Fields().ByName("timestamp")
+	fd_BridgeWithdrawal_epochId = md_BridgeWithdrawal.Fields().ByName("epochId")
+	fd_BridgeWithdrawal_requestId = md_BridgeWithdrawal.Fields().ByName("requestId")
+	fd_BridgeWithdrawal_status = md_BridgeWithdrawal.Fields().ByName("status")
+	fd_BridgeWithdrawal_cw20Contract = md_BridgeWithdrawal.Fields().ByName("cw20Contract")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeWithdrawal)(nil)
+
+type fastReflection_BridgeWithdrawal BridgeWithdrawal
+
+func (x *BridgeWithdrawal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeWithdrawal)(x)
+}
+
+func (x *BridgeWithdrawal) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_transaction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeWithdrawal_messageType fastReflection_BridgeWithdrawal_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeWithdrawal_messageType{}
+
+type fastReflection_BridgeWithdrawal_messageType struct{}
+
+func (x fastReflection_BridgeWithdrawal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeWithdrawal)(nil)
+}
+func (x fastReflection_BridgeWithdrawal_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeWithdrawal)
+}
+func (x fastReflection_BridgeWithdrawal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeWithdrawal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeWithdrawal) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeWithdrawal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeWithdrawal) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeWithdrawal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeWithdrawal) New() protoreflect.Message {
+	return new(fastReflection_BridgeWithdrawal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeWithdrawal) Interface() protoreflect.ProtoMessage {
+	return (*BridgeWithdrawal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeWithdrawal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BridgeWithdrawal_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_BridgeWithdrawal_creator, value) {
+			return
+		}
+	}
+	if x.DestinationChain != "" {
+		value := protoreflect.ValueOfString(x.DestinationChain)
+		if !f(fd_BridgeWithdrawal_destinationChain, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_BridgeWithdrawal_contractAddress, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_BridgeWithdrawal_recipient, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_BridgeWithdrawal_amount, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_BridgeWithdrawal_blockHeight, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_BridgeWithdrawal_timestamp, value) {
+			return
+		}
+	}
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_BridgeWithdrawal_epochId, value) {
+			return
+		}
+	}
+	if len(x.RequestId) != 0 {
+		value := protoreflect.ValueOfBytes(x.RequestId)
+		if !f(fd_BridgeWithdrawal_requestId, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_BridgeWithdrawal_status, value) {
+			return
+		}
+	}
+	if x.Cw20Contract != "" {
+		value := protoreflect.ValueOfString(x.Cw20Contract)
+		if !f(fd_BridgeWithdrawal_cw20Contract, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeWithdrawal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWithdrawal.id":
+		return x.Id != uint64(0)
+	case "inference.inference.BridgeWithdrawal.creator":
+		return x.Creator != ""
+	case "inference.inference.BridgeWithdrawal.destinationChain":
+		return x.DestinationChain != ""
+	case "inference.inference.BridgeWithdrawal.contractAddress":
+		return x.ContractAddress != ""
+	case "inference.inference.BridgeWithdrawal.recipient":
+		return x.Recipient != ""
+	case "inference.inference.BridgeWithdrawal.amount":
+		return x.Amount != ""
+	case "inference.inference.BridgeWithdrawal.blockHeight":
+		return x.BlockHeight != int64(0)
+	case "inference.inference.BridgeWithdrawal.timestamp":
+		return x.Timestamp != int64(0)
+	case "inference.inference.BridgeWithdrawal.epochId":
+		return x.EpochId != uint64(0)
+	case "inference.inference.BridgeWithdrawal.requestId":
+		return len(x.RequestId) != 0
+	case "inference.inference.BridgeWithdrawal.status":
+		return x.Status != 0
+	case "inference.inference.BridgeWithdrawal.cw20Contract":
+		return x.Cw20Contract != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWithdrawal"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWithdrawal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWithdrawal.id":
+		x.Id = uint64(0)
+	case "inference.inference.BridgeWithdrawal.creator":
+		x.Creator = ""
+	case "inference.inference.BridgeWithdrawal.destinationChain":
+		x.DestinationChain = ""
+	case "inference.inference.BridgeWithdrawal.contractAddress":
+		x.ContractAddress = ""
+	case "inference.inference.BridgeWithdrawal.recipient":
+		x.Recipient = ""
+	case "inference.inference.BridgeWithdrawal.amount":
+		x.Amount = ""
+	case "inference.inference.BridgeWithdrawal.blockHeight":
+		x.BlockHeight = int64(0)
+	case "inference.inference.BridgeWithdrawal.timestamp":
+		x.Timestamp = int64(0)
+	case "inference.inference.BridgeWithdrawal.epochId":
+		x.EpochId = uint64(0)
+	case "inference.inference.BridgeWithdrawal.requestId":
+		x.RequestId = nil
+	case "inference.inference.BridgeWithdrawal.status":
+		x.Status = 0
+	case "inference.inference.BridgeWithdrawal.cw20Contract":
+		x.Cw20Contract = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWithdrawal"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeWithdrawal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.BridgeWithdrawal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.BridgeWithdrawal.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWithdrawal.destinationChain":
+		value := x.DestinationChain
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWithdrawal.contractAddress":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWithdrawal.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWithdrawal.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWithdrawal.blockHeight":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.BridgeWithdrawal.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.BridgeWithdrawal.epochId":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.BridgeWithdrawal.requestId":
+		value := x.RequestId
+		return protoreflect.ValueOfBytes(value)
+	case "inference.inference.BridgeWithdrawal.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.inference.BridgeWithdrawal.cw20Contract":
+		value := x.Cw20Contract
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWithdrawal"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWithdrawal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWithdrawal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWithdrawal.id":
+		x.Id = value.Uint()
+	case "inference.inference.BridgeWithdrawal.creator":
+		x.Creator = value.Interface().(string)
+	case "inference.inference.BridgeWithdrawal.destinationChain":
+		x.DestinationChain = value.Interface().(string)
+	case "inference.inference.BridgeWithdrawal.contractAddress":
+		x.ContractAddress = value.Interface().(string)
+	case "inference.inference.BridgeWithdrawal.recipient":
+		x.Recipient = value.Interface().(string)
+	case "inference.inference.BridgeWithdrawal.amount":
+		x.Amount = value.Interface().(string)
+	case "inference.inference.BridgeWithdrawal.blockHeight":
+		x.BlockHeight = value.Int()
+	case "inference.inference.BridgeWithdrawal.timestamp":
+		x.Timestamp = value.Int()
+	case "inference.inference.BridgeWithdrawal.epochId":
+		x.EpochId = value.Uint()
+	case "inference.inference.BridgeWithdrawal.requestId":
+		x.RequestId = value.Bytes()
+	case "inference.inference.BridgeWithdrawal.status":
+		x.Status = (BridgeWithdrawalStatus)(value.Enum())
+	case "inference.inference.BridgeWithdrawal.cw20Contract":
+		x.Cw20Contract = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWithdrawal"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWithdrawal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWithdrawal.id":
+		panic(fmt.Errorf("field id of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.creator":
+		panic(fmt.Errorf("field creator of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.destinationChain":
+		panic(fmt.Errorf("field destinationChain of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.contractAddress":
+		panic(fmt.Errorf("field contractAddress of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.recipient":
+		panic(fmt.Errorf("field recipient of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.amount":
+		panic(fmt.Errorf("field amount of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.blockHeight":
+		panic(fmt.Errorf("field blockHeight of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.timestamp":
+		panic(fmt.Errorf("field timestamp of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.epochId":
+		panic(fmt.Errorf("field epochId of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.requestId":
+		panic(fmt.Errorf("field requestId of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.status":
+		panic(fmt.Errorf("field status of message inference.inference.BridgeWithdrawal is not mutable"))
+	case "inference.inference.BridgeWithdrawal.cw20Contract":
+		panic(fmt.Errorf("field cw20Contract of message inference.inference.BridgeWithdrawal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWithdrawal"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeWithdrawal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWithdrawal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.BridgeWithdrawal.creator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWithdrawal.destinationChain":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWithdrawal.contractAddress":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWithdrawal.recipient":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWithdrawal.amount":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWithdrawal.blockHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.BridgeWithdrawal.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.BridgeWithdrawal.epochId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.BridgeWithdrawal.requestId":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.inference.BridgeWithdrawal.status":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.inference.BridgeWithdrawal.cw20Contract":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWithdrawal"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeWithdrawal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeWithdrawal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeWithdrawal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWithdrawal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeWithdrawal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeWithdrawal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeWithdrawal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationChain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		l = len(x.RequestId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Cw20Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeWithdrawal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Cw20Contract) > 0 {
+			i -= len(x.Cw20Contract)
+			copy(dAtA[i:], x.Cw20Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cw20Contract)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.RequestId) > 0 {
+			i -= len(x.RequestId)
+			copy(dAtA[i:], x.RequestId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestId)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DestinationChain) > 0 {
+			i -= len(x.DestinationChain)
+			copy(dAtA[i:], x.DestinationChain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChain)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeWithdrawal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeWithdrawal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationChain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestId = append(x.RequestId[:0], dAtA[iNdEx:postIndex]...)
+				if x.RequestId == nil {
+					x.RequestId = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= BridgeWithdrawalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cw20Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cw20Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BridgeBlockHeader_4_list)(nil)
+
+type _BridgeBlockHeader_4_list struct {
+	list *[]string
+}
+
+func (x *_BridgeBlockHeader_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BridgeBlockHeader_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_BridgeBlockHeader_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BridgeBlockHeader_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BridgeBlockHeader_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BridgeBlockHeader at list field Validators as it is not of Message kind"))
+}
+
+func (x *_BridgeBlockHeader_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BridgeBlockHeader_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_BridgeBlockHeader_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BridgeBlockHeader                       protoreflect.MessageDescriptor
+	fd_BridgeBlockHeader_originChain           protoreflect.FieldDescriptor
+	fd_BridgeBlockHeader_blockNumber           protoreflect.FieldDescriptor
+	fd_BridgeBlockHeader_receiptsRoot          protoreflect.FieldDescriptor
+	fd_BridgeBlockHeader_validators            protoreflect.FieldDescriptor
+	fd_BridgeBlockHeader_validationCount       protoreflect.FieldDescriptor
+	fd_BridgeBlockHeader_status                protoreflect.FieldDescriptor
+	fd_BridgeBlockHeader_attestedAtBlockHeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_bridge_transaction_proto_init()
+	md_BridgeBlockHeader = File_inference_inference_bridge_transaction_proto.Messages().ByName("BridgeBlockHeader")
+	fd_BridgeBlockHeader_originChain = md_BridgeBlockHeader.Fields().ByName("originChain")
+	fd_BridgeBlockHeader_blockNumber = md_BridgeBlockHeader.Fields().ByName("blockNumber")
+	fd_BridgeBlockHeader_receiptsRoot = md_BridgeBlockHeader.Fields().ByName("receiptsRoot")
+	fd_BridgeBlockHeader_validators = md_BridgeBlockHeader.Fields().ByName("validators")
+	fd_BridgeBlockHeader_validationCount = md_BridgeBlockHeader.Fields().ByName("validationCount")
+	fd_BridgeBlockHeader_status = md_BridgeBlockHeader.Fields().ByName("status")
+	fd_BridgeBlockHeader_attestedAtBlockHeight = md_BridgeBlockHeader.Fields().ByName("attestedAtBlockHeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeBlockHeader)(nil)
+
+type fastReflection_BridgeBlockHeader BridgeBlockHeader
+
+func (x *BridgeBlockHeader) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeBlockHeader)(x)
+}
+
+func (x *BridgeBlockHeader) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_transaction_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeBlockHeader_messageType fastReflection_BridgeBlockHeader_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeBlockHeader_messageType{}
+
+type fastReflection_BridgeBlockHeader_messageType struct{}
+
+func (x fastReflection_BridgeBlockHeader_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeBlockHeader)(nil)
+}
+func (x fastReflection_BridgeBlockHeader_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeBlockHeader)
+}
+func (x fastReflection_BridgeBlockHeader_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeBlockHeader
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeBlockHeader) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeBlockHeader
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeBlockHeader) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeBlockHeader_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeBlockHeader) New() protoreflect.Message {
+	return new(fastReflection_BridgeBlockHeader)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeBlockHeader) Interface() protoreflect.ProtoMessage {
+	return (*BridgeBlockHeader)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeBlockHeader) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OriginChain != "" {
+		value := protoreflect.ValueOfString(x.OriginChain)
+		if !f(fd_BridgeBlockHeader_originChain, value) {
+			return
+		}
+	}
+	if x.BlockNumber != "" {
+		value := protoreflect.ValueOfString(x.BlockNumber)
+		if !f(fd_BridgeBlockHeader_blockNumber, value) {
+			return
+		}
+	}
+	if x.ReceiptsRoot != "" {
+		value := protoreflect.ValueOfString(x.ReceiptsRoot)
+		if !f(fd_BridgeBlockHeader_receiptsRoot, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_BridgeBlockHeader_4_list{list: &x.Validators})
+		if !f(fd_BridgeBlockHeader_validators, value) {
+			return
+		}
+	}
+	if x.ValidationCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ValidationCount)
+		if !f(fd_BridgeBlockHeader_validationCount, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_BridgeBlockHeader_status, value) {
+			return
+		}
+	}
+	if x.AttestedAtBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AttestedAtBlockHeight)
+		if !f(fd_BridgeBlockHeader_attestedAtBlockHeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeBlockHeader) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.BridgeBlockHeader.originChain":
+		return x.OriginChain != ""
+	case "inference.inference.BridgeBlockHeader.blockNumber":
+		return x.BlockNumber != ""
+	case "inference.inference.BridgeBlockHeader.receiptsRoot":
+		return x.ReceiptsRoot != ""
+	case "inference.inference.BridgeBlockHeader.validators":
+		return len(x.Validators) != 0
+	case "inference.inference.BridgeBlockHeader.validationCount":
+		return x.ValidationCount != uint32(0)
+	case "inference.inference.BridgeBlockHeader.status":
+		return x.Status != 0
+	case "inference.inference.BridgeBlockHeader.attestedAtBlockHeight":
+		return x.AttestedAtBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockHeader"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeBlockHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeBlockHeader) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeBlockHeader.originChain":
+		x.OriginChain = ""
+	case "inference.inference.BridgeBlockHeader.blockNumber":
+		x.BlockNumber = ""
+	case "inference.inference.BridgeBlockHeader.receiptsRoot":
+		x.ReceiptsRoot = ""
+	case "inference.inference.BridgeBlockHeader.validators":
+		x.Validators = nil
+	case "inference.inference.BridgeBlockHeader.validationCount":
+		x.ValidationCount = uint32(0)
+	case "inference.inference.BridgeBlockHeader.status":
+		x.Status = 0
+	case "inference.inference.BridgeBlockHeader.attestedAtBlockHeight":
+		x.AttestedAtBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockHeader"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeBlockHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeBlockHeader) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.BridgeBlockHeader.originChain":
+		value := x.OriginChain
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeBlockHeader.blockNumber":
+		value := x.BlockNumber
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeBlockHeader.receiptsRoot":
+		value := x.ReceiptsRoot
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeBlockHeader.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_BridgeBlockHeader_4_list{})
+		}
+		listValue := &_BridgeBlockHeader_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.BridgeBlockHeader.validationCount":
+		value := x.ValidationCount
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.BridgeBlockHeader.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.inference.BridgeBlockHeader.attestedAtBlockHeight":
+		value := x.AttestedAtBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockHeader"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeBlockHeader does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeBlockHeader) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeBlockHeader.originChain":
+		x.OriginChain = value.Interface().(string)
+	case "inference.inference.BridgeBlockHeader.blockNumber":
+		x.BlockNumber = value.Interface().(string)
+	case "inference.inference.BridgeBlockHeader.receiptsRoot":
+		x.ReceiptsRoot = value.Interface().(string)
+	case "inference.inference.BridgeBlockHeader.validators":
+		lv := value.List()
+		clv := lv.(*_BridgeBlockHeader_4_list)
+		x.Validators = *clv.list
+	case "inference.inference.BridgeBlockHeader.validationCount":
+		x.ValidationCount = uint32(value.Uint())
+	case "inference.inference.BridgeBlockHeader.status":
+		x.Status = (BridgeTransactionStatus)(value.Enum())
+	case "inference.inference.BridgeBlockHeader.attestedAtBlockHeight":
+		x.AttestedAtBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockHeader"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeBlockHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeBlockHeader) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeBlockHeader.validators":
+		if x.Validators == nil {
+			x.Validators = []string{}
+		}
+		value := &_BridgeBlockHeader_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.BridgeBlockHeader.originChain":
+		panic(fmt.Errorf("field originChain of message inference.inference.BridgeBlockHeader is not mutable"))
+	case "inference.inference.BridgeBlockHeader.blockNumber":
+		panic(fmt.Errorf("field blockNumber of message inference.inference.BridgeBlockHeader is not mutable"))
+	case "inference.inference.BridgeBlockHeader.receiptsRoot":
+		panic(fmt.Errorf("field receiptsRoot of message inference.inference.BridgeBlockHeader is not mutable"))
+	case "inference.inference.BridgeBlockHeader.validationCount":
+		panic(fmt.Errorf("field validationCount of message inference.inference.BridgeBlockHeader is not mutable"))
+	case "inference.inference.BridgeBlockHeader.status":
+		panic(fmt.Errorf("field status of message inference.inference.BridgeBlockHeader is not mutable"))
+	case "inference.inference.BridgeBlockHeader.attestedAtBlockHeight":
+		panic(fmt.Errorf("field attestedAtBlockHeight of message inference.inference.BridgeBlockHeader is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockHeader"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeBlockHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeBlockHeader) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeBlockHeader.originChain":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeBlockHeader.blockNumber":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeBlockHeader.receiptsRoot":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeBlockHeader.validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_BridgeBlockHeader_4_list{list: &list})
+	case "inference.inference.BridgeBlockHeader.validationCount":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.BridgeBlockHeader.status":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.inference.BridgeBlockHeader.attestedAtBlockHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockHeader"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeBlockHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeBlockHeader) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeBlockHeader", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeBlockHeader) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeBlockHeader) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeBlockHeader) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeBlockHeader) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeBlockHeader)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OriginChain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockNumber)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceiptsRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Validators) > 0 {
+			for _, s := range x.Validators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ValidationCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationCount))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.AttestedAtBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.AttestedAtBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeBlockHeader)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AttestedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttestedAtBlockHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ValidationCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Validators[iNdEx])
+				copy(dAtA[i:], x.Validators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validators[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ReceiptsRoot) > 0 {
+			i -= len(x.ReceiptsRoot)
+			copy(dAtA[i:], x.ReceiptsRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptsRoot)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BlockNumber) > 0 {
+			i -= len(x.BlockNumber)
+			copy(dAtA[i:], x.BlockNumber)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockNumber)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OriginChain) > 0 {
+			i -= len(x.OriginChain)
+			copy(dAtA[i:], x.OriginChain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginChain)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeBlockHeader)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeBlockHeader: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginChain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockNumber = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationCount", wireType)
+				}
+				x.ValidationCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= BridgeTransactionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestedAtBlockHeight", wireType)
+				}
+				x.AttestedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AttestedAtBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -1319,6 +3181,55 @@ func (BridgeTransactionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_inference_inference_bridge_transaction_proto_rawDescGZIP(), []int{0}
 }
 
+type BridgeWithdrawalStatus int32
+
+const (
+	BridgeWithdrawalStatus_WITHDRAWAL_PENDING  BridgeWithdrawalStatus = 0 // tokens held in escrow, waiting for the threshold signature
+	BridgeWithdrawalStatus_WITHDRAWAL_SIGNED   BridgeWithdrawalStatus = 1 // signature completed, escrowed tokens burned
+	BridgeWithdrawalStatus_WITHDRAWAL_REFUNDED BridgeWithdrawalStatus = 2 // signing expired or failed, escrowed tokens returned
+)
+
+// Enum value maps for BridgeWithdrawalStatus.
+var (
+	BridgeWithdrawalStatus_name = map[int32]string{
+		0: "WITHDRAWAL_PENDING",
+		1: "WITHDRAWAL_SIGNED",
+		2: "WITHDRAWAL_REFUNDED",
+	}
+	BridgeWithdrawalStatus_value = map[string]int32{
+		"WITHDRAWAL_PENDING":  0,
+		"WITHDRAWAL_SIGNED":   1,
+		"WITHDRAWAL_REFUNDED": 2,
+	}
+)
+
+func (x BridgeWithdrawalStatus) Enum() *BridgeWithdrawalStatus {
+	p := new(BridgeWithdrawalStatus)
+	*p = x
+	return p
+}
+
+func (x BridgeWithdrawalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeWithdrawalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_bridge_transaction_proto_enumTypes[1].Descriptor()
+}
+
+func (BridgeWithdrawalStatus) Type() protoreflect.EnumType {
+	return &file_inference_inference_bridge_transaction_proto_enumTypes[1]
+}
+
+func (x BridgeWithdrawalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeWithdrawalStatus.Descriptor instead.
+func (BridgeWithdrawalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_transaction_proto_rawDescGZIP(), []int{1}
+}
+
 type BridgeTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1458,6 +3369,218 @@ func (x *BridgeTransaction) GetReceiptsRoot() string {
 	return ""
 }
 
+// BridgeWithdrawal is an outbound transfer of bridged tokens back to their origin chain.
+// The tokens are held in escrow until the BLS threshold group signs the withdrawal, so a contract
+// on the destination chain can release the funds. They are burned once the signature completes
+// and returned to the creator if signing expires or fails.
+type BridgeWithdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator          string                 `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`                   // account whose bridged tokens were burned
+	DestinationChain string                 `protobuf:"bytes,3,opt,name=destinationChain,proto3" json:"destinationChain,omitempty"` // e.g., "ethereum"
+	ContractAddress  string                 `protobuf:"bytes,4,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`   // token contract on the destination chain
+	Recipient        string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`               // recipient address on the destination chain
+	Amount           string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHeight      int64                  `protobuf:"varint,7,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Timestamp        int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EpochId          uint64                 `protobuf:"varint,9,opt,name=epochId,proto3" json:"epochId,omitempty"`     // epoch whose BLS group signs the withdrawal
+	RequestId        []byte                 `protobuf:"bytes,10,opt,name=requestId,proto3" json:"requestId,omitempty"` // threshold signing request in x/bls
+	Status           BridgeWithdrawalStatus `protobuf:"varint,11,opt,name=status,proto3,enum=inference.inference.BridgeWithdrawalStatus" json:"status,omitempty"`
+	Cw20Contract     string                 `protobuf:"bytes,12,opt,name=cw20Contract,proto3" json:"cw20Contract,omitempty"` // CW20 token the escrowed tokens belong to
+}
+
+func (x *BridgeWithdrawal) Reset() {
+	*x = BridgeWithdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_transaction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeWithdrawal) ProtoMessage() {}
+
+// Deprecated: Use BridgeWithdrawal.ProtoReflect.Descriptor instead.
+func (*BridgeWithdrawal) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BridgeWithdrawal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BridgeWithdrawal) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *BridgeWithdrawal) GetDestinationChain() string {
+	if x != nil {
+		return x.DestinationChain
+	}
+	return ""
+}
+
+func (x *BridgeWithdrawal) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *BridgeWithdrawal) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *BridgeWithdrawal) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BridgeWithdrawal) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *BridgeWithdrawal) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BridgeWithdrawal) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *BridgeWithdrawal) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *BridgeWithdrawal) GetStatus() BridgeWithdrawalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BridgeWithdrawalStatus_WITHDRAWAL_PENDING
+}
+
+func (x *BridgeWithdrawal) GetCw20Contract() string {
+	if x != nil {
+		return x.Cw20Contract
+	}
+	return ""
+}
+
+// BridgeBlockHeader is the receipts root of an origin chain block, as attested by the validators.
+// Once a majority agrees on it, deposits in the block are proven against it with receipt proofs.
+type BridgeBlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginChain           string                  `protobuf:"bytes,1,opt,name=originChain,proto3" json:"originChain,omitempty"`
+	BlockNumber           string                  `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ReceiptsRoot          string                  `protobuf:"bytes,3,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"` // 0x-prefixed lowercase hex
+	Validators            []string                `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	ValidationCount       uint32                  `protobuf:"varint,5,opt,name=validationCount,proto3" json:"validationCount,omitempty"`
+	Status                BridgeTransactionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=inference.inference.BridgeTransactionStatus" json:"status,omitempty"`
+	AttestedAtBlockHeight int64                   `protobuf:"varint,7,opt,name=attestedAtBlockHeight,proto3" json:"attestedAtBlockHeight,omitempty"` // height of the first attestation, votes that never reach a majority are pruned
+}
+
+func (x *BridgeBlockHeader) Reset() {
+	*x = BridgeBlockHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_transaction_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeBlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeBlockHeader) ProtoMessage() {}
+
+// Deprecated: Use BridgeBlockHeader.ProtoReflect.Descriptor instead.
+func (*BridgeBlockHeader) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BridgeBlockHeader) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *BridgeBlockHeader) GetBlockNumber() string {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return ""
+}
+
+func (x *BridgeBlockHeader) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
+}
+
+func (x *BridgeBlockHeader) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *BridgeBlockHeader) GetValidationCount() uint32 {
+	if x != nil {
+		return x.ValidationCount
+	}
+	return 0
+}
+
+func (x *BridgeBlockHeader) GetStatus() BridgeTransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BridgeTransactionStatus_BRIDGE_PENDING
+}
+
+func (x *BridgeBlockHeader) GetAttestedAtBlockHeight() int64 {
+	if x != nil {
+		return x.AttestedAtBlockHeight
+	}
+	return 0
+}
+
 var File_inference_inference_bridge_transaction_proto protoreflect.FileDescriptor
 
 var file_inference_inference_bridge_transaction_proto_rawDesc = []byte{
@@ -1497,24 +3620,77 @@ var file_inference_inference_bridge_transaction_proto_rawDesc = []byte{
 	0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
 	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
 	0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x2a, 0x43, 0x0a, 0x17, 0x42, 0x72, 0x69,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0xa9, 0x03, 0x0a, 0x10, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x77, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x77, 0x32, 0x30, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0xc1, 0x02, 0x0a, 0x11, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x43, 0x0a, 0x17, 0x42, 0x72, 0x69,
 	0x64, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x50,
 	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x52, 0x49, 0x44,
-	0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x42, 0xc4,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x16, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58,
-	0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x60,
+	0x0a, 0x16, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x49, 0x54, 0x48,
+	0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x49, 0x54, 0x48, 0x44,
+	0x52, 0x41, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02,
+	0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x16, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49,
+	0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02,
+	0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1529,19 +3705,24 @@ func file_inference_inference_bridge_transaction_proto_rawDescGZIP() []byte {
 	return file_inference_inference_bridge_transaction_proto_rawDescData
 }
 
-var file_inference_inference_bridge_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inference_inference_bridge_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_inference_inference_bridge_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_inference_inference_bridge_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_inference_inference_bridge_transaction_proto_goTypes = []interface{}{
 	(BridgeTransactionStatus)(0), // 0: inference.inference.BridgeTransactionStatus
-	(*BridgeTransaction)(nil),    // 1: inference.inference.BridgeTransaction
+	(BridgeWithdrawalStatus)(0),  // 1: inference.inference.BridgeWithdrawalStatus
+	(*BridgeTransaction)(nil),    // 2: inference.inference.BridgeTransaction
+	(*BridgeWithdrawal)(nil),     // 3: inference.inference.BridgeWithdrawal
+	(*BridgeBlockHeader)(nil),    // 4: inference.inference.BridgeBlockHeader
 }
 var file_inference_inference_bridge_transaction_proto_depIdxs = []int32{
 	0, // 0: inference.inference.BridgeTransaction.status:type_name -> inference.inference.BridgeTransactionStatus
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: inference.inference.BridgeWithdrawal.status:type_name -> inference.inference.BridgeWithdrawalStatus
+	0, // 2: inference.inference.BridgeBlockHeader.status:type_name -> inference.inference.BridgeTransactionStatus
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_bridge_transaction_proto_init() }
@@ -1562,14 +3743,38 @@ func file_inference_inference_bridge_transaction_proto_init() {
 				return nil
 			}
 		}
+		file_inference_inference_bridge_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeWithdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_bridge_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeBlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_bridge_transaction_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
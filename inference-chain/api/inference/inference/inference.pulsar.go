@@ -574,6 +574,52 @@ func (x *_Inference_23_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Inference_34_list)(nil)
+
+type _Inference_34_list struct {
+	list *[]string
+}
+
+func (x *_Inference_34_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Inference_34_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Inference_34_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Inference_34_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Inference_34_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Inference at list field AbandonedExecutors as it is not of Message kind"))
+}
+
+func (x *_Inference_34_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Inference_34_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Inference_34_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Inference                              protoreflect.MessageDescriptor
 	fd_Inference_index                        protoreflect.FieldDescriptor
@@ -608,6 +654,13 @@ var (
 	fd_Inference_execution_signature          protoreflect.FieldDescriptor
 	fd_Inference_original_prompt              protoreflect.FieldDescriptor
 	fd_Inference_per_token_price              protoreflect.FieldDescriptor
+	fd_Inference_kind                         protoreflect.FieldDescriptor
+	fd_Inference_abandoned_executors          protoreflect.FieldDescriptor
+	fd_Inference_original_prompt_hash         protoreflect.FieldDescriptor
+	fd_Inference_response_payload_hash        protoreflect.FieldDescriptor
+	fd_Inference_payload_locator              protoreflect.FieldDescriptor
+	fd_Inference_sub_key                      protoreflect.FieldDescriptor
+	fd_Inference_sub_key_epoch                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -645,6 +698,13 @@ func init() {
 	fd_Inference_execution_signature = md_Inference.Fields().ByName("execution_signature")
 	fd_Inference_original_prompt = md_Inference.Fields().ByName("original_prompt")
 	fd_Inference_per_token_price = md_Inference.Fields().ByName("per_token_price")
+	fd_Inference_kind = md_Inference.Fields().ByName("kind")
+	fd_Inference_abandoned_executors = md_Inference.Fields().ByName("abandoned_executors")
+	fd_Inference_original_prompt_hash = md_Inference.Fields().ByName("original_prompt_hash")
+	fd_Inference_response_payload_hash = md_Inference.Fields().ByName("response_payload_hash")
+	fd_Inference_payload_locator = md_Inference.Fields().ByName("payload_locator")
+	fd_Inference_sub_key = md_Inference.Fields().ByName("sub_key")
+	fd_Inference_sub_key_epoch = md_Inference.Fields().ByName("sub_key_epoch")
 }
 
 var _ protoreflect.Message = (*fastReflection_Inference)(nil)
@@ -904,6 +964,48 @@ func (x *fastReflection_Inference) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_Inference_kind, value) {
+			return
+		}
+	}
+	if len(x.AbandonedExecutors) != 0 {
+		value := protoreflect.ValueOfList(&_Inference_34_list{list: &x.AbandonedExecutors})
+		if !f(fd_Inference_abandoned_executors, value) {
+			return
+		}
+	}
+	if x.OriginalPromptHash != "" {
+		value := protoreflect.ValueOfString(x.OriginalPromptHash)
+		if !f(fd_Inference_original_prompt_hash, value) {
+			return
+		}
+	}
+	if x.ResponsePayloadHash != "" {
+		value := protoreflect.ValueOfString(x.ResponsePayloadHash)
+		if !f(fd_Inference_response_payload_hash, value) {
+			return
+		}
+	}
+	if x.PayloadLocator != "" {
+		value := protoreflect.ValueOfString(x.PayloadLocator)
+		if !f(fd_Inference_payload_locator, value) {
+			return
+		}
+	}
+	if x.SubKey != "" {
+		value := protoreflect.ValueOfString(x.SubKey)
+		if !f(fd_Inference_sub_key, value) {
+			return
+		}
+	}
+	if x.SubKeyEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubKeyEpoch)
+		if !f(fd_Inference_sub_key_epoch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -983,6 +1085,20 @@ func (x *fastReflection_Inference) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.OriginalPrompt != ""
 	case "inference.inference.Inference.per_token_price":
 		return x.PerTokenPrice != uint64(0)
+	case "inference.inference.Inference.kind":
+		return x.Kind != 0
+	case "inference.inference.Inference.abandoned_executors":
+		return len(x.AbandonedExecutors) != 0
+	case "inference.inference.Inference.original_prompt_hash":
+		return x.OriginalPromptHash != ""
+	case "inference.inference.Inference.response_payload_hash":
+		return x.ResponsePayloadHash != ""
+	case "inference.inference.Inference.payload_locator":
+		return x.PayloadLocator != ""
+	case "inference.inference.Inference.sub_key":
+		return x.SubKey != ""
+	case "inference.inference.Inference.sub_key_epoch":
+		return x.SubKeyEpoch != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1063,6 +1179,20 @@ func (x *fastReflection_Inference) Clear(fd protoreflect.FieldDescriptor) {
 		x.OriginalPrompt = ""
 	case "inference.inference.Inference.per_token_price":
 		x.PerTokenPrice = uint64(0)
+	case "inference.inference.Inference.kind":
+		x.Kind = 0
+	case "inference.inference.Inference.abandoned_executors":
+		x.AbandonedExecutors = nil
+	case "inference.inference.Inference.original_prompt_hash":
+		x.OriginalPromptHash = ""
+	case "inference.inference.Inference.response_payload_hash":
+		x.ResponsePayloadHash = ""
+	case "inference.inference.Inference.payload_locator":
+		x.PayloadLocator = ""
+	case "inference.inference.Inference.sub_key":
+		x.SubKey = ""
+	case "inference.inference.Inference.sub_key_epoch":
+		x.SubKeyEpoch = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1178,6 +1308,30 @@ func (x *fastReflection_Inference) Get(descriptor protoreflect.FieldDescriptor)
 	case "inference.inference.Inference.per_token_price":
 		value := x.PerTokenPrice
 		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.Inference.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.inference.Inference.abandoned_executors":
+		if len(x.AbandonedExecutors) == 0 {
+			return protoreflect.ValueOfList(&_Inference_34_list{})
+		}
+		listValue := &_Inference_34_list{list: &x.AbandonedExecutors}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.Inference.original_prompt_hash":
+		value := x.OriginalPromptHash
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.response_payload_hash":
+		value := x.ResponsePayloadHash
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.payload_locator":
+		value := x.PayloadLocator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.sub_key":
+		value := x.SubKey
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.sub_key_epoch":
+		value := x.SubKeyEpoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1264,6 +1418,22 @@ func (x *fastReflection_Inference) Set(fd protoreflect.FieldDescriptor, value pr
 		x.OriginalPrompt = value.Interface().(string)
 	case "inference.inference.Inference.per_token_price":
 		x.PerTokenPrice = value.Uint()
+	case "inference.inference.Inference.kind":
+		x.Kind = (InferenceKind)(value.Enum())
+	case "inference.inference.Inference.abandoned_executors":
+		lv := value.List()
+		clv := lv.(*_Inference_34_list)
+		x.AbandonedExecutors = *clv.list
+	case "inference.inference.Inference.original_prompt_hash":
+		x.OriginalPromptHash = value.Interface().(string)
+	case "inference.inference.Inference.response_payload_hash":
+		x.ResponsePayloadHash = value.Interface().(string)
+	case "inference.inference.Inference.payload_locator":
+		x.PayloadLocator = value.Interface().(string)
+	case "inference.inference.Inference.sub_key":
+		x.SubKey = value.Interface().(string)
+	case "inference.inference.Inference.sub_key_epoch":
+		x.SubKeyEpoch = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1295,6 +1465,12 @@ func (x *fastReflection_Inference) Mutable(fd protoreflect.FieldDescriptor) prot
 		}
 		value := &_Inference_23_list{list: &x.ValidatedBy}
 		return protoreflect.ValueOfList(value)
+	case "inference.inference.Inference.abandoned_executors":
+		if x.AbandonedExecutors == nil {
+			x.AbandonedExecutors = []string{}
+		}
+		value := &_Inference_34_list{list: &x.AbandonedExecutors}
+		return protoreflect.ValueOfList(value)
 	case "inference.inference.Inference.index":
 		panic(fmt.Errorf("field index of message inference.inference.Inference is not mutable"))
 	case "inference.inference.Inference.inference_id":
@@ -1355,6 +1531,18 @@ func (x *fastReflection_Inference) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field original_prompt of message inference.inference.Inference is not mutable"))
 	case "inference.inference.Inference.per_token_price":
 		panic(fmt.Errorf("field per_token_price of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.kind":
+		panic(fmt.Errorf("field kind of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.original_prompt_hash":
+		panic(fmt.Errorf("field original_prompt_hash of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.response_payload_hash":
+		panic(fmt.Errorf("field response_payload_hash of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.payload_locator":
+		panic(fmt.Errorf("field payload_locator of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.sub_key":
+		panic(fmt.Errorf("field sub_key of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.sub_key_epoch":
+		panic(fmt.Errorf("field sub_key_epoch of message inference.inference.Inference is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1434,6 +1622,21 @@ func (x *fastReflection_Inference) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfString("")
 	case "inference.inference.Inference.per_token_price":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.Inference.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.inference.Inference.abandoned_executors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Inference_34_list{list: &list})
+	case "inference.inference.Inference.original_prompt_hash":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.response_payload_hash":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.payload_locator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.sub_key":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.sub_key_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1618,6 +1821,34 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 		if x.PerTokenPrice != 0 {
 			n += 2 + runtime.Sov(uint64(x.PerTokenPrice))
 		}
+		if x.Kind != 0 {
+			n += 2 + runtime.Sov(uint64(x.Kind))
+		}
+		if len(x.AbandonedExecutors) > 0 {
+			for _, s := range x.AbandonedExecutors {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.OriginalPromptHash)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ResponsePayloadHash)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PayloadLocator)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubKey)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubKeyEpoch != 0 {
+			n += 2 + runtime.Sov(uint64(x.SubKeyEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1647,6 +1878,67 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SubKeyEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubKeyEpoch))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb8
+		}
+		if len(x.SubKey) > 0 {
+			i -= len(x.SubKey)
+			copy(dAtA[i:], x.SubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubKey)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.PayloadLocator) > 0 {
+			i -= len(x.PayloadLocator)
+			copy(dAtA[i:], x.PayloadLocator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayloadLocator)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.ResponsePayloadHash) > 0 {
+			i -= len(x.ResponsePayloadHash)
+			copy(dAtA[i:], x.ResponsePayloadHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ResponsePayloadHash)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.OriginalPromptHash) > 0 {
+			i -= len(x.OriginalPromptHash)
+			copy(dAtA[i:], x.OriginalPromptHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginalPromptHash)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.AbandonedExecutors) > 0 {
+			for iNdEx := len(x.AbandonedExecutors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AbandonedExecutors[iNdEx])
+				copy(dAtA[i:], x.AbandonedExecutors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbandonedExecutors[iNdEx])))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
 		if x.PerTokenPrice != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerTokenPrice))
 			i--
@@ -2766,6 +3058,204 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 33:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= InferenceKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbandonedExecutors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AbandonedExecutors = append(x.AbandonedExecutors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 35:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 36:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResponsePayloadHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResponsePayloadHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 37:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayloadLocator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayloadLocator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 38:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 39:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubKeyEpoch", wireType)
+				}
+				x.SubKeyEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubKeyEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2823,6 +3313,9 @@ const (
 	InferenceStatus_INVALIDATED InferenceStatus = 3
 	InferenceStatus_VOTING      InferenceStatus = 4
 	InferenceStatus_EXPIRED     InferenceStatus = 5
+	// The transfer agent couldn't reach the assigned executor and released the escrow.
+	// The inference can be started again with another executor.
+	InferenceStatus_ABANDONED InferenceStatus = 6
 )
 
 // Enum value maps for InferenceStatus.
@@ -2834,6 +3327,7 @@ var (
 		3: "INVALIDATED",
 		4: "VOTING",
 		5: "EXPIRED",
+		6: "ABANDONED",
 	}
 	InferenceStatus_value = map[string]int32{
 		"STARTED":     0,
@@ -2842,6 +3336,7 @@ var (
 		"INVALIDATED": 3,
 		"VOTING":      4,
 		"EXPIRED":     5,
+		"ABANDONED":   6,
 	}
 )
 
@@ -2872,6 +3367,56 @@ func (InferenceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_inference_inference_inference_proto_rawDescGZIP(), []int{0}
 }
 
+// InferenceKind identifies the OpenAI-compatible API an inference was served through.
+type InferenceKind int32
+
+const (
+	InferenceKind_CHAT_COMPLETION InferenceKind = 0
+	InferenceKind_TEXT_COMPLETION InferenceKind = 1
+	InferenceKind_EMBEDDING       InferenceKind = 2
+)
+
+// Enum value maps for InferenceKind.
+var (
+	InferenceKind_name = map[int32]string{
+		0: "CHAT_COMPLETION",
+		1: "TEXT_COMPLETION",
+		2: "EMBEDDING",
+	}
+	InferenceKind_value = map[string]int32{
+		"CHAT_COMPLETION": 0,
+		"TEXT_COMPLETION": 1,
+		"EMBEDDING":       2,
+	}
+)
+
+func (x InferenceKind) Enum() *InferenceKind {
+	p := new(InferenceKind)
+	*p = x
+	return p
+}
+
+func (x InferenceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InferenceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_inference_proto_enumTypes[1].Descriptor()
+}
+
+func (InferenceKind) Type() protoreflect.EnumType {
+	return &file_inference_inference_inference_proto_enumTypes[1]
+}
+
+func (x InferenceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InferenceKind.Descriptor instead.
+func (InferenceKind) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_inference_proto_rawDescGZIP(), []int{1}
+}
+
 type ProposalDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2960,6 +3505,20 @@ type Inference struct {
 	ExecutionSignature       string           `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
 	OriginalPrompt           string           `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
 	PerTokenPrice            uint64           `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"` // Locked-in per-token price when inference started (for dynamic pricing)
+	Kind                     InferenceKind    `protobuf:"varint,33,opt,name=kind,proto3,enum=inference.inference.InferenceKind" json:"kind,omitempty"`
+	// Executors the inference was assigned to and then abandoned by the transfer agent
+	AbandonedExecutors []string `protobuf:"bytes,34,rep,name=abandoned_executors,json=abandonedExecutors,proto3" json:"abandoned_executors,omitempty"`
+	// Set instead of original_prompt when payloads are kept off-chain. The developer, transfer
+	// agent and executor signatures are then made over this hash.
+	OriginalPromptHash string `protobuf:"bytes,35,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"`
+	// SHA-256 of the response payload kept off-chain, response_hash only covers the content
+	ResponsePayloadHash string `protobuf:"bytes,36,opt,name=response_payload_hash,json=responsePayloadHash,proto3" json:"response_payload_hash,omitempty"`
+	// Base URL of the executor's payload store, where payloads can be fetched by hash
+	PayloadLocator string `protobuf:"bytes,37,opt,name=payload_locator,json=payloadLocator,proto3" json:"payload_locator,omitempty"`
+	// Developer sub-key the request was signed with, its epoch spending is adjusted on refunds
+	SubKey string `protobuf:"bytes,38,opt,name=sub_key,json=subKey,proto3" json:"sub_key,omitempty"`
+	// Epoch whose sub-key spending the escrow was charged to, refunds are given back to it
+	SubKeyEpoch uint64 `protobuf:"varint,39,opt,name=sub_key_epoch,json=subKeyEpoch,proto3" json:"sub_key_epoch,omitempty"`
 }
 
 func (x *Inference) Reset() {
@@ -3206,6 +3765,55 @@ func (x *Inference) GetPerTokenPrice() uint64 {
 	return 0
 }
 
+func (x *Inference) GetKind() InferenceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return InferenceKind_CHAT_COMPLETION
+}
+
+func (x *Inference) GetAbandonedExecutors() []string {
+	if x != nil {
+		return x.AbandonedExecutors
+	}
+	return nil
+}
+
+func (x *Inference) GetOriginalPromptHash() string {
+	if x != nil {
+		return x.OriginalPromptHash
+	}
+	return ""
+}
+
+func (x *Inference) GetResponsePayloadHash() string {
+	if x != nil {
+		return x.ResponsePayloadHash
+	}
+	return ""
+}
+
+func (x *Inference) GetPayloadLocator() string {
+	if x != nil {
+		return x.PayloadLocator
+	}
+	return ""
+}
+
+func (x *Inference) GetSubKey() string {
+	if x != nil {
+		return x.SubKey
+	}
+	return ""
+}
+
+func (x *Inference) GetSubKeyEpoch() uint64 {
+	if x != nil {
+		return x.SubKeyEpoch
+	}
+	return 0
+}
+
 var File_inference_inference_inference_proto protoreflect.FileDescriptor
 
 var file_inference_inference_inference_proto_rawDesc = []byte{
@@ -3222,7 +3830,7 @@ var file_inference_inference_inference_proto_rawDesc = []byte{
 	0x12, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
 	0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb7, 0x0a, 0x0a, 0x09, 0x49,
+	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x0c, 0x0a, 0x09, 0x49,
 	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
 	0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
@@ -3306,26 +3914,50 @@ var file_inference_inference_inference_proto_rawDesc = []byte{
 	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0f,
 	0x70, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
 	0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x2a, 0x65, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0b,
-	0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x42, 0xbc, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2,
-	0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
-	0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x13,
+	0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x32, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x2a, 0x74, 0x0a, 0x0f, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e,
+	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x2a,
+	0x48, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x4d,
+	0x42, 0x45, 0x44, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x42, 0xbc, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03,
+	0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2,
+	0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3340,21 +3972,23 @@ func file_inference_inference_inference_proto_rawDescGZIP() []byte {
 	return file_inference_inference_inference_proto_rawDescData
 }
 
-var file_inference_inference_inference_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_inference_inference_inference_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_inference_inference_inference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_inference_inference_inference_proto_goTypes = []interface{}{
 	(InferenceStatus)(0),    // 0: inference.inference.InferenceStatus
-	(*ProposalDetails)(nil), // 1: inference.inference.ProposalDetails
-	(*Inference)(nil),       // 2: inference.inference.Inference
+	(InferenceKind)(0),      // 1: inference.inference.InferenceKind
+	(*ProposalDetails)(nil), // 2: inference.inference.ProposalDetails
+	(*Inference)(nil),       // 3: inference.inference.Inference
 }
 var file_inference_inference_inference_proto_depIdxs = []int32{
 	0, // 0: inference.inference.Inference.status:type_name -> inference.inference.InferenceStatus
-	1, // 1: inference.inference.Inference.proposal_details:type_name -> inference.inference.ProposalDetails
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: inference.inference.Inference.proposal_details:type_name -> inference.inference.ProposalDetails
+	1, // 2: inference.inference.Inference.kind:type_name -> inference.inference.InferenceKind
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_inference_proto_init() }
@@ -3393,7 +4027,7 @@ func file_inference_inference_inference_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_inference_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
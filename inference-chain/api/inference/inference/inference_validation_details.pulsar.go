@@ -2,16 +2,69 @@
 package inference
 
 import (
+	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	math "math"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_InferenceValidationDetails_10_list)(nil)
+
+type _InferenceValidationDetails_10_list struct {
+	list *[]*ValidationDiagnostics
+}
+
+func (x *_InferenceValidationDetails_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InferenceValidationDetails_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InferenceValidationDetails_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidationDiagnostics)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InferenceValidationDetails_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidationDiagnostics)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InferenceValidationDetails_10_list) AppendMutable() protoreflect.Value {
+	v := new(ValidationDiagnostics)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InferenceValidationDetails_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InferenceValidationDetails_10_list) NewElement() protoreflect.Value {
+	v := new(ValidationDiagnostics)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InferenceValidationDetails_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_InferenceValidationDetails                         protoreflect.MessageDescriptor
 	fd_InferenceValidationDetails_epoch_id                protoreflect.FieldDescriptor
@@ -23,6 +76,7 @@ var (
 	fd_InferenceValidationDetails_model                   protoreflect.FieldDescriptor
 	fd_InferenceValidationDetails_total_power             protoreflect.FieldDescriptor
 	fd_InferenceValidationDetails_created_at_block_height protoreflect.FieldDescriptor
+	fd_InferenceValidationDetails_validations             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +91,7 @@ func init() {
 	fd_InferenceValidationDetails_model = md_InferenceValidationDetails.Fields().ByName("model")
 	fd_InferenceValidationDetails_total_power = md_InferenceValidationDetails.Fields().ByName("total_power")
 	fd_InferenceValidationDetails_created_at_block_height = md_InferenceValidationDetails.Fields().ByName("created_at_block_height")
+	fd_InferenceValidationDetails_validations = md_InferenceValidationDetails.Fields().ByName("validations")
 }
 
 var _ protoreflect.Message = (*fastReflection_InferenceValidationDetails)(nil)
@@ -158,6 +213,12 @@ func (x *fastReflection_InferenceValidationDetails) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if len(x.Validations) != 0 {
+		value := protoreflect.ValueOfList(&_InferenceValidationDetails_10_list{list: &x.Validations})
+		if !f(fd_InferenceValidationDetails_validations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -191,6 +252,8 @@ func (x *fastReflection_InferenceValidationDetails) Has(fd protoreflect.FieldDes
 		return x.TotalPower != uint64(0)
 	case "inference.inference.InferenceValidationDetails.created_at_block_height":
 		return x.CreatedAtBlockHeight != int64(0)
+	case "inference.inference.InferenceValidationDetails.validations":
+		return len(x.Validations) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceValidationDetails"))
@@ -225,6 +288,8 @@ func (x *fastReflection_InferenceValidationDetails) Clear(fd protoreflect.FieldD
 		x.TotalPower = uint64(0)
 	case "inference.inference.InferenceValidationDetails.created_at_block_height":
 		x.CreatedAtBlockHeight = int64(0)
+	case "inference.inference.InferenceValidationDetails.validations":
+		x.Validations = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceValidationDetails"))
@@ -268,6 +333,12 @@ func (x *fastReflection_InferenceValidationDetails) Get(descriptor protoreflect.
 	case "inference.inference.InferenceValidationDetails.created_at_block_height":
 		value := x.CreatedAtBlockHeight
 		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceValidationDetails.validations":
+		if len(x.Validations) == 0 {
+			return protoreflect.ValueOfList(&_InferenceValidationDetails_10_list{})
+		}
+		listValue := &_InferenceValidationDetails_10_list{list: &x.Validations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceValidationDetails"))
@@ -306,6 +377,10 @@ func (x *fastReflection_InferenceValidationDetails) Set(fd protoreflect.FieldDes
 		x.TotalPower = value.Uint()
 	case "inference.inference.InferenceValidationDetails.created_at_block_height":
 		x.CreatedAtBlockHeight = value.Int()
+	case "inference.inference.InferenceValidationDetails.validations":
+		lv := value.List()
+		clv := lv.(*_InferenceValidationDetails_10_list)
+		x.Validations = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceValidationDetails"))
@@ -326,6 +401,12 @@ func (x *fastReflection_InferenceValidationDetails) Set(fd protoreflect.FieldDes
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_InferenceValidationDetails) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "inference.inference.InferenceValidationDetails.validations":
+		if x.Validations == nil {
+			x.Validations = []*ValidationDiagnostics{}
+		}
+		value := &_InferenceValidationDetails_10_list{list: &x.Validations}
+		return protoreflect.ValueOfList(value)
 	case "inference.inference.InferenceValidationDetails.epoch_id":
 		panic(fmt.Errorf("field epoch_id of message inference.inference.InferenceValidationDetails is not mutable"))
 	case "inference.inference.InferenceValidationDetails.inference_id":
@@ -375,6 +456,9 @@ func (x *fastReflection_InferenceValidationDetails) NewField(fd protoreflect.Fie
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "inference.inference.InferenceValidationDetails.created_at_block_height":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceValidationDetails.validations":
+		list := []*ValidationDiagnostics{}
+		return protoreflect.ValueOfList(&_InferenceValidationDetails_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceValidationDetails"))
@@ -474,6 +558,12 @@ func (x *fastReflection_InferenceValidationDetails) ProtoMethods() *protoiface.M
 		if x.CreatedAtBlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.CreatedAtBlockHeight))
 		}
+		if len(x.Validations) > 0 {
+			for _, e := range x.Validations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -503,6 +593,22 @@ func (x *fastReflection_InferenceValidationDetails) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Validations) > 0 {
+			for iNdEx := len(x.Validations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
 		if x.CreatedAtBlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAtBlockHeight))
 			i--
@@ -813,6 +919,40 @@ func (x *fastReflection_InferenceValidationDetails) ProtoMethods() *protoiface.M
 						break
 					}
 				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validations = append(x.Validations, &ValidationDiagnostics{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validations[len(x.Validations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -848,162 +988,1203 @@ func (x *fastReflection_InferenceValidationDetails) ProtoMethods() *protoiface.M
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: inference/inference/inference_validation_details.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_ValidationDiagnostics                       protoreflect.MessageDescriptor
+	fd_ValidationDiagnostics_validator             protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_strategy              protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_value                 protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_passed                protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_compared_tokens       protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_mismatched_tokens     protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_max_position_distance protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_reason                protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_report_hash           protoreflect.FieldDescriptor
+	fd_ValidationDiagnostics_report_locator        protoreflect.FieldDescriptor
 )
 
-type InferenceValidationDetails struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_inference_inference_inference_validation_details_proto_init()
+	md_ValidationDiagnostics = File_inference_inference_inference_validation_details_proto.Messages().ByName("ValidationDiagnostics")
+	fd_ValidationDiagnostics_validator = md_ValidationDiagnostics.Fields().ByName("validator")
+	fd_ValidationDiagnostics_strategy = md_ValidationDiagnostics.Fields().ByName("strategy")
+	fd_ValidationDiagnostics_value = md_ValidationDiagnostics.Fields().ByName("value")
+	fd_ValidationDiagnostics_passed = md_ValidationDiagnostics.Fields().ByName("passed")
+	fd_ValidationDiagnostics_compared_tokens = md_ValidationDiagnostics.Fields().ByName("compared_tokens")
+	fd_ValidationDiagnostics_mismatched_tokens = md_ValidationDiagnostics.Fields().ByName("mismatched_tokens")
+	fd_ValidationDiagnostics_max_position_distance = md_ValidationDiagnostics.Fields().ByName("max_position_distance")
+	fd_ValidationDiagnostics_reason = md_ValidationDiagnostics.Fields().ByName("reason")
+	fd_ValidationDiagnostics_report_hash = md_ValidationDiagnostics.Fields().ByName("report_hash")
+	fd_ValidationDiagnostics_report_locator = md_ValidationDiagnostics.Fields().ByName("report_locator")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidationDiagnostics)(nil)
 
-	EpochId              uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"` // The ID of the epoch!
-	InferenceId          string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
-	ExecutorId           string `protobuf:"bytes,3,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
-	ExecutorReputation   int32  `protobuf:"varint,4,opt,name=executor_reputation,json=executorReputation,proto3" json:"executor_reputation,omitempty"`
-	TrafficBasis         uint64 `protobuf:"varint,5,opt,name=traffic_basis,json=trafficBasis,proto3" json:"traffic_basis,omitempty"`
-	ExecutorPower        uint64 `protobuf:"varint,6,opt,name=executor_power,json=executorPower,proto3" json:"executor_power,omitempty"`
-	Model                string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
-	TotalPower           uint64 `protobuf:"varint,8,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
-	CreatedAtBlockHeight int64  `protobuf:"varint,9,opt,name=created_at_block_height,json=createdAtBlockHeight,proto3" json:"created_at_block_height,omitempty"` // Should be the same as block_finished for the inference
+type fastReflection_ValidationDiagnostics ValidationDiagnostics
+
+func (x *ValidationDiagnostics) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidationDiagnostics)(x)
 }
 
-func (x *InferenceValidationDetails) Reset() {
-	*x = InferenceValidationDetails{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_inference_validation_details_proto_msgTypes[0]
+func (x *ValidationDiagnostics) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_inference_validation_details_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *InferenceValidationDetails) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_ValidationDiagnostics_messageType fastReflection_ValidationDiagnostics_messageType
+var _ protoreflect.MessageType = fastReflection_ValidationDiagnostics_messageType{}
 
-func (*InferenceValidationDetails) ProtoMessage() {}
+type fastReflection_ValidationDiagnostics_messageType struct{}
 
-// Deprecated: Use InferenceValidationDetails.ProtoReflect.Descriptor instead.
-func (*InferenceValidationDetails) Descriptor() ([]byte, []int) {
-	return file_inference_inference_inference_validation_details_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_ValidationDiagnostics_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidationDiagnostics)(nil)
+}
+func (x fastReflection_ValidationDiagnostics_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidationDiagnostics)
+}
+func (x fastReflection_ValidationDiagnostics_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidationDiagnostics
 }
 
-func (x *InferenceValidationDetails) GetEpochId() uint64 {
-	if x != nil {
-		return x.EpochId
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidationDiagnostics) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidationDiagnostics
 }
 
-func (x *InferenceValidationDetails) GetInferenceId() string {
-	if x != nil {
-		return x.InferenceId
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidationDiagnostics) Type() protoreflect.MessageType {
+	return _fastReflection_ValidationDiagnostics_messageType
 }
 
-func (x *InferenceValidationDetails) GetExecutorId() string {
-	if x != nil {
-		return x.ExecutorId
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidationDiagnostics) New() protoreflect.Message {
+	return new(fastReflection_ValidationDiagnostics)
 }
 
-func (x *InferenceValidationDetails) GetExecutorReputation() int32 {
-	if x != nil {
-		return x.ExecutorReputation
-	}
-	return 0
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidationDiagnostics) Interface() protoreflect.ProtoMessage {
+	return (*ValidationDiagnostics)(x)
 }
 
-func (x *InferenceValidationDetails) GetTrafficBasis() uint64 {
-	if x != nil {
-		return x.TrafficBasis
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidationDiagnostics) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidationDiagnostics_validator, value) {
+			return
+		}
+	}
+	if x.Strategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Strategy))
+		if !f(fd_ValidationDiagnostics_strategy, value) {
+			return
+		}
+	}
+	if x.Value != float64(0) || math.Signbit(x.Value) {
+		value := protoreflect.ValueOfFloat64(x.Value)
+		if !f(fd_ValidationDiagnostics_value, value) {
+			return
+		}
+	}
+	if x.Passed != false {
+		value := protoreflect.ValueOfBool(x.Passed)
+		if !f(fd_ValidationDiagnostics_passed, value) {
+			return
+		}
+	}
+	if x.ComparedTokens != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ComparedTokens)
+		if !f(fd_ValidationDiagnostics_compared_tokens, value) {
+			return
+		}
+	}
+	if x.MismatchedTokens != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MismatchedTokens)
+		if !f(fd_ValidationDiagnostics_mismatched_tokens, value) {
+			return
+		}
+	}
+	if x.MaxPositionDistance != float64(0) || math.Signbit(x.MaxPositionDistance) {
+		value := protoreflect.ValueOfFloat64(x.MaxPositionDistance)
+		if !f(fd_ValidationDiagnostics_max_position_distance, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ValidationDiagnostics_reason, value) {
+			return
+		}
+	}
+	if x.ReportHash != "" {
+		value := protoreflect.ValueOfString(x.ReportHash)
+		if !f(fd_ValidationDiagnostics_report_hash, value) {
+			return
+		}
+	}
+	if x.ReportLocator != "" {
+		value := protoreflect.ValueOfString(x.ReportLocator)
+		if !f(fd_ValidationDiagnostics_report_locator, value) {
+			return
+		}
 	}
-	return 0
 }
 
-func (x *InferenceValidationDetails) GetExecutorPower() uint64 {
-	if x != nil {
-		return x.ExecutorPower
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidationDiagnostics) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ValidationDiagnostics.validator":
+		return x.Validator != ""
+	case "inference.inference.ValidationDiagnostics.strategy":
+		return x.Strategy != 0
+	case "inference.inference.ValidationDiagnostics.value":
+		return x.Value != float64(0) || math.Signbit(x.Value)
+	case "inference.inference.ValidationDiagnostics.passed":
+		return x.Passed != false
+	case "inference.inference.ValidationDiagnostics.compared_tokens":
+		return x.ComparedTokens != uint32(0)
+	case "inference.inference.ValidationDiagnostics.mismatched_tokens":
+		return x.MismatchedTokens != uint32(0)
+	case "inference.inference.ValidationDiagnostics.max_position_distance":
+		return x.MaxPositionDistance != float64(0) || math.Signbit(x.MaxPositionDistance)
+	case "inference.inference.ValidationDiagnostics.reason":
+		return x.Reason != ""
+	case "inference.inference.ValidationDiagnostics.report_hash":
+		return x.ReportHash != ""
+	case "inference.inference.ValidationDiagnostics.report_locator":
+		return x.ReportLocator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidationDiagnostics"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidationDiagnostics does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-func (x *InferenceValidationDetails) GetModel() string {
-	if x != nil {
-		return x.Model
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidationDiagnostics) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ValidationDiagnostics.validator":
+		x.Validator = ""
+	case "inference.inference.ValidationDiagnostics.strategy":
+		x.Strategy = 0
+	case "inference.inference.ValidationDiagnostics.value":
+		x.Value = float64(0)
+	case "inference.inference.ValidationDiagnostics.passed":
+		x.Passed = false
+	case "inference.inference.ValidationDiagnostics.compared_tokens":
+		x.ComparedTokens = uint32(0)
+	case "inference.inference.ValidationDiagnostics.mismatched_tokens":
+		x.MismatchedTokens = uint32(0)
+	case "inference.inference.ValidationDiagnostics.max_position_distance":
+		x.MaxPositionDistance = float64(0)
+	case "inference.inference.ValidationDiagnostics.reason":
+		x.Reason = ""
+	case "inference.inference.ValidationDiagnostics.report_hash":
+		x.ReportHash = ""
+	case "inference.inference.ValidationDiagnostics.report_locator":
+		x.ReportLocator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidationDiagnostics"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidationDiagnostics does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *InferenceValidationDetails) GetTotalPower() uint64 {
-	if x != nil {
-		return x.TotalPower
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidationDiagnostics) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ValidationDiagnostics.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ValidationDiagnostics.strategy":
+		value := x.Strategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.inference.ValidationDiagnostics.value":
+		value := x.Value
+		return protoreflect.ValueOfFloat64(value)
+	case "inference.inference.ValidationDiagnostics.passed":
+		value := x.Passed
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.ValidationDiagnostics.compared_tokens":
+		value := x.ComparedTokens
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.ValidationDiagnostics.mismatched_tokens":
+		value := x.MismatchedTokens
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.ValidationDiagnostics.max_position_distance":
+		value := x.MaxPositionDistance
+		return protoreflect.ValueOfFloat64(value)
+	case "inference.inference.ValidationDiagnostics.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ValidationDiagnostics.report_hash":
+		value := x.ReportHash
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ValidationDiagnostics.report_locator":
+		value := x.ReportLocator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidationDiagnostics"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidationDiagnostics does not contain field %s", descriptor.FullName()))
 	}
-	return 0
 }
 
-func (x *InferenceValidationDetails) GetCreatedAtBlockHeight() int64 {
-	if x != nil {
-		return x.CreatedAtBlockHeight
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidationDiagnostics) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ValidationDiagnostics.validator":
+		x.Validator = value.Interface().(string)
+	case "inference.inference.ValidationDiagnostics.strategy":
+		x.Strategy = (ValidationStrategy)(value.Enum())
+	case "inference.inference.ValidationDiagnostics.value":
+		x.Value = value.Float()
+	case "inference.inference.ValidationDiagnostics.passed":
+		x.Passed = value.Bool()
+	case "inference.inference.ValidationDiagnostics.compared_tokens":
+		x.ComparedTokens = uint32(value.Uint())
+	case "inference.inference.ValidationDiagnostics.mismatched_tokens":
+		x.MismatchedTokens = uint32(value.Uint())
+	case "inference.inference.ValidationDiagnostics.max_position_distance":
+		x.MaxPositionDistance = value.Float()
+	case "inference.inference.ValidationDiagnostics.reason":
+		x.Reason = value.Interface().(string)
+	case "inference.inference.ValidationDiagnostics.report_hash":
+		x.ReportHash = value.Interface().(string)
+	case "inference.inference.ValidationDiagnostics.report_locator":
+		x.ReportLocator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidationDiagnostics"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidationDiagnostics does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-var File_inference_inference_inference_validation_details_proto protoreflect.FileDescriptor
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidationDiagnostics) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ValidationDiagnostics.validator":
+		panic(fmt.Errorf("field validator of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.strategy":
+		panic(fmt.Errorf("field strategy of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.value":
+		panic(fmt.Errorf("field value of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.passed":
+		panic(fmt.Errorf("field passed of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.compared_tokens":
+		panic(fmt.Errorf("field compared_tokens of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.mismatched_tokens":
+		panic(fmt.Errorf("field mismatched_tokens of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.max_position_distance":
+		panic(fmt.Errorf("field max_position_distance of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.reason":
+		panic(fmt.Errorf("field reason of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.report_hash":
+		panic(fmt.Errorf("field report_hash of message inference.inference.ValidationDiagnostics is not mutable"))
+	case "inference.inference.ValidationDiagnostics.report_locator":
+		panic(fmt.Errorf("field report_locator of message inference.inference.ValidationDiagnostics is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidationDiagnostics"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidationDiagnostics does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_inference_inference_inference_validation_details_proto_rawDesc = []byte{
-	0x0a, 0x36, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xe6, 0x02,
-	0x0a, 0x1a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x42, 0x61, 0x73, 0x69,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
-	0x35, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xcd, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69,
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidationDiagnostics) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ValidationDiagnostics.validator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ValidationDiagnostics.strategy":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.inference.ValidationDiagnostics.value":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "inference.inference.ValidationDiagnostics.passed":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.ValidationDiagnostics.compared_tokens":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.ValidationDiagnostics.mismatched_tokens":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.ValidationDiagnostics.max_position_distance":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "inference.inference.ValidationDiagnostics.reason":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ValidationDiagnostics.report_hash":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ValidationDiagnostics.report_locator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidationDiagnostics"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidationDiagnostics does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidationDiagnostics) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ValidationDiagnostics", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidationDiagnostics) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidationDiagnostics) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidationDiagnostics) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidationDiagnostics) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidationDiagnostics)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Strategy != 0 {
+			n += 1 + runtime.Sov(uint64(x.Strategy))
+		}
+		if x.Value != 0 || math.Signbit(x.Value) {
+			n += 9
+		}
+		if x.Passed {
+			n += 2
+		}
+		if x.ComparedTokens != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComparedTokens))
+		}
+		if x.MismatchedTokens != 0 {
+			n += 1 + runtime.Sov(uint64(x.MismatchedTokens))
+		}
+		if x.MaxPositionDistance != 0 || math.Signbit(x.MaxPositionDistance) {
+			n += 9
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReportHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReportLocator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidationDiagnostics)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReportLocator) > 0 {
+			i -= len(x.ReportLocator)
+			copy(dAtA[i:], x.ReportLocator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportLocator)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ReportHash) > 0 {
+			i -= len(x.ReportHash)
+			copy(dAtA[i:], x.ReportHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportHash)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MaxPositionDistance != 0 || math.Signbit(x.MaxPositionDistance) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.MaxPositionDistance))))
+			i--
+			dAtA[i] = 0x39
+		}
+		if x.MismatchedTokens != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MismatchedTokens))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ComparedTokens != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComparedTokens))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Passed {
+			i--
+			if x.Passed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Value != 0 || math.Signbit(x.Value) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.Value))))
+			i--
+			dAtA[i] = 0x19
+		}
+		if x.Strategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Strategy))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidationDiagnostics)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidationDiagnostics: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidationDiagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				}
+				x.Strategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Strategy |= ValidationStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Value = float64(math.Float64frombits(v))
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Passed = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComparedTokens", wireType)
+				}
+				x.ComparedTokens = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComparedTokens |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MismatchedTokens", wireType)
+				}
+				x.MismatchedTokens = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MismatchedTokens |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPositionDistance", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.MaxPositionDistance = float64(math.Float64frombits(v))
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportLocator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportLocator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/inference_validation_details.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InferenceValidationDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochId              uint64                   `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"` // The ID of the epoch!
+	InferenceId          string                   `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	ExecutorId           string                   `protobuf:"bytes,3,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	ExecutorReputation   int32                    `protobuf:"varint,4,opt,name=executor_reputation,json=executorReputation,proto3" json:"executor_reputation,omitempty"`
+	TrafficBasis         uint64                   `protobuf:"varint,5,opt,name=traffic_basis,json=trafficBasis,proto3" json:"traffic_basis,omitempty"`
+	ExecutorPower        uint64                   `protobuf:"varint,6,opt,name=executor_power,json=executorPower,proto3" json:"executor_power,omitempty"`
+	Model                string                   `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	TotalPower           uint64                   `protobuf:"varint,8,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	CreatedAtBlockHeight int64                    `protobuf:"varint,9,opt,name=created_at_block_height,json=createdAtBlockHeight,proto3" json:"created_at_block_height,omitempty"` // Should be the same as block_finished for the inference
+	Validations          []*ValidationDiagnostics `protobuf:"bytes,10,rep,name=validations,proto3" json:"validations,omitempty"`
+}
+
+func (x *InferenceValidationDetails) Reset() {
+	*x = InferenceValidationDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_validation_details_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InferenceValidationDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferenceValidationDetails) ProtoMessage() {}
+
+// Deprecated: Use InferenceValidationDetails.ProtoReflect.Descriptor instead.
+func (*InferenceValidationDetails) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_validation_details_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InferenceValidationDetails) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *InferenceValidationDetails) GetInferenceId() string {
+	if x != nil {
+		return x.InferenceId
+	}
+	return ""
+}
+
+func (x *InferenceValidationDetails) GetExecutorId() string {
+	if x != nil {
+		return x.ExecutorId
+	}
+	return ""
+}
+
+func (x *InferenceValidationDetails) GetExecutorReputation() int32 {
+	if x != nil {
+		return x.ExecutorReputation
+	}
+	return 0
+}
+
+func (x *InferenceValidationDetails) GetTrafficBasis() uint64 {
+	if x != nil {
+		return x.TrafficBasis
+	}
+	return 0
+}
+
+func (x *InferenceValidationDetails) GetExecutorPower() uint64 {
+	if x != nil {
+		return x.ExecutorPower
+	}
+	return 0
+}
+
+func (x *InferenceValidationDetails) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *InferenceValidationDetails) GetTotalPower() uint64 {
+	if x != nil {
+		return x.TotalPower
+	}
+	return 0
+}
+
+func (x *InferenceValidationDetails) GetCreatedAtBlockHeight() int64 {
+	if x != nil {
+		return x.CreatedAtBlockHeight
+	}
+	return 0
+}
+
+func (x *InferenceValidationDetails) GetValidations() []*ValidationDiagnostics {
+	if x != nil {
+		return x.Validations
+	}
+	return nil
+}
+
+// ValidationDiagnostics summarizes how a validator reached its verdict on an inference.
+type ValidationDiagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator           string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Strategy            ValidationStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=inference.inference.ValidationStrategy" json:"strategy,omitempty"`
+	Value               float64            `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Passed              bool               `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"` // set by the chain against the model's validation_threshold
+	ComparedTokens      uint32             `protobuf:"varint,5,opt,name=compared_tokens,json=comparedTokens,proto3" json:"compared_tokens,omitempty"`
+	MismatchedTokens    uint32             `protobuf:"varint,6,opt,name=mismatched_tokens,json=mismatchedTokens,proto3" json:"mismatched_tokens,omitempty"`             // positions where the tokens (or for EXACT_TOKEN_MATCH the top tokens) differ
+	MaxPositionDistance float64            `protobuf:"fixed64,7,opt,name=max_position_distance,json=maxPositionDistance,proto3" json:"max_position_distance,omitempty"` // worst single position, in the units of the strategy
+	Reason              string             `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`                                                          // e.g. different_length, different_tokens
+	ReportHash          string             `protobuf:"bytes,9,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`                                // sha256 of the validator's per-position report, kept off-chain
+	ReportLocator       string             `protobuf:"bytes,10,opt,name=report_locator,json=reportLocator,proto3" json:"report_locator,omitempty"`                      // where the report can be fetched by its hash
+}
+
+func (x *ValidationDiagnostics) Reset() {
+	*x = ValidationDiagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_validation_details_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationDiagnostics) ProtoMessage() {}
+
+// Deprecated: Use ValidationDiagnostics.ProtoReflect.Descriptor instead.
+func (*ValidationDiagnostics) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_validation_details_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidationDiagnostics) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidationDiagnostics) GetStrategy() ValidationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ValidationStrategy_DEFAULT_VALIDATION
+}
+
+func (x *ValidationDiagnostics) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ValidationDiagnostics) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ValidationDiagnostics) GetComparedTokens() uint32 {
+	if x != nil {
+		return x.ComparedTokens
+	}
+	return 0
+}
+
+func (x *ValidationDiagnostics) GetMismatchedTokens() uint32 {
+	if x != nil {
+		return x.MismatchedTokens
+	}
+	return 0
+}
+
+func (x *ValidationDiagnostics) GetMaxPositionDistance() float64 {
+	if x != nil {
+		return x.MaxPositionDistance
+	}
+	return 0
+}
+
+func (x *ValidationDiagnostics) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ValidationDiagnostics) GetReportHash() string {
+	if x != nil {
+		return x.ReportHash
+	}
+	return ""
+}
+
+func (x *ValidationDiagnostics) GetReportLocator() string {
+	if x != nil {
+		return x.ReportLocator
+	}
+	return ""
+}
+
+var File_inference_inference_inference_validation_details_proto protoreflect.FileDescriptor
+
+var file_inference_inference_inference_validation_details_proto_rawDesc = []byte{
+	0x0a, 0x36, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x1f, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4,
+	0x03, 0x0a, 0x1a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x42, 0x61, 0x73,
+	0x69, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4c, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69,
 	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x42, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49,
-	0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f,
-	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x92, 0x03, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0xcd, 0x01, 0x0a, 0x17, 0x63,
+	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2,
+	0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
+	0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1018,16 +2199,20 @@ func file_inference_inference_inference_validation_details_proto_rawDescGZIP() [
 	return file_inference_inference_inference_validation_details_proto_rawDescData
 }
 
-var file_inference_inference_inference_validation_details_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_inference_inference_inference_validation_details_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_inference_inference_inference_validation_details_proto_goTypes = []interface{}{
 	(*InferenceValidationDetails)(nil), // 0: inference.inference.InferenceValidationDetails
+	(*ValidationDiagnostics)(nil),      // 1: inference.inference.ValidationDiagnostics
+	(ValidationStrategy)(0),            // 2: inference.inference.ValidationStrategy
 }
 var file_inference_inference_inference_validation_details_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: inference.inference.InferenceValidationDetails.validations:type_name -> inference.inference.ValidationDiagnostics
+	2, // 1: inference.inference.ValidationDiagnostics.strategy:type_name -> inference.inference.ValidationStrategy
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_inference_validation_details_proto_init() }
@@ -1035,6 +2220,7 @@ func file_inference_inference_inference_validation_details_proto_init() {
 	if File_inference_inference_inference_validation_details_proto != nil {
 		return
 	}
+	file_inference_inference_model_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_inference_inference_inference_validation_details_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InferenceValidationDetails); i {
@@ -1048,6 +2234,18 @@ func file_inference_inference_inference_validation_details_proto_init() {
 				return nil
 			}
 		}
+		file_inference_inference_inference_validation_details_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationDiagnostics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1055,7 +2253,7 @@ func file_inference_inference_inference_validation_details_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_inference_validation_details_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
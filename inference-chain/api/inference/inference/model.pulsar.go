@@ -73,6 +73,7 @@ var (
 	fd_Model_v_ram                      protoreflect.FieldDescriptor
 	fd_Model_throughput_per_nonce       protoreflect.FieldDescriptor
 	fd_Model_validation_threshold       protoreflect.FieldDescriptor
+	fd_Model_validation_strategy        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -91,6 +92,7 @@ func init() {
 	fd_Model_v_ram = md_Model.Fields().ByName("v_ram")
 	fd_Model_throughput_per_nonce = md_Model.Fields().ByName("throughput_per_nonce")
 	fd_Model_validation_threshold = md_Model.Fields().ByName("validation_threshold")
+	fd_Model_validation_strategy = md_Model.Fields().ByName("validation_strategy")
 }
 
 var _ protoreflect.Message = (*fastReflection_Model)(nil)
@@ -236,6 +238,12 @@ func (x *fastReflection_Model) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.ValidationStrategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ValidationStrategy))
+		if !f(fd_Model_validation_strategy, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -277,6 +285,8 @@ func (x *fastReflection_Model) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ThroughputPerNonce != uint64(0)
 	case "inference.inference.Model.validation_threshold":
 		return x.ValidationThreshold != nil
+	case "inference.inference.Model.validation_strategy":
+		return x.ValidationStrategy != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Model"))
@@ -319,6 +329,8 @@ func (x *fastReflection_Model) Clear(fd protoreflect.FieldDescriptor) {
 		x.ThroughputPerNonce = uint64(0)
 	case "inference.inference.Model.validation_threshold":
 		x.ValidationThreshold = nil
+	case "inference.inference.Model.validation_strategy":
+		x.ValidationStrategy = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Model"))
@@ -377,6 +389,9 @@ func (x *fastReflection_Model) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "inference.inference.Model.validation_threshold":
 		value := x.ValidationThreshold
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.Model.validation_strategy":
+		value := x.ValidationStrategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Model"))
@@ -425,6 +440,8 @@ func (x *fastReflection_Model) Set(fd protoreflect.FieldDescriptor, value protor
 		x.ThroughputPerNonce = value.Uint()
 	case "inference.inference.Model.validation_threshold":
 		x.ValidationThreshold = value.Message().Interface().(*Decimal)
+	case "inference.inference.Model.validation_strategy":
+		x.ValidationStrategy = (ValidationStrategy)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Model"))
@@ -478,6 +495,8 @@ func (x *fastReflection_Model) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field v_ram of message inference.inference.Model is not mutable"))
 	case "inference.inference.Model.throughput_per_nonce":
 		panic(fmt.Errorf("field throughput_per_nonce of message inference.inference.Model is not mutable"))
+	case "inference.inference.Model.validation_strategy":
+		panic(fmt.Errorf("field validation_strategy of message inference.inference.Model is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Model"))
@@ -519,6 +538,8 @@ func (x *fastReflection_Model) NewField(fd protoreflect.FieldDescriptor) protore
 	case "inference.inference.Model.validation_threshold":
 		m := new(Decimal)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.Model.validation_strategy":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Model"))
@@ -636,6 +657,9 @@ func (x *fastReflection_Model) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.ValidationThreshold)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ValidationStrategy != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationStrategy))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -665,6 +689,11 @@ func (x *fastReflection_Model) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ValidationStrategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationStrategy))
+			i--
+			dAtA[i] = 0x70
+		}
 		if x.ValidationThreshold != nil {
 			encoded, err := options.Marshal(x.ValidationThreshold)
 			if err != nil {
@@ -1144,6 +1173,25 @@ func (x *fastReflection_Model) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationStrategy", wireType)
+				}
+				x.ValidationStrategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationStrategy |= ValidationStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1192,24 +1240,82 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ValidationStrategy decides how a validator compares its rerun of an inference with the original.
+// Every strategy yields a similarity in [0, 1] that is checked against the model's validation_threshold.
+type ValidationStrategy int32
+
+const (
+	ValidationStrategy_DEFAULT_VALIDATION ValidationStrategy = 0 // logprob similarity for completions, embedding cosine for embeddings
+	ValidationStrategy_LOGPROB_SIMILARITY ValidationStrategy = 1 // distance between the top logprobs at each position
+	ValidationStrategy_EXACT_TOKEN_MATCH  ValidationStrategy = 2 // share of positions where the rerun's most likely token is the original one
+	ValidationStrategy_JS_DIVERGENCE      ValidationStrategy = 3 // one minus the mean Jensen-Shannon divergence of the top logprob distributions
+	ValidationStrategy_EMBEDDING_COSINE   ValidationStrategy = 4 // lowest cosine similarity between matching embedding vectors
+)
+
+// Enum value maps for ValidationStrategy.
+var (
+	ValidationStrategy_name = map[int32]string{
+		0: "DEFAULT_VALIDATION",
+		1: "LOGPROB_SIMILARITY",
+		2: "EXACT_TOKEN_MATCH",
+		3: "JS_DIVERGENCE",
+		4: "EMBEDDING_COSINE",
+	}
+	ValidationStrategy_value = map[string]int32{
+		"DEFAULT_VALIDATION": 0,
+		"LOGPROB_SIMILARITY": 1,
+		"EXACT_TOKEN_MATCH":  2,
+		"JS_DIVERGENCE":      3,
+		"EMBEDDING_COSINE":   4,
+	}
+)
+
+func (x ValidationStrategy) Enum() *ValidationStrategy {
+	p := new(ValidationStrategy)
+	*p = x
+	return p
+}
+
+func (x ValidationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_model_proto_enumTypes[0].Descriptor()
+}
+
+func (ValidationStrategy) Type() protoreflect.EnumType {
+	return &file_inference_inference_model_proto_enumTypes[0]
+}
+
+func (x ValidationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationStrategy.Descriptor instead.
+func (ValidationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_model_proto_rawDescGZIP(), []int{0}
+}
+
 type Model struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposedBy             string   `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
-	Id                     string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	UnitsOfComputePerToken uint64   `protobuf:"varint,3,opt,name=units_of_compute_per_token,json=unitsOfComputePerToken,proto3" json:"units_of_compute_per_token,omitempty"`
-	ContextWindow          uint64   `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
-	Quantization           string   `protobuf:"bytes,5,opt,name=quantization,proto3" json:"quantization,omitempty"`
-	CoinsPerInputToken     uint64   `protobuf:"varint,6,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
-	CoinsPerOutputToken    uint64   `protobuf:"varint,7,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
-	HfRepo                 string   `protobuf:"bytes,8,opt,name=hf_repo,json=hfRepo,proto3" json:"hf_repo,omitempty"`
-	HfCommit               string   `protobuf:"bytes,9,opt,name=hf_commit,json=hfCommit,proto3" json:"hf_commit,omitempty"`
-	ModelArgs              []string `protobuf:"bytes,10,rep,name=model_args,json=modelArgs,proto3" json:"model_args,omitempty"`
-	VRam                   uint64   `protobuf:"varint,11,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
-	ThroughputPerNonce     uint64   `protobuf:"varint,12,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
-	ValidationThreshold    *Decimal `protobuf:"bytes,13,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	ProposedBy             string             `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	Id                     string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UnitsOfComputePerToken uint64             `protobuf:"varint,3,opt,name=units_of_compute_per_token,json=unitsOfComputePerToken,proto3" json:"units_of_compute_per_token,omitempty"`
+	ContextWindow          uint64             `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
+	Quantization           string             `protobuf:"bytes,5,opt,name=quantization,proto3" json:"quantization,omitempty"`
+	CoinsPerInputToken     uint64             `protobuf:"varint,6,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
+	CoinsPerOutputToken    uint64             `protobuf:"varint,7,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
+	HfRepo                 string             `protobuf:"bytes,8,opt,name=hf_repo,json=hfRepo,proto3" json:"hf_repo,omitempty"`
+	HfCommit               string             `protobuf:"bytes,9,opt,name=hf_commit,json=hfCommit,proto3" json:"hf_commit,omitempty"`
+	ModelArgs              []string           `protobuf:"bytes,10,rep,name=model_args,json=modelArgs,proto3" json:"model_args,omitempty"`
+	VRam                   uint64             `protobuf:"varint,11,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
+	ThroughputPerNonce     uint64             `protobuf:"varint,12,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
+	ValidationThreshold    *Decimal           `protobuf:"bytes,13,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	ValidationStrategy     ValidationStrategy `protobuf:"varint,14,opt,name=validation_strategy,json=validationStrategy,proto3,enum=inference.inference.ValidationStrategy" json:"validation_strategy,omitempty"`
 }
 
 func (x *Model) Reset() {
@@ -1323,6 +1429,13 @@ func (x *Model) GetValidationThreshold() *Decimal {
 	return nil
 }
 
+func (x *Model) GetValidationStrategy() ValidationStrategy {
+	if x != nil {
+		return x.ValidationStrategy
+	}
+	return ValidationStrategy_DEFAULT_VALIDATION
+}
+
 var File_inference_inference_model_proto protoreflect.FileDescriptor
 
 var file_inference_inference_model_proto_rawDesc = []byte{
@@ -1331,7 +1444,7 @@ var file_inference_inference_model_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
 	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x20, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
 	0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x04, 0x0a, 0x05, 0x4d, 0x6f, 0x64,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x04, 0x0a, 0x05, 0x4d, 0x6f, 0x64,
 	0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
 	0x64, 0x42, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1364,20 +1477,34 @@ var file_inference_inference_model_proto_rawDesc = []byte{
 	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
 	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
 	0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42,
-	0xb8, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2,
-	0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
-	0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x58, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2a, 0x84, 0x01, 0x0a, 0x12, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47, 0x50,
+	0x52, 0x4f, 0x42, 0x5f, 0x53, 0x49, 0x4d, 0x49, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x53, 0x5f, 0x44, 0x49,
+	0x56, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4d,
+	0x42, 0x45, 0x44, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x53, 0x49, 0x4e, 0x45, 0x10, 0x04,
+	0x42, 0xb8, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1392,18 +1519,21 @@ func file_inference_inference_model_proto_rawDescGZIP() []byte {
 	return file_inference_inference_model_proto_rawDescData
 }
 
+var file_inference_inference_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_inference_inference_model_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_inference_inference_model_proto_goTypes = []interface{}{
-	(*Model)(nil),   // 0: inference.inference.Model
-	(*Decimal)(nil), // 1: inference.inference.Decimal
+	(ValidationStrategy)(0), // 0: inference.inference.ValidationStrategy
+	(*Model)(nil),           // 1: inference.inference.Model
+	(*Decimal)(nil),         // 2: inference.inference.Decimal
 }
 var file_inference_inference_model_proto_depIdxs = []int32{
-	1, // 0: inference.inference.Model.validation_threshold:type_name -> inference.inference.Decimal
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: inference.inference.Model.validation_threshold:type_name -> inference.inference.Decimal
+	0, // 1: inference.inference.Model.validation_strategy:type_name -> inference.inference.ValidationStrategy
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_model_proto_init() }
@@ -1431,13 +1561,14 @@ func file_inference_inference_model_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_model_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_inference_inference_model_proto_goTypes,
 		DependencyIndexes: file_inference_inference_model_proto_depIdxs,
+		EnumInfos:         file_inference_inference_model_proto_enumTypes,
 		MessageInfos:      file_inference_inference_model_proto_msgTypes,
 	}.Build()
 	File_inference_inference_model_proto = out.File
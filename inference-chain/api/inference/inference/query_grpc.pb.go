@@ -26,10 +26,12 @@ const (
 	Query_ParticipantAll_FullMethodName                            = "/inference.inference.Query/ParticipantAll"
 	Query_InferenceParticipant_FullMethodName                      = "/inference.inference.Query/InferenceParticipant"
 	Query_GetRandomExecutor_FullMethodName                         = "/inference.inference.Query/GetRandomExecutor"
+	Query_ExecutorCandidates_FullMethodName                        = "/inference.inference.Query/ExecutorCandidates"
 	Query_EpochGroupData_FullMethodName                            = "/inference.inference.Query/EpochGroupData"
 	Query_EpochGroupDataAll_FullMethodName                         = "/inference.inference.Query/EpochGroupDataAll"
 	Query_SettleAmount_FullMethodName                              = "/inference.inference.Query/SettleAmount"
 	Query_SettleAmountAll_FullMethodName                           = "/inference.inference.Query/SettleAmountAll"
+	Query_PendingClaims_FullMethodName                             = "/inference.inference.Query/PendingClaims"
 	Query_EpochGroupValidations_FullMethodName                     = "/inference.inference.Query/EpochGroupValidations"
 	Query_EpochGroupValidationsAll_FullMethodName                  = "/inference.inference.Query/EpochGroupValidationsAll"
 	Query_PocBatchesForStage_FullMethodName                        = "/inference.inference.Query/PocBatchesForStage"
@@ -68,8 +70,12 @@ const (
 	Query_PartialUpgradeAll_FullMethodName                         = "/inference.inference.Query/PartialUpgradeAll"
 	Query_BridgeTransaction_FullMethodName                         = "/inference.inference.Query/BridgeTransaction"
 	Query_BridgeTransactions_FullMethodName                        = "/inference.inference.Query/BridgeTransactions"
+	Query_BridgeBlockHeader_FullMethodName                         = "/inference.inference.Query/BridgeBlockHeader"
+	Query_BridgeWithdrawal_FullMethodName                          = "/inference.inference.Query/BridgeWithdrawal"
 	Query_TrainingKvRecord_FullMethodName                          = "/inference.inference.Query/TrainingKvRecord"
 	Query_ListTrainingKvRecordKeys_FullMethodName                  = "/inference.inference.Query/ListTrainingKvRecordKeys"
+	Query_TrainingCheckpoint_FullMethodName                        = "/inference.inference.Query/TrainingCheckpoint"
+	Query_TrainingCheckpoints_FullMethodName                       = "/inference.inference.Query/TrainingCheckpoints"
 	Query_TrainingBarrier_FullMethodName                           = "/inference.inference.Query/TrainingBarrier"
 	Query_TrainingAliveNodes_FullMethodName                        = "/inference.inference.Query/TrainingAliveNodes"
 	Query_EpochInfo_FullMethodName                                 = "/inference.inference.Query/EpochInfo"
@@ -80,6 +86,8 @@ const (
 	Query_GetModelCapacity_FullMethodName                          = "/inference.inference.Query/GetModelCapacity"
 	Query_GetAllModelCapacities_FullMethodName                     = "/inference.inference.Query/GetAllModelCapacities"
 	Query_GranteesByMessageType_FullMethodName                     = "/inference.inference.Query/GranteesByMessageType"
+	Query_DeveloperSubKey_FullMethodName                           = "/inference.inference.Query/DeveloperSubKey"
+	Query_DeveloperSubKeys_FullMethodName                          = "/inference.inference.Query/DeveloperSubKeys"
 )
 
 // QueryClient is the client API for Query service.
@@ -98,12 +106,16 @@ type QueryClient interface {
 	InferenceParticipant(ctx context.Context, in *QueryInferenceParticipantRequest, opts ...grpc.CallOption) (*QueryInferenceParticipantResponse, error)
 	// Queries a list of GetRandomExecutor items.
 	GetRandomExecutor(ctx context.Context, in *QueryGetRandomExecutorRequest, opts ...grpc.CallOption) (*QueryGetRandomExecutorResponse, error)
+	// Queries the executors a random executor would be selected from, with their weights
+	ExecutorCandidates(ctx context.Context, in *QueryExecutorCandidatesRequest, opts ...grpc.CallOption) (*QueryExecutorCandidatesResponse, error)
 	// Queries a list of EpochGroupData items.
 	EpochGroupData(ctx context.Context, in *QueryGetEpochGroupDataRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupDataResponse, error)
 	EpochGroupDataAll(ctx context.Context, in *QueryAllEpochGroupDataRequest, opts ...grpc.CallOption) (*QueryAllEpochGroupDataResponse, error)
 	// Queries a list of SettleAmount items.
 	SettleAmount(ctx context.Context, in *QueryGetSettleAmountRequest, opts ...grpc.CallOption) (*QueryGetSettleAmountResponse, error)
 	SettleAmountAll(ctx context.Context, in *QueryAllSettleAmountRequest, opts ...grpc.CallOption) (*QueryAllSettleAmountResponse, error)
+	// Queries the unclaimed settle amounts of a participant and when they expire
+	PendingClaims(ctx context.Context, in *QueryPendingClaimsRequest, opts ...grpc.CallOption) (*QueryPendingClaimsResponse, error)
 	// Queries a list of EpochGroupValidations items.
 	EpochGroupValidations(ctx context.Context, in *QueryGetEpochGroupValidationsRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupValidationsResponse, error)
 	EpochGroupValidationsAll(ctx context.Context, in *QueryAllEpochGroupValidationsRequest, opts ...grpc.CallOption) (*QueryAllEpochGroupValidationsResponse, error)
@@ -167,10 +179,18 @@ type QueryClient interface {
 	BridgeTransaction(ctx context.Context, in *QueryGetBridgeTransactionRequest, opts ...grpc.CallOption) (*QueryGetBridgeTransactionResponse, error)
 	// Queries all bridge transactions
 	BridgeTransactions(ctx context.Context, in *QueryAllBridgeTransactionsRequest, opts ...grpc.CallOption) (*QueryAllBridgeTransactionsResponse, error)
+	// Queries the attested receipts root of an origin chain block
+	BridgeBlockHeader(ctx context.Context, in *QueryGetBridgeBlockHeaderRequest, opts ...grpc.CallOption) (*QueryGetBridgeBlockHeaderResponse, error)
+	// Queries a bridge withdrawal with its signed payload
+	BridgeWithdrawal(ctx context.Context, in *QueryGetBridgeWithdrawalRequest, opts ...grpc.CallOption) (*QueryGetBridgeWithdrawalResponse, error)
 	// Queries a list of TrainingKvRecord items.
 	TrainingKvRecord(ctx context.Context, in *QueryTrainingKvRecordRequest, opts ...grpc.CallOption) (*QueryTrainingKvRecordResponse, error)
 	// Queries a list of ListTrainingKvRecordKeys items.
 	ListTrainingKvRecordKeys(ctx context.Context, in *QueryListTrainingKvRecordKeysRequest, opts ...grpc.CallOption) (*QueryListTrainingKvRecordKeysResponse, error)
+	// Queries the checkpoint of a training task produced at an outer step
+	TrainingCheckpoint(ctx context.Context, in *QueryTrainingCheckpointRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointResponse, error)
+	// Queries all checkpoints of a training task
+	TrainingCheckpoints(ctx context.Context, in *QueryTrainingCheckpointsRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointsResponse, error)
 	// Queries a list of TrainingBarrier items.
 	TrainingBarrier(ctx context.Context, in *QueryTrainingBarrierRequest, opts ...grpc.CallOption) (*QueryTrainingBarrierResponse, error)
 	// Queries a list of TrainingAliveNodes items.
@@ -188,6 +208,10 @@ type QueryClient interface {
 	GetAllModelCapacities(ctx context.Context, in *QueryGetAllModelCapacitiesRequest, opts ...grpc.CallOption) (*QueryGetAllModelCapacitiesResponse, error)
 	// Queries all authz grantees with specific message type for an account
 	GranteesByMessageType(ctx context.Context, in *QueryGranteesByMessageTypeRequest, opts ...grpc.CallOption) (*QueryGranteesByMessageTypeResponse, error)
+	// Queries a developer sub-key with its spending in the current epoch
+	DeveloperSubKey(ctx context.Context, in *QueryGetDeveloperSubKeyRequest, opts ...grpc.CallOption) (*QueryGetDeveloperSubKeyResponse, error)
+	// Queries all sub-keys of a developer
+	DeveloperSubKeys(ctx context.Context, in *QueryDeveloperSubKeysRequest, opts ...grpc.CallOption) (*QueryDeveloperSubKeysResponse, error)
 }
 
 type queryClient struct {
@@ -261,6 +285,15 @@ func (c *queryClient) GetRandomExecutor(ctx context.Context, in *QueryGetRandomE
 	return out, nil
 }
 
+func (c *queryClient) ExecutorCandidates(ctx context.Context, in *QueryExecutorCandidatesRequest, opts ...grpc.CallOption) (*QueryExecutorCandidatesResponse, error) {
+	out := new(QueryExecutorCandidatesResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutorCandidates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EpochGroupData(ctx context.Context, in *QueryGetEpochGroupDataRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupDataResponse, error) {
 	out := new(QueryGetEpochGroupDataResponse)
 	err := c.cc.Invoke(ctx, Query_EpochGroupData_FullMethodName, in, out, opts...)
@@ -297,6 +330,15 @@ func (c *queryClient) SettleAmountAll(ctx context.Context, in *QueryAllSettleAmo
 	return out, nil
 }
 
+func (c *queryClient) PendingClaims(ctx context.Context, in *QueryPendingClaimsRequest, opts ...grpc.CallOption) (*QueryPendingClaimsResponse, error) {
+	out := new(QueryPendingClaimsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingClaims_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EpochGroupValidations(ctx context.Context, in *QueryGetEpochGroupValidationsRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupValidationsResponse, error) {
 	out := new(QueryGetEpochGroupValidationsResponse)
 	err := c.cc.Invoke(ctx, Query_EpochGroupValidations_FullMethodName, in, out, opts...)
@@ -639,6 +681,24 @@ func (c *queryClient) BridgeTransactions(ctx context.Context, in *QueryAllBridge
 	return out, nil
 }
 
+func (c *queryClient) BridgeBlockHeader(ctx context.Context, in *QueryGetBridgeBlockHeaderRequest, opts ...grpc.CallOption) (*QueryGetBridgeBlockHeaderResponse, error) {
+	out := new(QueryGetBridgeBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeBlockHeader_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeWithdrawal(ctx context.Context, in *QueryGetBridgeWithdrawalRequest, opts ...grpc.CallOption) (*QueryGetBridgeWithdrawalResponse, error) {
+	out := new(QueryGetBridgeWithdrawalResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TrainingKvRecord(ctx context.Context, in *QueryTrainingKvRecordRequest, opts ...grpc.CallOption) (*QueryTrainingKvRecordResponse, error) {
 	out := new(QueryTrainingKvRecordResponse)
 	err := c.cc.Invoke(ctx, Query_TrainingKvRecord_FullMethodName, in, out, opts...)
@@ -657,6 +717,24 @@ func (c *queryClient) ListTrainingKvRecordKeys(ctx context.Context, in *QueryLis
 	return out, nil
 }
 
+func (c *queryClient) TrainingCheckpoint(ctx context.Context, in *QueryTrainingCheckpointRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointResponse, error) {
+	out := new(QueryTrainingCheckpointResponse)
+	err := c.cc.Invoke(ctx, Query_TrainingCheckpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrainingCheckpoints(ctx context.Context, in *QueryTrainingCheckpointsRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointsResponse, error) {
+	out := new(QueryTrainingCheckpointsResponse)
+	err := c.cc.Invoke(ctx, Query_TrainingCheckpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TrainingBarrier(ctx context.Context, in *QueryTrainingBarrierRequest, opts ...grpc.CallOption) (*QueryTrainingBarrierResponse, error) {
 	out := new(QueryTrainingBarrierResponse)
 	err := c.cc.Invoke(ctx, Query_TrainingBarrier_FullMethodName, in, out, opts...)
@@ -747,6 +825,24 @@ func (c *queryClient) GranteesByMessageType(ctx context.Context, in *QueryGrante
 	return out, nil
 }
 
+func (c *queryClient) DeveloperSubKey(ctx context.Context, in *QueryGetDeveloperSubKeyRequest, opts ...grpc.CallOption) (*QueryGetDeveloperSubKeyResponse, error) {
+	out := new(QueryGetDeveloperSubKeyResponse)
+	err := c.cc.Invoke(ctx, Query_DeveloperSubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperSubKeys(ctx context.Context, in *QueryDeveloperSubKeysRequest, opts ...grpc.CallOption) (*QueryDeveloperSubKeysResponse, error) {
+	out := new(QueryDeveloperSubKeysResponse)
+	err := c.cc.Invoke(ctx, Query_DeveloperSubKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -763,12 +859,16 @@ type QueryServer interface {
 	InferenceParticipant(context.Context, *QueryInferenceParticipantRequest) (*QueryInferenceParticipantResponse, error)
 	// Queries a list of GetRandomExecutor items.
 	GetRandomExecutor(context.Context, *QueryGetRandomExecutorRequest) (*QueryGetRandomExecutorResponse, error)
+	// Queries the executors a random executor would be selected from, with their weights
+	ExecutorCandidates(context.Context, *QueryExecutorCandidatesRequest) (*QueryExecutorCandidatesResponse, error)
 	// Queries a list of EpochGroupData items.
 	EpochGroupData(context.Context, *QueryGetEpochGroupDataRequest) (*QueryGetEpochGroupDataResponse, error)
 	EpochGroupDataAll(context.Context, *QueryAllEpochGroupDataRequest) (*QueryAllEpochGroupDataResponse, error)
 	// Queries a list of SettleAmount items.
 	SettleAmount(context.Context, *QueryGetSettleAmountRequest) (*QueryGetSettleAmountResponse, error)
 	SettleAmountAll(context.Context, *QueryAllSettleAmountRequest) (*QueryAllSettleAmountResponse, error)
+	// Queries the unclaimed settle amounts of a participant and when they expire
+	PendingClaims(context.Context, *QueryPendingClaimsRequest) (*QueryPendingClaimsResponse, error)
 	// Queries a list of EpochGroupValidations items.
 	EpochGroupValidations(context.Context, *QueryGetEpochGroupValidationsRequest) (*QueryGetEpochGroupValidationsResponse, error)
 	EpochGroupValidationsAll(context.Context, *QueryAllEpochGroupValidationsRequest) (*QueryAllEpochGroupValidationsResponse, error)
@@ -832,10 +932,18 @@ type QueryServer interface {
 	BridgeTransaction(context.Context, *QueryGetBridgeTransactionRequest) (*QueryGetBridgeTransactionResponse, error)
 	// Queries all bridge transactions
 	BridgeTransactions(context.Context, *QueryAllBridgeTransactionsRequest) (*QueryAllBridgeTransactionsResponse, error)
+	// Queries the attested receipts root of an origin chain block
+	BridgeBlockHeader(context.Context, *QueryGetBridgeBlockHeaderRequest) (*QueryGetBridgeBlockHeaderResponse, error)
+	// Queries a bridge withdrawal with its signed payload
+	BridgeWithdrawal(context.Context, *QueryGetBridgeWithdrawalRequest) (*QueryGetBridgeWithdrawalResponse, error)
 	// Queries a list of TrainingKvRecord items.
 	TrainingKvRecord(context.Context, *QueryTrainingKvRecordRequest) (*QueryTrainingKvRecordResponse, error)
 	// Queries a list of ListTrainingKvRecordKeys items.
 	ListTrainingKvRecordKeys(context.Context, *QueryListTrainingKvRecordKeysRequest) (*QueryListTrainingKvRecordKeysResponse, error)
+	// Queries the checkpoint of a training task produced at an outer step
+	TrainingCheckpoint(context.Context, *QueryTrainingCheckpointRequest) (*QueryTrainingCheckpointResponse, error)
+	// Queries all checkpoints of a training task
+	TrainingCheckpoints(context.Context, *QueryTrainingCheckpointsRequest) (*QueryTrainingCheckpointsResponse, error)
 	// Queries a list of TrainingBarrier items.
 	TrainingBarrier(context.Context, *QueryTrainingBarrierRequest) (*QueryTrainingBarrierResponse, error)
 	// Queries a list of TrainingAliveNodes items.
@@ -853,6 +961,10 @@ type QueryServer interface {
 	GetAllModelCapacities(context.Context, *QueryGetAllModelCapacitiesRequest) (*QueryGetAllModelCapacitiesResponse, error)
 	// Queries all authz grantees with specific message type for an account
 	GranteesByMessageType(context.Context, *QueryGranteesByMessageTypeRequest) (*QueryGranteesByMessageTypeResponse, error)
+	// Queries a developer sub-key with its spending in the current epoch
+	DeveloperSubKey(context.Context, *QueryGetDeveloperSubKeyRequest) (*QueryGetDeveloperSubKeyResponse, error)
+	// Queries all sub-keys of a developer
+	DeveloperSubKeys(context.Context, *QueryDeveloperSubKeysRequest) (*QueryDeveloperSubKeysResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -881,6 +993,9 @@ func (UnimplementedQueryServer) InferenceParticipant(context.Context, *QueryInfe
 func (UnimplementedQueryServer) GetRandomExecutor(context.Context, *QueryGetRandomExecutorRequest) (*QueryGetRandomExecutorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRandomExecutor not implemented")
 }
+func (UnimplementedQueryServer) ExecutorCandidates(context.Context, *QueryExecutorCandidatesRequest) (*QueryExecutorCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutorCandidates not implemented")
+}
 func (UnimplementedQueryServer) EpochGroupData(context.Context, *QueryGetEpochGroupDataRequest) (*QueryGetEpochGroupDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochGroupData not implemented")
 }
@@ -893,6 +1008,9 @@ func (UnimplementedQueryServer) SettleAmount(context.Context, *QueryGetSettleAmo
 func (UnimplementedQueryServer) SettleAmountAll(context.Context, *QueryAllSettleAmountRequest) (*QueryAllSettleAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SettleAmountAll not implemented")
 }
+func (UnimplementedQueryServer) PendingClaims(context.Context, *QueryPendingClaimsRequest) (*QueryPendingClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingClaims not implemented")
+}
 func (UnimplementedQueryServer) EpochGroupValidations(context.Context, *QueryGetEpochGroupValidationsRequest) (*QueryGetEpochGroupValidationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochGroupValidations not implemented")
 }
@@ -1007,12 +1125,24 @@ func (UnimplementedQueryServer) BridgeTransaction(context.Context, *QueryGetBrid
 func (UnimplementedQueryServer) BridgeTransactions(context.Context, *QueryAllBridgeTransactionsRequest) (*QueryAllBridgeTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeTransactions not implemented")
 }
+func (UnimplementedQueryServer) BridgeBlockHeader(context.Context, *QueryGetBridgeBlockHeaderRequest) (*QueryGetBridgeBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeBlockHeader not implemented")
+}
+func (UnimplementedQueryServer) BridgeWithdrawal(context.Context, *QueryGetBridgeWithdrawalRequest) (*QueryGetBridgeWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeWithdrawal not implemented")
+}
 func (UnimplementedQueryServer) TrainingKvRecord(context.Context, *QueryTrainingKvRecordRequest) (*QueryTrainingKvRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrainingKvRecord not implemented")
 }
 func (UnimplementedQueryServer) ListTrainingKvRecordKeys(context.Context, *QueryListTrainingKvRecordKeysRequest) (*QueryListTrainingKvRecordKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTrainingKvRecordKeys not implemented")
 }
+func (UnimplementedQueryServer) TrainingCheckpoint(context.Context, *QueryTrainingCheckpointRequest) (*QueryTrainingCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingCheckpoint not implemented")
+}
+func (UnimplementedQueryServer) TrainingCheckpoints(context.Context, *QueryTrainingCheckpointsRequest) (*QueryTrainingCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingCheckpoints not implemented")
+}
 func (UnimplementedQueryServer) TrainingBarrier(context.Context, *QueryTrainingBarrierRequest) (*QueryTrainingBarrierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrainingBarrier not implemented")
 }
@@ -1043,6 +1173,12 @@ func (UnimplementedQueryServer) GetAllModelCapacities(context.Context, *QueryGet
 func (UnimplementedQueryServer) GranteesByMessageType(context.Context, *QueryGranteesByMessageTypeRequest) (*QueryGranteesByMessageTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteesByMessageType not implemented")
 }
+func (UnimplementedQueryServer) DeveloperSubKey(context.Context, *QueryGetDeveloperSubKeyRequest) (*QueryGetDeveloperSubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperSubKey not implemented")
+}
+func (UnimplementedQueryServer) DeveloperSubKeys(context.Context, *QueryDeveloperSubKeysRequest) (*QueryDeveloperSubKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperSubKeys not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -1182,6 +1318,24 @@ func _Query_GetRandomExecutor_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExecutorCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutorCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutorCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutorCandidates(ctx, req.(*QueryExecutorCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EpochGroupData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetEpochGroupDataRequest)
 	if err := dec(in); err != nil {
@@ -1254,6 +1408,24 @@ func _Query_SettleAmountAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingClaims(ctx, req.(*QueryPendingClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EpochGroupValidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetEpochGroupValidationsRequest)
 	if err := dec(in); err != nil {
@@ -1938,6 +2110,42 @@ func _Query_BridgeTransactions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BridgeBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBridgeBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeBlockHeader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeBlockHeader(ctx, req.(*QueryGetBridgeBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBridgeWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeWithdrawal(ctx, req.(*QueryGetBridgeWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTrainingKvRecordRequest)
 	if err := dec(in); err != nil {
@@ -1974,6 +2182,42 @@ func _Query_ListTrainingKvRecordKeys_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TrainingCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrainingCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrainingCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TrainingCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrainingCheckpoint(ctx, req.(*QueryTrainingCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrainingCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrainingCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrainingCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TrainingCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrainingCheckpoints(ctx, req.(*QueryTrainingCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TrainingBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTrainingBarrierRequest)
 	if err := dec(in); err != nil {
@@ -2154,6 +2398,42 @@ func _Query_GranteesByMessageType_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeveloperSubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDeveloperSubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperSubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeveloperSubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperSubKey(ctx, req.(*QueryGetDeveloperSubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperSubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperSubKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperSubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeveloperSubKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperSubKeys(ctx, req.(*QueryDeveloperSubKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2189,6 +2469,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRandomExecutor",
 			Handler:    _Query_GetRandomExecutor_Handler,
 		},
+		{
+			MethodName: "ExecutorCandidates",
+			Handler:    _Query_ExecutorCandidates_Handler,
+		},
 		{
 			MethodName: "EpochGroupData",
 			Handler:    _Query_EpochGroupData_Handler,
@@ -2205,6 +2489,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SettleAmountAll",
 			Handler:    _Query_SettleAmountAll_Handler,
 		},
+		{
+			MethodName: "PendingClaims",
+			Handler:    _Query_PendingClaims_Handler,
+		},
 		{
 			MethodName: "EpochGroupValidations",
 			Handler:    _Query_EpochGroupValidations_Handler,
@@ -2357,6 +2645,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeTransactions",
 			Handler:    _Query_BridgeTransactions_Handler,
 		},
+		{
+			MethodName: "BridgeBlockHeader",
+			Handler:    _Query_BridgeBlockHeader_Handler,
+		},
+		{
+			MethodName: "BridgeWithdrawal",
+			Handler:    _Query_BridgeWithdrawal_Handler,
+		},
 		{
 			MethodName: "TrainingKvRecord",
 			Handler:    _Query_TrainingKvRecord_Handler,
@@ -2365,6 +2661,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTrainingKvRecordKeys",
 			Handler:    _Query_ListTrainingKvRecordKeys_Handler,
 		},
+		{
+			MethodName: "TrainingCheckpoint",
+			Handler:    _Query_TrainingCheckpoint_Handler,
+		},
+		{
+			MethodName: "TrainingCheckpoints",
+			Handler:    _Query_TrainingCheckpoints_Handler,
+		},
 		{
 			MethodName: "TrainingBarrier",
 			Handler:    _Query_TrainingBarrier_Handler,
@@ -2405,6 +2709,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GranteesByMessageType",
 			Handler:    _Query_GranteesByMessageType_Handler,
 		},
+		{
+			MethodName: "DeveloperSubKey",
+			Handler:    _Query_DeveloperSubKey_Handler,
+		},
+		{
+			MethodName: "DeveloperSubKeys",
+			Handler:    _Query_DeveloperSubKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/query.proto",
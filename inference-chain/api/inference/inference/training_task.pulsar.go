@@ -114,6 +114,57 @@ func (x *_TrainingTask_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_TrainingTask_18_list)(nil)
+
+type _TrainingTask_18_list struct {
+	list *[]*TrainingHardwareResources
+}
+
+func (x *_TrainingTask_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TrainingTask_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TrainingTask_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrainingHardwareResources)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TrainingTask_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrainingHardwareResources)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TrainingTask_18_list) AppendMutable() protoreflect.Value {
+	v := new(TrainingHardwareResources)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TrainingTask_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TrainingTask_18_list) NewElement() protoreflect.Value {
+	v := new(TrainingHardwareResources)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TrainingTask_18_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_TrainingTask                                     protoreflect.MessageDescriptor
 	fd_TrainingTask_id                                  protoreflect.FieldDescriptor
@@ -127,6 +178,14 @@ var (
 	fd_TrainingTask_config                              protoreflect.FieldDescriptor
 	fd_TrainingTask_assignees                           protoreflect.FieldDescriptor
 	fd_TrainingTask_epoch                               protoreflect.FieldDescriptor
+	fd_TrainingTask_budget                              protoreflect.FieldDescriptor
+	fd_TrainingTask_outer_step_payout                   protoreflect.FieldDescriptor
+	fd_TrainingTask_paid_out                            protoreflect.FieldDescriptor
+	fd_TrainingTask_refunded                            protoreflect.FieldDescriptor
+	fd_TrainingTask_next_payable_outer_step             protoreflect.FieldDescriptor
+	fd_TrainingTask_cancelled                           protoreflect.FieldDescriptor
+	fd_TrainingTask_replacement_resources               protoreflect.FieldDescriptor
+	fd_TrainingTask_nodes_replaced_at_block_height      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -143,6 +202,14 @@ func init() {
 	fd_TrainingTask_config = md_TrainingTask.Fields().ByName("config")
 	fd_TrainingTask_assignees = md_TrainingTask.Fields().ByName("assignees")
 	fd_TrainingTask_epoch = md_TrainingTask.Fields().ByName("epoch")
+	fd_TrainingTask_budget = md_TrainingTask.Fields().ByName("budget")
+	fd_TrainingTask_outer_step_payout = md_TrainingTask.Fields().ByName("outer_step_payout")
+	fd_TrainingTask_paid_out = md_TrainingTask.Fields().ByName("paid_out")
+	fd_TrainingTask_refunded = md_TrainingTask.Fields().ByName("refunded")
+	fd_TrainingTask_next_payable_outer_step = md_TrainingTask.Fields().ByName("next_payable_outer_step")
+	fd_TrainingTask_cancelled = md_TrainingTask.Fields().ByName("cancelled")
+	fd_TrainingTask_replacement_resources = md_TrainingTask.Fields().ByName("replacement_resources")
+	fd_TrainingTask_nodes_replaced_at_block_height = md_TrainingTask.Fields().ByName("nodes_replaced_at_block_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_TrainingTask)(nil)
@@ -276,6 +343,54 @@ func (x *fastReflection_TrainingTask) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Budget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Budget)
+		if !f(fd_TrainingTask_budget, value) {
+			return
+		}
+	}
+	if x.OuterStepPayout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OuterStepPayout)
+		if !f(fd_TrainingTask_outer_step_payout, value) {
+			return
+		}
+	}
+	if x.PaidOut != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PaidOut)
+		if !f(fd_TrainingTask_paid_out, value) {
+			return
+		}
+	}
+	if x.Refunded != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Refunded)
+		if !f(fd_TrainingTask_refunded, value) {
+			return
+		}
+	}
+	if x.NextPayableOuterStep != int32(0) {
+		value := protoreflect.ValueOfInt32(x.NextPayableOuterStep)
+		if !f(fd_TrainingTask_next_payable_outer_step, value) {
+			return
+		}
+	}
+	if x.Cancelled != false {
+		value := protoreflect.ValueOfBool(x.Cancelled)
+		if !f(fd_TrainingTask_cancelled, value) {
+			return
+		}
+	}
+	if len(x.ReplacementResources) != 0 {
+		value := protoreflect.ValueOfList(&_TrainingTask_18_list{list: &x.ReplacementResources})
+		if !f(fd_TrainingTask_replacement_resources, value) {
+			return
+		}
+	}
+	if x.NodesReplacedAtBlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NodesReplacedAtBlockHeight)
+		if !f(fd_TrainingTask_nodes_replaced_at_block_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -313,6 +428,22 @@ func (x *fastReflection_TrainingTask) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Assignees) != 0
 	case "inference.inference.TrainingTask.epoch":
 		return x.Epoch != nil
+	case "inference.inference.TrainingTask.budget":
+		return x.Budget != uint64(0)
+	case "inference.inference.TrainingTask.outer_step_payout":
+		return x.OuterStepPayout != uint64(0)
+	case "inference.inference.TrainingTask.paid_out":
+		return x.PaidOut != uint64(0)
+	case "inference.inference.TrainingTask.refunded":
+		return x.Refunded != uint64(0)
+	case "inference.inference.TrainingTask.next_payable_outer_step":
+		return x.NextPayableOuterStep != int32(0)
+	case "inference.inference.TrainingTask.cancelled":
+		return x.Cancelled != false
+	case "inference.inference.TrainingTask.replacement_resources":
+		return len(x.ReplacementResources) != 0
+	case "inference.inference.TrainingTask.nodes_replaced_at_block_height":
+		return x.NodesReplacedAtBlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -351,6 +482,22 @@ func (x *fastReflection_TrainingTask) Clear(fd protoreflect.FieldDescriptor) {
 		x.Assignees = nil
 	case "inference.inference.TrainingTask.epoch":
 		x.Epoch = nil
+	case "inference.inference.TrainingTask.budget":
+		x.Budget = uint64(0)
+	case "inference.inference.TrainingTask.outer_step_payout":
+		x.OuterStepPayout = uint64(0)
+	case "inference.inference.TrainingTask.paid_out":
+		x.PaidOut = uint64(0)
+	case "inference.inference.TrainingTask.refunded":
+		x.Refunded = uint64(0)
+	case "inference.inference.TrainingTask.next_payable_outer_step":
+		x.NextPayableOuterStep = int32(0)
+	case "inference.inference.TrainingTask.cancelled":
+		x.Cancelled = false
+	case "inference.inference.TrainingTask.replacement_resources":
+		x.ReplacementResources = nil
+	case "inference.inference.TrainingTask.nodes_replaced_at_block_height":
+		x.NodesReplacedAtBlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -406,6 +553,33 @@ func (x *fastReflection_TrainingTask) Get(descriptor protoreflect.FieldDescripto
 	case "inference.inference.TrainingTask.epoch":
 		value := x.Epoch
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.TrainingTask.budget":
+		value := x.Budget
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingTask.outer_step_payout":
+		value := x.OuterStepPayout
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingTask.paid_out":
+		value := x.PaidOut
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingTask.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingTask.next_payable_outer_step":
+		value := x.NextPayableOuterStep
+		return protoreflect.ValueOfInt32(value)
+	case "inference.inference.TrainingTask.cancelled":
+		value := x.Cancelled
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.TrainingTask.replacement_resources":
+		if len(x.ReplacementResources) == 0 {
+			return protoreflect.ValueOfList(&_TrainingTask_18_list{})
+		}
+		listValue := &_TrainingTask_18_list{list: &x.ReplacementResources}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.TrainingTask.nodes_replaced_at_block_height":
+		value := x.NodesReplacedAtBlockHeight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -452,6 +626,24 @@ func (x *fastReflection_TrainingTask) Set(fd protoreflect.FieldDescriptor, value
 		x.Assignees = *clv.list
 	case "inference.inference.TrainingTask.epoch":
 		x.Epoch = value.Message().Interface().(*EpochInfo)
+	case "inference.inference.TrainingTask.budget":
+		x.Budget = value.Uint()
+	case "inference.inference.TrainingTask.outer_step_payout":
+		x.OuterStepPayout = value.Uint()
+	case "inference.inference.TrainingTask.paid_out":
+		x.PaidOut = value.Uint()
+	case "inference.inference.TrainingTask.refunded":
+		x.Refunded = value.Uint()
+	case "inference.inference.TrainingTask.next_payable_outer_step":
+		x.NextPayableOuterStep = int32(value.Int())
+	case "inference.inference.TrainingTask.cancelled":
+		x.Cancelled = value.Bool()
+	case "inference.inference.TrainingTask.replacement_resources":
+		lv := value.List()
+		clv := lv.(*_TrainingTask_18_list)
+		x.ReplacementResources = *clv.list
+	case "inference.inference.TrainingTask.nodes_replaced_at_block_height":
+		x.NodesReplacedAtBlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -494,6 +686,12 @@ func (x *fastReflection_TrainingTask) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Epoch = new(EpochInfo)
 		}
 		return protoreflect.ValueOfMessage(x.Epoch.ProtoReflect())
+	case "inference.inference.TrainingTask.replacement_resources":
+		if x.ReplacementResources == nil {
+			x.ReplacementResources = []*TrainingHardwareResources{}
+		}
+		value := &_TrainingTask_18_list{list: &x.ReplacementResources}
+		return protoreflect.ValueOfList(value)
 	case "inference.inference.TrainingTask.id":
 		panic(fmt.Errorf("field id of message inference.inference.TrainingTask is not mutable"))
 	case "inference.inference.TrainingTask.requested_by":
@@ -508,6 +706,20 @@ func (x *fastReflection_TrainingTask) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field assigned_at_block_height of message inference.inference.TrainingTask is not mutable"))
 	case "inference.inference.TrainingTask.finished_at_block_height":
 		panic(fmt.Errorf("field finished_at_block_height of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.budget":
+		panic(fmt.Errorf("field budget of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.outer_step_payout":
+		panic(fmt.Errorf("field outer_step_payout of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.paid_out":
+		panic(fmt.Errorf("field paid_out of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.refunded":
+		panic(fmt.Errorf("field refunded of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.next_payable_outer_step":
+		panic(fmt.Errorf("field next_payable_outer_step of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.cancelled":
+		panic(fmt.Errorf("field cancelled of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.nodes_replaced_at_block_height":
+		panic(fmt.Errorf("field nodes_replaced_at_block_height of message inference.inference.TrainingTask is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -547,6 +759,23 @@ func (x *fastReflection_TrainingTask) NewField(fd protoreflect.FieldDescriptor)
 	case "inference.inference.TrainingTask.epoch":
 		m := new(EpochInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.TrainingTask.budget":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingTask.outer_step_payout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingTask.paid_out":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingTask.refunded":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingTask.next_payable_outer_step":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "inference.inference.TrainingTask.cancelled":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.TrainingTask.replacement_resources":
+		list := []*TrainingHardwareResources{}
+		return protoreflect.ValueOfList(&_TrainingTask_18_list{list: &list})
+	case "inference.inference.TrainingTask.nodes_replaced_at_block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -659,6 +888,33 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Epoch)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Budget != 0 {
+			n += 1 + runtime.Sov(uint64(x.Budget))
+		}
+		if x.OuterStepPayout != 0 {
+			n += 1 + runtime.Sov(uint64(x.OuterStepPayout))
+		}
+		if x.PaidOut != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaidOut))
+		}
+		if x.Refunded != 0 {
+			n += 1 + runtime.Sov(uint64(x.Refunded))
+		}
+		if x.NextPayableOuterStep != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextPayableOuterStep))
+		}
+		if x.Cancelled {
+			n += 3
+		}
+		if len(x.ReplacementResources) > 0 {
+			for _, e := range x.ReplacementResources {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NodesReplacedAtBlockHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.NodesReplacedAtBlockHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -688,6 +944,70 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NodesReplacedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NodesReplacedAtBlockHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.ReplacementResources) > 0 {
+			for iNdEx := len(x.ReplacementResources) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReplacementResources[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.Cancelled {
+			i--
+			if x.Cancelled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.NextPayableOuterStep != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPayableOuterStep))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.Refunded != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Refunded))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.PaidOut != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaidOut))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.OuterStepPayout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OuterStepPayout))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.Budget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Budget))
+			i--
+			dAtA[i] = 0x60
+		}
 		if x.Epoch != nil {
 			encoded, err := options.Marshal(x.Epoch)
 			if err != nil {
@@ -1029,11 +1349,1736 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &TrainingConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Assignees = append(x.Assignees, &TrainingTaskAssignee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Assignees[len(x.Assignees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Epoch == nil {
+					x.Epoch = &EpochInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Epoch); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+				}
+				x.Budget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Budget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OuterStepPayout", wireType)
+				}
+				x.OuterStepPayout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OuterStepPayout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidOut", wireType)
+				}
+				x.PaidOut = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaidOut |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				x.Refunded = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Refunded |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPayableOuterStep", wireType)
+				}
+				x.NextPayableOuterStep = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPayableOuterStep |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Cancelled = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReplacementResources", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReplacementResources = append(x.ReplacementResources, &TrainingHardwareResources{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReplacementResources[len(x.ReplacementResources)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodesReplacedAtBlockHeight", wireType)
+				}
+				x.NodesReplacedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NodesReplacedAtBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TrainingCheckpoint_5_list)(nil)
+
+type _TrainingCheckpoint_5_list struct {
+	list *[]*TrainingCheckpointSignature
+}
+
+func (x *_TrainingCheckpoint_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TrainingCheckpoint_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TrainingCheckpoint_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrainingCheckpointSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TrainingCheckpoint_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrainingCheckpointSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TrainingCheckpoint_5_list) AppendMutable() protoreflect.Value {
+	v := new(TrainingCheckpointSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TrainingCheckpoint_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TrainingCheckpoint_5_list) NewElement() protoreflect.Value {
+	v := new(TrainingCheckpointSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TrainingCheckpoint_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TrainingCheckpoint                                protoreflect.MessageDescriptor
+	fd_TrainingCheckpoint_task_id                        protoreflect.FieldDescriptor
+	fd_TrainingCheckpoint_outer_step                     protoreflect.FieldDescriptor
+	fd_TrainingCheckpoint_content_hash                   protoreflect.FieldDescriptor
+	fd_TrainingCheckpoint_storage_uri                    protoreflect.FieldDescriptor
+	fd_TrainingCheckpoint_signatures                     protoreflect.FieldDescriptor
+	fd_TrainingCheckpoint_created_at_block_height        protoreflect.FieldDescriptor
+	fd_TrainingCheckpoint_quorum_reached_at_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_training_task_proto_init()
+	md_TrainingCheckpoint = File_inference_inference_training_task_proto.Messages().ByName("TrainingCheckpoint")
+	fd_TrainingCheckpoint_task_id = md_TrainingCheckpoint.Fields().ByName("task_id")
+	fd_TrainingCheckpoint_outer_step = md_TrainingCheckpoint.Fields().ByName("outer_step")
+	fd_TrainingCheckpoint_content_hash = md_TrainingCheckpoint.Fields().ByName("content_hash")
+	fd_TrainingCheckpoint_storage_uri = md_TrainingCheckpoint.Fields().ByName("storage_uri")
+	fd_TrainingCheckpoint_signatures = md_TrainingCheckpoint.Fields().ByName("signatures")
+	fd_TrainingCheckpoint_created_at_block_height = md_TrainingCheckpoint.Fields().ByName("created_at_block_height")
+	fd_TrainingCheckpoint_quorum_reached_at_block_height = md_TrainingCheckpoint.Fields().ByName("quorum_reached_at_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrainingCheckpoint)(nil)
+
+type fastReflection_TrainingCheckpoint TrainingCheckpoint
+
+func (x *TrainingCheckpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrainingCheckpoint)(x)
+}
+
+func (x *TrainingCheckpoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_training_task_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrainingCheckpoint_messageType fastReflection_TrainingCheckpoint_messageType
+var _ protoreflect.MessageType = fastReflection_TrainingCheckpoint_messageType{}
+
+type fastReflection_TrainingCheckpoint_messageType struct{}
+
+func (x fastReflection_TrainingCheckpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrainingCheckpoint)(nil)
+}
+func (x fastReflection_TrainingCheckpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrainingCheckpoint)
+}
+func (x fastReflection_TrainingCheckpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrainingCheckpoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrainingCheckpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrainingCheckpoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrainingCheckpoint) Type() protoreflect.MessageType {
+	return _fastReflection_TrainingCheckpoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TrainingCheckpoint) New() protoreflect.Message {
+	return new(fastReflection_TrainingCheckpoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TrainingCheckpoint) Interface() protoreflect.ProtoMessage {
+	return (*TrainingCheckpoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrainingCheckpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TaskId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TaskId)
+		if !f(fd_TrainingCheckpoint_task_id, value) {
+			return
+		}
+	}
+	if x.OuterStep != int32(0) {
+		value := protoreflect.ValueOfInt32(x.OuterStep)
+		if !f(fd_TrainingCheckpoint_outer_step, value) {
+			return
+		}
+	}
+	if x.ContentHash != "" {
+		value := protoreflect.ValueOfString(x.ContentHash)
+		if !f(fd_TrainingCheckpoint_content_hash, value) {
+			return
+		}
+	}
+	if x.StorageUri != "" {
+		value := protoreflect.ValueOfString(x.StorageUri)
+		if !f(fd_TrainingCheckpoint_storage_uri, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_TrainingCheckpoint_5_list{list: &x.Signatures})
+		if !f(fd_TrainingCheckpoint_signatures, value) {
+			return
+		}
+	}
+	if x.CreatedAtBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAtBlockHeight)
+		if !f(fd_TrainingCheckpoint_created_at_block_height, value) {
+			return
+		}
+	}
+	if x.QuorumReachedAtBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.QuorumReachedAtBlockHeight)
+		if !f(fd_TrainingCheckpoint_quorum_reached_at_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrainingCheckpoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpoint.task_id":
+		return x.TaskId != uint64(0)
+	case "inference.inference.TrainingCheckpoint.outer_step":
+		return x.OuterStep != int32(0)
+	case "inference.inference.TrainingCheckpoint.content_hash":
+		return x.ContentHash != ""
+	case "inference.inference.TrainingCheckpoint.storage_uri":
+		return x.StorageUri != ""
+	case "inference.inference.TrainingCheckpoint.signatures":
+		return len(x.Signatures) != 0
+	case "inference.inference.TrainingCheckpoint.created_at_block_height":
+		return x.CreatedAtBlockHeight != int64(0)
+	case "inference.inference.TrainingCheckpoint.quorum_reached_at_block_height":
+		return x.QuorumReachedAtBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpoint"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpoint.task_id":
+		x.TaskId = uint64(0)
+	case "inference.inference.TrainingCheckpoint.outer_step":
+		x.OuterStep = int32(0)
+	case "inference.inference.TrainingCheckpoint.content_hash":
+		x.ContentHash = ""
+	case "inference.inference.TrainingCheckpoint.storage_uri":
+		x.StorageUri = ""
+	case "inference.inference.TrainingCheckpoint.signatures":
+		x.Signatures = nil
+	case "inference.inference.TrainingCheckpoint.created_at_block_height":
+		x.CreatedAtBlockHeight = int64(0)
+	case "inference.inference.TrainingCheckpoint.quorum_reached_at_block_height":
+		x.QuorumReachedAtBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpoint"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrainingCheckpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.TrainingCheckpoint.task_id":
+		value := x.TaskId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingCheckpoint.outer_step":
+		value := x.OuterStep
+		return protoreflect.ValueOfInt32(value)
+	case "inference.inference.TrainingCheckpoint.content_hash":
+		value := x.ContentHash
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.TrainingCheckpoint.storage_uri":
+		value := x.StorageUri
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.TrainingCheckpoint.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_TrainingCheckpoint_5_list{})
+		}
+		listValue := &_TrainingCheckpoint_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.TrainingCheckpoint.created_at_block_height":
+		value := x.CreatedAtBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.TrainingCheckpoint.quorum_reached_at_block_height":
+		value := x.QuorumReachedAtBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpoint"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpoint.task_id":
+		x.TaskId = value.Uint()
+	case "inference.inference.TrainingCheckpoint.outer_step":
+		x.OuterStep = int32(value.Int())
+	case "inference.inference.TrainingCheckpoint.content_hash":
+		x.ContentHash = value.Interface().(string)
+	case "inference.inference.TrainingCheckpoint.storage_uri":
+		x.StorageUri = value.Interface().(string)
+	case "inference.inference.TrainingCheckpoint.signatures":
+		lv := value.List()
+		clv := lv.(*_TrainingCheckpoint_5_list)
+		x.Signatures = *clv.list
+	case "inference.inference.TrainingCheckpoint.created_at_block_height":
+		x.CreatedAtBlockHeight = value.Int()
+	case "inference.inference.TrainingCheckpoint.quorum_reached_at_block_height":
+		x.QuorumReachedAtBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpoint"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpoint.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*TrainingCheckpointSignature{}
+		}
+		value := &_TrainingCheckpoint_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.TrainingCheckpoint.task_id":
+		panic(fmt.Errorf("field task_id of message inference.inference.TrainingCheckpoint is not mutable"))
+	case "inference.inference.TrainingCheckpoint.outer_step":
+		panic(fmt.Errorf("field outer_step of message inference.inference.TrainingCheckpoint is not mutable"))
+	case "inference.inference.TrainingCheckpoint.content_hash":
+		panic(fmt.Errorf("field content_hash of message inference.inference.TrainingCheckpoint is not mutable"))
+	case "inference.inference.TrainingCheckpoint.storage_uri":
+		panic(fmt.Errorf("field storage_uri of message inference.inference.TrainingCheckpoint is not mutable"))
+	case "inference.inference.TrainingCheckpoint.created_at_block_height":
+		panic(fmt.Errorf("field created_at_block_height of message inference.inference.TrainingCheckpoint is not mutable"))
+	case "inference.inference.TrainingCheckpoint.quorum_reached_at_block_height":
+		panic(fmt.Errorf("field quorum_reached_at_block_height of message inference.inference.TrainingCheckpoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpoint"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrainingCheckpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpoint.task_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingCheckpoint.outer_step":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "inference.inference.TrainingCheckpoint.content_hash":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.TrainingCheckpoint.storage_uri":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.TrainingCheckpoint.signatures":
+		list := []*TrainingCheckpointSignature{}
+		return protoreflect.ValueOfList(&_TrainingCheckpoint_5_list{list: &list})
+	case "inference.inference.TrainingCheckpoint.created_at_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.TrainingCheckpoint.quorum_reached_at_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpoint"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrainingCheckpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.TrainingCheckpoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrainingCheckpoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrainingCheckpoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrainingCheckpoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrainingCheckpoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TaskId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TaskId))
+		}
+		if x.OuterStep != 0 {
+			n += 1 + runtime.Sov(uint64(x.OuterStep))
+		}
+		l = len(x.ContentHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StorageUri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAtBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAtBlockHeight))
+		}
+		if x.QuorumReachedAtBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.QuorumReachedAtBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrainingCheckpoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.QuorumReachedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QuorumReachedAtBlockHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.CreatedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAtBlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.StorageUri) > 0 {
+			i -= len(x.StorageUri)
+			copy(dAtA[i:], x.StorageUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageUri)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ContentHash) > 0 {
+			i -= len(x.ContentHash)
+			copy(dAtA[i:], x.ContentHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContentHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OuterStep != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OuterStep))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TaskId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TaskId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrainingCheckpoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrainingCheckpoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrainingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				x.TaskId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TaskId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OuterStep", wireType)
+				}
+				x.OuterStep = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OuterStep |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContentHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageUri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageUri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &TrainingCheckpointSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAtBlockHeight", wireType)
+				}
+				x.CreatedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAtBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuorumReachedAtBlockHeight", wireType)
+				}
+				x.QuorumReachedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QuorumReachedAtBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TrainingCheckpointSignature_2_list)(nil)
+
+type _TrainingCheckpointSignature_2_list struct {
+	list *[]string
+}
+
+func (x *_TrainingCheckpointSignature_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TrainingCheckpointSignature_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TrainingCheckpointSignature_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TrainingCheckpointSignature_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TrainingCheckpointSignature_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TrainingCheckpointSignature at list field NodeIds as it is not of Message kind"))
+}
+
+func (x *_TrainingCheckpointSignature_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TrainingCheckpointSignature_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TrainingCheckpointSignature_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TrainingCheckpointSignature              protoreflect.MessageDescriptor
+	fd_TrainingCheckpointSignature_participant  protoreflect.FieldDescriptor
+	fd_TrainingCheckpointSignature_node_ids     protoreflect.FieldDescriptor
+	fd_TrainingCheckpointSignature_block_height protoreflect.FieldDescriptor
+	fd_TrainingCheckpointSignature_content_hash protoreflect.FieldDescriptor
+	fd_TrainingCheckpointSignature_storage_uri  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_training_task_proto_init()
+	md_TrainingCheckpointSignature = File_inference_inference_training_task_proto.Messages().ByName("TrainingCheckpointSignature")
+	fd_TrainingCheckpointSignature_participant = md_TrainingCheckpointSignature.Fields().ByName("participant")
+	fd_TrainingCheckpointSignature_node_ids = md_TrainingCheckpointSignature.Fields().ByName("node_ids")
+	fd_TrainingCheckpointSignature_block_height = md_TrainingCheckpointSignature.Fields().ByName("block_height")
+	fd_TrainingCheckpointSignature_content_hash = md_TrainingCheckpointSignature.Fields().ByName("content_hash")
+	fd_TrainingCheckpointSignature_storage_uri = md_TrainingCheckpointSignature.Fields().ByName("storage_uri")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrainingCheckpointSignature)(nil)
+
+type fastReflection_TrainingCheckpointSignature TrainingCheckpointSignature
+
+func (x *TrainingCheckpointSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrainingCheckpointSignature)(x)
+}
+
+func (x *TrainingCheckpointSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_training_task_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrainingCheckpointSignature_messageType fastReflection_TrainingCheckpointSignature_messageType
+var _ protoreflect.MessageType = fastReflection_TrainingCheckpointSignature_messageType{}
+
+type fastReflection_TrainingCheckpointSignature_messageType struct{}
+
+func (x fastReflection_TrainingCheckpointSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrainingCheckpointSignature)(nil)
+}
+func (x fastReflection_TrainingCheckpointSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrainingCheckpointSignature)
+}
+func (x fastReflection_TrainingCheckpointSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrainingCheckpointSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrainingCheckpointSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrainingCheckpointSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrainingCheckpointSignature) Type() protoreflect.MessageType {
+	return _fastReflection_TrainingCheckpointSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TrainingCheckpointSignature) New() protoreflect.Message {
+	return new(fastReflection_TrainingCheckpointSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TrainingCheckpointSignature) Interface() protoreflect.ProtoMessage {
+	return (*TrainingCheckpointSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrainingCheckpointSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Participant != "" {
+		value := protoreflect.ValueOfString(x.Participant)
+		if !f(fd_TrainingCheckpointSignature_participant, value) {
+			return
+		}
+	}
+	if len(x.NodeIds) != 0 {
+		value := protoreflect.ValueOfList(&_TrainingCheckpointSignature_2_list{list: &x.NodeIds})
+		if !f(fd_TrainingCheckpointSignature_node_ids, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_TrainingCheckpointSignature_block_height, value) {
+			return
+		}
+	}
+	if x.ContentHash != "" {
+		value := protoreflect.ValueOfString(x.ContentHash)
+		if !f(fd_TrainingCheckpointSignature_content_hash, value) {
+			return
+		}
+	}
+	if x.StorageUri != "" {
+		value := protoreflect.ValueOfString(x.StorageUri)
+		if !f(fd_TrainingCheckpointSignature_storage_uri, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrainingCheckpointSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpointSignature.participant":
+		return x.Participant != ""
+	case "inference.inference.TrainingCheckpointSignature.node_ids":
+		return len(x.NodeIds) != 0
+	case "inference.inference.TrainingCheckpointSignature.block_height":
+		return x.BlockHeight != int64(0)
+	case "inference.inference.TrainingCheckpointSignature.content_hash":
+		return x.ContentHash != ""
+	case "inference.inference.TrainingCheckpointSignature.storage_uri":
+		return x.StorageUri != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpointSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpointSignature.participant":
+		x.Participant = ""
+	case "inference.inference.TrainingCheckpointSignature.node_ids":
+		x.NodeIds = nil
+	case "inference.inference.TrainingCheckpointSignature.block_height":
+		x.BlockHeight = int64(0)
+	case "inference.inference.TrainingCheckpointSignature.content_hash":
+		x.ContentHash = ""
+	case "inference.inference.TrainingCheckpointSignature.storage_uri":
+		x.StorageUri = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrainingCheckpointSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.TrainingCheckpointSignature.participant":
+		value := x.Participant
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.TrainingCheckpointSignature.node_ids":
+		if len(x.NodeIds) == 0 {
+			return protoreflect.ValueOfList(&_TrainingCheckpointSignature_2_list{})
+		}
+		listValue := &_TrainingCheckpointSignature_2_list{list: &x.NodeIds}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.TrainingCheckpointSignature.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.TrainingCheckpointSignature.content_hash":
+		value := x.ContentHash
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.TrainingCheckpointSignature.storage_uri":
+		value := x.StorageUri
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpointSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpointSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpointSignature.participant":
+		x.Participant = value.Interface().(string)
+	case "inference.inference.TrainingCheckpointSignature.node_ids":
+		lv := value.List()
+		clv := lv.(*_TrainingCheckpointSignature_2_list)
+		x.NodeIds = *clv.list
+	case "inference.inference.TrainingCheckpointSignature.block_height":
+		x.BlockHeight = value.Int()
+	case "inference.inference.TrainingCheckpointSignature.content_hash":
+		x.ContentHash = value.Interface().(string)
+	case "inference.inference.TrainingCheckpointSignature.storage_uri":
+		x.StorageUri = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpointSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpointSignature.node_ids":
+		if x.NodeIds == nil {
+			x.NodeIds = []string{}
+		}
+		value := &_TrainingCheckpointSignature_2_list{list: &x.NodeIds}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.TrainingCheckpointSignature.participant":
+		panic(fmt.Errorf("field participant of message inference.inference.TrainingCheckpointSignature is not mutable"))
+	case "inference.inference.TrainingCheckpointSignature.block_height":
+		panic(fmt.Errorf("field block_height of message inference.inference.TrainingCheckpointSignature is not mutable"))
+	case "inference.inference.TrainingCheckpointSignature.content_hash":
+		panic(fmt.Errorf("field content_hash of message inference.inference.TrainingCheckpointSignature is not mutable"))
+	case "inference.inference.TrainingCheckpointSignature.storage_uri":
+		panic(fmt.Errorf("field storage_uri of message inference.inference.TrainingCheckpointSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrainingCheckpointSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TrainingCheckpointSignature.participant":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.TrainingCheckpointSignature.node_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TrainingCheckpointSignature_2_list{list: &list})
+	case "inference.inference.TrainingCheckpointSignature.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.TrainingCheckpointSignature.content_hash":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.TrainingCheckpointSignature.storage_uri":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrainingCheckpointSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.TrainingCheckpointSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrainingCheckpointSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingCheckpointSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrainingCheckpointSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrainingCheckpointSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrainingCheckpointSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Participant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.NodeIds) > 0 {
+			for _, s := range x.NodeIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.ContentHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StorageUri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrainingCheckpointSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.StorageUri) > 0 {
+			i -= len(x.StorageUri)
+			copy(dAtA[i:], x.StorageUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageUri)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ContentHash) > 0 {
+			i -= len(x.ContentHash)
+			copy(dAtA[i:], x.ContentHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContentHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.NodeIds) > 0 {
+			for iNdEx := len(x.NodeIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NodeIds[iNdEx])
+				copy(dAtA[i:], x.NodeIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NodeIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Participant) > 0 {
+			i -= len(x.Participant)
+			copy(dAtA[i:], x.Participant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participant)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrainingCheckpointSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrainingCheckpointSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrainingCheckpointSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NodeIds = append(x.NodeIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var msglen int
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1043,33 +3088,16 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.BlockHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Config == nil {
-					x.Config = &TrainingConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 10:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1079,31 +3107,29 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Assignees = append(x.Assignees, &TrainingTaskAssignee{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Assignees[len(x.Assignees)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ContentHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageUri", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1113,27 +3139,23 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Epoch == nil {
-					x.Epoch = &EpochInfo{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Epoch); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.StorageUri = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1192,7 +3214,7 @@ func (x *TrainingHardwareResources) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingHardwareResources) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[1]
+	mi := &file_inference_inference_training_task_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +3682,7 @@ func (x *TrainingConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[2]
+	mi := &file_inference_inference_training_task_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,7 +4165,7 @@ func (x *TrainingDatasets) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingDatasets) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[3]
+	mi := &file_inference_inference_training_task_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2673,7 +4695,7 @@ func (x *TrainingTaskAssignee) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingTaskAssignee) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[4]
+	mi := &file_inference_inference_training_task_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3175,7 +5197,7 @@ func (x *EpochInfo) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EpochInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[5]
+	mi := &file_inference_inference_training_task_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3732,6 +5754,17 @@ type TrainingTask struct {
 	Config                         *TrainingConfig              `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
 	Assignees                      []*TrainingTaskAssignee      `protobuf:"bytes,10,rep,name=assignees,proto3" json:"assignees,omitempty"`
 	Epoch                          *EpochInfo                   `protobuf:"bytes,11,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Budget                         uint64                       `protobuf:"varint,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	OuterStepPayout                uint64                       `protobuf:"varint,13,opt,name=outer_step_payout,json=outerStepPayout,proto3" json:"outer_step_payout,omitempty"`
+	PaidOut                        uint64                       `protobuf:"varint,14,opt,name=paid_out,json=paidOut,proto3" json:"paid_out,omitempty"`
+	Refunded                       uint64                       `protobuf:"varint,15,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// Outer steps before this one have been paid for
+	NextPayableOuterStep int32 `protobuf:"varint,16,opt,name=next_payable_outer_step,json=nextPayableOuterStep,proto3" json:"next_payable_outer_step,omitempty"`
+	Cancelled            bool  `protobuf:"varint,17,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Hardware of the assigned nodes that stopped sending heartbeats, waiting to be assigned to other nodes
+	ReplacementResources []*TrainingHardwareResources `protobuf:"bytes,18,rep,name=replacement_resources,json=replacementResources,proto3" json:"replacement_resources,omitempty"`
+	// Height the latest replacement nodes were assigned at
+	NodesReplacedAtBlockHeight uint64 `protobuf:"varint,19,opt,name=nodes_replaced_at_block_height,json=nodesReplacedAtBlockHeight,proto3" json:"nodes_replaced_at_block_height,omitempty"`
 }
 
 func (x *TrainingTask) Reset() {
@@ -3831,6 +5864,218 @@ func (x *TrainingTask) GetEpoch() *EpochInfo {
 	return nil
 }
 
+func (x *TrainingTask) GetBudget() uint64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *TrainingTask) GetOuterStepPayout() uint64 {
+	if x != nil {
+		return x.OuterStepPayout
+	}
+	return 0
+}
+
+func (x *TrainingTask) GetPaidOut() uint64 {
+	if x != nil {
+		return x.PaidOut
+	}
+	return 0
+}
+
+func (x *TrainingTask) GetRefunded() uint64 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *TrainingTask) GetNextPayableOuterStep() int32 {
+	if x != nil {
+		return x.NextPayableOuterStep
+	}
+	return 0
+}
+
+func (x *TrainingTask) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *TrainingTask) GetReplacementResources() []*TrainingHardwareResources {
+	if x != nil {
+		return x.ReplacementResources
+	}
+	return nil
+}
+
+func (x *TrainingTask) GetNodesReplacedAtBlockHeight() uint64 {
+	if x != nil {
+		return x.NodesReplacedAtBlockHeight
+	}
+	return 0
+}
+
+// A model checkpoint produced at the end of an outer step, accepted once a quorum of assignees signed
+// the same content hash
+type TrainingCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId    uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OuterStep int32  `protobuf:"varint,2,opt,name=outer_step,json=outerStep,proto3" json:"outer_step,omitempty"`
+	// The accepted content hash and where to fetch it, empty until the quorum is reached
+	ContentHash          string                         `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	StorageUri           string                         `protobuf:"bytes,4,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+	Signatures           []*TrainingCheckpointSignature `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	CreatedAtBlockHeight int64                          `protobuf:"varint,6,opt,name=created_at_block_height,json=createdAtBlockHeight,proto3" json:"created_at_block_height,omitempty"`
+	// Zero until more than half of the assignees signed the same content hash
+	QuorumReachedAtBlockHeight int64 `protobuf:"varint,7,opt,name=quorum_reached_at_block_height,json=quorumReachedAtBlockHeight,proto3" json:"quorum_reached_at_block_height,omitempty"`
+}
+
+func (x *TrainingCheckpoint) Reset() {
+	*x = TrainingCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_training_task_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainingCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingCheckpoint) ProtoMessage() {}
+
+// Deprecated: Use TrainingCheckpoint.ProtoReflect.Descriptor instead.
+func (*TrainingCheckpoint) Descriptor() ([]byte, []int) {
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrainingCheckpoint) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TrainingCheckpoint) GetOuterStep() int32 {
+	if x != nil {
+		return x.OuterStep
+	}
+	return 0
+}
+
+func (x *TrainingCheckpoint) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *TrainingCheckpoint) GetStorageUri() string {
+	if x != nil {
+		return x.StorageUri
+	}
+	return ""
+}
+
+func (x *TrainingCheckpoint) GetSignatures() []*TrainingCheckpointSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *TrainingCheckpoint) GetCreatedAtBlockHeight() int64 {
+	if x != nil {
+		return x.CreatedAtBlockHeight
+	}
+	return 0
+}
+
+func (x *TrainingCheckpoint) GetQuorumReachedAtBlockHeight() int64 {
+	if x != nil {
+		return x.QuorumReachedAtBlockHeight
+	}
+	return 0
+}
+
+type TrainingCheckpointSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// Nodes of the participant that produced the checkpoint
+	NodeIds     []string `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	BlockHeight int64    `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The checkpoint the participant signed
+	ContentHash string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	StorageUri  string `protobuf:"bytes,5,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+}
+
+func (x *TrainingCheckpointSignature) Reset() {
+	*x = TrainingCheckpointSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_training_task_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainingCheckpointSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingCheckpointSignature) ProtoMessage() {}
+
+// Deprecated: Use TrainingCheckpointSignature.ProtoReflect.Descriptor instead.
+func (*TrainingCheckpointSignature) Descriptor() ([]byte, []int) {
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TrainingCheckpointSignature) GetParticipant() string {
+	if x != nil {
+		return x.Participant
+	}
+	return ""
+}
+
+func (x *TrainingCheckpointSignature) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *TrainingCheckpointSignature) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *TrainingCheckpointSignature) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *TrainingCheckpointSignature) GetStorageUri() string {
+	if x != nil {
+		return x.StorageUri
+	}
+	return ""
+}
+
 type TrainingHardwareResources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3843,7 +6088,7 @@ type TrainingHardwareResources struct {
 func (x *TrainingHardwareResources) Reset() {
 	*x = TrainingHardwareResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[1]
+		mi := &file_inference_inference_training_task_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3857,7 +6102,7 @@ func (*TrainingHardwareResources) ProtoMessage() {}
 
 // Deprecated: Use TrainingHardwareResources.ProtoReflect.Descriptor instead.
 func (*TrainingHardwareResources) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{1}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TrainingHardwareResources) GetType_() string {
@@ -3886,7 +6131,7 @@ type TrainingConfig struct {
 func (x *TrainingConfig) Reset() {
 	*x = TrainingConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[2]
+		mi := &file_inference_inference_training_task_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3900,7 +6145,7 @@ func (*TrainingConfig) ProtoMessage() {}
 
 // Deprecated: Use TrainingConfig.ProtoReflect.Descriptor instead.
 func (*TrainingConfig) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{2}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TrainingConfig) GetDatasets() *TrainingDatasets {
@@ -3929,7 +6174,7 @@ type TrainingDatasets struct {
 func (x *TrainingDatasets) Reset() {
 	*x = TrainingDatasets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[3]
+		mi := &file_inference_inference_training_task_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3943,7 +6188,7 @@ func (*TrainingDatasets) ProtoMessage() {}
 
 // Deprecated: Use TrainingDatasets.ProtoReflect.Descriptor instead.
 func (*TrainingDatasets) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{3}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TrainingDatasets) GetTrain() string {
@@ -3972,7 +6217,7 @@ type TrainingTaskAssignee struct {
 func (x *TrainingTaskAssignee) Reset() {
 	*x = TrainingTaskAssignee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[4]
+		mi := &file_inference_inference_training_task_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3986,7 +6231,7 @@ func (*TrainingTaskAssignee) ProtoMessage() {}
 
 // Deprecated: Use TrainingTaskAssignee.ProtoReflect.Descriptor instead.
 func (*TrainingTaskAssignee) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{4}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TrainingTaskAssignee) GetParticipant() string {
@@ -4017,7 +6262,7 @@ type EpochInfo struct {
 func (x *EpochInfo) Reset() {
 	*x = EpochInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[5]
+		mi := &file_inference_inference_training_task_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4031,7 +6276,7 @@ func (*EpochInfo) ProtoMessage() {}
 
 // Deprecated: Use EpochInfo.ProtoReflect.Descriptor instead.
 func (*EpochInfo) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{5}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EpochInfo) GetLastEpoch() int32 {
@@ -4068,8 +6313,8 @@ var file_inference_inference_training_task_proto_rawDesc = []byte{
 	0x0a, 0x27, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74,
 	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xee,
-	0x04, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xe7,
+	0x07, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
@@ -4107,55 +6352,113 @@ var file_inference_inference_training_task_proto_rawDesc = []byte{
 	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x70, 0x6f,
 	0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
-	0x45, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x64, 0x77,
-	0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x69, 0x6e,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x73, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x18,
-	0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6f, 0x63, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15,
-	0x6e, 0x75, 0x6d, 0x55, 0x6f, 0x63, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x65, 0x70, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54,
-	0x61, 0x73, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x09, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x33,
-	0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x73, 0x5f,
-	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
-	0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x42, 0xbf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42,
-	0x11, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58,
-	0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x69, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x63, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x14,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x1e, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x50, 0x0a, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x35, 0x0a,
+	0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x1e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x1b, 0x54, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x22, 0x45, 0x0a, 0x19,
+	0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d,
+	0x5f, 0x75, 0x6f, 0x63, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6e, 0x75, 0x6d,
+	0x55, 0x6f, 0x63, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74,
+	0x22, 0x53, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x35, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x33, 0x0a, 0x16, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x42, 0xbf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4170,26 +6473,30 @@ func file_inference_inference_training_task_proto_rawDescGZIP() []byte {
 	return file_inference_inference_training_task_proto_rawDescData
 }
 
-var file_inference_inference_training_task_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_inference_inference_training_task_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_inference_inference_training_task_proto_goTypes = []interface{}{
-	(*TrainingTask)(nil),              // 0: inference.inference.TrainingTask
-	(*TrainingHardwareResources)(nil), // 1: inference.inference.TrainingHardwareResources
-	(*TrainingConfig)(nil),            // 2: inference.inference.TrainingConfig
-	(*TrainingDatasets)(nil),          // 3: inference.inference.TrainingDatasets
-	(*TrainingTaskAssignee)(nil),      // 4: inference.inference.TrainingTaskAssignee
-	(*EpochInfo)(nil),                 // 5: inference.inference.EpochInfo
+	(*TrainingTask)(nil),                // 0: inference.inference.TrainingTask
+	(*TrainingCheckpoint)(nil),          // 1: inference.inference.TrainingCheckpoint
+	(*TrainingCheckpointSignature)(nil), // 2: inference.inference.TrainingCheckpointSignature
+	(*TrainingHardwareResources)(nil),   // 3: inference.inference.TrainingHardwareResources
+	(*TrainingConfig)(nil),              // 4: inference.inference.TrainingConfig
+	(*TrainingDatasets)(nil),            // 5: inference.inference.TrainingDatasets
+	(*TrainingTaskAssignee)(nil),        // 6: inference.inference.TrainingTaskAssignee
+	(*EpochInfo)(nil),                   // 7: inference.inference.EpochInfo
 }
 var file_inference_inference_training_task_proto_depIdxs = []int32{
-	1, // 0: inference.inference.TrainingTask.hardware_resources:type_name -> inference.inference.TrainingHardwareResources
-	2, // 1: inference.inference.TrainingTask.config:type_name -> inference.inference.TrainingConfig
-	4, // 2: inference.inference.TrainingTask.assignees:type_name -> inference.inference.TrainingTaskAssignee
-	5, // 3: inference.inference.TrainingTask.epoch:type_name -> inference.inference.EpochInfo
-	3, // 4: inference.inference.TrainingConfig.datasets:type_name -> inference.inference.TrainingDatasets
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	3, // 0: inference.inference.TrainingTask.hardware_resources:type_name -> inference.inference.TrainingHardwareResources
+	4, // 1: inference.inference.TrainingTask.config:type_name -> inference.inference.TrainingConfig
+	6, // 2: inference.inference.TrainingTask.assignees:type_name -> inference.inference.TrainingTaskAssignee
+	7, // 3: inference.inference.TrainingTask.epoch:type_name -> inference.inference.EpochInfo
+	3, // 4: inference.inference.TrainingTask.replacement_resources:type_name -> inference.inference.TrainingHardwareResources
+	2, // 5: inference.inference.TrainingCheckpoint.signatures:type_name -> inference.inference.TrainingCheckpointSignature
+	5, // 6: inference.inference.TrainingConfig.datasets:type_name -> inference.inference.TrainingDatasets
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_training_task_proto_init() }
@@ -4211,7 +6518,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingHardwareResources); i {
+			switch v := v.(*TrainingCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4223,7 +6530,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingConfig); i {
+			switch v := v.(*TrainingCheckpointSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4235,7 +6542,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingDatasets); i {
+			switch v := v.(*TrainingHardwareResources); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4247,7 +6554,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingTaskAssignee); i {
+			switch v := v.(*TrainingConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4259,6 +6566,30 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainingDatasets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_training_task_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainingTaskAssignee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_training_task_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpochInfo); i {
 			case 0:
 				return &v.state
@@ -4277,7 +6608,7 @@ func file_inference_inference_training_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_training_task_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,6 +45,17 @@ const (
 	Msg_JoinTrainingStatus_FullMethodName               = "/inference.inference.Msg/JoinTrainingStatus"
 	Msg_CreateDummyTrainingTask_FullMethodName          = "/inference.inference.Msg/CreateDummyTrainingTask"
 	Msg_BridgeExchange_FullMethodName                   = "/inference.inference.Msg/BridgeExchange"
+	Msg_AbandonInference_FullMethodName                 = "/inference.inference.Msg/AbandonInference"
+	Msg_BatchClaimRewards_FullMethodName                = "/inference.inference.Msg/BatchClaimRewards"
+	Msg_BridgeWithdraw_FullMethodName                   = "/inference.inference.Msg/BridgeWithdraw"
+	Msg_AttestBridgeBlock_FullMethodName                = "/inference.inference.Msg/AttestBridgeBlock"
+	Msg_BridgeDepositProof_FullMethodName               = "/inference.inference.Msg/BridgeDepositProof"
+	Msg_CreateDeveloperSubKey_FullMethodName            = "/inference.inference.Msg/CreateDeveloperSubKey"
+	Msg_RevokeDeveloperSubKey_FullMethodName            = "/inference.inference.Msg/RevokeDeveloperSubKey"
+	Msg_FinishTrainingTask_FullMethodName               = "/inference.inference.Msg/FinishTrainingTask"
+	Msg_CancelTrainingTask_FullMethodName               = "/inference.inference.Msg/CancelTrainingTask"
+	Msg_SubmitTrainingCheckpoint_FullMethodName         = "/inference.inference.Msg/SubmitTrainingCheckpoint"
+	Msg_UpdateParticipantRegion_FullMethodName          = "/inference.inference.Msg/UpdateParticipantRegion"
 )
 
 // MsgClient is the client API for Msg service.
@@ -79,6 +90,17 @@ type MsgClient interface {
 	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
 	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
 	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
+	AbandonInference(ctx context.Context, in *MsgAbandonInference, opts ...grpc.CallOption) (*MsgAbandonInferenceResponse, error)
+	BatchClaimRewards(ctx context.Context, in *MsgBatchClaimRewards, opts ...grpc.CallOption) (*MsgBatchClaimRewardsResponse, error)
+	BridgeWithdraw(ctx context.Context, in *MsgBridgeWithdraw, opts ...grpc.CallOption) (*MsgBridgeWithdrawResponse, error)
+	AttestBridgeBlock(ctx context.Context, in *MsgAttestBridgeBlock, opts ...grpc.CallOption) (*MsgAttestBridgeBlockResponse, error)
+	BridgeDepositProof(ctx context.Context, in *MsgBridgeDepositProof, opts ...grpc.CallOption) (*MsgBridgeDepositProofResponse, error)
+	CreateDeveloperSubKey(ctx context.Context, in *MsgCreateDeveloperSubKey, opts ...grpc.CallOption) (*MsgCreateDeveloperSubKeyResponse, error)
+	RevokeDeveloperSubKey(ctx context.Context, in *MsgRevokeDeveloperSubKey, opts ...grpc.CallOption) (*MsgRevokeDeveloperSubKeyResponse, error)
+	FinishTrainingTask(ctx context.Context, in *MsgFinishTrainingTask, opts ...grpc.CallOption) (*MsgFinishTrainingTaskResponse, error)
+	CancelTrainingTask(ctx context.Context, in *MsgCancelTrainingTask, opts ...grpc.CallOption) (*MsgCancelTrainingTaskResponse, error)
+	SubmitTrainingCheckpoint(ctx context.Context, in *MsgSubmitTrainingCheckpoint, opts ...grpc.CallOption) (*MsgSubmitTrainingCheckpointResponse, error)
+	UpdateParticipantRegion(ctx context.Context, in *MsgUpdateParticipantRegion, opts ...grpc.CallOption) (*MsgUpdateParticipantRegionResponse, error)
 }
 
 type msgClient struct {
@@ -323,6 +345,105 @@ func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, o
 	return out, nil
 }
 
+func (c *msgClient) AbandonInference(ctx context.Context, in *MsgAbandonInference, opts ...grpc.CallOption) (*MsgAbandonInferenceResponse, error) {
+	out := new(MsgAbandonInferenceResponse)
+	err := c.cc.Invoke(ctx, Msg_AbandonInference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchClaimRewards(ctx context.Context, in *MsgBatchClaimRewards, opts ...grpc.CallOption) (*MsgBatchClaimRewardsResponse, error) {
+	out := new(MsgBatchClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchClaimRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BridgeWithdraw(ctx context.Context, in *MsgBridgeWithdraw, opts ...grpc.CallOption) (*MsgBridgeWithdrawResponse, error) {
+	out := new(MsgBridgeWithdrawResponse)
+	err := c.cc.Invoke(ctx, Msg_BridgeWithdraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestBridgeBlock(ctx context.Context, in *MsgAttestBridgeBlock, opts ...grpc.CallOption) (*MsgAttestBridgeBlockResponse, error) {
+	out := new(MsgAttestBridgeBlockResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestBridgeBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BridgeDepositProof(ctx context.Context, in *MsgBridgeDepositProof, opts ...grpc.CallOption) (*MsgBridgeDepositProofResponse, error) {
+	out := new(MsgBridgeDepositProofResponse)
+	err := c.cc.Invoke(ctx, Msg_BridgeDepositProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDeveloperSubKey(ctx context.Context, in *MsgCreateDeveloperSubKey, opts ...grpc.CallOption) (*MsgCreateDeveloperSubKeyResponse, error) {
+	out := new(MsgCreateDeveloperSubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDeveloperSubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeDeveloperSubKey(ctx context.Context, in *MsgRevokeDeveloperSubKey, opts ...grpc.CallOption) (*MsgRevokeDeveloperSubKeyResponse, error) {
+	out := new(MsgRevokeDeveloperSubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeDeveloperSubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinishTrainingTask(ctx context.Context, in *MsgFinishTrainingTask, opts ...grpc.CallOption) (*MsgFinishTrainingTaskResponse, error) {
+	out := new(MsgFinishTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, Msg_FinishTrainingTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTrainingTask(ctx context.Context, in *MsgCancelTrainingTask, opts ...grpc.CallOption) (*MsgCancelTrainingTaskResponse, error) {
+	out := new(MsgCancelTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelTrainingTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitTrainingCheckpoint(ctx context.Context, in *MsgSubmitTrainingCheckpoint, opts ...grpc.CallOption) (*MsgSubmitTrainingCheckpointResponse, error) {
+	out := new(MsgSubmitTrainingCheckpointResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitTrainingCheckpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParticipantRegion(ctx context.Context, in *MsgUpdateParticipantRegion, opts ...grpc.CallOption) (*MsgUpdateParticipantRegionResponse, error) {
+	out := new(MsgUpdateParticipantRegionResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParticipantRegion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -355,6 +476,17 @@ type MsgServer interface {
 	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
 	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
 	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
+	AbandonInference(context.Context, *MsgAbandonInference) (*MsgAbandonInferenceResponse, error)
+	BatchClaimRewards(context.Context, *MsgBatchClaimRewards) (*MsgBatchClaimRewardsResponse, error)
+	BridgeWithdraw(context.Context, *MsgBridgeWithdraw) (*MsgBridgeWithdrawResponse, error)
+	AttestBridgeBlock(context.Context, *MsgAttestBridgeBlock) (*MsgAttestBridgeBlockResponse, error)
+	BridgeDepositProof(context.Context, *MsgBridgeDepositProof) (*MsgBridgeDepositProofResponse, error)
+	CreateDeveloperSubKey(context.Context, *MsgCreateDeveloperSubKey) (*MsgCreateDeveloperSubKeyResponse, error)
+	RevokeDeveloperSubKey(context.Context, *MsgRevokeDeveloperSubKey) (*MsgRevokeDeveloperSubKeyResponse, error)
+	FinishTrainingTask(context.Context, *MsgFinishTrainingTask) (*MsgFinishTrainingTaskResponse, error)
+	CancelTrainingTask(context.Context, *MsgCancelTrainingTask) (*MsgCancelTrainingTaskResponse, error)
+	SubmitTrainingCheckpoint(context.Context, *MsgSubmitTrainingCheckpoint) (*MsgSubmitTrainingCheckpointResponse, error)
+	UpdateParticipantRegion(context.Context, *MsgUpdateParticipantRegion) (*MsgUpdateParticipantRegionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -440,6 +572,39 @@ func (UnimplementedMsgServer) CreateDummyTrainingTask(context.Context, *MsgCreat
 func (UnimplementedMsgServer) BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
 }
+func (UnimplementedMsgServer) AbandonInference(context.Context, *MsgAbandonInference) (*MsgAbandonInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonInference not implemented")
+}
+func (UnimplementedMsgServer) BatchClaimRewards(context.Context, *MsgBatchClaimRewards) (*MsgBatchClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchClaimRewards not implemented")
+}
+func (UnimplementedMsgServer) BridgeWithdraw(context.Context, *MsgBridgeWithdraw) (*MsgBridgeWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeWithdraw not implemented")
+}
+func (UnimplementedMsgServer) AttestBridgeBlock(context.Context, *MsgAttestBridgeBlock) (*MsgAttestBridgeBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestBridgeBlock not implemented")
+}
+func (UnimplementedMsgServer) BridgeDepositProof(context.Context, *MsgBridgeDepositProof) (*MsgBridgeDepositProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeDepositProof not implemented")
+}
+func (UnimplementedMsgServer) CreateDeveloperSubKey(context.Context, *MsgCreateDeveloperSubKey) (*MsgCreateDeveloperSubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeveloperSubKey not implemented")
+}
+func (UnimplementedMsgServer) RevokeDeveloperSubKey(context.Context, *MsgRevokeDeveloperSubKey) (*MsgRevokeDeveloperSubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDeveloperSubKey not implemented")
+}
+func (UnimplementedMsgServer) FinishTrainingTask(context.Context, *MsgFinishTrainingTask) (*MsgFinishTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishTrainingTask not implemented")
+}
+func (UnimplementedMsgServer) CancelTrainingTask(context.Context, *MsgCancelTrainingTask) (*MsgCancelTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrainingTask not implemented")
+}
+func (UnimplementedMsgServer) SubmitTrainingCheckpoint(context.Context, *MsgSubmitTrainingCheckpoint) (*MsgSubmitTrainingCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingCheckpoint not implemented")
+}
+func (UnimplementedMsgServer) UpdateParticipantRegion(context.Context, *MsgUpdateParticipantRegion) (*MsgUpdateParticipantRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParticipantRegion not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -921,6 +1086,204 @@ func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AbandonInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAbandonInference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AbandonInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AbandonInference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AbandonInference(ctx, req.(*MsgAbandonInference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchClaimRewards(ctx, req.(*MsgBatchClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BridgeWithdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeWithdraw(ctx, req.(*MsgBridgeWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestBridgeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestBridgeBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestBridgeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestBridgeBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestBridgeBlock(ctx, req.(*MsgAttestBridgeBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeDepositProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeDepositProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeDepositProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BridgeDepositProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeDepositProof(ctx, req.(*MsgBridgeDepositProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDeveloperSubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDeveloperSubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDeveloperSubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDeveloperSubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDeveloperSubKey(ctx, req.(*MsgCreateDeveloperSubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeDeveloperSubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeDeveloperSubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeDeveloperSubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeDeveloperSubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeDeveloperSubKey(ctx, req.(*MsgRevokeDeveloperSubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinishTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinishTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinishTrainingTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishTrainingTask(ctx, req.(*MsgFinishTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelTrainingTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTrainingTask(ctx, req.(*MsgCancelTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitTrainingCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTrainingCheckpoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTrainingCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitTrainingCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTrainingCheckpoint(ctx, req.(*MsgSubmitTrainingCheckpoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParticipantRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParticipantRegion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParticipantRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParticipantRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParticipantRegion(ctx, req.(*MsgUpdateParticipantRegion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1032,6 +1395,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeExchange",
 			Handler:    _Msg_BridgeExchange_Handler,
 		},
+		{
+			MethodName: "AbandonInference",
+			Handler:    _Msg_AbandonInference_Handler,
+		},
+		{
+			MethodName: "BatchClaimRewards",
+			Handler:    _Msg_BatchClaimRewards_Handler,
+		},
+		{
+			MethodName: "BridgeWithdraw",
+			Handler:    _Msg_BridgeWithdraw_Handler,
+		},
+		{
+			MethodName: "AttestBridgeBlock",
+			Handler:    _Msg_AttestBridgeBlock_Handler,
+		},
+		{
+			MethodName: "BridgeDepositProof",
+			Handler:    _Msg_BridgeDepositProof_Handler,
+		},
+		{
+			MethodName: "CreateDeveloperSubKey",
+			Handler:    _Msg_CreateDeveloperSubKey_Handler,
+		},
+		{
+			MethodName: "RevokeDeveloperSubKey",
+			Handler:    _Msg_RevokeDeveloperSubKey_Handler,
+		},
+		{
+			MethodName: "FinishTrainingTask",
+			Handler:    _Msg_FinishTrainingTask_Handler,
+		},
+		{
+			MethodName: "CancelTrainingTask",
+			Handler:    _Msg_CancelTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitTrainingCheckpoint",
+			Handler:    _Msg_SubmitTrainingCheckpoint_Handler,
+		},
+		{
+			MethodName: "UpdateParticipantRegion",
+			Handler:    _Msg_UpdateParticipantRegion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/tx.proto",
@@ -81,6 +81,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	collateralmodulekeeper "github.com/productscience/inference/x/collateral/keeper"
+	collateralmoduletypes "github.com/productscience/inference/x/collateral/types"
 	inferencemodulekeeper "github.com/productscience/inference/x/inference/keeper"
 	inferencegenesis "github.com/productscience/inference/x/inference/module"
 	inferencetypes "github.com/productscience/inference/x/inference/types"
@@ -243,6 +244,8 @@ func New(
 				app.GetCapabilityScopedKeeper,
 				// Supply the logger
 				app.GetWasmKeeper,
+				// The collateral module looks up inference participants, which depend on it in turn
+				app.GetInferenceKeeper,
 				logger,
 
 				// ADVANCED CONFIGURATION
@@ -491,6 +494,11 @@ func (app *App) GetWasmKeeper() wasmkeeper.Keeper {
 	return app.WasmKeeper
 }
 
+// GetInferenceKeeper returns the inference keeper as the participant lookup of the collateral module.
+func (app *App) GetInferenceKeeper() collateralmoduletypes.InferenceKeeper {
+	return app.InferenceKeeper
+}
+
 // GetCapabilityScopedKeeper returns the capability scoped keeper.
 func (app *App) GetCapabilityScopedKeeper(moduleName string) capabilitykeeper.ScopedKeeper {
 	return app.CapabilityKeeper.ScopeToModule(moduleName)
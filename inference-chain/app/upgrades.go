@@ -16,6 +16,7 @@ import (
 	"github.com/productscience/inference/app/upgrades/v1_14"
 	"github.com/productscience/inference/app/upgrades/v1_17"
 	"github.com/productscience/inference/app/upgrades/v1_18"
+	"github.com/productscience/inference/app/upgrades/v1_19"
 	"github.com/productscience/inference/app/upgrades/v1_8"
 	"github.com/productscience/inference/app/upgrades/v1_9"
 )
@@ -74,4 +75,5 @@ func (app *App) setupUpgradeHandlers() {
 	// app.UpgradeKeeper.SetUpgradeHandler(v1_16.UpgradeName, v1_16.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), app.InferenceKeeper))
 	app.UpgradeKeeper.SetUpgradeHandler(v1_17.UpgradeName, v1_17.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), app.InferenceKeeper))
 	app.UpgradeKeeper.SetUpgradeHandler(v1_18.UpgradeName, v1_18.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), app.InferenceKeeper))
+	app.UpgradeKeeper.SetUpgradeHandler(v1_19.UpgradeName, v1_19.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), app.InferenceKeeper, app.BookkeeperKeeper, app.CollateralKeeper))
 }
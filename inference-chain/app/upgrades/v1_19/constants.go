@@ -0,0 +1,3 @@
+package v1_19
+
+const UpgradeName = "v0.1.19"
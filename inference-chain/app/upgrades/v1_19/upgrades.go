@@ -0,0 +1,183 @@
+package v1_19
+
+import (
+	"context"
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bookkeeperkeeper "github.com/productscience/inference/x/bookkeeper/keeper"
+	bookkeepertypes "github.com/productscience/inference/x/bookkeeper/types"
+	collateralkeeper "github.com/productscience/inference/x/collateral/keeper"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	inferencePruningMaxPerBlock = 1000
+	claimGracePeriodEpochs      = 4
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	k keeper.Keeper,
+	bookkeeperKeeper bookkeeperkeeper.Keeper,
+	collateralKeeper collateralkeeper.Keeper) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		for moduleName, version := range vm {
+			fmt.Printf("Module: %s, Version: %d\n", moduleName, version)
+		}
+		fmt.Printf("OrderMigrations: %v\n", mm.OrderMigrations)
+
+		err := configurator.RegisterMigration(types.ModuleName, 4, func(ctx sdk.Context) error {
+			if err := BackfillInferenceIndexes(ctx, k); err != nil {
+				return err
+			}
+			if err := MigrateSettleAmounts(ctx, k); err != nil {
+				return err
+			}
+			if err := MigrateExternalTokenContractKeys(ctx, k); err != nil {
+				return err
+			}
+			return SetNewParams(ctx, k)
+		})
+		if err != nil {
+			k.LogError("Failed to register migration during upgrade", types.Upgrades, "error", err)
+			return nil, fmt.Errorf("failed to register migration: %w", err)
+		}
+
+		if err := SetLedgerRetention(ctx, k, bookkeeperKeeper); err != nil {
+			return nil, err
+		}
+		if err := SetSlashShares(ctx, k, collateralKeeper); err != nil {
+			return nil, err
+		}
+		if err := SetDelegationLimits(ctx, k, collateralKeeper); err != nil {
+			return nil, err
+		}
+		if err := SnapshotDelegations(ctx, k, collateralKeeper); err != nil {
+			return nil, err
+		}
+
+		// For some reason, the capability module doesn't have a version set, but it DOES exist, causing
+		// the `InitGenesis` to panic.
+		if _, ok := vm["capability"]; !ok {
+			vm["capability"] = mm.Modules["capability"].(module.HasConsensusVersion).ConsensusVersion()
+		}
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// BackfillInferenceIndexes indexes the inferences stored before the by-epoch index existed, pruning
+// only finds inferences through it.
+func BackfillInferenceIndexes(ctx context.Context, k keeper.Keeper) error {
+	count, err := k.BackfillInferenceIndexes(ctx)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to backfill inference indexes", UpgradeName), types.Upgrades, "error", err)
+		return err
+	}
+	k.LogInfo(fmt.Sprintf("%s - Backfilled inference indexes", UpgradeName), types.Upgrades, "inferences", count)
+	return nil
+}
+
+// MigrateSettleAmounts moves the unclaimed settle amounts to the store keyed by participant and epoch,
+// so they stay claimable for the claim grace period.
+func MigrateSettleAmounts(ctx context.Context, k keeper.Keeper) error {
+	count, err := k.MigrateLegacySettleAmounts(ctx)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to migrate settle amounts", UpgradeName), types.Upgrades, "error", err)
+		return err
+	}
+	k.LogInfo(fmt.Sprintf("%s - Migrated settle amounts", UpgradeName), types.Upgrades, "settleAmounts", count)
+	return nil
+}
+
+// MigrateExternalTokenContractKeys moves the token contract mappings to lowercase keys, so deposits
+// of any spelling of a token mint the CW20 token it was first bridged with.
+func MigrateExternalTokenContractKeys(ctx sdk.Context, k keeper.Keeper) error {
+	count, err := k.MigrateExternalTokenContractKeys(ctx)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to migrate token contract keys", UpgradeName), types.Upgrades, "error", err)
+		return err
+	}
+	k.LogInfo(fmt.Sprintf("%s - Migrated token contract keys", UpgradeName), types.Upgrades, "tokenContracts", count)
+	return nil
+}
+
+func SetNewParams(ctx context.Context, k keeper.Keeper) error {
+	params := k.GetParams(ctx)
+	params.EpochParams.InferencePruningMaxPerBlock = inferencePruningMaxPerBlock
+	params.TokenomicsParams.ClaimGracePeriodEpochs = claimGracePeriodEpochs
+	if params.TrainingParams == nil {
+		params.TrainingParams = types.DefaultTrainingParams()
+	}
+	err := k.SetParams(ctx, params)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to set params during upgrade", UpgradeName), types.Upgrades, "error", err)
+	}
+	return err
+}
+
+// SetLedgerRetention turns on ledger pruning, the bookkeeper params predate ledger_retention_blocks
+func SetLedgerRetention(ctx context.Context, k keeper.Keeper, bookkeeperKeeper bookkeeperkeeper.Keeper) error {
+	params := bookkeeperKeeper.GetParams(ctx)
+	if params.LedgerRetentionBlocks != 0 {
+		return nil
+	}
+	params.LedgerRetentionBlocks = bookkeepertypes.DefaultLedgerRetentionBlocks
+	err := bookkeeperKeeper.SetParams(ctx, params)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to set bookkeeper params during upgrade", UpgradeName), types.Upgrades, "error", err)
+	}
+	return err
+}
+
+// SetSlashShares sets the default split of slashed collateral, the collateral params predate the
+// slash shares and don't validate without them.
+func SetSlashShares(ctx context.Context, k keeper.Keeper, collateralKeeper collateralkeeper.Keeper) error {
+	params := collateralKeeper.GetParams(ctx)
+	if !params.SlashBurnShare.IsNil() {
+		return nil
+	}
+	params.SlashBurnShare = collateraltypes.DefaultSlashBurnShare
+	params.SlashValidatorsShare = collateraltypes.DefaultSlashValidatorsShare
+	params.SlashCommunityPoolShare = collateraltypes.DefaultSlashCommunityPoolShare
+	err := collateralKeeper.SetParams(ctx, params)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to set collateral params during upgrade", UpgradeName), types.Upgrades, "error", err)
+	}
+	return err
+}
+
+// SetDelegationLimits sets the default minimum delegation and delegator limit, the collateral params
+// predate them and don't validate without them.
+func SetDelegationLimits(ctx context.Context, k keeper.Keeper, collateralKeeper collateralkeeper.Keeper) error {
+	params := collateralKeeper.GetParams(ctx)
+	if !params.MinDelegation.IsNil() && params.MaxDelegatorsPerParticipant != 0 {
+		return nil
+	}
+	params.MinDelegation = collateraltypes.DefaultMinDelegation
+	params.MaxDelegatorsPerParticipant = collateraltypes.DefaultMaxDelegatorsPerParticipant
+	err := collateralKeeper.SetParams(ctx, params)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to set collateral delegation limits during upgrade", UpgradeName), types.Upgrades, "error", err)
+	}
+	return err
+}
+
+// SnapshotDelegations takes the delegation snapshot of the current epoch, which is otherwise taken when
+// the epoch starts, so its rewards are shared with the delegators too.
+func SnapshotDelegations(ctx context.Context, k keeper.Keeper, collateralKeeper collateralkeeper.Keeper) error {
+	epoch, err := collateralKeeper.CurrentEpoch.Get(ctx)
+	if err != nil {
+		k.LogError(fmt.Sprintf("%s - Failed to get the collateral epoch during upgrade", UpgradeName), types.Upgrades, "error", err)
+		return err
+	}
+	collateralKeeper.SnapshotDelegations(ctx, epoch)
+	k.LogInfo(fmt.Sprintf("%s - Snapshotted collateral delegations", UpgradeName), types.Upgrades, "epoch", epoch)
+	return nil
+}
@@ -24,14 +24,18 @@ import (
 
 // CollateralMocks holds all the mock keepers for testing
 type CollateralMocks struct {
-	BankKeeper *MockBookkeepingBankKeeper
+	BankKeeper         *MockBookkeepingBankKeeper
+	DistributionKeeper *MockDistributionKeeper
+	InferenceKeeper    *MockInferenceKeeper
 }
 
 func CollateralKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	ctrl := gomock.NewController(t)
 	bankKeeper := NewMockBookkeepingBankKeeper(ctrl)
+	distributionKeeper := NewMockDistributionKeeper(ctrl)
+	inferenceKeeper := NewMockInferenceKeeper(ctrl)
 	// StakingKeeper and InferenceKeeper can be nil for basic tests
-	k, ctx := CollateralKeeperWithMock(t, bankKeeper)
+	k, ctx := CollateralKeeperWithMock(t, bankKeeper, distributionKeeper, inferenceKeeper)
 
 	return k, ctx
 }
@@ -39,11 +43,15 @@ func CollateralKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 func CollateralKeeperReturningMocks(t testing.TB) (keeper.Keeper, sdk.Context, CollateralMocks) {
 	ctrl := gomock.NewController(t)
 	bankKeeper := NewMockBookkeepingBankKeeper(ctrl)
+	distributionKeeper := NewMockDistributionKeeper(ctrl)
+	inferenceKeeper := NewMockInferenceKeeper(ctrl)
 
-	k, ctx := CollateralKeeperWithMock(t, bankKeeper)
+	k, ctx := CollateralKeeperWithMock(t, bankKeeper, distributionKeeper, inferenceKeeper)
 
 	mocks := CollateralMocks{
-		BankKeeper: bankKeeper,
+		BankKeeper:         bankKeeper,
+		DistributionKeeper: distributionKeeper,
+		InferenceKeeper:    inferenceKeeper,
 	}
 
 	return k, ctx, mocks
@@ -52,6 +60,8 @@ func CollateralKeeperReturningMocks(t testing.TB) (keeper.Keeper, sdk.Context, C
 func CollateralKeeperWithMock(
 	t testing.TB,
 	bankKeeper *MockBookkeepingBankKeeper,
+	distributionKeeper *MockDistributionKeeper,
+	inferenceKeeper *MockInferenceKeeper,
 ) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -71,6 +81,8 @@ func CollateralKeeperWithMock(
 		authority.String(),
 		nil,
 		bankKeeper,
+		distributionKeeper,
+		func() types.InferenceKeeper { return inferenceKeeper },
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -20,6 +20,7 @@ import (
 	group "github.com/cosmos/cosmos-sdk/x/group"
 	keeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types3 "github.com/productscience/inference/x/collateral/types"
 	types2 "github.com/productscience/inference/x/inference/types"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -312,6 +313,21 @@ func (mr *MockGroupMessageKeeperMockRecorder) UpdateGroupMetadata(goCtx, msg any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupMetadata", reflect.TypeOf((*MockGroupMessageKeeper)(nil).UpdateGroupMetadata), goCtx, msg)
 }
 
+// Proposal mocks base method.
+func (m *MockGroupMessageKeeper) Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Proposal", goCtx, request)
+	ret0, _ := ret[0].(*group.QueryProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Proposal indicates an expected call of Proposal.
+func (mr *MockGroupMessageKeeperMockRecorder) Proposal(goCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Proposal", reflect.TypeOf((*MockGroupMessageKeeper)(nil).Proposal), goCtx, request)
+}
+
 // Vote mocks base method.
 func (m *MockGroupMessageKeeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -327,6 +343,21 @@ func (mr *MockGroupMessageKeeperMockRecorder) Vote(goCtx, msg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockGroupMessageKeeper)(nil).Vote), goCtx, msg)
 }
 
+// VotesByProposal mocks base method.
+func (m *MockGroupMessageKeeper) VotesByProposal(goCtx context.Context, request *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotesByProposal", goCtx, request)
+	ret0, _ := ret[0].(*group.QueryVotesByProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotesByProposal indicates an expected call of VotesByProposal.
+func (mr *MockGroupMessageKeeperMockRecorder) VotesByProposal(goCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotesByProposal", reflect.TypeOf((*MockGroupMessageKeeper)(nil).VotesByProposal), goCtx, request)
+}
+
 // MockParamSubspace is a mock of ParamSubspace interface.
 type MockParamSubspace struct {
 	ctrl     *gomock.Controller
@@ -682,19 +713,49 @@ func (mr *MockCollateralKeeperMockRecorder) GetCollateral(ctx, participant any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).GetCollateral), ctx, participant)
 }
 
+// GetDelegatorRewards mocks base method.
+func (m *MockCollateralKeeper) GetDelegatorRewards(ctx context.Context, participant types.AccAddress, epoch uint64) (math.LegacyDec, []types3.CollateralDelegation) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorRewards", ctx, participant, epoch)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].([]types3.CollateralDelegation)
+	return ret0, ret1
+}
+
+// GetDelegatorRewards indicates an expected call of GetDelegatorRewards.
+func (mr *MockCollateralKeeperMockRecorder) GetDelegatorRewards(ctx, participant, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorRewards", reflect.TypeOf((*MockCollateralKeeper)(nil).GetDelegatorRewards), ctx, participant, epoch)
+}
+
+// GetTotalCollateral mocks base method.
+func (m *MockCollateralKeeper) GetTotalCollateral(ctx context.Context, participant types.AccAddress) (types.Coin, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCollateral", ctx, participant)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetTotalCollateral indicates an expected call of GetTotalCollateral.
+func (mr *MockCollateralKeeperMockRecorder) GetTotalCollateral(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).GetTotalCollateral), ctx, participant)
+}
+
 // Slash mocks base method.
-func (m *MockCollateralKeeper) Slash(ctx context.Context, participant types.AccAddress, slashFraction math.LegacyDec) (types.Coin, error) {
+func (m *MockCollateralKeeper) Slash(ctx context.Context, participant types.AccAddress, slashFraction math.LegacyDec, reason string, validators []types.AccAddress) (types.Coin, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Slash", ctx, participant, slashFraction)
+	ret := m.ctrl.Call(m, "Slash", ctx, participant, slashFraction, reason, validators)
 	ret0, _ := ret[0].(types.Coin)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Slash indicates an expected call of Slash.
-func (mr *MockCollateralKeeperMockRecorder) Slash(ctx, participant, slashFraction any) *gomock.Call {
+func (mr *MockCollateralKeeperMockRecorder) Slash(ctx, participant, slashFraction, reason, validators any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockCollateralKeeper)(nil).Slash), ctx, participant, slashFraction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockCollateralKeeper)(nil).Slash), ctx, participant, slashFraction, reason, validators)
 }
 
 // MockStreamVestingKeeper is a mock of StreamVestingKeeper interface.
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,6 +15,12 @@ import (
 )
 
 type (
+	// LedgerIndexes are the secondary indexes of ledger entries
+	LedgerIndexes struct {
+		ByAccount  *indexes.Multi[string, ledgerKey, types.LedgerEntry]
+		ByMemoType *indexes.Multi[string, ledgerKey, types.LedgerEntry]
+	}
+
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService store.KVStoreService
@@ -24,9 +32,18 @@ type (
 
 		bankKeeper types.BankKeeper
 		logConfig  LogConfig
+
+		// Ledger holds the double-entry record of every transfer, keyed by (height, id)
+		Ledger         *collections.IndexedMap[ledgerKey, types.LedgerEntry, LedgerIndexes]
+		LedgerSequence collections.Sequence
+		Schema         collections.Schema
 	}
 )
 
+func (i LedgerIndexes) IndexesList() []collections.Index[ledgerKey, types.LedgerEntry] {
+	return []collections.Index[ledgerKey, types.LedgerEntry]{i.ByAccount, i.ByMemoType}
+}
+
 type LogConfig struct {
 	DoubleEntry bool   `json:"double_entry"`
 	SimpleEntry bool   `json:"simple_entry"`
@@ -46,7 +63,21 @@ func NewKeeper(
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	ledgerIndexes := LedgerIndexes{
+		ByAccount: indexes.NewMulti(sb, types.LedgerByAccountKey, "ledger_by_account",
+			collections.StringKey, ledgerKeyCodec,
+			func(_ ledgerKey, entry types.LedgerEntry) (string, error) {
+				return entry.Account, nil
+			}),
+		ByMemoType: indexes.NewMulti(sb, types.LedgerByMemoTypeKey, "ledger_by_memo_type",
+			collections.StringKey, ledgerKeyCodec,
+			func(_ ledgerKey, entry types.LedgerEntry) (string, error) {
+				return entry.MemoType, nil
+			}),
+	}
+
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authority:    authority,
@@ -54,7 +85,17 @@ func NewKeeper(
 
 		bankKeeper: bankKeeper,
 		logConfig:  logConfig,
+
+		Ledger: collections.NewIndexedMap(sb, types.LedgerEntriesKey, "ledger",
+			ledgerKeyCodec, codec.CollValue[types.LedgerEntry](cdc), ledgerIndexes),
+		LedgerSequence: collections.NewSequence(sb, types.LedgerSequenceKey, "ledger_sequence"),
 	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -140,13 +181,18 @@ func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins,
 }
 
 func (k Keeper) LogSubAccountTransaction(ctx context.Context, recipient string, sender string, subAccount string, amt sdk.Coin, memo string) {
-	k.logTransaction(ctx, recipient+"_"+subAccount, sender+"_"+subAccount, amt, memo, subAccount)
+	k.logTransaction(ctx, recipient, sender, amt, memo, subAccount)
 }
 
 func (k Keeper) logTransaction(ctx context.Context, to string, from string, coin sdk.Coin, memo string, subAccount string) {
 	if coin.Amount.IsZero() {
 		return
 	}
+	k.recordTransaction(ctx, to, from, coin, memo, subAccount)
+	if subAccount != "" {
+		to = to + "_" + subAccount
+		from = from + "_" + subAccount
+	}
 	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
 	logFunc := k.getLogFunction(k.logConfig.LogLevel)
 	amount := coin.Amount.Int64()
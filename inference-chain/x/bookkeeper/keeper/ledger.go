@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+// maxPrunedEntriesPerBlock bounds the work EndBlock does, a backlog is pruned over several blocks
+const maxPrunedEntriesPerBlock = 1000
+
+// ledgerKey orders ledger entries by block height, then by the order they were recorded in
+type ledgerKey = collections.Pair[int64, uint64]
+
+var ledgerKeyCodec = collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)
+
+// recordTransaction stores a transfer as a debit of the recipient and a credit of the sender
+func (k Keeper) recordTransaction(ctx context.Context, to string, from string, coin sdk.Coin, memo string, subAccount string) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	memoType := types.MemoTypeOf(memo)
+	entries := []types.LedgerEntry{
+		{EntryType: types.LedgerEntryType_DEBIT, Account: to, CounterAccount: from},
+		{EntryType: types.LedgerEntryType_CREDIT, Account: from, CounterAccount: to},
+	}
+	for _, entry := range entries {
+		entry.Height = height
+		entry.SubAccount = subAccount
+		entry.Amount = coin
+		entry.Memo = memo
+		entry.MemoType = memoType
+		if err := k.appendLedgerEntry(ctx, entry); err != nil {
+			// Bookkeeping must never fail the transfer it records
+			k.Logger().Error("Failed to record ledger entry", "account", entry.Account, "memo", memo, "error", err)
+		}
+	}
+}
+
+func (k Keeper) appendLedgerEntry(ctx context.Context, entry types.LedgerEntry) error {
+	id, err := k.LedgerSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	entry.Id = id
+	return k.Ledger.Set(ctx, collections.Join(entry.Height, entry.Id), entry)
+}
+
+// SetLedgerEntry stores an entry as is, keeping its id. Used when importing genesis.
+func (k Keeper) SetLedgerEntry(ctx context.Context, entry types.LedgerEntry) error {
+	next, err := k.LedgerSequence.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	if entry.Id >= next {
+		if err := k.LedgerSequence.Set(ctx, entry.Id+1); err != nil {
+			return err
+		}
+	}
+	return k.Ledger.Set(ctx, collections.Join(entry.Height, entry.Id), entry)
+}
+
+func (k Keeper) GetAllLedgerEntries(ctx context.Context) ([]types.LedgerEntry, error) {
+	iter, err := k.Ledger.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// PruneLedger removes entries older than the retention period and returns how many were removed
+func (k Keeper) PruneLedger(ctx context.Context) (int, error) {
+	retention := k.GetParams(ctx).LedgerRetentionBlocks
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockHeight() - int64(retention)
+	if retention == 0 || cutoff <= 0 {
+		return 0, nil
+	}
+
+	ranger := new(collections.Range[ledgerKey]).EndExclusive(collections.Join(cutoff, uint64(0)))
+	iter, err := k.Ledger.Iterate(ctx, ranger)
+	if err != nil {
+		return 0, err
+	}
+	var expired []ledgerKey
+	for ; iter.Valid() && len(expired) < maxPrunedEntriesPerBlock; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return 0, err
+		}
+		expired = append(expired, key)
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		if err := k.Ledger.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+	return len(expired), nil
+}
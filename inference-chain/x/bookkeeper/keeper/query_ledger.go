@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+const maxLedgerPageSize = 1000
+
+// primaryKeyIterator is the part of map and index iterators the query needs
+type primaryKeyIterator interface {
+	Valid() bool
+	Next()
+	Close() error
+}
+
+func (k Keeper) LedgerEntries(ctx context.Context, req *types.QueryLedgerEntriesRequest) (*types.QueryLedgerEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MemoType != "" && !types.IsValidMemoType(req.MemoType) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown memo type %s", req.MemoType)
+	}
+	if req.StartHeight < 0 || (req.EndHeight != 0 && req.EndHeight < req.StartHeight) {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+	if req.Pagination.GetOffset() != 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset pagination is not supported, use the next key")
+	}
+
+	// Entries are ordered by (height, id) in the map and within every index
+	from := collections.Join(req.StartHeight, uint64(0))
+	if len(req.Pagination.GetKey()) > 0 {
+		_, key, err := ledgerKeyCodec.Decode(req.Pagination.GetKey())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		from = key
+	}
+	to := collections.Join(int64(math.MaxInt64), uint64(0))
+	if req.EndHeight != 0 {
+		to = collections.Join(req.EndHeight+1, uint64(0))
+	}
+
+	limit := req.Pagination.GetLimit()
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	limit = min(limit, maxLedgerPageSize)
+
+	iter, primaryKey, err := k.iterateLedger(ctx, req.Account, req.MemoType, from, to)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var entries []types.LedgerEntry
+	pageRes := &query.PageResponse{}
+	for ; iter.Valid(); iter.Next() {
+		key, err := primaryKey()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if uint64(len(entries)) == limit {
+			pageRes.NextKey, err = collections.EncodeKeyWithPrefix(nil, ledgerKeyCodec, key)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			break
+		}
+		entry, err := k.Ledger.Get(ctx, key)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if req.MemoType != "" && entry.MemoType != req.MemoType {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return &types.QueryLedgerEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// iterateLedger picks the narrowest index for the filters. Only the account filter is applied
+// by the index when both account and memo type are given, the caller checks the memo type.
+func (k Keeper) iterateLedger(ctx context.Context, account string, memoType string, from ledgerKey, to ledgerKey) (primaryKeyIterator, func() (ledgerKey, error), error) {
+	index := k.Ledger.Indexes.ByAccount
+	reference := account
+	if account == "" && memoType != "" {
+		index = k.Ledger.Indexes.ByMemoType
+		reference = memoType
+	}
+
+	if reference == "" {
+		ranger := new(collections.Range[ledgerKey]).StartInclusive(from).EndExclusive(to)
+		iter, err := k.Ledger.Iterate(ctx, ranger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return iter, iter.Key, nil
+	}
+
+	ranger := new(collections.Range[collections.Pair[string, ledgerKey]]).
+		StartInclusive(collections.Join(reference, from)).
+		EndExclusive(collections.Join(reference, to))
+	iter, err := index.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return iter, iter.PrimaryKey, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+func TestLedgerRecordsBothSides(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	k.LogSubAccountTransaction(ctx, "participant", "inference", "owed", sdk.NewInt64Coin("ngonka", 100), "inference_finished:id1")
+
+	response, err := k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Entries, 2)
+
+	debit, credit := response.Entries[0], response.Entries[1]
+	require.Equal(t, types.LedgerEntryType_DEBIT, debit.EntryType)
+	require.Equal(t, "participant", debit.Account)
+	require.Equal(t, "inference", debit.CounterAccount)
+	require.Equal(t, types.LedgerEntryType_CREDIT, credit.EntryType)
+	require.Equal(t, "inference", credit.Account)
+	require.Equal(t, "participant", credit.CounterAccount)
+	for _, entry := range response.Entries {
+		require.Equal(t, int64(10), entry.Height)
+		require.Equal(t, "owed", entry.SubAccount)
+		require.Equal(t, sdk.NewInt64Coin("ngonka", 100), entry.Amount)
+		require.Equal(t, types.MemoTypeReward, entry.MemoType)
+	}
+
+	// Zero amounts aren't recorded
+	k.LogSubAccountTransaction(ctx, "participant", "inference", "owed", sdk.NewInt64Coin("ngonka", 0), "inference_finished:id2")
+	response, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Entries, 2)
+}
+
+func TestLedgerEntriesFilters(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+	coin := sdk.NewInt64Coin("ngonka", 5)
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(1), "alice", "inference", "owed", coin, "inference_finished:id1")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(2), "bob", "inference", "owed", coin, "inference_refund:id2")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(3), "alice", "inference", "owed", coin, "abandoned_inference:id3")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(4), "collateral", "alice", "collateral", coin, "collateral deposit")
+
+	accountEntries := func(req *types.QueryLedgerEntriesRequest) []types.LedgerEntry {
+		response, err := k.LedgerEntries(ctx, req)
+		require.NoError(t, err)
+		return response.Entries
+	}
+
+	byAccount := accountEntries(&types.QueryLedgerEntriesRequest{Account: "alice"})
+	require.Len(t, byAccount, 3)
+	require.Equal(t, []int64{1, 3, 4}, []int64{byAccount[0].Height, byAccount[1].Height, byAccount[2].Height})
+
+	refunds := accountEntries(&types.QueryLedgerEntriesRequest{MemoType: types.MemoTypeRefund})
+	require.Len(t, refunds, 4)
+
+	aliceRefunds := accountEntries(&types.QueryLedgerEntriesRequest{Account: "alice", MemoType: types.MemoTypeRefund})
+	require.Len(t, aliceRefunds, 1)
+	require.Equal(t, "abandoned_inference:id3", aliceRefunds[0].Memo)
+
+	inRange := accountEntries(&types.QueryLedgerEntriesRequest{Account: "inference", StartHeight: 2, EndHeight: 3})
+	require.Len(t, inRange, 2)
+	require.Equal(t, int64(2), inRange[0].Height)
+	require.Equal(t, int64(3), inRange[1].Height)
+
+	_, err := k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{MemoType: "bonus"})
+	require.Error(t, err)
+	_, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{StartHeight: 5, EndHeight: 4})
+	require.Error(t, err)
+}
+
+func TestLedgerEntriesPagination(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+	for height := int64(1); height <= 5; height++ {
+		k.LogSubAccountTransaction(ctx.WithBlockHeight(height), "alice", "inference", "owed", sdk.NewInt64Coin("ngonka", height), "inference_finished")
+	}
+
+	var heights []int64
+	var nextKey []byte
+	for {
+		response, err := k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{
+			Account:    "alice",
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(response.Entries), 2)
+		for _, entry := range response.Entries {
+			heights = append(heights, entry.Height)
+		}
+		nextKey = response.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, heights)
+}
+
+func TestPruneLedger(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.NewParams(10)))
+	coin := sdk.NewInt64Coin("ngonka", 1)
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(5), "alice", "inference", "owed", coin, "inference_finished")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(15), "alice", "inference", "owed", coin, "inference_finished")
+
+	removed, err := k.PruneLedger(ctx.WithBlockHeight(20))
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	response, err := k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{Account: "alice"})
+	require.NoError(t, err)
+	require.Len(t, response.Entries, 1)
+	require.Equal(t, int64(15), response.Entries[0].Height)
+
+	// Retention of 0 keeps everything
+	require.NoError(t, k.SetParams(ctx, types.NewParams(0)))
+	removed, err = k.PruneLedger(ctx.WithBlockHeight(1000))
+	require.NoError(t, err)
+	require.Equal(t, 0, removed)
+}
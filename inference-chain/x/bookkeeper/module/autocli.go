@@ -17,6 +17,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "LedgerEntries",
+					Use:       "ledger",
+					Short:     "Query ledger entries, filtered by account, memo type and height range",
+					Long:      "Query the double-entry record of transfers. Memo type is one of reward, refund, escrow, slash or other. An end height of 0 means up to the latest block.",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
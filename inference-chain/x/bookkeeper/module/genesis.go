@@ -13,12 +13,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	for _, entry := range genState.LedgerEntries {
+		if err := k.SetLedgerEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	ledgerEntries, err := k.GetAllLedgerEntries(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.LedgerEntries = ledgerEntries
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -3,6 +3,8 @@ package bookkeeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	keepertest "github.com/productscience/inference/testutil/keeper"
 	"github.com/productscience/inference/testutil/nullify"
 	bookkeeper "github.com/productscience/inference/x/bookkeeper/module"
@@ -13,6 +15,10 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		LedgerEntries: []types.LedgerEntry{
+			{Id: 0, Height: 1, EntryType: types.LedgerEntryType_DEBIT, Account: "alice", CounterAccount: "bob", Amount: sdk.NewInt64Coin("ngonka", 1), MemoType: types.MemoTypeOther},
+			{Id: 1, Height: 1, EntryType: types.LedgerEntryType_CREDIT, Account: "bob", CounterAccount: "alice", Amount: sdk.NewInt64Coin("ngonka", 1), MemoType: types.MemoTypeOther},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -25,5 +31,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.LedgerEntries, got.LedgerEntries)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
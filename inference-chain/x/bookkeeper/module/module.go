@@ -149,9 +149,16 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 	return nil
 }
 
-// EndBlock contains the logic that is automatically triggered at the end of each block.
-// The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
+// EndBlock prunes ledger entries that are past their retention period.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	removed, err := am.keeper.PruneLedger(ctx)
+	if err != nil {
+		am.keeper.Logger().Error("Failed to prune ledger", "error", err)
+		return nil
+	}
+	if removed > 0 {
+		am.keeper.Logger().Debug("Pruned ledger entries", "removed", removed)
+	}
 	return nil
 }
 
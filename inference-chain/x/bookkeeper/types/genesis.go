@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -17,6 +19,13 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	ids := make(map[uint64]bool, len(gs.LedgerEntries))
+	for _, entry := range gs.LedgerEntries {
+		if ids[entry.Id] {
+			return fmt.Errorf("duplicated ledger entry id %d", entry.Id)
+		}
+		ids[entry.Id] = true
+	}
 
 	return gs.Params.Validate()
 }
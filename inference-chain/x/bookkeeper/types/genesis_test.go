@@ -26,6 +26,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated ledger entry id",
+			genState: &types.GenesisState{
+				LedgerEntries: []types.LedgerEntry{{Id: 1, Height: 1}, {Id: 1, Height: 2}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "bookkeeper"
@@ -13,6 +15,15 @@ const (
 
 var (
 	ParamsKey = []byte("p_bookkeeper")
+
+	// LedgerEntriesKey is the prefix for ledger entries, keyed by (height, id)
+	LedgerEntriesKey = collections.NewPrefix(1)
+	// LedgerSequenceKey is the prefix for the id of the next ledger entry
+	LedgerSequenceKey = collections.NewPrefix(2)
+	// LedgerByAccountKey is the prefix for the index of ledger entries by account
+	LedgerByAccountKey = collections.NewPrefix(3)
+	// LedgerByMemoTypeKey is the prefix for the index of ledger entries by memo type
+	LedgerByMemoTypeKey = collections.NewPrefix(4)
 )
 
 func KeyPrefix(p string) []byte {
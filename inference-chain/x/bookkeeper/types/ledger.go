@@ -0,0 +1,53 @@
+package types
+
+import "strings"
+
+const (
+	MemoTypeReward = "reward"
+	MemoTypeRefund = "refund"
+	MemoTypeEscrow = "escrow"
+	MemoTypeSlash  = "slash"
+	MemoTypeOther  = "other"
+)
+
+// memoTypePrefixes maps the memos the modules use when moving coins to a memo type. Memos
+// usually carry an id after a colon, so they are matched by prefix, first match wins.
+var memoTypePrefixes = []struct {
+	prefix   string
+	memoType string
+}{
+	{"escrow", MemoTypeEscrow},
+	{"inference_refund", MemoTypeRefund},
+	{"expired_inference", MemoTypeRefund},
+	{"abandoned_inference", MemoTypeRefund},
+	{"invalidated_inference", MemoTypeRefund},
+	{"training_refund", MemoTypeRefund},
+	{"collateral slashed", MemoTypeSlash},
+	{"reward_", MemoTypeReward},
+	{"delegator_reward_coins", MemoTypeReward},
+	{"training_milestone", MemoTypeReward},
+	{"work_coins", MemoTypeReward},
+	{"top_miner", MemoTypeReward},
+	{"vesting payment", MemoTypeReward},
+	{"inference_finished", MemoTypeReward},
+	{"share_validation_executor", MemoTypeReward},
+}
+
+// MemoTypeOf classifies a transfer memo as reward, refund, escrow, slash or other
+func MemoTypeOf(memo string) string {
+	for _, p := range memoTypePrefixes {
+		if strings.HasPrefix(memo, p.prefix) {
+			return p.memoType
+		}
+	}
+	return MemoTypeOther
+}
+
+// IsValidMemoType reports whether memoType can be used to filter ledger entries
+func IsValidMemoType(memoType string) bool {
+	switch memoType {
+	case MemoTypeReward, MemoTypeRefund, MemoTypeEscrow, MemoTypeSlash, MemoTypeOther:
+		return true
+	}
+	return false
+}
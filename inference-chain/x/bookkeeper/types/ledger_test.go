@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+func TestMemoTypeOf(t *testing.T) {
+	for memo, expected := range map[string]string{
+		"escrow for inferenceId:id1":         types.MemoTypeEscrow,
+		"inference_refund:id1":               types.MemoTypeRefund,
+		"expired_inference:id1":              types.MemoTypeRefund,
+		"invalidated_inference:id1":          types.MemoTypeRefund,
+		"collateral slashed":                 types.MemoTypeSlash,
+		"reward_coins:participant_vested":    types.MemoTypeReward,
+		"work_coins:participant":             types.MemoTypeReward,
+		"delegator_reward_coins:participant": types.MemoTypeReward,
+		"training_milestone:1":               types.MemoTypeReward,
+		"training_refund:1":                  types.MemoTypeRefund,
+		"top_miner":                          types.MemoTypeReward,
+		"collateral deposit":                 types.MemoTypeOther,
+		"":                                   types.MemoTypeOther,
+	} {
+		require.Equal(t, expected, types.MemoTypeOf(memo), memo)
+	}
+}
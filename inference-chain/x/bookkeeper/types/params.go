@@ -11,14 +11,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// DefaultLedgerRetentionBlocks keeps ledger entries for about 30 days of 5 second blocks
+const DefaultLedgerRetentionBlocks = 518_400
+
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(ledgerRetentionBlocks uint64) Params {
+	return Params{LedgerRetentionBlocks: ledgerRetentionBlocks}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return Params{}
+	return NewParams(DefaultLedgerRetentionBlocks)
 }
 
 // ParamSetPairs get the params.ParamSet
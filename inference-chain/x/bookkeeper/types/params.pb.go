@@ -26,6 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// Ledger entries older than this many blocks are pruned, 0 keeps them forever
+	LedgerRetentionBlocks uint64 `protobuf:"varint,1,opt,name=ledger_retention_blocks,json=ledgerRetentionBlocks,proto3" json:"ledger_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +63,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetLedgerRetentionBlocks() uint64 {
+	if m != nil {
+		return m.LedgerRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.bookkeeper.Params")
 }
@@ -68,19 +77,21 @@ func init() {
 func init() { proto.RegisterFile("inference/bookkeeper/params.proto", fileDescriptor_67a8bb373d1e46fa) }
 
 var fileDescriptor_67a8bb373d1e46fa = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
+	// 224 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xca, 0xcf, 0xcf, 0xce, 0x4e, 0x4d, 0x2d, 0x48, 0x2d,
 	0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
 	0x2b, 0xd1, 0x43, 0x28, 0x91, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x85,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x32, 0xe0, 0x62,
-	0x0b, 0x00, 0x1b, 0x67, 0xa5, 0xf6, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0xb2, 0x08,
-	0x4b, 0x2b, 0x90, 0xad, 0x85, 0xa8, 0x73, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82,
-	0xa2, 0xfc, 0x94, 0xd2, 0xe4, 0x92, 0xe2, 0xe4, 0x4c, 0xb0, 0x41, 0x38, 0x8c, 0x2c, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xc5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x69, 0x98, 0x3a,
-	0xe1, 0xee, 0x00, 0x00, 0x00,
+	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xca, 0xe0, 0x62,
+	0x0b, 0x00, 0x1b, 0x27, 0x64, 0xc6, 0x25, 0x9e, 0x93, 0x9a, 0x92, 0x9e, 0x5a, 0x14, 0x5f, 0x94,
+	0x5a, 0x92, 0x9a, 0x57, 0x92, 0x99, 0x9f, 0x17, 0x9f, 0x94, 0x93, 0x9f, 0x9c, 0x5d, 0x2c, 0xc1,
+	0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x24, 0x0a, 0x91, 0x0e, 0x82, 0xc9, 0x3a, 0x81, 0x25, 0xad, 0xd4,
+	0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x16, 0xe1, 0xd8, 0x0a, 0x64, 0xe7, 0x42,
+	0xcc, 0x77, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
+	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4,
+	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xa2, 0xfc, 0x94, 0xd2, 0xe4,
+	0x92, 0xe2, 0xe4, 0x4c, 0xb0, 0x41, 0x38, 0x8c, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
+	0x7b, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xba, 0xe5, 0x88, 0x75, 0x26, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +113,9 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.LedgerRetentionBlocks != that1.LedgerRetentionBlocks {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +138,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LedgerRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LedgerRetentionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +163,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.LedgerRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.LedgerRetentionBlocks))
+	}
 	return n
 }
 
@@ -182,6 +204,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerRetentionBlocks", wireType)
+			}
+			m.LedgerRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
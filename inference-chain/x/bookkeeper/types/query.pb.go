@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,36 +114,183 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryLedgerEntriesRequest is the request type for the Query/LedgerEntries RPC method.
+type QueryLedgerEntriesRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// One of reward, refund, escrow, slash or other
+	MemoType    string `protobuf:"bytes,2,opt,name=memo_type,json=memoType,proto3" json:"memo_type,omitempty"`
+	StartHeight int64  `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Inclusive, 0 means up to the latest block
+	EndHeight  int64              `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLedgerEntriesRequest) Reset()         { *m = QueryLedgerEntriesRequest{} }
+func (m *QueryLedgerEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLedgerEntriesRequest) ProtoMessage()    {}
+func (*QueryLedgerEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98df7282cc6d5f4d, []int{2}
+}
+func (m *QueryLedgerEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLedgerEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLedgerEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLedgerEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLedgerEntriesRequest.Merge(m, src)
+}
+func (m *QueryLedgerEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLedgerEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLedgerEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLedgerEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryLedgerEntriesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryLedgerEntriesRequest) GetMemoType() string {
+	if m != nil {
+		return m.MemoType
+	}
+	return ""
+}
+
+func (m *QueryLedgerEntriesRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryLedgerEntriesRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryLedgerEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLedgerEntriesResponse is the response type for the Query/LedgerEntries RPC method.
+type QueryLedgerEntriesResponse struct {
+	Entries    []LedgerEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLedgerEntriesResponse) Reset()         { *m = QueryLedgerEntriesResponse{} }
+func (m *QueryLedgerEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLedgerEntriesResponse) ProtoMessage()    {}
+func (*QueryLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98df7282cc6d5f4d, []int{3}
+}
+func (m *QueryLedgerEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLedgerEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLedgerEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLedgerEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLedgerEntriesResponse.Merge(m, src)
+}
+func (m *QueryLedgerEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLedgerEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLedgerEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLedgerEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryLedgerEntriesResponse) GetEntries() []LedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryLedgerEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.bookkeeper.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.bookkeeper.QueryParamsResponse")
+	proto.RegisterType((*QueryLedgerEntriesRequest)(nil), "inference.bookkeeper.QueryLedgerEntriesRequest")
+	proto.RegisterType((*QueryLedgerEntriesResponse)(nil), "inference.bookkeeper.QueryLedgerEntriesResponse")
 }
 
 func init() { proto.RegisterFile("inference/bookkeeper/query.proto", fileDescriptor_98df7282cc6d5f4d) }
 
 var fileDescriptor_98df7282cc6d5f4d = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xcf, 0x4a, 0x3b, 0x31,
-	0x10, 0xc7, 0x37, 0x3f, 0xf8, 0x15, 0x5c, 0x4f, 0xae, 0x3d, 0x48, 0x29, 0x6b, 0xed, 0xa9, 0x56,
-	0xdc, 0xd0, 0x16, 0xbc, 0x0a, 0x7d, 0x82, 0xda, 0x83, 0x07, 0x6f, 0xd9, 0x75, 0x5c, 0x43, 0xdd,
-	0x4c, 0x9a, 0x64, 0xc5, 0x5e, 0x7d, 0x02, 0xd1, 0xab, 0x0f, 0xe0, 0xd1, 0xc7, 0xe8, 0xb1, 0xe0,
-	0xc5, 0x93, 0x48, 0x2b, 0xf8, 0x1a, 0xd2, 0x64, 0xf1, 0x0f, 0xae, 0xe2, 0x25, 0x0c, 0x93, 0xcf,
-	0x7c, 0x32, 0xf9, 0xfa, 0x0d, 0x2e, 0x4e, 0x40, 0x81, 0x48, 0x80, 0xc6, 0x88, 0xa3, 0x11, 0x80,
-	0x04, 0x45, 0xc7, 0x39, 0xa8, 0x49, 0x24, 0x15, 0x1a, 0x0c, 0xaa, 0xef, 0x44, 0xf4, 0x41, 0xd4,
-	0xd6, 0x58, 0xc6, 0x05, 0x52, 0x7b, 0x3a, 0xb0, 0x56, 0x4d, 0x31, 0x45, 0x5b, 0xd2, 0x65, 0x55,
-	0x74, 0xeb, 0x29, 0x62, 0x7a, 0x06, 0x94, 0x49, 0x4e, 0x99, 0x10, 0x68, 0x98, 0xe1, 0x28, 0x74,
-	0x71, 0xdb, 0x4e, 0x50, 0x67, 0xa8, 0x69, 0xcc, 0x34, 0xb8, 0x57, 0xe9, 0x79, 0x27, 0x06, 0xc3,
-	0x3a, 0x54, 0xb2, 0x94, 0x0b, 0x0b, 0x17, 0xec, 0x56, 0xe9, 0xaa, 0x92, 0x29, 0x96, 0x15, 0xba,
-	0x66, 0xd5, 0x0f, 0x0e, 0x96, 0x92, 0x81, 0x6d, 0x0e, 0x61, 0x9c, 0x83, 0x36, 0xcd, 0x43, 0x7f,
-	0xfd, 0x4b, 0x57, 0x4b, 0x14, 0x1a, 0x82, 0x7d, 0xbf, 0xe2, 0x86, 0x37, 0x48, 0x83, 0xb4, 0x56,
-	0xbb, 0xf5, 0xa8, 0xec, 0xa7, 0x91, 0x9b, 0xea, 0xaf, 0x4c, 0x9f, 0x36, 0xbd, 0xbb, 0xd7, 0xfb,
-	0x36, 0x19, 0x16, 0x63, 0xdd, 0x5b, 0xe2, 0xff, 0xb7, 0xe2, 0xe0, 0x9a, 0xf8, 0x15, 0xc7, 0x05,
-	0xad, 0x72, 0xcb, 0xf7, 0xb5, 0x6a, 0xdb, 0x7f, 0x20, 0xdd, 0xaa, 0xcd, 0xde, 0xe5, 0xc3, 0xcb,
-	0xcd, 0xbf, 0xdd, 0x60, 0x87, 0x4a, 0x85, 0xc7, 0x79, 0x62, 0x74, 0xc2, 0x6d, 0x10, 0xbf, 0x44,
-	0xd2, 0x1f, 0x4c, 0xe7, 0x21, 0x99, 0xcd, 0x43, 0xf2, 0x3c, 0x0f, 0xc9, 0xd5, 0x22, 0xf4, 0x66,
-	0x8b, 0xd0, 0x7b, 0x5c, 0x84, 0xde, 0xd1, 0x5e, 0xca, 0xcd, 0x69, 0x1e, 0x47, 0x09, 0x66, 0x3f,
-	0x0b, 0x2f, 0x3e, 0x2b, 0xcd, 0x44, 0x82, 0x8e, 0x2b, 0x36, 0xe5, 0xde, 0x5b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x1c, 0xdc, 0xfd, 0x35, 0x02, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x26, 0x6d, 0x4a, 0x36, 0x70, 0x60, 0xc9, 0xc1, 0x84, 0x62, 0x12, 0x1f, 0x20, 0x14,
+	0xe1, 0xa5, 0xa9, 0xc4, 0x15, 0x11, 0x89, 0x9f, 0x03, 0x87, 0x60, 0x21, 0x0e, 0x5c, 0xaa, 0xb5,
+	0x33, 0x38, 0x56, 0xeb, 0x5d, 0xd7, 0xbb, 0x46, 0xe4, 0xca, 0x13, 0x20, 0x78, 0x08, 0x7a, 0xe4,
+	0x29, 0x50, 0x8f, 0x95, 0xb8, 0x70, 0x40, 0x08, 0x25, 0x48, 0xbc, 0x06, 0xf2, 0xae, 0x43, 0x12,
+	0x61, 0x42, 0xc5, 0xc5, 0x5a, 0xcf, 0x7c, 0xdf, 0xcc, 0xb7, 0x33, 0xdf, 0xe2, 0x4e, 0xc4, 0x5f,
+	0x42, 0x0a, 0x3c, 0x00, 0xea, 0x0b, 0x71, 0x70, 0x00, 0x90, 0x40, 0x4a, 0x8f, 0x32, 0x48, 0x27,
+	0x6e, 0x92, 0x0a, 0x25, 0x48, 0xeb, 0x37, 0xc2, 0x5d, 0x20, 0xda, 0x17, 0x59, 0x1c, 0x71, 0x41,
+	0xf5, 0xd7, 0x00, 0xdb, 0xad, 0x50, 0x84, 0x42, 0x1f, 0x69, 0x7e, 0x2a, 0xa2, 0xdb, 0xa1, 0x10,
+	0xe1, 0x21, 0x50, 0x96, 0x44, 0x94, 0x71, 0x2e, 0x14, 0x53, 0x91, 0xe0, 0xb2, 0xc8, 0xee, 0x04,
+	0x42, 0xc6, 0x42, 0x52, 0x9f, 0x49, 0x30, 0x5d, 0xe9, 0xab, 0x5d, 0x1f, 0x14, 0xdb, 0xa5, 0x09,
+	0x0b, 0x23, 0xae, 0xc1, 0x05, 0xb6, 0x5b, 0x2a, 0x35, 0x61, 0x29, 0x8b, 0xe5, 0x5a, 0xc8, 0x21,
+	0x8c, 0x42, 0x48, 0x0d, 0xc4, 0x69, 0x61, 0xf2, 0x34, 0xef, 0x33, 0xd4, 0x3c, 0x0f, 0x8e, 0x32,
+	0x90, 0xca, 0x79, 0x8e, 0x2f, 0xad, 0x44, 0x65, 0x22, 0xb8, 0x04, 0x72, 0x0f, 0xd7, 0x4d, 0x7d,
+	0x0b, 0x75, 0x50, 0xaf, 0xd9, 0xdf, 0x76, 0xcb, 0x86, 0xe1, 0x1a, 0xd6, 0xa0, 0x71, 0xf2, 0xed,
+	0x5a, 0xe5, 0xf8, 0xe7, 0xc7, 0x1d, 0xe4, 0x15, 0x34, 0xe7, 0x2b, 0xc2, 0x97, 0x75, 0xe1, 0x27,
+	0x5a, 0xc3, 0x03, 0xae, 0xd2, 0x08, 0xe6, 0x5d, 0x89, 0x85, 0xb7, 0x58, 0x10, 0x88, 0x8c, 0x2b,
+	0x5d, 0xbf, 0xe1, 0xcd, 0x7f, 0xc9, 0x15, 0xdc, 0x88, 0x21, 0x16, 0xfb, 0x6a, 0x92, 0x80, 0x55,
+	0xd5, 0xb9, 0x73, 0x79, 0xe0, 0xd9, 0x24, 0x01, 0xd2, 0xc5, 0xe7, 0xa5, 0x62, 0xa9, 0xda, 0x1f,
+	0x43, 0x14, 0x8e, 0x95, 0x55, 0xeb, 0xa0, 0x5e, 0xcd, 0x6b, 0xea, 0xd8, 0x63, 0x1d, 0x22, 0x57,
+	0x31, 0x06, 0x3e, 0x9a, 0x03, 0x36, 0x34, 0xa0, 0x01, 0x7c, 0x54, 0xa4, 0x1f, 0x62, 0xbc, 0x18,
+	0xaf, 0xb5, 0xa9, 0xef, 0x76, 0xdd, 0x35, 0xbb, 0x70, 0xf3, 0x5d, 0xb8, 0xc6, 0x01, 0xc5, 0x2e,
+	0xdc, 0x21, 0x0b, 0xa1, 0x10, 0xed, 0x2d, 0x31, 0x9d, 0x63, 0x84, 0xdb, 0x65, 0xd7, 0x2b, 0xc6,
+	0x77, 0x1f, 0x6f, 0x81, 0x09, 0x59, 0xa8, 0x53, 0xeb, 0x35, 0xfb, 0xdd, 0xf2, 0xf9, 0x2d, 0xd8,
+	0x93, 0xc1, 0x46, 0x3e, 0x44, 0x6f, 0xce, 0x23, 0x8f, 0x56, 0x94, 0x56, 0xb5, 0xd2, 0x1b, 0xff,
+	0x54, 0x6a, 0xfa, 0x2f, 0x4b, 0xed, 0x7f, 0xaa, 0xe2, 0x4d, 0x2d, 0x95, 0xbc, 0x43, 0xb8, 0x6e,
+	0x36, 0x46, 0x7a, 0xe5, 0x7a, 0xfe, 0x34, 0x48, 0xfb, 0xe6, 0x19, 0x90, 0xa6, 0xab, 0xb3, 0xf7,
+	0xe6, 0xf3, 0x8f, 0xf7, 0xd5, 0xdb, 0xe4, 0x16, 0x4d, 0x52, 0x31, 0xca, 0x02, 0x25, 0x83, 0x48,
+	0x5b, 0x72, 0x8d, 0x7f, 0xc9, 0x07, 0x84, 0x2f, 0xac, 0x0c, 0x91, 0xd0, 0x35, 0x1d, 0xcb, 0xdc,
+	0xd4, 0xbe, 0x73, 0x76, 0xc2, 0x7f, 0x29, 0x35, 0xcf, 0x68, 0x30, 0x3c, 0x99, 0xda, 0xe8, 0x74,
+	0x6a, 0xa3, 0xef, 0x53, 0x1b, 0xbd, 0x9d, 0xd9, 0x95, 0xd3, 0x99, 0x5d, 0xf9, 0x32, 0xb3, 0x2b,
+	0x2f, 0xee, 0x86, 0x91, 0x1a, 0x67, 0xbe, 0x1b, 0x88, 0xf8, 0xef, 0x05, 0x5f, 0x2f, 0x97, 0xcc,
+	0xed, 0x2d, 0xfd, 0xba, 0x7e, 0x99, 0x7b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x6b, 0xf4,
+	0xe7, 0x8c, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -160,6 +307,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries ledger entries, optionally filtered by account, memo type and height range.
+	LedgerEntries(ctx context.Context, in *QueryLedgerEntriesRequest, opts ...grpc.CallOption) (*QueryLedgerEntriesResponse, error)
 }
 
 type queryClient struct {
@@ -179,10 +328,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) LedgerEntries(ctx context.Context, in *QueryLedgerEntriesRequest, opts ...grpc.CallOption) (*QueryLedgerEntriesResponse, error) {
+	out := new(QueryLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, "/inference.bookkeeper.Query/LedgerEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries ledger entries, optionally filtered by account, memo type and height range.
+	LedgerEntries(context.Context, *QueryLedgerEntriesRequest) (*QueryLedgerEntriesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -192,6 +352,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) LedgerEntries(ctx context.Context, req *QueryLedgerEntriesRequest) (*QueryLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LedgerEntries not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -215,6 +378,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bookkeeper.Query/LedgerEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LedgerEntries(ctx, req.(*QueryLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.bookkeeper.Query",
@@ -224,6 +405,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "LedgerEntries",
+			Handler:    _Query_LedgerEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bookkeeper/query.proto",
@@ -285,6 +470,114 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLedgerEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLedgerEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLedgerEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MemoType) > 0 {
+		i -= len(m.MemoType)
+		copy(dAtA[i:], m.MemoType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MemoType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLedgerEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLedgerEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLedgerEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -316,6 +609,52 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLedgerEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MemoType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLedgerEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -455,6 +794,314 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLedgerEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLedgerEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLedgerEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLedgerEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLedgerEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLedgerEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, LedgerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,420 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// SetDelegation stores the collateral a delegator locked on behalf of a participant and updates the
+// participant's delegated total and delegator count
+func (k Keeper) SetDelegation(ctx context.Context, delegation types.CollateralDelegation) {
+	participantAddr, delegatorAddr := delegationAddresses(delegation.Participant, delegation.Delegator)
+	total := k.GetDelegatedCollateral(ctx, participantAddr)
+	existing, found := k.GetDelegation(ctx, participantAddr, delegatorAddr)
+	if found {
+		total = total.Sub(existing.Amount)
+	} else {
+		k.setDelegatorCount(ctx, participantAddr, k.GetDelegatorCount(ctx, participantAddr)+1)
+	}
+	k.setDelegatedCollateral(ctx, participantAddr, total.Add(delegation.Amount))
+
+	if err := k.Delegations.Set(ctx, collections.Join(participantAddr, delegatorAddr), delegation); err != nil {
+		panic(err)
+	}
+}
+
+// GetDelegation retrieves the collateral a delegator locked on behalf of a participant
+func (k Keeper) GetDelegation(ctx context.Context, participantAddress sdk.AccAddress, delegatorAddress sdk.AccAddress) (types.CollateralDelegation, bool) {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(participantAddress, delegatorAddress))
+	return delegation, err == nil
+}
+
+// RemoveDelegation removes a delegation from the store and from the participant's delegated total
+func (k Keeper) RemoveDelegation(ctx context.Context, participantAddress sdk.AccAddress, delegatorAddress sdk.AccAddress) {
+	existing, found := k.GetDelegation(ctx, participantAddress, delegatorAddress)
+	if !found {
+		return
+	}
+	k.setDelegatedCollateral(ctx, participantAddress, k.GetDelegatedCollateral(ctx, participantAddress).Sub(existing.Amount))
+	k.setDelegatorCount(ctx, participantAddress, k.GetDelegatorCount(ctx, participantAddress)-1)
+
+	if err := k.Delegations.Remove(ctx, collections.Join(participantAddress, delegatorAddress)); err != nil {
+		panic(err)
+	}
+}
+
+// GetDelegationsByParticipant returns the delegations backing a participant, ordered by delegator
+func (k Keeper) GetDelegationsByParticipant(ctx context.Context, participantAddress sdk.AccAddress) []types.CollateralDelegation {
+	iter, err := k.Delegations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](participantAddress))
+	if err != nil {
+		panic(err)
+	}
+	delegations, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return delegations
+}
+
+// GetAllDelegations returns all delegations (for genesis export)
+func (k Keeper) GetAllDelegations(ctx context.Context) []types.CollateralDelegation {
+	iter, err := k.Delegations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	delegations, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return delegations
+}
+
+// GetDelegatedCollateral returns the total collateral delegated to a participant
+func (k Keeper) GetDelegatedCollateral(ctx context.Context, participantAddress sdk.AccAddress) sdk.Coin {
+	total, err := k.DelegatedTotals.Get(ctx, participantAddress)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	return total
+}
+
+func (k Keeper) setDelegatedCollateral(ctx context.Context, participantAddress sdk.AccAddress, total sdk.Coin) {
+	var err error
+	if total.IsZero() {
+		err = k.DelegatedTotals.Remove(ctx, participantAddress)
+	} else {
+		err = k.DelegatedTotals.Set(ctx, participantAddress, total)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetDelegatorCount returns the number of accounts delegating collateral to a participant
+func (k Keeper) GetDelegatorCount(ctx context.Context, participantAddress sdk.AccAddress) uint64 {
+	count, err := k.DelegatorCounts.Get(ctx, participantAddress)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return 0
+	}
+	return count
+}
+
+func (k Keeper) setDelegatorCount(ctx context.Context, participantAddress sdk.AccAddress, count uint64) {
+	var err error
+	if count == 0 {
+		err = k.DelegatorCounts.Remove(ctx, participantAddress)
+	} else {
+		err = k.DelegatorCounts.Set(ctx, participantAddress, count)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetTotalCollateral returns the collateral backing a participant: its own and the delegated one
+func (k Keeper) GetTotalCollateral(ctx context.Context, participantAddress sdk.AccAddress) (sdk.Coin, bool) {
+	own, found := k.GetCollateral(ctx, participantAddress)
+	if !found {
+		own = sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	delegated := k.GetDelegatedCollateral(ctx, participantAddress)
+	if !found && delegated.IsZero() {
+		return sdk.Coin{}, false
+	}
+	return own.Add(delegated), true
+}
+
+// AddDelegatedUnbonding stores an unbonding entry of delegated collateral, adding to the amount if one
+// already exists for the same participant, delegator and epoch.
+func (k Keeper) AddDelegatedUnbonding(ctx context.Context, participantAddress sdk.AccAddress, delegatorAddress sdk.AccAddress, completionEpoch uint64, amount sdk.Coin) {
+	pk := collections.Join3(completionEpoch, participantAddress, delegatorAddress)
+	existing, err := k.DelegatedUnbondingIM.Get(ctx, pk)
+	if err == nil {
+		amount = amount.Add(existing.Amount)
+	}
+
+	k.setDelegatedUnbondingEntry(ctx, types.UnbondingCollateral{
+		Participant:     participantAddress.String(),
+		Delegator:       delegatorAddress.String(),
+		CompletionEpoch: completionEpoch,
+		Amount:          amount,
+	})
+}
+
+func (k Keeper) setDelegatedUnbondingEntry(ctx context.Context, unbonding types.UnbondingCollateral) {
+	participantAddr, delegatorAddr := delegationAddresses(unbonding.Participant, unbonding.Delegator)
+	pk := collections.Join3(unbonding.CompletionEpoch, participantAddr, delegatorAddr)
+	if err := k.DelegatedUnbondingIM.Set(ctx, pk, unbonding); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) removeDelegatedUnbondingEntry(ctx context.Context, unbonding types.UnbondingCollateral) {
+	participantAddr, delegatorAddr := delegationAddresses(unbonding.Participant, unbonding.Delegator)
+	pk := collections.Join3(unbonding.CompletionEpoch, participantAddr, delegatorAddr)
+	if err := k.DelegatedUnbondingIM.Remove(ctx, pk); err != nil {
+		panic(err)
+	}
+}
+
+// GetDelegatedUnbondingByParticipant returns the unbonding entries of collateral delegated to a participant
+func (k Keeper) GetDelegatedUnbondingByParticipant(ctx context.Context, participantAddress sdk.AccAddress) []types.UnbondingCollateral {
+	idxIter, err := k.DelegatedUnbondingIM.Indexes.ByParticipant.MatchExact(ctx, participantAddress)
+	if err != nil {
+		panic(err)
+	}
+	defer idxIter.Close()
+	var list []types.UnbondingCollateral
+	for ; idxIter.Valid(); idxIter.Next() {
+		pk, err := idxIter.PrimaryKey()
+		if err != nil {
+			panic(err)
+		}
+		v, err := k.DelegatedUnbondingIM.Get(ctx, pk)
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// GetDelegatedUnbondingByEpoch returns the unbonding entries of delegated collateral completing at an epoch
+func (k Keeper) GetDelegatedUnbondingByEpoch(ctx context.Context, completionEpoch uint64) []types.UnbondingCollateral {
+	iter, err := k.DelegatedUnbondingIM.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](completionEpoch))
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// GetAllDelegatedUnbondings returns all unbonding entries of delegated collateral (for genesis export)
+func (k Keeper) GetAllDelegatedUnbondings(ctx context.Context) []types.UnbondingCollateral {
+	iter, err := k.DelegatedUnbondingIM.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// processDelegatedUnbondingQueue releases the delegated collateral completing at an epoch to the delegators
+func (k Keeper) processDelegatedUnbondingQueue(ctx sdk.Context, completionEpoch uint64) {
+	for _, entry := range k.GetDelegatedUnbondingByEpoch(ctx, completionEpoch) {
+		_, delegatorAddr := delegationAddresses(entry.Participant, entry.Delegator)
+		k.removeDelegatedUnbondingEntry(ctx, entry)
+
+		err := k.bookkeepingBankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddr, sdk.NewCoins(entry.Amount), "delegated collateral unbonded")
+		if err != nil {
+			panic(fmt.Sprintf("failed to release unbonding delegated collateral for %s: %v", entry.Delegator, err))
+		}
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, entry.Delegator, types.ModuleName, types.SubAccountUnbonding, entry.Amount, "delegated collateral unbonded")
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProcessWithdrawal,
+				sdk.NewAttribute(types.AttributeKeyParticipant, entry.Participant),
+				sdk.NewAttribute(types.AttributeKeyDelegator, entry.Delegator),
+				sdk.NewAttribute(types.AttributeKeyAmount, entry.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
+			),
+		)
+
+		k.Logger().Info("processed delegated collateral withdrawal",
+			"participant", entry.Participant,
+			"delegator", entry.Delegator,
+			"amount", entry.Amount.String(),
+			"completion_epoch", completionEpoch,
+		)
+	}
+}
+
+// slashDelegations takes the slash fraction of the collateral delegated to a participant, both active
+// and unbonding, and returns the total taken.
+func (k Keeper) slashDelegations(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec) sdk.Coin {
+	total := sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+
+	for _, delegation := range k.GetDelegationsByParticipant(ctx, participantAddress) {
+		slashAmount := sdk.NewCoin(delegation.Amount.Denom, math.LegacyNewDecFromInt(delegation.Amount.Amount).Mul(slashFraction).TruncateInt())
+		if slashAmount.IsZero() {
+			continue
+		}
+		delegation.Amount = delegation.Amount.Sub(slashAmount)
+		if delegation.Amount.IsZero() {
+			_, delegatorAddr := delegationAddresses(delegation.Participant, delegation.Delegator)
+			k.RemoveDelegation(ctx, participantAddress, delegatorAddr)
+		} else {
+			k.SetDelegation(ctx, delegation)
+		}
+		total = total.Add(slashAmount)
+	}
+
+	for _, entry := range k.GetDelegatedUnbondingByParticipant(ctx, participantAddress) {
+		slashAmount := sdk.NewCoin(entry.Amount.Denom, math.LegacyNewDecFromInt(entry.Amount.Amount).Mul(slashFraction).TruncateInt())
+		if slashAmount.IsZero() {
+			continue
+		}
+		entry.Amount = entry.Amount.Sub(slashAmount)
+		if entry.Amount.IsZero() {
+			k.removeDelegatedUnbondingEntry(ctx, entry)
+		} else {
+			k.setDelegatedUnbondingEntry(ctx, entry)
+		}
+		total = total.Add(slashAmount)
+	}
+
+	return total
+}
+
+// SetRewardShare stores the part of a participant's rewards paid to its delegators
+func (k Keeper) SetRewardShare(ctx context.Context, share types.DelegatorRewardShare) {
+	participantAddr, err := sdk.AccAddressFromBech32(share.Participant)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.DelegatorRewardShares.Set(ctx, participantAddr, share); err != nil {
+		panic(err)
+	}
+}
+
+// UpdateRewardShare sets the part of a participant's rewards paid to its delegators from the next
+// epoch on. The share of the current epoch is kept until then, so a participant can't cut it just
+// before its rewards are settled.
+func (k Keeper) UpdateRewardShare(ctx context.Context, participantAddress sdk.AccAddress, rewardShare math.LegacyDec) types.DelegatorRewardShare {
+	currentEpoch := k.currentEpochOrZero(ctx)
+	share := types.DelegatorRewardShare{
+		Participant:         participantAddress.String(),
+		RewardShare:         rewardShare,
+		PreviousRewardShare: k.GetRewardShare(ctx, participantAddress, currentEpoch),
+		EffectiveEpoch:      currentEpoch + 1,
+	}
+	k.SetRewardShare(ctx, share)
+	return share
+}
+
+// GetRewardShare returns the part of a participant's rewards for an epoch paid to its delegators,
+// none unless the participant set one
+func (k Keeper) GetRewardShare(ctx context.Context, participantAddress sdk.AccAddress, epoch uint64) math.LegacyDec {
+	share, err := k.DelegatorRewardShares.Get(ctx, participantAddress)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return math.LegacyZeroDec()
+	}
+	if epoch >= share.EffectiveEpoch {
+		return share.RewardShare
+	}
+	if share.PreviousRewardShare.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return share.PreviousRewardShare
+}
+
+// GetAllRewardShares returns all delegator reward shares (for genesis export)
+func (k Keeper) GetAllRewardShares(ctx context.Context) []types.DelegatorRewardShare {
+	iter, err := k.DelegatorRewardShares.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	shares, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return shares
+}
+
+// GetDelegatorRewards returns the reward share of a participant for an epoch and the delegations it
+// is split between, as they were when the epoch started. There is nothing to split when the share is
+// zero or there were no delegations.
+func (k Keeper) GetDelegatorRewards(ctx context.Context, participantAddress sdk.AccAddress, epoch uint64) (math.LegacyDec, []types.CollateralDelegation) {
+	share := k.GetRewardShare(ctx, participantAddress, epoch)
+	if !share.IsPositive() {
+		return share, nil
+	}
+	return share, k.GetDelegationSnapshot(ctx, participantAddress, epoch)
+}
+
+// SnapshotDelegations stores the delegations of the participants sharing their rewards in an epoch,
+// both the share and the delegations are then fixed for the whole epoch. Delegating just before the
+// epoch is settled earns nothing for it.
+func (k Keeper) SnapshotDelegations(ctx context.Context, epoch uint64) {
+	for _, share := range k.GetAllRewardShares(ctx) {
+		participantAddr, err := sdk.AccAddressFromBech32(share.Participant)
+		if err != nil {
+			panic(err)
+		}
+		if !k.GetRewardShare(ctx, participantAddr, epoch).IsPositive() {
+			continue
+		}
+		for _, delegation := range k.GetDelegationsByParticipant(ctx, participantAddr) {
+			_, delegatorAddr := delegationAddresses(delegation.Participant, delegation.Delegator)
+			if err := k.DelegationSnapshots.Set(ctx, collections.Join3(epoch, participantAddr, delegatorAddr), delegation); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// GetDelegationSnapshot returns the delegations of a participant snapshotted for an epoch, ordered by delegator
+func (k Keeper) GetDelegationSnapshot(ctx context.Context, participantAddress sdk.AccAddress, epoch uint64) []types.CollateralDelegation {
+	iter, err := k.DelegationSnapshots.Iterate(ctx, collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](epoch, participantAddress))
+	if err != nil {
+		panic(err)
+	}
+	delegations, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return delegations
+}
+
+// RemoveDelegationSnapshot removes the delegations snapshotted for an epoch once it is settled
+func (k Keeper) RemoveDelegationSnapshot(ctx context.Context, epoch uint64) {
+	if err := k.DelegationSnapshots.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](epoch)); err != nil {
+		panic(err)
+	}
+}
+
+// currentEpochOrZero is the current epoch, zero before the first epoch transition
+func (k Keeper) currentEpochOrZero(ctx context.Context) uint64 {
+	epoch, err := k.CurrentEpoch.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return epoch
+}
+
+func delegationAddresses(participant string, delegator string) (sdk.AccAddress, sdk.AccAddress) {
+	participantAddr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		panic(err)
+	}
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		panic(err)
+	}
+	return participantAddr, delegatorAddr
+}
@@ -0,0 +1,380 @@
+package keeper_test
+
+import (
+	"github.com/productscience/inference/testutil/sample"
+	collateralmodule "github.com/productscience/inference/x/collateral/module"
+	"github.com/productscience/inference/x/collateral/types"
+	inftypes "github.com/productscience/inference/x/inference/types"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *KeeperTestSuite) setDelegationLimits(minDelegation int64, maxDelegators uint32) {
+	params := s.k.GetParams(s.ctx)
+	params.MinDelegation = math.NewInt(minDelegation)
+	params.MaxDelegatorsPerParticipant = maxDelegators
+	s.Require().NoError(s.k.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestMsgDelegateCollateral_Success() {
+	s.setDelegationLimits(100, types.DefaultMaxDelegatorsPerParticipant)
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	deposit := sdk.NewInt64Coin(inftypes.BaseCoin, 100)
+
+	s.inferenceKeeper.EXPECT().HasParticipant(s.ctx, participant).Return(true).Times(2)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(s.ctx, delegator, types.ModuleName, sdk.NewCoins(deposit), "collateral delegation").
+		Return(nil).
+		Times(2)
+	s.bankKeeper.EXPECT().
+		LogSubAccountTransaction(s.ctx, types.ModuleName, delegator.String(), types.SubAccountCollateral, deposit, "collateral delegation").
+		Times(2)
+
+	msg := &types.MsgDelegateCollateral{
+		Delegator:   delegator.String(),
+		Participant: participant.String(),
+		Amount:      deposit,
+	}
+	_, err := s.msgServer.DelegateCollateral(s.ctx, msg)
+	s.Require().NoError(err)
+	_, err = s.msgServer.DelegateCollateral(s.ctx, msg)
+	s.Require().NoError(err)
+
+	delegation, found := s.k.GetDelegation(s.ctx, participant, delegator)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(200), delegation.Amount.Amount)
+
+	// The participant's own collateral is untouched, the delegation counts towards its total
+	_, found = s.k.GetCollateral(s.ctx, participant)
+	s.Require().False(found)
+	total, found := s.k.GetTotalCollateral(s.ctx, participant)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(200), total.Amount)
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 50))
+	total, found = s.k.GetTotalCollateral(s.ctx, participant)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(250), total.Amount)
+}
+
+func (s *KeeperTestSuite) TestMsgDelegateCollateral_InvalidDenom() {
+	msg := &types.MsgDelegateCollateral{
+		Delegator:   sample.AccAddress(),
+		Participant: sample.AccAddress(),
+		Amount:      sdk.NewInt64Coin("faketoken", 100),
+	}
+
+	_, err := s.msgServer.DelegateCollateral(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+}
+
+func (s *KeeperTestSuite) TestMsgDelegateCollateral_NotAParticipant() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	s.inferenceKeeper.EXPECT().HasParticipant(s.ctx, participant).Return(false)
+
+	_, err := s.msgServer.DelegateCollateral(s.ctx, &types.MsgDelegateCollateral{
+		Delegator:   sample.AccAddress(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 100),
+	})
+	s.Require().ErrorIs(err, types.ErrParticipantNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgDelegateCollateral_Limits() {
+	s.setDelegationLimits(100, 1)
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	s.k.SetCurrentEpoch(s.ctx, 10)
+	s.inferenceKeeper.EXPECT().HasParticipant(s.ctx, participant).Return(true).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, gomock.Any(), types.ModuleName, gomock.Any(), "collateral delegation").Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(s.ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	delegate := func(delegator sdk.AccAddress, amount int64) error {
+		_, err := s.msgServer.DelegateCollateral(s.ctx, &types.MsgDelegateCollateral{
+			Delegator:   delegator.String(),
+			Participant: participant.String(),
+			Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, amount),
+		})
+		return err
+	}
+	undelegate := func(delegator sdk.AccAddress, amount int64) error {
+		_, err := s.msgServer.UndelegateCollateral(s.ctx, &types.MsgUndelegateCollateral{
+			Delegator:   delegator.String(),
+			Participant: participant.String(),
+			Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, amount),
+		})
+		return err
+	}
+
+	s.Require().ErrorIs(delegate(delegator1, 50), types.ErrDelegationTooSmall)
+	s.Require().NoError(delegate(delegator1, 100))
+	s.Require().ErrorIs(delegate(delegator2, 100), types.ErrTooManyDelegators)
+	// Topping up an existing delegation doesn't add a delegator
+	s.Require().NoError(delegate(delegator1, 10))
+	s.Require().Equal(uint64(1), s.k.GetDelegatorCount(s.ctx, participant))
+	s.Require().Equal(math.NewInt(110), s.k.GetDelegatedCollateral(s.ctx, participant).Amount)
+
+	s.Require().ErrorIs(undelegate(delegator1, 50), types.ErrDelegationTooSmall)
+	s.Require().NoError(undelegate(delegator1, 110))
+	s.Require().Zero(s.k.GetDelegatorCount(s.ctx, participant))
+	s.Require().True(s.k.GetDelegatedCollateral(s.ctx, participant).IsZero())
+
+	s.Require().NoError(delegate(delegator2, 100))
+}
+
+func (s *KeeperTestSuite) TestMsgUndelegateCollateral_ReleasedToDelegator() {
+	s.setDelegationLimits(100, types.DefaultMaxDelegatorsPerParticipant)
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	s.k.SetDelegation(s.ctx, types.CollateralDelegation{
+		Delegator:   delegator.String(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 1000),
+	})
+	s.k.SetCurrentEpoch(s.ctx, 10)
+
+	withdrawCoin := sdk.NewInt64Coin(inftypes.BaseCoin, 400)
+	s.bankKeeper.EXPECT().
+		LogSubAccountTransaction(s.ctx, gomock.Any(), gomock.Any(), gomock.Any(), withdrawCoin, "delegated collateral to unbonding").
+		Times(2)
+
+	res, err := s.msgServer.UndelegateCollateral(s.ctx, &types.MsgUndelegateCollateral{
+		Delegator:   delegator.String(),
+		Participant: participant.String(),
+		Amount:      withdrawCoin,
+	})
+	s.Require().NoError(err)
+	completionEpoch := uint64(10) + s.k.GetParams(s.ctx).UnbondingPeriodEpochs
+	s.Require().Equal(completionEpoch, res.CompletionEpoch)
+
+	delegation, found := s.k.GetDelegation(s.ctx, participant, delegator)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(600), delegation.Amount.Amount)
+
+	unbondings := s.k.GetDelegatedUnbondingByParticipant(s.ctx, participant)
+	s.Require().Len(unbondings, 1)
+	s.Require().Equal(delegator.String(), unbondings[0].Delegator)
+	s.Require().Equal(withdrawCoin, unbondings[0].Amount)
+
+	// The unbonding entry is listed with the participant's own ones
+	response, err := s.k.UnbondingCollateral(s.ctx, &types.QueryUnbondingCollateralRequest{Participant: participant.String()})
+	s.Require().NoError(err)
+	s.Require().Len(response.Unbondings, 1)
+
+	// Once the unbonding period ends the collateral goes back to the delegator, not the participant
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, delegator, sdk.NewCoins(withdrawCoin), "delegated collateral unbonded").
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		LogSubAccountTransaction(s.ctx, delegator.String(), types.ModuleName, types.SubAccountUnbonding, withdrawCoin, "delegated collateral unbonded").
+		Times(1)
+
+	s.k.AdvanceEpoch(s.ctx, completionEpoch)
+	s.Require().Empty(s.k.GetDelegatedUnbondingByParticipant(s.ctx, participant))
+}
+
+func (s *KeeperTestSuite) TestMsgUndelegateCollateral_Errors() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	msg := &types.MsgUndelegateCollateral{
+		Delegator:   delegator.String(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 200),
+	}
+	_, err := s.msgServer.UndelegateCollateral(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrNoDelegationFound)
+
+	s.k.SetDelegation(s.ctx, types.CollateralDelegation{
+		Delegator:   delegator.String(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 100),
+	})
+	_, err = s.msgServer.UndelegateCollateral(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientCollateral)
+}
+
+func (s *KeeperTestSuite) TestSlashing_Delegations() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.k.SetDelegation(s.ctx, types.CollateralDelegation{
+		Delegator:   delegator1.String(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 500),
+	})
+	s.k.SetDelegation(s.ctx, types.CollateralDelegation{
+		Delegator:   delegator2.String(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 5),
+	})
+	s.k.AddDelegatedUnbonding(s.ctx, participant, delegator2, 100, sdk.NewInt64Coin(inftypes.BaseCoin, 300))
+
+	// 10% of 1000 own, 500 and 300 delegated; the 5 delegated rounds down to nothing
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 180)), "collateral slashed").
+		Return(nil).
+		Times(1)
+
+	slashedAmount, err := s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(10, 2), types.SlashReasonInvalid, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(180), slashedAmount.Amount)
+
+	delegation, found := s.k.GetDelegation(s.ctx, participant, delegator1)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(450), delegation.Amount.Amount)
+	delegation, found = s.k.GetDelegation(s.ctx, participant, delegator2)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(5), delegation.Amount.Amount)
+	s.Require().Equal(math.NewInt(455), s.k.GetDelegatedCollateral(s.ctx, participant).Amount)
+
+	unbondings := s.k.GetDelegatedUnbondingByParticipant(s.ctx, participant)
+	s.Require().Len(unbondings, 1)
+	s.Require().Equal(math.NewInt(270), unbondings[0].Amount.Amount)
+}
+
+func (s *KeeperTestSuite) TestMsgSetDelegatorRewardShare() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegation := types.CollateralDelegation{
+		Delegator:   delegator.String(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 100),
+	}
+	s.k.SetDelegation(s.ctx, delegation)
+
+	s.k.SetCurrentEpoch(s.ctx, 5)
+
+	// Delegators earn nothing until the participant sets a share
+	share, delegations := s.k.GetDelegatorRewards(s.ctx, participant, 5)
+	s.Require().True(share.IsZero())
+	s.Require().Empty(delegations)
+
+	_, err := s.msgServer.SetDelegatorRewardShare(s.ctx, &types.MsgSetDelegatorRewardShare{
+		Participant: participant.String(),
+		RewardShare: math.LegacyNewDecWithPrec(25, 2),
+	})
+	s.Require().NoError(err)
+
+	// The share applies from the next epoch, the current one is settled with the previous share
+	share, _ = s.k.GetDelegatorRewards(s.ctx, participant, 5)
+	s.Require().True(share.IsZero())
+
+	// The rewards of an epoch go to the delegations it started with
+	s.k.AdvanceEpoch(s.ctx, 5)
+	share, delegations = s.k.GetDelegatorRewards(s.ctx, participant, 6)
+	s.Require().Equal(math.LegacyNewDecWithPrec(25, 2), share)
+	s.Require().Equal([]types.CollateralDelegation{delegation}, delegations)
+
+	lateDelegation := types.CollateralDelegation{
+		Delegator:   sample.AccAddress(),
+		Participant: participant.String(),
+		Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 100),
+	}
+	s.k.SetDelegation(s.ctx, lateDelegation)
+	_, delegations = s.k.GetDelegatorRewards(s.ctx, participant, 6)
+	s.Require().Equal([]types.CollateralDelegation{delegation}, delegations)
+	s.k.RemoveDelegation(s.ctx, participant, sdk.MustAccAddressFromBech32(lateDelegation.Delegator))
+
+	response, err := s.k.DelegatedCollateral(s.ctx, &types.QueryDelegatedCollateralRequest{Participant: participant.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(delegation.Amount, response.Delegated)
+	s.Require().Equal(math.LegacyNewDecWithPrec(25, 2), response.RewardShare)
+
+	// Cutting the share only affects the epochs after the current one
+	_, err = s.msgServer.SetDelegatorRewardShare(s.ctx, &types.MsgSetDelegatorRewardShare{
+		Participant: participant.String(),
+		RewardShare: math.LegacyZeroDec(),
+	})
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetDelegatorRewardShare(s.ctx, &types.MsgSetDelegatorRewardShare{
+		Participant: participant.String(),
+		RewardShare: math.LegacyNewDecWithPrec(1, 2),
+	})
+	s.Require().NoError(err)
+	share, _ = s.k.GetDelegatorRewards(s.ctx, participant, 6)
+	s.Require().Equal(math.LegacyNewDecWithPrec(25, 2), share)
+	share, _ = s.k.GetDelegatorRewards(s.ctx, participant, 7)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 2), share)
+
+	_, err = s.msgServer.SetDelegatorRewardShare(s.ctx, &types.MsgSetDelegatorRewardShare{
+		Participant: participant.String(),
+		RewardShare: math.LegacyNewDecWithPrec(15, 1),
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestCollateralDelegationsQuery() {
+	participant1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	participant2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	for _, pair := range [][2]sdk.AccAddress{{participant1, delegator1}, {participant1, delegator2}, {participant2, delegator1}} {
+		s.k.SetDelegation(s.ctx, types.CollateralDelegation{
+			Participant: pair[0].String(),
+			Delegator:   pair[1].String(),
+			Amount:      sdk.NewInt64Coin(inftypes.BaseCoin, 100),
+		})
+	}
+
+	response, err := s.k.CollateralDelegations(s.ctx, &types.QueryCollateralDelegationsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Delegations, 3)
+
+	response, err = s.k.CollateralDelegations(s.ctx, &types.QueryCollateralDelegationsRequest{Participant: participant1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(response.Delegations, 2)
+
+	response, err = s.k.CollateralDelegations(s.ctx, &types.QueryCollateralDelegationsRequest{Delegator: delegator1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(response.Delegations, 2)
+	for _, delegation := range response.Delegations {
+		s.Require().Equal(delegator1.String(), delegation.Delegator)
+	}
+
+	response, err = s.k.CollateralDelegations(s.ctx, &types.QueryCollateralDelegationsRequest{
+		Participant: participant1.String(),
+		Delegator:   delegator2.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Delegations, 1)
+
+	_, err = s.k.CollateralDelegations(s.ctx, &types.QueryCollateralDelegationsRequest{Delegator: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis_Delegations() {
+	participant := sample.AccAddress()
+	delegator := sample.AccAddress()
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		UnbondingCollateralList: []types.UnbondingCollateral{
+			{Participant: participant, CompletionEpoch: 100, Amount: sdk.NewInt64Coin(inftypes.BaseCoin, 100)},
+			{Participant: participant, Delegator: delegator, CompletionEpoch: 100, Amount: sdk.NewInt64Coin(inftypes.BaseCoin, 50)},
+		},
+		DelegationList: []types.CollateralDelegation{
+			{Participant: participant, Delegator: delegator, Amount: sdk.NewInt64Coin(inftypes.BaseCoin, 500)},
+		},
+		DelegatorRewardShareList: []types.DelegatorRewardShare{
+			{Participant: participant, RewardShare: math.LegacyNewDecWithPrec(1, 1), PreviousRewardShare: math.LegacyZeroDec(), EffectiveEpoch: 3},
+		},
+	}
+	s.Require().NoError(genesisState.Validate())
+
+	collateralmodule.InitGenesis(s.ctx, s.k, genesisState)
+
+	exportedGenesis := collateralmodule.ExportGenesis(s.ctx, s.k)
+	s.Require().NotNil(exportedGenesis)
+	s.Require().ElementsMatch(genesisState.UnbondingCollateralList, exportedGenesis.UnbondingCollateralList)
+	s.Require().ElementsMatch(genesisState.DelegationList, exportedGenesis.DelegationList)
+	s.Require().Equal(math.NewInt(500), s.k.GetDelegatedCollateral(s.ctx, sdk.MustAccAddressFromBech32(participant)).Amount)
+	s.Require().ElementsMatch(genesisState.DelegatorRewardShareList, exportedGenesis.DelegatorRewardShareList)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -24,6 +25,18 @@ type (
 		ByParticipant *indexes.ReversePair[uint64, sdk.AccAddress, types.UnbondingCollateral]
 	}
 
+	// DelegationIndexes groups the secondary indexes for the Delegations map
+	DelegationIndexes struct {
+		// ByDelegator indexes primary keys by delegator address, to allow queries by delegator
+		ByDelegator *indexes.ReversePair[sdk.AccAddress, sdk.AccAddress, types.CollateralDelegation]
+	}
+
+	// DelegatedUnbondingIndexes groups the secondary indexes for the DelegatedUnbondingIM map
+	DelegatedUnbondingIndexes struct {
+		// ByParticipant indexes primary keys by participant address, to slash them with the participant
+		ByParticipant *indexes.Multi[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.UnbondingCollateral]
+	}
+
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService store.KVStoreService
@@ -35,14 +48,31 @@ type (
 
 		bankViewKeeper        types.BankKeeper
 		bookkeepingBankKeeper types.BookkeepingBankKeeper
+		distributionKeeper    types.DistributionKeeper
+		getInferenceKeeper    func() types.InferenceKeeper
 		params                collections.Item[types.Params]
 		CollateralMap         collections.Map[sdk.AccAddress, sdk.Coin]
 		Schema                collections.Schema
 		CurrentEpoch          collections.Item[uint64]
 		Jailed                collections.KeySet[sdk.AccAddress]
+		SlashRecords          collections.Map[collections.Pair[sdk.AccAddress, uint64], types.SlashRecord]
+		SlashRecordSeq        collections.Sequence
 
 		// UnbondingIM is an IndexedMap with primary key Pair[completionEpoch, participant]
 		UnbondingIM collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], types.UnbondingCollateral, UnbondingIndexes]
+
+		// Delegations is an IndexedMap with primary key Pair[participant, delegator]
+		Delegations collections.IndexedMap[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.CollateralDelegation, DelegationIndexes]
+		// DelegatedUnbondingIM is an IndexedMap with primary key Triple[completionEpoch, participant, delegator]
+		DelegatedUnbondingIM  collections.IndexedMap[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.UnbondingCollateral, DelegatedUnbondingIndexes]
+		DelegatorRewardShares collections.Map[sdk.AccAddress, types.DelegatorRewardShare]
+		// DelegatedTotals and DelegatorCounts are kept with the delegations, so reading a participant's
+		// collateral doesn't iterate them
+		DelegatedTotals collections.Map[sdk.AccAddress, sdk.Coin]
+		DelegatorCounts collections.Map[sdk.AccAddress, uint64]
+		// DelegationSnapshots holds the delegations of participants sharing their rewards, taken when an
+		// epoch starts, with primary key Triple[epoch, participant, delegator]
+		DelegationSnapshots collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.CollateralDelegation]
 	}
 )
 
@@ -54,6 +84,8 @@ func NewKeeper(
 
 	bankKeeper types.BankKeeper,
 	bookkeepingBankKeeper types.BookkeepingBankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	getInferenceKeeper func() types.InferenceKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -69,6 +101,28 @@ func NewKeeper(
 		),
 	}
 
+	delegationIdx := DelegationIndexes{
+		ByDelegator: indexes.NewReversePair[types.CollateralDelegation](
+			sb,
+			types.DelegationsByDelegatorIndexKey,
+			"delegations_by_delegator",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+	}
+	delegatedUnbondingKeyCodec := collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey)
+	delegatedUnbondingIdx := DelegatedUnbondingIndexes{
+		ByParticipant: indexes.NewMulti(
+			sb,
+			types.DelegatedUnbondingByParticipantIndexKey,
+			"delegated_unbonding_by_participant",
+			sdk.AccAddressKey,
+			delegatedUnbondingKeyCodec,
+			func(pk collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], _ types.UnbondingCollateral) (sdk.AccAddress, error) {
+				return pk.K2(), nil
+			},
+		),
+	}
+
 	ak := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
@@ -77,10 +131,20 @@ func NewKeeper(
 
 		bankViewKeeper:        bankKeeper,
 		bookkeepingBankKeeper: bookkeepingBankKeeper,
+		distributionKeeper:    distributionKeeper,
+		getInferenceKeeper:    getInferenceKeeper,
 		params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		CollateralMap:         collections.NewMap(sb, types.CollateralKey, "collateral", sdk.AccAddressKey, codec.CollValue[sdk.Coin](cdc)),
 		CurrentEpoch:          collections.NewItem(sb, types.CurrentEpochKey, "current_epoch", collections.Uint64Value),
 		Jailed:                collections.NewKeySet(sb, types.JailedKey, "jailed", sdk.AccAddressKey),
+		SlashRecords: collections.NewMap(
+			sb,
+			types.SlashRecordsKey,
+			"slash_records",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.SlashRecord](cdc),
+		),
+		SlashRecordSeq: collections.NewSequence(sb, types.SlashRecordSeqKey, "slash_record_seq"),
 		UnbondingIM: *collections.NewIndexedMap(
 			sb,
 			types.UnbondingCollPrefix,
@@ -89,6 +153,26 @@ func NewKeeper(
 			codec.CollValue[types.UnbondingCollateral](cdc),
 			unbondingIdx,
 		),
+		Delegations: *collections.NewIndexedMap(
+			sb,
+			types.DelegationsKey,
+			"delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.CollateralDelegation](cdc),
+			delegationIdx,
+		),
+		DelegatedUnbondingIM: *collections.NewIndexedMap(
+			sb,
+			types.DelegatedUnbondingKey,
+			"delegated_unbonding",
+			delegatedUnbondingKeyCodec,
+			codec.CollValue[types.UnbondingCollateral](cdc),
+			delegatedUnbondingIdx,
+		),
+		DelegatorRewardShares: collections.NewMap(sb, types.DelegatorRewardSharesKey, "delegator_reward_shares", sdk.AccAddressKey, codec.CollValue[types.DelegatorRewardShare](cdc)),
+		DelegatedTotals:       collections.NewMap(sb, types.DelegatedTotalsKey, "delegated_totals", sdk.AccAddressKey, codec.CollValue[sdk.Coin](cdc)),
+		DelegatorCounts:       collections.NewMap(sb, types.DelegatorCountsKey, "delegator_counts", sdk.AccAddressKey, collections.Uint64Value),
+		DelegationSnapshots:   collections.NewMap(sb, types.DelegationSnapshotsKey, "delegation_snapshots", delegatedUnbondingKeyCodec, codec.CollValue[types.CollateralDelegation](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -264,7 +348,8 @@ func (k Keeper) SetCurrentEpoch(ctx sdk.Context, epoch uint64) {
 }
 
 // AdvanceEpoch is called by an external module (inference) to signal an epoch transition.
-// It processes the unbonding queue for the completed epoch and increments the internal epoch counter.
+// It processes the unbonding queue for the completed epoch, increments the internal epoch counter and
+// snapshots the delegations the rewards of the new epoch are shared with.
 func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	k.Logger().Info("advancing epoch in collateral module", "completed_epoch", completedEpoch)
@@ -275,6 +360,12 @@ func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) {
 	// Increment the epoch counter
 	nextEpoch := completedEpoch + 1
 	k.SetCurrentEpoch(sdkCtx, nextEpoch)
+
+	// The completed epoch is settled right after this, the one before it already was
+	if completedEpoch > 0 {
+		k.RemoveDelegationSnapshot(sdkCtx, completedEpoch-1)
+	}
+	k.SnapshotDelegations(sdkCtx, nextEpoch)
 }
 
 // ProcessUnbondingQueue iterates through all unbonding entries for a given epoch,
@@ -321,6 +412,8 @@ func (k Keeper) ProcessUnbondingQueue(ctx sdk.Context, completionEpoch uint64) {
 	if len(unbondingEntries) > 0 {
 		k.RemoveUnbondingByEpoch(ctx, completionEpoch)
 	}
+
+	k.processDelegatedUnbondingQueue(ctx, completionEpoch)
 }
 
 // GetAllUnbondings returns all unbonding entries (for genesis export)
@@ -380,10 +473,11 @@ func (k Keeper) GetAllJailed(ctx sdk.Context) []sdk.AccAddress {
 	return result
 }
 
-// Slash penalizes a participant by burning a fraction of their total collateral.
+// Slash penalizes a participant by taking a fraction of their total collateral.
 // This includes both their active collateral and any collateral in the unbonding queue.
-// The slash is applied proportionally to all holdings.
-func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec) (sdk.Coin, error) {
+// The slash is applied proportionally to all holdings. The slashed amount is split between
+// burning, the validators who caused the slash and the community pool, by the slash shares.
+func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec, reason string, validators []sdk.AccAddress) (sdk.Coin, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if slashFraction.IsNegative() || slashFraction.GT(math.LegacyOneDec()) {
 		return sdk.Coin{}, fmt.Errorf("slash fraction must be between 0 and 1, got %s", slashFraction)
@@ -429,28 +523,52 @@ func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, sl
 		}
 	}
 
-	// 3. Burn the total slashed amount from the module account
+	// 3. Slash collateral delegated to the participant, active and unbonding
+	totalSlashedAmount = totalSlashedAmount.Add(k.slashDelegations(ctx, participantAddress, slashFraction))
+
+	// 4. Distribute the total slashed amount from the module account
 	if !totalSlashedAmount.IsZero() {
-		err := k.bookkeepingBankKeeper.BurnCoins(sdkCtx, types.ModuleName, sdk.NewCoins(totalSlashedAmount), "collateral slashed")
+		record, err := k.distributeSlashed(sdkCtx, totalSlashedAmount, validators)
 		if err != nil {
 			// This is a critical error, indicating an issue with the module account or supply
-			return sdk.Coin{}, fmt.Errorf("failed to burn slashed coins: %w", err)
+			return sdk.Coin{}, err
+		}
+		record.Participant = participantAddress.String()
+		record.Reason = reason
+		record.SlashFraction = slashFraction.String()
+		record.BlockHeight = sdkCtx.BlockHeight()
+		// Zero until the inference module advances the first epoch
+		epoch, err := k.CurrentEpoch.Get(sdkCtx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, err
+		}
+		record.Epoch = epoch
+		if err := k.AddSlashRecord(sdkCtx, participantAddress, record); err != nil {
+			return sdk.Coin{}, err
 		}
 
-		// 4. Emit a slash event
+		// 5. Emit a slash event
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeSlashCollateral,
 				sdk.NewAttribute(types.AttributeKeyParticipant, participantAddress.String()),
 				sdk.NewAttribute(types.AttributeKeySlashAmount, totalSlashedAmount.String()),
 				sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+				sdk.NewAttribute(types.AttributeKeySlashReason, reason),
+				sdk.NewAttribute(types.AttributeKeyBurnedAmount, record.Burned.String()),
+				sdk.NewAttribute(types.AttributeKeyValidatorsAmount, record.ValidatorsAmount().String()),
+				sdk.NewAttribute(types.AttributeKeyCommunityPoolAmount, record.CommunityPool.String()),
 			),
 		)
 
 		k.Logger().Info("slashed participant collateral",
 			"participant", participantAddress.String(),
+			"reason", reason,
 			"slash_fraction", slashFraction.String(),
 			"slashed_amount", totalSlashedAmount.String(),
+			"burned_amount", record.Burned.String(),
+			"validator_payouts", len(record.ValidatorPayouts),
+			"community_pool_amount", record.CommunityPool.String(),
 		)
 	}
 
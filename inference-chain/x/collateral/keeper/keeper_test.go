@@ -15,10 +15,12 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx        sdk.Context
-	k          keeper.Keeper
-	bankKeeper *testkeeper.MockBookkeepingBankKeeper
-	msgServer  types.MsgServer
+	ctx                sdk.Context
+	k                  keeper.Keeper
+	bankKeeper         *testkeeper.MockBookkeepingBankKeeper
+	distributionKeeper *testkeeper.MockDistributionKeeper
+	inferenceKeeper    *testkeeper.MockInferenceKeeper
+	msgServer          types.MsgServer
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -27,6 +29,8 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = ctx
 	s.k = k
 	s.bankKeeper = mocks.BankKeeper
+	s.distributionKeeper = mocks.DistributionKeeper
+	s.inferenceKeeper = mocks.InferenceKeeper
 	s.msgServer = keeper.NewMsgServerImpl(s.k)
 }
 
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) DelegateCollateral(goCtx context.Context, msg *types.MsgDelegateCollateral) (*types.MsgDelegateCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure only base denomination is accepted
+	if msg.Amount.Denom != inferencetypes.BaseCoin {
+		return nil, types.ErrInvalidDenom.Wrapf("only %s denomination is accepted for collateral, got %s",
+			inferencetypes.BaseCoin, msg.Amount.Denom)
+	}
+
+	// Collateral can only back a registered participant
+	if k.getInferenceKeeper != nil && !k.getInferenceKeeper().HasParticipant(ctx, participantAddr) {
+		return nil, types.ErrParticipantNotFound.Wrapf("%s is not a participant", msg.Participant)
+	}
+
+	delegation, found := k.GetDelegation(ctx, participantAddr, delegatorAddr)
+	if found {
+		delegation.Amount = delegation.Amount.Add(msg.Amount)
+	} else {
+		delegation = types.CollateralDelegation{
+			Delegator:   msg.Delegator,
+			Participant: msg.Participant,
+			Amount:      msg.Amount,
+		}
+	}
+
+	// Every delegation is iterated when the participant is slashed or paid, so they are bounded
+	params := k.GetParams(ctx)
+	if delegation.Amount.Amount.LT(params.MinDelegation) {
+		return nil, types.ErrDelegationTooSmall.Wrapf("delegation of %s is below the minimum of %s%s",
+			delegation.Amount.String(), params.MinDelegation.String(), inferencetypes.BaseCoin)
+	}
+	if !found && k.GetDelegatorCount(ctx, participantAddr) >= uint64(params.MaxDelegatorsPerParticipant) {
+		return nil, types.ErrTooManyDelegators.Wrapf("%s already has %d delegators", msg.Participant, params.MaxDelegatorsPerParticipant)
+	}
+
+	// Transfer tokens from the delegator to the module account
+	err = k.bookkeepingBankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddr, types.ModuleName, sdk.NewCoins(msg.Amount), "collateral delegation")
+	if err != nil {
+		return nil, err
+	}
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Delegator, types.SubAccountCollateral, msg.Amount, "collateral delegation")
+
+	k.SetDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateCollateral,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
+	k.Logger().Info("collateral delegated",
+		"delegator", msg.Delegator,
+		"participant", msg.Participant,
+		"amount", msg.Amount.String(),
+		"total_delegation", delegation.Amount.String(),
+	)
+
+	return &types.MsgDelegateCollateralResponse{}, nil
+}
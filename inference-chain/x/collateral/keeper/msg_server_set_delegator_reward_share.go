@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+)
+
+func (k msgServer) SetDelegatorRewardShare(goCtx context.Context, msg *types.MsgSetDelegatorRewardShare) (*types.MsgSetDelegatorRewardShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateRewardShare(msg.RewardShare); err != nil {
+		return nil, err
+	}
+
+	share := k.UpdateRewardShare(ctx, participantAddr, msg.RewardShare)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetDelegatorRewardShare,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyRewardShare, msg.RewardShare.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveEpoch, strconv.FormatUint(share.EffectiveEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("delegator reward share set",
+		"participant", msg.Participant,
+		"reward_share", msg.RewardShare.String(),
+		"effective_epoch", share.EffectiveEpoch,
+	)
+
+	return &types.MsgSetDelegatorRewardShareResponse{}, nil
+}
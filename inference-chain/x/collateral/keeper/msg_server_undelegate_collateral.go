@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) UndelegateCollateral(goCtx context.Context, msg *types.MsgUndelegateCollateral) (*types.MsgUndelegateCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure only base denomination is accepted
+	if msg.Amount.Denom != inferencetypes.BaseCoin {
+		return nil, types.ErrInvalidDenom.Wrapf("only %s denomination is accepted for collateral, got %s",
+			inferencetypes.BaseCoin, msg.Amount.Denom)
+	}
+
+	delegation, found := k.GetDelegation(ctx, participantAddr, delegatorAddr)
+	if !found {
+		return nil, types.ErrNoDelegationFound.Wrapf("%s has no collateral delegated to %s", msg.Delegator, msg.Participant)
+	}
+
+	if delegation.Amount.IsLT(msg.Amount) {
+		return nil, types.ErrInsufficientCollateral.Wrapf("delegated collateral %s is less than withdrawal amount %s",
+			delegation.Amount.String(), msg.Amount.String())
+	}
+	remaining := delegation.Amount.Sub(msg.Amount)
+	if minDelegation := k.GetParams(ctx).MinDelegation; !remaining.IsZero() && remaining.Amount.LT(minDelegation) {
+		return nil, types.ErrDelegationTooSmall.Wrapf("remaining delegation of %s is below the minimum of %s%s, undelegate all of it instead",
+			remaining.String(), minDelegation.String(), inferencetypes.BaseCoin)
+	}
+
+	// Delegated collateral unbonds like the participant's own, it stays slashable until released
+	completionEpoch := k.GetCurrentEpoch(ctx) + k.GetParams(ctx).UnbondingPeriodEpochs
+	k.AddDelegatedUnbonding(ctx, participantAddr, delegatorAddr, completionEpoch, msg.Amount)
+
+	delegation.Amount = remaining
+	if delegation.Amount.IsZero() {
+		k.RemoveDelegation(ctx, participantAddr, delegatorAddr)
+	} else {
+		k.SetDelegation(ctx, delegation)
+	}
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, msg.Delegator, types.ModuleName, types.SubAccountCollateral, msg.Amount, "delegated collateral to unbonding")
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Delegator, types.SubAccountUnbonding, msg.Amount, "delegated collateral to unbonding")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegateCollateral,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("collateral undelegation initiated",
+		"delegator", msg.Delegator,
+		"participant", msg.Participant,
+		"amount", msg.Amount.String(),
+		"completion_epoch", completionEpoch,
+	)
+
+	return &types.MsgUndelegateCollateralResponse{
+		CompletionEpoch: completionEpoch,
+	}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -69,6 +70,7 @@ func (k Keeper) UnbondingCollateral(c context.Context, req *types.QueryUnbonding
 	}
 
 	unbondings := k.GetUnbondingByParticipant(ctx, participantAddr)
+	unbondings = append(unbondings, k.GetDelegatedUnbondingByParticipant(ctx, participantAddr)...)
 
 	return &types.QueryUnbondingCollateralResponse{Unbondings: unbondings}, nil
 }
@@ -99,3 +101,106 @@ func (k Keeper) AllUnbondingCollaterals(c context.Context, req *types.QueryAllUn
 
 	return &types.QueryAllUnbondingCollateralsResponse{Unbondings: allUnbondings, Pagination: pageRes}, nil
 }
+
+func (k Keeper) SlashHistory(c context.Context, req *types.QuerySlashHistoryRequest) (*types.QuerySlashHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var options []func(o *query.CollectionsPaginateOptions[collections.Pair[sdk.AccAddress, uint64]])
+	if req.Participant != "" {
+		participantAddr, err := sdk.AccAddressFromBech32(req.Participant)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid participant address: %v", err)
+		}
+		options = append(options, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](participantAddr))
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		c,
+		k.SlashRecords,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], record types.SlashRecord) (types.SlashRecord, error) {
+			return record, nil
+		},
+		options...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashHistoryResponse{SlashRecords: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CollateralDelegations(c context.Context, req *types.QueryCollateralDelegationsRequest) (*types.QueryCollateralDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var participantAddr, delegatorAddr sdk.AccAddress
+	var err error
+	if req.Participant != "" {
+		if participantAddr, err = sdk.AccAddressFromBech32(req.Participant); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid participant address: %v", err)
+		}
+	}
+	if req.Delegator != "" {
+		if delegatorAddr, err = sdk.AccAddressFromBech32(req.Delegator); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %v", err)
+		}
+	}
+
+	if delegatorAddr != nil && participantAddr == nil {
+		delegations, pageRes, err := query.CollectionPaginate(
+			c,
+			k.Delegations.Indexes.ByDelegator,
+			req.Pagination,
+			func(pk collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (types.CollateralDelegation, error) {
+				return k.Delegations.Get(c, collections.Join(pk.K2(), pk.K1()))
+			},
+			query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](delegatorAddr),
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryCollateralDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+	}
+
+	var options []func(o *query.CollectionsPaginateOptions[collections.Pair[sdk.AccAddress, sdk.AccAddress]])
+	if participantAddr != nil {
+		options = append(options, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](participantAddr))
+	}
+	delegations, pageRes, err := query.CollectionFilteredPaginate(
+		c,
+		&k.Delegations,
+		req.Pagination,
+		func(pk collections.Pair[sdk.AccAddress, sdk.AccAddress], _ types.CollateralDelegation) (bool, error) {
+			return delegatorAddr == nil || pk.K2().Equals(delegatorAddr), nil
+		},
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], delegation types.CollateralDelegation) (types.CollateralDelegation, error) {
+			return delegation, nil
+		},
+		options...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollateralDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DelegatedCollateral(c context.Context, req *types.QueryDelegatedCollateralRequest) (*types.QueryDelegatedCollateralResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	participantAddr, err := sdk.AccAddressFromBech32(req.Participant)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid participant address: %v", err)
+	}
+
+	return &types.QueryDelegatedCollateralResponse{
+		Delegated:   k.GetDelegatedCollateral(c, participantAddr),
+		RewardShare: k.GetRewardShare(c, participantAddr, k.currentEpochOrZero(c)),
+	}, nil
+}
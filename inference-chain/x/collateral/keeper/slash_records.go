@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/productscience/inference/x/collateral/types"
+)
+
+// distributeSlashed splits slashed collateral held by the module account by the slash shares. The
+// validators' share is paid in equal parts to the validators who caused the slash, and burned when
+// there are none. Whatever is left after rounding is burned.
+func (k Keeper) distributeSlashed(ctx sdk.Context, slashed sdk.Coin, validators []sdk.AccAddress) (types.SlashRecord, error) {
+	params := k.GetParams(ctx)
+	record := types.SlashRecord{
+		Slashed:       slashed,
+		Burned:        sdk.NewCoin(slashed.Denom, math.ZeroInt()),
+		CommunityPool: sdk.NewCoin(slashed.Denom, math.ZeroInt()),
+	}
+	remaining := slashed.Amount
+
+	validators = uniqueAddresses(validators)
+	validatorsAmount := shareOf(slashed.Amount, params.SlashValidatorsShare)
+	if len(validators) > 0 && validatorsAmount.IsPositive() {
+		perValidator := validatorsAmount.QuoRaw(int64(len(validators)))
+		if perValidator.IsPositive() {
+			for _, validator := range validators {
+				payout := sdk.NewCoin(slashed.Denom, perValidator)
+				err := k.bookkeepingBankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, validator, sdk.NewCoins(payout), "collateral slash reward")
+				if err != nil {
+					return types.SlashRecord{}, fmt.Errorf("failed to pay slash reward: %w", err)
+				}
+				record.ValidatorPayouts = append(record.ValidatorPayouts, types.SlashPayout{
+					Validator: validator.String(),
+					Amount:    payout,
+				})
+				remaining = remaining.Sub(perValidator)
+			}
+		}
+	}
+
+	communityPoolAmount := shareOf(slashed.Amount, params.SlashCommunityPoolShare)
+	if communityPoolAmount.IsPositive() {
+		record.CommunityPool = sdk.NewCoin(slashed.Denom, communityPoolAmount)
+		err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(record.CommunityPool), authtypes.NewModuleAddress(types.ModuleName))
+		if err != nil {
+			return types.SlashRecord{}, fmt.Errorf("failed to fund community pool with slashed coins: %w", err)
+		}
+		remaining = remaining.Sub(communityPoolAmount)
+	}
+
+	if remaining.IsPositive() {
+		record.Burned = sdk.NewCoin(slashed.Denom, remaining)
+		err := k.bookkeepingBankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(record.Burned), "collateral slashed")
+		if err != nil {
+			return types.SlashRecord{}, fmt.Errorf("failed to burn slashed coins: %w", err)
+		}
+	}
+
+	return record, nil
+}
+
+// shareOf is the truncated share of an amount. An unset share, as in params stored before the
+// slash shares existed, is none, which leaves the amount to be burned.
+func shareOf(amount math.Int, share math.LegacyDec) math.Int {
+	if share.IsNil() {
+		return math.ZeroInt()
+	}
+	return math.LegacyNewDecFromInt(amount).Mul(share).TruncateInt()
+}
+
+func uniqueAddresses(addresses []sdk.AccAddress) []sdk.AccAddress {
+	seen := make(map[string]bool, len(addresses))
+	unique := make([]sdk.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		if seen[address.String()] {
+			continue
+		}
+		seen[address.String()] = true
+		unique = append(unique, address)
+	}
+	return unique
+}
+
+// AddSlashRecord stores a slash in the slash history under the next slash id
+func (k Keeper) AddSlashRecord(ctx sdk.Context, participantAddress sdk.AccAddress, record types.SlashRecord) error {
+	id, err := k.SlashRecordSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	record.Id = id
+	return k.SlashRecords.Set(ctx, collections.Join(participantAddress, id), record)
+}
+
+// SetSlashRecord stores a slash record as is (for genesis import)
+func (k Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	participantAddress, err := sdk.AccAddressFromBech32(record.Participant)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.SlashRecords.Set(ctx, collections.Join(participantAddress, record.Id), record); err != nil {
+		panic(err)
+	}
+	next, err := k.SlashRecordSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if record.Id >= next {
+		if err := k.SlashRecordSeq.Set(ctx, record.Id+1); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllSlashRecords returns the whole slash history (for genesis export)
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) []types.SlashRecord {
+	iter, err := k.SlashRecords.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	records, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return records
+}
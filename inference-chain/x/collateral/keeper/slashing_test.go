@@ -41,7 +41,7 @@ func (s *KeeperTestSuite) TestSlashing_Proportional() {
 		Times(1)
 
 	// Perform the slash
-	slashedAmount, err := s.k.Slash(s.ctx, participant, slashFraction)
+	slashedAmount, err := s.k.Slash(s.ctx, participant, slashFraction, types.SlashReasonInvalid, nil)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedSlashedAmount, slashedAmount.Amount)
 
@@ -82,7 +82,7 @@ func (s *KeeperTestSuite) TestSlashing_ActiveOnly() {
 		Times(1)
 
 	// Perform the slash
-	slashedAmount, err := s.k.Slash(s.ctx, participant, slashFraction)
+	slashedAmount, err := s.k.Slash(s.ctx, participant, slashFraction, types.SlashReasonInvalid, nil)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedSlashedAmount, slashedAmount.Amount)
 
@@ -121,7 +121,7 @@ func (s *KeeperTestSuite) TestSlashing_UnbondingOnly() {
 		Times(1)
 
 	// Perform the slash
-	slashedAmount, err := s.k.Slash(s.ctx, participant, slashFraction)
+	slashedAmount, err := s.k.Slash(s.ctx, participant, slashFraction, types.SlashReasonInvalid, nil)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedSlashedAmount, slashedAmount.Amount)
 
@@ -146,11 +146,11 @@ func (s *KeeperTestSuite) TestSlashing_InvalidFraction() {
 	s.k.SetCollateral(s.ctx, participant, initialCollateral)
 
 	// Case 1: Negative fraction
-	_, err = s.k.Slash(s.ctx, participant, math.LegacyNewDec(-1))
+	_, err = s.k.Slash(s.ctx, participant, math.LegacyNewDec(-1), types.SlashReasonInvalid, nil)
 	s.Require().Error(err, "should error on negative slash fraction")
 
 	// Case 2: Fraction greater than 1
-	_, err = s.k.Slash(s.ctx, participant, math.LegacyNewDec(2))
+	_, err = s.k.Slash(s.ctx, participant, math.LegacyNewDec(2), types.SlashReasonInvalid, nil)
 	s.Require().Error(err, "should error on slash fraction greater than 1")
 
 	// Verify collateral is unchanged
@@ -158,3 +158,100 @@ func (s *KeeperTestSuite) TestSlashing_InvalidFraction() {
 	s.Require().True(found)
 	s.Require().Equal(initialCollateral, finalCollateral)
 }
+
+func (s *KeeperTestSuite) TestSlashing_DistributesShares() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	validator1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	validator2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	params := s.k.GetParams(s.ctx)
+	params.SlashBurnShare = math.LegacyNewDecWithPrec(50, 2)
+	params.SlashValidatorsShare = math.LegacyNewDecWithPrec(30, 2)
+	params.SlashCommunityPoolShare = math.LegacyNewDecWithPrec(20, 2)
+	s.Require().NoError(s.k.SetParams(s.ctx, params))
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1001))
+
+	// 1001 * 10% = 100 slashed: 30 split between the validators, 20 to the community pool, 50 burned
+	for _, validator := range []sdk.AccAddress{validator1, validator2} {
+		s.bankKeeper.EXPECT().
+			SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, validator, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 15)), "collateral slash reward").
+			Return(nil).
+			Times(1)
+	}
+	s.distributionKeeper.EXPECT().
+		FundCommunityPool(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 20)), gomock.Any()).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 50)), "collateral slashed").
+		Return(nil).
+		Times(1)
+
+	// The duplicate validator is paid once
+	slashedAmount, err := s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(10, 2), types.SlashReasonInvalid, []sdk.AccAddress{validator1, validator2, validator1})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(100), slashedAmount.Amount)
+
+	records := s.k.GetAllSlashRecords(s.ctx)
+	s.Require().Len(records, 1)
+	record := records[0]
+	s.Require().Equal(participant.String(), record.Participant)
+	s.Require().Equal(types.SlashReasonInvalid, record.Reason)
+	s.Require().Equal(math.NewInt(100), record.Slashed.Amount)
+	s.Require().Equal(math.NewInt(50), record.Burned.Amount)
+	s.Require().Equal(math.NewInt(20), record.CommunityPool.Amount)
+	s.Require().Equal(math.NewInt(30), record.ValidatorsAmount().Amount)
+	s.Require().Len(record.ValidatorPayouts, 2)
+}
+
+func (s *KeeperTestSuite) TestSlashing_NoValidatorsBurnsTheirShare() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	params := s.k.GetParams(s.ctx)
+	params.SlashBurnShare = math.LegacyNewDecWithPrec(50, 2)
+	params.SlashValidatorsShare = math.LegacyNewDecWithPrec(50, 2)
+	params.SlashCommunityPoolShare = math.LegacyZeroDec()
+	s.Require().NoError(s.k.SetParams(s.ctx, params))
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 100)), "collateral slashed").
+		Return(nil).
+		Times(1)
+
+	_, err := s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(10, 2), types.SlashReasonDowntime, nil)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSlashHistoryQuery() {
+	participant1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	participant2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	s.k.SetCollateral(s.ctx, participant1, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.k.SetCollateral(s.ctx, participant2, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	_, err := s.k.Slash(s.ctx, participant1, math.LegacyNewDecWithPrec(10, 2), types.SlashReasonInvalid, nil)
+	s.Require().NoError(err)
+	_, err = s.k.Slash(s.ctx, participant2, math.LegacyNewDecWithPrec(10, 2), types.SlashReasonDowntime, nil)
+	s.Require().NoError(err)
+	_, err = s.k.Slash(s.ctx, participant1, math.LegacyNewDecWithPrec(10, 2), types.SlashReasonDowntime, nil)
+	s.Require().NoError(err)
+
+	response, err := s.k.SlashHistory(s.ctx, &types.QuerySlashHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.SlashRecords, 3)
+
+	response, err = s.k.SlashHistory(s.ctx, &types.QuerySlashHistoryRequest{Participant: participant1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(response.SlashRecords, 2)
+	s.Require().Equal(types.SlashReasonInvalid, response.SlashRecords[0].Reason)
+	s.Require().Equal(math.NewInt(100), response.SlashRecords[0].Slashed.Amount)
+	s.Require().Equal(types.SlashReasonDowntime, response.SlashRecords[1].Reason)
+	s.Require().Equal(math.NewInt(90), response.SlashRecords[1].Slashed.Amount)
+	s.Require().Less(response.SlashRecords[0].Id, response.SlashRecords[1].Id)
+
+	_, err = s.k.SlashHistory(s.ctx, &types.QuerySlashHistoryRequest{Participant: "invalid"})
+	s.Require().Error(err)
+}
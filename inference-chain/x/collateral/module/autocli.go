@@ -39,6 +39,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "list-unbonding-collaterals",
 					Short:     "List all unbondingCollateral",
 				},
+				{
+					RpcMethod: "SlashHistory",
+					Use:       "slash-history",
+					Short:     "List slashes and how the slashed collateral was distributed",
+				},
+				{
+					RpcMethod: "CollateralDelegations",
+					Use:       "list-delegations",
+					Short:     "List collateral delegations, filtered by --participant and --delegator",
+				},
+				{
+					RpcMethod:      "DelegatedCollateral",
+					Use:            "show-delegated-collateral [participant]",
+					Short:          "Shows the collateral delegated to a participant and the reward share it pays",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -62,6 +78,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a withdraw-collateral tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
 				},
+				{
+					RpcMethod:      "DelegateCollateral",
+					Use:            "delegate-collateral [participant] [amount]",
+					Short:          "Deposit collateral on behalf of a participant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "UndelegateCollateral",
+					Use:            "undelegate-collateral [participant] [amount]",
+					Short:          "Withdraw collateral delegated to a participant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "SetDelegatorRewardShare",
+					Use:            "set-delegator-reward-share [reward-share]",
+					Short:          "Set the part of your rewards paid to the delegators of your collateral",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "reward_share"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
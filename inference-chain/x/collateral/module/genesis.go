@@ -24,9 +24,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if err != nil {
 			panic(err)
 		}
+		if elem.Delegator != "" {
+			delegator, err := sdk.AccAddressFromBech32(elem.Delegator)
+			if err != nil {
+				panic(err)
+			}
+			k.AddDelegatedUnbonding(ctx, participant, delegator, elem.CompletionEpoch, elem.Amount)
+			continue
+		}
 		k.AddUnbondingCollateral(ctx, participant, elem.CompletionEpoch, elem.Amount)
 	}
 
+	for _, delegation := range genState.DelegationList {
+		k.SetDelegation(ctx, delegation)
+	}
+
+	for _, share := range genState.DelegatorRewardShareList {
+		k.SetRewardShare(ctx, share)
+	}
+
 	// Set all the jailedParticipant
 	for _, elem := range genState.JailedParticipantList {
 		jailedAddr, err := sdk.AccAddressFromBech32(elem.Address)
@@ -36,6 +52,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetJailed(ctx, jailedAddr)
 	}
 
+	for _, record := range genState.SlashRecordList {
+		k.SetSlashRecord(ctx, record)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -61,7 +81,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	// Export all unbonding collateral entries
 	unbondingCollaterals := k.GetAllUnbondings(ctx)
-	genesis.UnbondingCollateralList = unbondingCollaterals
+	genesis.UnbondingCollateralList = append(unbondingCollaterals, k.GetAllDelegatedUnbondings(ctx)...)
+	genesis.DelegationList = k.GetAllDelegations(ctx)
+	genesis.DelegatorRewardShareList = k.GetAllRewardShares(ctx)
 
 	jailedParticipants := k.GetAllJailed(ctx)
 	genesis.JailedParticipantList = make([]*types.JailedParticipant, len(jailedParticipants))
@@ -69,6 +91,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.JailedParticipantList[i] = &types.JailedParticipant{Address: addr.String()}
 	}
 
+	genesis.SlashRecordList = k.GetAllSlashRecords(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
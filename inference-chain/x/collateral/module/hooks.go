@@ -8,6 +8,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/productscience/inference/x/collateral/keeper"
+	"github.com/productscience/inference/x/collateral/types"
 )
 
 // StakingHooks wrapper struct
@@ -81,6 +82,6 @@ func (h StakingHooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.Va
 		"fraction", fraction.String(),
 	)
 
-	_, err := h.k.Slash(sdkCtx, accAddr, fraction)
+	_, err := h.k.Slash(sdkCtx, accAddr, fraction, types.SlashReasonStaking, nil)
 	return err
 }
@@ -187,6 +187,9 @@ type ModuleInputs struct {
 	AccountKeeper    types.AccountKeeper
 	BankKeeper       types.BankKeeper
 	BankEscrowKeeper types.BookkeepingBankKeeper
+	DistrKeeper      types.DistributionKeeper
+
+	GetInferenceKeeper func() types.InferenceKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -210,6 +213,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority.String(),
 		in.BankKeeper,
 		in.BankEscrowKeeper,
+		in.DistrKeeper,
+		in.GetInferenceKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
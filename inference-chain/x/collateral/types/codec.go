@@ -14,6 +14,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgDepositCollateral{},
 		&MsgWithdrawCollateral{},
+		&MsgDelegateCollateral{},
+		&MsgUndelegateCollateral{},
+		&MsgSetDelegatorRewardShare{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// ValidateRewardShare checks that a delegator reward share is between 0 and 1
+func ValidateRewardShare(share math.LegacyDec) error {
+	if share.IsNil() {
+		return fmt.Errorf("reward share must be set")
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reward share must be between 0 and 1, got %s", share)
+	}
+	return nil
+}
@@ -11,4 +11,8 @@ var (
 	ErrInvalidDenom           = sdkerrors.Register(ModuleName, 1102, "invalid denomination")
 	ErrLatestEpochNotFound    = sdkerrors.Register(ModuleName, 1103, "latest epoch not found")
 	ErrInvalidSigner          = sdkerrors.Register(ModuleName, 1104, "expected gov account as only signer for proposal message")
+	ErrNoDelegationFound      = sdkerrors.Register(ModuleName, 1105, "no collateral delegation found")
+	ErrDelegationTooSmall     = sdkerrors.Register(ModuleName, 1106, "collateral delegation below the minimum")
+	ErrTooManyDelegators      = sdkerrors.Register(ModuleName, 1107, "participant has too many delegators")
+	ErrParticipantNotFound    = sdkerrors.Register(ModuleName, 1108, "participant not found")
 )
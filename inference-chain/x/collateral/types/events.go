@@ -6,13 +6,31 @@ const (
 	EventTypeWithdrawCollateral = "withdraw_collateral"
 	EventTypeSlashCollateral    = "slash_collateral"
 	EventTypeProcessWithdrawal  = "process_withdrawal"
+
+	EventTypeDelegateCollateral      = "delegate_collateral"
+	EventTypeUndelegateCollateral    = "undelegate_collateral"
+	EventTypeSetDelegatorRewardShare = "set_delegator_reward_share"
 )
 
 // Event attribute keys
 const (
-	AttributeKeyParticipant     = "participant"
-	AttributeKeyAmount          = "amount"
-	AttributeKeyCompletionEpoch = "completion_epoch"
-	AttributeKeySlashAmount     = "slash_amount"
-	AttributeKeySlashFraction   = "slash_fraction"
+	AttributeKeyParticipant         = "participant"
+	AttributeKeyAmount              = "amount"
+	AttributeKeyCompletionEpoch     = "completion_epoch"
+	AttributeKeySlashAmount         = "slash_amount"
+	AttributeKeySlashFraction       = "slash_fraction"
+	AttributeKeySlashReason         = "slash_reason"
+	AttributeKeyBurnedAmount        = "burned_amount"
+	AttributeKeyValidatorsAmount    = "validators_amount"
+	AttributeKeyCommunityPoolAmount = "community_pool_amount"
+	AttributeKeyDelegator           = "delegator"
+	AttributeKeyRewardShare         = "reward_share"
+	AttributeKeyEffectiveEpoch      = "effective_epoch"
+)
+
+// Slash reasons
+const (
+	SlashReasonInvalid  = "invalid"
+	SlashReasonDowntime = "downtime"
+	SlashReasonStaking  = "staking"
 )
@@ -27,6 +27,16 @@ type BookkeepingBankKeeper interface {
 	LogSubAccountTransaction(ctx context.Context, recipient string, sender string, subAccount string, amt sdk.Coin, memo string)
 }
 
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// InferenceKeeper defines the expected interface for looking up the participants of the Inference module.
+type InferenceKeeper interface {
+	HasParticipant(ctx context.Context, address sdk.AccAddress) bool
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
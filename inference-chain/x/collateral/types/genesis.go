@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -17,6 +19,33 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	seenIds := make(map[uint64]bool)
+	for _, record := range gs.SlashRecordList {
+		if seenIds[record.Id] {
+			return fmt.Errorf("duplicated id for slash record: %d", record.Id)
+		}
+		seenIds[record.Id] = true
+	}
+
+	seenDelegations := make(map[string]bool)
+	for _, delegation := range gs.DelegationList {
+		key := delegation.Participant + "/" + delegation.Delegator
+		if seenDelegations[key] {
+			return fmt.Errorf("duplicated delegation of %s to %s", delegation.Delegator, delegation.Participant)
+		}
+		seenDelegations[key] = true
+	}
+
+	for _, share := range gs.DelegatorRewardShareList {
+		if err := ValidateRewardShare(share.RewardShare); err != nil {
+			return fmt.Errorf("invalid delegator reward share of %s: %w", share.Participant, err)
+		}
+		if !share.PreviousRewardShare.IsNil() {
+			if err := ValidateRewardShare(share.PreviousRewardShare); err != nil {
+				return fmt.Errorf("invalid previous delegator reward share of %s: %w", share.Participant, err)
+			}
+		}
+	}
 
 	return gs.Params.Validate()
 }
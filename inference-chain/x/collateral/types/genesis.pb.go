@@ -33,6 +33,12 @@ type GenesisState struct {
 	// unbonding_collateral_list defines all the unbonding collateral entries at genesis
 	UnbondingCollateralList []UnbondingCollateral `protobuf:"bytes,3,rep,name=unbonding_collateral_list,json=unbondingCollateralList,proto3" json:"unbonding_collateral_list"`
 	JailedParticipantList   []*JailedParticipant  `protobuf:"bytes,4,rep,name=jailed_participant_list,json=jailedParticipantList,proto3" json:"jailed_participant_list,omitempty"`
+	// slash_record_list defines the slash history at genesis
+	SlashRecordList []SlashRecord `protobuf:"bytes,5,rep,name=slash_record_list,json=slashRecordList,proto3" json:"slash_record_list"`
+	// delegation_list defines the collateral delegated to participants at genesis
+	DelegationList []CollateralDelegation `protobuf:"bytes,6,rep,name=delegation_list,json=delegationList,proto3" json:"delegation_list"`
+	// delegator_reward_share_list defines the reward shares participants pay their delegators at genesis
+	DelegatorRewardShareList []DelegatorRewardShare `protobuf:"bytes,7,rep,name=delegator_reward_share_list,json=delegatorRewardShareList,proto3" json:"delegator_reward_share_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +102,27 @@ func (m *GenesisState) GetJailedParticipantList() []*JailedParticipant {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecordList() []SlashRecord {
+	if m != nil {
+		return m.SlashRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationList() []CollateralDelegation {
+	if m != nil {
+		return m.DelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorRewardShareList() []DelegatorRewardShare {
+	if m != nil {
+		return m.DelegatorRewardShareList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.collateral.GenesisState")
 }
@@ -105,30 +132,37 @@ func init() {
 }
 
 var fileDescriptor_f1879ca2d8ff63f9 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x5b, 0x37, 0x06, 0x76, 0x5e, 0x2c, 0x1b, 0x9b, 0x43, 0xea, 0x1c, 0x82, 0x53, 0xb0,
-	0x85, 0x09, 0x5e, 0x85, 0x7a, 0x10, 0xc4, 0xc3, 0x98, 0x78, 0xf1, 0x52, 0xd2, 0x2c, 0xd6, 0xcc,
-	0x2e, 0x29, 0x49, 0x0a, 0x7a, 0xf7, 0x01, 0x7c, 0x0c, 0x8f, 0x3e, 0xc6, 0x8e, 0x3b, 0x7a, 0x12,
-	0xd9, 0x0e, 0xbe, 0x86, 0x34, 0x99, 0x6b, 0xd9, 0xc2, 0x2e, 0xe5, 0x23, 0xf9, 0xfd, 0xf3, 0xfb,
-	0xbe, 0x7e, 0x56, 0x07, 0x93, 0x47, 0xc4, 0x10, 0x81, 0xc8, 0x83, 0x34, 0x8e, 0x81, 0x40, 0x0c,
-	0xc4, 0x5e, 0x84, 0x08, 0xe2, 0x98, 0xbb, 0x09, 0xa3, 0x82, 0xda, 0xb5, 0x25, 0xe3, 0xe6, 0x4c,
-	0x6b, 0x17, 0x8c, 0x31, 0xa1, 0x9e, 0xfc, 0x2a, 0xb0, 0x55, 0x8b, 0x68, 0x44, 0x65, 0xe9, 0x65,
-	0xd5, 0xe2, 0xf4, 0x50, 0xab, 0x48, 0x00, 0x03, 0xe3, 0x85, 0xa1, 0x75, 0xa6, 0x45, 0xf2, 0x32,
-	0x08, 0x41, 0x0c, 0x32, 0xbf, 0xc2, 0x8f, 0xb4, 0x78, 0x4a, 0x42, 0x4a, 0x86, 0x98, 0x44, 0x1b,
-	0xbd, 0x23, 0x80, 0x63, 0x34, 0x54, 0x48, 0xe7, 0xad, 0x64, 0xed, 0x5c, 0xab, 0x59, 0xef, 0x04,
-	0x10, 0xc8, 0xbe, 0xb4, 0x2a, 0xaa, 0xb1, 0xa6, 0xd9, 0x36, 0xbb, 0xd5, 0xde, 0xbe, 0xab, 0x9b,
-	0xdd, 0xed, 0x4b, 0xc6, 0xdf, 0x9e, 0x7c, 0x1f, 0x18, 0x1f, 0xbf, 0x9f, 0xa7, 0xe6, 0x60, 0x11,
-	0xb3, 0x91, 0xd5, 0x58, 0x6f, 0x3b, 0x88, 0x31, 0x17, 0xcd, 0xad, 0x76, 0xa9, 0x5b, 0xed, 0x1d,
-	0xeb, 0x5f, 0xbc, 0x5a, 0x96, 0xbe, 0xca, 0xf8, 0xe5, 0xec, 0xf1, 0x41, 0x1d, 0xae, 0x5e, 0xdc,
-	0x62, 0x2e, 0xec, 0x67, 0x6b, 0x6f, 0x39, 0x6e, 0x50, 0x10, 0x4a, 0x51, 0x49, 0x8a, 0x4e, 0xf4,
-	0xa2, 0xfb, 0xff, 0x58, 0xc1, 0xa8, 0x54, 0x8d, 0x74, 0xfd, 0x4a, 0xca, 0x02, 0xab, 0xa1, 0xfe,
-	0x5a, 0x90, 0x00, 0x26, 0x30, 0xc4, 0x09, 0x20, 0x42, 0xa9, 0xca, 0x9b, 0x66, 0xba, 0x91, 0xa1,
-	0x7e, 0x9e, 0x19, 0xd4, 0x47, 0xab, 0x47, 0x99, 0xc0, 0xef, 0x4f, 0x66, 0x8e, 0x39, 0x9d, 0x39,
-	0xe6, 0xcf, 0xcc, 0x31, 0xdf, 0xe7, 0x8e, 0x31, 0x9d, 0x3b, 0xc6, 0xd7, 0xdc, 0x31, 0x1e, 0x2e,
-	0x22, 0x2c, 0x9e, 0xd2, 0xd0, 0x85, 0x74, 0xec, 0x25, 0x8c, 0x0e, 0x53, 0x28, 0x38, 0xc4, 0x72,
-	0xa7, 0xf9, 0x76, 0x5f, 0x8a, 0xfb, 0x15, 0xaf, 0x09, 0xe2, 0x61, 0x45, 0xee, 0xf7, 0xfc, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x76, 0x8d, 0xf4, 0x00, 0xdf, 0x02, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xb6, 0x8d, 0x38, 0x15, 0x4b, 0x43, 0x4b, 0x62, 0x94, 0xb5, 0x2d, 0x4a, 0x6b,
+	0xc1, 0x5d, 0xa8, 0xe0, 0x55, 0x88, 0x05, 0x41, 0x3c, 0x84, 0x0d, 0x1e, 0xf4, 0x32, 0x4c, 0x66,
+	0xc7, 0xcd, 0xd4, 0xc9, 0xcc, 0x32, 0x33, 0x41, 0xfd, 0x16, 0x7e, 0x09, 0xc1, 0xa3, 0x1f, 0xa3,
+	0xc7, 0x1e, 0x3d, 0x89, 0x24, 0x07, 0xbf, 0x86, 0xec, 0x9b, 0x49, 0x76, 0x69, 0x87, 0x78, 0x09,
+	0x8f, 0x37, 0xff, 0xff, 0xff, 0x97, 0x79, 0x6f, 0x07, 0x1d, 0x71, 0xf9, 0x91, 0x69, 0x26, 0x29,
+	0x4b, 0xa9, 0x12, 0x82, 0x58, 0xa6, 0x89, 0x48, 0x0b, 0x26, 0x99, 0xe1, 0x26, 0x29, 0xb5, 0xb2,
+	0xaa, 0xb3, 0xb7, 0xd2, 0x24, 0xb5, 0xa6, 0xbf, 0x4b, 0xa6, 0x5c, 0xaa, 0x14, 0x7e, 0x9d, 0xb0,
+	0xbf, 0x57, 0xa8, 0x42, 0x41, 0x99, 0x56, 0x95, 0xef, 0x1e, 0x06, 0x11, 0x25, 0xd1, 0x64, 0xea,
+	0x09, 0xfd, 0x67, 0x41, 0x49, 0x5d, 0xe2, 0x31, 0x11, 0xa4, 0xe2, 0x3b, 0xf9, 0xe3, 0xa0, 0x7c,
+	0x26, 0xc7, 0x4a, 0xe6, 0x5c, 0x16, 0x6b, 0xb9, 0x17, 0x84, 0x0b, 0x96, 0x7b, 0xc9, 0x71, 0x50,
+	0x62, 0x04, 0x31, 0x13, 0xac, 0x19, 0x55, 0x7a, 0x29, 0x7c, 0x12, 0x14, 0xe6, 0x4c, 0xb0, 0x82,
+	0x58, 0xae, 0xa4, 0x93, 0x1d, 0x7d, 0xdf, 0x42, 0x77, 0x5f, 0xbb, 0xd9, 0x8d, 0x2c, 0xb1, 0xac,
+	0xf3, 0x12, 0xb5, 0xdd, 0x45, 0x7b, 0xd1, 0x41, 0x74, 0xb2, 0x7d, 0xf6, 0x30, 0x09, 0xcd, 0x32,
+	0x19, 0x82, 0x66, 0x70, 0xe7, 0xf2, 0xf7, 0xa3, 0xd6, 0x8f, 0xbf, 0x3f, 0x4f, 0xa3, 0xcc, 0xdb,
+	0x3a, 0x0c, 0x75, 0x6f, 0x8e, 0x01, 0x0b, 0x6e, 0x6c, 0xef, 0xd6, 0xc1, 0xc6, 0xc9, 0xf6, 0xd9,
+	0x71, 0x38, 0xf1, 0xd5, 0xaa, 0x1c, 0x38, 0xcf, 0x60, 0xb3, 0x0a, 0xcf, 0xf6, 0xe9, 0xf5, 0x83,
+	0xb7, 0xdc, 0xd8, 0xce, 0x27, 0x74, 0x7f, 0x35, 0x3e, 0xdc, 0x00, 0x02, 0x68, 0x03, 0x40, 0x4f,
+	0xc3, 0xa0, 0x77, 0x4b, 0x5b, 0x83, 0xe8, 0x50, 0xdd, 0xd9, 0xcd, 0x23, 0x80, 0x61, 0xd4, 0x75,
+	0x5b, 0xc0, 0x25, 0xd1, 0x96, 0x53, 0x5e, 0x12, 0x69, 0x1d, 0x6a, 0x73, 0xdd, 0x9d, 0xde, 0x80,
+	0x69, 0x58, 0x7b, 0xb2, 0xfd, 0x8b, 0xeb, 0x2d, 0x00, 0x8c, 0xd0, 0x6e, 0x73, 0x87, 0x2e, 0x7a,
+	0x0b, 0xa2, 0x0f, 0xc3, 0xd1, 0xa3, 0x4a, 0x9e, 0x81, 0xda, 0xff, 0xfb, 0x1d, 0x53, 0xb7, 0x20,
+	0xf4, 0x3d, 0xda, 0xa9, 0xf7, 0xed, 0x22, 0xdb, 0x10, 0x79, 0xfa, 0xbf, 0x0d, 0x9c, 0xaf, 0x6c,
+	0x3e, 0xfb, 0x5e, 0x1d, 0x04, 0xd1, 0x0a, 0x3d, 0xf0, 0x1d, 0xa5, 0xb1, 0x66, 0x9f, 0x89, 0xce,
+	0xb1, 0x99, 0x10, 0xed, 0x17, 0x7d, 0x7b, 0x1d, 0xe6, 0x7c, 0x69, 0xcc, 0xc0, 0x37, 0xaa, 0x6c,
+	0x1e, 0xd3, 0xcb, 0x03, 0x67, 0x15, 0x70, 0x30, 0xbc, 0x9c, 0xc7, 0xd1, 0xd5, 0x3c, 0x8e, 0xfe,
+	0xcc, 0xe3, 0xe8, 0xdb, 0x22, 0x6e, 0x5d, 0x2d, 0xe2, 0xd6, 0xaf, 0x45, 0xdc, 0xfa, 0xf0, 0xa2,
+	0xe0, 0x76, 0x32, 0x1b, 0x27, 0x54, 0x4d, 0xd3, 0x52, 0xab, 0x7c, 0x46, 0xad, 0xa1, 0x1c, 0x3e,
+	0xfc, 0xfa, 0x09, 0x7c, 0x69, 0x3e, 0x02, 0xfb, 0xb5, 0x64, 0x66, 0xdc, 0x86, 0x07, 0xf0, 0xfc,
+	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x1f, 0x51, 0x28, 0x50, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +185,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorRewardShareList) > 0 {
+		for iNdEx := len(m.DelegatorRewardShareList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewardShareList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegationList) > 0 {
+		for iNdEx := len(m.DelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SlashRecordList) > 0 {
+		for iNdEx := len(m.SlashRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.JailedParticipantList) > 0 {
 		for iNdEx := len(m.JailedParticipantList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -243,6 +319,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecordList) > 0 {
+		for _, e := range m.SlashRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationList) > 0 {
+		for _, e := range m.DelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewardShareList) > 0 {
+		for _, e := range m.DelegatorRewardShareList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -416,6 +510,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecordList = append(m.SlashRecordList, SlashRecord{})
+			if err := m.SlashRecordList[len(m.SlashRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationList = append(m.DelegationList, CollateralDelegation{})
+			if err := m.DelegationList[len(m.DelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewardShareList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRewardShareList = append(m.DelegatorRewardShareList, DelegatorRewardShare{})
+			if err := m.DelegatorRewardShareList[len(m.DelegatorRewardShareList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
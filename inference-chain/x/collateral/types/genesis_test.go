@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/productscience/inference/x/collateral/types"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +28,66 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated slash record",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashRecordList: []types.SlashRecord{
+					{Id: 1},
+					{Id: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "slash shares not summing to one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultUnbondingPeriodEpochs, math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(3, 1), math.LegacyNewDecWithPrec(1, 1), types.DefaultMinDelegation, types.DefaultMaxDelegatorsPerParticipant),
+			},
+			valid: false,
+		},
+		{
+			desc: "slash share above one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultUnbondingPeriodEpochs, math.LegacyNewDec(2), math.LegacyZeroDec(), math.LegacyNewDec(-1), types.DefaultMinDelegation, types.DefaultMaxDelegatorsPerParticipant),
+			},
+			valid: false,
+		},
+		{
+			desc: "no delegation minimum",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultUnbondingPeriodEpochs, types.DefaultSlashBurnShare, types.DefaultSlashValidatorsShare, types.DefaultSlashCommunityPoolShare, math.ZeroInt(), types.DefaultMaxDelegatorsPerParticipant),
+			},
+			valid: false,
+		},
+		{
+			desc: "no delegator limit",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultUnbondingPeriodEpochs, types.DefaultSlashBurnShare, types.DefaultSlashValidatorsShare, types.DefaultSlashCommunityPoolShare, types.DefaultMinDelegation, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationList: []types.CollateralDelegation{
+					{Participant: "gonka1rdyphrqxe9l5hkp7uxcruch64sh337jasqsntr", Delegator: "gonka1pda35dczayfhy2udffky7wzset9tpkpatzaksd"},
+					{Participant: "gonka1rdyphrqxe9l5hkp7uxcruch64sh337jasqsntr", Delegator: "gonka1pda35dczayfhy2udffky7wzset9tpkpatzaksd"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "delegator reward share above one",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegatorRewardShareList: []types.DelegatorRewardShare{
+					{Participant: "gonka1rdyphrqxe9l5hkp7uxcruch64sh337jasqsntr", RewardShare: math.LegacyNewDecWithPrec(15, 1)},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -38,4 +38,31 @@ var (
 
 	// JailedKey is the prefix for jailed participant entries
 	JailedKey = collections.NewPrefix(6)
+
+	// SlashRecordsKey is the prefix for the slash history, keyed by participant and slash id
+	SlashRecordsKey = collections.NewPrefix(7)
+
+	// SlashRecordSeqKey is the key of the sequence numbering slash records
+	SlashRecordSeqKey = collections.NewPrefix(8)
+
+	// DelegationsKey is the prefix for collateral delegations, keyed by participant and delegator
+	DelegationsKey                 = collections.NewPrefix(9)
+	DelegationsByDelegatorIndexKey = collections.NewPrefix(10)
+
+	// DelegatedUnbondingKey is the prefix for unbonding delegated collateral, keyed by completion epoch,
+	// participant and delegator
+	DelegatedUnbondingKey                   = collections.NewPrefix(11)
+	DelegatedUnbondingByParticipantIndexKey = collections.NewPrefix(12)
+
+	// DelegatorRewardSharesKey is the prefix for the reward shares participants pay their delegators
+	DelegatorRewardSharesKey = collections.NewPrefix(13)
+
+	// DelegatedTotalsKey and DelegatorCountsKey are the prefixes for the running total of collateral
+	// delegated to each participant and its number of delegators
+	DelegatedTotalsKey = collections.NewPrefix(14)
+	DelegatorCountsKey = collections.NewPrefix(15)
+
+	// DelegationSnapshotsKey is the prefix for the delegations each epoch's delegator rewards are split
+	// between, keyed by epoch, participant and delegator
+	DelegationSnapshotsKey = collections.NewPrefix(16)
 )
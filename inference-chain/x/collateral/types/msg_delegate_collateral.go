@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDelegateCollateral{}
+
+// ValidateBasic performs basic validation of the MsgDelegateCollateral
+func (msg *MsgDelegateCollateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Participant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address: %s", err)
+	}
+
+	if msg.Delegator == msg.Participant {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "participants deposit their own collateral with MsgDepositCollateral")
+	}
+
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "invalid collateral amount")
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "collateral amount must be positive")
+	}
+
+	return nil
+}
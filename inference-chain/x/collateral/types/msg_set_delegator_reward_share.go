@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetDelegatorRewardShare{}
+
+// ValidateBasic performs basic validation of the MsgSetDelegatorRewardShare
+func (msg *MsgSetDelegatorRewardShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Participant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address: %s", err)
+	}
+
+	if err := ValidateRewardShare(msg.RewardShare); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
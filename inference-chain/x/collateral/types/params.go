@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,11 +12,25 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 // Default parameter values
 var (
 	DefaultUnbondingPeriodEpochs = uint64(1) // 1 epoch
+
+	// Slashed collateral is burned until governance decides to redistribute it
+	DefaultSlashBurnShare          = math.LegacyOneDec()
+	DefaultSlashValidatorsShare    = math.LegacyZeroDec()
+	DefaultSlashCommunityPoolShare = math.LegacyZeroDec()
+
+	// Delegations below 1 gonka or past 100 delegators per participant are rejected
+	DefaultMinDelegation               = math.NewInt(1_000_000_000)
+	DefaultMaxDelegatorsPerParticipant = uint32(100)
 )
 
 // Parameter store keys
 var (
-	KeyUnbondingPeriodEpochs = []byte("UnbondingPeriodEpochs")
+	KeyUnbondingPeriodEpochs       = []byte("UnbondingPeriodEpochs")
+	KeySlashBurnShare              = []byte("SlashBurnShare")
+	KeySlashValidatorsShare        = []byte("SlashValidatorsShare")
+	KeySlashCommunityPoolShare     = []byte("SlashCommunityPoolShare")
+	KeyMinDelegation               = []byte("MinDelegation")
+	KeyMaxDelegatorsPerParticipant = []byte("MaxDelegatorsPerParticipant")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -26,9 +41,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingPeriodEpochs uint64,
+	slashBurnShare math.LegacyDec,
+	slashValidatorsShare math.LegacyDec,
+	slashCommunityPoolShare math.LegacyDec,
+	minDelegation math.Int,
+	maxDelegatorsPerParticipant uint32,
 ) Params {
 	return Params{
-		UnbondingPeriodEpochs: unbondingPeriodEpochs,
+		UnbondingPeriodEpochs:       unbondingPeriodEpochs,
+		SlashBurnShare:              slashBurnShare,
+		SlashValidatorsShare:        slashValidatorsShare,
+		SlashCommunityPoolShare:     slashCommunityPoolShare,
+		MinDelegation:               minDelegation,
+		MaxDelegatorsPerParticipant: maxDelegatorsPerParticipant,
 	}
 }
 
@@ -36,6 +61,11 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultUnbondingPeriodEpochs,
+		DefaultSlashBurnShare,
+		DefaultSlashValidatorsShare,
+		DefaultSlashCommunityPoolShare,
+		DefaultMinDelegation,
+		DefaultMaxDelegatorsPerParticipant,
 	)
 }
 
@@ -43,6 +73,11 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyUnbondingPeriodEpochs, &p.UnbondingPeriodEpochs, validateUnbondingPeriodEpochs),
+		paramtypes.NewParamSetPair(KeySlashBurnShare, &p.SlashBurnShare, validateSlashShare),
+		paramtypes.NewParamSetPair(KeySlashValidatorsShare, &p.SlashValidatorsShare, validateSlashShare),
+		paramtypes.NewParamSetPair(KeySlashCommunityPoolShare, &p.SlashCommunityPoolShare, validateSlashShare),
+		paramtypes.NewParamSetPair(KeyMinDelegation, &p.MinDelegation, validateMinDelegation),
+		paramtypes.NewParamSetPair(KeyMaxDelegatorsPerParticipant, &p.MaxDelegatorsPerParticipant, validateMaxDelegatorsPerParticipant),
 	}
 }
 
@@ -51,7 +86,19 @@ func (p Params) Validate() error {
 	if err := validateUnbondingPeriodEpochs(p.UnbondingPeriodEpochs); err != nil {
 		return err
 	}
-	return nil
+	for _, share := range []math.LegacyDec{p.SlashBurnShare, p.SlashValidatorsShare, p.SlashCommunityPoolShare} {
+		if err := validateSlashShare(share); err != nil {
+			return err
+		}
+	}
+	total := p.SlashBurnShare.Add(p.SlashValidatorsShare).Add(p.SlashCommunityPoolShare)
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("slash shares must add up to 1, got %s", total)
+	}
+	if err := validateMinDelegation(p.MinDelegation); err != nil {
+		return err
+	}
+	return validateMaxDelegatorsPerParticipant(p.MaxDelegatorsPerParticipant)
 }
 
 // validateUnbondingPeriodEpochs validates the UnbondingPeriodEpochs param
@@ -67,3 +114,51 @@ func validateUnbondingPeriodEpochs(v interface{}) error {
 
 	return nil
 }
+
+// validateSlashShare validates a share of slashed collateral
+func validateSlashShare(v interface{}) error {
+	share, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if share.IsNil() {
+		return fmt.Errorf("slash share must be set")
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash share must be between 0 and 1, got %s", share)
+	}
+
+	return nil
+}
+
+// validateMinDelegation validates the MinDelegation param
+func validateMinDelegation(v interface{}) error {
+	minDelegation, ok := v.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if minDelegation.IsNil() {
+		return fmt.Errorf("min delegation must be set")
+	}
+	if !minDelegation.IsPositive() {
+		return fmt.Errorf("min delegation must be positive, got %s", minDelegation)
+	}
+
+	return nil
+}
+
+// validateMaxDelegatorsPerParticipant validates the MaxDelegatorsPerParticipant param
+func validateMaxDelegatorsPerParticipant(v interface{}) error {
+	maxDelegators, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxDelegators == 0 {
+		return fmt.Errorf("max delegators per participant must be positive")
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// UnbondingPeriodEpochs is the number of epochs a withdrawal must wait before being released
 	UnbondingPeriodEpochs uint64 `protobuf:"varint,1,opt,name=unbonding_period_epochs,json=unbondingPeriodEpochs,proto3" json:"unbonding_period_epochs,omitempty"`
+	// SlashBurnShare is the part of slashed collateral that is burned. The shares add up to one.
+	SlashBurnShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slash_burn_share,json=slashBurnShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_burn_share"`
+	// SlashValidatorsShare is the part of slashed collateral paid to the validators whose votes
+	// invalidated the participant. It is burned when no validator caused the slash.
+	SlashValidatorsShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slash_validators_share,json=slashValidatorsShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_validators_share"`
+	// SlashCommunityPoolShare is the part of slashed collateral sent to the community pool
+	SlashCommunityPoolShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_community_pool_share,json=slashCommunityPoolShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_community_pool_share"`
+	// MinDelegation is the smallest collateral a delegator may keep delegated to a participant
+	MinDelegation cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_delegation,json=minDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_delegation"`
+	// MaxDelegatorsPerParticipant bounds the delegations iterated when a participant is slashed or paid
+	MaxDelegatorsPerParticipant uint32 `protobuf:"varint,6,opt,name=max_delegators_per_participant,json=maxDelegatorsPerParticipant,proto3" json:"max_delegators_per_participant,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +83,13 @@ func (m *Params) GetUnbondingPeriodEpochs() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxDelegatorsPerParticipant() uint32 {
+	if m != nil {
+		return m.MaxDelegatorsPerParticipant
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.collateral.Params")
 }
@@ -77,21 +97,36 @@ func init() {
 func init() { proto.RegisterFile("inference/collateral/params.proto", fileDescriptor_3c54d7995682b333) }
 
 var fileDescriptor_3c54d7995682b333 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcc, 0x4b, 0x4b,
-	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x2c, 0x49, 0x2d, 0x4a, 0xcc,
-	0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2b, 0xd1, 0x43, 0x28, 0x91, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x85,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xca, 0xe0, 0x62,
-	0x0b, 0x00, 0x1b, 0x27, 0x64, 0xc6, 0x25, 0x5e, 0x9a, 0x97, 0x94, 0x9f, 0x97, 0x92, 0x99, 0x97,
-	0x1e, 0x5f, 0x90, 0x5a, 0x94, 0x99, 0x9f, 0x12, 0x9f, 0x5a, 0x90, 0x9f, 0x9c, 0x51, 0x2c, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x24, 0x0a, 0x97, 0x0e, 0x00, 0xcb, 0xba, 0x82, 0x25, 0xad, 0xd4,
-	0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x16, 0xe1, 0xd8, 0x0a, 0x64, 0xe7, 0x42,
-	0xcc, 0x77, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xa2, 0xfc, 0x94, 0xd2, 0xe4,
-	0x92, 0xe2, 0xe4, 0x4c, 0xb0, 0x41, 0x38, 0x8c, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x7b, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x22, 0x46, 0x9f, 0x1c, 0x26, 0x01, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x8a, 0x13, 0x31,
+	0x18, 0xc7, 0x3b, 0x5a, 0x0b, 0x06, 0x76, 0xd1, 0xa1, 0xeb, 0xd6, 0x2e, 0x4e, 0xab, 0x07, 0x29,
+	0x8a, 0x33, 0x88, 0xb0, 0x07, 0x8f, 0xdd, 0x7a, 0x58, 0xf0, 0x30, 0x8c, 0xe0, 0x41, 0x0f, 0x43,
+	0x9a, 0x89, 0x33, 0xc1, 0x24, 0x5f, 0x48, 0x32, 0xd2, 0xbe, 0x82, 0x27, 0x1f, 0xc1, 0x47, 0xf0,
+	0x20, 0xf8, 0x0a, 0x7b, 0x5c, 0x3c, 0x89, 0x87, 0x45, 0xda, 0x83, 0x3e, 0x86, 0x34, 0x99, 0x9d,
+	0x2e, 0x88, 0x97, 0x5e, 0x86, 0x49, 0xbe, 0x3f, 0xbf, 0xdf, 0x47, 0xf8, 0xa3, 0xfb, 0x4c, 0xbe,
+	0xa3, 0x9a, 0x4a, 0x42, 0x13, 0x02, 0x9c, 0x63, 0x4b, 0x35, 0xe6, 0x89, 0xc2, 0x1a, 0x0b, 0x13,
+	0x2b, 0x0d, 0x16, 0xc2, 0x7e, 0x1b, 0x89, 0xb7, 0x91, 0xe1, 0x6d, 0x2c, 0x98, 0x84, 0xc4, 0x7d,
+	0x7d, 0x70, 0x78, 0x97, 0x80, 0x11, 0x60, 0x72, 0x77, 0x4a, 0xfc, 0xa1, 0x19, 0xf5, 0x4b, 0x28,
+	0xc1, 0xdf, 0x6f, 0xfe, 0xfc, 0xed, 0x83, 0x6f, 0x5d, 0xd4, 0x4b, 0x9d, 0x2a, 0x3c, 0x46, 0x87,
+	0xb5, 0x9c, 0x83, 0x2c, 0x98, 0x2c, 0x73, 0x45, 0x35, 0x83, 0x22, 0xa7, 0x0a, 0x48, 0x65, 0x06,
+	0xc1, 0x38, 0x98, 0x74, 0xb3, 0x83, 0x76, 0x9c, 0xba, 0xe9, 0x0b, 0x37, 0x0c, 0xdf, 0xa2, 0x5b,
+	0x86, 0x63, 0x53, 0xe5, 0xf3, 0x5a, 0xcb, 0xdc, 0x54, 0x58, 0xd3, 0xc1, 0xb5, 0x71, 0x30, 0xb9,
+	0x39, 0x7d, 0x7a, 0x76, 0x31, 0xea, 0xfc, 0xbc, 0x18, 0x1d, 0xf9, 0x45, 0x4c, 0xf1, 0x3e, 0x66,
+	0x90, 0x08, 0x6c, 0xab, 0xf8, 0x25, 0x2d, 0x31, 0x59, 0xce, 0x28, 0xf9, 0xfe, 0xf5, 0x09, 0x6a,
+	0xf6, 0x9c, 0x51, 0x92, 0xed, 0x3b, 0xd4, 0xb4, 0xd6, 0xf2, 0xd5, 0x06, 0x14, 0x96, 0xe8, 0x8e,
+	0x87, 0x7f, 0xc0, 0x9c, 0x15, 0xd8, 0x82, 0x36, 0x8d, 0xe2, 0xfa, 0xae, 0x8a, 0xbe, 0x03, 0xbe,
+	0x6e, 0x79, 0x5e, 0x24, 0xd1, 0xd0, 0x8b, 0x08, 0x08, 0x51, 0x4b, 0x66, 0x97, 0xb9, 0x02, 0xe0,
+	0x8d, 0xac, 0xbb, 0xab, 0xec, 0xd0, 0x41, 0x4f, 0x2e, 0x99, 0x29, 0x00, 0xf7, 0xbe, 0x0c, 0xed,
+	0x0b, 0x26, 0xf3, 0x82, 0x72, 0x5a, 0x62, 0xcb, 0x40, 0x0e, 0x6e, 0x38, 0xc7, 0xe3, 0xc6, 0x71,
+	0xf0, 0xaf, 0xe3, 0x54, 0xda, 0x2b, 0xf4, 0x53, 0x69, 0xb3, 0x3d, 0xc1, 0xe4, 0xac, 0x25, 0x84,
+	0x27, 0x28, 0x12, 0x78, 0x71, 0xc9, 0xdc, 0x3c, 0x95, 0xa2, 0x3a, 0x57, 0x58, 0x5b, 0x46, 0x98,
+	0xc2, 0xd2, 0x0e, 0x7a, 0xe3, 0x60, 0xb2, 0x97, 0x1d, 0x09, 0xbc, 0x98, 0xb5, 0xa1, 0x94, 0xea,
+	0x74, 0x1b, 0x79, 0xfe, 0xf0, 0xcf, 0xe7, 0x51, 0xf0, 0xf1, 0xf7, 0x97, 0x47, 0xf7, 0xb6, 0xbd,
+	0x5c, 0x5c, 0x6d, 0xa6, 0xaf, 0xcb, 0x34, 0x3d, 0x5b, 0x45, 0xc1, 0xf9, 0x2a, 0x0a, 0x7e, 0xad,
+	0xa2, 0xe0, 0xd3, 0x3a, 0xea, 0x9c, 0xaf, 0xa3, 0xce, 0x8f, 0x75, 0xd4, 0x79, 0x73, 0x5c, 0x32,
+	0x5b, 0xd5, 0xf3, 0x98, 0x80, 0x48, 0x94, 0x86, 0xa2, 0x26, 0xd6, 0x10, 0xe6, 0x40, 0xff, 0x41,
+	0xda, 0xa5, 0xa2, 0x66, 0xde, 0x73, 0x95, 0x7c, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x91,
+	0xfc, 0xa2, 0x11, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -116,6 +151,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UnbondingPeriodEpochs != that1.UnbondingPeriodEpochs {
 		return false
 	}
+	if !this.SlashBurnShare.Equal(that1.SlashBurnShare) {
+		return false
+	}
+	if !this.SlashValidatorsShare.Equal(that1.SlashValidatorsShare) {
+		return false
+	}
+	if !this.SlashCommunityPoolShare.Equal(that1.SlashCommunityPoolShare) {
+		return false
+	}
+	if !this.MinDelegation.Equal(that1.MinDelegation) {
+		return false
+	}
+	if this.MaxDelegatorsPerParticipant != that1.MaxDelegatorsPerParticipant {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +188,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegatorsPerParticipant != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDelegatorsPerParticipant))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashCommunityPoolShare.Size()
+		i -= size
+		if _, err := m.SlashCommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashValidatorsShare.Size()
+		i -= size
+		if _, err := m.SlashValidatorsShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashBurnShare.Size()
+		i -= size
+		if _, err := m.SlashBurnShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.UnbondingPeriodEpochs != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingPeriodEpochs))
 		i--
@@ -166,6 +261,17 @@ func (m *Params) Size() (n int) {
 	if m.UnbondingPeriodEpochs != 0 {
 		n += 1 + sovParams(uint64(m.UnbondingPeriodEpochs))
 	}
+	l = m.SlashBurnShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashValidatorsShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashCommunityPoolShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxDelegatorsPerParticipant != 0 {
+		n += 1 + sovParams(uint64(m.MaxDelegatorsPerParticipant))
+	}
 	return n
 }
 
@@ -223,6 +329,161 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashBurnShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashBurnShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashValidatorsShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashValidatorsShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashCommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatorsPerParticipant", wireType)
+			}
+			m.MaxDelegatorsPerParticipant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatorsPerParticipant |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
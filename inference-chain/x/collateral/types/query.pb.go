@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -490,6 +492,322 @@ func (m *QueryAllUnbondingCollateralsResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+// QuerySlashHistoryRequest is the request type for the Query/SlashHistory RPC method.
+type QuerySlashHistoryRequest struct {
+	// participant limits the history to the slashes of one participant, all slashes are listed when empty
+	Participant string             `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashHistoryRequest) Reset()         { *m = QuerySlashHistoryRequest{} }
+func (m *QuerySlashHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashHistoryRequest) ProtoMessage()    {}
+func (*QuerySlashHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{10}
+}
+func (m *QuerySlashHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashHistoryRequest.Merge(m, src)
+}
+func (m *QuerySlashHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySlashHistoryRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *QuerySlashHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashHistoryResponse is the response type for the Query/SlashHistory RPC method.
+type QuerySlashHistoryResponse struct {
+	SlashRecords []SlashRecord       `protobuf:"bytes,1,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashHistoryResponse) Reset()         { *m = QuerySlashHistoryResponse{} }
+func (m *QuerySlashHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashHistoryResponse) ProtoMessage()    {}
+func (*QuerySlashHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{11}
+}
+func (m *QuerySlashHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashHistoryResponse.Merge(m, src)
+}
+func (m *QuerySlashHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySlashHistoryResponse) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *QuerySlashHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCollateralDelegationsRequest is the request type for the Query/CollateralDelegations RPC method.
+type QueryCollateralDelegationsRequest struct {
+	// participant limits the delegations to those backing one participant
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// delegator limits the delegations to those of one delegator
+	Delegator  string             `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollateralDelegationsRequest) Reset()         { *m = QueryCollateralDelegationsRequest{} }
+func (m *QueryCollateralDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralDelegationsRequest) ProtoMessage()    {}
+func (*QueryCollateralDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{12}
+}
+func (m *QueryCollateralDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollateralDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralDelegationsRequest.Merge(m, src)
+}
+func (m *QueryCollateralDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollateralDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryCollateralDelegationsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *QueryCollateralDelegationsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *QueryCollateralDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCollateralDelegationsResponse is the response type for the Query/CollateralDelegations RPC method.
+type QueryCollateralDelegationsResponse struct {
+	Delegations []CollateralDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	Pagination  *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollateralDelegationsResponse) Reset()         { *m = QueryCollateralDelegationsResponse{} }
+func (m *QueryCollateralDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralDelegationsResponse) ProtoMessage()    {}
+func (*QueryCollateralDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{13}
+}
+func (m *QueryCollateralDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollateralDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralDelegationsResponse.Merge(m, src)
+}
+func (m *QueryCollateralDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollateralDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryCollateralDelegationsResponse) GetDelegations() []CollateralDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryCollateralDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatedCollateralRequest is the request type for the Query/DelegatedCollateral RPC method.
+type QueryDelegatedCollateralRequest struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryDelegatedCollateralRequest) Reset()         { *m = QueryDelegatedCollateralRequest{} }
+func (m *QueryDelegatedCollateralRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatedCollateralRequest) ProtoMessage()    {}
+func (*QueryDelegatedCollateralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{14}
+}
+func (m *QueryDelegatedCollateralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatedCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatedCollateralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatedCollateralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatedCollateralRequest.Merge(m, src)
+}
+func (m *QueryDelegatedCollateralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatedCollateralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatedCollateralRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatedCollateralRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatedCollateralRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// QueryDelegatedCollateralResponse is the response type for the Query/DelegatedCollateral RPC method.
+type QueryDelegatedCollateralResponse struct {
+	// delegated is the total collateral delegated to the participant
+	Delegated types.Coin `protobuf:"bytes,1,opt,name=delegated,proto3" json:"delegated"`
+	// reward_share is the part of the participant's rewards paid to its delegators
+	RewardShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=reward_share,json=rewardShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_share"`
+}
+
+func (m *QueryDelegatedCollateralResponse) Reset()         { *m = QueryDelegatedCollateralResponse{} }
+func (m *QueryDelegatedCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatedCollateralResponse) ProtoMessage()    {}
+func (*QueryDelegatedCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{15}
+}
+func (m *QueryDelegatedCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatedCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatedCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatedCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatedCollateralResponse.Merge(m, src)
+}
+func (m *QueryDelegatedCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatedCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatedCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatedCollateralResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatedCollateralResponse) GetDelegated() types.Coin {
+	if m != nil {
+		return m.Delegated
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.collateral.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.collateral.QueryParamsResponse")
@@ -501,55 +819,81 @@ func init() {
 	proto.RegisterType((*QueryUnbondingCollateralResponse)(nil), "inference.collateral.QueryUnbondingCollateralResponse")
 	proto.RegisterType((*QueryAllUnbondingCollateralsRequest)(nil), "inference.collateral.QueryAllUnbondingCollateralsRequest")
 	proto.RegisterType((*QueryAllUnbondingCollateralsResponse)(nil), "inference.collateral.QueryAllUnbondingCollateralsResponse")
+	proto.RegisterType((*QuerySlashHistoryRequest)(nil), "inference.collateral.QuerySlashHistoryRequest")
+	proto.RegisterType((*QuerySlashHistoryResponse)(nil), "inference.collateral.QuerySlashHistoryResponse")
+	proto.RegisterType((*QueryCollateralDelegationsRequest)(nil), "inference.collateral.QueryCollateralDelegationsRequest")
+	proto.RegisterType((*QueryCollateralDelegationsResponse)(nil), "inference.collateral.QueryCollateralDelegationsResponse")
+	proto.RegisterType((*QueryDelegatedCollateralRequest)(nil), "inference.collateral.QueryDelegatedCollateralRequest")
+	proto.RegisterType((*QueryDelegatedCollateralResponse)(nil), "inference.collateral.QueryDelegatedCollateralResponse")
 }
 
 func init() { proto.RegisterFile("inference/collateral/query.proto", fileDescriptor_eba28a79880c27ca) }
 
 var fileDescriptor_eba28a79880c27ca = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x33, 0x05, 0x82, 0x38, 0x91, 0x58, 0x4c, 0x2b, 0x5a, 0x4c, 0xe5, 0x06, 0x53, 0xd1,
-	0x16, 0xa8, 0xdd, 0xa4, 0xa2, 0x15, 0x15, 0x17, 0xd1, 0x72, 0x59, 0x21, 0xda, 0x48, 0x6c, 0xd8,
-	0xa0, 0xb1, 0x3b, 0x18, 0x4b, 0xce, 0x8c, 0xeb, 0x0b, 0xa2, 0x42, 0x6c, 0x78, 0x02, 0x04, 0x1b,
-	0x9e, 0x02, 0xb1, 0x61, 0xcf, 0xb2, 0x62, 0x55, 0x89, 0x0d, 0x2b, 0x84, 0x5a, 0x1e, 0x04, 0x65,
-	0x3c, 0x8e, 0x1d, 0x32, 0x31, 0x49, 0x55, 0x76, 0x23, 0xe7, 0x3f, 0xff, 0xf9, 0xce, 0x3f, 0x97,
-	0x40, 0xdd, 0x63, 0xcf, 0x69, 0x48, 0x99, 0x43, 0x2d, 0x87, 0xfb, 0x3e, 0x89, 0x69, 0x48, 0x7c,
-	0x6b, 0x27, 0xa1, 0xe1, 0xae, 0x19, 0x84, 0x3c, 0xe6, 0x78, 0xa2, 0xab, 0x30, 0x73, 0x85, 0x36,
-	0xe1, 0x72, 0x97, 0x0b, 0x81, 0xd5, 0x59, 0xa5, 0x5a, 0x6d, 0xda, 0xe5, 0xdc, 0xf5, 0xa9, 0x45,
-	0x02, 0xcf, 0x22, 0x8c, 0xf1, 0x98, 0xc4, 0x1e, 0x67, 0x91, 0xfc, 0xf5, 0x8a, 0xc3, 0xa3, 0x36,
-	0x8f, 0x2c, 0x9b, 0x44, 0x34, 0x6d, 0x61, 0xbd, 0x6c, 0xd8, 0x34, 0x26, 0x0d, 0x2b, 0x20, 0xae,
-	0xc7, 0x84, 0x58, 0x6a, 0x2f, 0x2a, 0xb9, 0x02, 0x12, 0x92, 0x76, 0x66, 0xa7, 0x17, 0xed, 0x32,
-	0x23, 0x87, 0x7b, 0x99, 0xc5, 0xac, 0xd2, 0x22, 0x61, 0x36, 0x67, 0xdb, 0x1e, 0x73, 0xa5, 0x6a,
-	0x51, 0xa9, 0xca, 0x97, 0xcf, 0x6c, 0xe2, 0x93, 0xce, 0xf0, 0x42, 0x6e, 0x4c, 0x00, 0xde, 0xea,
-	0x90, 0x6f, 0x0a, 0x92, 0x16, 0xdd, 0x49, 0x68, 0x14, 0x1b, 0x5b, 0x30, 0xde, 0xf3, 0x35, 0x0a,
-	0x38, 0x8b, 0x28, 0x5e, 0x83, 0x6a, 0x4a, 0x3c, 0x85, 0xea, 0x68, 0xbe, 0xd6, 0x9c, 0x36, 0x55,
-	0x59, 0x9a, 0x69, 0xd5, 0xfa, 0xc9, 0xbd, 0x9f, 0x33, 0x95, 0x96, 0xac, 0x30, 0xd6, 0xe0, 0x9c,
-	0xb0, 0xdc, 0xe8, 0xea, 0x64, 0x33, 0x5c, 0x87, 0x5a, 0x40, 0xc2, 0xd8, 0x73, 0xbc, 0x80, 0xb0,
-	0x58, 0x58, 0x9f, 0x69, 0x15, 0x3f, 0x19, 0x2d, 0x98, 0xec, 0xab, 0x95, 0x48, 0xab, 0x50, 0x25,
-	0x6d, 0x9e, 0xc8, 0xba, 0x5a, 0xf3, 0xbc, 0x99, 0xa6, 0x68, 0x76, 0x52, 0x34, 0x65, 0x8a, 0xe6,
-	0x06, 0xf7, 0x58, 0xc6, 0x93, 0xca, 0x8d, 0x6d, 0xd0, 0x84, 0xe7, 0x5d, 0xdf, 0xcf, 0x6d, 0xb3,
-	0x00, 0xf0, 0x03, 0x80, 0x7c, 0x0b, 0xa5, 0xf5, 0xe5, 0x1e, 0xeb, 0xf4, 0x48, 0x65, 0x0d, 0x36,
-	0x89, 0x4b, 0x65, 0x6d, 0xab, 0x50, 0x69, 0x7c, 0x41, 0x70, 0x41, 0xd9, 0x46, 0xe2, 0x3f, 0x02,
-	0xc8, 0x83, 0x9b, 0x42, 0xf5, 0x13, 0xf3, 0xb5, 0xe6, 0x9c, 0x3a, 0xd5, 0xbc, 0x7c, 0x3d, 0xdd,
-	0x41, 0x39, 0x50, 0xc1, 0x00, 0x3f, 0xec, 0xc1, 0x1e, 0x13, 0xd8, 0x73, 0xff, 0xc4, 0x4e, 0x59,
-	0x7a, 0xb8, 0x37, 0x60, 0x46, 0x60, 0x3f, 0xc9, 0x4e, 0xd7, 0x51, 0xb6, 0x2d, 0x82, 0xfa, 0x60,
-	0x13, 0x19, 0xc0, 0x63, 0x80, 0xee, 0x09, 0x8e, 0x64, 0x00, 0x0b, 0xea, 0x00, 0x14, 0x36, 0x59,
-	0x04, 0xb9, 0x85, 0xd1, 0x86, 0x4b, 0x59, 0xe0, 0x8a, 0x82, 0x63, 0xdf, 0xe0, 0xaf, 0x08, 0x66,
-	0xcb, 0xfb, 0xfd, 0xa7, 0x41, 0x8f, 0x6d, 0xaf, 0x9b, 0x1f, 0x4f, 0xc3, 0x29, 0x31, 0x02, 0x7e,
-	0x8f, 0xa0, 0x9a, 0x5e, 0x5e, 0x3c, 0xaf, 0x46, 0xeb, 0x7f, 0x2b, 0xb4, 0x85, 0x21, 0x94, 0x69,
-	0x57, 0x63, 0xf9, 0xed, 0xf7, 0xdf, 0x1f, 0xc6, 0x16, 0xf1, 0x55, 0x2b, 0x08, 0xf9, 0x76, 0xe2,
-	0xc4, 0x91, 0xe3, 0x89, 0x97, 0xaa, 0xe4, 0x71, 0xc4, 0x9f, 0x11, 0x40, 0x1e, 0x04, 0xbe, 0x56,
-	0xd2, 0xae, 0xef, 0x90, 0x6a, 0x8b, 0x43, 0xaa, 0x25, 0xe0, 0x7d, 0x01, 0x78, 0x07, 0xdf, 0x1a,
-	0x0a, 0xb0, 0xb0, 0x7c, 0x5d, 0x38, 0xf7, 0x6f, 0xf0, 0x27, 0x04, 0x67, 0x7b, 0x2f, 0x3c, 0x5e,
-	0x2a, 0x01, 0x51, 0x3e, 0x41, 0x5a, 0x63, 0x84, 0x0a, 0x89, 0xbf, 0x2a, 0xf0, 0x1b, 0xd8, 0x1a,
-	0x11, 0x1f, 0x7f, 0x43, 0x30, 0xae, 0x38, 0x75, 0xf8, 0x7a, 0x09, 0xc3, 0xe0, 0xa7, 0x41, 0x5b,
-	0x19, 0xb5, 0x4c, 0xf2, 0xdf, 0x13, 0xfc, 0xb7, 0xf1, 0xcd, 0xa1, 0xf8, 0xbb, 0x77, 0xe1, 0xaf,
-	0xf4, 0xf7, 0x10, 0x4c, 0x0e, 0xb8, 0x8d, 0xf8, 0x46, 0x79, 0xa8, 0x25, 0x2f, 0x86, 0xb6, 0x76,
-	0x94, 0x52, 0x39, 0xd8, 0x8a, 0x18, 0x6c, 0x09, 0x9b, 0xa3, 0x0d, 0xb6, 0xbe, 0xb9, 0x77, 0xa0,
-	0xa3, 0xfd, 0x03, 0x1d, 0xfd, 0x3a, 0xd0, 0xd1, 0xbb, 0x43, 0xbd, 0xb2, 0x7f, 0xa8, 0x57, 0x7e,
-	0x1c, 0xea, 0x95, 0xa7, 0x2b, 0xae, 0x17, 0xbf, 0x48, 0x6c, 0xd3, 0xe1, 0xed, 0xc1, 0x9e, 0xaf,
-	0x8a, 0xae, 0xf1, 0x6e, 0x40, 0x23, 0xbb, 0x2a, 0xfe, 0xf6, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0x22, 0x4b, 0x7b, 0x28, 0x09, 0x00, 0x00,
+	// 1002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xa4, 0x10, 0x29, 0x6f, 0x03, 0x87, 0x69, 0xa0, 0x89, 0x1b, 0x6d, 0x36, 0xa6, 0x90,
+	0xb4, 0x10, 0x3b, 0xbb, 0x85, 0xa4, 0x44, 0x14, 0x44, 0x12, 0x7e, 0x1c, 0x8a, 0x48, 0x5d, 0xb8,
+	0x70, 0x89, 0x66, 0xed, 0xc1, 0x6b, 0xe1, 0xf5, 0xb8, 0x1e, 0x2f, 0x10, 0x21, 0x2e, 0x88, 0x3f,
+	0x00, 0xc1, 0x9f, 0x51, 0x09, 0x21, 0x04, 0xe2, 0x0a, 0x12, 0x87, 0x88, 0x53, 0x05, 0x17, 0xd4,
+	0x43, 0x85, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0xe3, 0xb5, 0x37, 0x99, 0x75, 0xbc, 0xab, 0x70, 0xdb,
+	0x8c, 0xde, 0xfb, 0xde, 0xf7, 0xbe, 0x37, 0xf3, 0x3e, 0x07, 0x1a, 0x41, 0xf4, 0x31, 0x4d, 0x68,
+	0xe4, 0x52, 0xdb, 0x65, 0x61, 0x48, 0x52, 0x9a, 0x90, 0xd0, 0xbe, 0xdf, 0xa3, 0xc9, 0xa1, 0x15,
+	0x27, 0x2c, 0x65, 0x78, 0x7e, 0x10, 0x61, 0xe5, 0x11, 0xc6, 0xa2, 0xcb, 0x78, 0x97, 0xf1, 0x03,
+	0x11, 0x63, 0xcb, 0x3f, 0x64, 0x82, 0x31, 0xef, 0x33, 0x9f, 0xc9, 0xf3, 0xfe, 0x2f, 0x75, 0xba,
+	0xe4, 0x33, 0xe6, 0x87, 0xd4, 0x26, 0x71, 0x60, 0x93, 0x28, 0x62, 0x29, 0x49, 0x03, 0x16, 0x65,
+	0x39, 0x37, 0x24, 0x82, 0xdd, 0x26, 0x9c, 0xca, 0xea, 0xf6, 0xa7, 0xcd, 0x36, 0x4d, 0x49, 0xd3,
+	0x8e, 0x89, 0x1f, 0x44, 0x22, 0x58, 0xc5, 0xae, 0x68, 0x29, 0xc7, 0x24, 0x21, 0xdd, 0x0c, 0xae,
+	0x5e, 0x84, 0xcb, 0x80, 0x5c, 0x16, 0x64, 0x10, 0xd7, 0xb4, 0x10, 0xbd, 0xa8, 0xcd, 0x22, 0x2f,
+	0x88, 0x7c, 0x15, 0xb5, 0xae, 0x8d, 0xca, 0x7f, 0x1e, 0xb4, 0x49, 0x48, 0xfa, 0xba, 0xc8, 0xf0,
+	0x55, 0x6d, 0x38, 0x0f, 0x09, 0xef, 0x1c, 0x24, 0xd4, 0x65, 0x89, 0xa7, 0x02, 0x9f, 0xd7, 0x06,
+	0x7a, 0x34, 0xa4, 0x7e, 0xa1, 0x4f, 0x73, 0x1e, 0xf0, 0xdd, 0xbe, 0x12, 0xfb, 0xa2, 0x33, 0x87,
+	0xde, 0xef, 0x51, 0x9e, 0x9a, 0x77, 0xe1, 0xf2, 0xd0, 0x29, 0x8f, 0x59, 0xc4, 0x29, 0xde, 0x86,
+	0x19, 0xa9, 0xc0, 0x02, 0x6a, 0xa0, 0xb5, 0x5a, 0x6b, 0xc9, 0xd2, 0x8d, 0xcd, 0x92, 0x59, 0x3b,
+	0x4f, 0x1c, 0x3d, 0x5e, 0x9e, 0x72, 0x54, 0x86, 0xb9, 0x0d, 0xcf, 0x0a, 0xc8, 0xdd, 0x41, 0x9c,
+	0x2a, 0x86, 0x1b, 0x50, 0x8b, 0x49, 0x92, 0x06, 0x6e, 0x10, 0x93, 0x28, 0x15, 0xd0, 0xb3, 0x4e,
+	0xf1, 0xc8, 0x74, 0xe0, 0xca, 0x99, 0x5c, 0x45, 0x69, 0x0b, 0x66, 0x48, 0x97, 0xf5, 0x54, 0x5e,
+	0xad, 0xb5, 0x68, 0xa9, 0x6b, 0xd2, 0x9f, 0x8a, 0xa5, 0xa6, 0x62, 0xed, 0xb2, 0x20, 0xca, 0xf8,
+	0xc8, 0x70, 0xd3, 0x03, 0x43, 0x60, 0xbe, 0x19, 0x86, 0x39, 0x6c, 0x26, 0x00, 0x7e, 0x1b, 0x20,
+	0xbf, 0x12, 0x0a, 0xfa, 0x85, 0x21, 0x68, 0x79, 0x7b, 0xb3, 0x02, 0xfb, 0xc4, 0xa7, 0x2a, 0xd7,
+	0x29, 0x64, 0x9a, 0x3f, 0x23, 0xb8, 0xaa, 0x2d, 0xa3, 0xe8, 0xbf, 0x07, 0x90, 0x0b, 0xb7, 0x80,
+	0x1a, 0x97, 0xd6, 0x6a, 0xad, 0x55, 0xbd, 0xaa, 0x79, 0xfa, 0x8e, 0xbc, 0x11, 0xaa, 0xa1, 0x02,
+	0x00, 0x7e, 0x67, 0x88, 0xf6, 0xb4, 0xa0, 0xbd, 0x7a, 0x2e, 0x6d, 0xc9, 0x65, 0x88, 0xf7, 0x2e,
+	0x2c, 0x0b, 0xda, 0x1f, 0x66, 0xb7, 0x75, 0x92, 0xb1, 0x71, 0x68, 0x8c, 0x06, 0x51, 0x02, 0xbc,
+	0x0f, 0x30, 0x78, 0x11, 0x5c, 0x09, 0x70, 0x5d, 0x2f, 0x80, 0x06, 0x26, 0x93, 0x20, 0x87, 0x30,
+	0xbb, 0xf0, 0x5c, 0x26, 0xb8, 0x26, 0xe1, 0xc2, 0x07, 0xfc, 0x2b, 0x82, 0x6b, 0xe5, 0xf5, 0xfe,
+	0xa7, 0x46, 0x2f, 0x6e, 0xd6, 0x5f, 0x23, 0x58, 0x10, 0x2d, 0xdc, 0xeb, 0x6f, 0x91, 0x77, 0x03,
+	0x9e, 0xb2, 0xe4, 0xb0, 0xf2, 0x94, 0x4f, 0x29, 0x39, 0x3d, 0xb1, 0x92, 0x3f, 0x22, 0x58, 0xd4,
+	0xd0, 0x50, 0xf2, 0xdd, 0x81, 0xa7, 0x8a, 0x4b, 0x2e, 0x53, 0x70, 0x45, 0xaf, 0xa0, 0x80, 0x70,
+	0x44, 0xa4, 0x52, 0x6e, 0x8e, 0xe7, 0x47, 0x17, 0xa8, 0xdd, 0x03, 0x04, 0x2b, 0xa7, 0x56, 0xd3,
+	0xde, 0x60, 0xc5, 0xf2, 0xea, 0x22, 0x2e, 0xc1, 0xac, 0x5a, 0xcd, 0x2c, 0x11, 0x7c, 0x66, 0x9d,
+	0xfc, 0xe0, 0x94, 0xc4, 0x97, 0x26, 0x96, 0xf8, 0x37, 0x04, 0x66, 0x19, 0x5b, 0xa5, 0xb5, 0x03,
+	0xb5, 0xdc, 0x27, 0x32, 0xa5, 0x6f, 0x9c, 0xb7, 0x95, 0x72, 0x24, 0x25, 0x79, 0x11, 0xe4, 0xe2,
+	0x37, 0x93, 0x2a, 0x47, 0xbd, 0x49, 0x36, 0xd3, 0x2f, 0x48, 0xad, 0x26, 0x2d, 0x8a, 0x92, 0xe1,
+	0xf6, 0x60, 0x26, 0xd4, 0xab, 0xea, 0x2e, 0x79, 0x06, 0xfe, 0x00, 0xe6, 0x12, 0xfa, 0x19, 0x49,
+	0xbc, 0x03, 0xde, 0x21, 0x09, 0x95, 0x53, 0xdd, 0x69, 0xf6, 0xc3, 0x1e, 0x3d, 0x5e, 0xbe, 0x2a,
+	0x81, 0xb8, 0xf7, 0x89, 0x15, 0x30, 0xbb, 0x4b, 0xd2, 0x8e, 0x75, 0x87, 0xfa, 0xc4, 0x3d, 0xdc,
+	0xa3, 0xee, 0x9f, 0x3f, 0xad, 0x83, 0xaa, 0xb3, 0x47, 0x5d, 0xa7, 0x26, 0x61, 0xee, 0xf5, 0x51,
+	0x5a, 0x8f, 0x6a, 0xf0, 0xa4, 0x60, 0x8e, 0xbf, 0x45, 0x30, 0x23, 0x9d, 0x16, 0xaf, 0xe9, 0x67,
+	0x73, 0xd6, 0xd8, 0x8d, 0xeb, 0x15, 0x22, 0x65, 0xfb, 0xe6, 0xcd, 0xaf, 0xfe, 0xfa, 0xf7, 0xbb,
+	0xe9, 0x75, 0xfc, 0xa2, 0x1d, 0x27, 0xcc, 0xeb, 0xb9, 0x29, 0x77, 0x03, 0xf1, 0x39, 0x51, 0xf2,
+	0x65, 0x84, 0x7f, 0x40, 0x00, 0xb9, 0x94, 0xf8, 0xa5, 0x92, 0x72, 0x67, 0xe6, 0x66, 0xac, 0x57,
+	0x8c, 0x56, 0x04, 0xdf, 0x12, 0x04, 0xdf, 0xc0, 0xb7, 0x2b, 0x11, 0x2c, 0xfc, 0xfc, 0xa2, 0x70,
+	0x15, 0xbe, 0xc4, 0xdf, 0x23, 0x78, 0x7a, 0xd8, 0x9d, 0xf1, 0x46, 0x09, 0x11, 0xed, 0xf7, 0x82,
+	0xd1, 0x1c, 0x23, 0x43, 0xd1, 0xdf, 0x12, 0xf4, 0x9b, 0xd8, 0x1e, 0x93, 0x3e, 0xfe, 0x03, 0xc1,
+	0x65, 0x8d, 0x45, 0xe0, 0x57, 0x4a, 0x38, 0x8c, 0xf6, 0x71, 0x63, 0x73, 0xdc, 0x34, 0xc5, 0x7f,
+	0x4f, 0xf0, 0x7f, 0x1d, 0xbf, 0x56, 0x89, 0xff, 0xc0, 0xb8, 0x4e, 0xa9, 0x7f, 0x84, 0xe0, 0xca,
+	0x08, 0xeb, 0xc4, 0xaf, 0x96, 0x8b, 0x5a, 0x62, 0xef, 0xc6, 0xf6, 0x24, 0xa9, 0xaa, 0xb1, 0x4d,
+	0xd1, 0xd8, 0x06, 0xb6, 0xc6, 0x6b, 0x0c, 0x3f, 0x40, 0x30, 0x57, 0xf4, 0x2e, 0x6c, 0x95, 0x90,
+	0xd0, 0x78, 0xad, 0x61, 0x57, 0x8e, 0x57, 0x4c, 0xb7, 0x05, 0xd3, 0x97, 0x71, 0xab, 0x12, 0x53,
+	0xe9, 0x9f, 0x1d, 0x45, 0xee, 0x77, 0x04, 0xcf, 0x68, 0x6d, 0x00, 0x6f, 0x55, 0x7a, 0x86, 0x67,
+	0x6d, 0xce, 0xb8, 0x35, 0x7e, 0xa2, 0x6a, 0xe4, 0x96, 0x68, 0xa4, 0x85, 0x37, 0x2a, 0x35, 0x52,
+	0xf4, 0x95, 0xfe, 0x63, 0xd0, 0x2c, 0xf1, 0xd2, 0xc7, 0x30, 0xda, 0x3a, 0x4a, 0x1f, 0x43, 0x89,
+	0x57, 0x8c, 0xf9, 0x18, 0x06, 0x26, 0x31, 0xfc, 0x18, 0x76, 0xf6, 0x8f, 0x8e, 0xeb, 0xe8, 0xe1,
+	0x71, 0x1d, 0xfd, 0x73, 0x5c, 0x47, 0xdf, 0x9c, 0xd4, 0xa7, 0x1e, 0x9e, 0xd4, 0xa7, 0xfe, 0x3e,
+	0xa9, 0x4f, 0x7d, 0xb4, 0xe9, 0x07, 0x69, 0xa7, 0xd7, 0xb6, 0x5c, 0xd6, 0x1d, 0x5d, 0xe1, 0xf3,
+	0x62, 0x8d, 0xf4, 0x30, 0xa6, 0xbc, 0x3d, 0x23, 0xfe, 0xcb, 0xbb, 0xf9, 0x5f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xc7, 0xea, 0xb2, 0xb2, 0x82, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -574,6 +918,12 @@ type QueryClient interface {
 	UnbondingCollateral(ctx context.Context, in *QueryUnbondingCollateralRequest, opts ...grpc.CallOption) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(ctx context.Context, in *QueryAllUnbondingCollateralsRequest, opts ...grpc.CallOption) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the history of slashes and how the slashed collateral was distributed, optionally of one participant.
+	SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error)
+	// Queries collateral delegations, optionally of one participant and/or one delegator.
+	CollateralDelegations(ctx context.Context, in *QueryCollateralDelegationsRequest, opts ...grpc.CallOption) (*QueryCollateralDelegationsResponse, error)
+	// Queries a participant's delegated collateral and the reward share it pays its delegators.
+	DelegatedCollateral(ctx context.Context, in *QueryDelegatedCollateralRequest, opts ...grpc.CallOption) (*QueryDelegatedCollateralResponse, error)
 }
 
 type queryClient struct {
@@ -629,6 +979,33 @@ func (c *queryClient) AllUnbondingCollaterals(ctx context.Context, in *QueryAllU
 	return out, nil
 }
 
+func (c *queryClient) SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error) {
+	out := new(QuerySlashHistoryResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/SlashHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollateralDelegations(ctx context.Context, in *QueryCollateralDelegationsRequest, opts ...grpc.CallOption) (*QueryCollateralDelegationsResponse, error) {
+	out := new(QueryCollateralDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/CollateralDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedCollateral(ctx context.Context, in *QueryDelegatedCollateralRequest, opts ...grpc.CallOption) (*QueryDelegatedCollateralResponse, error) {
+	out := new(QueryDelegatedCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/DelegatedCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -641,6 +1018,12 @@ type QueryServer interface {
 	UnbondingCollateral(context.Context, *QueryUnbondingCollateralRequest) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the history of slashes and how the slashed collateral was distributed, optionally of one participant.
+	SlashHistory(context.Context, *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error)
+	// Queries collateral delegations, optionally of one participant and/or one delegator.
+	CollateralDelegations(context.Context, *QueryCollateralDelegationsRequest) (*QueryCollateralDelegationsResponse, error)
+	// Queries a participant's delegated collateral and the reward share it pays its delegators.
+	DelegatedCollateral(context.Context, *QueryDelegatedCollateralRequest) (*QueryDelegatedCollateralResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -662,6 +1045,15 @@ func (*UnimplementedQueryServer) UnbondingCollateral(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) AllUnbondingCollaterals(ctx context.Context, req *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllUnbondingCollaterals not implemented")
 }
+func (*UnimplementedQueryServer) SlashHistory(ctx context.Context, req *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashHistory not implemented")
+}
+func (*UnimplementedQueryServer) CollateralDelegations(ctx context.Context, req *QueryCollateralDelegationsRequest) (*QueryCollateralDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatedCollateral(ctx context.Context, req *QueryDelegatedCollateralRequest) (*QueryDelegatedCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatedCollateral not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -757,6 +1149,60 @@ func _Query_AllUnbondingCollaterals_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/SlashHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashHistory(ctx, req.(*QuerySlashHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollateralDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/CollateralDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralDelegations(ctx, req.(*QueryCollateralDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatedCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/DelegatedCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedCollateral(ctx, req.(*QueryDelegatedCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.collateral.Query",
@@ -782,6 +1228,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllUnbondingCollaterals",
 			Handler:    _Query_AllUnbondingCollaterals_Handler,
 		},
+		{
+			MethodName: "SlashHistory",
+			Handler:    _Query_SlashHistory_Handler,
+		},
+		{
+			MethodName: "CollateralDelegations",
+			Handler:    _Query_CollateralDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatedCollateral",
+			Handler:    _Query_DelegatedCollateral_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/query.proto",
@@ -1141,6 +1599,268 @@ func (m *QueryAllUnbondingCollateralsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySlashHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatedCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatedCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatedCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatedCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatedCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatedCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardShare.Size()
+		i -= size
+		if _, err := m.RewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1221,80 +1941,686 @@ func (m *QueryAllCollateralsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllUnbondingCollateralsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUnbondingCollateralsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatedCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatedCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryUnbondingCollateralRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryUnbondingCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryAllUnbondingCollateralsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllUnbondingCollateralsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, CollateralBalance{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1317,12 +2643,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1344,7 +2702,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,15 +2725,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1402,7 +2760,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1427,7 +2786,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,17 +2809,17 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1470,23 +2829,27 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1509,7 +2872,7 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,15 +2895,15 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,7 +2930,44 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1592,7 +2992,7 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,13 +3015,45 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1678,7 +3110,7 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +3133,15 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1736,8 +3168,8 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = append(m.Collateral, CollateralBalance{})
-			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1798,7 +3230,7 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,10 +3253,10 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1859,6 +3291,74 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1880,7 +3380,7 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1903,15 +3403,15 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1938,8 +3438,44 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, CollateralDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1964,7 +3500,7 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatedCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1987,17 +3523,17 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatedCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatedCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2007,27 +3543,23 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2050,7 +3582,7 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatedCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,15 +3605,15 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatedCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatedCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2108,16 +3640,15 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2127,25 +3658,23 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
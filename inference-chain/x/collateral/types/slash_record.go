@@ -0,0 +1,15 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorsAmount is the part of the slash paid to validators
+func (r SlashRecord) ValidatorsAmount() sdk.Coin {
+	total := sdk.NewCoin(r.Slashed.Denom, math.ZeroInt())
+	for _, payout := range r.ValidatorPayouts {
+		total = total.Add(payout.Amount)
+	}
+	return total
+}
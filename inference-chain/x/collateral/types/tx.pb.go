@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -322,6 +323,306 @@ func (m *MsgWithdrawCollateralResponse) GetCompletionEpoch() uint64 {
 	return 0
 }
 
+// MsgDelegateCollateral defines a message to deposit collateral on behalf of a participant
+type MsgDelegateCollateral struct {
+	// delegator is the address depositing collateral
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// participant is the address the collateral backs
+	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	// amount is the collateral to delegate
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDelegateCollateral) Reset()         { *m = MsgDelegateCollateral{} }
+func (m *MsgDelegateCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateCollateral) ProtoMessage()    {}
+func (*MsgDelegateCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{6}
+}
+func (m *MsgDelegateCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateCollateral.Merge(m, src)
+}
+func (m *MsgDelegateCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateCollateral proto.InternalMessageInfo
+
+func (m *MsgDelegateCollateral) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateCollateral) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *MsgDelegateCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgDelegateCollateralResponse defines the response structure for executing a
+// MsgDelegateCollateral message.
+type MsgDelegateCollateralResponse struct {
+}
+
+func (m *MsgDelegateCollateralResponse) Reset()         { *m = MsgDelegateCollateralResponse{} }
+func (m *MsgDelegateCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateCollateralResponse) ProtoMessage()    {}
+func (*MsgDelegateCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{7}
+}
+func (m *MsgDelegateCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateCollateralResponse.Merge(m, src)
+}
+func (m *MsgDelegateCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateCollateralResponse proto.InternalMessageInfo
+
+// MsgUndelegateCollateral defines a message to withdraw delegated collateral
+type MsgUndelegateCollateral struct {
+	// delegator is the address withdrawing collateral
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// participant is the address the collateral backs
+	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	// amount is the collateral to withdraw
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgUndelegateCollateral) Reset()         { *m = MsgUndelegateCollateral{} }
+func (m *MsgUndelegateCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateCollateral) ProtoMessage()    {}
+func (*MsgUndelegateCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{8}
+}
+func (m *MsgUndelegateCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateCollateral.Merge(m, src)
+}
+func (m *MsgUndelegateCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateCollateral proto.InternalMessageInfo
+
+func (m *MsgUndelegateCollateral) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgUndelegateCollateral) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *MsgUndelegateCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgUndelegateCollateralResponse defines the response structure for executing a
+// MsgUndelegateCollateral message.
+type MsgUndelegateCollateralResponse struct {
+	// completion_epoch is the epoch when the withdrawal will complete
+	CompletionEpoch uint64 `protobuf:"varint,1,opt,name=completion_epoch,json=completionEpoch,proto3" json:"completion_epoch,omitempty"`
+}
+
+func (m *MsgUndelegateCollateralResponse) Reset()         { *m = MsgUndelegateCollateralResponse{} }
+func (m *MsgUndelegateCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateCollateralResponse) ProtoMessage()    {}
+func (*MsgUndelegateCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{9}
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateCollateralResponse.Merge(m, src)
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateCollateralResponse) GetCompletionEpoch() uint64 {
+	if m != nil {
+		return m.CompletionEpoch
+	}
+	return 0
+}
+
+// MsgSetDelegatorRewardShare defines a message to set the part of a participant's rewards
+// paid to the delegators of its collateral
+type MsgSetDelegatorRewardShare struct {
+	// participant is the address sharing its rewards
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// reward_share is the part of the rewards paid to the delegators, between 0 and 1
+	RewardShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=reward_share,json=rewardShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_share"`
+}
+
+func (m *MsgSetDelegatorRewardShare) Reset()         { *m = MsgSetDelegatorRewardShare{} }
+func (m *MsgSetDelegatorRewardShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegatorRewardShare) ProtoMessage()    {}
+func (*MsgSetDelegatorRewardShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{10}
+}
+func (m *MsgSetDelegatorRewardShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegatorRewardShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegatorRewardShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegatorRewardShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegatorRewardShare.Merge(m, src)
+}
+func (m *MsgSetDelegatorRewardShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegatorRewardShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegatorRewardShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDelegatorRewardShare proto.InternalMessageInfo
+
+func (m *MsgSetDelegatorRewardShare) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// MsgSetDelegatorRewardShareResponse defines the response structure for executing a
+// MsgSetDelegatorRewardShare message.
+type MsgSetDelegatorRewardShareResponse struct {
+}
+
+func (m *MsgSetDelegatorRewardShareResponse) Reset()         { *m = MsgSetDelegatorRewardShareResponse{} }
+func (m *MsgSetDelegatorRewardShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegatorRewardShareResponse) ProtoMessage()    {}
+func (*MsgSetDelegatorRewardShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{11}
+}
+func (m *MsgSetDelegatorRewardShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegatorRewardShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegatorRewardShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegatorRewardShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegatorRewardShareResponse.Merge(m, src)
+}
+func (m *MsgSetDelegatorRewardShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegatorRewardShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegatorRewardShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDelegatorRewardShareResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.collateral.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.collateral.MsgUpdateParamsResponse")
@@ -329,47 +630,67 @@ func init() {
 	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "inference.collateral.MsgDepositCollateralResponse")
 	proto.RegisterType((*MsgWithdrawCollateral)(nil), "inference.collateral.MsgWithdrawCollateral")
 	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "inference.collateral.MsgWithdrawCollateralResponse")
+	proto.RegisterType((*MsgDelegateCollateral)(nil), "inference.collateral.MsgDelegateCollateral")
+	proto.RegisterType((*MsgDelegateCollateralResponse)(nil), "inference.collateral.MsgDelegateCollateralResponse")
+	proto.RegisterType((*MsgUndelegateCollateral)(nil), "inference.collateral.MsgUndelegateCollateral")
+	proto.RegisterType((*MsgUndelegateCollateralResponse)(nil), "inference.collateral.MsgUndelegateCollateralResponse")
+	proto.RegisterType((*MsgSetDelegatorRewardShare)(nil), "inference.collateral.MsgSetDelegatorRewardShare")
+	proto.RegisterType((*MsgSetDelegatorRewardShareResponse)(nil), "inference.collateral.MsgSetDelegatorRewardShareResponse")
 }
 
 func init() { proto.RegisterFile("inference/collateral/tx.proto", fileDescriptor_e738e4a8b0fcc692) }
 
 var fileDescriptor_e738e4a8b0fcc692 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4d, 0x6b, 0x13, 0x4f,
-	0x1c, 0xc7, 0x33, 0xfd, 0xff, 0x0d, 0x64, 0x2a, 0xd4, 0x2e, 0x91, 0x26, 0x4b, 0xbb, 0xd6, 0x80,
-	0x52, 0x53, 0xbb, 0x4b, 0x52, 0x28, 0x18, 0x04, 0x6d, 0xaa, 0x17, 0x21, 0x50, 0x22, 0x22, 0x78,
-	0x29, 0x93, 0xd9, 0x71, 0x33, 0x90, 0x9d, 0x19, 0x66, 0x26, 0xb1, 0xbd, 0x89, 0x47, 0x4f, 0xbe,
-	0x0c, 0x8f, 0x39, 0xf8, 0x16, 0x84, 0x1e, 0x4b, 0xf1, 0xe0, 0x49, 0x25, 0x39, 0xe4, 0x6d, 0xc8,
-	0x3e, 0xe4, 0xa1, 0xd9, 0x5d, 0xac, 0x37, 0x2f, 0xc9, 0xce, 0xef, 0xf7, 0xfd, 0x3d, 0x7c, 0x66,
-	0xbf, 0x2c, 0xdc, 0xa2, 0xec, 0x2d, 0x91, 0x84, 0x61, 0xe2, 0x60, 0xde, 0xeb, 0x21, 0x4d, 0x24,
-	0xea, 0x39, 0xfa, 0xd4, 0x16, 0x92, 0x6b, 0x6e, 0x14, 0x67, 0x69, 0x7b, 0x9e, 0x36, 0xd7, 0x91,
-	0x4f, 0x19, 0x77, 0xc2, 0xdf, 0x48, 0x68, 0x6e, 0x60, 0xae, 0x7c, 0xae, 0x1c, 0x5f, 0x79, 0xce,
-	0xa0, 0x16, 0xfc, 0xc5, 0x89, 0x72, 0x94, 0x38, 0x09, 0x4f, 0x4e, 0x74, 0x88, 0x53, 0x45, 0x8f,
-	0x7b, 0x3c, 0x8a, 0x07, 0x4f, 0x71, 0xf4, 0x6e, 0xea, 0x46, 0x02, 0x49, 0xe4, 0x4f, 0x0b, 0xad,
-	0x78, 0x58, 0x07, 0x29, 0xe2, 0x0c, 0x6a, 0x1d, 0xa2, 0x51, 0xcd, 0xc1, 0x9c, 0xb2, 0x28, 0x5f,
-	0xf9, 0x0a, 0xe0, 0x5a, 0x4b, 0x79, 0xaf, 0x84, 0x8b, 0x34, 0x39, 0x0e, 0x2b, 0x8d, 0x03, 0x58,
-	0x40, 0x7d, 0xdd, 0xe5, 0x92, 0xea, 0xb3, 0x12, 0xd8, 0x06, 0x3b, 0x85, 0x66, 0xe9, 0xf2, 0xcb,
-	0x5e, 0x31, 0xde, 0xe8, 0xd0, 0x75, 0x25, 0x51, 0xea, 0xa5, 0x96, 0x94, 0x79, 0xed, 0xb9, 0xd4,
-	0x78, 0x02, 0xf3, 0xd1, 0xec, 0xd2, 0xca, 0x36, 0xd8, 0x59, 0xad, 0x6f, 0xda, 0x69, 0x57, 0x62,
-	0x47, 0x53, 0x9a, 0x85, 0xf3, 0x1f, 0x77, 0x72, 0x9f, 0x27, 0xc3, 0x2a, 0x68, 0xc7, 0x65, 0x8d,
-	0x47, 0x1f, 0x26, 0xc3, 0xea, 0xbc, 0xe1, 0xc7, 0xc9, 0xb0, 0x7a, 0x7f, 0x8e, 0x78, 0xba, 0x08,
-	0xb9, 0xb4, 0x73, 0xa5, 0x0c, 0x37, 0x96, 0x42, 0x6d, 0xa2, 0x04, 0x67, 0x8a, 0x54, 0x2e, 0x01,
-	0x2c, 0xb6, 0x94, 0xf7, 0x8c, 0x08, 0xae, 0xa8, 0x3e, 0x9a, 0xf5, 0x30, 0x1a, 0x70, 0x55, 0x20,
-	0xa9, 0x29, 0xa6, 0x02, 0x31, 0xfd, 0x47, 0xd2, 0x45, 0xb1, 0xf1, 0x18, 0xe6, 0x91, 0xcf, 0xfb,
-	0x4c, 0xc7, 0xac, 0x65, 0x3b, 0xae, 0x09, 0x2e, 0xda, 0x8e, 0x2f, 0xda, 0x3e, 0xe2, 0x94, 0x5d,
-	0x01, 0x8d, 0x6a, 0x1a, 0x4f, 0x03, 0xd0, 0xc5, 0x7e, 0x01, 0xea, 0x6e, 0x36, 0x6a, 0x62, 0xf7,
-	0x8a, 0x05, 0x37, 0xd3, 0xe2, 0x33, 0xe8, 0x6f, 0x00, 0xde, 0x6e, 0x29, 0xef, 0x35, 0xd5, 0x5d,
-	0x57, 0xa2, 0x77, 0xff, 0x04, 0xf5, 0x61, 0x1a, 0xf5, 0xc3, 0x6c, 0xea, 0xe4, 0xf2, 0x95, 0x17,
-	0x70, 0x2b, 0x35, 0x31, 0xe5, 0x36, 0x1e, 0xc0, 0x5b, 0x98, 0xfb, 0xa2, 0x47, 0x34, 0xe5, 0xec,
-	0x84, 0x08, 0x8e, 0xbb, 0x21, 0xe2, 0xff, 0xed, 0xb5, 0x79, 0xfc, 0x79, 0x10, 0xae, 0xff, 0x5c,
-	0x81, 0xff, 0xb5, 0x94, 0x67, 0xb8, 0xf0, 0xe6, 0x15, 0xfb, 0xdf, 0x4b, 0xb7, 0xed, 0x92, 0xbd,
-	0xcc, 0xbd, 0x6b, 0xc9, 0x66, 0x8b, 0x29, 0xb8, 0x9e, 0x74, 0x60, 0x35, 0xb3, 0x47, 0x42, 0x6b,
-	0xd6, 0xaf, 0xaf, 0x9d, 0x0d, 0x1d, 0x40, 0x23, 0xc5, 0x01, 0xbb, 0x99, 0x9d, 0x92, 0x62, 0x73,
-	0xff, 0x2f, 0xc4, 0xd3, 0xb9, 0xe6, 0x8d, 0xf7, 0xc1, 0x8b, 0x6f, 0x1e, 0x9f, 0x8f, 0x2c, 0x70,
-	0x31, 0xb2, 0xc0, 0xaf, 0x91, 0x05, 0x3e, 0x8d, 0xad, 0xdc, 0xc5, 0xd8, 0xca, 0x7d, 0x1f, 0x5b,
-	0xb9, 0x37, 0x07, 0x1e, 0xd5, 0xdd, 0x7e, 0xc7, 0xc6, 0xdc, 0x77, 0x84, 0xe4, 0x6e, 0x1f, 0x6b,
-	0x85, 0x69, 0xe8, 0x82, 0x0c, 0x3f, 0xe8, 0x33, 0x41, 0x54, 0x27, 0x1f, 0x7e, 0xb5, 0xf6, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x12, 0x3f, 0x26, 0x8c, 0x05, 0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0x96, 0x3f, 0x24, 0x1d, 0x48, 0xf8, 0xb3, 0xa9, 0x01, 0x56, 0xd8, 0xe2, 0x46, 0x0d,
+	0x16, 0xd9, 0xb5, 0x10, 0x89, 0x36, 0x26, 0x08, 0x54, 0x0f, 0x86, 0x26, 0xa4, 0x68, 0x4c, 0xbc,
+	0x90, 0xe9, 0xee, 0xb8, 0x9d, 0xd8, 0xdd, 0xd9, 0xcc, 0x4c, 0x79, 0x49, 0x3c, 0x18, 0x13, 0x2f,
+	0x1c, 0x8c, 0x1f, 0xc3, 0x23, 0x07, 0xbe, 0x82, 0x09, 0x47, 0x42, 0x3c, 0x18, 0x13, 0x89, 0x81,
+	0x03, 0xdf, 0xc0, 0xb3, 0xd9, 0x97, 0x6e, 0x4b, 0x77, 0xb7, 0x40, 0xbd, 0x18, 0x2f, 0x6d, 0xf7,
+	0x79, 0x7e, 0xcf, 0xcb, 0xef, 0xb7, 0xf3, 0x3c, 0x53, 0x30, 0x89, 0xed, 0xd7, 0x88, 0x22, 0x5b,
+	0x47, 0x9a, 0x4e, 0xea, 0x75, 0xc8, 0x11, 0x85, 0x75, 0x8d, 0x6f, 0xab, 0x0e, 0x25, 0x9c, 0x88,
+	0xd9, 0xd0, 0xad, 0xb6, 0xdc, 0xd2, 0x08, 0xb4, 0xb0, 0x4d, 0x34, 0xef, 0xd3, 0x07, 0x4a, 0xa3,
+	0x3a, 0x61, 0x16, 0x61, 0x9a, 0xc5, 0x4c, 0x6d, 0xb3, 0xe0, 0x7e, 0x05, 0x8e, 0x71, 0xdf, 0xb1,
+	0xe1, 0x3d, 0x69, 0xfe, 0x43, 0xe0, 0xca, 0x9a, 0xc4, 0x24, 0xbe, 0xdd, 0xfd, 0x15, 0x58, 0x6f,
+	0xc4, 0x76, 0xe4, 0x40, 0x0a, 0xad, 0x66, 0xa0, 0x1c, 0x14, 0xab, 0x42, 0x86, 0xb4, 0xcd, 0x42,
+	0x15, 0x71, 0x58, 0xd0, 0x74, 0x82, 0x6d, 0xdf, 0xaf, 0x7c, 0x11, 0xc0, 0x70, 0x99, 0x99, 0x2f,
+	0x1c, 0x03, 0x72, 0xb4, 0xe6, 0x45, 0x8a, 0x0b, 0x20, 0x03, 0x1b, 0xbc, 0x46, 0x28, 0xe6, 0x3b,
+	0x63, 0xc2, 0x94, 0x30, 0x9d, 0x59, 0x1e, 0x3b, 0xda, 0x9f, 0xcd, 0x06, 0x1d, 0x2d, 0x19, 0x06,
+	0x45, 0x8c, 0xad, 0x73, 0x8a, 0x6d, 0xb3, 0xd2, 0x82, 0x8a, 0x8b, 0x60, 0xc0, 0xaf, 0x3d, 0x96,
+	0x9e, 0x12, 0xa6, 0x07, 0xe7, 0x26, 0xd4, 0x38, 0x49, 0x54, 0xbf, 0xca, 0x72, 0xe6, 0xe0, 0x38,
+	0x97, 0xfa, 0x7c, 0xb6, 0x97, 0x17, 0x2a, 0x41, 0x58, 0xf1, 0xe1, 0xfb, 0xb3, 0xbd, 0x7c, 0x2b,
+	0xe1, 0xee, 0xd9, 0x5e, 0xfe, 0x76, 0x8b, 0xe2, 0x76, 0x3b, 0xc9, 0x8e, 0x9e, 0x95, 0x71, 0x30,
+	0xda, 0x61, 0xaa, 0x20, 0xe6, 0x10, 0x9b, 0x21, 0xe5, 0x48, 0x00, 0xd9, 0x32, 0x33, 0x4b, 0xc8,
+	0x21, 0x0c, 0xf3, 0x95, 0x30, 0x87, 0x58, 0x04, 0x83, 0x0e, 0xa4, 0x1c, 0xeb, 0xd8, 0x81, 0x36,
+	0xbf, 0x90, 0x69, 0x3b, 0x58, 0x7c, 0x04, 0x06, 0xa0, 0x45, 0x1a, 0x36, 0x0f, 0xb8, 0x8e, 0xab,
+	0x41, 0x8c, 0x2b, 0xb4, 0x1a, 0x08, 0xad, 0xae, 0x10, 0x6c, 0x9f, 0x23, 0xea, 0xc7, 0x14, 0x1f,
+	0xbb, 0x44, 0xdb, 0xf3, 0xb9, 0x54, 0x67, 0x92, 0xa9, 0x46, 0x7a, 0x57, 0x64, 0x30, 0x11, 0x67,
+	0x0f, 0x49, 0x7f, 0x15, 0xc0, 0xb5, 0x32, 0x33, 0x5f, 0x62, 0x5e, 0x33, 0x28, 0xdc, 0xfa, 0x2b,
+	0x58, 0x2f, 0xc5, 0xb1, 0xbe, 0x9b, 0xcc, 0x3a, 0xda, 0xbc, 0xf2, 0x0c, 0x4c, 0xc6, 0x3a, 0x9a,
+	0xbc, 0xc5, 0x3b, 0xe0, 0x7f, 0x9d, 0x58, 0x4e, 0x1d, 0x71, 0x4c, 0xec, 0x0d, 0xe4, 0x10, 0xbd,
+	0xe6, 0x51, 0xfc, 0xaf, 0x32, 0xdc, 0xb2, 0x3f, 0x71, 0xcd, 0xca, 0x6e, 0xda, 0x93, 0xa8, 0x84,
+	0xea, 0xc8, 0x84, 0x1c, 0xb5, 0x49, 0xb4, 0x00, 0x32, 0x86, 0x6f, 0x25, 0xf4, 0xe2, 0x01, 0x08,
+	0xa1, 0x9d, 0xd2, 0xa6, 0x7b, 0x93, 0xb6, 0xaf, 0x07, 0x69, 0x17, 0xbd, 0xc9, 0x09, 0x3b, 0xb9,
+	0x40, 0xd8, 0x28, 0x65, 0x25, 0xe7, 0x09, 0x1b, 0x75, 0x84, 0x07, 0xea, 0x63, 0xda, 0x9f, 0x30,
+	0xdb, 0xf8, 0x17, 0xf4, 0x5a, 0x8a, 0xea, 0xa5, 0x76, 0xd9, 0x34, 0x31, 0xa4, 0x95, 0x55, 0x90,
+	0x4b, 0x70, 0xf5, 0x72, 0x18, 0x7f, 0x09, 0x40, 0x2a, 0x33, 0x73, 0x1d, 0xf1, 0x52, 0xb3, 0xa9,
+	0x0a, 0xda, 0x82, 0xd4, 0x58, 0xaf, 0x41, 0x8a, 0xfe, 0x68, 0x68, 0x9f, 0x83, 0x21, 0xea, 0xa5,
+	0xda, 0x60, 0x6e, 0xae, 0x40, 0xe6, 0x82, 0x2b, 0xca, 0xf7, 0xe3, 0xdc, 0x75, 0x3f, 0x01, 0x33,
+	0xde, 0xa8, 0x98, 0x68, 0x16, 0xe4, 0x35, 0x75, 0x15, 0x99, 0x50, 0xdf, 0x29, 0x21, 0xfd, 0x68,
+	0x7f, 0x16, 0x04, 0xf9, 0x4b, 0x48, 0xaf, 0x0c, 0xd2, 0x56, 0x47, 0xc5, 0xa7, 0x71, 0xc3, 0x5c,
+	0x48, 0xd6, 0x30, 0x81, 0x99, 0x72, 0x13, 0x28, 0xc9, 0xde, 0xa6, 0x92, 0x73, 0x3f, 0xfa, 0x41,
+	0x5f, 0x99, 0x99, 0xa2, 0x01, 0x86, 0xce, 0x5d, 0x55, 0xb7, 0xe2, 0xaf, 0x98, 0x8e, 0xab, 0x40,
+	0x9a, 0xbd, 0x14, 0x2c, 0x7c, 0x6f, 0x0c, 0x8c, 0x44, 0x6f, 0x8b, 0x7c, 0x62, 0x8e, 0x08, 0x56,
+	0x9a, 0xbb, 0x3c, 0x36, 0x2c, 0xba, 0x09, 0xc4, 0x98, 0x6d, 0x3d, 0x93, 0x98, 0x29, 0x0a, 0x96,
+	0xe6, 0xaf, 0x00, 0x6e, 0xaf, 0x1b, 0xb3, 0x02, 0x67, 0xba, 0x30, 0xe8, 0x04, 0x77, 0xa9, 0x9b,
+	0xbc, 0x50, 0xc4, 0xb7, 0x20, 0x1b, 0xbb, 0x4c, 0xba, 0xbc, 0xab, 0x18, 0xb8, 0x74, 0xff, 0x4a,
+	0xf0, 0xb0, 0xfa, 0x07, 0x01, 0x8c, 0x26, 0x0d, 0xdb, 0xbd, 0xc4, 0x94, 0x09, 0x11, 0xd2, 0x83,
+	0xab, 0x46, 0x34, 0xfb, 0x90, 0xfa, 0xdf, 0xb9, 0x7b, 0x69, 0x79, 0xed, 0xe0, 0x44, 0x16, 0x0e,
+	0x4f, 0x64, 0xe1, 0xe7, 0x89, 0x2c, 0x7c, 0x3a, 0x95, 0x53, 0x87, 0xa7, 0x72, 0xea, 0xdb, 0xa9,
+	0x9c, 0x7a, 0xb5, 0x60, 0x62, 0x5e, 0x6b, 0x54, 0x55, 0x9d, 0x58, 0x9a, 0x43, 0x89, 0xd1, 0xd0,
+	0x39, 0xd3, 0xb1, 0x37, 0x62, 0x09, 0xc3, 0xc6, 0x77, 0x1c, 0xc4, 0xaa, 0x03, 0xde, 0xff, 0xbb,
+	0xf9, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x23, 0x72, 0x02, 0xd0, 0xb6, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -391,6 +712,12 @@ type MsgClient interface {
 	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	// DelegateCollateral deposits collateral on behalf of a participant
+	DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral initiates withdrawal of delegated collateral (subject to unbonding period)
+	UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error)
+	// SetDelegatorRewardShare sets the part of the participant's rewards paid to its delegators
+	SetDelegatorRewardShare(ctx context.Context, in *MsgSetDelegatorRewardShare, opts ...grpc.CallOption) (*MsgSetDelegatorRewardShareResponse, error)
 }
 
 type msgClient struct {
@@ -428,6 +755,33 @@ func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawColla
 	return out, nil
 }
 
+func (c *msgClient) DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error) {
+	out := new(MsgDelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/DelegateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error) {
+	out := new(MsgUndelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/UndelegateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDelegatorRewardShare(ctx context.Context, in *MsgSetDelegatorRewardShare, opts ...grpc.CallOption) (*MsgSetDelegatorRewardShareResponse, error) {
+	out := new(MsgSetDelegatorRewardShareResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/SetDelegatorRewardShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -437,6 +791,12 @@ type MsgServer interface {
 	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	// DelegateCollateral deposits collateral on behalf of a participant
+	DelegateCollateral(context.Context, *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral initiates withdrawal of delegated collateral (subject to unbonding period)
+	UndelegateCollateral(context.Context, *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error)
+	// SetDelegatorRewardShare sets the part of the participant's rewards paid to its delegators
+	SetDelegatorRewardShare(context.Context, *MsgSetDelegatorRewardShare) (*MsgSetDelegatorRewardShareResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -452,6 +812,15 @@ func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDe
 func (*UnimplementedMsgServer) WithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
 }
+func (*UnimplementedMsgServer) DelegateCollateral(ctx context.Context, req *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateCollateral not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateCollateral(ctx context.Context, req *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateCollateral not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegatorRewardShare(ctx context.Context, req *MsgSetDelegatorRewardShare) (*MsgSetDelegatorRewardShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegatorRewardShare not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -511,6 +880,60 @@ func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/DelegateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateCollateral(ctx, req.(*MsgDelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/UndelegateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateCollateral(ctx, req.(*MsgUndelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegatorRewardShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegatorRewardShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegatorRewardShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/SetDelegatorRewardShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegatorRewardShare(ctx, req.(*MsgSetDelegatorRewardShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.collateral.Msg",
@@ -528,6 +951,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCollateral",
 			Handler:    _Msg_WithdrawCollateral_Handler,
 		},
+		{
+			MethodName: "DelegateCollateral",
+			Handler:    _Msg_DelegateCollateral_Handler,
+		},
+		{
+			MethodName: "UndelegateCollateral",
+			Handler:    _Msg_UndelegateCollateral_Handler,
+		},
+		{
+			MethodName: "SetDelegatorRewardShare",
+			Handler:    _Msg_SetDelegatorRewardShare_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/tx.proto",
@@ -727,16 +1162,224 @@ func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDelegateCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegatorRewardShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegatorRewardShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegatorRewardShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardShare.Size()
+		i -= size
+		if _, err := m.RewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegatorRewardShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegatorRewardShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegatorRewardShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
@@ -772,54 +1415,651 @@ func (m *MsgDepositCollateral) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	}
+	return n
+}
+
+func (m *MsgDelegateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	}
+	return n
+}
+
+func (m *MsgSetDelegatorRewardShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RewardShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDelegatorRewardShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgDepositCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgWithdrawCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+			}
+			m.CompletionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgWithdrawCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CompletionEpoch != 0 {
-		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,15 +2082,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,11 +2118,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -909,7 +2181,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -934,7 +2206,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -957,10 +2229,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -984,7 +2256,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,13 +2279,45 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
@@ -1045,7 +2349,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			}
 			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1099,7 +2403,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,12 +2426,31 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+			}
+			m.CompletionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1149,7 +2472,7 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDelegatorRewardShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,10 +2495,10 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDelegatorRewardShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDelegatorRewardShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1212,9 +2535,9 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1224,22 +2547,23 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1264,7 +2588,7 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDelegatorRewardShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,31 +2611,12 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDelegatorRewardShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDelegatorRewardShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
-			}
-			m.CompletionEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompletionEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
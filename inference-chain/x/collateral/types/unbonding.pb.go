@@ -32,6 +32,8 @@ type UnbondingCollateral struct {
 	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 	// completion_epoch is the epoch when the funds will be released
 	CompletionEpoch uint64 `protobuf:"varint,3,opt,name=completion_epoch,json=completionEpoch,proto3" json:"completion_epoch,omitempty"`
+	// delegator is set when delegated collateral is being withdrawn, the funds are released to it
+	Delegator string `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
 func (m *UnbondingCollateral) Reset()         { *m = UnbondingCollateral{} }
@@ -88,6 +90,13 @@ func (m *UnbondingCollateral) GetCompletionEpoch() uint64 {
 	return 0
 }
 
+func (m *UnbondingCollateral) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*UnbondingCollateral)(nil), "inference.collateral.UnbondingCollateral")
 }
@@ -97,25 +106,26 @@ func init() {
 }
 
 var fileDescriptor_38f0f782a7bd039b = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0xff, 0xaf, 0x2a, 0x91, 0x0e, 0xa0, 0xd0, 0x21, 0x74, 0x30, 0x11, 0x62, 0x08,
-	0x8b, 0xad, 0x82, 0x04, 0x7b, 0x2b, 0x76, 0x14, 0x89, 0x85, 0x05, 0x39, 0xae, 0x49, 0x2d, 0x25,
-	0xbe, 0x56, 0x7c, 0x83, 0xe0, 0x2d, 0xd8, 0x79, 0xa1, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x5e, 0x04,
-	0x25, 0x29, 0x4d, 0x37, 0xeb, 0xf8, 0xd3, 0x39, 0xba, 0x9f, 0x7f, 0xa9, 0xcd, 0x8b, 0x2a, 0x95,
-	0x91, 0x8a, 0x4b, 0xc8, 0x73, 0x81, 0xaa, 0x14, 0x39, 0xaf, 0x4c, 0x0a, 0x66, 0xa5, 0x4d, 0xc6,
-	0x6c, 0x09, 0x08, 0xc1, 0x74, 0x4f, 0xb1, 0x81, 0x9a, 0x4d, 0x33, 0xc8, 0xa0, 0x03, 0x78, 0xfb,
-	0xea, 0xd9, 0x19, 0x95, 0xe0, 0x0a, 0x70, 0x3c, 0x15, 0x4e, 0xf1, 0xd7, 0x79, 0xaa, 0x50, 0xcc,
-	0xb9, 0x04, 0x6d, 0xfa, 0xff, 0x8b, 0x4f, 0xe2, 0x9f, 0x3e, 0xfe, 0xf5, 0x2f, 0xf7, 0x6d, 0x41,
-	0xe4, 0x4f, 0xac, 0x28, 0x51, 0x4b, 0x6d, 0x85, 0xc1, 0x90, 0x44, 0x24, 0x3e, 0x4a, 0x0e, 0xa3,
-	0xe0, 0xce, 0x1f, 0x8b, 0x02, 0x2a, 0x83, 0xe1, 0xbf, 0x88, 0xc4, 0x93, 0xeb, 0x33, 0xd6, 0x4f,
-	0xb1, 0x76, 0x8a, 0xed, 0xa6, 0xd8, 0x12, 0xb4, 0x59, 0x8c, 0x36, 0xdf, 0xe7, 0x5e, 0xb2, 0xc3,
-	0x83, 0x2b, 0xff, 0x44, 0x42, 0x61, 0x73, 0x85, 0x1a, 0xcc, 0xb3, 0xb2, 0x20, 0xd7, 0xe1, 0xff,
-	0x88, 0xc4, 0xa3, 0xe4, 0x78, 0xc8, 0xef, 0xdb, 0x78, 0xf1, 0xb0, 0xa9, 0x29, 0xd9, 0xd6, 0x94,
-	0xfc, 0xd4, 0x94, 0x7c, 0x34, 0xd4, 0xdb, 0x36, 0xd4, 0xfb, 0x6a, 0xa8, 0xf7, 0x74, 0x9b, 0x69,
-	0x5c, 0x57, 0x29, 0x93, 0x50, 0x70, 0x5b, 0xc2, 0xaa, 0x92, 0xe8, 0xa4, 0xee, 0xcc, 0x0d, 0x0e,
-	0xdf, 0x0e, 0x2d, 0xe2, 0xbb, 0x55, 0x2e, 0x1d, 0x77, 0x67, 0xdf, 0xfc, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0x4a, 0x64, 0x0c, 0x6a, 0x01, 0x00, 0x00,
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4b, 0xf3, 0x30,
+	0x1c, 0xc6, 0x9b, 0xf7, 0x1d, 0x83, 0x65, 0x07, 0xa5, 0xee, 0x50, 0x87, 0xc4, 0x22, 0x1e, 0xea,
+	0x25, 0x61, 0x0a, 0x7a, 0xdf, 0xf0, 0x2e, 0x05, 0x2f, 0x5e, 0x24, 0x4d, 0x63, 0x17, 0x68, 0xf3,
+	0x0f, 0x69, 0x2a, 0xfa, 0x2d, 0xfc, 0x3a, 0x7e, 0x83, 0x1d, 0x77, 0xf4, 0x24, 0xd2, 0x7e, 0x11,
+	0x69, 0x3b, 0xd7, 0xdd, 0xc2, 0x93, 0x1f, 0xcf, 0xf3, 0xe7, 0x87, 0x2f, 0x95, 0x7e, 0x91, 0x56,
+	0x6a, 0x21, 0x99, 0x80, 0x3c, 0xe7, 0x4e, 0x5a, 0x9e, 0xb3, 0x4a, 0x27, 0xa0, 0x53, 0xa5, 0x33,
+	0x6a, 0x2c, 0x38, 0xf0, 0x67, 0x7b, 0x8a, 0x0e, 0xd4, 0x7c, 0x96, 0x41, 0x06, 0x1d, 0xc0, 0xda,
+	0x57, 0xcf, 0xce, 0x89, 0x80, 0xb2, 0x80, 0x92, 0x25, 0xbc, 0x94, 0xec, 0x75, 0x91, 0x48, 0xc7,
+	0x17, 0x4c, 0x80, 0xd2, 0xfd, 0xff, 0xc5, 0x27, 0xc2, 0x27, 0x8f, 0x7f, 0xfd, 0xab, 0x7d, 0x9b,
+	0x1f, 0xe2, 0xa9, 0xe1, 0xd6, 0x29, 0xa1, 0x0c, 0xd7, 0x2e, 0x40, 0x21, 0x8a, 0x26, 0xf1, 0x61,
+	0xe4, 0xdf, 0xe1, 0x31, 0x2f, 0xa0, 0xd2, 0x2e, 0xf8, 0x17, 0xa2, 0x68, 0x7a, 0x7d, 0x4a, 0xfb,
+	0x29, 0xda, 0x4e, 0xd1, 0xdd, 0x14, 0x5d, 0x81, 0xd2, 0xcb, 0xd1, 0xe6, 0xfb, 0xdc, 0x8b, 0x77,
+	0xb8, 0x7f, 0x85, 0x8f, 0x05, 0x14, 0x26, 0x97, 0x4e, 0x81, 0x7e, 0x96, 0x06, 0xc4, 0x3a, 0xf8,
+	0x1f, 0xa2, 0x68, 0x14, 0x1f, 0x0d, 0xf9, 0x7d, 0x1b, 0xfb, 0x67, 0x78, 0x92, 0xca, 0x5c, 0x66,
+	0xdc, 0x81, 0x0d, 0x46, 0xdd, 0x0d, 0x43, 0xb0, 0x7c, 0xd8, 0xd4, 0x04, 0x6d, 0x6b, 0x82, 0x7e,
+	0x6a, 0x82, 0x3e, 0x1a, 0xe2, 0x6d, 0x1b, 0xe2, 0x7d, 0x35, 0xc4, 0x7b, 0xba, 0xcd, 0x94, 0x5b,
+	0x57, 0x09, 0x15, 0x50, 0x30, 0x63, 0x21, 0xad, 0x84, 0x2b, 0x85, 0xea, 0xbc, 0x0e, 0x86, 0xdf,
+	0x0e, 0x1d, 0xbb, 0x77, 0x23, 0xcb, 0x64, 0xdc, 0x49, 0xb9, 0xf9, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0x9e, 0x93, 0xe0, 0xee, 0x88, 0x01, 0x00, 0x00,
 }
 
 func (m *UnbondingCollateral) Marshal() (dAtA []byte, err error) {
@@ -138,6 +148,13 @@ func (m *UnbondingCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.CompletionEpoch != 0 {
 		i = encodeVarintUnbonding(dAtA, i, uint64(m.CompletionEpoch))
 		i--
@@ -189,6 +206,10 @@ func (m *UnbondingCollateral) Size() (n int) {
 	if m.CompletionEpoch != 0 {
 		n += 1 + sovUnbonding(uint64(m.CompletionEpoch))
 	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
 	return n
 }
 
@@ -311,6 +332,38 @@ func (m *UnbondingCollateral) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUnbonding(dAtA[iNdEx:])
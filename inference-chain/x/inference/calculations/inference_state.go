@@ -1,6 +1,8 @@
 package calculations
 
 import (
+	"slices"
+
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -32,12 +34,20 @@ func ProcessStartInference(
 	if currentInference == nil {
 		return nil, nil, sdkerrors.Wrap(types.ErrInferenceNotFound, startMessage.InferenceId)
 	}
-	if currentInference.InferenceId != "" && !finishedProcessed(currentInference) {
+	abandoned := currentInference.Status == types.InferenceStatus_ABANDONED && !finishedProcessed(currentInference)
+	if currentInference.InferenceId != "" && !finishedProcessed(currentInference) && !abandoned {
 		// We already have an inference with this ID (but it wasn't created by FinishInference)
 		return nil, nil, sdkerrors.Wrap(types.ErrInferenceIdExists, currentInference.InferenceId)
 	}
+	if finishedProcessed(currentInference) && currentInference.EscrowAmount != 0 {
+		// Already paid for, either started before or finished over an abandon
+		return nil, nil, sdkerrors.Wrap(types.ErrInferenceIdExists, currentInference.InferenceId)
+	}
+	if abandoned && slices.Contains(currentInference.AbandonedExecutors, startMessage.AssignedTo) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInferenceAbandoned, "executor %s already abandoned inference %s", startMessage.AssignedTo, currentInference.InferenceId)
+	}
 	payments := &Payments{}
-	if currentInference.InferenceId == "" {
+	if currentInference.InferenceId == "" || abandoned {
 		logger.LogInfo(
 			"New Inference started",
 			types.Inferences,
@@ -55,10 +65,11 @@ func ProcessStartInference(
 		// Preserve the PerTokenPrice that was set by RecordInferencePrice
 		existingPerTokenPrice := currentInference.PerTokenPrice
 		currentInference = &types.Inference{
-			Index:         startMessage.InferenceId,
-			InferenceId:   startMessage.InferenceId,
-			Status:        types.InferenceStatus_STARTED,
-			PerTokenPrice: existingPerTokenPrice,
+			Index:              startMessage.InferenceId,
+			InferenceId:        startMessage.InferenceId,
+			Status:             types.InferenceStatus_STARTED,
+			PerTokenPrice:      existingPerTokenPrice,
+			AbandonedExecutors: currentInference.AbandonedExecutors,
 		}
 	}
 	// Works if FinishInference came before
@@ -68,6 +79,7 @@ func ProcessStartInference(
 	currentInference.PromptHash = startMessage.PromptHash
 	currentInference.PromptPayload = startMessage.PromptPayload
 	currentInference.OriginalPrompt = startMessage.OriginalPrompt
+	currentInference.OriginalPromptHash = startMessage.OriginalPromptHash
 	if currentInference.PromptTokenCount == 0 {
 		currentInference.PromptTokenCount = startMessage.PromptTokenCount
 	}
@@ -78,6 +90,8 @@ func ProcessStartInference(
 	currentInference.MaxTokens = getMaxTokens(startMessage)
 	currentInference.AssignedTo = startMessage.AssignedTo
 	currentInference.NodeVersion = startMessage.NodeVersion
+	currentInference.Kind = startMessage.Kind
+	currentInference.SubKey = startMessage.SubKey
 
 	if currentInference.EscrowAmount == 0 {
 		if startMessage.PromptTokenCount == 0 {
@@ -145,16 +159,20 @@ func ProcessFinishInference(
 	currentInference.TransferSignature = finishMessage.TransferSignature
 	currentInference.ExecutionSignature = finishMessage.ExecutorSignature
 	currentInference.OriginalPrompt = finishMessage.OriginalPrompt
+	currentInference.OriginalPromptHash = finishMessage.OriginalPromptHash
+	currentInference.ResponsePayloadHash = finishMessage.ResponsePayloadHash
+	currentInference.PayloadLocator = finishMessage.PayloadLocator
 
 	currentInference.CompletionTokenCount = finishMessage.CompletionTokenCount
 	currentInference.ExecutedBy = finishMessage.ExecutedBy
+	// Kind is only taken from StartInference, the executor must not pick how its work gets validated
 	currentInference.EndBlockHeight = blockContext.BlockHeight
 	currentInference.EndBlockTimestamp = blockContext.BlockTimestamp
 
 	if currentInference.PromptTokenCount == 0 {
 		logger.LogWarn("PromptTokens is 0 when FinishInference is called!", types.Inferences, "inferenceId", currentInference.InferenceId)
 	}
-	if currentInference.CompletionTokenCount == 0 {
+	if currentInference.CompletionTokenCount == 0 && currentInference.Kind != types.InferenceKind_EMBEDDING {
 		logger.LogWarn("CompletionTokens is 0 when FinishInference is called!", types.Inferences, "inferenceId", currentInference.InferenceId)
 	}
 	currentInference.ActualCost = CalculateCost(currentInference)
@@ -180,6 +198,10 @@ func finishedProcessed(inference *types.Inference) bool {
 }
 
 func getMaxTokens(msg *types.MsgStartInference) uint64 {
+	// Embeddings produce no completion tokens, so only the prompt is escrowed
+	if msg.Kind == types.InferenceKind_EMBEDDING {
+		return 0
+	}
 	if msg.MaxTokens > 0 {
 		return msg.MaxTokens
 	}
@@ -93,6 +93,14 @@ func TestGetMaxTokens(t *testing.T) {
 			},
 			expected: 1000,
 		},
+		{
+			name: "Embedding message ignores MaxTokens",
+			msg: &types.MsgStartInference{
+				MaxTokens: 1000,
+				Kind:      types.InferenceKind_EMBEDDING,
+			},
+			expected: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -367,10 +375,111 @@ func TestProcessStartInference(t *testing.T) {
 			assert.Equal(t, tt.blockContext.BlockTimestamp, inference.StartBlockTimestamp)
 			assert.Equal(t, tt.startMessage.AssignedTo, inference.AssignedTo)
 			assert.Equal(t, tt.startMessage.NodeVersion, inference.NodeVersion)
+			assert.Equal(t, tt.startMessage.Kind, inference.Kind)
 		})
 	}
 }
 
+func TestProcessStartInferenceEmbeddingEscrowsPromptOnly(t *testing.T) {
+	inference, payments, err := ProcessStartInference(
+		&types.Inference{PerTokenPrice: 10},
+		&types.MsgStartInference{
+			InferenceId:      "test-id",
+			PromptHash:       "hash",
+			PromptTokenCount: 50,
+			MaxTokens:        100,
+			Kind:             types.InferenceKind_EMBEDDING,
+		},
+		BlockContext{BlockHeight: 100},
+		&MockInferenceLogger{},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, types.InferenceKind_EMBEDDING, inference.Kind)
+	assert.Equal(t, uint64(0), inference.MaxTokens)
+	assert.Equal(t, int64(500), payments.EscrowAmount)
+}
+
+func TestProcessFinishInferenceKeepsStartKind(t *testing.T) {
+	started, _, err := ProcessStartInference(
+		&types.Inference{PerTokenPrice: 10},
+		&types.MsgStartInference{
+			InferenceId:      "test-id",
+			PromptHash:       "hash",
+			PromptTokenCount: 50,
+			Kind:             types.InferenceKind_CHAT_COMPLETION,
+		},
+		BlockContext{BlockHeight: 100},
+		&MockInferenceLogger{},
+	)
+	assert.NoError(t, err)
+
+	inference, _ := ProcessFinishInference(
+		started,
+		&types.MsgFinishInference{
+			InferenceId:          "test-id",
+			ExecutedBy:           "executor",
+			PromptTokenCount:     50,
+			CompletionTokenCount: 10,
+			Kind:                 types.InferenceKind_EMBEDDING,
+		},
+		BlockContext{BlockHeight: 101},
+		&MockInferenceLogger{},
+	)
+	assert.Equal(t, types.InferenceKind_CHAT_COMPLETION, inference.Kind)
+}
+
+func TestProcessStartInferenceRejectsPaidInference(t *testing.T) {
+	_, _, err := ProcessStartInference(
+		&types.Inference{
+			InferenceId:  "test-id",
+			Status:       types.InferenceStatus_FINISHED,
+			ExecutedBy:   "executor-1",
+			EscrowAmount: 300,
+		},
+		&types.MsgStartInference{InferenceId: "test-id", PromptHash: "hash", AssignedTo: "executor-2"},
+		BlockContext{BlockHeight: 100},
+		&MockInferenceLogger{},
+	)
+	assert.ErrorIs(t, err, types.ErrInferenceIdExists)
+}
+
+func TestProcessStartInferenceAfterAbandon(t *testing.T) {
+	abandoned := &types.Inference{
+		Index:              "test-id",
+		InferenceId:        "test-id",
+		Status:             types.InferenceStatus_ABANDONED,
+		PerTokenPrice:      10,
+		AbandonedExecutors: []string{"executor-1"},
+	}
+
+	_, _, err := ProcessStartInference(
+		abandoned,
+		&types.MsgStartInference{InferenceId: "test-id", PromptHash: "hash", AssignedTo: "executor-1"},
+		BlockContext{BlockHeight: 100},
+		&MockInferenceLogger{},
+	)
+	assert.ErrorIs(t, err, types.ErrInferenceAbandoned)
+
+	inference, payments, err := ProcessStartInference(
+		abandoned,
+		&types.MsgStartInference{
+			InferenceId:      "test-id",
+			PromptHash:       "hash",
+			PromptTokenCount: 10,
+			MaxTokens:        10,
+			AssignedTo:       "executor-2",
+		},
+		BlockContext{BlockHeight: 100},
+		&MockInferenceLogger{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, types.InferenceStatus_STARTED, inference.Status)
+	assert.Equal(t, "executor-2", inference.AssignedTo)
+	assert.Equal(t, []string{"executor-1"}, inference.AbandonedExecutors)
+	assert.Equal(t, int64(200), payments.EscrowAmount)
+}
+
 func TestProcessFinishInference(t *testing.T) {
 	mockLogger := &MockInferenceLogger{}
 
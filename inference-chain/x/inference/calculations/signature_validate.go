@@ -35,18 +35,24 @@ type SignatureData struct {
 	Dev               *types.Participant `json:"dev"`
 	TransferAgent     *types.Participant `json:"transfer_agent"`
 	Executor          *types.Participant `json:"executor"`
+	// DevPubKey is the developer sub-key that signed the request, used instead of the account key
+	DevPubKey string `json:"dev_pub_key"`
 }
 
 // VerifyKeys verifies signatures for each non-null participant in SignatureData
 func VerifyKeys(ctx context.Context, components SignatureComponents, sigData SignatureData, pubKeyGetter PubKeyGetter) error {
 	// Check developer signature if developer participant is provided
 	if sigData.Dev != nil && sigData.DevSignature != "" {
-		devKey, err := pubKeyGetter.GetAccountPubKey(ctx, sigData.Dev.Address)
-		if err != nil {
-			return sdkerrors.Wrap(types.ErrParticipantNotFound, sigData.Dev.Address)
+		devKey := sigData.DevPubKey
+		if devKey == "" {
+			accountKey, err := pubKeyGetter.GetAccountPubKey(ctx, sigData.Dev.Address)
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrParticipantNotFound, sigData.Dev.Address)
+			}
+			devKey = accountKey
 		}
 
-		err = ValidateSignature(components, Developer, devKey, sigData.DevSignature)
+		err := ValidateSignature(components, Developer, devKey, sigData.DevSignature)
 		if err != nil {
 			return sdkerrors.Wrap(types.ErrInvalidSignature, "dev signature validation failed")
 		}
@@ -18,17 +18,52 @@ func (eg *EpochGroup) GetRandomMemberForModel(
 	modelId string,
 	filterFn func([]*group.GroupMember) []*group.GroupMember,
 ) (*types.Participant, error) {
-	// If modelId is provided and this is the parent group, delegate to the sub-group
+	modelGroup, err := eg.groupForModel(goCtx, modelId)
+	if err != nil {
+		return nil, err
+	}
+	return modelGroup.GetRandomMember(goCtx, filterFn)
+}
+
+// GetCandidatesForModel returns the members a random member for the model is selected from, with their weights
+func (eg *EpochGroup) GetCandidatesForModel(
+	goCtx context.Context,
+	modelId string,
+	filterFn func([]*group.GroupMember) []*group.GroupMember,
+) ([]types.ExecutorCandidate, error) {
+	modelGroup, err := eg.groupForModel(goCtx, modelId)
+	if err != nil {
+		return nil, err
+	}
+	members, err := modelGroup.getFilteredMembers(goCtx, filterFn)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]types.ExecutorCandidate, 0, len(members))
+	for _, member := range members {
+		participant, ok := eg.ParticipantKeeper.GetParticipant(goCtx, member.Member.Address)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, types.ExecutorCandidate{
+			Executor: participant,
+			Weight:   getWeight(member),
+		})
+	}
+	return candidates, nil
+}
+
+// groupForModel delegates to the sub-group of the model if modelId is provided and this is the parent group
+func (eg *EpochGroup) groupForModel(goCtx context.Context, modelId string) (*EpochGroup, error) {
 	if modelId != "" && eg.GroupData.GetModelId() == "" {
 		subGroup, err := eg.GetSubGroup(goCtx, modelId)
 		if err != nil {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("Error getting sub-group for model %s: %v", modelId, err))
 		}
-		return subGroup.GetRandomMember(goCtx, filterFn)
+		return subGroup, nil
 	}
-
-	// Otherwise, get a random member from this group
-	return eg.GetRandomMember(goCtx, filterFn)
+	return eg, nil
 }
 
 func (eg *EpochGroup) GetRandomMember(
@@ -39,18 +74,9 @@ func (eg *EpochGroup) GetRandomMember(
 	// This allows the method to work with both SDK contexts and regular contexts
 	ctx := goCtx
 
-	groupMemberResponse, err := eg.GroupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: uint64(eg.GroupData.EpochGroupId)})
+	filteredParticipants, err := eg.getFilteredMembers(ctx, filterFn)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	activeParticipants := groupMemberResponse.GetMembers()
-	if len(activeParticipants) == 0 {
-		return nil, status.Error(codes.Internal, "Active participants found, but length is 0")
-	}
-
-	filteredParticipants := filterFn(activeParticipants)
-	if len(filteredParticipants) == 0 {
-		return nil, status.Error(codes.Internal, "After filtering participants the length is 0")
+		return nil, err
 	}
 
 	participantIndex := selectRandomParticipant(filteredParticipants)
@@ -65,6 +91,26 @@ func (eg *EpochGroup) GetRandomMember(
 	return &participant, nil
 }
 
+func (eg *EpochGroup) getFilteredMembers(
+	ctx context.Context,
+	filterFn func([]*group.GroupMember) []*group.GroupMember,
+) ([]*group.GroupMember, error) {
+	groupMemberResponse, err := eg.GroupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: uint64(eg.GroupData.EpochGroupId)})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	activeParticipants := groupMemberResponse.GetMembers()
+	if len(activeParticipants) == 0 {
+		return nil, status.Error(codes.Internal, "Active participants found, but length is 0")
+	}
+
+	filteredParticipants := filterFn(activeParticipants)
+	if len(filteredParticipants) == 0 {
+		return nil, status.Error(codes.Internal, "After filtering participants the length is 0")
+	}
+	return filteredParticipants, nil
+}
+
 func selectRandomParticipant(participants []*group.GroupMember) string {
 	cumulativeArray := computeCumulativeArray(participants)
 
@@ -0,0 +1,81 @@
+package ethproof
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Log is an event emitted by a contract, as stored in a transaction receipt
+type Log struct {
+	Address []byte
+	Topics  [][]byte
+	Data    []byte
+}
+
+// DecodeReceipt decodes a receipt in its consensus encoding, legacy or typed (EIP-2718), and
+// returns whether the transaction succeeded along with its logs.
+func DecodeReceipt(receipt []byte) (bool, []Log, error) {
+	if len(receipt) == 0 {
+		return false, nil, errors.New("empty receipt")
+	}
+	// Typed receipts are the transaction type followed by the RLP list of a legacy receipt
+	if receipt[0] < 0x80 {
+		receipt = receipt[1:]
+	}
+
+	fields, err := splitList(receipt)
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid receipt: %w", err)
+	}
+	if len(fields) != 4 {
+		return false, nil, fmt.Errorf("invalid receipt with %d fields", len(fields))
+	}
+	status, err := bytesValue(fields[0])
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid receipt status: %w", err)
+	}
+	// Receipts before Byzantium carry a state root instead of a status, they are not accepted
+	succeeded := len(status) == 1 && status[0] == 1
+
+	encodedLogs, err := splitList(fields[3])
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid receipt logs: %w", err)
+	}
+	logs := make([]Log, 0, len(encodedLogs))
+	for _, encodedLog := range encodedLogs {
+		log, err := decodeLog(encodedLog)
+		if err != nil {
+			return false, nil, err
+		}
+		logs = append(logs, log)
+	}
+	return succeeded, logs, nil
+}
+
+func decodeLog(encodedLog []byte) (Log, error) {
+	fields, err := splitList(encodedLog)
+	if err != nil || len(fields) != 3 {
+		return Log{}, errors.New("invalid receipt log")
+	}
+	address, err := bytesValue(fields[0])
+	if err != nil || len(address) != 20 {
+		return Log{}, errors.New("invalid receipt log address")
+	}
+	encodedTopics, err := splitList(fields[1])
+	if err != nil {
+		return Log{}, fmt.Errorf("invalid receipt log topics: %w", err)
+	}
+	topics := make([][]byte, 0, len(encodedTopics))
+	for _, encodedTopic := range encodedTopics {
+		topic, err := bytesValue(encodedTopic)
+		if err != nil || len(topic) != 32 {
+			return Log{}, errors.New("invalid receipt log topic")
+		}
+		topics = append(topics, topic)
+	}
+	data, err := bytesValue(fields[2])
+	if err != nil {
+		return Log{}, fmt.Errorf("invalid receipt log data: %w", err)
+	}
+	return Log{Address: address, Topics: topics, Data: data}, nil
+}
@@ -0,0 +1,113 @@
+package ethproof
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var errUnexpectedEnd = errors.New("rlp: value size exceeds available input")
+
+// split reads the first RLP item of b. It returns whether the item is a list, its content and
+// the bytes following it.
+func split(b []byte) (isList bool, content []byte, rest []byte, err error) {
+	if len(b) == 0 {
+		return false, nil, nil, errUnexpectedEnd
+	}
+	prefix := b[0]
+	switch {
+	case prefix < 0x80:
+		return false, b[:1], b[1:], nil
+	case prefix < 0xB8:
+		return splitContent(false, b, 1, uint64(prefix-0x80))
+	case prefix < 0xC0:
+		size, err := readSize(b, int(prefix-0xB7))
+		if err != nil {
+			return false, nil, nil, err
+		}
+		return splitContent(false, b, 1+int(prefix-0xB7), size)
+	case prefix < 0xF8:
+		return splitContent(true, b, 1, uint64(prefix-0xC0))
+	default:
+		size, err := readSize(b, int(prefix-0xF7))
+		if err != nil {
+			return false, nil, nil, err
+		}
+		return splitContent(true, b, 1+int(prefix-0xF7), size)
+	}
+}
+
+func readSize(b []byte, sizeLen int) (uint64, error) {
+	if len(b) < 1+sizeLen {
+		return 0, errUnexpectedEnd
+	}
+	if b[1] == 0 {
+		return 0, errors.New("rlp: size has leading zero bytes")
+	}
+	padded := make([]byte, 8)
+	copy(padded[8-sizeLen:], b[1:1+sizeLen])
+	return binary.BigEndian.Uint64(padded), nil
+}
+
+func splitContent(isList bool, b []byte, headerLen int, size uint64) (bool, []byte, []byte, error) {
+	if size > uint64(len(b)-headerLen) {
+		return false, nil, nil, errUnexpectedEnd
+	}
+	end := headerLen + int(size)
+	return isList, b[headerLen:end], b[end:], nil
+}
+
+// splitList decodes b as a single RLP list and returns the raw encoding of each of its elements
+func splitList(b []byte) ([][]byte, error) {
+	isList, content, rest, err := split(b)
+	if err != nil {
+		return nil, err
+	}
+	if !isList {
+		return nil, errors.New("rlp: expected list")
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("rlp: %d trailing bytes after list", len(rest))
+	}
+	var elements [][]byte
+	for len(content) > 0 {
+		_, _, next, err := split(content)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, content[:len(content)-len(next)])
+		content = next
+	}
+	return elements, nil
+}
+
+// bytesValue decodes b as a single RLP string
+func bytesValue(b []byte) ([]byte, error) {
+	isList, content, rest, err := split(b)
+	if err != nil {
+		return nil, err
+	}
+	if isList {
+		return nil, errors.New("rlp: expected string, got list")
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("rlp: %d trailing bytes after string", len(rest))
+	}
+	return content, nil
+}
+
+// encodeUint returns the RLP encoding of i, it is how receipt indexes are keyed in the receipts trie
+func encodeUint(i uint64) []byte {
+	switch {
+	case i == 0:
+		return []byte{0x80}
+	case i < 0x80:
+		return []byte{byte(i)}
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, i)
+	for len(buf) > 0 && buf[0] == 0 {
+		buf = buf[1:]
+	}
+	return append([]byte{0x80 + byte(len(buf))}, buf...)
+}
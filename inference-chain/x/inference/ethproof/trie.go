@@ -0,0 +1,150 @@
+// Package ethproof verifies Ethereum receipt inclusion proofs, so bridge deposits can be proven
+// against an attested receipts root instead of being attested receipt by receipt.
+package ethproof
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// maxProofDepth bounds the walk through a proof, a receipts trie is never deeper than its key
+const maxProofDepth = 64
+
+func Keccak256(data ...[]byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		hash.Write(d)
+	}
+	return hash.Sum(nil)
+}
+
+// VerifyProof walks a Merkle-Patricia proof from root along key and returns the value stored
+// under key. proof holds the RLP encoded trie nodes, in any order; nodes shorter than 32 bytes
+// are embedded in their parent and need not be part of it.
+func VerifyProof(root []byte, key []byte, proof [][]byte) ([]byte, error) {
+	nodes := make(map[string][]byte, len(proof))
+	for _, node := range proof {
+		nodes[string(Keccak256(node))] = node
+	}
+
+	nibbles := keyToNibbles(key)
+	wantHash := root
+	var node []byte
+	for depth := 0; depth < maxProofDepth; depth++ {
+		if node == nil {
+			found, ok := nodes[string(wantHash)]
+			if !ok {
+				return nil, fmt.Errorf("proof is missing node %s", hex.EncodeToString(wantHash))
+			}
+			node = found
+		}
+
+		elements, err := splitList(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trie node: %w", err)
+		}
+
+		var child []byte
+		switch len(elements) {
+		case 17:
+			if len(nibbles) == 0 {
+				return nonEmptyValue(elements[16])
+			}
+			child = elements[nibbles[0]]
+			nibbles = nibbles[1:]
+		case 2:
+			encodedPath, err := bytesValue(elements[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid trie node path: %w", err)
+			}
+			path, isLeaf, err := compactToNibbles(encodedPath)
+			if err != nil {
+				return nil, err
+			}
+			if isLeaf {
+				if !bytes.Equal(path, nibbles) {
+					return nil, errors.New("key not found in trie")
+				}
+				return nonEmptyValue(elements[1])
+			}
+			if len(nibbles) < len(path) || !bytes.Equal(path, nibbles[:len(path)]) {
+				return nil, errors.New("key not found in trie")
+			}
+			nibbles = nibbles[len(path):]
+			child = elements[1]
+		default:
+			return nil, fmt.Errorf("invalid trie node with %d elements", len(elements))
+		}
+
+		// A child is either the hash of a node in the proof or, when shorter than 32 bytes, the node itself
+		isList, content, _, err := split(child)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trie node reference: %w", err)
+		}
+		switch {
+		case isList:
+			node = child
+		case len(content) == 0:
+			return nil, errors.New("key not found in trie")
+		case len(content) == 32:
+			wantHash = content
+			node = nil
+		default:
+			return nil, fmt.Errorf("invalid trie node reference of %d bytes", len(content))
+		}
+	}
+	return nil, errors.New("proof exceeds the maximum trie depth")
+}
+
+// VerifyReceiptProof checks that receipt, in its consensus encoding, is the receipt at index of
+// the block whose receipts root is root.
+func VerifyReceiptProof(root []byte, index uint64, receipt []byte, proof [][]byte) error {
+	value, err := VerifyProof(root, encodeUint(index), proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(value, receipt) {
+		return errors.New("receipt does not match the value proven in the trie")
+	}
+	return nil
+}
+
+func nonEmptyValue(element []byte) ([]byte, error) {
+	value, err := bytesValue(element)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trie value: %w", err)
+	}
+	if len(value) == 0 {
+		return nil, errors.New("key not found in trie")
+	}
+	return value, nil
+}
+
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0F)
+	}
+	return nibbles
+}
+
+// compactToNibbles decodes the hex-prefix encoding of a leaf or extension node path
+func compactToNibbles(compact []byte) ([]byte, bool, error) {
+	if len(compact) == 0 {
+		return nil, false, errors.New("empty trie node path")
+	}
+	flag := compact[0] >> 4
+	if flag > 3 {
+		return nil, false, fmt.Errorf("invalid trie node path flag %d", flag)
+	}
+	isLeaf := flag&2 != 0
+	nibbles := keyToNibbles(compact[1:])
+	if flag&1 != 0 {
+		nibbles = append([]byte{compact[0] & 0x0F}, nibbles...)
+	}
+	return nibbles, isLeaf, nil
+}
@@ -0,0 +1,113 @@
+package ethproof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return b
+	}
+	return append(encodeHeader(0x80, len(b)), b...)
+}
+
+func encodeList(elements ...[]byte) []byte {
+	content := bytes.Join(elements, nil)
+	return append(encodeHeader(0xC0, len(content)), content...)
+}
+
+func encodeHeader(offset byte, size int) []byte {
+	if size < 56 {
+		return []byte{offset + byte(size)}
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(size))
+	for buf[0] == 0 {
+		buf = buf[1:]
+	}
+	return append([]byte{offset + 55 + byte(len(buf))}, buf...)
+}
+
+func testReceipt(amount byte) []byte {
+	log := encodeList(
+		encodeBytes(bytes.Repeat([]byte{0xAA}, 20)),
+		encodeList(encodeBytes(bytes.Repeat([]byte{0x01}, 32)), encodeBytes(bytes.Repeat([]byte{0x02}, 32))),
+		encodeBytes(append(make([]byte, 31), amount)),
+	)
+	legacy := encodeList(
+		encodeBytes([]byte{0x01}),
+		encodeBytes([]byte{0x52, 0x08}),
+		encodeBytes(make([]byte, 256)),
+		encodeList(log),
+	)
+	// EIP-1559 receipt
+	return append([]byte{0x02}, legacy...)
+}
+
+// testTrie builds the receipts trie of a block with two receipts: a branch root keyed by the
+// first nibble of rlp(0)=0x80 and rlp(1)=0x01, with a leaf for each receipt.
+func testTrie(receipt0, receipt1 []byte) (root []byte, proof0 [][]byte, proof1 [][]byte) {
+	leaf0 := encodeList(encodeBytes([]byte{0x30}), encodeBytes(receipt0))
+	leaf1 := encodeList(encodeBytes([]byte{0x31}), encodeBytes(receipt1))
+
+	children := make([][]byte, 17)
+	for i := range children {
+		children[i] = encodeBytes(nil)
+	}
+	children[0] = encodeBytes(Keccak256(leaf1))
+	children[8] = encodeBytes(Keccak256(leaf0))
+	branch := encodeList(children...)
+
+	return Keccak256(branch), [][]byte{branch, leaf0}, [][]byte{leaf1, branch}
+}
+
+func TestVerifyReceiptProof(t *testing.T) {
+	receipt0, receipt1 := testReceipt(1), testReceipt(2)
+	root, proof0, proof1 := testTrie(receipt0, receipt1)
+
+	require.NoError(t, VerifyReceiptProof(root, 0, receipt0, proof0))
+	require.NoError(t, VerifyReceiptProof(root, 1, receipt1, proof1))
+
+	// wrong receipt for the index
+	require.Error(t, VerifyReceiptProof(root, 0, receipt1, proof0))
+	// proof of another index
+	require.Error(t, VerifyReceiptProof(root, 1, receipt1, proof0))
+	// index not in the trie
+	require.Error(t, VerifyReceiptProof(root, 2, receipt1, proof1))
+	// another root
+	otherRoot, _, _ := testTrie(receipt1, receipt0)
+	require.Error(t, VerifyReceiptProof(otherRoot, 0, receipt0, proof0))
+	// tampered node
+	tampered := [][]byte{proof0[0], bytes.Replace(proof0[1], []byte{0x30}, []byte{0x31}, 1)}
+	require.Error(t, VerifyReceiptProof(root, 0, receipt0, tampered))
+}
+
+func TestDecodeReceipt(t *testing.T) {
+	succeeded, logs, err := DecodeReceipt(testReceipt(7))
+	require.NoError(t, err)
+	require.True(t, succeeded)
+	require.Len(t, logs, 1)
+	require.Equal(t, bytes.Repeat([]byte{0xAA}, 20), logs[0].Address)
+	require.Len(t, logs[0].Topics, 2)
+	require.Equal(t, byte(7), logs[0].Data[31])
+
+	failed := encodeList(encodeBytes(nil), encodeBytes([]byte{0x01}), encodeBytes(make([]byte, 256)), encodeList())
+	succeeded, logs, err = DecodeReceipt(failed)
+	require.NoError(t, err)
+	require.False(t, succeeded)
+	require.Empty(t, logs)
+
+	_, _, err = DecodeReceipt([]byte{0x02, 0xC1})
+	require.Error(t, err)
+}
+
+func TestEncodeUint(t *testing.T) {
+	require.Equal(t, []byte{0x80}, encodeUint(0))
+	require.Equal(t, []byte{0x7F}, encodeUint(127))
+	require.Equal(t, []byte{0x81, 0x80}, encodeUint(128))
+	require.Equal(t, []byte{0x82, 0x01, 0x00}, encodeUint(256))
+}
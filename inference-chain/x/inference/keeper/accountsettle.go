@@ -87,7 +87,7 @@ func (k *Keeper) GetSettleParameters(ctx context.Context) *SettleParameters {
 	}
 }
 
-func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, previousEpochIndex uint64) error {
+func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64) error {
 	if currentEpochIndex == 0 {
 		k.LogInfo("SettleAccounts Skipped For Epoch 0", types.Settle, "currentEpochIndex", currentEpochIndex, "skipping")
 		return nil
@@ -209,18 +209,17 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 		}
 
 		amount.Settle.EpochIndex = currentEpochIndex
+		if err := k.snapshotDelegatorRewards(sdkCtx, amount.Settle); err != nil {
+			k.LogError("Error splitting delegator rewards", types.Settle, "error", err, "participant", amount.Settle.Participant)
+		}
 		k.LogInfo("Settle for participant", types.Settle, "rewardCoins", amount.Settle.RewardCoins, "workCoins", amount.Settle.WorkCoins, "address", amount.Settle.Participant)
 		k.SetSettleAmountWithBurn(ctx, *amount.Settle)
 	}
 
-	if previousEpochIndex == 0 {
-		return nil
-	}
-
-	k.LogInfo("Burning old settle amounts", types.Settle, "previousEpochIndex", previousEpochIndex)
-	err = k.BurnOldSettleAmounts(ctx, previousEpochIndex)
+	k.LogInfo("Burning expired settle amounts", types.Settle, "currentEpochIndex", currentEpochIndex)
+	err = k.BurnExpiredSettleAmounts(ctx, currentEpochIndex)
 	if err != nil {
-		k.LogError("Error burning old settle amounts", types.Settle, "error", err)
+		k.LogError("Error burning expired settle amounts", types.Settle, "error", err)
 	}
 	return nil
 }
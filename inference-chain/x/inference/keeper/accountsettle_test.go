@@ -3,10 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/testutil"
 	"go.uber.org/mock/gomock"
 
 	keeper2 "github.com/productscience/inference/testutil/keeper"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
 	inference "github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/shopspring/decimal"
@@ -279,7 +282,13 @@ func TestActualSettle(t *testing.T) {
 
 	mocks.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, types.GetCoins(expectedRewardCoin), gomock.Any()).Return(nil)
 	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
-	err := keeper.SettleAccounts(ctx, 10, 0)
+	// 20% of the first participant's rewards go to its delegators, split 3:1 by their delegations
+	mocks.CollateralKeeper.EXPECT().GetDelegatorRewards(gomock.Any(), sdk.MustAccAddressFromBech32(testutil.Executor), gomock.Any()).Return(math.LegacyNewDecWithPrec(2, 1), []collateraltypes.CollateralDelegation{
+		{Delegator: testutil.Requester, Participant: testutil.Executor, Amount: sdk.NewInt64Coin(types.BaseCoin, 300)},
+		{Delegator: testutil.Creator, Participant: testutil.Executor, Amount: sdk.NewInt64Coin(types.BaseCoin, 100)},
+	})
+	mocks.CollateralKeeper.EXPECT().GetDelegatorRewards(gomock.Any(), sdk.MustAccAddressFromBech32(testutil.Executor2), gomock.Any()).Return(math.LegacyZeroDec(), nil)
+	err := keeper.SettleAccounts(ctx, 10)
 	require.NoError(t, err)
 	updated1, found := keeper.GetParticipant(ctx, participant1.Address)
 	require.True(t, found)
@@ -289,13 +298,19 @@ func TestActualSettle(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, int64(0), updated2.CoinBalance)
 	require.Equal(t, uint32(1), updated2.EpochsCompleted)
-	settleAmount1, found := keeper.GetSettleAmount(ctx, participant1.Address)
+	settleAmount1, found := keeper.GetSettleAmount(ctx, participant1.Address, 10)
 	require.True(t, found)
 	require.Equal(t, uint64(1000), settleAmount1.WorkCoins)
 	require.Equal(t, uint64(expectedRewardCoin/2), settleAmount1.RewardCoins)
 	require.Equal(t, uint64(10), settleAmount1.EpochIndex)
-	settleAmount2, found := keeper.GetSettleAmount(ctx, participant2.Address)
+	delegatorsReward := uint64(expectedRewardCoin/2) / 5
+	require.Equal(t, []*types.DelegatorReward{
+		{Delegator: testutil.Requester, Amount: delegatorsReward * 3 / 4},
+		{Delegator: testutil.Creator, Amount: delegatorsReward / 4},
+	}, settleAmount1.DelegatorRewards)
+	settleAmount2, found := keeper.GetSettleAmount(ctx, participant2.Address, 10)
 	require.True(t, found)
 	require.Equal(t, uint64(1000), settleAmount2.WorkCoins)
 	require.Equal(t, uint64(expectedRewardCoin/2), settleAmount2.RewardCoins)
+	require.Empty(t, settleAmount2.DelegatorRewards)
 }
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// maxBridgeAttestationsPrunedPerBlock bounds how many stale votes PruneBridgeBlockAttestations removes in one block
+const maxBridgeAttestationsPrunedPerBlock = 100
+
+// bridgeBlockAttestationKey is the (origin chain, block number, receipts root) key of a vote
+type bridgeBlockAttestationKey = collections.Triple[string, string, string]
+
+var bridgeBlockAttestationKeyCodec = collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey)
+
+// GetBridgeBlockHeader returns the receipts root a majority of validators attested for the block
+func (k Keeper) GetBridgeBlockHeader(ctx context.Context, originChain, blockNumber string) (types.BridgeBlockHeader, bool) {
+	header, err := k.BridgeBlockHeaders.Get(ctx, collections.Join(originChain, blockNumber))
+	if err != nil {
+		return types.BridgeBlockHeader{}, false
+	}
+	return header, true
+}
+
+// bridgeAttestationQuorum checks that validator is an active participant of the current epoch and
+// returns how many attestations make a majority, the same threshold as for bridge transactions
+func (k Keeper) bridgeAttestationQuorum(ctx sdk.Context, validator string) (uint32, int, error) {
+	currentEpochGroup, err := k.GetCurrentEpochGroup(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to get current epoch group: %v", err)
+	}
+	activeParticipants, found := k.GetActiveParticipants(ctx, currentEpochGroup.GroupData.EpochGroupId)
+	if !found {
+		return 0, 0, fmt.Errorf("no active participants found for current epoch")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(validator)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid validator address: %v", err)
+	}
+	for _, participant := range activeParticipants.Participants {
+		participantAddr, err := sdk.AccAddressFromBech32(participant.Index)
+		if err != nil {
+			continue
+		}
+		if participantAddr.Equals(addr) {
+			total := len(activeParticipants.Participants)
+			return uint32((total * 2) / 3), total, nil
+		}
+	}
+	return 0, 0, errors.New("validator not in active participants")
+}
+
+// AttestBridgeBlock records the vote of validator for the receipts root of a block. Every validator
+// votes for one root per block. The root is final once a majority attested it, later votes for
+// other roots of the block are rejected.
+func (k Keeper) AttestBridgeBlock(ctx sdk.Context, validator, originChain, blockNumber, receiptsRoot string) (types.BridgeBlockHeader, error) {
+	if header, found := k.GetBridgeBlockHeader(ctx, originChain, blockNumber); found {
+		if header.ReceiptsRoot != receiptsRoot {
+			return types.BridgeBlockHeader{}, fmt.Errorf("block %s on %s is already attested with receipts root %s", blockNumber, originChain, header.ReceiptsRoot)
+		}
+		return header, nil
+	}
+	if err := k.checkNoBridgeBlockVote(ctx, validator, originChain, blockNumber); err != nil {
+		return types.BridgeBlockHeader{}, err
+	}
+
+	required, total, err := k.bridgeAttestationQuorum(ctx, validator)
+	if err != nil {
+		return types.BridgeBlockHeader{}, err
+	}
+
+	key := collections.Join3(originChain, blockNumber, receiptsRoot)
+	attestation, err := k.BridgeBlockAttestations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		attestation = types.BridgeBlockHeader{
+			OriginChain:           originChain,
+			BlockNumber:           blockNumber,
+			ReceiptsRoot:          receiptsRoot,
+			Status:                types.BridgeTransactionStatus_BRIDGE_PENDING,
+			AttestedAtBlockHeight: ctx.BlockHeight(),
+		}
+	} else if err != nil {
+		return types.BridgeBlockHeader{}, err
+	}
+	attestation.Validators = append(attestation.Validators, validator)
+	attestation.ValidationCount++
+
+	if attestation.ValidationCount < required {
+		k.LogInfo("Bridge block: attestation pending majority", types.Messages,
+			"originChain", originChain,
+			"blockNumber", blockNumber,
+			"receiptsRoot", receiptsRoot,
+			"validationsRequired", required,
+			"validationsReceived", attestation.ValidationCount,
+			"totalValidators", total)
+		return attestation, k.BridgeBlockAttestations.Set(ctx, key, attestation)
+	}
+
+	attestation.Status = types.BridgeTransactionStatus_BRIDGE_COMPLETED
+	if err := k.BridgeBlockHeaders.Set(ctx, collections.Join(originChain, blockNumber), attestation); err != nil {
+		return types.BridgeBlockHeader{}, err
+	}
+	// The votes of the block are no longer needed once its root is final
+	if err := k.removeBridgeBlockAttestations(ctx, originChain, blockNumber); err != nil {
+		return types.BridgeBlockHeader{}, err
+	}
+
+	k.LogInfo("Bridge block: receipts root reached majority", types.Messages,
+		"originChain", originChain,
+		"blockNumber", blockNumber,
+		"receiptsRoot", receiptsRoot,
+		"validationsRequired", required,
+		"validationsReceived", attestation.ValidationCount,
+		"totalValidators", total)
+	return attestation, nil
+}
+
+// checkNoBridgeBlockVote fails if validator already voted for any receipts root of the block
+func (k Keeper) checkNoBridgeBlockVote(ctx context.Context, validator, originChain, blockNumber string) error {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, string](originChain, blockNumber)
+	iter, err := k.BridgeBlockAttestations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		attestation, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		for _, v := range attestation.Validators {
+			if v == validator {
+				return fmt.Errorf("validator has already attested block %s on %s", blockNumber, originChain)
+			}
+		}
+	}
+	return nil
+}
+
+// removeBridgeBlockAttestations removes the votes for all receipts roots of the block
+func (k Keeper) removeBridgeBlockAttestations(ctx context.Context, originChain, blockNumber string) error {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, string](originChain, blockNumber)
+	iter, err := k.BridgeBlockAttestations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.BridgeBlockAttestations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PruneBridgeBlockAttestations removes the votes for receipts roots that were first attested before
+// cutoffHeight and never reached a majority, oldest first and no more than
+// maxBridgeAttestationsPrunedPerBlock of them. Votes of finalized blocks are removed as they finalize.
+func (k Keeper) PruneBridgeBlockAttestations(ctx context.Context, cutoffHeight int64) (int, error) {
+	rng := collections.NewPrefixUntilPairRange[int64, bridgeBlockAttestationKey](cutoffHeight - 1)
+	iter, err := k.BridgeBlockAttestations.Indexes.ByHeight.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	var stale []bridgeBlockAttestationKey
+	for ; iter.Valid() && len(stale) < maxBridgeAttestationsPrunedPerBlock; iter.Next() {
+		key, err := iter.PrimaryKey()
+		if err != nil {
+			iter.Close()
+			return 0, err
+		}
+		stale = append(stale, key)
+	}
+	iter.Close()
+
+	for _, key := range stale {
+		if err := k.BridgeBlockAttestations.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+	return len(stale), nil
+}
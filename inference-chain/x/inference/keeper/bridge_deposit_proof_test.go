@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func rlpBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return b
+	}
+	return append(rlpHeader(0x80, len(b)), b...)
+}
+
+func rlpList(elements ...[]byte) []byte {
+	content := bytes.Join(elements, nil)
+	return append(rlpHeader(0xC0, len(content)), content...)
+}
+
+func rlpHeader(offset byte, size int) []byte {
+	switch {
+	case size < 56:
+		return []byte{offset + byte(size)}
+	case size < 256:
+		return []byte{offset + 56, byte(size)}
+	default:
+		return []byte{offset + 57, byte(size >> 8), byte(size)}
+	}
+}
+
+func depositReceipt(status byte, logs ...[]byte) []byte {
+	return append([]byte{0x02}, rlpList(
+		rlpBytes([]byte{status}),
+		rlpBytes([]byte{0x52, 0x08}),
+		rlpBytes(make([]byte, 256)),
+		rlpList(logs...),
+	)...)
+}
+
+func depositLog(contract []byte, token []byte, amount byte, recipient []byte) []byte {
+	data := make([]byte, 64)
+	data[31] = amount
+	copy(data[64-len(recipient):], recipient)
+	return rlpList(
+		rlpBytes(contract),
+		rlpList(
+			rlpBytes(keeper.BRIDGE_DEPOSIT_EVENT),
+			rlpBytes(append(make([]byte, 32-len(token)), token...)),
+			rlpBytes(make([]byte, 32)),
+		),
+		rlpBytes(data),
+	)
+}
+
+func TestParseBridgeDeposit(t *testing.T) {
+	bridge := bytes.Repeat([]byte{0xB1}, 20)
+	token := bytes.Repeat([]byte{0x7E}, 20)
+	recipient := bytes.Repeat([]byte{0x0A}, 20)
+
+	deposit, err := keeper.ParseBridgeDeposit(depositReceipt(1, depositLog(bridge, token, 42, recipient)), bridge)
+	require.NoError(t, err)
+	require.Equal(t, "0x7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e", deposit.Token)
+	require.Equal(t, "42", deposit.Amount)
+	require.Equal(t, sdk.AccAddress(recipient), deposit.Recipient)
+
+	// failed transaction
+	_, err = keeper.ParseBridgeDeposit(depositReceipt(0, depositLog(bridge, token, 42, recipient)), bridge)
+	require.Error(t, err)
+	// event of another contract
+	_, err = keeper.ParseBridgeDeposit(depositReceipt(1, depositLog(token, token, 42, recipient)), bridge)
+	require.Error(t, err)
+	// two deposits in one receipt
+	_, err = keeper.ParseBridgeDeposit(depositReceipt(1, depositLog(bridge, token, 1, recipient), depositLog(bridge, token, 2, recipient)), bridge)
+	require.Error(t, err)
+	// zero amount
+	_, err = keeper.ParseBridgeDeposit(depositReceipt(1, depositLog(bridge, token, 0, recipient)), bridge)
+	require.Error(t, err)
+	// recipient with dirty padding
+	_, err = keeper.ParseBridgeDeposit(depositReceipt(1, depositLog(bridge, token, 42, append([]byte{0x01}, recipient...))), bridge)
+	require.ErrorContains(t, err, "padding")
+	// token with dirty padding
+	_, err = keeper.ParseBridgeDeposit(depositReceipt(1, depositLog(bridge, append([]byte{0x01}, token...), 42, recipient)), bridge)
+	require.ErrorContains(t, err, "padding")
+}
+
+func TestAttestBridgeBlock_RootIsFinal(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	root := "0x" + strings.Repeat("ab", 32)
+	require.NoError(t, k.BridgeBlockHeaders.Set(ctx, collections.Join("ethereum", "100"), types.BridgeBlockHeader{
+		OriginChain:  "ethereum",
+		BlockNumber:  "100",
+		ReceiptsRoot: root,
+		Status:       types.BridgeTransactionStatus_BRIDGE_COMPLETED,
+	}))
+
+	header, err := k.AttestBridgeBlock(ctx, "validator", "ethereum", "100", root)
+	require.NoError(t, err)
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_COMPLETED, header.Status)
+
+	other := "0x" + strings.Repeat("cd", 32)
+	_, err = k.AttestBridgeBlock(ctx, "validator", "ethereum", "100", other)
+	require.Error(t, err)
+}
+
+func TestAttestBridgeBlock_OneRootPerValidator(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	root := "0x" + strings.Repeat("ab", 32)
+	require.NoError(t, k.BridgeBlockAttestations.Set(ctx, collections.Join3("ethereum", "100", root), types.BridgeBlockHeader{
+		OriginChain:     "ethereum",
+		BlockNumber:     "100",
+		ReceiptsRoot:    root,
+		Validators:      []string{"validator"},
+		ValidationCount: 1,
+		Status:          types.BridgeTransactionStatus_BRIDGE_PENDING,
+	}))
+
+	_, err := k.AttestBridgeBlock(ctx, "validator", "ethereum", "100", root)
+	require.ErrorContains(t, err, "already attested")
+	other := "0x" + strings.Repeat("cd", 32)
+	_, err = k.AttestBridgeBlock(ctx, "validator", "ethereum", "100", other)
+	require.ErrorContains(t, err, "already attested")
+}
+
+func TestPruneBridgeBlockAttestations(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	root := "0x" + strings.Repeat("ab", 32)
+	stale := collections.Join3("ethereum", "100", root)
+	recent := collections.Join3("ethereum", "101", root)
+	require.NoError(t, k.BridgeBlockAttestations.Set(ctx, stale, types.BridgeBlockHeader{AttestedAtBlockHeight: 10}))
+	require.NoError(t, k.BridgeBlockAttestations.Set(ctx, recent, types.BridgeBlockHeader{AttestedAtBlockHeight: 50}))
+
+	pruned, err := k.PruneBridgeBlockAttestations(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	found, err := k.BridgeBlockAttestations.Has(ctx, stale)
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = k.BridgeBlockAttestations.Has(ctx, recent)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestPruneBridgeBlockAttestations_BoundedPerBlock(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	root := "0x" + strings.Repeat("ab", 32)
+	for block := 1; block <= 150; block++ {
+		key := collections.Join3("ethereum", strconv.Itoa(block), root)
+		require.NoError(t, k.BridgeBlockAttestations.Set(ctx, key, types.BridgeBlockHeader{AttestedAtBlockHeight: int64(block)}))
+	}
+
+	pruned, err := k.PruneBridgeBlockAttestations(ctx, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 100, pruned)
+	// The oldest votes go first
+	found, err := k.BridgeBlockAttestations.Has(ctx, collections.Join3("ethereum", "100", root))
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = k.BridgeBlockAttestations.Has(ctx, collections.Join3("ethereum", "101", root))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	pruned, err = k.PruneBridgeBlockAttestations(ctx, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 50, pruned)
+}
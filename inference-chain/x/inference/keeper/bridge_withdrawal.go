@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	blstypes "github.com/productscience/inference/x/bls/types"
+	"github.com/productscience/inference/x/inference/ethproof"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// BRIDGE_WITHDRAWAL_DOMAIN is the first data element of every withdrawal payload, so a withdrawal
+// signature can't be mistaken for any other threshold signature (external requests are prefixed
+// with CUSTOM_THRESHOLD_SIGNATURE by x/bls)
+var BRIDGE_WITHDRAWAL_DOMAIN = ethproof.Keccak256([]byte("BRIDGE_WITHDRAWAL"))
+
+func (k Keeper) SetBridgeWithdrawal(ctx context.Context, withdrawal types.BridgeWithdrawal) error {
+	return k.BridgeWithdrawals.Set(ctx, withdrawal.Id, withdrawal)
+}
+
+func (k Keeper) GetBridgeWithdrawal(ctx context.Context, id uint64) (types.BridgeWithdrawal, bool) {
+	withdrawal, err := k.BridgeWithdrawals.Get(ctx, id)
+	if err != nil {
+		return types.BridgeWithdrawal{}, false
+	}
+	return withdrawal, true
+}
+
+const (
+	// maxBridgeWithdrawalsPerBlock bounds the work EndBlock does, the pending withdrawals are checked
+	// over several blocks
+	maxBridgeWithdrawalsPerBlock = 100
+	// maxBridgeWithdrawalAttempts is how many times settling a withdrawal may fail before it is given up
+	maxBridgeWithdrawalAttempts = 10
+)
+
+// ProcessPendingBridgeWithdrawals settles the escrow of withdrawals whose threshold signing ended:
+// the tokens are burned once the signature completed and returned to the creator if it expired or
+// failed. Withdrawals still collecting signatures are left pending. Each block checks the next
+// maxBridgeWithdrawalsPerBlock of them, starting over once it gets to the end.
+func (k Keeper) ProcessPendingBridgeWithdrawals(ctx sdk.Context) error {
+	cursor, err := k.BridgeWithdrawalCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	iter, err := k.PendingBridgeWithdrawals.Iterate(ctx, new(collections.Range[uint64]).StartExclusive(cursor))
+	if err != nil {
+		return err
+	}
+	// Collected first, the map must not be written to while it is iterated
+	var ids []uint64
+	var attempts []uint32
+	for ; iter.Valid() && len(ids) < maxBridgeWithdrawalsPerBlock; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		ids = append(ids, kv.Key)
+		attempts = append(attempts, kv.Value)
+	}
+	iter.Close()
+
+	if len(ids) < maxBridgeWithdrawalsPerBlock {
+		err = k.BridgeWithdrawalCursor.Remove(ctx)
+	} else {
+		err = k.BridgeWithdrawalCursor.Set(ctx, ids[len(ids)-1])
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, id := range ids {
+		withdrawal, found := k.GetBridgeWithdrawal(ctx, id)
+		if !found {
+			k.LogError("Bridge withdraw: Pending withdrawal not found", types.Messages, "withdrawalId", id)
+			if err := k.PendingBridgeWithdrawals.Remove(ctx, id); err != nil {
+				return err
+			}
+			continue
+		}
+		request, err := k.BlsKeeper.GetSigningStatus(ctx, withdrawal.RequestId)
+		if err != nil {
+			k.LogError("Bridge withdraw: Unable to get signing status", types.Messages, "withdrawalId", id, "error", err)
+			if attempts[i]+1 >= maxBridgeWithdrawalAttempts {
+				// The signature can't be checked, so it is treated as never completed
+				err = k.settleBridgeWithdrawal(ctx, withdrawal, types.BridgeWithdrawalStatus_WITHDRAWAL_REFUNDED)
+			}
+			if err != nil {
+				if err := k.bridgeWithdrawalFailed(ctx, withdrawal, attempts[i]); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		switch request.Status {
+		case blstypes.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COMPLETED:
+			err = k.settleBridgeWithdrawal(ctx, withdrawal, types.BridgeWithdrawalStatus_WITHDRAWAL_SIGNED)
+		case blstypes.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_EXPIRED,
+			blstypes.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_FAILED:
+			err = k.settleBridgeWithdrawal(ctx, withdrawal, types.BridgeWithdrawalStatus_WITHDRAWAL_REFUNDED)
+		default:
+			continue
+		}
+		if err != nil {
+			k.LogError("Bridge withdraw: Failed to settle withdrawal escrow", types.Messages, "withdrawalId", id, "error", err)
+			if err := k.bridgeWithdrawalFailed(ctx, withdrawal, attempts[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bridgeWithdrawalFailed counts a failed attempt to settle a withdrawal. After maxBridgeWithdrawalAttempts
+// the withdrawal is no longer retried and its tokens stay in escrow.
+func (k Keeper) bridgeWithdrawalFailed(ctx sdk.Context, withdrawal types.BridgeWithdrawal, attempts uint32) error {
+	attempts++
+	if attempts < maxBridgeWithdrawalAttempts {
+		return k.PendingBridgeWithdrawals.Set(ctx, withdrawal.Id, attempts)
+	}
+	k.LogError("Bridge withdraw: Giving up on settling withdrawal", types.Messages, "withdrawalId", withdrawal.Id, "attempts", attempts)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"bridge_withdrawal_abandoned",
+			sdk.NewAttribute("withdrawal_id", strconv.FormatUint(withdrawal.Id, 10)),
+			sdk.NewAttribute("attempts", strconv.FormatUint(uint64(attempts), 10)),
+		),
+	)
+	return k.PendingBridgeWithdrawals.Remove(ctx, withdrawal.Id)
+}
+
+// settleBridgeWithdrawal burns or refunds the escrowed tokens of a withdrawal. The wasm calls run in a
+// cached context, so a failure leaves the withdrawal pending for the next block.
+func (k Keeper) settleBridgeWithdrawal(ctx sdk.Context, withdrawal types.BridgeWithdrawal, status types.BridgeWithdrawalStatus) error {
+	cacheCtx, write := ctx.CacheContext()
+	moduleAddress := k.AccountKeeper.GetModuleAddress(types.ModuleName).String()
+	if status == types.BridgeWithdrawalStatus_WITHDRAWAL_SIGNED {
+		if err := k.BurnTokens(cacheCtx, withdrawal.Cw20Contract, moduleAddress, withdrawal.Amount); err != nil {
+			return fmt.Errorf("failed to burn escrowed tokens: %w", err)
+		}
+	} else {
+		if err := k.TransferTokens(cacheCtx, withdrawal.Cw20Contract, moduleAddress, withdrawal.Creator, withdrawal.Amount); err != nil {
+			return fmt.Errorf("failed to refund escrowed tokens: %w", err)
+		}
+	}
+	write()
+
+	withdrawal.Status = status
+	if err := k.SetBridgeWithdrawal(ctx, withdrawal); err != nil {
+		return err
+	}
+	if err := k.PendingBridgeWithdrawals.Remove(ctx, withdrawal.Id); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"bridge_withdrawal_settled",
+			sdk.NewAttribute("withdrawal_id", strconv.FormatUint(withdrawal.Id, 10)),
+			sdk.NewAttribute("status", status.String()),
+		),
+	)
+	k.LogInfo("Bridge withdraw: Withdrawal settled", types.Messages, "withdrawalId", withdrawal.Id, "status", status.String())
+	return nil
+}
+
+// BridgeWithdrawalSigningData builds the threshold signing request for a withdrawal. The payload is
+// abi.encodePacked(epochId, chainId, requestId, domain, keccak256(destinationChain), contractAddress,
+// recipient, amount, withdrawalId), every data element being 32 bytes, with addresses left-padded
+// and amount and id as uint256. chainId is keccak256 of this chain's id.
+func BridgeWithdrawalSigningData(chainId string, txBytes []byte, withdrawal types.BridgeWithdrawal) (blstypes.SigningData, error) {
+	contractAddress, err := evmAddressWord(withdrawal.ContractAddress)
+	if err != nil {
+		return blstypes.SigningData{}, fmt.Errorf("invalid contract address: %w", err)
+	}
+	recipient, err := evmAddressWord(withdrawal.Recipient)
+	if err != nil {
+		return blstypes.SigningData{}, fmt.Errorf("invalid recipient: %w", err)
+	}
+	amount, ok := new(big.Int).SetString(withdrawal.Amount, 10)
+	if !ok || amount.Sign() <= 0 || amount.BitLen() > 256 {
+		return blstypes.SigningData{}, fmt.Errorf("invalid amount: %s", withdrawal.Amount)
+	}
+
+	idWord := make([]byte, 32)
+	binary.BigEndian.PutUint64(idWord[24:], withdrawal.Id)
+	chainIdHash := ethproof.Keccak256([]byte(chainId))
+
+	// Request ids share one namespace in x/bls and anyone can request a signature, so the id
+	// includes the hash of the withdrawal transaction to keep it from being taken in advance
+	txHash := sha256.Sum256(txBytes)
+	requestId := ethproof.Keccak256(BRIDGE_WITHDRAWAL_DOMAIN, chainIdHash, idWord, txHash[:])
+
+	return blstypes.SigningData{
+		CurrentEpochId: withdrawal.EpochId,
+		ChainId:        chainIdHash,
+		RequestId:      requestId,
+		Data: [][]byte{
+			BRIDGE_WITHDRAWAL_DOMAIN,
+			ethproof.Keccak256([]byte(withdrawal.DestinationChain)),
+			contractAddress,
+			recipient,
+			amount.FillBytes(make([]byte, 32)),
+			idWord,
+		},
+	}, nil
+}
+
+// evmAddressWord decodes a 0x-prefixed 20-byte address into a left-padded 32-byte word
+func evmAddressWord(address string) ([]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 20 {
+		return nil, fmt.Errorf("address must be 20 bytes, got %d", len(raw))
+	}
+	word := make([]byte, 32)
+	copy(word[12:], raw)
+	return word, nil
+}
+
+// bridgeSigningEpoch returns the epoch whose BLS group signs new withdrawals: the current one once
+// its DKG has completed, the previous one while the current DKG is still running.
+func (k Keeper) bridgeSigningEpoch(ctx sdk.Context) (uint64, error) {
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return 0, fmt.Errorf("no effective epoch")
+	}
+	candidates := []uint64{epochIndex}
+	if epochIndex > 0 {
+		candidates = append(candidates, epochIndex-1)
+	}
+	for _, candidate := range candidates {
+		epochBLSData, found := k.BlsKeeper.GetEpochBLSData(ctx, candidate)
+		if !found || len(epochBLSData.GroupPublicKey) == 0 {
+			continue
+		}
+		if epochBLSData.DkgPhase == blstypes.DKGPhase_DKG_PHASE_COMPLETED || epochBLSData.DkgPhase == blstypes.DKGPhase_DKG_PHASE_SIGNED {
+			return candidate, nil
+		}
+	}
+	return 0, fmt.Errorf("no epoch with a completed DKG to sign with, effective epoch %d", epochIndex)
+}
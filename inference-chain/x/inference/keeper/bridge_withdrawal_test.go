@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeWithdrawalSigningData(t *testing.T) {
+	withdrawal := types.BridgeWithdrawal{
+		Id:               7,
+		DestinationChain: "ethereum",
+		ContractAddress:  "0x1111111111111111111111111111111111111111",
+		Recipient:        "0xAbCdEf0123456789aBcDeF0123456789abCDEF01",
+		Amount:           "1000",
+		EpochId:          3,
+	}
+
+	signingData, err := keeper.BridgeWithdrawalSigningData("gonka-testnet", []byte("tx"), withdrawal)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(3), signingData.CurrentEpochId)
+	require.Len(t, signingData.ChainId, 32)
+	require.Len(t, signingData.RequestId, 32)
+	require.Len(t, signingData.Data, 6)
+	for _, element := range signingData.Data {
+		require.Len(t, element, 32)
+	}
+	require.Equal(t, keeper.BRIDGE_WITHDRAWAL_DOMAIN, signingData.Data[0])
+	require.Equal(t, bytes.Repeat([]byte{0x11}, 20), signingData.Data[2][12:])
+	require.Equal(t, make([]byte, 12), signingData.Data[3][:12])
+	require.Equal(t, byte(0xAB), signingData.Data[3][12])
+	require.Equal(t, []byte{0x03, 0xe8}, signingData.Data[4][30:])
+	require.Equal(t, byte(7), signingData.Data[5][31])
+
+	// A different transaction for the same withdrawal id gets a different request id
+	other, err := keeper.BridgeWithdrawalSigningData("gonka-testnet", []byte("other tx"), withdrawal)
+	require.NoError(t, err)
+	require.NotEqual(t, signingData.RequestId, other.RequestId)
+	require.Equal(t, signingData.Data, other.Data)
+
+	withdrawal.Recipient = "0x1234"
+	_, err = keeper.BridgeWithdrawalSigningData("gonka-testnet", []byte("tx"), withdrawal)
+	require.Error(t, err)
+}
+
+func TestBridgeWithdrawalQuery_NotFound(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	_, err := k.BridgeWithdrawal(ctx, &types.QueryGetBridgeWithdrawalRequest{Id: 1})
+	require.Error(t, err)
+}
+
+func TestProcessPendingBridgeWithdrawals_KeepsUnsignedPending(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	withdrawal := types.BridgeWithdrawal{Id: 1, RequestId: []byte("unknown request"), Status: types.BridgeWithdrawalStatus_WITHDRAWAL_PENDING}
+	require.NoError(t, k.SetBridgeWithdrawal(ctx, withdrawal))
+	require.NoError(t, k.PendingBridgeWithdrawals.Set(ctx, withdrawal.Id, 0))
+
+	require.NoError(t, k.ProcessPendingBridgeWithdrawals(ctx))
+
+	pending, err := k.PendingBridgeWithdrawals.Has(ctx, withdrawal.Id)
+	require.NoError(t, err)
+	require.True(t, pending)
+	stored, found := k.GetBridgeWithdrawal(ctx, withdrawal.Id)
+	require.True(t, found)
+	require.Equal(t, types.BridgeWithdrawalStatus_WITHDRAWAL_PENDING, stored.Status)
+}
+
+func TestProcessPendingBridgeWithdrawals_BoundedPerBlock(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	for id := uint64(1); id <= 150; id++ {
+		withdrawal := types.BridgeWithdrawal{Id: id, RequestId: []byte("unknown request"), Status: types.BridgeWithdrawalStatus_WITHDRAWAL_PENDING}
+		require.NoError(t, k.SetBridgeWithdrawal(ctx, withdrawal))
+		require.NoError(t, k.PendingBridgeWithdrawals.Set(ctx, id, 0))
+	}
+	attempts := func(id uint64) uint32 {
+		value, err := k.PendingBridgeWithdrawals.Get(ctx, id)
+		require.NoError(t, err)
+		return value
+	}
+
+	// The first block checks the first 100, the next one the rest
+	require.NoError(t, k.ProcessPendingBridgeWithdrawals(ctx))
+	require.Equal(t, uint32(1), attempts(100))
+	require.Equal(t, uint32(0), attempts(101))
+	require.NoError(t, k.ProcessPendingBridgeWithdrawals(ctx))
+	require.Equal(t, uint32(1), attempts(100))
+	require.Equal(t, uint32(1), attempts(150))
+	require.NoError(t, k.ProcessPendingBridgeWithdrawals(ctx))
+	require.Equal(t, uint32(2), attempts(1))
+}
+
+func TestProcessPendingBridgeWithdrawals_GivesUpAfterMaxAttempts(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	mocks.AccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+
+	withdrawal := types.BridgeWithdrawal{Id: 1, RequestId: []byte("unknown request"), Status: types.BridgeWithdrawalStatus_WITHDRAWAL_PENDING}
+	require.NoError(t, k.SetBridgeWithdrawal(ctx, withdrawal))
+	require.NoError(t, k.PendingBridgeWithdrawals.Set(ctx, withdrawal.Id, 0))
+
+	for i := 0; i < 9; i++ {
+		require.NoError(t, k.ProcessPendingBridgeWithdrawals(ctx))
+	}
+	pending, err := k.PendingBridgeWithdrawals.Has(ctx, withdrawal.Id)
+	require.NoError(t, err)
+	require.True(t, pending)
+
+	require.NoError(t, k.ProcessPendingBridgeWithdrawals(ctx))
+	pending, err = k.PendingBridgeWithdrawals.Has(ctx, withdrawal.Id)
+	require.NoError(t, err)
+	require.False(t, pending)
+}
+
+func TestExternalTokenContract_CaseInsensitive(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	k.SetExternalTokenContract(ctx, types.ExternalTokenContract{
+		ExternalChain:    "Ethereum",
+		ExternalContract: "0xAbCdEf0123456789aBcDeF0123456789abCDEF01",
+		Cw20Contract:     "cw20",
+	})
+
+	contract, found := k.GetExternalTokenContract(ctx, "ethereum", "0xabcdef0123456789abcdef0123456789abcdef01")
+	require.True(t, found)
+	require.Equal(t, "cw20", contract.Cw20Contract)
+	require.Equal(t, "0xabcdef0123456789abcdef0123456789abcdef01", contract.ExternalContract)
+
+	_, found = k.GetExternalTokenContract(ctx, "ETHEREUM", "0xABCDEF0123456789ABCDEF0123456789ABCDEF01")
+	require.True(t, found)
+}
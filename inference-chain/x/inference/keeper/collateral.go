@@ -6,6 +6,9 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
@@ -65,7 +68,8 @@ func (k Keeper) AdjustWeightsByCollateral(ctx context.Context, participants []*t
 		var activatedWeight math.LegacyDec
 
 		// 3. Calculate Activated Weight: Determine how much of the eligible weight is activated by the participant's collateral.
-		collateral, found := k.collateralKeeper.GetCollateral(sdkCtx, participantAddress)
+		// Collateral delegated to the participant activates weight like its own
+		collateral, found := k.collateralKeeper.GetTotalCollateral(sdkCtx, participantAddress)
 		if !found || collateral.IsZero() {
 			activatedWeight = math.LegacyZeroDec()
 		} else {
@@ -98,8 +102,9 @@ func (k Keeper) AdjustWeightsByCollateral(ctx context.Context, participants []*t
 }
 
 // CheckAndSlashForInvalidStatus checks if a participant's status has transitioned to INVALID
-// and, if so, triggers a collateral slash.
-func (k Keeper) CheckAndSlashForInvalidStatus(ctx context.Context, originalStatus types.ParticipantStatus, participant *types.Participant) {
+// and, if so, triggers a collateral slash. The validators' share of the slash goes to the
+// invalidators, when there are none it is burned.
+func (k Keeper) CheckAndSlashForInvalidStatus(ctx context.Context, originalStatus types.ParticipantStatus, participant *types.Participant, invalidators []sdk.AccAddress) {
 	// If the status changed to INVALID, trigger a slash.
 	if originalStatus != types.ParticipantStatus_INVALID && participant.Status == types.ParticipantStatus_INVALID {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -118,7 +123,7 @@ func (k Keeper) CheckAndSlashForInvalidStatus(ctx context.Context, originalStatu
 				"participant", participant.Address,
 				"slash_fraction", slashFraction.String(),
 			)
-			_, err := k.collateralKeeper.Slash(sdkCtx, participantAddress, slashFraction)
+			_, err := k.collateralKeeper.Slash(sdkCtx, participantAddress, slashFraction, collateraltypes.SlashReasonInvalid, invalidators)
 			if err != nil {
 				k.LogError("Failed to slash participant", types.Tokenomics, "participant", participant.Address, "error", err)
 				// Non-fatal error, we log and continue. The participant is already marked INVALID.
@@ -165,7 +170,7 @@ func (k Keeper) CheckAndSlashForDowntime(ctx context.Context, participant *types
 			"slash_fraction", slashFraction.String(),
 		)
 
-		_, err = k.collateralKeeper.Slash(sdkCtx, participantAddress, slashFraction)
+		_, err = k.collateralKeeper.Slash(sdkCtx, participantAddress, slashFraction, collateraltypes.SlashReasonDowntime, nil)
 		if err != nil {
 			k.LogError("Failed to slash participant for downtime", types.Tokenomics, "participant", participant.Address, "error", err)
 		}
@@ -173,3 +178,55 @@ func (k Keeper) CheckAndSlashForDowntime(ctx context.Context, participant *types
 		k.LogInfo("Participant did not exceed downtime threshold", types.Tokenomics, "participant", participant.Address, "missed_percentage", missedPercentage.String(), "downtime_threshold", downtimeThreshold.String())
 	}
 }
+
+// invalidatingValidators returns the validators that invalidated the inference: the one who
+// proposed the invalidation and those who voted yes on it. The executor is never among them.
+func (k Keeper) invalidatingValidators(ctx context.Context, inference types.Inference) []sdk.AccAddress {
+	if inference.ProposalDetails == nil || inference.ProposalDetails.InvalidatePolicyId == 0 {
+		return nil
+	}
+	proposalId := inference.ProposalDetails.InvalidatePolicyId
+
+	var voters []string
+	proposal, err := k.group.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalId})
+	if err != nil {
+		k.LogWarn("Could not get invalidation proposal", types.Validation, "inferenceId", inference.InferenceId, "proposalId", proposalId, "error", err)
+	} else {
+		voters = append(voters, proposal.Proposal.Proposers...)
+	}
+
+	var nextKey []byte
+	for {
+		votes, err := k.group.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{
+			ProposalId: proposalId,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			k.LogWarn("Could not get invalidation votes", types.Validation, "inferenceId", inference.InferenceId, "proposalId", proposalId, "error", err)
+			break
+		}
+		for _, vote := range votes.Votes {
+			if vote.Option == group.VOTE_OPTION_YES {
+				voters = append(voters, vote.Voter)
+			}
+		}
+		if votes.Pagination == nil || len(votes.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = votes.Pagination.NextKey
+	}
+
+	validators := make([]sdk.AccAddress, 0, len(voters))
+	for _, voter := range voters {
+		if voter == inference.ExecutedBy {
+			continue
+		}
+		address, err := sdk.AccAddressFromBech32(voter)
+		if err != nil {
+			k.LogWarn("Could not parse invalidator address", types.Validation, "address", voter, "error", err)
+			continue
+		}
+		validators = append(validators, address)
+	}
+	return validators
+}
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -63,6 +64,7 @@ func setupRealKeepers(t testing.TB) (sdk.Context, keeper.Keeper, collateralKeepe
 	stakingKeeper := keepertest.NewMockStakingKeeper(ctrl)
 	streamvestingKeeper := keepertest.NewMockStreamVestingKeeper(ctrl)
 	authzKeeper := keepertest.NewMockAuthzKeeper(ctrl)
+	distributionKeeper := keepertest.NewMockDistributionKeeper(ctrl)
 
 	// --- Real Keepers ---
 	cKeeper := collateralKeeper.NewKeeper(
@@ -72,6 +74,8 @@ func setupRealKeepers(t testing.TB) (sdk.Context, keeper.Keeper, collateralKeepe
 		authority.String(),
 		nil,                  // bank keeper
 		bookkepingBankKeeper, // bookkeeping bank keeper
+		distributionKeeper,
+		nil,
 	)
 
 	// Create a BLS keeper for testing (similar to testutil/keeper/inference.go)
@@ -143,11 +147,11 @@ func TestSlashingForInvalidStatus_Integration(t *testing.T) {
 	expectedSlashFraction, err := slashFraction.ToLegacyDec()
 	require.NoError(t, err)
 	mocks.CollateralKeeper.EXPECT().
-		Slash(gomock.Any(), participantAcc, expectedSlashFraction).
+		Slash(gomock.Any(), participantAcc, expectedSlashFraction, collateralTypes.SlashReasonInvalid, gomock.Nil()).
 		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
 
 	// Execute the function under test directly
-	k.CheckAndSlashForInvalidStatus(ctx, originalStatus, participant)
+	k.CheckAndSlashForInvalidStatus(ctx, originalStatus, participant, nil)
 }
 
 func TestSlashingForDowntime_Integration(t *testing.T) {
@@ -178,7 +182,7 @@ func TestSlashingForDowntime_Integration(t *testing.T) {
 	expectedSlashFraction, err := slashFraction.ToLegacyDec()
 	require.NoError(t, err)
 	mocks.CollateralKeeper.EXPECT().
-		Slash(gomock.Any(), participantAcc, expectedSlashFraction).
+		Slash(gomock.Any(), participantAcc, expectedSlashFraction, collateralTypes.SlashReasonDowntime, gomock.Nil()).
 		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
 
 	// Execute the function under test directly
@@ -221,8 +225,8 @@ func TestInvalidateInference_FullFlow_WithStatefulMock(t *testing.T) {
 	// Mock Slash to modify our fake collateral
 	expectedSlashFraction, err := slashFraction.ToLegacyDec()
 	require.NoError(t, err)
-	mocks.CollateralKeeper.EXPECT().Slash(gomock.Any(), participantAcc, expectedSlashFraction).DoAndReturn(
-		func(ctx sdk.Context, pa sdk.AccAddress, fraction math.LegacyDec) (sdk.Coin, error) {
+	mocks.CollateralKeeper.EXPECT().Slash(gomock.Any(), participantAcc, expectedSlashFraction, collateralTypes.SlashReasonInvalid, gomock.Any()).DoAndReturn(
+		func(ctx sdk.Context, pa sdk.AccAddress, fraction math.LegacyDec, reason string, validators []sdk.AccAddress) (sdk.Coin, error) {
 			slashedAmount := fakeCollateralAmount.ToLegacyDec().Mul(fraction).TruncateInt()
 			fakeCollateralAmount = fakeCollateralAmount.Sub(slashedAmount)
 			return sdk.NewCoin(types.BaseCoin, slashedAmount), nil
@@ -284,6 +288,69 @@ func TestInvalidateInference_FullFlow_WithStatefulMock(t *testing.T) {
 	require.Equal(t, expectedAmount, fakeCollateralAmount)
 }
 
+func TestInvalidateInference_SlashRewardsInvalidators(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocksForIntegration(t)
+	k.SetEpoch(ctx, &types.Epoch{Index: 1})
+	k.SetEffectiveEpochIndex(ctx, 1)
+
+	params := types.DefaultParams()
+	params.ValidationParams.FalsePositiveRate = types.DecimalFromFloat(0.05)
+	k.SetParams(ctx, params)
+
+	participantAddrStr := sample.AccAddress()
+	participantAcc, err := sdk.AccAddressFromBech32(participantAddrStr)
+	require.NoError(t, err)
+	authority := k.GetAuthority()
+	proposer := sample.AccAddress()
+	yesVoter := sample.AccAddress()
+	noVoter := sample.AccAddress()
+
+	k.SetParticipant(ctx, types.Participant{
+		Index:                        participantAddrStr,
+		Address:                      participantAddrStr,
+		Status:                       types.ParticipantStatus_ACTIVE,
+		ConsecutiveInvalidInferences: 4,
+		CurrentEpochStats:            &types.CurrentEpochStats{},
+	})
+	k.SetParticipant(ctx, types.Participant{Index: authority, Address: authority, CurrentEpochStats: &types.CurrentEpochStats{}})
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	inferenceId := "test-inference-invalidated-by-vote"
+	k.SetInference(ctx, types.Inference{
+		Index:       inferenceId,
+		InferenceId: inferenceId,
+		ExecutedBy:  participantAddrStr,
+		RequestedBy: authority,
+		Status:      types.InferenceStatus_VOTING,
+		ProposalDetails: &types.ProposalDetails{
+			PolicyAddress:      authority,
+			InvalidatePolicyId: 7,
+			ReValidatePolicyId: 8,
+		},
+	})
+
+	mocks.GroupKeeper.EXPECT().Proposal(gomock.Any(), &group.QueryProposalRequest{ProposalId: 7}).
+		Return(&group.QueryProposalResponse{Proposal: &group.Proposal{Id: 7, Proposers: []string{proposer}}}, nil)
+	mocks.GroupKeeper.EXPECT().VotesByProposal(gomock.Any(), gomock.Any()).
+		Return(&group.QueryVotesByProposalResponse{Votes: []*group.Vote{
+			{ProposalId: 7, Voter: yesVoter, Option: group.VOTE_OPTION_YES},
+			{ProposalId: 7, Voter: noVoter, Option: group.VOTE_OPTION_NO},
+			{ProposalId: 7, Voter: participantAddrStr, Option: group.VOTE_OPTION_YES},
+		}}, nil)
+
+	expectedInvalidators := []sdk.AccAddress{sdk.MustAccAddressFromBech32(proposer), sdk.MustAccAddressFromBech32(yesVoter)}
+	mocks.CollateralKeeper.EXPECT().
+		Slash(gomock.Any(), participantAcc, gomock.Any(), collateralTypes.SlashReasonInvalid, expectedInvalidators).
+		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
+
+	_, err = ms.InvalidateInference(ctx, &types.MsgInvalidateInference{
+		Creator:     authority,
+		InferenceId: inferenceId,
+	})
+	require.NoError(t, err)
+}
+
 func TestDoubleJeopardy_DowntimeThenInvalidSlash(t *testing.T) {
 	ctx, k, ck, ms, collateralMsgSrv := setupRealKeepers(t)
 	authority := k.GetAuthority()
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SplitDelegatorRewards splits the reward share of a participant's rewards between the delegators of
+// its collateral, in proportion to their delegations. Amounts are truncated, so the delegators never
+// get more than their share and the remainder stays with the participant.
+func SplitDelegatorRewards(rewardCoins uint64, rewardShare math.LegacyDec, delegations []collateraltypes.CollateralDelegation) []*types.DelegatorReward {
+	if rewardCoins == 0 || rewardShare.IsNil() || !rewardShare.IsPositive() || len(delegations) == 0 {
+		return nil
+	}
+	totalDelegated := math.ZeroInt()
+	for _, delegation := range delegations {
+		totalDelegated = totalDelegated.Add(delegation.Amount.Amount)
+	}
+	if !totalDelegated.IsPositive() {
+		return nil
+	}
+
+	delegatorsReward := math.LegacyNewDecFromInt(math.NewIntFromUint64(rewardCoins)).Mul(math.LegacyMinDec(rewardShare, math.LegacyOneDec()))
+	rewards := make([]*types.DelegatorReward, 0, len(delegations))
+	for _, delegation := range delegations {
+		amount := delegatorsReward.MulInt(delegation.Amount.Amount).QuoInt(totalDelegated).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+		rewards = append(rewards, &types.DelegatorReward{
+			Delegator: delegation.Delegator,
+			Amount:    amount.Uint64(),
+		})
+	}
+	return rewards
+}
+
+// snapshotDelegatorRewards splits the participant's rewards by the delegations the epoch started with,
+// so delegating just before settlement earns nothing and undelegating before the claim changes nothing
+func (k *Keeper) snapshotDelegatorRewards(ctx sdk.Context, settleAmount *types.SettleAmount) error {
+	if settleAmount.RewardCoins == 0 || k.collateralKeeper == nil {
+		return nil
+	}
+	participantAddress, err := sdk.AccAddressFromBech32(settleAmount.Participant)
+	if err != nil {
+		return err
+	}
+	rewardShare, delegations := k.collateralKeeper.GetDelegatorRewards(ctx, participantAddress, settleAmount.EpochIndex)
+	settleAmount.DelegatorRewards = SplitDelegatorRewards(settleAmount.RewardCoins, rewardShare, delegations)
+	return nil
+}
+
+// payDelegatorRewards pays the delegators of the participant's collateral their part of the rewards,
+// as split at settlement, and returns what is left for the participant
+func (k *Keeper) payDelegatorRewards(ctx sdk.Context, settleAmount *types.SettleAmount, vestingPeriod *uint64) (uint64, error) {
+	rewardCoins := settleAmount.GetRewardCoins()
+	for _, reward := range settleAmount.DelegatorRewards {
+		if err := k.PayParticipantFromModule(ctx, reward.Delegator, reward.Amount, types.ModuleName, "delegator_reward_coins:"+settleAmount.Participant, vestingPeriod); err != nil {
+			return 0, err
+		}
+		k.LogInfo("Paid delegator reward", types.Claims, "participant", settleAmount.Participant, "delegator", reward.Delegator, "amount", reward.Amount)
+		rewardCoins -= reward.Amount
+	}
+	return rewardCoins, nil
+}
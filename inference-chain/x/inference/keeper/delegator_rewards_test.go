@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestSplitDelegatorRewards(t *testing.T) {
+	delegations := []collateraltypes.CollateralDelegation{
+		{Delegator: "delegator1", Amount: sdk.NewInt64Coin(types.BaseCoin, 200)},
+		{Delegator: "delegator2", Amount: sdk.NewInt64Coin(types.BaseCoin, 100)},
+	}
+
+	tests := []struct {
+		name        string
+		rewardCoins uint64
+		rewardShare math.LegacyDec
+		delegations []collateraltypes.CollateralDelegation
+		expected    []*types.DelegatorReward
+	}{
+		{
+			name:        "split by delegation",
+			rewardCoins: 1000,
+			rewardShare: math.LegacyNewDecWithPrec(3, 1),
+			delegations: delegations,
+			expected: []*types.DelegatorReward{
+				{Delegator: "delegator1", Amount: 200},
+				{Delegator: "delegator2", Amount: 100},
+			},
+		},
+		{
+			name:        "amounts are truncated",
+			rewardCoins: 10,
+			rewardShare: math.LegacyNewDecWithPrec(5, 1),
+			delegations: delegations,
+			expected: []*types.DelegatorReward{
+				{Delegator: "delegator1", Amount: 3},
+				{Delegator: "delegator2", Amount: 1},
+			},
+		},
+		{
+			name:        "no share",
+			rewardCoins: 1000,
+			rewardShare: math.LegacyZeroDec(),
+			delegations: delegations,
+		},
+		{
+			name:        "unset share",
+			rewardCoins: 1000,
+			rewardShare: math.LegacyDec{},
+			delegations: delegations,
+		},
+		{
+			name:        "no delegations",
+			rewardCoins: 1000,
+			rewardShare: math.LegacyOneDec(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rewards := keeper.SplitDelegatorRewards(tt.rewardCoins, tt.rewardShare, tt.delegations)
+			require.Equal(t, tt.expected, rewards)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k Keeper) SetDeveloperSubKey(ctx context.Context, subKey types.DeveloperSubKey) error {
+	return k.DeveloperSubKeyMap.Set(ctx, collections.Join(subKey.Developer, subKey.Name), subKey)
+}
+
+func (k Keeper) GetDeveloperSubKey(ctx context.Context, developer string, name string) (types.DeveloperSubKey, bool) {
+	subKey, err := k.DeveloperSubKeyMap.Get(ctx, collections.Join(developer, name))
+	if err != nil {
+		return types.DeveloperSubKey{}, false
+	}
+	return subKey, true
+}
+
+func (k Keeper) GetDeveloperSubKeys(ctx context.Context, developer string) ([]types.DeveloperSubKey, error) {
+	iter, err := k.DeveloperSubKeyMap.Iterate(ctx, collections.NewPrefixedPairRange[string, string](developer))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// developerSubKeyPubKey returns the public key the developer signature is checked against, empty
+// for requests signed with the developer's account key. Revoked keys still verify, so inferences
+// started before the revocation can finish.
+func (k Keeper) developerSubKeyPubKey(ctx context.Context, developer string, name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	subKey, found := k.GetDeveloperSubKey(ctx, developer, name)
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrDeveloperSubKeyNotFound, "%s/%s", developer, name)
+	}
+	return subKey.PubKey, nil
+}
+
+// chargeDeveloperSubKey counts escrow taken for an inference against the epoch budget of its sub-key
+func (k Keeper) chargeDeveloperSubKey(ctx context.Context, inference *types.Inference, amount int64) error {
+	if inference.SubKey == "" {
+		return nil
+	}
+	subKey, found := k.GetDeveloperSubKey(ctx, inference.RequestedBy, inference.SubKey)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDeveloperSubKeyNotFound, "%s/%s", inference.RequestedBy, inference.SubKey)
+	}
+	epochIndex, _ := k.GetEffectiveEpochIndex(ctx)
+	if err := subKey.Charge(epochIndex, uint64(amount)); err != nil {
+		return err
+	}
+	inference.SubKeyEpoch = epochIndex
+	return k.SetDeveloperSubKey(ctx, subKey)
+}
+
+// ReleaseDeveloperSubKey gives refunded escrow back to the sub-key budget of the epoch it was charged in
+func (k Keeper) ReleaseDeveloperSubKey(ctx context.Context, inference *types.Inference, amount uint64) {
+	if inference.SubKey == "" {
+		return
+	}
+	subKey, found := k.GetDeveloperSubKey(ctx, inference.RequestedBy, inference.SubKey)
+	if !found {
+		return
+	}
+	subKey.Release(inference.SubKeyEpoch, amount)
+	if err := k.SetDeveloperSubKey(ctx, subKey); err != nil {
+		k.LogError("Unable to release developer sub-key spending", types.Payments, "inferenceId", inference.InferenceId, "error", err)
+	}
+}
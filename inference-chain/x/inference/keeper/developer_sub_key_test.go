@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMsgServer_StartInferenceWithDeveloperSubKey(t *testing.T) {
+	h, k, ctx := NewMockInferenceHelper(t)
+	h.Mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	h.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), h.MockTransferAgent.GetBechAddress()).Return(h.MockTransferAgent).AnyTimes()
+	h.Mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	subKeyAccount := NewMockAccount(testutil.Requester)
+	_, err := h.MessageServer.CreateDeveloperSubKey(ctx, &types.MsgCreateDeveloperSubKey{
+		Creator:          h.MockRequester.address,
+		Name:             "service-a",
+		PubKey:           base64.StdEncoding.EncodeToString(subKeyAccount.GetPubKey().Bytes()),
+		MaxCoinsPerEpoch: 1500 * calculations.PerTokenCost,
+		AllowedModels:    []string{"model1"},
+		MaxTokens:        1000,
+	})
+	require.NoError(t, err)
+
+	requestTimestamp := ctx.BlockTime().UnixNano()
+	start := func(signer *MockAccount, payload string, model string, maxTokens uint64) (string, error) {
+		components := calculations.SignatureComponents{
+			Payload:         payload,
+			Timestamp:       requestTimestamp,
+			TransferAddress: h.MockTransferAgent.address,
+			ExecutorAddress: h.MockExecutor.address,
+		}
+		inferenceId, err := calculations.Sign(signer, components, calculations.Developer)
+		require.NoError(t, err)
+		taSignature, err := calculations.Sign(h.MockTransferAgent, components, calculations.TransferAgent)
+		require.NoError(t, err)
+		_, err = h.MessageServer.StartInference(ctx, &types.MsgStartInference{
+			InferenceId:       inferenceId,
+			PromptHash:        "promptHash",
+			PromptPayload:     payload,
+			OriginalPrompt:    payload,
+			RequestedBy:       h.MockRequester.address,
+			Creator:           h.MockTransferAgent.address,
+			Model:             model,
+			MaxTokens:         maxTokens,
+			RequestTimestamp:  requestTimestamp,
+			TransferSignature: taSignature,
+			AssignedTo:        h.MockExecutor.address,
+			SubKey:            "service-a",
+		})
+		return inferenceId, err
+	}
+
+	// The request must be signed with the sub-key, not the account key
+	_, err = start(h.MockRequester, "prompt0", "model1", 1000)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	_, err = start(subKeyAccount, "prompt1", "model2", 1000)
+	require.ErrorIs(t, err, types.ErrDeveloperSubKeyNotAllowed)
+	_, err = start(subKeyAccount, "prompt2", "model1", 2000)
+	require.ErrorIs(t, err, types.ErrDeveloperSubKeyNotAllowed)
+	// No max_tokens means the default, above the cap
+	_, err = start(subKeyAccount, "prompt3", "model1", 0)
+	require.ErrorIs(t, err, types.ErrDeveloperSubKeyNotAllowed)
+
+	inferenceId, err := start(subKeyAccount, "prompt4", "model1", 1000)
+	require.NoError(t, err)
+	inference, found := k.GetInference(ctx, inferenceId)
+	require.True(t, found)
+	require.Equal(t, "service-a", inference.SubKey)
+	subKey, found := k.GetDeveloperSubKey(ctx, h.MockRequester.address, "service-a")
+	require.True(t, found)
+	require.Equal(t, uint64(inference.EscrowAmount), subKey.SpentCoins)
+	require.Equal(t, subKey.SpentEpoch, inference.SubKeyEpoch)
+
+	// A refund is given back to the epoch the escrow was charged in, not to a later one
+	later := inference
+	later.SubKeyEpoch = subKey.SpentEpoch + 1
+	k.ReleaseDeveloperSubKey(ctx, &later, uint64(inference.EscrowAmount))
+	subKey, _ = k.GetDeveloperSubKey(ctx, h.MockRequester.address, "service-a")
+	require.Equal(t, uint64(inference.EscrowAmount), subKey.SpentCoins)
+
+	// Over the epoch budget
+	_, err = start(subKeyAccount, "prompt5", "model1", 1000)
+	require.ErrorIs(t, err, types.ErrDeveloperSubKeyNotAllowed)
+
+	_, err = h.MessageServer.RevokeDeveloperSubKey(ctx, &types.MsgRevokeDeveloperSubKey{Creator: h.MockRequester.address, Name: "service-a"})
+	require.NoError(t, err)
+	_, err = start(subKeyAccount, "prompt6", "model1", 10)
+	require.ErrorIs(t, err, types.ErrDeveloperSubKeyNotAllowed)
+
+	// Names are not reused
+	_, err = h.MessageServer.CreateDeveloperSubKey(ctx, &types.MsgCreateDeveloperSubKey{
+		Creator: h.MockRequester.address,
+		Name:    "service-a",
+		PubKey:  base64.StdEncoding.EncodeToString(subKeyAccount.GetPubKey().Bytes()),
+	})
+	require.ErrorIs(t, err, types.ErrDeveloperSubKeyNotAllowed)
+}
+
+func TestDeveloperSubKey_ChargeAndRelease(t *testing.T) {
+	subKey := types.DeveloperSubKey{Name: "service-a", MaxCoinsPerEpoch: 100}
+	require.NoError(t, subKey.Charge(1, 60))
+	require.Error(t, subKey.Charge(1, 50))
+	subKey.Release(1, 20)
+	require.NoError(t, subKey.Charge(1, 50))
+	require.Equal(t, uint64(90), subKey.SpentIn(1))
+
+	// A new epoch starts from zero and refunds of the old one don't count
+	require.Equal(t, uint64(0), subKey.SpentIn(2))
+	require.NoError(t, subKey.Charge(2, 100))
+	subKey.Release(1, 100)
+	require.Equal(t, uint64(100), subKey.SpentIn(2))
+}
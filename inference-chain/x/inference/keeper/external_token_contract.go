@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/store/prefix"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,12 +44,17 @@ const (
 	TokenCodeIDKey         = "TokenCodeID"
 )
 
+// externalTokenContractKey is the store key of a token contract mapping. Origin chain addresses are
+// case-insensitive hex, so the key is lowercased to map every spelling to the same CW20 token.
+func externalTokenContractKey(externalChain, externalContract string) []byte {
+	return []byte(TokenContractKeyPrefix + strings.ToLower(externalChain) + "/" + strings.ToLower(externalContract))
+}
+
 // GetExternalTokenContract retrieves a token contract mapping
 func (k Keeper) GetExternalTokenContract(ctx sdk.Context, externalChain, externalContract string) (types.ExternalTokenContract, bool) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	key := []byte(TokenContractKeyPrefix + externalChain + "/" + externalContract)
 
-	bz := store.Get(key)
+	bz := store.Get(externalTokenContractKey(externalChain, externalContract))
 	if bz == nil {
 		return types.ExternalTokenContract{}, false
 	}
@@ -61,10 +67,49 @@ func (k Keeper) GetExternalTokenContract(ctx sdk.Context, externalChain, externa
 // SetExternalTokenContract stores a token contract mapping
 func (k Keeper) SetExternalTokenContract(ctx sdk.Context, contract types.ExternalTokenContract) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	key := []byte(TokenContractKeyPrefix + contract.ExternalChain + "/" + contract.ExternalContract)
+	contract.ExternalChain = strings.ToLower(contract.ExternalChain)
+	contract.ExternalContract = strings.ToLower(contract.ExternalContract)
 
 	bz := k.cdc.MustMarshal(&contract)
-	store.Set(key, bz)
+	store.Set(externalTokenContractKey(contract.ExternalChain, contract.ExternalContract), bz)
+}
+
+// MigrateExternalTokenContractKeys re-keys the token contract mappings stored before keys were
+// lowercased. A legacy mapping whose lowercase key is already taken is left in place and reported,
+// its CW20 token can't be merged into the other one.
+func (k Keeper) MigrateExternalTokenContractKeys(ctx sdk.Context) (int, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	contractStore := prefix.NewStore(store, []byte(TokenContractKeyPrefix))
+
+	var legacyKeys [][]byte
+	iterator := contractStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if string(key) != strings.ToLower(string(key)) {
+			legacyKeys = append(legacyKeys, append([]byte{}, key...))
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, key := range legacyKeys {
+		var contract types.ExternalTokenContract
+		if err := k.cdc.Unmarshal(contractStore.Get(key), &contract); err != nil {
+			return migrated, err
+		}
+		if contractStore.Has([]byte(strings.ToLower(string(key)))) {
+			k.LogError("Token contract mapping conflicts with an existing lowercase mapping, keeping it under its legacy key", types.Upgrades,
+				"key", string(key),
+				"cw20Contract", contract.Cw20Contract)
+			continue
+		}
+		contractStore.Delete(key)
+		k.SetExternalTokenContract(ctx, contract)
+		migrated++
+	}
+	return migrated, nil
 }
 
 // GetTokenCodeID retrieves the stored CW20 code ID, uploading code if needed
@@ -253,3 +298,59 @@ func (k Keeper) handleCompletedBridgeTransaction(ctx sdk.Context, bridgeTx *type
 
 	return nil
 }
+
+// BurnTokens burns tokens from the owner's balance, the owner must have signed the transaction
+// or be this module's account
+func (k Keeper) BurnTokens(ctx sdk.Context, contractAddr string, owner string, amount string) error {
+	burnMsg := struct {
+		Burn struct {
+			Amount string `json:"amount"`
+		} `json:"burn"`
+	}{}
+	burnMsg.Burn.Amount = amount
+
+	return k.executeCw20(ctx, contractAddr, owner, burnMsg)
+}
+
+// TransferTokens moves tokens from the sender's balance, the sender must have signed the transaction
+// or be this module's account
+func (k Keeper) TransferTokens(ctx sdk.Context, contractAddr string, sender string, recipient string, amount string) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return fmt.Errorf("invalid cosmos address: %v", err)
+	}
+
+	transferMsg := struct {
+		Transfer struct {
+			Recipient string `json:"recipient"`
+			Amount    string `json:"amount"`
+		} `json:"transfer"`
+	}{}
+	transferMsg.Transfer.Recipient = recipient
+	transferMsg.Transfer.Amount = amount
+
+	return k.executeCw20(ctx, contractAddr, sender, transferMsg)
+}
+
+// executeCw20 executes a CW20 message on behalf of the sender, the message acts on the sender's balance
+func (k Keeper) executeCw20(ctx sdk.Context, contractAddr string, sender string, msg any) error {
+	wasmKeeper := wasmkeeper.NewDefaultPermissionKeeper(k.GetWasmKeeper())
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return fmt.Errorf("invalid cosmos address: %v", err)
+	}
+
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = wasmKeeper.Execute(
+		ctx,
+		sdk.MustAccAddressFromBech32(strings.ToLower(contractAddr)),
+		senderAddr,
+		msgBz,
+		sdk.NewCoins(),
+	)
+	return err
+}
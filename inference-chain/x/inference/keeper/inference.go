@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// inferenceEpochKey is the (epoch, status) key of the inferences by epoch index
+type inferenceEpochKey = collections.Pair[uint64, int32]
+
+var inferenceEpochKeyCodec = collections.PairKeyCodec(collections.Uint64Key, collections.Int32Key)
+
 // SetInference set a specific inference in the store from its index
 func (k Keeper) SetInference(ctx context.Context, inference types.Inference) {
 	// store via collections
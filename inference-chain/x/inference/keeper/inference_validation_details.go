@@ -28,6 +28,22 @@ func (k Keeper) GetInferenceValidationDetails(
 	return v, true
 }
 
+// recordValidationDiagnostics keeps the validator's diagnostics next to the details the inference was sampled
+// with, so the reasons for a verdict can be looked up later. Validators can't set the verdict themselves.
+func (k Keeper) recordValidationDiagnostics(ctx context.Context, inference types.Inference, msg *types.MsgValidation, passed bool) {
+	details, found := k.GetInferenceValidationDetails(ctx, inference.EpochId, inference.InferenceId)
+	if !found {
+		k.LogWarn("No validation details to record diagnostics in", types.Validation, "inferenceId", inference.InferenceId, "epochId", inference.EpochId)
+		return
+	}
+	diagnostics := *msg.Diagnostics
+	diagnostics.Validator = msg.Creator
+	diagnostics.Value = msg.Value
+	diagnostics.Passed = passed
+	details.Validations = append(details.Validations, &diagnostics)
+	k.SetInferenceValidationDetails(ctx, details)
+}
+
 // RemoveInferenceValidationDetails removes an InferenceValidationDetails by (epochId, inferenceId)
 func (k Keeper) RemoveInferenceValidationDetails(
 	ctx context.Context,
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -13,6 +15,25 @@ import (
 )
 
 type (
+	// InferenceIndexes groups the secondary indexes of the Inferences map
+	InferenceIndexes struct {
+		// ByEpoch indexes inferences by epoch and status, so old epochs can be walked
+		// without reading inferences that are still pending
+		ByEpoch *indexes.Multi[inferenceEpochKey, string, types.Inference]
+	}
+
+	// SettleAmountIndexes groups the secondary indexes of the SettleAmounts map
+	SettleAmountIndexes struct {
+		// ByEpoch indexes settle amounts by their epoch, so expired ones are found without reading the rest
+		ByEpoch *indexes.Multi[uint64, settleAmountKey, types.SettleAmount]
+	}
+
+	// BridgeBlockAttestationIndexes groups the secondary indexes of the BridgeBlockAttestations map
+	BridgeBlockAttestationIndexes struct {
+		// ByHeight indexes votes by the height they were first cast at, so stale ones are pruned oldest first
+		ByHeight *indexes.Multi[int64, bridgeBlockAttestationKey, types.BridgeBlockHeader]
+	}
+
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService store.KVStoreService
@@ -43,19 +64,37 @@ type (
 		ModelCapacityMap     collections.Map[string, uint64]
 		// Governance models
 		Models                        collections.Map[string, types.Model]
-		Inferences                    collections.Map[string, types.Inference]
+		Inferences                    *collections.IndexedMap[string, types.Inference, InferenceIndexes]
+		InferencePruningCutoff        collections.Item[uint64]
+		InferencePruningCursor        collections.Item[collections.Pair[inferenceEpochKey, string]]
 		InferenceTimeouts             collections.Map[collections.Pair[uint64, string], types.InferenceTimeout]
 		InferenceValidationDetailsMap collections.Map[collections.Pair[uint64, string], types.InferenceValidationDetails]
 		UnitOfComputePriceProposals   collections.Map[string, types.UnitOfComputePriceProposal]
 		EpochGroupDataMap             collections.Map[collections.Pair[uint64, string], types.EpochGroupData]
 		// Epoch collections
-		Epochs                    collections.Map[uint64, types.Epoch]
-		EffectiveEpochIndex       collections.Item[uint64]
-		EpochGroupValidationsMap  collections.Map[collections.Pair[uint64, string], types.EpochGroupValidations]
-		SettleAmounts             collections.Map[sdk.AccAddress, types.SettleAmount]
+		Epochs                   collections.Map[uint64, types.Epoch]
+		EffectiveEpochIndex      collections.Item[uint64]
+		EpochGroupValidationsMap collections.Map[collections.Pair[uint64, string], types.EpochGroupValidations]
+		SettleAmounts            *collections.IndexedMap[settleAmountKey, types.SettleAmount, SettleAmountIndexes]
+		// legacySettleAmounts holds the single settle amount per participant stored before amounts were kept per epoch
+		legacySettleAmounts       collections.Map[sdk.AccAddress, types.SettleAmount]
 		TopMiners                 collections.Map[sdk.AccAddress, types.TopMiner]
 		PartialUpgrades           collections.Map[uint64, types.PartialUpgrade]
 		EpochPerformanceSummaries collections.Map[collections.Pair[sdk.AccAddress, uint64], types.EpochPerformanceSummary]
+		// Outbound bridge
+		BridgeWithdrawals        collections.Map[uint64, types.BridgeWithdrawal]
+		BridgeWithdrawalSequence collections.Sequence
+		// PendingBridgeWithdrawals holds the ids of withdrawals whose tokens are still in escrow, with
+		// the number of times settling them failed
+		PendingBridgeWithdrawals collections.Map[uint64, uint32]
+		// BridgeWithdrawalCursor is the id the next block resumes checking pending withdrawals after
+		BridgeWithdrawalCursor collections.Item[uint64]
+		// BridgeBlockAttestations collects the votes for each receipts root of an origin chain block,
+		// BridgeBlockHeaders holds the receipts root a majority agreed on
+		BridgeBlockAttestations *collections.IndexedMap[bridgeBlockAttestationKey, types.BridgeBlockHeader, BridgeBlockAttestationIndexes]
+		BridgeBlockHeaders      collections.Map[collections.Pair[string, string], types.BridgeBlockHeader]
+		// DeveloperSubKeyMap is keyed by developer address and key name
+		DeveloperSubKeyMap collections.Map[collections.Pair[string, string], types.DeveloperSubKey]
 	}
 )
 
@@ -151,12 +190,36 @@ func NewKeeper(
 			codec.CollValue[types.Model](cdc),
 		),
 		// inferences map
-		Inferences: collections.NewMap(
+		Inferences: collections.NewIndexedMap(
 			sb,
 			types.InferencesPrefix,
 			"inferences",
 			collections.StringKey,
 			codec.CollValue[types.Inference](cdc),
+			InferenceIndexes{
+				ByEpoch: indexes.NewMulti(
+					sb,
+					types.InferencesByEpochPrefix,
+					"inferences_by_epoch",
+					inferenceEpochKeyCodec,
+					collections.StringKey,
+					func(_ string, inference types.Inference) (inferenceEpochKey, error) {
+						return collections.Join(inference.EpochId, int32(inference.Status)), nil
+					},
+				),
+			},
+		),
+		InferencePruningCutoff: collections.NewItem(
+			sb,
+			types.InferencePruningCutoffPrefix,
+			"inference_pruning_cutoff",
+			collections.Uint64Value,
+		),
+		InferencePruningCursor: collections.NewItem(
+			sb,
+			types.InferencePruningCursorPrefix,
+			"inference_pruning_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(inferenceEpochKeyCodec, collections.StringKey)),
 		),
 		// unit of compute price proposals map
 		UnitOfComputePriceProposals: collections.NewMap(
@@ -208,7 +271,26 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
 			codec.CollValue[types.EpochGroupValidations](cdc),
 		),
-		SettleAmounts: collections.NewMap(
+		SettleAmounts: collections.NewIndexedMap(
+			sb,
+			types.SettleAmountsByEpochPrefix,
+			"settle_amount_by_epoch",
+			settleAmountKeyCodec,
+			codec.CollValue[types.SettleAmount](cdc),
+			SettleAmountIndexes{
+				ByEpoch: indexes.NewMulti(
+					sb,
+					types.SettleAmountsEpochIndexPrefix,
+					"settle_amounts_by_epoch_index",
+					collections.Uint64Key,
+					settleAmountKeyCodec,
+					func(key settleAmountKey, _ types.SettleAmount) (uint64, error) {
+						return key.K2(), nil
+					},
+				),
+			},
+		),
+		legacySettleAmounts: collections.NewMap(
 			sb,
 			types.SettleAmountPrefix,
 			"settle_amount",
@@ -236,6 +318,64 @@ func NewKeeper(
 			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
 			codec.CollValue[types.EpochPerformanceSummary](cdc),
 		),
+		BridgeWithdrawals: collections.NewMap(
+			sb,
+			types.BridgeWithdrawalsPrefix,
+			"bridge_withdrawal",
+			collections.Uint64Key,
+			codec.CollValue[types.BridgeWithdrawal](cdc),
+		),
+		BridgeWithdrawalSequence: collections.NewSequence(
+			sb,
+			types.BridgeWithdrawalSequencePrefix,
+			"bridge_withdrawal_sequence",
+		),
+		PendingBridgeWithdrawals: collections.NewMap(
+			sb,
+			types.PendingBridgeWithdrawalsPrefix,
+			"pending_bridge_withdrawal",
+			collections.Uint64Key,
+			collections.Uint32Value,
+		),
+		BridgeWithdrawalCursor: collections.NewItem(
+			sb,
+			types.BridgeWithdrawalCursorPrefix,
+			"bridge_withdrawal_cursor",
+			collections.Uint64Value,
+		),
+		BridgeBlockAttestations: collections.NewIndexedMap(
+			sb,
+			types.BridgeBlockAttestationsPrefix,
+			"bridge_block_attestation",
+			bridgeBlockAttestationKeyCodec,
+			codec.CollValue[types.BridgeBlockHeader](cdc),
+			BridgeBlockAttestationIndexes{
+				ByHeight: indexes.NewMulti(
+					sb,
+					types.BridgeAttestationsByHeightPrefix,
+					"bridge_block_attestations_by_height",
+					collections.Int64Key,
+					bridgeBlockAttestationKeyCodec,
+					func(_ bridgeBlockAttestationKey, attestation types.BridgeBlockHeader) (int64, error) {
+						return attestation.AttestedAtBlockHeight, nil
+					},
+				),
+			},
+		),
+		BridgeBlockHeaders: collections.NewMap(
+			sb,
+			types.BridgeBlockHeadersPrefix,
+			"bridge_block_header",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.BridgeBlockHeader](cdc),
+		),
+		DeveloperSubKeyMap: collections.NewMap(
+			sb,
+			types.DeveloperSubKeysPrefix,
+			"developer_sub_key",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.DeveloperSubKey](cdc),
+		),
 	}
 	// Build the collections schema
 	schema, err := sb.Build()
@@ -246,6 +386,10 @@ func NewKeeper(
 	return k
 }
 
+func (i InferenceIndexes) IndexesList() []collections.Index[string, types.Inference] {
+	return []collections.Index[string, types.Inference]{i.ByEpoch}
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
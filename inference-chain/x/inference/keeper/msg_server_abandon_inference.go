@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// AbandonInference releases a started inference whose executor failed before responding.
+// The escrow goes back to the requester and the inference is reset so the transfer agent can
+// start it again with another executor. A signed finish from the abandoned executor still
+// overrides the abandon until then, so its missed request is only counted once the inference
+// is settled by someone else or expires.
+func (k msgServer) AbandonInference(goCtx context.Context, msg *types.MsgAbandonInference) (*types.MsgAbandonInferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	inference, found := k.GetInference(ctx, msg.InferenceId)
+	if !found {
+		k.LogError("AbandonInference: inference not found", types.Inferences, "inferenceId", msg.InferenceId)
+		return nil, errorsmod.Wrapf(types.ErrInferenceNotFound, "inference with id %s not found", msg.InferenceId)
+	}
+
+	if msg.Creator != inference.TransferredBy {
+		k.LogError("AbandonInference: only the transfer agent can abandon an inference", types.Inferences,
+			"inferenceId", msg.InferenceId, "expected", inference.TransferredBy, "got", msg.Creator)
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid transfer agent; expected %s, got %s", inference.TransferredBy, msg.Creator)
+	}
+
+	if inference.Status != types.InferenceStatus_STARTED || inference.FinishedProcessed() {
+		k.LogWarn("AbandonInference: inference is not waiting for its executor", types.Inferences,
+			"inferenceId", msg.InferenceId, "status", inference.Status, "executedBy", inference.ExecutedBy)
+		return nil, errorsmod.Wrapf(types.ErrIllegalState, "inference %s can't be abandoned in status %s", msg.InferenceId, inference.Status)
+	}
+
+	k.LogInfo("Inference abandoned by transfer agent", types.Inferences,
+		"inferenceId", msg.InferenceId, "executor", inference.AssignedTo, "reason", msg.Reason)
+
+	if inference.EscrowAmount > 0 {
+		err := k.IssueRefund(ctx, uint64(inference.EscrowAmount), inference.RequestedBy, "abandoned_inference:"+inference.InferenceId)
+		if err != nil {
+			k.LogError("AbandonInference: unable to issue refund", types.Payments, "inferenceId", msg.InferenceId, "error", err)
+			return nil, err
+		}
+		k.ReleaseDeveloperSubKey(ctx, &inference, uint64(inference.EscrowAmount))
+	}
+
+	expirationBlocks := k.GetParams(ctx).ValidationParams.ExpirationBlocks
+	k.RemoveInferenceTimeout(ctx, uint64(inference.StartBlockHeight+expirationBlocks), inference.InferenceId)
+
+	// Only what identifies the request is kept, so a restart or a finish from the next executor
+	// is processed as if this was the first attempt. The abandon gets its own timeout, so it
+	// expires if nobody takes it over.
+	abandoned := types.Inference{
+		Index:              inference.Index,
+		InferenceId:        inference.InferenceId,
+		Status:             types.InferenceStatus_ABANDONED,
+		Model:              inference.Model,
+		Kind:               inference.Kind,
+		RequestedBy:        inference.RequestedBy,
+		TransferredBy:      inference.TransferredBy,
+		RequestTimestamp:   inference.RequestTimestamp,
+		PerTokenPrice:      inference.PerTokenPrice,
+		MaxTokens:          inference.MaxTokens,
+		PromptTokenCount:   inference.PromptTokenCount,
+		StartBlockHeight:   ctx.BlockHeight(),
+		AbandonedExecutors: append(inference.AbandonedExecutors, inference.AssignedTo),
+	}
+	k.SetInference(ctx, abandoned)
+	k.addTimeout(ctx, &abandoned)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_abandoned",
+			sdk.NewAttribute("inference_id", inference.InferenceId),
+			sdk.NewAttribute("executor", inference.AssignedTo),
+			sdk.NewAttribute("reason", msg.Reason),
+		),
+	)
+
+	return &types.MsgAbandonInferenceResponse{}, nil
+}
+
+// ChargeAbandonedExecutors counts a missed request for every executor that abandoned the
+// inference and never finished it. It is called once the inference is settled or expires.
+func (k Keeper) ChargeAbandonedExecutors(ctx context.Context, inference *types.Inference) {
+	for _, address := range inference.AbandonedExecutors {
+		executor, found := k.GetParticipant(ctx, address)
+		if !found {
+			k.LogWarn("ChargeAbandonedExecutors: abandoned executor not found", types.Inferences,
+				"inferenceId", inference.InferenceId, "executor", address)
+			continue
+		}
+		executor.CurrentEpochStats.MissedRequests++
+		k.SetParticipant(ctx, executor)
+	}
+}
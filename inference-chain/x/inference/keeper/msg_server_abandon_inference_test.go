@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMsgServer_AbandonInference(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	requestTimestamp := inferenceHelper.context.BlockTime().UnixNano()
+
+	ctx, err := advanceEpoch(ctx, &k, inferenceHelper.Mocks, 10, 1)
+	require.NoError(t, err)
+
+	modelId := "model1"
+	model := types.Model{Id: modelId}
+	k.SetModel(ctx, &model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, &model)
+
+	started, err := inferenceHelper.StartInference("promptPayload", modelId, requestTimestamp, keeper.DefaultMaxTokens)
+	require.NoError(t, err)
+
+	_, err = inferenceHelper.MessageServer.AbandonInference(ctx, &types.MsgAbandonInference{
+		Creator:     testutil.Requester,
+		InferenceId: started.InferenceId,
+		Reason:      "timeout",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	inferenceHelper.Mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	_, err = inferenceHelper.MessageServer.AbandonInference(ctx, &types.MsgAbandonInference{
+		Creator:     started.TransferredBy,
+		InferenceId: started.InferenceId,
+		Reason:      "timeout",
+	})
+	require.NoError(t, err)
+
+	abandoned, found := k.GetInference(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_ABANDONED, abandoned.Status)
+	require.Equal(t, []string{testutil.Executor}, abandoned.AbandonedExecutors)
+	require.Zero(t, abandoned.EscrowAmount)
+	require.False(t, abandoned.StartProcessed())
+
+	// The miss isn't counted yet, the abandon expires on its own timeout unless someone settles it
+	executor, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Zero(t, executor.CurrentEpochStats.MissedRequests)
+	expirationBlocks := k.GetParams(ctx).ValidationParams.ExpirationBlocks
+	_, found = k.GetInferenceTimeout(ctx, uint64(ctx.BlockHeight()+expirationBlocks), started.InferenceId)
+	require.True(t, found)
+
+	// The inference can't be abandoned twice
+	_, err = inferenceHelper.MessageServer.AbandonInference(ctx, &types.MsgAbandonInference{
+		Creator:     started.TransferredBy,
+		InferenceId: started.InferenceId,
+	})
+	require.ErrorIs(t, err, types.ErrIllegalState)
+
+	inferenceHelper.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), inferenceHelper.MockRequester.GetBechAddress()).Return(inferenceHelper.MockRequester).AnyTimes()
+	inferenceHelper.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), inferenceHelper.MockExecutor.GetBechAddress()).Return(inferenceHelper.MockExecutor).AnyTimes()
+	components := calculations.SignatureComponents{
+		Payload:         started.PromptPayload,
+		Timestamp:       started.RequestTimestamp,
+		TransferAddress: started.TransferredBy,
+		ExecutorAddress: started.AssignedTo,
+	}
+	executorSignature, err := calculations.Sign(inferenceHelper.MockExecutor, components, calculations.ExecutorAgent)
+	require.NoError(t, err)
+	finish := &types.MsgFinishInference{
+		InferenceId:          started.InferenceId,
+		ResponseHash:         "responseHash",
+		ResponsePayload:      "responsePayload",
+		PromptTokenCount:     10,
+		CompletionTokenCount: 20,
+		ExecutedBy:           started.AssignedTo,
+		TransferredBy:        started.TransferredBy,
+		RequestTimestamp:     started.RequestTimestamp,
+		TransferSignature:    started.TransferSignature,
+		ExecutorSignature:    executorSignature,
+		RequestedBy:          started.RequestedBy,
+		OriginalPrompt:       started.OriginalPrompt,
+		Model:                modelId,
+	}
+
+	// Once another executor has taken over, the abandoned executor can't finish the inference
+	takenOver := abandoned
+	takenOver.Status = types.InferenceStatus_STARTED
+	takenOver.AssignedTo = testutil.Executor2
+	k.SetInference(ctx, takenOver)
+	_, err = inferenceHelper.MessageServer.FinishInference(ctx, finish)
+	require.ErrorIs(t, err, types.ErrInferenceAbandoned)
+	// An earlier executor also abandoned the inference and is charged once it is settled
+	k.SetParticipant(ctx, types.Participant{Index: testutil.Executor2, Address: testutil.Executor2, CurrentEpochStats: &types.CurrentEpochStats{}})
+	abandoned.AbandonedExecutors = []string{testutil.Executor2, testutil.Executor}
+	k.SetInference(ctx, abandoned)
+
+	// Otherwise its signed finish proves the request was served and overrides the abandon
+	inferenceHelper.Mocks.BankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		Return(nil)
+	_, err = inferenceHelper.MessageServer.FinishInference(ctx, finish)
+	require.NoError(t, err)
+
+	finished, found := k.GetInference(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_FINISHED, finished.Status)
+	require.Equal(t, testutil.Executor, finished.ExecutedBy)
+	require.Equal(t, []string{testutil.Executor2}, finished.AbandonedExecutors)
+	require.Positive(t, finished.ActualCost)
+	require.Equal(t, finished.ActualCost, finished.EscrowAmount)
+
+	executor, found = k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Zero(t, executor.CurrentEpochStats.MissedRequests)
+	require.Equal(t, finished.ActualCost, executor.CoinBalance)
+
+	earlierExecutor, found := k.GetParticipant(ctx, testutil.Executor2)
+	require.True(t, found)
+	require.Equal(t, uint64(1), earlierExecutor.CurrentEpochStats.MissedRequests)
+}
+
+func TestMsgServer_FinishAfterAbandonIsCappedAtEscrow(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	requestTimestamp := inferenceHelper.context.BlockTime().UnixNano()
+
+	ctx, err := advanceEpoch(ctx, &k, inferenceHelper.Mocks, 10, 1)
+	require.NoError(t, err)
+
+	modelId := "model1"
+	model := types.Model{Id: modelId}
+	k.SetModel(ctx, &model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, &model)
+
+	const maxTokens = 50
+	started, err := inferenceHelper.StartInference("promptPayload", modelId, requestTimestamp, maxTokens)
+	require.NoError(t, err)
+
+	inferenceHelper.Mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	_, err = inferenceHelper.MessageServer.AbandonInference(ctx, &types.MsgAbandonInference{
+		Creator:     started.TransferredBy,
+		InferenceId: started.InferenceId,
+		Reason:      "timeout",
+	})
+	require.NoError(t, err)
+
+	abandoned, found := k.GetInference(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, uint64(maxTokens), abandoned.MaxTokens)
+
+	inferenceHelper.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), inferenceHelper.MockRequester.GetBechAddress()).Return(inferenceHelper.MockRequester).AnyTimes()
+	inferenceHelper.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), inferenceHelper.MockExecutor.GetBechAddress()).Return(inferenceHelper.MockExecutor).AnyTimes()
+	components := calculations.SignatureComponents{
+		Payload:         started.PromptPayload,
+		Timestamp:       started.RequestTimestamp,
+		TransferAddress: started.TransferredBy,
+		ExecutorAddress: started.AssignedTo,
+	}
+	executorSignature, err := calculations.Sign(inferenceHelper.MockExecutor, components, calculations.ExecutorAgent)
+	require.NoError(t, err)
+
+	// The executor reports far more tokens than the requester reserved
+	inferenceHelper.Mocks.BankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		Return(nil)
+	_, err = inferenceHelper.MessageServer.FinishInference(ctx, &types.MsgFinishInference{
+		InferenceId:          started.InferenceId,
+		ResponseHash:         "responseHash",
+		ResponsePayload:      "responsePayload",
+		PromptTokenCount:     10_000,
+		CompletionTokenCount: 1_000_000,
+		ExecutedBy:           started.AssignedTo,
+		TransferredBy:        started.TransferredBy,
+		RequestTimestamp:     started.RequestTimestamp,
+		TransferSignature:    started.TransferSignature,
+		ExecutorSignature:    executorSignature,
+		RequestedBy:          started.RequestedBy,
+		OriginalPrompt:       started.OriginalPrompt,
+		Model:                modelId,
+	})
+	require.NoError(t, err)
+
+	finished, found := k.GetInference(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_FINISHED, finished.Status)
+	require.Equal(t, started.EscrowAmount, finished.ActualCost)
+	require.Equal(t, started.EscrowAmount, finished.EscrowAmount)
+
+	executor, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Equal(t, started.EscrowAmount, executor.CoinBalance)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -11,6 +12,18 @@ import (
 func (k msgServer) AssignTrainingTask(goCtx context.Context, msg *types.MsgAssignTrainingTask) (*types.MsgAssignTrainingTaskResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	// Only the assigner holding the claim may pick the nodes that get paid out of the escrow
+	if task.Assigner == "" || task.Assigner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "training task %d is claimed by %q, not %s", msg.TaskId, task.Assigner, msg.Creator)
+	}
+	if task.AssignedAtBlockHeight != 0 && len(task.ReplacementResources) > 0 {
+		return k.assignReplacementNodes(ctx, msg)
+	}
+
 	err := k.StartTask(ctx, msg.TaskId, msg.Assignees)
 	if err != nil {
 		k.LogError("MsgAssignTrainingTask: failed to StartTask", types.Training, "error", err)
@@ -27,3 +40,19 @@ func (k msgServer) AssignTrainingTask(goCtx context.Context, msg *types.MsgAssig
 
 	return &types.MsgAssignTrainingTaskResponse{}, nil
 }
+
+func (k msgServer) assignReplacementNodes(ctx sdk.Context, msg *types.MsgAssignTrainingTask) (*types.MsgAssignTrainingTaskResponse, error) {
+	if err := k.AddTaskAssignees(ctx, msg.TaskId, msg.Assignees); err != nil {
+		k.LogError("MsgAssignTrainingTask: failed to add replacement nodes", types.Training, "error", err)
+		return nil, err
+	}
+
+	k.LogInfo("MsgAssignTrainingTask: replacement nodes assigned", types.Training, "taskId", msg.TaskId, "assignees", msg.Assignees)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_task_nodes_replaced",
+			sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
+		),
+	)
+	return &types.MsgAssignTrainingTaskResponse{}, nil
+}
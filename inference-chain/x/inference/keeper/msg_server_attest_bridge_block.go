@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) AttestBridgeBlock(goCtx context.Context, msg *types.MsgAttestBridgeBlock) (*types.MsgAttestBridgeBlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.LogInfo("Bridge block: Processing attestation", types.Messages,
+		"validator", msg.Validator,
+		"originChain", msg.OriginChain,
+		"blockNumber", msg.BlockNumber,
+		"receiptsRoot", msg.ReceiptsRoot)
+
+	header, err := k.Keeper.AttestBridgeBlock(ctx, msg.Validator, msg.OriginChain, msg.BlockNumber, strings.ToLower(msg.ReceiptsRoot))
+	if err != nil {
+		k.LogError("Bridge block: Attestation rejected", types.Messages,
+			"validator", msg.Validator,
+			"originChain", msg.OriginChain,
+			"blockNumber", msg.BlockNumber,
+			"error", err)
+		return nil, err
+	}
+
+	return &types.MsgAttestBridgeBlockResponse{
+		Status: header.Status,
+	}, nil
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/ethproof"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	depositResultMinted           = "minted"
+	depositResultAlreadyProcessed = "already processed"
+)
+
+// BRIDGE_DEPOSIT_EVENT is the topic of the event the bridge contract emits for every deposit:
+// BridgeDeposit(address indexed token, address indexed sender, uint256 amount, address recipient),
+// recipient being the 20-byte account on this chain.
+var BRIDGE_DEPOSIT_EVENT = ethproof.Keccak256([]byte("BridgeDeposit(address,address,uint256,address)"))
+
+// BridgeDeposit is a deposit read from a proven receipt
+type BridgeDeposit struct {
+	Token     string
+	Amount    string
+	Recipient sdk.AccAddress
+}
+
+func (k msgServer) BridgeDepositProof(goCtx context.Context, msg *types.MsgBridgeDepositProof) (*types.MsgBridgeDepositProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.LogInfo("Bridge deposit proof: Processing receipts", types.Messages,
+		"creator", msg.Creator,
+		"originChain", msg.OriginChain,
+		"blockNumber", msg.BlockNumber,
+		"receipts", len(msg.Receipts))
+
+	header, found := k.GetBridgeBlockHeader(ctx, msg.OriginChain, msg.BlockNumber)
+	if !found {
+		return nil, fmt.Errorf("block %s on %s has no attested receipts root", msg.BlockNumber, msg.OriginChain)
+	}
+	receiptsRoot, err := hex.DecodeString(strings.TrimPrefix(header.ReceiptsRoot, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid attested receipts root: %v", err)
+	}
+	bridgeContract, found := k.GetBridgeParams(ctx).ContractFor(msg.OriginChain)
+	if !found {
+		return nil, fmt.Errorf("no bridge contract configured for %s", msg.OriginChain)
+	}
+	bridgeAddress, err := hex.DecodeString(strings.TrimPrefix(bridgeContract, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid bridge contract address: %v", err)
+	}
+
+	results := make([]types.BridgeDepositResult, 0, len(msg.Receipts))
+	for _, receiptProof := range msg.Receipts {
+		receiptIndex, err := strconv.ParseUint(receiptProof.ReceiptIndex, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid receipt index %s: %v", receiptProof.ReceiptIndex, err)
+		}
+		if err := ethproof.VerifyReceiptProof(receiptsRoot, receiptIndex, receiptProof.Receipt, receiptProof.Proof); err != nil {
+			k.LogError("Bridge deposit proof: Invalid receipt proof", types.Messages,
+				"originChain", msg.OriginChain,
+				"blockNumber", msg.BlockNumber,
+				"receiptIndex", receiptProof.ReceiptIndex,
+				"error", err)
+			return nil, fmt.Errorf("invalid proof for receipt %s: %v", receiptProof.ReceiptIndex, err)
+		}
+		deposit, err := ParseBridgeDeposit(receiptProof.Receipt, bridgeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("receipt %s: %v", receiptProof.ReceiptIndex, err)
+		}
+
+		// The proof is for the parsed index, so its canonical spelling keys the stored deposit
+		result, err := k.completeProvenDeposit(ctx, msg, header, strconv.FormatUint(receiptIndex, 10), deposit)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return &types.MsgBridgeDepositProofResponse{
+		Results: results,
+	}, nil
+}
+
+func (k msgServer) completeProvenDeposit(
+	ctx sdk.Context,
+	msg *types.MsgBridgeDepositProof,
+	header types.BridgeBlockHeader,
+	receiptIndex string,
+	deposit BridgeDeposit,
+) (types.BridgeDepositResult, error) {
+	// The deposit may already be known from attestations of the receipt, the proof completes it
+	bridgeTx, found := k.GetBridgeTransaction(ctx, msg.OriginChain, msg.BlockNumber, receiptIndex)
+	if found && bridgeTx.Status == types.BridgeTransactionStatus_BRIDGE_COMPLETED {
+		return types.BridgeDepositResult{
+			ReceiptIndex: receiptIndex,
+			Id:           bridgeTx.Id,
+			Result:       depositResultAlreadyProcessed,
+		}, nil
+	}
+	recipient := deposit.Recipient.String()
+	if !found {
+		bridgeTx = &types.BridgeTransaction{
+			OriginChain:  msg.OriginChain,
+			BlockNumber:  msg.BlockNumber,
+			ReceiptIndex: receiptIndex,
+		}
+	} else if !strings.EqualFold(bridgeTx.ContractAddress, deposit.Token) || bridgeTx.OwnerAddress != recipient || bridgeTx.Amount != deposit.Amount {
+		// The proof completes the deposit still being attested. Votes for other contents don't
+		// support what is minted, so they are dropped rather than kept on the completed transaction.
+		k.LogWarn("Bridge deposit proof: Pending attestations disagree with the proven deposit", types.Messages,
+			"originChain", msg.OriginChain,
+			"blockNumber", msg.BlockNumber,
+			"receiptIndex", receiptIndex,
+			"attestedToken", bridgeTx.ContractAddress,
+			"attestedRecipient", bridgeTx.OwnerAddress,
+			"attestedAmount", bridgeTx.Amount,
+			"validators", bridgeTx.Validators)
+		bridgeTx.Validators = nil
+		bridgeTx.ValidationCount = 0
+	}
+	bridgeTx.ContractAddress = deposit.Token
+	bridgeTx.OwnerAddress = recipient
+	bridgeTx.Recipient = recipient
+	bridgeTx.Amount = deposit.Amount
+	bridgeTx.ReceiptsRoot = header.ReceiptsRoot
+	bridgeTx.BlockHeight = ctx.BlockHeight()
+	bridgeTx.Timestamp = ctx.BlockTime().Unix()
+	bridgeTx.Status = types.BridgeTransactionStatus_BRIDGE_COMPLETED
+
+	if err := k.handleCompletedBridgeTransaction(ctx, bridgeTx); err != nil {
+		k.LogError("Bridge deposit proof: Failed to handle completed bridge transaction", types.Messages,
+			"error", err,
+			"originChain", msg.OriginChain,
+			"blockNumber", msg.BlockNumber,
+			"receiptIndex", receiptIndex)
+		return types.BridgeDepositResult{}, err
+	}
+	k.SetBridgeTransaction(ctx, bridgeTx)
+
+	k.LogInfo("Bridge deposit proof: Deposit proven", types.Messages,
+		"originChain", msg.OriginChain,
+		"blockNumber", msg.BlockNumber,
+		"receiptIndex", receiptIndex,
+		"token", deposit.Token,
+		"recipient", recipient,
+		"amount", deposit.Amount)
+
+	return types.BridgeDepositResult{
+		ReceiptIndex: receiptIndex,
+		Id:           bridgeTx.Id,
+		Result:       depositResultMinted,
+	}, nil
+}
+
+// ParseBridgeDeposit reads the deposit of a successful receipt, which must carry exactly one
+// BridgeDeposit event of the bridge contract
+func ParseBridgeDeposit(receipt []byte, bridgeAddress []byte) (BridgeDeposit, error) {
+	succeeded, logs, err := ethproof.DecodeReceipt(receipt)
+	if err != nil {
+		return BridgeDeposit{}, err
+	}
+	if !succeeded {
+		return BridgeDeposit{}, fmt.Errorf("transaction failed")
+	}
+
+	var depositLog *ethproof.Log
+	for i, log := range logs {
+		if !bytes.Equal(log.Address, bridgeAddress) || len(log.Topics) == 0 || !bytes.Equal(log.Topics[0], BRIDGE_DEPOSIT_EVENT) {
+			continue
+		}
+		if depositLog != nil {
+			return BridgeDeposit{}, fmt.Errorf("more than one deposit in the receipt")
+		}
+		depositLog = &logs[i]
+	}
+	if depositLog == nil {
+		return BridgeDeposit{}, fmt.Errorf("no deposit of the bridge contract in the receipt")
+	}
+	if len(depositLog.Topics) != 3 || len(depositLog.Topics[1]) != 32 || len(depositLog.Data) != 64 {
+		return BridgeDeposit{}, fmt.Errorf("malformed deposit event")
+	}
+	// Addresses are left padded to 32 bytes, anything else in the padding is not an address
+	if !isZeroPadding(depositLog.Topics[1][:12]) || !isZeroPadding(depositLog.Data[32:44]) {
+		return BridgeDeposit{}, fmt.Errorf("malformed deposit event: address padding is not zero")
+	}
+
+	amount := new(big.Int).SetBytes(depositLog.Data[:32])
+	if amount.Sign() <= 0 {
+		return BridgeDeposit{}, fmt.Errorf("deposit amount must be > 0")
+	}
+	return BridgeDeposit{
+		Token:     "0x" + hex.EncodeToString(depositLog.Topics[1][12:]),
+		Amount:    amount.String(),
+		Recipient: sdk.AccAddress(depositLog.Data[44:64]),
+	}, nil
+}
+
+func isZeroPadding(padding []byte) bool {
+	for _, b := range padding {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -117,7 +117,8 @@ func (k msgServer) BridgeExchange(goCtx context.Context, msg *types.MsgBridgeExc
 		// Check if we have majority
 		requiredValidators := (len(activeParticipants.Participants) * 2) / 3
 
-		if existingTx.ValidationCount >= uint32(requiredValidators) {
+		// A transaction completed by an earlier majority or by a receipt proof is not minted again
+		if existingTx.Status != types.BridgeTransactionStatus_BRIDGE_COMPLETED && existingTx.ValidationCount >= uint32(requiredValidators) {
 			existingTx.Status = types.BridgeTransactionStatus_BRIDGE_COMPLETED
 
 			// Handle token minting for completed transaction
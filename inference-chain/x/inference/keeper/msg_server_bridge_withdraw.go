@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) BridgeWithdraw(goCtx context.Context, msg *types.MsgBridgeWithdraw) (*types.MsgBridgeWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.LogInfo("Bridge withdraw: Processing withdrawal request", types.Messages,
+		"creator", msg.Creator,
+		"destinationChain", msg.DestinationChain,
+		"contractAddress", msg.ContractAddress,
+		"recipient", msg.Recipient,
+		"amount", msg.Amount)
+
+	// Only tokens that were bridged in can be withdrawn, they are held as the CW20 token of their contract
+	tokenContract, found := k.GetExternalTokenContract(ctx, msg.DestinationChain, msg.ContractAddress)
+	if !found {
+		k.LogError("Bridge withdraw: No bridged token for contract", types.Messages,
+			"destinationChain", msg.DestinationChain,
+			"contractAddress", msg.ContractAddress)
+		return nil, fmt.Errorf("no bridged token for contract %s on %s", msg.ContractAddress, msg.DestinationChain)
+	}
+
+	epochId, err := k.bridgeSigningEpoch(ctx)
+	if err != nil {
+		k.LogError("Bridge withdraw: Unable to find a signing epoch", types.Messages, "error", err)
+		return nil, err
+	}
+
+	// Tokens are only burned once the withdrawal is signed, until then the module holds them
+	moduleAddress := k.AccountKeeper.GetModuleAddress(types.ModuleName).String()
+	if err := k.TransferTokens(ctx, tokenContract.Cw20Contract, msg.Creator, moduleAddress, msg.Amount); err != nil {
+		k.LogError("Bridge withdraw: Failed to escrow bridged tokens", types.Messages,
+			"creator", msg.Creator,
+			"cw20Contract", tokenContract.Cw20Contract,
+			"error", err)
+		return nil, fmt.Errorf("failed to escrow tokens: %v", err)
+	}
+
+	id, err := k.BridgeWithdrawalSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	withdrawal := types.BridgeWithdrawal{
+		Id:               id,
+		Creator:          msg.Creator,
+		// The canonical spelling is signed, the bridge contract hashes the chain name as registered
+		DestinationChain: tokenContract.ExternalChain,
+		ContractAddress:  msg.ContractAddress,
+		Recipient:        msg.Recipient,
+		Amount:           msg.Amount,
+		BlockHeight:      ctx.BlockHeight(),
+		Timestamp:        ctx.BlockTime().Unix(),
+		EpochId:          epochId,
+		Status:           types.BridgeWithdrawalStatus_WITHDRAWAL_PENDING,
+		Cw20Contract:     tokenContract.Cw20Contract,
+	}
+
+	signingData, err := BridgeWithdrawalSigningData(ctx.ChainID(), ctx.TxBytes(), withdrawal)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.BlsKeeper.RequestThresholdSignature(ctx, signingData); err != nil {
+		k.LogError("Bridge withdraw: Failed to request threshold signature", types.Messages,
+			"withdrawalId", id,
+			"epochId", epochId,
+			"error", err)
+		return nil, fmt.Errorf("failed to request threshold signature: %v", err)
+	}
+	withdrawal.RequestId = signingData.RequestId
+
+	if err := k.SetBridgeWithdrawal(ctx, withdrawal); err != nil {
+		return nil, err
+	}
+	if err := k.PendingBridgeWithdrawals.Set(ctx, id, 0); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"bridge_withdrawal",
+			sdk.NewAttribute("withdrawal_id", strconv.FormatUint(id, 10)),
+			sdk.NewAttribute("request_id", hex.EncodeToString(signingData.RequestId)),
+			sdk.NewAttribute("destination_chain", withdrawal.DestinationChain),
+			sdk.NewAttribute("recipient", msg.Recipient),
+			sdk.NewAttribute("amount", msg.Amount),
+		),
+	)
+
+	k.LogInfo("Bridge withdraw: Withdrawal created, awaiting threshold signature", types.Messages,
+		"withdrawalId", id,
+		"epochId", epochId,
+		"requestId", hex.EncodeToString(signingData.RequestId))
+
+	return &types.MsgBridgeWithdrawResponse{
+		Id:        id,
+		RequestId: signingData.RequestId,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) CancelTrainingTask(goCtx context.Context, msg *types.MsgCancelTrainingTask) (*types.MsgCancelTrainingTaskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.RequestedBy != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "only the requester can cancel the task; expected %s, got %s", task.RequestedBy, msg.Creator)
+	}
+
+	refundedBefore := task.Refunded
+	if err := k.Keeper.CancelTrainingTask(ctx, task); err != nil {
+		k.LogError("MsgCancelTrainingTask: failed to cancel task", types.Training, "taskId", msg.TaskId, "error", err)
+		return nil, err
+	}
+
+	return &types.MsgCancelTrainingTaskResponse{
+		Refunded: task.Refunded - refundedBefore,
+	}, nil
+}
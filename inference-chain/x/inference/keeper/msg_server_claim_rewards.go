@@ -17,26 +17,51 @@ import (
 
 func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.claimRewards(ctx, msg), nil
+}
+
+// BatchClaimRewards claims several epochs in one transaction. Every claim is settled on its own, so
+// a failed claim does not hold back the others.
+func (k msgServer) BatchClaimRewards(goCtx context.Context, msg *types.MsgBatchClaimRewards) (*types.MsgBatchClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	response := &types.MsgBatchClaimRewardsResponse{
+		Results: make([]types.RewardClaimResult, 0, len(msg.Claims)),
+	}
+	for _, claim := range msg.Claims {
+		result := k.claimRewards(ctx, &types.MsgClaimRewards{
+			Creator:    msg.Creator,
+			Seed:       claim.Seed,
+			EpochIndex: claim.EpochIndex,
+		})
+		response.Amount += result.Amount
+		response.Results = append(response.Results, types.RewardClaimResult{
+			EpochIndex: claim.EpochIndex,
+			Amount:     result.Amount,
+			Result:     result.Result,
+		})
+	}
+	return response, nil
+}
 
+func (k msgServer) claimRewards(ctx sdk.Context, msg *types.MsgClaimRewards) *types.MsgClaimRewardsResponse {
 	settleAmount, response := k.validateRequest(ctx, msg)
 	if response != nil {
-		return response, nil
+		return response
 	}
 
 	response, err := k.validateClaim(ctx, msg, settleAmount)
 	if err != nil {
 		k.LogError("Claim validation failed", types.Claims, "error", err, "account", msg.Creator)
-		return response, nil
+		return response
 	}
 	k.LogDebug("Claim verified", types.Claims, "account", msg.Creator, "seed", msg.Seed)
 
 	response, err = k.payoutClaim(ctx, msg, settleAmount)
 	if err != nil {
 		k.LogError("Claim payout failed", types.Claims, "error", err, "account", msg.Creator)
-		return response, nil
 	}
-
-	return response, nil
+	return response
 }
 
 func (ms msgServer) payoutClaim(ctx sdk.Context, msg *types.MsgClaimRewards, settleAmount *types.SettleAmount) (*types.MsgClaimRewardsResponse, error) {
@@ -63,9 +88,13 @@ func (ms msgServer) payoutClaim(ctx sdk.Context, msg *types.MsgClaimRewards, set
 	}
 	ms.AddTokenomicsData(ctx, &types.TokenomicsData{TotalFees: settleAmount.GetWorkCoins()})
 
-	// Pay rewards from module
+	// Pay rewards from module, the delegators of the participant's collateral first
 	rewardVestingPeriod := &params.TokenomicsParams.RewardVestingPeriod
-	if err := ms.PayParticipantFromModule(ctx, msg.Creator, settleAmount.GetRewardCoins(), types.ModuleName, "reward_coins:"+settleAmount.Participant, rewardVestingPeriod); err != nil {
+	participantRewardCoins, err := ms.payDelegatorRewards(ctx, settleAmount, rewardVestingPeriod)
+	if err == nil {
+		err = ms.PayParticipantFromModule(ctx, msg.Creator, participantRewardCoins, types.ModuleName, "reward_coins:"+settleAmount.Participant, rewardVestingPeriod)
+	}
+	if err != nil {
 		if sdkerrors.ErrInsufficientFunds.Is(err) {
 			ms.LogError("Insufficient funds for paying rewards. Work paid, rewards declined", types.Claims, "error", err, "settleAmount", settleAmount)
 		} else {
@@ -80,7 +109,7 @@ func (ms msgServer) payoutClaim(ctx sdk.Context, msg *types.MsgClaimRewards, set
 
 	ms.finishSettle(ctx, settleAmount)
 	return &types.MsgClaimRewardsResponse{
-		Amount: settleAmount.GetTotalCoins(),
+		Amount: settleAmount.GetWorkCoins() + participantRewardCoins,
 		Result: "Rewards claimed successfully",
 	}, nil
 }
@@ -103,7 +132,7 @@ func (ms msgServer) parseBalanceError(errMsg string) (spendable int64, required
 }
 
 func (ms msgServer) finishSettle(ctx sdk.Context, settleAmount *types.SettleAmount) {
-	ms.RemoveSettleAmount(ctx, settleAmount.Participant)
+	ms.RemoveSettleAmount(ctx, settleAmount.Participant, settleAmount.EpochIndex)
 	perfSummary, found := ms.GetEpochPerformanceSummary(ctx, settleAmount.EpochIndex, settleAmount.Participant)
 	if found {
 		perfSummary.Claimed = true
@@ -112,16 +141,16 @@ func (ms msgServer) finishSettle(ctx sdk.Context, settleAmount *types.SettleAmou
 }
 
 func (k msgServer) validateRequest(ctx sdk.Context, msg *types.MsgClaimRewards) (*types.SettleAmount, *types.MsgClaimRewardsResponse) {
-	settleAmount, found := k.GetSettleAmount(ctx, msg.Creator)
+	settleAmount, found := k.GetSettleAmount(ctx, msg.Creator, msg.EpochIndex)
 	if !found {
-		k.LogDebug("SettleAmount not found for address", types.Claims, "address", msg.Creator)
-		return nil, &types.MsgClaimRewardsResponse{
-			Amount: 0,
-			Result: "No rewards for this address",
+		if len(k.GetParticipantSettleAmounts(ctx, msg.Creator)) == 0 {
+			k.LogDebug("SettleAmount not found for address", types.Claims, "address", msg.Creator)
+			return nil, &types.MsgClaimRewardsResponse{
+				Amount: 0,
+				Result: "No rewards for this address",
+			}
 		}
-	}
-	if settleAmount.EpochIndex != msg.EpochIndex {
-		k.LogDebug("SettleAmount does not match epoch index", types.Claims, "epoch", msg.EpochIndex, "settleEpoch", settleAmount.EpochIndex)
+		k.LogDebug("SettleAmount not found for epoch index", types.Claims, "address", msg.Creator, "epoch", msg.EpochIndex)
 		return nil, &types.MsgClaimRewardsResponse{
 			Amount: 0,
 			Result: "No rewards for this block height",
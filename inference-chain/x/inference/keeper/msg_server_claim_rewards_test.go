@@ -155,7 +155,7 @@ func TestMsgServer_ClaimRewards(t *testing.T) {
 	require.Equal(t, "Rewards claimed successfully", resp.Result)
 
 	// Verify the settle amount was removed
-	_, found := k.GetSettleAmount(sdk.UnwrapSDKContext(ctx), testutil.Creator)
+	_, found := k.GetSettleAmount(sdk.UnwrapSDKContext(ctx), testutil.Creator, epochIndex)
 	require.False(t, found)
 
 	// Verify the performance summary was updated
@@ -164,6 +164,60 @@ func TestMsgServer_ClaimRewards(t *testing.T) {
 	require.True(t, updatedPerfSummary.Claimed)
 }
 
+func TestMsgServer_ClaimRewards_PaysDelegators(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+
+	mockAccount := NewMockAccount(testutil.Creator)
+	seedBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seedBytes, 1)
+	signature, err := mockAccount.key.Sign(seedBytes)
+	require.NoError(t, err)
+
+	epochIndex := uint64(100)
+	epoch := types.Epoch{Index: epochIndex, PocStartBlockHeight: 1000}
+	k.SetEpoch(ctx, &epoch)
+	k.SetEffectiveEpochIndex(ctx, epoch.Index)
+	delegator1 := sdk.MustAccAddressFromBech32(testutil.Requester)
+	delegator2 := sdk.MustAccAddressFromBech32(testutil.Executor)
+	// The delegators' part of the 500 reward coins was split at settlement
+	k.SetSettleAmount(sdk.UnwrapSDKContext(ctx), types.SettleAmount{
+		Participant:   testutil.Creator,
+		EpochIndex:    epochIndex,
+		WorkCoins:     1000,
+		RewardCoins:   500,
+		SeedSignature: hex.EncodeToString(signature),
+		DelegatorRewards: []*types.DelegatorReward{
+			{Delegator: delegator1.String(), Amount: 75},
+			{Delegator: delegator2.String(), Amount: 25},
+		},
+	})
+	k.SetEpochGroupData(sdk.UnwrapSDKContext(ctx), types.EpochGroupData{
+		EpochIndex:          epoch.Index,
+		EpochGroupId:        100,
+		PocStartBlockHeight: epochIndex,
+		ValidationWeights:   []*types.ValidationWeight{{MemberAddress: testutil.Creator, Weight: 10}},
+	})
+
+	addr, err := sdk.AccAddressFromBech32(testutil.Creator)
+	require.NoError(t, err)
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(mockAccount).AnyTimes()
+	mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addr, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1000)), gomock.Any()).Return(nil)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delegator1, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 75)), "delegator_reward_coins:"+testutil.Creator).Return(nil)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delegator2, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 25)), "delegator_reward_coins:"+testutil.Creator).Return(nil)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addr, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 400)), "reward_coins:"+testutil.Creator).Return(nil)
+
+	resp, err := ms.ClaimRewards(ctx, &types.MsgClaimRewards{
+		Creator:    testutil.Creator,
+		EpochIndex: epochIndex,
+		Seed:       1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Rewards claimed successfully", resp.Result)
+	require.Equal(t, uint64(1400), resp.Amount)
+}
+
 func TestMsgServer_ClaimRewards_NoRewards(t *testing.T) {
 	_, ms, ctx := setupMsgServer(t)
 
@@ -235,6 +289,33 @@ func TestMsgServer_ClaimRewards_ZeroRewards(t *testing.T) {
 	require.Equal(t, "No rewards for this address", resp.Result)
 }
 
+func TestMsgServer_BatchClaimRewards_ResultPerEpoch(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	// Only epoch 100 has a settle amount, and it is empty
+	k.SetSettleAmount(sdk.UnwrapSDKContext(ctx), types.SettleAmount{
+		Participant:   testutil.Creator,
+		EpochIndex:    100,
+		SeedSignature: "0102030405060708",
+	})
+
+	resp, err := ms.BatchClaimRewards(ctx, &types.MsgBatchClaimRewards{
+		Creator: testutil.Creator,
+		Claims: []types.RewardClaim{
+			{Seed: 1, EpochIndex: 100},
+			{Seed: 2, EpochIndex: 101},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Amount)
+	require.Len(t, resp.Results, 2)
+	require.Equal(t, uint64(100), resp.Results[0].EpochIndex)
+	require.Equal(t, "No rewards for this address", resp.Results[0].Result)
+	require.Equal(t, uint64(101), resp.Results[1].EpochIndex)
+	require.Equal(t, "No rewards for this block height", resp.Results[1].Result)
+}
+
 // TestMsgServer_ClaimRewards_ValidationLogic tests the validation logic in ClaimRewards
 // It specifically tests that the right inferences are identified as "must be validated"
 // based on the seed, validator power, etc.
@@ -441,7 +522,7 @@ func TestMsgServer_ClaimRewards_ValidationLogic(t *testing.T) {
 	require.Equal(t, "Rewards claimed successfully", resp.Result)
 
 	// Verify the settle amount was removed
-	_, found := k.GetSettleAmount(sdkCtx, testutil.Creator)
+	_, found := k.GetSettleAmount(sdkCtx, testutil.Creator, epochIndex)
 	require.False(t, found)
 
 	// Verify the performance summary was updated
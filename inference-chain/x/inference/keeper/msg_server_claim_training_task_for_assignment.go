@@ -3,14 +3,11 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
-// FIXME: move to chain params?
-// Number of blocks an assinger has to finish the assignment process
-const TrainingTaskAssignmentDeadline = 100
-
 // TODO: ideally this should check that one participant can't claim more than 1 task at a time
 func (k msgServer) ClaimTrainingTaskForAssignment(goCtx context.Context, msg *types.MsgClaimTrainingTaskForAssignment) (*types.MsgClaimTrainingTaskForAssignmentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -20,18 +17,27 @@ func (k msgServer) ClaimTrainingTaskForAssignment(goCtx context.Context, msg *ty
 		return nil, types.ErrTrainingTaskNotFound
 	}
 
-	if task.AssignedAtBlockHeight != 0 {
+	if task.FinishedAtBlockHeight != 0 {
+		return nil, types.ErrTrainingTaskFinished
+	}
+	// An assigned task is claimed again only to replace the nodes it lost
+	if task.AssignedAtBlockHeight != 0 && len(task.ReplacementResources) == 0 {
 		return nil, types.ErrTrainingTaskAlreadyAssigned
 	}
+	// and only by the assigner that started it
+	if task.AssignedAtBlockHeight != 0 && task.Assigner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "training task %d is assigned by %q, not %s", msg.TaskId, task.Assigner, msg.Creator)
+	}
 
-	blockHeight := uint64(ctx.BlockHeight())
-	blocksSinceAssignment := task.ClaimedByAssignerAtBlockHeight - blockHeight
-	if task.Assigner != "" && blocksSinceAssignment < TrainingTaskAssignmentDeadline {
+	blockHeight := ctx.BlockHeight()
+	blocksSinceClaim := blockHeight - int64(task.ClaimedByAssignerAtBlockHeight)
+	assignmentDeadline := k.GetParams(ctx).TrainingParamsOrDefault().AssignmentDeadlineBlocks
+	if task.Assigner != "" && blocksSinceClaim <= assignmentDeadline {
 		return nil, types.ErrTrainingTaskAlreadyAssigned
 	}
 
 	task.Assigner = msg.Creator
-	task.ClaimedByAssignerAtBlockHeight = blockHeight
+	task.ClaimedByAssignerAtBlockHeight = uint64(blockHeight)
 	k.SetTrainingTask(ctx, task)
 
 	return &types.MsgClaimTrainingTaskForAssignmentResponse{}, nil
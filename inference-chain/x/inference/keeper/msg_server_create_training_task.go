@@ -22,9 +22,16 @@ func (k msgServer) CreateTrainingTask(goCtx context.Context, msg *types.MsgCreat
 		HardwareResources:     msg.HardwareResources,
 		Config:                msg.Config,
 		Epoch:                 training.NewEmptyEpochInfo(),
+		Budget:                msg.Budget,
+		OuterStepPayout:       msg.OuterStepPayout,
 	}
 
-	err := k.CreateTask(ctx, task)
+	err := k.EscrowTrainingBudget(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.CreateTask(ctx, task)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) CreateDeveloperSubKey(goCtx context.Context, msg *types.MsgCreateDeveloperSubKey) (*types.MsgCreateDeveloperSubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Names are never reused, so inferences in flight keep verifying against the key they were signed with
+	if _, found := k.GetDeveloperSubKey(ctx, msg.Creator, msg.Name); found {
+		return nil, sdkerrors.Wrapf(types.ErrDeveloperSubKeyNotAllowed, "sub-key %s already exists", msg.Name)
+	}
+
+	subKey := types.DeveloperSubKey{
+		Developer:        msg.Creator,
+		Name:             msg.Name,
+		PubKey:           msg.PubKey,
+		MaxCoinsPerEpoch: msg.MaxCoinsPerEpoch,
+		AllowedModels:    msg.AllowedModels,
+		MaxTokens:        msg.MaxTokens,
+		ExpiresAt:        msg.ExpiresAt,
+		CreatedAtHeight:  ctx.BlockHeight(),
+	}
+	if err := k.SetDeveloperSubKey(ctx, subKey); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Developer sub-key created", types.Participants, "developer", msg.Creator, "name", msg.Name,
+		"maxCoinsPerEpoch", msg.MaxCoinsPerEpoch, "allowedModels", msg.AllowedModels, "maxTokens", msg.MaxTokens, "expiresAt", msg.ExpiresAt)
+	return &types.MsgCreateDeveloperSubKeyResponse{SubKey: &subKey}, nil
+}
+
+func (k msgServer) RevokeDeveloperSubKey(goCtx context.Context, msg *types.MsgRevokeDeveloperSubKey) (*types.MsgRevokeDeveloperSubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subKey, found := k.GetDeveloperSubKey(ctx, msg.Creator, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrDeveloperSubKeyNotFound, "%s/%s", msg.Creator, msg.Name)
+	}
+	subKey.Revoked = true
+	if err := k.SetDeveloperSubKey(ctx, subKey); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Developer sub-key revoked", types.Participants, "developer", msg.Creator, "name", msg.Name)
+	return &types.MsgRevokeDeveloperSubKeyResponse{}, nil
+}
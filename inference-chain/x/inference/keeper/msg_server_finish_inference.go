@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -33,7 +34,12 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 		return nil, sdkerrors.Wrap(types.ErrParticipantNotFound, msg.TransferredBy)
 	}
 
-	err := k.verifyFinishKeys(goCtx, msg, &transferAgent, &requestor, &executor)
+	devPubKey, err := k.developerSubKeyPubKey(ctx, msg.RequestedBy, msg.SubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.verifyFinishKeys(goCtx, msg, &transferAgent, &requestor, &executor, devPubKey)
 	if err != nil {
 		k.LogError("FinishInference: verifyKeys failed", types.Inferences, "error", err)
 		return nil, sdkerrors.Wrap(types.ErrInvalidSignature, err.Error())
@@ -49,6 +55,27 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 		return nil, sdkerrors.Wrap(types.ErrInferenceExpired, "inference has already expired")
 	}
 
+	// A finish signed by the transfer agent for this executor proves the request was served, so it
+	// overrides the abandon unless another executor has taken the inference over in the meantime.
+	overridesAbandon := false
+	if found && slices.Contains(existingInference.AbandonedExecutors, msg.ExecutedBy) {
+		if existingInference.Status != types.InferenceStatus_ABANDONED {
+			k.LogWarn("FinishInference: executor already abandoned this inference", types.Inferences,
+				"inferenceId", msg.InferenceId,
+				"executedBy", msg.ExecutedBy)
+			return nil, sdkerrors.Wrapf(types.ErrInferenceAbandoned, "executor %s was replaced", msg.ExecutedBy)
+		}
+		k.LogInfo("FinishInference: signed finish overrides the abandon", types.Inferences,
+			"inferenceId", msg.InferenceId,
+			"executedBy", msg.ExecutedBy)
+		overridesAbandon = true
+		existingInference.AbandonedExecutors = slices.DeleteFunc(existingInference.AbandonedExecutors, func(address string) bool {
+			return address == msg.ExecutedBy
+		})
+	}
+	// The first finish settles the inference, so executors that abandoned it can't finish it anymore
+	settlesAbandon := !existingInference.FinishedProcessed() && len(existingInference.AbandonedExecutors) > 0
+
 	// Record the current price only if this is the first message (StartInference not processed yet)
 	// This ensures consistent pricing regardless of message arrival order
 	if !existingInference.StartProcessed() {
@@ -70,13 +97,29 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 		BlockTimestamp: ctx.BlockTime().UnixMilli(),
 	}
 
+	// The finish may carry its own prompt count, the cap is what the requester agreed to at start
+	var abandonedEscrow int64
+	if overridesAbandon {
+		abandonedEscrow = calculations.CalculateEscrow(&existingInference, existingInference.PromptTokenCount)
+	}
 	inference, payments := calculations.ProcessFinishInference(&existingInference, msg, blockContext, k)
+	if overridesAbandon {
+		// The escrow was refunded by the abandon, so the requester pays for the served request now,
+		// but never more than the escrow it would have paid had the executor not been replaced
+		amountToPay := min(inference.ActualCost, abandonedEscrow)
+		inference.ActualCost = amountToPay
+		payments.EscrowAmount = amountToPay
+		payments.ExecutorPayment = amountToPay
+	}
 
 	finalInference, err := k.processInferencePayments(ctx, inference, payments)
 	if err != nil {
 		return nil, err
 	}
 	k.SetInference(ctx, *finalInference)
+	if settlesAbandon {
+		k.ChargeAbandonedExecutors(ctx, finalInference)
+	}
 	if existingInference.IsCompleted() {
 		err := k.handleInferenceCompleted(ctx, &existingInference)
 		if err != nil {
@@ -87,7 +130,7 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 	return &types.MsgFinishInferenceResponse{}, nil
 }
 
-func (k msgServer) verifyFinishKeys(ctx context.Context, msg *types.MsgFinishInference, transferAgent *types.Participant, requestor *types.Participant, executor *types.Participant) error {
+func (k msgServer) verifyFinishKeys(ctx context.Context, msg *types.MsgFinishInference, transferAgent *types.Participant, requestor *types.Participant, executor *types.Participant, devPubKey string) error {
 	components := getFinishSignatureComponents(msg)
 
 	// Create SignatureData with the necessary participants and signatures
@@ -98,6 +141,7 @@ func (k msgServer) verifyFinishKeys(ctx context.Context, msg *types.MsgFinishInf
 		Dev:               requestor,
 		TransferAgent:     transferAgent,
 		Executor:          executor,
+		DevPubKey:         devPubKey,
 	}
 
 	// Use the generic VerifyKeys function
@@ -112,7 +156,7 @@ func (k msgServer) verifyFinishKeys(ctx context.Context, msg *types.MsgFinishInf
 
 func getFinishSignatureComponents(msg *types.MsgFinishInference) calculations.SignatureComponents {
 	return calculations.SignatureComponents{
-		Payload:         msg.OriginalPrompt,
+		Payload:         msg.SignedPayload(),
 		Timestamp:       msg.RequestTimestamp,
 		TransferAddress: msg.TransferredBy,
 		ExecutorAddress: msg.ExecutedBy,
@@ -124,6 +168,7 @@ func (k msgServer) handleInferenceCompleted(ctx sdk.Context, existingInference *
 		sdk.NewEvent(
 			"inference_finished",
 			sdk.NewAttribute("inference_id", existingInference.InferenceId),
+			sdk.NewAttribute("requested_by", existingInference.RequestedBy),
 		),
 	)
 	effectiveEpoch, found := k.GetEffectiveEpoch(ctx)
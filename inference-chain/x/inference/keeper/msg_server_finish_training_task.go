@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) FinishTrainingTask(goCtx context.Context, msg *types.MsgFinishTrainingTask) (*types.MsgFinishTrainingTaskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.RequestedBy != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "only the requester can finish the task; expected %s, got %s", task.RequestedBy, msg.Creator)
+	}
+
+	refundedBefore := task.Refunded
+	if err := k.Keeper.FinishTrainingTask(ctx, task); err != nil {
+		k.LogError("MsgFinishTrainingTask: failed to finish task", types.Training, "taskId", msg.TaskId, "error", err)
+		return nil, err
+	}
+
+	return &types.MsgFinishTrainingTaskResponse{
+		Refunded: task.Refunded - refundedBefore,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,7 +44,7 @@ func (k msgServer) InvalidateInference(goCtx context.Context, msg *types.MsgInva
 	executor.Status = calculateStatus(k.Keeper.GetParams(goCtx).ValidationParams, executor)
 
 	// Check for a status transition and slash if necessary.
-	k.CheckAndSlashForInvalidStatus(goCtx, originalStatus, &executor)
+	k.CheckAndSlashForInvalidStatus(goCtx, originalStatus, &executor, k.invalidatingValidators(goCtx, inference))
 
 	k.SetInference(ctx, inference)
 	k.SetParticipant(ctx, executor)
@@ -66,7 +67,18 @@ func (k msgServer) markInferenceAsInvalid(executor *types.Participant, inference
 	err := k.IssueRefund(ctx, uint64(inference.ActualCost), payer.Address, "invalidated_inference:"+inference.InferenceId)
 	if err != nil {
 		k.LogError("Refund failed", types.Validation, "error", err)
+	} else {
+		k.ReleaseDeveloperSubKey(ctx, inference, uint64(inference.ActualCost))
 	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_invalidated",
+			sdk.NewAttribute("inference_id", inference.InferenceId),
+			sdk.NewAttribute("executor", executor.Address),
+			sdk.NewAttribute("requested_by", inference.RequestedBy),
+			sdk.NewAttribute("refund", strconv.FormatInt(inference.ActualCost, 10)),
+		),
+	)
 	k.LogInfo("Inference invalidated", types.Inferences, "inferenceId", inference.InferenceId, "executor", executor.Address, "actualCost", inference.ActualCost)
 	return nil
 }
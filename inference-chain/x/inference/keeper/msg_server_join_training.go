@@ -3,10 +3,13 @@ package keeper
 import (
 	"context"
 	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/productscience/inference/x/inference/training"
 	"github.com/productscience/inference/x/inference/types"
-	"strings"
 )
 
 func (k msgServer) JoinTraining(goCtx context.Context, msg *types.MsgJoinTraining) (*types.MsgJoinTrainingResponse, error) {
@@ -21,6 +24,31 @@ func (k msgServer) JoinTraining(goCtx context.Context, msg *types.MsgJoinTrainin
 	}
 
 	store := NewKeeperTrainingRunStore(k.Keeper)
+	task := store.GetRunState(ctx, msg.Req.RunId)
+	if task == nil {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.FinishedAtBlockHeight != 0 {
+		return nil, types.ErrTrainingTaskFinished
+	}
+	if !IsTrainingTaskAssignee(task, *nodeId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "node %s is not assigned to training task %d", msg.Req.NodeId, msg.Req.RunId)
+	}
+
+	previousOuterStep := int32(-1)
+	previousIsFinished := false
+	if task.Epoch != nil {
+		previousOuterStep = task.Epoch.LastEpoch
+		previousIsFinished = task.Epoch.LastEpochIsFinished
+	}
+	// Outer steps advance one at a time and only once the previous one is finished
+	if msg.Req.OuterStep > previousOuterStep+1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "outer step %d skips ahead of the last outer step %d", msg.Req.OuterStep, previousOuterStep)
+	}
+	if msg.Req.OuterStep > previousOuterStep && previousOuterStep >= 0 && !previousIsFinished {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "outer step %d is not finished yet", previousOuterStep)
+	}
+
 	runManager := training.NewRunManager(msg.Req.RunId, store, k)
 	err = runManager.Join(ctx, *nodeId, msg.Req.OuterStep, training.NewBlockInfo(ctx))
 	if err != nil {
@@ -28,6 +56,15 @@ func (k msgServer) JoinTraining(goCtx context.Context, msg *types.MsgJoinTrainin
 		return nil, err
 	}
 
+	// The first node joining the next outer step marks the previous one as completed
+	if msg.Req.OuterStep > previousOuterStep && previousOuterStep >= 0 {
+		task = store.GetRunState(ctx, msg.Req.RunId)
+		if err := k.PayTrainingMilestone(ctx, task, previousOuterStep); err != nil {
+			return nil, err
+		}
+		k.SetTrainingTask(ctx, task)
+	}
+
 	return &types.MsgJoinTrainingResponse{
 		Status: &types.MLNodeTrainStatus{
 			Status:      types.MLNodeTrainStatusEnum_OK,
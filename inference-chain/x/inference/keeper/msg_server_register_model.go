@@ -26,6 +26,7 @@ func (k msgServer) RegisterModel(goCtx context.Context, msg *types.MsgRegisterMo
 		VRam:                   msg.VRam,
 		ThroughputPerNonce:     msg.ThroughputPerNonce,
 		ValidationThreshold:    msg.ValidationThreshold,
+		ValidationStrategy:     msg.ValidationStrategy,
 	})
 
 	return &types.MsgRegisterModelResponse{}, nil
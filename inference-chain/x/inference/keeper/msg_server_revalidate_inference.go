@@ -42,5 +42,13 @@ func (k msgServer) RevalidateInference(goCtx context.Context, msg *types.MsgReva
 	k.LogInfo("Saving inference", types.Validation, "inferenceId", inference.InferenceId, "status", inference.Status, "authority", inference.ProposalDetails.PolicyAddress)
 	k.SetInference(ctx, inference)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_revalidated",
+			sdk.NewAttribute("inference_id", inference.InferenceId),
+			sdk.NewAttribute("executor", executor.Address),
+			sdk.NewAttribute("requested_by", inference.RequestedBy),
+		),
+	)
 	return &types.MsgRevalidateInferenceResponse{}, nil
 }
@@ -29,7 +29,12 @@ func (k msgServer) StartInference(goCtx context.Context, msg *types.MsgStartInfe
 	k.LogInfo("DevPubKey", types.Inferences, "DevPubKey", dev.WorkerPublicKey, "DevAddress", dev.Address)
 	k.LogInfo("TransferAgentPubKey", types.Inferences, "TransferAgentPubKey", transferAgent.WorkerPublicKey, "TransferAgentAddress", transferAgent.Address)
 
-	err := k.verifyKeys(ctx, msg, transferAgent, dev)
+	devPubKey, err := k.developerSubKeyPubKey(ctx, msg.RequestedBy, msg.SubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.verifyKeys(ctx, msg, transferAgent, dev, devPubKey)
 	if err != nil {
 		k.LogError("StartInference: verifyKeys failed", types.Inferences, "error", err)
 		return nil, sdkerrors.Wrap(types.ErrInvalidSignature, err.Error())
@@ -54,11 +59,24 @@ func (k msgServer) StartInference(goCtx context.Context, msg *types.MsgStartInfe
 		return nil, err
 	}
 
+	if msg.SubKey != "" {
+		subKey, _ := k.GetDeveloperSubKey(ctx, msg.RequestedBy, msg.SubKey)
+		if err := subKey.CheckRequest(ctx.BlockTime().Unix(), inference.Model, inference.MaxTokens); err != nil {
+			k.LogWarn("StartInference: request not allowed for developer sub-key", types.Inferences, "inferenceId", msg.InferenceId, "error", err)
+			return nil, err
+		}
+	}
+
 	finalInference, err := k.processInferencePayments(ctx, inference, payments)
 	if err != nil {
 		return nil, err
 	}
 	k.SetInference(ctx, *finalInference)
+	if found && existingInference.Status == types.InferenceStatus_ABANDONED {
+		// The restart replaces the timeout of the abandon
+		expirationBlocks := k.GetParams(ctx).ValidationParams.ExpirationBlocks
+		k.RemoveInferenceTimeout(ctx, uint64(existingInference.StartBlockHeight+expirationBlocks), inference.InferenceId)
+	}
 	k.addTimeout(ctx, inference)
 
 	if inference.IsCompleted() {
@@ -73,7 +91,7 @@ func (k msgServer) StartInference(goCtx context.Context, msg *types.MsgStartInfe
 	}, nil
 }
 
-func (k msgServer) verifyKeys(ctx context.Context, msg *types.MsgStartInference, agent types.Participant, dev types.Participant) error {
+func (k msgServer) verifyKeys(ctx context.Context, msg *types.MsgStartInference, agent types.Participant, dev types.Participant, devPubKey string) error {
 	components := getSignatureComponents(msg)
 
 	// Create SignatureData with the necessary participants and signatures
@@ -82,6 +100,7 @@ func (k msgServer) verifyKeys(ctx context.Context, msg *types.MsgStartInference,
 		TransferSignature: msg.TransferSignature,
 		Dev:               &dev,
 		TransferAgent:     &agent,
+		DevPubKey:         devPubKey,
 	}
 
 	// Use the generic VerifyKeys function
@@ -113,6 +132,9 @@ func (k msgServer) processInferencePayments(
 	payments *calculations.Payments,
 ) (*types.Inference, error) {
 	if payments.EscrowAmount > 0 {
+		if err := k.chargeDeveloperSubKey(ctx, inference, payments.EscrowAmount); err != nil {
+			return nil, err
+		}
 		escrowAmount, err := k.PutPaymentInEscrow(ctx, inference, payments.EscrowAmount)
 		if err != nil {
 			return nil, err
@@ -123,6 +145,8 @@ func (k msgServer) processInferencePayments(
 		err := k.IssueRefund(ctx, uint64(-payments.EscrowAmount), inference.RequestedBy, "inference_refund:"+inference.InferenceId)
 		if err != nil {
 			k.LogError("Unable to Issue Refund for started inference", types.Payments, err)
+		} else {
+			k.ReleaseDeveloperSubKey(ctx, inference, uint64(-payments.EscrowAmount))
 		}
 	}
 	if payments.ExecutorPayment > 0 {
@@ -144,7 +168,7 @@ func (k msgServer) processInferencePayments(
 
 func getSignatureComponents(msg *types.MsgStartInference) calculations.SignatureComponents {
 	return calculations.SignatureComponents{
-		Payload:         msg.OriginalPrompt,
+		Payload:         msg.SignedPayload(),
 		Timestamp:       msg.RequestTimestamp,
 		TransferAddress: msg.Creator,
 		ExecutorAddress: msg.AssignedTo,
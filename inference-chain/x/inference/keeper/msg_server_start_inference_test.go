@@ -5,7 +5,10 @@ import (
 	"github.com/productscience/inference/x/inference/keeper"
 	"testing"
 
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -76,5 +79,47 @@ func TestMsgServer_StartInferenceWithMaxTokens(t *testing.T) {
 	}, devStat)
 }
 
+func TestMsgServer_StartInferenceWithPayloadsOffChain(t *testing.T) {
+	h, k, ctx := NewMockInferenceHelper(t)
+	requestTimestamp := h.context.BlockTime().UnixNano()
+	h.Mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil)
+	h.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), h.MockRequester.GetBechAddress()).Return(h.MockRequester).AnyTimes()
+	h.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), h.MockTransferAgent.GetBechAddress()).Return(h.MockTransferAgent).AnyTimes()
+	h.Mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	// The developer and transfer agent sign the hash of the prompt instead of the prompt itself
+	originalPromptHash := "originalPromptHash"
+	components := calculations.SignatureComponents{
+		Payload:         originalPromptHash,
+		Timestamp:       requestTimestamp,
+		TransferAddress: h.MockTransferAgent.address,
+		ExecutorAddress: h.MockExecutor.address,
+	}
+	inferenceId, err := calculations.Sign(h.MockRequester, components, calculations.Developer)
+	require.NoError(t, err)
+	taSignature, err := calculations.Sign(h.MockTransferAgent, components, calculations.TransferAgent)
+	require.NoError(t, err)
+
+	_, err = h.MessageServer.StartInference(ctx, &types.MsgStartInference{
+		InferenceId:        inferenceId,
+		PromptHash:         "promptHash",
+		RequestedBy:        h.MockRequester.address,
+		Creator:            h.MockTransferAgent.address,
+		Model:              "model1",
+		OriginalPromptHash: originalPromptHash,
+		RequestTimestamp:   requestTimestamp,
+		TransferSignature:  taSignature,
+		AssignedTo:         h.MockExecutor.address,
+	})
+	require.NoError(t, err)
+
+	savedInference, found := k.GetInference(ctx, inferenceId)
+	require.True(t, found)
+	require.Equal(t, originalPromptHash, savedInference.OriginalPromptHash)
+	require.Equal(t, "promptHash", savedInference.PromptHash)
+	require.Empty(t, savedInference.PromptPayload)
+	require.Empty(t, savedInference.OriginalPrompt)
+}
+
 // TODO: Need a way to test that blockheight is set to newer values, but can't figure out how to change the
 // test value of the blockheight
@@ -26,6 +26,7 @@ func createNewParticipant(ctx sdk.Context, msg *types.MsgSubmitNewParticipant) t
 		Status:            types.ParticipantStatus_ACTIVE,
 		ValidatorKey:      msg.GetValidatorKey(),
 		WorkerPublicKey:   msg.GetWorkerKey(),
+		Region:            msg.GetRegion(),
 		CurrentEpochStats: &types.CurrentEpochStats{},
 	}
 
@@ -51,6 +51,27 @@ func TestMsgServer_SubmitNewParticipant(t *testing.T) {
 	}, savedParticipant)
 }
 
+func TestMsgServer_UpdateParticipantRegion(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	_, err := ms.UpdateParticipantRegion(ctx, &types.MsgUpdateParticipantRegion{Creator: testutil.Executor, Region: "eu-west"})
+	require.ErrorIs(t, err, types.ErrParticipantNotFound)
+
+	_, err = ms.SubmitNewParticipant(ctx, &types.MsgSubmitNewParticipant{
+		Creator: testutil.Executor,
+		Url:     "url",
+		Region:  "asia",
+	})
+	require.NoError(t, err)
+
+	_, err = ms.UpdateParticipantRegion(ctx, &types.MsgUpdateParticipantRegion{Creator: testutil.Executor, Region: "eu-west"})
+	require.NoError(t, err)
+	savedParticipant, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Equal(t, "eu-west", savedParticipant.Region)
+	require.Equal(t, "url", savedParticipant.InferenceUrl)
+}
+
 func TestMsgServer_SubmitNewParticipant_WithEmptyKeys(t *testing.T) {
 	k, ms, ctx := setupMsgServer(t)
 
@@ -42,6 +42,7 @@ func (k msgServer) SubmitNewUnfundedParticipant(goCtx context.Context, msg *type
 			Url:          msg.GetUrl(),
 			ValidatorKey: msg.GetValidatorKey(),
 			WorkerKey:    msg.GetWorkerKey(),
+			Region:       msg.GetRegion(),
 		})
 	k.LogDebug("Adding new participant", types.Participants, "participant", newParticipant)
 	k.SetParticipant(ctx, newParticipant)
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) SubmitTrainingCheckpoint(goCtx context.Context, msg *types.MsgSubmitTrainingCheckpoint) (*types.MsgSubmitTrainingCheckpointResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.Cancelled {
+		return nil, types.ErrTrainingTaskFinished
+	}
+
+	var assignee *types.TrainingTaskAssignee
+	for _, a := range task.Assignees {
+		if a.Participant == msg.Creator {
+			assignee = a
+			break
+		}
+	}
+	if assignee == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "participant %s is not assigned to training task %d", msg.Creator, msg.TaskId)
+	}
+	for _, nodeId := range msg.NodeIds {
+		if !slices.Contains(assignee.NodeIds, nodeId) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "node %s of participant %s is not assigned to training task %d", nodeId, msg.Creator, msg.TaskId)
+		}
+	}
+	if task.Epoch == nil || msg.OuterStep > task.Epoch.LastEpoch {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "outer step %d has not been reached by training task %d", msg.OuterStep, msg.TaskId)
+	}
+
+	checkpoint, found := k.GetTrainingCheckpoint(ctx, msg.TaskId, msg.OuterStep)
+	if !found {
+		checkpoint = &types.TrainingCheckpoint{
+			TaskId:               msg.TaskId,
+			OuterStep:            msg.OuterStep,
+			CreatedAtBlockHeight: ctx.BlockHeight(),
+		}
+	}
+	for _, signature := range checkpoint.Signatures {
+		if signature.Participant == msg.Creator {
+			return nil, types.ErrTrainingCheckpointAlreadySigned
+		}
+	}
+	// Once a hash is accepted late signers can only confirm it
+	if checkpoint.QuorumReachedAtBlockHeight != 0 && checkpoint.ContentHash != msg.ContentHash {
+		return nil, errorsmod.Wrapf(types.ErrTrainingCheckpointMismatch, "expected content hash %s, got %s", checkpoint.ContentHash, msg.ContentHash)
+	}
+
+	checkpoint.Signatures = append(checkpoint.Signatures, &types.TrainingCheckpointSignature{
+		Participant: msg.Creator,
+		NodeIds:     msg.NodeIds,
+		BlockHeight: ctx.BlockHeight(),
+		ContentHash: msg.ContentHash,
+		StorageUri:  msg.StorageUri,
+	})
+	if checkpoint.QuorumReachedAtBlockHeight == 0 && HasCheckpointQuorum(task, checkpoint, msg.ContentHash) {
+		checkpoint.ContentHash = msg.ContentHash
+		checkpoint.StorageUri = firstCheckpointStorageUri(checkpoint, msg.ContentHash)
+		checkpoint.QuorumReachedAtBlockHeight = ctx.BlockHeight()
+		k.LogInfo("Training checkpoint reached quorum", types.Training, "taskId", msg.TaskId, "outerStep", msg.OuterStep, "contentHash", msg.ContentHash)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"training_checkpoint_accepted",
+				sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
+				sdk.NewAttribute("outer_step", strconv.FormatInt(int64(msg.OuterStep), 10)),
+				sdk.NewAttribute("content_hash", checkpoint.ContentHash),
+				sdk.NewAttribute("storage_uri", checkpoint.StorageUri),
+			),
+		)
+	}
+	k.SetTrainingCheckpoint(ctx, checkpoint)
+
+	return &types.MsgSubmitTrainingCheckpointResponse{
+		QuorumReached: checkpoint.QuorumReachedAtBlockHeight != 0,
+	}, nil
+}
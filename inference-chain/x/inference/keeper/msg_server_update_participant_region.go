@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// UpdateParticipantRegion changes the region of an already registered participant, registering
+// again would reset the rest of its state
+func (k msgServer) UpdateParticipantRegion(goCtx context.Context, msg *types.MsgUpdateParticipantRegion) (*types.MsgUpdateParticipantRegionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	participant, found := k.GetParticipant(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrParticipantNotFound
+	}
+	participant.Region = msg.Region
+	k.SetParticipant(ctx, participant)
+	k.LogInfo("Participant region updated", types.Participants, "participant", msg.Creator, "region", msg.Region)
+	return &types.MsgUpdateParticipantRegionResponse{}, nil
+}
@@ -70,6 +70,9 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 		"msgValue", msg.Value,
 		"model", inference.Model,
 	)
+	if msg.Diagnostics != nil {
+		k.recordValidationDiagnostics(ctx, inference, msg, passed)
+	}
 	needsRevalidation := false
 
 	epochGroup, err := k.GetCurrentEpochGroup(ctx)
@@ -128,7 +131,7 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 	executor.Status = calculateStatus(params.ValidationParams, executor)
 
 	// Check for a status transition and slash if necessary.
-	k.CheckAndSlashForInvalidStatus(goCtx, originalStatus, &executor)
+	k.CheckAndSlashForInvalidStatus(goCtx, originalStatus, &executor, nil)
 
 	k.SetParticipant(ctx, executor)
 
@@ -142,6 +145,7 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 			sdk.NewAttribute("validator", msg.Creator),
 			sdk.NewAttribute("needs_revalidation", strconv.FormatBool(needsRevalidation)),
 			sdk.NewAttribute("passed", strconv.FormatBool(passed)),
+			sdk.NewAttribute("requested_by", inference.RequestedBy),
 		))
 	return &types.MsgValidationResponse{}, nil
 }
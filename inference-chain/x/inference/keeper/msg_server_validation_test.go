@@ -38,6 +38,54 @@ func TestMsgServer_Validation(t *testing.T) {
 	require.Equal(t, types.InferenceStatus_VALIDATED, inference.Status)
 }
 
+func TestMsgServer_Validation_RecordsDiagnostics(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+
+	model := &types.Model{
+		Id:                  MODEL_ID,
+		ValidationThreshold: &types.Decimal{Value: 85, Exponent: -2},
+		ValidationStrategy:  types.ValidationStrategy_JS_DIVERGENCE,
+	}
+	k.SetModel(ctx, model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, model)
+
+	expected, err := inferenceHelper.StartInference("promptPayload", model.Id, 10020220, keeper.DefaultMaxTokens)
+	require.NoError(t, err)
+	_, err = inferenceHelper.FinishInference()
+	require.NoError(t, err)
+	_, err = inferenceHelper.MessageServer.Validation(ctx, &types.MsgValidation{
+		InferenceId: expected.InferenceId,
+		Creator:     testutil.Validator,
+		Value:       0.97,
+		Diagnostics: &types.ValidationDiagnostics{
+			Validator:           testutil.Executor,
+			Strategy:            types.ValidationStrategy_JS_DIVERGENCE,
+			Passed:              false,
+			ComparedTokens:      12,
+			MaxPositionDistance: 0.2,
+			ReportHash:          "reportHash",
+			ReportLocator:       "http://validator:9000/v1/payloads",
+		},
+	})
+	require.NoError(t, err)
+
+	inference, found := k.GetInference(ctx, expected.InferenceId)
+	require.True(t, found)
+	details, found := k.GetInferenceValidationDetails(ctx, inference.EpochId, inference.InferenceId)
+	require.True(t, found)
+	require.Len(t, details.Validations, 1)
+	require.Equal(t, types.ValidationDiagnostics{
+		Validator:           testutil.Validator,
+		Strategy:            types.ValidationStrategy_JS_DIVERGENCE,
+		Value:               0.97,
+		Passed:              true,
+		ComparedTokens:      12,
+		MaxPositionDistance: 0.2,
+		ReportHash:          "reportHash",
+		ReportLocator:       "http://validator:9000/v1/payloads",
+	}, *details.Validations[0])
+}
+
 func createParticipants(t *testing.T, ms types.MsgServer, ctx context.Context) {
 	mockRequester := NewMockAccount(testutil.Requester)
 	mockExecutor := NewMockAccount(testutil.Executor)
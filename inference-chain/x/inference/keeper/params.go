@@ -64,3 +64,12 @@ func (k Keeper) GetBandwidthLimitsParams(ctx context.Context) (*types.BandwidthL
 	}
 	return params.BandwidthLimitsParams, nil
 }
+
+// GetBridgeParams returns bridge parameters, with no bridge contracts when they are not set
+func (k Keeper) GetBridgeParams(ctx context.Context) *types.BridgeParams {
+	params := k.GetParams(ctx)
+	if params.BridgeParams == nil {
+		return &types.BridgeParams{}
+	}
+	return params.BridgeParams
+}
@@ -162,13 +162,14 @@ func TestTokenomicsParamsParamSetPairs(t *testing.T) {
 
 	// Test that ParamSetPairs returns the correct number of pairs
 	pairs := params.ParamSetPairs()
-	require.Len(t, pairs, 3, "TokenomicsParams should have 3 parameter pairs for vesting")
+	require.Len(t, pairs, 4, "TokenomicsParams should have 3 parameter pairs for vesting and 1 for the claim grace period")
 
 	// Verify the parameter keys are correctly set
 	expectedKeys := [][]byte{
 		types.KeyWorkVestingPeriod,
 		types.KeyRewardVestingPeriod,
 		types.KeyTopMinerVestingPeriod,
+		types.KeyClaimGracePeriodEpochs,
 	}
 
 	for i, pair := range pairs {
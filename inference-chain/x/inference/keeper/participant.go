@@ -50,6 +50,15 @@ func (k Keeper) GetParticipant(
 	return val, true
 }
 
+// HasParticipant reports whether the address is a registered participant
+func (k Keeper) HasParticipant(ctx context.Context, address sdk.AccAddress) bool {
+	has, err := k.Participants.Has(ctx, address)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
 func (k Keeper) GetParticipants(ctx context.Context, ids []string) ([]types.Participant, bool) {
 	var participants = make([]types.Participant, len(ids))
 	for i, id := range ids {
@@ -2,48 +2,152 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
-// PruneInferences removes old inference records based on threshold and status
-func (k Keeper) PruneInferences(ctx context.Context, upcomingEpochIndex uint64, pruningThreshold uint64) error {
-	inferences := k.GetAllInference(ctx)
-	prunedCount := 0
+// defaultInferencePruningMaxPerBlock is used when the inference_pruning_max_per_block param is not set
+const defaultInferencePruningMaxPerBlock = uint64(1000)
 
-	k.LogInfo("Starting inference pruning iteration", types.Pruning,
-		"total_inferences", len(inferences),
+// PruneInferences starts a pruning pass over the epochs that are old enough for their inferences to be
+// removed, and runs its first batch. The rest of the pass runs in PruneInferencesBatch, one batch per block,
+// so a large backlog doesn't land on the epoch boundary block.
+func (k Keeper) PruneInferences(ctx context.Context, upcomingEpochIndex uint64, pruningThreshold uint64) error {
+	if pruningThreshold > upcomingEpochIndex {
+		k.LogInfo("No epochs old enough to prune inferences", types.Pruning, "upcoming_epoch_index", upcomingEpochIndex, "threshold", pruningThreshold)
+		return nil
+	}
+	cutoff := upcomingEpochIndex - pruningThreshold
+	if err := k.InferencePruningCutoff.Set(ctx, cutoff); err != nil {
+		return err
+	}
+	// Every pass starts from the oldest epoch, so inferences that were still pending last time get another look
+	if err := k.InferencePruningCursor.Set(ctx, collections.Join(collections.Join(uint64(0), int32(0)), "")); err != nil {
+		return err
+	}
+	k.LogInfo("Starting inference pruning pass", types.Pruning,
 		"upcoming_epoch_index", upcomingEpochIndex,
-		"threshold", pruningThreshold)
+		"threshold", pruningThreshold,
+		"cutoff_epoch", cutoff)
 
-	for _, inference := range inferences {
-		if isInferenceEligibleForPruning(inference, upcomingEpochIndex, pruningThreshold) {
-			k.LogInfo("Pruning inference", types.Pruning,
-				"inference_index", inference.Index,
-				"inference_epoch", inference.EpochId,
-				"upcoming_epoch_index", upcomingEpochIndex)
-			k.RemoveInference(ctx, inference.Index)
-			prunedCount++
+	_, err := k.PruneInferencesBatch(ctx)
+	return err
+}
+
+// PruneInferencesBatch continues the pruning pass in progress, if any. It walks the inferences by epoch
+// index up to the cutoff epoch, looking at no more than inference_pruning_max_per_block of them, and
+// returns how many were removed.
+func (k Keeper) PruneInferencesBatch(ctx context.Context) (int, error) {
+	cursor, err := k.InferencePruningCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	cutoff, err := k.InferencePruningCutoff.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	maxPerBlock := k.GetParams(ctx).EpochParams.GetInferencePruningMaxPerBlock()
+	if maxPerBlock == 0 {
+		maxPerBlock = defaultInferencePruningMaxPerBlock
+	}
+
+	ranger := new(collections.Range[collections.Pair[inferenceEpochKey, string]]).
+		StartInclusive(cursor).
+		EndExclusive(collections.Join(collections.Join(cutoff+1, int32(0)), ""))
+	iter, err := k.Inferences.Indexes.ByEpoch.Iterate(ctx, ranger)
+	if err != nil {
+		return 0, err
+	}
+	var eligible []string
+	var next *collections.Pair[inferenceEpochKey, string]
+	looked := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			iter.Close()
+			return 0, err
+		}
+		if looked == maxPerBlock {
+			next = &key
+			break
 		}
+		looked++
+		if isPendingStatus(types.InferenceStatus(key.K1().K2())) {
+			continue
+		}
+		eligible = append(eligible, key.K2())
 	}
-	k.LogInfo("Pruned inferences", types.Pruning, "count", prunedCount, "upcoming_epoch_index", upcomingEpochIndex, "threshold", pruningThreshold)
+	iter.Close()
 
-	return nil
-}
+	for _, index := range eligible {
+		if err := k.Inferences.Remove(ctx, index); err != nil {
+			return 0, err
+		}
+	}
 
-// isInferenceEligibleForPruning checks if inference can be pruned based on age
-func isInferenceEligibleForPruning(inference types.Inference, upcomingEpochIndex uint64, pruningThreshold uint64) bool {
-	if inference.Status == types.InferenceStatus_STARTED || inference.Status == types.InferenceStatus_VOTING {
-		// pending activity
-		return false
+	if next != nil {
+		err = k.InferencePruningCursor.Set(ctx, *next)
+	} else {
+		err = k.InferencePruningCursor.Remove(ctx)
 	}
-	if pruningThreshold > upcomingEpochIndex {
-		return false
+	if err != nil {
+		return 0, err
 	}
+	k.LogInfo("Pruned inferences", types.Pruning,
+		"count", len(eligible),
+		"looked_at", looked,
+		"cutoff_epoch", cutoff,
+		"pass_finished", next == nil)
+	return len(eligible), nil
+}
 
-	cutoff := upcomingEpochIndex - pruningThreshold
-	return inference.EpochId <= cutoff
+// isPendingStatus reports whether an inference still has activity ahead and must not be pruned
+func isPendingStatus(status types.InferenceStatus) bool {
+	return status == types.InferenceStatus_STARTED || status == types.InferenceStatus_VOTING
+}
+
+// backfillBatchSize bounds how many inferences BackfillInferenceIndexes holds in memory at once
+const backfillBatchSize = 1000
+
+// BackfillInferenceIndexes writes the secondary indexes of inferences stored before they existed.
+// The store is walked in batches, each read completely before its index entries are written.
+func (k Keeper) BackfillInferenceIndexes(ctx context.Context) (int, error) {
+	notIndexed := func() (types.Inference, error) { return types.Inference{}, collections.ErrNotFound }
+	ranger := new(collections.Range[string])
+	count := 0
+	for {
+		iter, err := k.Inferences.Iterate(ctx, ranger)
+		if err != nil {
+			return count, err
+		}
+		batch := make([]collections.KeyValue[string, types.Inference], 0, backfillBatchSize)
+		for ; iter.Valid() && len(batch) < backfillBatchSize; iter.Next() {
+			kv, err := iter.KeyValue()
+			if err != nil {
+				iter.Close()
+				return count, err
+			}
+			batch = append(batch, kv)
+		}
+		iter.Close()
+
+		for _, kv := range batch {
+			if err := k.Inferences.Indexes.ByEpoch.Reference(ctx, kv.Key, kv.Value, notIndexed); err != nil {
+				return count, err
+			}
+		}
+		count += len(batch)
+		if len(batch) < backfillBatchSize {
+			return count, nil
+		}
+		ranger = new(collections.Range[string]).StartExclusive(batch[len(batch)-1].Key)
+	}
 }
 
 var lookbackMultiplier = uint64(5)
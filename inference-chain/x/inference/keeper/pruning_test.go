@@ -168,3 +168,64 @@ func TestPruningMultipleEpochs(t *testing.T) {
 	}
 
 }
+
+// TestPruningBudgetSpansBlocks tests that a pruning pass removes at most the per-block budget and
+// continues in the following blocks
+func TestPruningBudgetSpansBlocks(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	params := k.GetParams(ctx)
+	params.EpochParams.InferencePruningMaxPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for i := 1; i <= 4; i++ {
+		k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{
+			Index:   fmt.Sprintf("inference-%d", i),
+			EpochId: 1,
+			Status:  types.InferenceStatus_VALIDATED,
+		})
+	}
+	k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "pending", EpochId: 1, Status: types.InferenceStatus_VOTING})
+	k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "recent", EpochId: 5, Status: types.InferenceStatus_FINISHED})
+
+	require.NoError(t, k.PruneInferences(ctx, 4, 2))
+	require.Len(t, k.GetAllInference(ctx), 4)
+
+	removed, err := k.PruneInferencesBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	// Only the pending inference is left to look at, which ends the pass
+	removed, err = k.PruneInferencesBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, removed)
+	removed, err = k.PruneInferencesBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, removed)
+
+	remaining := k.GetAllInference(ctx)
+	require.Len(t, remaining, 2)
+	_, found := k.GetInference(ctx, "pending")
+	require.True(t, found, "Pending inference should not be pruned")
+	_, found = k.GetInference(ctx, "recent")
+	require.True(t, found, "Inference newer than the cutoff should not be pruned")
+
+	// The next pass looks at the pending inference again once it settled
+	k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "pending", EpochId: 1, Status: types.InferenceStatus_INVALIDATED})
+	require.NoError(t, k.PruneInferences(ctx, 5, 2))
+	_, found = k.GetInference(ctx, "pending")
+	require.False(t, found, "Settled inference should be pruned by the next pass")
+}
+
+// TestBackfillInferenceIndexes tests that backfilling is safe to run on inferences that are already indexed
+func TestBackfillInferenceIndexes(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "inference", EpochId: 1, Status: types.InferenceStatus_FINISHED})
+
+	count, err := k.BackfillInferenceIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	require.NoError(t, k.PruneInferences(ctx, 4, 2))
+	_, found := k.GetInference(ctx, "inference")
+	require.False(t, found)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BridgeBlockHeader(goCtx context.Context, req *types.QueryGetBridgeBlockHeaderRequest) (*types.QueryGetBridgeBlockHeaderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	header, found := k.GetBridgeBlockHeader(goCtx, req.OriginChain, req.BlockNumber)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bridge block header not found")
+	}
+
+	return &types.QueryGetBridgeBlockHeaderResponse{
+		Header: header,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BridgeWithdrawal(goCtx context.Context, req *types.QueryGetBridgeWithdrawalRequest) (*types.QueryGetBridgeWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	withdrawal, found := k.GetBridgeWithdrawal(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bridge withdrawal not found")
+	}
+
+	signingRequest, err := k.BlsKeeper.GetSigningStatus(ctx, withdrawal.RequestId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetBridgeWithdrawalResponse{
+		Withdrawal:    withdrawal,
+		SigningStatus: signingRequest.Status.String(),
+		EncodedData:   signingRequest.EncodedData,
+		MessageHash:   signingRequest.MessageHash,
+		Signature:     signingRequest.FinalSignature,
+	}, nil
+}
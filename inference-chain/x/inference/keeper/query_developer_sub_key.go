@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DeveloperSubKey(ctx context.Context, req *types.QueryGetDeveloperSubKeyRequest) (*types.QueryGetDeveloperSubKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	subKey, found := k.GetDeveloperSubKey(ctx, req.Developer, req.Name)
+	if !found {
+		return nil, status.Error(codes.NotFound, "developer sub-key not found")
+	}
+	epochIndex, _ := k.GetEffectiveEpochIndex(ctx)
+
+	return &types.QueryGetDeveloperSubKeyResponse{
+		SubKey:         subKey,
+		SpentThisEpoch: subKey.SpentIn(epochIndex),
+	}, nil
+}
+
+func (k Keeper) DeveloperSubKeys(ctx context.Context, req *types.QueryDeveloperSubKeysRequest) (*types.QueryDeveloperSubKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	subKeys, err := k.GetDeveloperSubKeys(ctx, req.Developer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDeveloperSubKeysResponse{SubKeys: subKeys}, nil
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -20,7 +22,7 @@ func (k Keeper) GetRandomExecutor(goCtx context.Context, req *types.QueryGetRand
 	k.Logger().Info("GetRandomExecutor: Starting executor selection",
 		"model_id", req.Model)
 
-	filterFn, err := k.createFilterFn(goCtx, req.Model)
+	filterFn, err := k.createExecutorFilterFn(goCtx, req.Model, req.Exclude, req.Region)
 	if err != nil {
 		k.Logger().Error("GetRandomExecutor: failed to create filter function",
 			"model_id", req.Model, "error", err.Error())
@@ -52,6 +54,50 @@ func (k Keeper) GetRandomExecutor(goCtx context.Context, req *types.QueryGetRand
 	}, nil
 }
 
+func (k Keeper) ExecutorCandidates(goCtx context.Context, req *types.QueryExecutorCandidatesRequest) (*types.QueryExecutorCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	filterFn, err := k.createExecutorFilterFn(goCtx, req.Model, req.Exclude, req.Region)
+	if err != nil {
+		k.Logger().Error("ExecutorCandidates: failed to create filter function",
+			"model_id", req.Model, "error", err.Error())
+		return nil, err
+	}
+
+	epochGroup, err := k.GetCurrentEpochGroup(goCtx)
+	if err != nil {
+		k.Logger().Error("ExecutorCandidates: failed to get current epoch group",
+			"model_id", req.Model, "error", err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	candidates, err := epochGroup.GetCandidatesForModel(goCtx, req.Model, filterFn)
+	if err != nil {
+		k.Logger().Error("ExecutorCandidates: failed to get candidates",
+			"model_id", req.Model, "error", err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutorCandidatesResponse{Candidates: candidates}, nil
+}
+
+// createExecutorFilterFn combines the phase filter of the model with the optional exclusions and region preference of a request
+func (k Keeper) createExecutorFilterFn(goCtx context.Context, modelId string, exclude []string, region string) (func(members []*group.GroupMember) []*group.GroupMember, error) {
+	filterFn, err := k.createFilterFn(goCtx, modelId)
+	if err != nil {
+		return nil, err
+	}
+	if len(exclude) > 0 {
+		filterFn = excludeMembersFilterFn(filterFn, exclude)
+	}
+	if region != "" {
+		filterFn = k.preferRegionFilterFn(goCtx, filterFn, region)
+	}
+	return filterFn, nil
+}
+
 func (k Keeper) createFilterFn(goCtx context.Context, modelId string) (func(members []*group.GroupMember) []*group.GroupMember, error) {
 	sdkCtx := sdk.UnwrapSDKContext(goCtx)
 
@@ -276,3 +322,42 @@ func (k Keeper) createIsAvailableDuringPoCFilterFn(ctx context.Context, epochId
 		return filtered
 	}, nil
 }
+
+// excludeMembersFilterFn wraps a filter so the given addresses are never selected,
+// letting transfer agents retry a request on a different executor.
+func excludeMembersFilterFn(filterFn func(members []*group.GroupMember) []*group.GroupMember, exclude []string) func(members []*group.GroupMember) []*group.GroupMember {
+	return func(members []*group.GroupMember) []*group.GroupMember {
+		filtered := make([]*group.GroupMember, 0, len(members))
+		for _, member := range filterFn(members) {
+			if member == nil || member.Member == nil || slices.Contains(exclude, member.Member.Address) {
+				continue
+			}
+			filtered = append(filtered, member)
+		}
+		return filtered
+	}
+}
+
+// preferRegionFilterFn narrows the filtered members down to those declaring the region. The region is only
+// a hint, so all filtered members are kept when none of them declares it.
+func (k Keeper) preferRegionFilterFn(ctx context.Context, filterFn func(members []*group.GroupMember) []*group.GroupMember, region string) func(members []*group.GroupMember) []*group.GroupMember {
+	return func(members []*group.GroupMember) []*group.GroupMember {
+		filtered := filterFn(members)
+		inRegion := make([]*group.GroupMember, 0, len(filtered))
+		for _, member := range filtered {
+			if member == nil || member.Member == nil {
+				continue
+			}
+			participant, found := k.GetParticipant(ctx, member.Member.Address)
+			if found && strings.EqualFold(participant.Region, region) {
+				inRegion = append(inRegion, member)
+			}
+		}
+		if len(inRegion) == 0 {
+			k.Logger().Debug("GetRandomExecutor: no member declares the region, ignoring it",
+				"region", region, "member_count", len(filtered))
+			return filtered
+		}
+		return inRegion
+	}
+}
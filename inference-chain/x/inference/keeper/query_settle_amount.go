@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/productscience/inference/x/inference/types"
@@ -19,7 +20,9 @@ func (k Keeper) SettleAmountAll(ctx context.Context, req *types.QueryAllSettleAm
 		ctx,
 		k.SettleAmounts,
 		req.Pagination,
-		func(_ sdk.AccAddress, v types.SettleAmount) (types.SettleAmount, error) { return v, nil },
+		func(_ collections.Pair[sdk.AccAddress, uint64], v types.SettleAmount) (types.SettleAmount, error) {
+			return v, nil
+		},
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -33,13 +36,35 @@ func (k Keeper) SettleAmount(ctx context.Context, req *types.QueryGetSettleAmoun
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetSettleAmount(
-		ctx,
-		req.Participant,
-	)
-	if !found {
+	// The latest epoch, older unclaimed amounts are listed by PendingClaims
+	settleAmounts := k.GetParticipantSettleAmounts(ctx, req.Participant)
+	if len(settleAmounts) == 0 {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetSettleAmountResponse{SettleAmount: val}, nil
+	return &types.QueryGetSettleAmountResponse{SettleAmount: settleAmounts[len(settleAmounts)-1]}, nil
+}
+
+func (k Keeper) PendingClaims(ctx context.Context, req *types.QueryPendingClaimsRequest) (*types.QueryPendingClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Participant); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid participant address")
+	}
+
+	// The next settlement is the one of the effective epoch
+	currentEpochIndex, _ := k.GetEffectiveEpochIndex(ctx)
+	settleAmounts := k.GetParticipantSettleAmounts(ctx, req.Participant)
+	claims := make([]types.PendingClaim, 0, len(settleAmounts))
+	for _, settleAmount := range settleAmounts {
+		expiresAt := k.SettleAmountExpiresAt(ctx, settleAmount.EpochIndex)
+		claims = append(claims, types.PendingClaim{
+			SettleAmount:   settleAmount,
+			ExpiresAtEpoch: expiresAt,
+			Expiring:       expiresAt <= currentEpochIndex,
+		})
+	}
+
+	return &types.QueryPendingClaimsResponse{Claims: claims, CurrentEpochIndex: currentEpochIndex}, nil
 }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TrainingCheckpoint(goCtx context.Context, req *types.QueryTrainingCheckpointRequest) (*types.QueryTrainingCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	checkpoint, found := k.GetTrainingCheckpoint(ctx, req.TaskId, req.OuterStep)
+	if !found {
+		return nil, status.Error(codes.NotFound, "checkpoint not found")
+	}
+
+	return &types.QueryTrainingCheckpointResponse{
+		Checkpoint: checkpoint,
+	}, nil
+}
+
+func (k Keeper) TrainingCheckpoints(goCtx context.Context, req *types.QueryTrainingCheckpointsRequest) (*types.QueryTrainingCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	checkpoints, err := k.ListTrainingCheckpoints(ctx, req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTrainingCheckpointsResponse{
+		Checkpoints: checkpoints,
+	}, nil
+}
@@ -3,48 +3,73 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/productscience/inference/x/inference/types"
 )
 
-// SetSettleAmount sets a specific settleAmount in the store by participant
+// settleAmountKey is the (participant, epoch) key of a settle amount
+type settleAmountKey = collections.Pair[sdk.AccAddress, uint64]
+
+var settleAmountKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)
+
+// SetSettleAmount sets a specific settleAmount in the store by participant and epoch
 func (k Keeper) SetSettleAmount(ctx context.Context, settleAmount types.SettleAmount) {
 	addr, err := sdk.AccAddressFromBech32(settleAmount.Participant)
 	if err != nil {
 		panic(err)
 	}
-	if err := k.SettleAmounts.Set(ctx, addr, settleAmount); err != nil {
+	if err := k.SettleAmounts.Set(ctx, collections.Join(addr, settleAmount.EpochIndex), settleAmount); err != nil {
 		panic(err)
 	}
 }
 
-// GetSettleAmount returns a settleAmount by participant
+// GetSettleAmount returns the settleAmount of a participant for an epoch
 func (k Keeper) GetSettleAmount(
 	ctx context.Context,
 	participant string,
+	epochIndex uint64,
 ) (val types.SettleAmount, found bool) {
 	addr, err := sdk.AccAddressFromBech32(participant)
 	if err != nil {
 		return val, false
 	}
-	v, err := k.SettleAmounts.Get(ctx, addr)
+	v, err := k.SettleAmounts.Get(ctx, collections.Join(addr, epochIndex))
 	if err != nil {
 		return val, false
 	}
 	return v, true
 }
 
+// GetParticipantSettleAmounts returns the unclaimed settleAmounts of a participant, oldest epoch first
+func (k Keeper) GetParticipantSettleAmounts(ctx context.Context, participant string) []types.SettleAmount {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return nil
+	}
+	iter, err := k.SettleAmounts.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil
+	}
+	vals, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return vals
+}
+
 // RemoveSettleAmount removes a settleAmount from the store
 func (k Keeper) RemoveSettleAmount(
 	ctx context.Context,
 	participant string,
+	epochIndex uint64,
 ) {
 	addr, err := sdk.AccAddressFromBech32(participant)
 	if err != nil {
 		return
 	}
-	_ = k.SettleAmounts.Remove(ctx, addr)
+	_ = k.SettleAmounts.Remove(ctx, collections.Join(addr, epochIndex))
 }
 
 // GetAllSettleAmount returns all settleAmount entries
@@ -75,10 +100,9 @@ func (k Keeper) burnSettleAmount(ctx context.Context, settleAmount types.SettleA
 	return nil
 }
 
-// SetSettleAmountWithBurn sets a settle amount, burning any existing unclaimed amount first
+// SetSettleAmountWithBurn sets a settle amount, burning any unclaimed amount already stored for the same epoch first
 func (k Keeper) SetSettleAmountWithBurn(ctx context.Context, settleAmount types.SettleAmount) error {
-	// Burn existing settle amount if it exists
-	existingSettle, found := k.GetSettleAmount(ctx, settleAmount.Participant)
+	existingSettle, found := k.GetSettleAmount(ctx, settleAmount.Participant, settleAmount.EpochIndex)
 	if found {
 		err := k.burnSettleAmount(ctx, existingSettle, "expired claim")
 		if err != nil {
@@ -86,24 +110,65 @@ func (k Keeper) SetSettleAmountWithBurn(ctx context.Context, settleAmount types.
 		}
 	}
 
-	// Set the new settle amount
 	k.SetSettleAmount(ctx, settleAmount)
 	k.BankKeeper.LogSubAccountTransaction(ctx, types.ModuleName, settleAmount.Participant, types.SettleSubAccount, sdk.NewInt64Coin(types.BaseCoin, int64(settleAmount.GetTotalCoins())), "awaiting claim")
 	k.BankKeeper.LogSubAccountTransaction(ctx, settleAmount.Participant, types.ModuleName, types.OwedSubAccount, sdk.NewInt64Coin(types.BaseCoin, int64(settleAmount.WorkCoins)), "moved to settled")
 	return nil
 }
 
-// BurnOldSettleAmounts burns and removes all settle amounts older than the specified epoch
-func (k Keeper) BurnOldSettleAmounts(ctx context.Context, beforeEpochIndex uint64) error {
-	allSettleAmounts := k.GetAllSettleAmount(ctx)
-	for _, settleAmount := range allSettleAmounts {
-		if settleAmount.EpochIndex < beforeEpochIndex {
-			err := k.burnSettleAmount(ctx, settleAmount, "expired")
-			if err != nil {
-				return err
-			}
-			k.RemoveSettleAmount(ctx, settleAmount.Participant)
+// SettleAmountExpiresAt returns the epoch whose settlement burns the settle amount of epochIndex if it is still unclaimed
+func (k Keeper) SettleAmountExpiresAt(ctx context.Context, epochIndex uint64) uint64 {
+	return epochIndex + k.GetParams(ctx).TokenomicsParams.ClaimGracePeriodEpochs + 1
+}
+
+// BurnExpiredSettleAmounts burns and removes the settle amounts whose claim grace period ends with the settlement of settledEpochIndex
+func (k Keeper) BurnExpiredSettleAmounts(ctx context.Context, settledEpochIndex uint64) error {
+	expiresAfter := k.SettleAmountExpiresAt(ctx, 0)
+	if settledEpochIndex < expiresAfter {
+		return nil
+	}
+	// Only the epochs up to the last expired one are read, through the by-epoch index
+	ranger := collections.NewPrefixUntilPairRange[uint64, settleAmountKey](settledEpochIndex - expiresAfter)
+	iter, err := k.SettleAmounts.Indexes.ByEpoch.Iterate(ctx, ranger)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		settleAmount, err := k.SettleAmounts.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if err := k.burnSettleAmount(ctx, settleAmount, "expired"); err != nil {
+			return err
+		}
+		if err := k.SettleAmounts.Remove(ctx, key); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// MigrateLegacySettleAmounts moves the settle amounts stored once per participant to the per-epoch store
+func (k Keeper) MigrateLegacySettleAmounts(ctx context.Context) (int, error) {
+	iter, err := k.legacySettleAmounts.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return 0, err
+	}
+	for _, kv := range kvs {
+		if err := k.SettleAmounts.Set(ctx, collections.Join(kv.Key, kv.Value.EpochIndex), kv.Value); err != nil {
+			return 0, err
+		}
+		if err := k.legacySettleAmounts.Remove(ctx, kv.Key); err != nil {
+			return 0, err
+		}
+	}
+	return len(kvs), nil
+}
@@ -10,12 +10,14 @@ import (
 	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func createNSettleAmount(keeper keeper.Keeper, ctx context.Context, n int) []types.SettleAmount {
 	items := make([]types.SettleAmount, n)
 	for i := range items {
 		items[i].Participant = sample.AccAddress()
+		items[i].EpochIndex = uint64(i)
 		keeper.SetSettleAmount(ctx, items[i])
 	}
 	return items
@@ -27,6 +29,7 @@ func TestSettleAmountGet(t *testing.T) {
 	for _, item := range items {
 		rst, found := keeper.GetSettleAmount(ctx,
 			item.Participant,
+			item.EpochIndex,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -41,9 +44,11 @@ func TestSettleAmountRemove(t *testing.T) {
 	for _, item := range items {
 		keeper.RemoveSettleAmount(ctx,
 			item.Participant,
+			item.EpochIndex,
 		)
 		_, found := keeper.GetSettleAmount(ctx,
 			item.Participant,
+			item.EpochIndex,
 		)
 		require.False(t, found)
 	}
@@ -57,3 +62,28 @@ func TestSettleAmountGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllSettleAmount(ctx)),
 	)
 }
+
+func TestBurnExpiredSettleAmounts(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	params := k.GetParams(ctx)
+	params.TokenomicsParams.ClaimGracePeriodEpochs = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	participant := sample.AccAddress()
+	for epochIndex := uint64(1); epochIndex <= 3; epochIndex++ {
+		k.SetSettleAmount(ctx, types.SettleAmount{Participant: participant, EpochIndex: epochIndex, WorkCoins: 100})
+	}
+
+	// Epoch 1 stays claimable through the settlements of epochs 2 and 3 and is burned by the one of epoch 4
+	require.NoError(t, k.BurnExpiredSettleAmounts(ctx, 3))
+	require.Len(t, k.GetParticipantSettleAmounts(ctx, participant), 3)
+
+	mocks.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, types.GetCoins(100), gomock.Any()).Return(nil)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	require.NoError(t, k.BurnExpiredSettleAmounts(ctx, 4))
+
+	remaining := k.GetParticipantSettleAmounts(ctx, participant)
+	require.Len(t, remaining, 2)
+	require.Equal(t, uint64(2), remaining[0].EpochIndex)
+	require.Equal(t, uint64(3), remaining[1].EpochIndex)
+}
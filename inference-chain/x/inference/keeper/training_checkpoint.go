@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k Keeper) GetTrainingCheckpoint(ctx sdk.Context, taskId uint64, outerStep int32) (*types.TrainingCheckpoint, bool) {
+	var checkpoint types.TrainingCheckpoint
+	return GetValue(&k, ctx, &checkpoint, []byte{}, types.TrainingCheckpointKey(taskId, outerStep))
+}
+
+func (k Keeper) SetTrainingCheckpoint(ctx sdk.Context, checkpoint *types.TrainingCheckpoint) {
+	SetValue(k, ctx, checkpoint, []byte{}, types.TrainingCheckpointKey(checkpoint.TaskId, checkpoint.OuterStep))
+}
+
+// ListTrainingCheckpoints returns the checkpoints of a task ordered by outer step
+func (k Keeper) ListTrainingCheckpoints(ctx sdk.Context, taskId uint64) ([]*types.TrainingCheckpoint, error) {
+	checkpoints, err := GetAllValues(ctx, &k, types.TrainingTaskAllCheckpointsKey(taskId), func() *types.TrainingCheckpoint {
+		return &types.TrainingCheckpoint{}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(checkpoints, func(i, j int) bool {
+		return checkpoints[i].OuterStep < checkpoints[j].OuterStep
+	})
+	return checkpoints, nil
+}
+
+// HasCheckpointQuorum reports whether more than half of the task's current assignees signed the given content hash.
+// Signatures of participants that are no longer assigned don't count.
+func HasCheckpointQuorum(task *types.TrainingTask, checkpoint *types.TrainingCheckpoint, contentHash string) bool {
+	if len(task.Assignees) == 0 {
+		return false
+	}
+	assigned := make(map[string]bool, len(task.Assignees))
+	for _, assignee := range task.Assignees {
+		assigned[assignee.Participant] = true
+	}
+	signers := 0
+	for _, signature := range checkpoint.Signatures {
+		if signature.ContentHash == contentHash && assigned[signature.Participant] {
+			signers++
+		}
+	}
+	return 2*signers > len(task.Assignees)
+}
+
+// firstCheckpointStorageUri returns the storage uri of the earliest signature for the given content hash
+func firstCheckpointStorageUri(checkpoint *types.TrainingCheckpoint, contentHash string) string {
+	for _, signature := range checkpoint.Signatures {
+		if signature.ContentHash == contentHash {
+			return signature.StorageUri
+		}
+	}
+	return ""
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestTrainingCheckpoint_QuorumOfAssignees(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	resp, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: testutil.Requester})
+	require.NoError(t, err)
+	taskId := resp.Task.Id
+	require.NoError(t, k.StartTask(ctx, taskId, []*types.TrainingTaskAssignee{
+		{Participant: testutil.Creator, NodeIds: []string{"node1", "node2"}},
+		{Participant: testutil.Executor, NodeIds: []string{"node1"}},
+		{Participant: testutil.Executor2, NodeIds: []string{"node1"}},
+	}))
+
+	submit := func(creator string, outerStep int32, contentHash string, nodeIds ...string) (*types.MsgSubmitTrainingCheckpointResponse, error) {
+		return ms.SubmitTrainingCheckpoint(ctx, &types.MsgSubmitTrainingCheckpoint{
+			Creator:     creator,
+			TaskId:      taskId,
+			OuterStep:   outerStep,
+			ContentHash: contentHash,
+			StorageUri:  "s3://checkpoints/" + contentHash,
+			NodeIds:     nodeIds,
+		})
+	}
+
+	// The task hasn't reached the first outer step yet
+	_, err = submit(testutil.Creator, 0, "hash0", "node1")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	joinTraining(t, ms, ctx, testutil.Creator, "node1", taskId, 0)
+	joinTraining(t, ms, ctx, testutil.Creator, "node2", taskId, 0)
+	joinTraining(t, ms, ctx, testutil.Executor, "node1", taskId, 0)
+	joinTraining(t, ms, ctx, testutil.Executor2, "node1", taskId, 0)
+	joinTraining(t, ms, ctx, testutil.Creator, "node1", taskId, 1)
+
+	_, err = submit(testutil.Requester, 0, "hash0", "node1")
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = submit(testutil.Executor, 0, "hash0", "node2")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// A conflicting first submission doesn't fix the hash for everybody else
+	signResp, err := submit(testutil.Executor, 0, "other", "node1")
+	require.NoError(t, err)
+	require.False(t, signResp.QuorumReached)
+
+	signResp, err = submit(testutil.Creator, 0, "hash0", "node1", "node2")
+	require.NoError(t, err)
+	require.False(t, signResp.QuorumReached)
+
+	_, err = submit(testutil.Creator, 0, "hash0", "node1")
+	require.ErrorIs(t, err, types.ErrTrainingCheckpointAlreadySigned)
+	_, err = submit(testutil.Executor, 0, "hash0", "node1")
+	require.ErrorIs(t, err, types.ErrTrainingCheckpointAlreadySigned)
+
+	// Two out of three assignees signing the same hash make a quorum
+	signResp, err = submit(testutil.Executor2, 0, "hash0", "node1")
+	require.NoError(t, err)
+	require.True(t, signResp.QuorumReached)
+
+	_, err = submit(testutil.Creator, 1, "hash1", "node1")
+	require.NoError(t, err)
+
+	checkpointResp, err := k.TrainingCheckpoint(ctx, &types.QueryTrainingCheckpointRequest{TaskId: taskId, OuterStep: 0})
+	require.NoError(t, err)
+	checkpoint := checkpointResp.Checkpoint
+	require.Equal(t, "hash0", checkpoint.ContentHash)
+	require.Equal(t, "s3://checkpoints/hash0", checkpoint.StorageUri)
+	require.Equal(t, int64(10), checkpoint.QuorumReachedAtBlockHeight)
+	require.Len(t, checkpoint.Signatures, 3)
+	require.Equal(t, "other", checkpoint.Signatures[0].ContentHash)
+	require.Equal(t, testutil.Creator, checkpoint.Signatures[1].Participant)
+	require.Equal(t, []string{"node1", "node2"}, checkpoint.Signatures[1].NodeIds)
+	require.Equal(t, "hash0", checkpoint.Signatures[1].ContentHash)
+
+	checkpointsResp, err := k.TrainingCheckpoints(ctx, &types.QueryTrainingCheckpointsRequest{TaskId: taskId})
+	require.NoError(t, err)
+	require.Len(t, checkpointsResp.Checkpoints, 2)
+	require.Equal(t, int32(0), checkpointsResp.Checkpoints[0].OuterStep)
+	require.Equal(t, int32(1), checkpointsResp.Checkpoints[1].OuterStep)
+	require.Zero(t, checkpointsResp.Checkpoints[1].QuorumReachedAtBlockHeight)
+
+	_, err = k.TrainingCheckpoint(ctx, &types.QueryTrainingCheckpointRequest{TaskId: taskId, OuterStep: 2})
+	require.Error(t, err)
+}
+
+func TestTrainingCheckpoint_QuorumCountsOnlyCurrentAssignees(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	resp, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: testutil.Requester})
+	require.NoError(t, err)
+	taskId := resp.Task.Id
+	require.NoError(t, k.StartTask(ctx, taskId, []*types.TrainingTaskAssignee{
+		{Participant: testutil.Creator, NodeIds: []string{"node1"}},
+		{Participant: testutil.Executor, NodeIds: []string{"node1"}},
+		{Participant: testutil.Executor2, NodeIds: []string{"node1"}},
+		{Participant: testutil.Validator, NodeIds: []string{"node1"}},
+	}))
+	joinTraining(t, ms, ctx, testutil.Creator, "node1", taskId, 0)
+
+	submit := func(creator string, contentHash string) (*types.MsgSubmitTrainingCheckpointResponse, error) {
+		return ms.SubmitTrainingCheckpoint(ctx, &types.MsgSubmitTrainingCheckpoint{
+			Creator:     creator,
+			TaskId:      taskId,
+			OuterStep:   0,
+			ContentHash: contentHash,
+			StorageUri:  "s3://checkpoints/" + contentHash,
+			NodeIds:     []string{"node1"},
+		})
+	}
+
+	_, err = submit(testutil.Creator, "hash0")
+	require.NoError(t, err)
+
+	// The first signer loses its assignment, so its signature no longer counts
+	task, found := k.GetTrainingTask(ctx, taskId)
+	require.True(t, found)
+	task.Assignees = task.Assignees[1:]
+	k.SetTrainingTask(ctx, task)
+
+	signResp, err := submit(testutil.Executor, "hash0")
+	require.NoError(t, err)
+	require.False(t, signResp.QuorumReached)
+
+	signResp, err = submit(testutil.Executor2, "hash0")
+	require.NoError(t, err)
+	require.True(t, signResp.QuorumReached)
+
+	// Late signers can only confirm the accepted hash
+	_, err = submit(testutil.Validator, "other")
+	require.ErrorIs(t, err, types.ErrTrainingCheckpointMismatch)
+	signResp, err = submit(testutil.Validator, "hash0")
+	require.NoError(t, err)
+	require.True(t, signResp.QuorumReached)
+}
+
+func TestListTrainingCheckpoints_OrderedByOuterStep(t *testing.T) {
+	k, _, ctx, _ := setupKeeperWithMocks(t)
+
+	for _, outerStep := range []int32{10, 2, 1} {
+		k.SetTrainingCheckpoint(ctx, &types.TrainingCheckpoint{TaskId: 1, OuterStep: outerStep, ContentHash: "hash"})
+	}
+	k.SetTrainingCheckpoint(ctx, &types.TrainingCheckpoint{TaskId: 11, OuterStep: 0, ContentHash: "hash"})
+
+	checkpoints, err := k.ListTrainingCheckpoints(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 3)
+	require.Equal(t, int32(1), checkpoints[0].OuterStep)
+	require.Equal(t, int32(2), checkpoints[1].OuterStep)
+	require.Equal(t, int32(10), checkpoints[2].OuterStep)
+}
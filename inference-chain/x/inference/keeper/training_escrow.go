@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/training"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// EscrowTrainingBudget moves the budget of a new training task from the requester to the module account
+func (k Keeper) EscrowTrainingBudget(ctx sdk.Context, task *types.TrainingTask) error {
+	if task.Budget == 0 {
+		return nil
+	}
+	requester, err := sdk.AccAddressFromBech32(task.RequestedBy)
+	if err != nil {
+		return err
+	}
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleName, types.GetCoins(int64(task.Budget)), "escrow for training task:"+strconv.FormatUint(task.Id, 10))
+	if err != nil {
+		k.LogError("Error sending training budget to escrow", types.Training, "taskId", task.Id, "error", err)
+		return sdkerrors.Wrapf(err, types.ErrRequesterCannotPay.Error())
+	}
+	k.LogInfo("Sent training budget to escrow", types.Training, "taskId", task.Id, "budget", task.Budget, "requester", task.RequestedBy)
+	return nil
+}
+
+// TrainingEscrowRemaining returns the part of the budget that is neither paid out nor refunded
+func TrainingEscrowRemaining(task *types.TrainingTask) uint64 {
+	return task.Budget - task.PaidOut - task.Refunded
+}
+
+// PayTrainingMilestone pays the assignees for a completed outer step out of the task's escrow.
+// Each outer step is paid at most once; the caller saves the task.
+func (k Keeper) PayTrainingMilestone(ctx sdk.Context, task *types.TrainingTask, outerStep int32) error {
+	if task.FinishedAtBlockHeight != 0 || outerStep < task.NextPayableOuterStep {
+		return nil
+	}
+	task.NextPayableOuterStep = outerStep + 1
+
+	amount := min(task.OuterStepPayout, TrainingEscrowRemaining(task))
+	if amount == 0 {
+		return nil
+	}
+	activity, err := k.GetTrainingTaskNodeActivityAtEpoch(ctx, task.Id, outerStep)
+	if err != nil {
+		return err
+	}
+
+	var paid uint64
+	memo := "training_milestone:" + strconv.FormatUint(task.Id, 10)
+	for _, payout := range training.SplitOuterStepPayout(task, activity, amount) {
+		if err := k.PayParticipantFromEscrow(ctx, payout.Participant, payout.Amount, memo, nil); err != nil {
+			k.LogError("Error paying training milestone", types.Training, "taskId", task.Id, "outerStep", outerStep, "participant", payout.Participant, "error", err)
+			return err
+		}
+		paid += payout.Amount
+	}
+	task.PaidOut += paid
+
+	k.LogInfo("Paid training milestone", types.Training, "taskId", task.Id, "outerStep", outerStep, "paid", paid)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_milestone_paid",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("outer_step", strconv.FormatInt(int64(outerStep), 10)),
+			sdk.NewAttribute("amount", strconv.FormatUint(paid, 10)),
+		),
+	)
+	return nil
+}
+
+// FinishTrainingTask pays for the last outer step if it completed, marks the task as finished
+// and refunds what is left of its budget to the requester.
+func (k Keeper) FinishTrainingTask(ctx sdk.Context, task *types.TrainingTask) error {
+	if task.FinishedAtBlockHeight != 0 {
+		return types.ErrTrainingTaskFinished
+	}
+	if err := k.CompleteTask(ctx, task.Id); err != nil {
+		return err
+	}
+
+	if task.Epoch != nil && task.Epoch.LastEpochIsFinished {
+		if err := k.PayTrainingMilestone(ctx, task, task.Epoch.LastEpoch); err != nil {
+			return err
+		}
+	}
+	task.FinishedAtBlockHeight = uint64(ctx.BlockHeight())
+
+	return k.closeTrainingTask(ctx, task, "training_task_finished")
+}
+
+// CancelTrainingTask stops a queued or in-progress task and refunds what is left of its budget to
+// the requester. The outer step in progress is not paid for.
+func (k Keeper) CancelTrainingTask(ctx sdk.Context, task *types.TrainingTask) error {
+	if task.FinishedAtBlockHeight != 0 {
+		return types.ErrTrainingTaskFinished
+	}
+	if err := k.CancelTask(ctx, task.Id); err != nil {
+		return err
+	}
+	task.Cancelled = true
+	task.FinishedAtBlockHeight = uint64(ctx.BlockHeight())
+
+	return k.closeTrainingTask(ctx, task, "training_task_cancelled")
+}
+
+func (k Keeper) closeTrainingTask(ctx sdk.Context, task *types.TrainingTask, eventType string) error {
+	refund := TrainingEscrowRemaining(task)
+	if refund > 0 {
+		if err := k.IssueRefund(ctx, refund, task.RequestedBy, "training_refund:"+strconv.FormatUint(task.Id, 10)); err != nil {
+			return err
+		}
+		task.Refunded += refund
+	}
+	k.SetTrainingTask(ctx, task)
+
+	k.LogInfo("Training task closed", types.Training, "taskId", task.Id, "cancelled", task.Cancelled, "paidOut", task.PaidOut, "refunded", refund)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("paid_out", strconv.FormatUint(task.PaidOut, 10)),
+			sdk.NewAttribute("refunded", strconv.FormatUint(refund, 10)),
+		),
+	)
+	return nil
+}
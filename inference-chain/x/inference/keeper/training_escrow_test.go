@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func joinTraining(t *testing.T, ms types.MsgServer, ctx sdk.Context, creator string, nodeId string, taskId uint64, outerStep int32) {
+	_, err := ms.JoinTraining(ctx, &types.MsgJoinTraining{
+		Creator: creator,
+		Req: &types.JoinTrainingRequest{
+			NodeId:    creator + "/" + nodeId,
+			RunId:     taskId,
+			OuterStep: outerStep,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestTrainingTask_EscrowMilestonesAndRefund(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	requester := sdk.MustAccAddressFromBech32(testutil.Requester)
+	executor1 := sdk.MustAccAddressFromBech32(testutil.Creator)
+	executor2 := sdk.MustAccAddressFromBech32(testutil.Executor)
+
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), requester, types.ModuleName, types.GetCoins(1000), "escrow for training task:1").
+		Return(nil)
+	resp, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{
+		Creator:         testutil.Requester,
+		Budget:          1000,
+		OuterStepPayout: 300,
+	})
+	require.NoError(t, err)
+	taskId := resp.Task.Id
+	require.Equal(t, uint64(1), taskId)
+
+	require.NoError(t, k.StartTask(ctx, taskId, []*types.TrainingTaskAssignee{
+		{Participant: testutil.Creator, NodeIds: []string{"node1", "node2"}},
+		{Participant: testutil.Executor, NodeIds: []string{"node1"}},
+	}))
+
+	// All assigned nodes join the first outer step and get ranks
+	joinTraining(t, ms, ctx, testutil.Creator, "node1", taskId, 0)
+	joinTraining(t, ms, ctx, testutil.Creator, "node2", taskId, 0)
+	joinTraining(t, ms, ctx, testutil.Executor, "node1", taskId, 0)
+
+	// Moving on to the next outer step pays for the first one, split by the number of active nodes
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, executor1, types.GetCoins(200), "training_milestone:1").
+		Return(nil)
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, executor2, types.GetCoins(100), "training_milestone:1").
+		Return(nil)
+	joinTraining(t, ms, ctx, testutil.Creator, "node1", taskId, 1)
+	joinTraining(t, ms, ctx, testutil.Creator, "node2", taskId, 1)
+
+	task, found := k.GetTrainingTask(ctx, taskId)
+	require.True(t, found)
+	require.Equal(t, uint64(300), task.PaidOut)
+	require.Equal(t, int32(1), task.NextPayableOuterStep)
+
+	_, err = ms.FinishTrainingTask(ctx, &types.MsgFinishTrainingTask{Creator: testutil.Executor, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// The second outer step never completed, so everything else goes back to the requester
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester, types.GetCoins(700), "training_refund:1").
+		Return(nil)
+	finishResp, err := ms.FinishTrainingTask(ctx, &types.MsgFinishTrainingTask{Creator: testutil.Requester, TaskId: taskId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), finishResp.Refunded)
+
+	task, found = k.GetTrainingTask(ctx, taskId)
+	require.True(t, found)
+	require.Equal(t, uint64(10), task.FinishedAtBlockHeight)
+	require.Equal(t, uint64(700), task.Refunded)
+	inProgress, err := k.ListInProgressTasks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inProgress)
+
+	_, err = ms.FinishTrainingTask(ctx, &types.MsgFinishTrainingTask{Creator: testutil.Requester, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrTrainingTaskFinished)
+}
+
+func TestTrainingTask_PayoutLimitedByEscrow(t *testing.T) {
+	k, _, ctx, mocks := setupKeeperWithMocks(t)
+
+	task := &types.TrainingTask{
+		Id:              1,
+		RequestedBy:     testutil.Requester,
+		Budget:          1000,
+		OuterStepPayout: 300,
+		PaidOut:         900,
+		Assignees:       []*types.TrainingTaskAssignee{{Participant: testutil.Creator, NodeIds: []string{"node1"}}},
+	}
+	k.SetTrainingTaskNodeEpochActivity(ctx, &types.TrainingTaskNodeEpochActivity{
+		TaskId:      1,
+		Participant: testutil.Creator,
+		NodeId:      "node1",
+		Rank:        0,
+		Heartbeat:   &types.TrainingTaskHeartbeat{OuterStep: 3},
+	})
+
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Creator), types.GetCoins(100), "training_milestone:1").
+		Return(nil)
+	require.NoError(t, k.PayTrainingMilestone(ctx, task, 3))
+	require.Equal(t, uint64(1000), task.PaidOut)
+	require.Equal(t, int32(4), task.NextPayableOuterStep)
+
+	// Outer steps are paid once
+	require.NoError(t, k.PayTrainingMilestone(ctx, task, 3))
+	require.Equal(t, uint64(1000), task.PaidOut)
+}
+
+func TestTrainingTask_MilestonesOnlyFromAssigneesOneStepAtATime(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, types.GetCoins(1000), gomock.Any()).
+		Return(nil)
+	resp, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{
+		Creator:         testutil.Requester,
+		Budget:          1000,
+		OuterStepPayout: 300,
+	})
+	require.NoError(t, err)
+	taskId := resp.Task.Id
+	require.NoError(t, k.StartTask(ctx, taskId, []*types.TrainingTaskAssignee{
+		{Participant: testutil.Creator, NodeIds: []string{"node1"}},
+		{Participant: testutil.Executor, NodeIds: []string{"node1"}},
+	}))
+
+	join := func(creator string, nodeId string, outerStep int32) error {
+		_, err := ms.JoinTraining(ctx, &types.MsgJoinTraining{
+			Creator: creator,
+			Req:     &types.JoinTrainingRequest{NodeId: creator + "/" + nodeId, RunId: taskId, OuterStep: outerStep},
+		})
+		return err
+	}
+
+	require.ErrorIs(t, join(testutil.Requester, "node1", 0), types.ErrInvalidSigner)
+	require.ErrorIs(t, join(testutil.Creator, "node2", 0), types.ErrInvalidSigner)
+	require.Error(t, join(testutil.Creator, "node1", 5))
+
+	// The first outer step is not finished until every assignee joined
+	require.NoError(t, join(testutil.Creator, "node1", 0))
+	require.Error(t, join(testutil.Creator, "node1", 1))
+	require.NoError(t, join(testutil.Executor, "node1", 0))
+
+	require.Error(t, join(testutil.Creator, "node1", 2))
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), types.GetCoins(150), "training_milestone:1").
+		Return(nil).Times(2)
+	require.NoError(t, join(testutil.Creator, "node1", 1))
+
+	task, found := k.GetTrainingTask(ctx, taskId)
+	require.True(t, found)
+	require.Equal(t, uint64(300), task.PaidOut)
+	require.Equal(t, int32(1), task.Epoch.LastEpoch)
+}
+
+func TestAssignTrainingTask_OnlyByAssigner(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: testutil.Requester})
+	require.NoError(t, err)
+	assignees := []*types.TrainingTaskAssignee{{Participant: testutil.Executor, NodeIds: []string{"node1"}}}
+
+	_, err = ms.AssignTrainingTask(ctx, &types.MsgAssignTrainingTask{Creator: testutil.Executor, TaskId: 1, Assignees: assignees})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Creator, TaskId: 1})
+	require.NoError(t, err)
+	_, err = ms.AssignTrainingTask(ctx, &types.MsgAssignTrainingTask{Creator: testutil.Executor, TaskId: 1, Assignees: assignees})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	checkPoolSize(t, ctx, k, 1, 0)
+
+	_, err = ms.AssignTrainingTask(ctx, &types.MsgAssignTrainingTask{Creator: testutil.Creator, TaskId: 1, Assignees: assignees})
+	require.NoError(t, err)
+	checkPoolSize(t, ctx, k, 0, 1)
+}
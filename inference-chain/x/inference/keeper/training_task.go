@@ -4,7 +4,9 @@ import (
 	"encoding/binary"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/training"
 	"github.com/productscience/inference/x/inference/types"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -94,8 +96,8 @@ func (k Keeper) StartTask(ctx sdk.Context, taskId uint64, assignees []*types.Tra
 	return nil
 }
 
-// CompleteTask marks a task as finished by removing it from the in-progress set.
-// Optionally, you can also update the full object state to indicate completion.
+// CompleteTask marks a task as finished by removing it from the in-progress set
+// and recording the height it finished at.
 func (k Keeper) CompleteTask(ctx sdk.Context, taskId uint64) error {
 	store := EmptyPrefixStore(ctx, &k)
 
@@ -116,7 +118,7 @@ func (k Keeper) CompleteTask(ctx sdk.Context, taskId uint64) error {
 	var task types.TrainingTask
 	k.cdc.MustUnmarshal(bz, &task)
 
-	// TODO: update the task object to mark it as "finished"
+	task.FinishedAtBlockHeight = uint64(ctx.BlockHeight())
 	updatedBz := k.cdc.MustMarshal(&task)
 	store.Set(taskKey, updatedBz)
 
@@ -186,3 +188,212 @@ func (k Keeper) GetAllTrainingTasks(ctx sdk.Context) ([]*types.TrainingTask, err
 		return &types.TrainingTask{}
 	})
 }
+
+// CancelTask removes a task from the queued or in-progress set, whichever it is in,
+// and records the height it was cancelled at.
+func (k Keeper) CancelTask(ctx sdk.Context, taskId uint64) error {
+	store := EmptyPrefixStore(ctx, &k)
+
+	queuedKey := types.QueuedTrainingTaskFullKey(taskId)
+	inProgressKey := types.InProgressTrainingTaskFullKey(taskId)
+	switch {
+	case store.Has(queuedKey):
+		store.Delete(queuedKey)
+	case store.Has(inProgressKey):
+		store.Delete(inProgressKey)
+	default:
+		return fmt.Errorf("task %d is neither queued nor in progress", taskId)
+	}
+
+	taskKey := types.TrainingTaskFullKey(taskId)
+	bz := store.Get(taskKey)
+	if bz == nil {
+		return fmt.Errorf("task %d not found in full object store", taskId)
+	}
+	var task types.TrainingTask
+	k.cdc.MustUnmarshal(bz, &task)
+
+	task.Cancelled = true
+	task.FinishedAtBlockHeight = uint64(ctx.BlockHeight())
+	updatedBz := k.cdc.MustMarshal(&task)
+	store.Set(taskKey, updatedBz)
+
+	return nil
+}
+
+// RequeueTask moves an in-progress task back to the queued set so it can be claimed and assigned again.
+// The outer step progress is kept: the new assignees join the step the previous ones got to.
+func (k Keeper) RequeueTask(ctx sdk.Context, taskId uint64) error {
+	store := EmptyPrefixStore(ctx, &k)
+
+	inProgressKey := types.InProgressTrainingTaskFullKey(taskId)
+	if !store.Has(inProgressKey) {
+		return fmt.Errorf("task %d is not in progress", taskId)
+	}
+	store.Delete(inProgressKey)
+	store.Set(types.QueuedTrainingTaskFullKey(taskId), []byte{})
+
+	taskKey := types.TrainingTaskFullKey(taskId)
+	bz := store.Get(taskKey)
+	if bz == nil {
+		return fmt.Errorf("task %d not found in full object store", taskId)
+	}
+	var task types.TrainingTask
+	k.cdc.MustUnmarshal(bz, &task)
+
+	task.Assigner = ""
+	task.ClaimedByAssignerAtBlockHeight = 0
+	task.AssignedAtBlockHeight = 0
+	task.Assignees = nil
+	task.ReplacementResources = nil
+	task.NodesReplacedAtBlockHeight = 0
+	if task.Epoch != nil {
+		task.Epoch.LastEpochIsFinished = false
+		task.Epoch.LastEpochBlockHeight = ctx.BlockHeight()
+		task.Epoch.LastEpochTimestamp = ctx.BlockTime().Unix()
+	}
+	updatedBz := k.cdc.MustMarshal(&task)
+	store.Set(taskKey, updatedBz)
+
+	return nil
+}
+
+// ReleaseLostNodes removes the lost nodes from the task's assignees and opens their hardware to
+// the task's assigner, the remaining nodes carry on with the run meanwhile.
+func (k Keeper) ReleaseLostNodes(ctx sdk.Context, task *types.TrainingTask, lost []training.GlobalNodeId) {
+	lostNodes := make(map[training.GlobalNodeId]bool, len(lost))
+	for _, nodeId := range lost {
+		lostNodes[nodeId] = true
+	}
+
+	var assignees []*types.TrainingTaskAssignee
+	for _, assignee := range task.Assignees {
+		var nodeIds []string
+		for _, nodeId := range assignee.NodeIds {
+			if !lostNodes[training.GlobalNodeId{Participant: assignee.Participant, LocalNodeId: nodeId}] {
+				nodeIds = append(nodeIds, nodeId)
+			}
+		}
+		if len(nodeIds) > 0 {
+			assignees = append(assignees, &types.TrainingTaskAssignee{Participant: assignee.Participant, NodeIds: nodeIds})
+		}
+	}
+
+	for _, nodeId := range lost {
+		for _, hardware := range k.getNodeHardware(ctx, nodeId) {
+			task.ReplacementResources = addTrainingHardware(task.ReplacementResources, hardware.Type, hardware.Count)
+		}
+	}
+	task.Assignees = assignees
+	// The assigner of the task keeps it and gets a fresh claim to pick the replacements
+	task.ClaimedByAssignerAtBlockHeight = uint64(ctx.BlockHeight())
+	k.SetTrainingTask(ctx, task)
+}
+
+// AddTaskAssignees assigns the nodes replacing the ones an in-progress task lost
+func (k Keeper) AddTaskAssignees(ctx sdk.Context, taskId uint64, assignees []*types.TrainingTaskAssignee) error {
+	store := EmptyPrefixStore(ctx, &k)
+	if !store.Has(types.InProgressTrainingTaskFullKey(taskId)) {
+		return fmt.Errorf("task %d is not in progress", taskId)
+	}
+	task, found := k.GetTrainingTask(ctx, taskId)
+	if !found {
+		return types.ErrTrainingTaskNotFound
+	}
+	if len(task.ReplacementResources) == 0 {
+		return fmt.Errorf("task %d has no nodes to replace", taskId)
+	}
+	if err := k.validateReplacementNodes(ctx, task, assignees); err != nil {
+		return err
+	}
+
+	for _, assignee := range assignees {
+		var existing *types.TrainingTaskAssignee
+		for _, a := range task.Assignees {
+			if a.Participant == assignee.Participant {
+				existing = a
+				break
+			}
+		}
+		if existing == nil {
+			task.Assignees = append(task.Assignees, &types.TrainingTaskAssignee{Participant: assignee.Participant, NodeIds: assignee.NodeIds})
+			continue
+		}
+		for _, nodeId := range assignee.NodeIds {
+			if !slices.Contains(existing.NodeIds, nodeId) {
+				existing.NodeIds = append(existing.NodeIds, nodeId)
+			}
+		}
+	}
+	task.ReplacementResources = nil
+	task.NodesReplacedAtBlockHeight = uint64(ctx.BlockHeight())
+	k.SetTrainingTask(ctx, task)
+	return nil
+}
+
+// validateReplacementNodes checks that the replacement nodes are registered, are not training the task
+// already and together provide the hardware the task lost
+func (k Keeper) validateReplacementNodes(ctx sdk.Context, task *types.TrainingTask, assignees []*types.TrainingTaskAssignee) error {
+	var provided []*types.TrainingHardwareResources
+	for _, assignee := range assignees {
+		for _, nodeId := range assignee.NodeIds {
+			globalNodeId := training.GlobalNodeId{Participant: assignee.Participant, LocalNodeId: nodeId}
+			if IsTrainingTaskAssignee(task, globalNodeId) {
+				return fmt.Errorf("node %s is already assigned to task %d", globalNodeId.ToString(), task.Id)
+			}
+			hardware := k.getNodeHardware(ctx, globalNodeId)
+			if len(hardware) == 0 {
+				return fmt.Errorf("node %s has no registered hardware", globalNodeId.ToString())
+			}
+			for _, h := range hardware {
+				provided = addTrainingHardware(provided, h.Type, h.Count)
+			}
+		}
+	}
+
+	for _, required := range task.ReplacementResources {
+		var count uint32
+		for _, resource := range provided {
+			if resource.Type == required.Type {
+				count = resource.Count
+			}
+		}
+		if count < required.Count {
+			return fmt.Errorf("replacement nodes provide %d of %d %s required by task %d", count, required.Count, required.Type, task.Id)
+		}
+	}
+	return nil
+}
+
+// IsTrainingTaskAssignee reports whether the node is currently assigned to the task
+func IsTrainingTaskAssignee(task *types.TrainingTask, nodeId training.GlobalNodeId) bool {
+	for _, assignee := range task.Assignees {
+		if assignee.Participant == nodeId.Participant {
+			return slices.Contains(assignee.NodeIds, nodeId.LocalNodeId)
+		}
+	}
+	return false
+}
+
+func (k Keeper) getNodeHardware(ctx sdk.Context, nodeId training.GlobalNodeId) []*types.Hardware {
+	hardwareNodes, found := k.GetHardwareNodes(ctx, nodeId.Participant)
+	if !found {
+		return nil
+	}
+	for _, node := range hardwareNodes.HardwareNodes {
+		if node.LocalId == nodeId.LocalNodeId {
+			return node.Hardware
+		}
+	}
+	return nil
+}
+
+func addTrainingHardware(resources []*types.TrainingHardwareResources, hardwareType string, count uint32) []*types.TrainingHardwareResources {
+	for _, resource := range resources {
+		if resource.Type == hardwareType {
+			resource.Count += count
+			return resources
+		}
+	}
+	return append(resources, &types.TrainingHardwareResources{Type: hardwareType, Count: count})
+}
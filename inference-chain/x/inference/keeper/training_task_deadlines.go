@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/training"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ProcessTrainingTaskDeadlines releases claims that weren't followed by an assignment in time, returns
+// to the queue the in-progress tasks whose assignees never started or lost all their nodes, and opens
+// the slots of the nodes lost by the other tasks to assigners.
+func (k Keeper) ProcessTrainingTaskDeadlines(ctx sdk.Context) error {
+	blockHeight := ctx.BlockHeight()
+	trainingParams := k.GetParams(ctx).TrainingParamsOrDefault()
+
+	queued, err := k.ListQueuedTasks(ctx)
+	if err != nil {
+		return err
+	}
+	tasks, err := k.GetTasks(ctx, queued)
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		if task.Assigner == "" || blockHeight-int64(task.ClaimedByAssignerAtBlockHeight) <= trainingParams.AssignmentDeadlineBlocks {
+			continue
+		}
+		k.LogInfo("Training task claim expired", types.Training, "taskId", task.Id, "assigner", task.Assigner, "claimedAt", task.ClaimedByAssignerAtBlockHeight)
+		assigner := task.Assigner
+		task.Assigner = ""
+		task.ClaimedByAssignerAtBlockHeight = 0
+		k.SetTrainingTask(ctx, task)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"training_task_claim_expired",
+				sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+				sdk.NewAttribute("assigner", assigner),
+			),
+		)
+	}
+
+	inProgress, err := k.ListInProgressTasks(ctx)
+	if err != nil {
+		return err
+	}
+	tasks, err = k.GetTasks(ctx, inProgress)
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		if err := k.processInProgressTaskDeadlines(ctx, task, trainingParams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) processInProgressTaskDeadlines(ctx sdk.Context, task *types.TrainingTask, trainingParams *types.TrainingParams) error {
+	blockHeight := ctx.BlockHeight()
+	if task.Epoch == nil || task.Epoch.LastEpoch < 0 {
+		if blockHeight-int64(task.AssignedAtBlockHeight) > trainingParams.StartDeadlineBlocks {
+			return k.requeueTrainingTask(ctx, task, "start_deadline")
+		}
+		return nil
+	}
+
+	// Nodes joining an outer step, or replacing lost ones, get the reassignment timeout from the time they could join
+	stepStart := max(task.Epoch.LastEpochBlockHeight, int64(task.AssignedAtBlockHeight), int64(task.NodesReplacedAtBlockHeight))
+	if blockHeight-stepStart <= trainingParams.NodeReassignmentTimeoutBlocks {
+		return nil
+	}
+	activity, err := k.GetTrainingTaskNodeActivityAtEpoch(ctx, task.Id, task.Epoch.LastEpoch)
+	if err != nil {
+		return err
+	}
+	lost := training.LostNodes(task, activity, training.NewBlockInfo(ctx), trainingParams.NodeReassignmentTimeoutBlocks)
+	if len(lost) == 0 {
+		return nil
+	}
+	assignedNodes := 0
+	for _, assignee := range task.Assignees {
+		assignedNodes += len(assignee.NodeIds)
+	}
+	if len(lost) == assignedNodes {
+		return k.requeueTrainingTask(ctx, task, "nodes_lost")
+	}
+
+	nodeIds := make([]string, len(lost))
+	for i, nodeId := range lost {
+		nodeIds[i] = nodeId.ToString()
+	}
+	k.LogInfo("Training task lost nodes, reassigning them", types.Training, "taskId", task.Id, "nodes", nodeIds)
+	k.ReleaseLostNodes(ctx, task, lost)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_task_nodes_lost",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("node_ids", strings.Join(nodeIds, ",")),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) requeueTrainingTask(ctx sdk.Context, task *types.TrainingTask, reason string) error {
+	k.LogInfo("Returning training task to the queue", types.Training, "taskId", task.Id, "reason", reason)
+	if err := k.RequeueTask(ctx, task.Id); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_task_requeued",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
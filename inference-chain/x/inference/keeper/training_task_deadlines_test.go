@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestCancelTrainingTask_RefundsBudget(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	requester := sdk.MustAccAddressFromBech32(testutil.Requester)
+
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), requester, types.ModuleName, types.GetCoins(500), gomock.Any()).
+		Return(nil)
+	resp, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{
+		Creator:         testutil.Requester,
+		Budget:          500,
+		OuterStepPayout: 100,
+	})
+	require.NoError(t, err)
+	taskId := resp.Task.Id
+
+	_, err = ms.CancelTrainingTask(ctx, &types.MsgCancelTrainingTask{Creator: testutil.Creator, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester, types.GetCoins(500), "training_refund:1").
+		Return(nil)
+	cancelResp, err := ms.CancelTrainingTask(ctx, &types.MsgCancelTrainingTask{Creator: testutil.Requester, TaskId: taskId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), cancelResp.Refunded)
+
+	task, found := k.GetTrainingTask(ctx, taskId)
+	require.True(t, found)
+	require.True(t, task.Cancelled)
+	require.Equal(t, uint64(10), task.FinishedAtBlockHeight)
+	checkPoolSize(t, ctx, k, 0, 0)
+
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Creator, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrTrainingTaskFinished)
+	_, err = ms.CancelTrainingTask(ctx, &types.MsgCancelTrainingTask{Creator: testutil.Requester, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrTrainingTaskFinished)
+}
+
+func TestTrainingTaskDeadlines_ClaimExpires(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	assignmentDeadline := k.GetParams(ctx).TrainingParams.AssignmentDeadlineBlocks
+
+	_, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: testutil.Requester})
+	require.NoError(t, err)
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Creator, TaskId: 1})
+	require.NoError(t, err)
+
+	// Another assigner can't take over while the claim is fresh
+	ctx = ctx.WithBlockHeight(10 + assignmentDeadline)
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Executor, TaskId: 1})
+	require.ErrorIs(t, err, types.ErrTrainingTaskAlreadyAssigned)
+	require.NoError(t, k.ProcessTrainingTaskDeadlines(ctx))
+	task, _ := k.GetTrainingTask(ctx, 1)
+	require.Equal(t, testutil.Creator, task.Assigner)
+
+	ctx = ctx.WithBlockHeight(11 + assignmentDeadline)
+	require.NoError(t, k.ProcessTrainingTaskDeadlines(ctx))
+	task, _ = k.GetTrainingTask(ctx, 1)
+	require.Empty(t, task.Assigner)
+	require.Zero(t, task.ClaimedByAssignerAtBlockHeight)
+	checkPoolSize(t, ctx, k, 1, 0)
+}
+
+func TestTrainingTaskDeadlines_RequeueWhenNotStarted(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	startDeadline := k.GetParams(ctx).TrainingParams.StartDeadlineBlocks
+
+	_, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: testutil.Requester})
+	require.NoError(t, err)
+	require.NoError(t, k.StartTask(ctx, 1, []*types.TrainingTaskAssignee{
+		{Participant: testutil.Creator, NodeIds: []string{"node1"}},
+	}))
+
+	ctx = ctx.WithBlockHeight(10 + startDeadline)
+	require.NoError(t, k.ProcessTrainingTaskDeadlines(ctx))
+	checkPoolSize(t, ctx, k, 0, 1)
+
+	ctx = ctx.WithBlockHeight(11 + startDeadline)
+	require.NoError(t, k.ProcessTrainingTaskDeadlines(ctx))
+	checkPoolSize(t, ctx, k, 1, 0)
+	task, _ := k.GetTrainingTask(ctx, 1)
+	require.Empty(t, task.Assignees)
+	require.Zero(t, task.AssignedAtBlockHeight)
+}
+
+func TestTrainingTaskDeadlines_ReassignLostNodes(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: testutil.Requester})
+	require.NoError(t, err)
+	require.NoError(t, k.SetHardwareNodes(ctx, &types.HardwareNodes{
+		Participant: testutil.Executor,
+		HardwareNodes: []*types.HardwareNode{
+			{LocalId: "node1", Hardware: []*types.Hardware{{Type: "H100", Count: 8}}},
+		},
+	}))
+	require.NoError(t, k.SetHardwareNodes(ctx, &types.HardwareNodes{
+		Participant: testutil.Requester,
+		HardwareNodes: []*types.HardwareNode{
+			{LocalId: "node3", Hardware: []*types.Hardware{{Type: "H100", Count: 8}}},
+			{LocalId: "node4", Hardware: []*types.Hardware{{Type: "A100", Count: 8}}},
+		},
+	}))
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Creator, TaskId: 1})
+	require.NoError(t, err)
+	_, err = ms.AssignTrainingTask(ctx, &types.MsgAssignTrainingTask{
+		Creator: testutil.Creator,
+		TaskId:  1,
+		Assignees: []*types.TrainingTaskAssignee{
+			{Participant: testutil.Creator, NodeIds: []string{"node1", "node2"}},
+			{Participant: testutil.Executor, NodeIds: []string{"node1"}},
+		},
+	})
+	require.NoError(t, err)
+	joinTraining(t, ms, ctx, testutil.Creator, "node1", 1, 0)
+	joinTraining(t, ms, ctx, testutil.Creator, "node2", 1, 0)
+	joinTraining(t, ms, ctx, testutil.Executor, "node1", 1, 0)
+
+	heartbeat := func(creator string, nodeId string) {
+		_, err := ms.TrainingHeartbeat(ctx, &types.MsgTrainingHeartbeat{
+			Creator: creator,
+			Req:     &types.HeartbeatRequest{NodeId: creator + "/" + nodeId, RunId: 1, OuterStep: 0},
+		})
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockHeight(100)
+	heartbeat(testutil.Creator, "node1")
+	heartbeat(testutil.Creator, "node2")
+
+	// Only the lost node is released, the task stays in progress with the others
+	ctx = ctx.WithBlockHeight(180)
+	require.NoError(t, k.ProcessTrainingTaskDeadlines(ctx))
+	checkPoolSize(t, ctx, k, 0, 1)
+	task, _ := k.GetTrainingTask(ctx, 1)
+	require.Equal(t, []*types.TrainingTaskAssignee{{Participant: testutil.Creator, NodeIds: []string{"node1", "node2"}}}, task.Assignees)
+	require.Equal(t, []*types.TrainingHardwareResources{{Type: "H100", Count: 8}}, task.ReplacementResources)
+
+	require.Equal(t, testutil.Creator, task.Assigner)
+	require.Equal(t, uint64(180), task.ClaimedByAssignerAtBlockHeight)
+
+	// Only the task's assigner picks the replacements, and they must bring the lost hardware
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Requester, TaskId: 1})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	replace := func(creator string, nodeIds ...string) error {
+		_, err := ms.AssignTrainingTask(ctx, &types.MsgAssignTrainingTask{
+			Creator:   creator,
+			TaskId:    1,
+			Assignees: []*types.TrainingTaskAssignee{{Participant: testutil.Requester, NodeIds: nodeIds}},
+		})
+		return err
+	}
+	require.ErrorIs(t, replace(testutil.Requester, "node3"), types.ErrInvalidSigner)
+	require.Error(t, replace(testutil.Creator, "node4"))
+	require.Error(t, replace(testutil.Creator, "node5"))
+	require.NoError(t, replace(testutil.Creator, "node3"))
+	task, _ = k.GetTrainingTask(ctx, 1)
+	require.Len(t, task.Assignees, 2)
+	require.Empty(t, task.ReplacementResources)
+	require.Equal(t, uint64(180), task.NodesReplacedAtBlockHeight)
+	require.Equal(t, uint64(10), task.AssignedAtBlockHeight)
+
+	_, err = ms.ClaimTrainingTaskForAssignment(ctx, &types.MsgClaimTrainingTaskForAssignment{Creator: testutil.Requester, TaskId: 1})
+	require.ErrorIs(t, err, types.ErrTrainingTaskAlreadyAssigned)
+
+	// Losing every node returns the whole task to the queue
+	ctx = ctx.WithBlockHeight(300)
+	require.NoError(t, k.ProcessTrainingTaskDeadlines(ctx))
+	checkPoolSize(t, ctx, k, 1, 0)
+
+	task, _ = k.GetTrainingTask(ctx, 1)
+	require.Empty(t, task.Assignees)
+	require.Zero(t, task.NodesReplacedAtBlockHeight)
+	require.Equal(t, int32(0), task.Epoch.LastEpoch)
+	require.False(t, task.Epoch.LastEpochIsFinished)
+	require.Equal(t, int64(300), task.Epoch.LastEpochBlockHeight)
+}
@@ -45,6 +45,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query get-random-executor",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod:      "ExecutorCandidates",
+					Use:            "executor-candidates [model]",
+					Short:          "Lists the executors a random executor for the model is selected from, with their weights",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "model"}},
+				},
 
 				{
 					RpcMethod:      "InferenceParticipant",
@@ -75,6 +81,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows a settleAmount",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
 				},
+				{
+					RpcMethod:      "PendingClaims",
+					Use:            "pending-claims [participant]",
+					Short:          "Lists the unclaimed settle amounts of a participant and when they expire",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
+				{
+					RpcMethod:      "BridgeBlockHeader",
+					Use:            "bridge-block-header [origin-chain] [block-number]",
+					Short:          "Shows the receipts root validators attested for an origin chain block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "origin_chain"}, {ProtoField: "block_number"}},
+				},
+				{
+					RpcMethod:      "BridgeWithdrawal",
+					Use:            "bridge-withdrawal [id]",
+					Short:          "Shows a bridge withdrawal with its signed payload",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "DeveloperSubKey",
+					Use:            "developer-sub-key [developer] [name]",
+					Short:          "Shows a developer sub-key with its spending in the current epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "developer"}, {ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "DeveloperSubKeys",
+					Use:            "developer-sub-keys [developer]",
+					Short:          "Lists the sub-keys of a developer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "developer"}},
+				},
 				{
 					RpcMethod: "EpochGroupValidationsAll",
 					Use:       "list-epoch-group-validations",
@@ -261,6 +297,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query list-training-kv-record-keys",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "taskId"}},
 				},
+				{
+					RpcMethod:      "TrainingCheckpoint",
+					Use:            "training-checkpoint [task-id] [outer-step]",
+					Short:          "Query the checkpoint of a training task at an outer step",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "outer_step"}},
+				},
+				{
+					RpcMethod:      "TrainingCheckpoints",
+					Use:            "training-checkpoints [task-id]",
+					Short:          "Query all checkpoints of a training task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
 
 				{
 					RpcMethod:      "GetAllModelCapacities",
@@ -331,6 +379,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a submitNewParticipant tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "url"}},
 				},
+				{
+					RpcMethod:      "UpdateParticipantRegion",
+					Use:            "update-participant-region [region]",
+					Short:          "Changes the region the participant declares, an empty region clears it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "region", Optional: true}},
+				},
 				{
 					RpcMethod:      "Validation",
 					Use:            "validation [id] [inference-id] [response-payload] [response-hash] [value]",
@@ -355,12 +409,69 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a revalidateInference tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}},
 				},
+				{
+					RpcMethod:      "AbandonInference",
+					Use:            "abandon-inference [inference-id] [reason]",
+					Short:          "Send an abandonInference tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}, {ProtoField: "reason"}},
+				},
 				{
 					RpcMethod:      "ClaimRewards",
 					Use:            "claim-rewards [seed] [poc-start-height]",
 					Short:          "Send a claimRewards tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "seed"}, {ProtoField: "epoch_index"}},
 				},
+				{
+					RpcMethod: "BatchClaimRewards",
+					Skip:      true, // repeated claims are not supported by autocli positional args
+				},
+				{
+					RpcMethod:      "AttestBridgeBlock",
+					Use:            "attest-bridge-block [origin-chain] [block-number] [receipts-root]",
+					Short:          "Attests the receipts root of a finalized origin chain block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "originChain"}, {ProtoField: "blockNumber"}, {ProtoField: "receiptsRoot"}},
+				},
+				{
+					RpcMethod: "BridgeDepositProof",
+					Skip:      true, // receipt proofs are not supported by autocli positional args
+				},
+				{
+					RpcMethod:      "BridgeWithdraw",
+					Use:            "bridge-withdraw [destination-chain] [contract-address] [recipient] [amount]",
+					Short:          "Burns bridged tokens and requests a threshold signature releasing them on the destination chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "destinationChain"}, {ProtoField: "contractAddress"}, {ProtoField: "recipient"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "CreateDeveloperSubKey",
+					Use:            "create-developer-sub-key [name] [pub-key]",
+					Short:          "Lets a key sign inference requests paid by your account, within the limits given as flags",
+					Long:           "Lets a key sign inference requests paid by your account. Limits are set with --max-coins-per-epoch, --allowed-models, --max-tokens and --expires-at (unix seconds), unset limits don't apply. Clients send the name in the X-Developer-Key header.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "pub_key"}},
+				},
+				{
+					RpcMethod:      "RevokeDeveloperSubKey",
+					Use:            "revoke-developer-sub-key [name]",
+					Short:          "Stops a developer sub-key from starting inferences",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "FinishTrainingTask",
+					Use:            "finish-training-task [task-id]",
+					Short:          "Finishes a training task and refunds its unspent budget to the requester",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "CancelTrainingTask",
+					Use:            "cancel-training-task [task-id]",
+					Short:          "Cancels a training task and refunds its unspent budget to the requester",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "SubmitTrainingCheckpoint",
+					Use:            "submit-training-checkpoint [task-id] [outer-step] [content-hash] [storage-uri]",
+					Short:          "Registers or signs the checkpoint of a training task produced at an outer step",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "outer_step"}, {ProtoField: "content_hash"}, {ProtoField: "storage_uri"}},
+				},
 				{
 					RpcMethod:      "SubmitPocBatch",
 					Use:            "submit-poc-batch [poc-stage-start-block-height] [nonces] [dist]",
@@ -191,7 +191,7 @@ func TestNoCollateralPostGracePeriod(t *testing.T) {
 	addr, err := sdk.AccAddressFromBech32(participantAddress)
 	require.NoError(t, err)
 	mocks.CollateralKeeper.EXPECT().
-		GetCollateral(gomock.Any(), addr).
+		GetTotalCollateral(gomock.Any(), addr).
 		Return(sdk.Coin{}, false). // No collateral found
 		Times(1)
 
@@ -238,7 +238,7 @@ func TestPostGracePeriod_FullCollateral(t *testing.T) {
 	require.NoError(t, err)
 	requiredCollateral := sdk.NewCoin(types.BaseCoin, math.NewInt(800))
 	mocks.CollateralKeeper.EXPECT().
-		GetCollateral(gomock.Any(), addr).
+		GetTotalCollateral(gomock.Any(), addr).
 		Return(requiredCollateral, true). // Full collateral found
 		Times(1)
 
@@ -285,7 +285,7 @@ func TestPostGracePeriod_PartialCollateral(t *testing.T) {
 	require.NoError(t, err)
 	partialCollateral := sdk.NewCoin(types.BaseCoin, math.NewInt(400))
 	mocks.CollateralKeeper.EXPECT().
-		GetCollateral(gomock.Any(), addr).
+		GetTotalCollateral(gomock.Any(), addr).
 		Return(partialCollateral, true). // Partial collateral found
 		Times(1)
 
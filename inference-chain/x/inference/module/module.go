@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -163,7 +164,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -185,8 +186,11 @@ func (am AppModule) expireInferences(ctx context.Context, timeouts []types.Infer
 		if !found {
 			continue
 		}
-		if inference.Status == types.InferenceStatus_STARTED {
+		switch inference.Status {
+		case types.InferenceStatus_STARTED:
 			am.handleExpiredInference(ctx, inference)
+		case types.InferenceStatus_ABANDONED:
+			am.handleExpiredAbandonedInference(ctx, inference)
 		}
 	}
 	return nil
@@ -204,10 +208,31 @@ func (am AppModule) handleExpiredInference(ctx context.Context, inference types.
 	err := am.keeper.IssueRefund(ctx, uint64(inference.EscrowAmount), inference.RequestedBy, "expired_inference:"+inference.InferenceId)
 	if err != nil {
 		am.LogError("Error issuing refund", types.Inferences, "error", err)
+	} else {
+		am.keeper.ReleaseDeveloperSubKey(ctx, &inference, uint64(inference.EscrowAmount))
 	}
 	am.keeper.SetInference(ctx, inference)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_expired",
+			sdk.NewAttribute("inference_id", inference.InferenceId),
+			sdk.NewAttribute("executor", inference.AssignedTo),
+			sdk.NewAttribute("requested_by", inference.RequestedBy),
+			sdk.NewAttribute("refund", strconv.FormatInt(inference.EscrowAmount, 10)),
+		),
+	)
 	executor.CurrentEpochStats.MissedRequests++
 	am.keeper.SetParticipant(ctx, executor)
+	am.keeper.ChargeAbandonedExecutors(ctx, &inference)
+}
+
+// handleExpiredAbandonedInference closes an abandoned inference nobody took over. The escrow was
+// already refunded by the abandon, so only the abandoned executors are charged.
+func (am AppModule) handleExpiredAbandonedInference(ctx context.Context, inference types.Inference) {
+	am.LogInfo("Abandoned inference expired", types.Inferences, "inferenceId", inference.InferenceId, "abandonedExecutors", inference.AbandonedExecutors)
+	inference.Status = types.InferenceStatus_EXPIRED
+	am.keeper.SetInference(ctx, inference)
+	am.keeper.ChargeAbandonedExecutors(ctx, &inference)
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
@@ -240,6 +265,21 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		am.keeper.RemoveInferenceTimeout(ctx, t.ExpirationHeight, t.InferenceId)
 	}
 
+	// A pruning pass starts at the PoC stage and then removes a batch of inferences every block
+	if !epochContext.IsStartOfPocStage(blockHeight) {
+		if _, err := am.keeper.PruneInferencesBatch(ctx); err != nil {
+			am.LogError("Error pruning inferences", types.Pruning, "error", err)
+		}
+	}
+
+	// Unfinalized votes are pruned a batch per block, every validator of the epoch had a chance to attest by then
+	pruned, err := am.keeper.PruneBridgeBlockAttestations(ctx, blockHeight-epochParams.EpochLength)
+	if err != nil {
+		am.LogError("Error pruning bridge block attestations", types.Messages, "error", err)
+	} else if pruned > 0 {
+		am.LogInfo("Pruned unfinalized bridge block attestations", types.Messages, "count", pruned)
+	}
+
 	partialUpgrades := am.keeper.GetAllPartialUpgrade(ctx)
 	for _, pu := range partialUpgrades {
 		if pu.Height < uint64(blockHeight) {
@@ -248,6 +288,17 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		}
 	}
 
+	// Deadlines are counted in tens of blocks, there is no need to go through the tasks every block
+	if blockHeight%am.keeper.GetParams(ctx).TrainingParamsOrDefault().DeadlineCheckIntervalBlocks == 0 {
+		if err := am.keeper.ProcessTrainingTaskDeadlines(sdkCtx); err != nil {
+			am.LogError("Error processing training task deadlines", types.Training, "error", err)
+		}
+	}
+
+	if err := am.keeper.ProcessPendingBridgeWithdrawals(sdkCtx); err != nil {
+		am.LogError("Error processing pending bridge withdrawals", types.Messages, "error", err)
+	}
+
 	// Stage execution order for epoch transitions:
 	// 1. IsEndOfPoCValidationStage: Complete all epoch formation (onEndOfPoCValidationStage)
 	// 2. IsSetNewValidatorsStage: Switch validators and activate epoch (onSetNewValidatorsStage)
@@ -372,13 +423,7 @@ func (am AppModule) onEndOfPoCValidationStage(ctx context.Context, blockHeight i
 		}
 	}
 
-	previousEpoch, found := am.keeper.GetPreviousEpoch(ctx)
-	previousEpochIndex := uint64(0)
-	if found {
-		previousEpochIndex = previousEpoch.Index
-	}
-
-	err := am.keeper.SettleAccounts(ctx, effectiveEpoch.Index, previousEpochIndex)
+	err := am.keeper.SettleAccounts(ctx, effectiveEpoch.Index)
 	if err != nil {
 		am.LogError("onEndOfPoCValidationStage: Unable to settle accounts", types.Settle, "error", err.Error())
 	}
@@ -19,11 +19,14 @@ var InferenceOperationKeyPerms = []sdk.Msg{
 	&types.MsgStartInference{},
 	&types.MsgFinishInference{},
 	&types.MsgClaimRewards{},
+	&types.MsgBatchClaimRewards{},
 	&types.MsgValidation{},
 	&types.MsgSubmitPocBatch{},
 	&types.MsgSubmitPocValidation{},
 	&types.MsgSubmitSeed{},
 	&types.MsgBridgeExchange{},
+	&types.MsgAttestBridgeBlock{},
+	&types.MsgBridgeDepositProof{},
 	&types.MsgSubmitTrainingKvRecord{},
 	&types.MsgJoinTraining{},
 	&types.MsgJoinTrainingStatus{},
@@ -33,9 +36,11 @@ var InferenceOperationKeyPerms = []sdk.Msg{
 	&types.MsgAssignTrainingTask{},
 	&types.MsgSubmitNewUnfundedParticipant{},
 	&types.MsgSubmitNewParticipant{},
+	&types.MsgUpdateParticipantRegion{},
 	&types.MsgSubmitHardwareDiff{},
 	&types.MsgInvalidateInference{},
 	&types.MsgRevalidateInference{},
+	&types.MsgAbandonInference{},
 	&blstypes.MsgSubmitDealerPart{},
 	&blstypes.MsgSubmitVerificationVector{},
 	&blstypes.MsgRequestThresholdSignature{},
@@ -0,0 +1,51 @@
+package training
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type MilestonePayout struct {
+	Participant string
+	Amount      uint64
+}
+
+// SplitOuterStepPayout splits the payout of a completed outer step between the assignees,
+// proportionally to the number of their nodes that held a rank at the end of the step.
+// Activity of nodes that aren't assigned to the task or dropped out of the step earns nothing.
+// Whatever is lost to rounding stays in escrow.
+func SplitOuterStepPayout(task *types.TrainingTask, activity []*types.TrainingTaskNodeEpochActivity, amount uint64) []MilestonePayout {
+	assigned := make(map[GlobalNodeId]bool)
+	for _, assignee := range task.Assignees {
+		for _, nodeId := range assignee.NodeIds {
+			assigned[GlobalNodeId{Participant: assignee.Participant, LocalNodeId: nodeId}] = true
+		}
+	}
+
+	activeNodes := make(map[string]uint64)
+	var totalNodes uint64
+	for _, rec := range activity {
+		if rec.Rank < 0 || !assigned[GlobalNodeId{Participant: rec.Participant, LocalNodeId: rec.NodeId}] {
+			continue
+		}
+		activeNodes[rec.Participant]++
+		totalNodes++
+	}
+	if totalNodes == 0 || amount == 0 {
+		return nil
+	}
+
+	payouts := make([]MilestonePayout, 0, len(activeNodes))
+	for participant, nodes := range activeNodes {
+		payouts = append(payouts, MilestonePayout{
+			Participant: participant,
+			Amount:      math.NewIntFromUint64(amount).Mul(math.NewIntFromUint64(nodes)).Quo(math.NewIntFromUint64(totalNodes)).Uint64(),
+		})
+	}
+	sort.Slice(payouts, func(i, j int) bool {
+		return payouts[i].Participant < payouts[j].Participant
+	})
+	return payouts
+}
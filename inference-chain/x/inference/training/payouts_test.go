@@ -0,0 +1,84 @@
+package training_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/training"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func nodeActivity(participant string, nodeId string, rank int32) *types.TrainingTaskNodeEpochActivity {
+	return &types.TrainingTaskNodeEpochActivity{
+		TaskId:      1,
+		Participant: participant,
+		NodeId:      nodeId,
+		Rank:        rank,
+		Heartbeat:   &types.TrainingTaskHeartbeat{OuterStep: 0},
+	}
+}
+
+func TestSplitOuterStepPayout(t *testing.T) {
+	task := &types.TrainingTask{
+		Id: 1,
+		Assignees: []*types.TrainingTaskAssignee{
+			{Participant: "participantB", NodeIds: []string{"node1", "node2"}},
+			{Participant: "participantA", NodeIds: []string{"node1"}},
+		},
+	}
+
+	activity := []*types.TrainingTaskNodeEpochActivity{
+		nodeActivity("participantB", "node1", 1),
+		nodeActivity("participantB", "node2", 2),
+		nodeActivity("participantA", "node1", 0),
+		// Not assigned to the task
+		nodeActivity("participantC", "node1", 3),
+		nodeActivity("participantA", "node9", 4),
+	}
+	payouts := training.SplitOuterStepPayout(task, activity, 100)
+	require.Equal(t, []training.MilestonePayout{
+		{Participant: "participantA", Amount: 33},
+		{Participant: "participantB", Amount: 66},
+	}, payouts)
+
+	// Nodes that dropped out of the step lose their rank and earn nothing
+	activity[1].Rank = -1
+	payouts = training.SplitOuterStepPayout(task, activity, 100)
+	require.Equal(t, []training.MilestonePayout{
+		{Participant: "participantA", Amount: 50},
+		{Participant: "participantB", Amount: 50},
+	}, payouts)
+
+	require.Empty(t, training.SplitOuterStepPayout(task, nil, 100))
+	require.Empty(t, training.SplitOuterStepPayout(task, activity, 0))
+}
+
+func TestLostNodes(t *testing.T) {
+	task := &types.TrainingTask{
+		Assignees: []*types.TrainingTaskAssignee{
+			{Participant: "participantA", NodeIds: []string{"node1", "node2"}},
+			{Participant: "participantB", NodeIds: []string{"node1"}},
+		},
+	}
+	withHeartbeat := func(rec *types.TrainingTaskNodeEpochActivity, height int64) *types.TrainingTaskNodeEpochActivity {
+		rec.Heartbeat.BlockHeight = height
+		return rec
+	}
+	block := training.NewBlockInfoFromValues(100, time.Now())
+
+	activity := []*types.TrainingTaskNodeEpochActivity{
+		withHeartbeat(nodeActivity("participantA", "node1", 0), 95),
+		withHeartbeat(nodeActivity("participantA", "node2", 1), 90),
+		withHeartbeat(nodeActivity("participantB", "node1", 2), 10),
+	}
+	require.Equal(t, []training.GlobalNodeId{{Participant: "participantB", LocalNodeId: "node1"}}, training.LostNodes(task, activity, block, 30))
+
+	// Heartbeats of nodes outside the task don't count
+	activity[1] = withHeartbeat(nodeActivity("participantC", "node2", 1), 99)
+	require.Equal(t, []training.GlobalNodeId{
+		{Participant: "participantA", LocalNodeId: "node2"},
+		{Participant: "participantB", LocalNodeId: "node1"},
+	}, training.LostNodes(task, activity, block, 30))
+	require.Len(t, training.LostNodes(task, nil, block, 30), 3)
+}
@@ -602,3 +602,26 @@ func NewEmptyEpochInfo() *types.EpochInfo {
 		LastEpochTimestamp:   0,
 	}
 }
+
+// LostNodes returns the task's assigned nodes that didn't send a heartbeat within the timeout,
+// sorted. Nodes that never joined the outer step are lost as well.
+func LostNodes(task *types.TrainingTask, activity []*types.TrainingTaskNodeEpochActivity, block BlockInfo, heartbeatTimeout int64) []GlobalNodeId {
+	live := make(map[GlobalNodeId]bool)
+	for _, rec := range activity {
+		if block.height-rec.Heartbeat.BlockHeight <= heartbeatTimeout {
+			live[GlobalNodeId{Participant: rec.Participant, LocalNodeId: rec.NodeId}] = true
+		}
+	}
+
+	var lost []GlobalNodeId
+	for _, a := range task.Assignees {
+		for _, nodeId := range a.NodeIds {
+			globalNodeId := GlobalNodeId{Participant: a.Participant, LocalNodeId: nodeId}
+			if !live[globalNodeId] {
+				lost = append(lost, globalNodeId)
+			}
+		}
+	}
+	sortNodeIds(lost)
+	return lost
+}
@@ -47,6 +47,34 @@ func (BridgeTransactionStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b5993e241d1d0a3b, []int{0}
 }
 
+type BridgeWithdrawalStatus int32
+
+const (
+	BridgeWithdrawalStatus_WITHDRAWAL_PENDING  BridgeWithdrawalStatus = 0
+	BridgeWithdrawalStatus_WITHDRAWAL_SIGNED   BridgeWithdrawalStatus = 1
+	BridgeWithdrawalStatus_WITHDRAWAL_REFUNDED BridgeWithdrawalStatus = 2
+)
+
+var BridgeWithdrawalStatus_name = map[int32]string{
+	0: "WITHDRAWAL_PENDING",
+	1: "WITHDRAWAL_SIGNED",
+	2: "WITHDRAWAL_REFUNDED",
+}
+
+var BridgeWithdrawalStatus_value = map[string]int32{
+	"WITHDRAWAL_PENDING":  0,
+	"WITHDRAWAL_SIGNED":   1,
+	"WITHDRAWAL_REFUNDED": 2,
+}
+
+func (x BridgeWithdrawalStatus) String() string {
+	return proto.EnumName(BridgeWithdrawalStatus_name, int32(x))
+}
+
+func (BridgeWithdrawalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b5993e241d1d0a3b, []int{1}
+}
+
 type BridgeTransaction struct {
 	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	OriginChain     string                  `protobuf:"bytes,2,opt,name=originChain,proto3" json:"originChain,omitempty"`
@@ -195,9 +223,242 @@ func (m *BridgeTransaction) GetReceiptsRoot() string {
 	return ""
 }
 
+// BridgeWithdrawal is an outbound transfer of bridged tokens back to their origin chain.
+// The tokens are held in escrow until the BLS threshold group signs the withdrawal, so a contract
+// on the destination chain can release the funds. They are burned once the signature completes
+// and returned to the creator if signing expires or fails.
+type BridgeWithdrawal struct {
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator          string                 `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	DestinationChain string                 `protobuf:"bytes,3,opt,name=destinationChain,proto3" json:"destinationChain,omitempty"`
+	ContractAddress  string                 `protobuf:"bytes,4,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Recipient        string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount           string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHeight      int64                  `protobuf:"varint,7,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Timestamp        int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EpochId          uint64                 `protobuf:"varint,9,opt,name=epochId,proto3" json:"epochId,omitempty"`
+	RequestId        []byte                 `protobuf:"bytes,10,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Status           BridgeWithdrawalStatus `protobuf:"varint,11,opt,name=status,proto3,enum=inference.inference.BridgeWithdrawalStatus" json:"status,omitempty"`
+	Cw20Contract     string                 `protobuf:"bytes,12,opt,name=cw20Contract,proto3" json:"cw20Contract,omitempty"`
+}
+
+func (m *BridgeWithdrawal) Reset()         { *m = BridgeWithdrawal{} }
+func (m *BridgeWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*BridgeWithdrawal) ProtoMessage()    {}
+func (*BridgeWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5993e241d1d0a3b, []int{1}
+}
+func (m *BridgeWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeWithdrawal.Merge(m, src)
+}
+func (m *BridgeWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeWithdrawal proto.InternalMessageInfo
+
+func (m *BridgeWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetDestinationChain() string {
+	if m != nil {
+		return m.DestinationChain
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+func (m *BridgeWithdrawal) GetStatus() BridgeWithdrawalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BridgeWithdrawalStatus_WITHDRAWAL_PENDING
+}
+
+func (m *BridgeWithdrawal) GetCw20Contract() string {
+	if m != nil {
+		return m.Cw20Contract
+	}
+	return ""
+}
+
+// BridgeBlockHeader is the receipts root of an origin chain block, as attested by the validators.
+// Once a majority agrees on it, deposits in the block are proven against it with receipt proofs.
+type BridgeBlockHeader struct {
+	OriginChain           string                  `protobuf:"bytes,1,opt,name=originChain,proto3" json:"originChain,omitempty"`
+	BlockNumber           string                  `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ReceiptsRoot          string                  `protobuf:"bytes,3,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	Validators            []string                `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	ValidationCount       uint32                  `protobuf:"varint,5,opt,name=validationCount,proto3" json:"validationCount,omitempty"`
+	Status                BridgeTransactionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=inference.inference.BridgeTransactionStatus" json:"status,omitempty"`
+	AttestedAtBlockHeight int64                   `protobuf:"varint,7,opt,name=attestedAtBlockHeight,proto3" json:"attestedAtBlockHeight,omitempty"`
+}
+
+func (m *BridgeBlockHeader) Reset()         { *m = BridgeBlockHeader{} }
+func (m *BridgeBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BridgeBlockHeader) ProtoMessage()    {}
+func (*BridgeBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5993e241d1d0a3b, []int{2}
+}
+func (m *BridgeBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeBlockHeader.Merge(m, src)
+}
+func (m *BridgeBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeBlockHeader proto.InternalMessageInfo
+
+func (m *BridgeBlockHeader) GetOriginChain() string {
+	if m != nil {
+		return m.OriginChain
+	}
+	return ""
+}
+
+func (m *BridgeBlockHeader) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
+}
+
+func (m *BridgeBlockHeader) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *BridgeBlockHeader) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *BridgeBlockHeader) GetValidationCount() uint32 {
+	if m != nil {
+		return m.ValidationCount
+	}
+	return 0
+}
+
+func (m *BridgeBlockHeader) GetStatus() BridgeTransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BridgeTransactionStatus_BRIDGE_PENDING
+}
+
+func (m *BridgeBlockHeader) GetAttestedAtBlockHeight() int64 {
+	if m != nil {
+		return m.AttestedAtBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("inference.inference.BridgeTransactionStatus", BridgeTransactionStatus_name, BridgeTransactionStatus_value)
+	proto.RegisterEnum("inference.inference.BridgeWithdrawalStatus", BridgeWithdrawalStatus_name, BridgeWithdrawalStatus_value)
 	proto.RegisterType((*BridgeTransaction)(nil), "inference.inference.BridgeTransaction")
+	proto.RegisterType((*BridgeWithdrawal)(nil), "inference.inference.BridgeWithdrawal")
+	proto.RegisterType((*BridgeBlockHeader)(nil), "inference.inference.BridgeBlockHeader")
 }
 
 func init() {
@@ -205,34 +466,49 @@ func init() {
 }
 
 var fileDescriptor_b5993e241d1d0a3b = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x6e, 0x13, 0x31,
-	0x14, 0x85, 0xe3, 0xa4, 0x04, 0xe2, 0xb6, 0x21, 0x18, 0x04, 0x5e, 0xa0, 0xd1, 0xa8, 0xab, 0x11,
-	0xaa, 0xa6, 0x12, 0x88, 0x07, 0x20, 0x3f, 0x2a, 0x91, 0x20, 0xad, 0x86, 0xae, 0xd8, 0x54, 0x1e,
-	0xdb, 0x24, 0x57, 0x74, 0xec, 0x91, 0x7d, 0x07, 0xca, 0x9a, 0x17, 0xe0, 0xb1, 0x58, 0x76, 0xc9,
-	0x12, 0x25, 0x2f, 0x82, 0xe2, 0x86, 0x64, 0x12, 0xe8, 0xee, 0xfa, 0x3b, 0xe7, 0x48, 0xbe, 0x47,
-	0x97, 0x1e, 0x83, 0xf9, 0xa4, 0x9d, 0x36, 0x52, 0x9f, 0x6c, 0xa6, 0xdc, 0x81, 0x9a, 0xea, 0x4b,
-	0x74, 0xc2, 0x78, 0x21, 0x11, 0xac, 0x49, 0x4b, 0x67, 0xd1, 0xb2, 0xc7, 0x6b, 0x4f, 0xba, 0x9e,
-	0x8e, 0xbe, 0xef, 0xd1, 0x47, 0xfd, 0x90, 0xb8, 0xd8, 0x04, 0x58, 0x97, 0x36, 0x41, 0x71, 0x12,
-	0x93, 0xa4, 0x93, 0x35, 0x41, 0xb1, 0x98, 0xee, 0x5b, 0x07, 0x53, 0x30, 0x83, 0x99, 0x00, 0xc3,
-	0x9b, 0x41, 0xa8, 0x23, 0x96, 0xd0, 0x87, 0xd2, 0x1a, 0x74, 0x42, 0xe2, 0x1b, 0xa5, 0x9c, 0xf6,
-	0x9e, 0xb7, 0x82, 0x6b, 0x17, 0xb3, 0x23, 0x7a, 0x60, 0xbf, 0x1a, 0xed, 0xfe, 0xda, 0xf6, 0x82,
-	0x6d, 0x8b, 0xb1, 0xa7, 0xb4, 0x2d, 0x0a, 0x5b, 0x19, 0xe4, 0xf7, 0x82, 0xba, 0x7a, 0xb1, 0xe7,
-	0xb4, 0xe3, 0xb4, 0x84, 0x12, 0xb4, 0x41, 0xde, 0x0e, 0xd2, 0x06, 0x2c, 0x7f, 0x99, 0x5f, 0x59,
-	0xf9, 0xf9, 0xad, 0x86, 0xe9, 0x0c, 0xf9, 0xfd, 0x98, 0x24, 0xad, 0xac, 0x8e, 0x96, 0x79, 0x84,
-	0x42, 0x7b, 0x14, 0x45, 0xc9, 0x1f, 0x04, 0x7d, 0x03, 0xd8, 0x90, 0xb6, 0x3d, 0x0a, 0xac, 0x3c,
-	0xef, 0xc4, 0x24, 0xe9, 0xbe, 0x3c, 0x4e, 0xff, 0xd3, 0x58, 0xfa, 0x4f, 0x5b, 0x1f, 0x42, 0x26,
-	0x5b, 0x65, 0x59, 0x44, 0xe9, 0x17, 0x71, 0x05, 0x4a, 0xa0, 0x75, 0x9e, 0xd3, 0xb8, 0x95, 0x74,
-	0xb2, 0x1a, 0x59, 0x36, 0xb5, 0x7a, 0x81, 0x35, 0x83, 0xb0, 0xe4, 0x7e, 0x4c, 0x92, 0xc3, 0x6c,
-	0x17, 0xaf, 0xf7, 0x99, 0x54, 0x45, 0xae, 0x1d, 0x3f, 0xb8, 0x6d, 0xbd, 0x86, 0x96, 0x5d, 0x3a,
-	0x2d, 0x35, 0x94, 0x38, 0x36, 0x4a, 0x5f, 0xf3, 0xc3, 0xdb, 0x2e, 0xeb, 0xac, 0xe6, 0xf1, 0x99,
-	0xb5, 0xc8, 0xbb, 0x5b, 0x9e, 0xc0, 0x5e, 0x0c, 0xe8, 0xb3, 0x3b, 0xd6, 0x62, 0x8c, 0x76, 0xfb,
-	0xd9, 0x78, 0x78, 0x3a, 0xba, 0x3c, 0x1f, 0x4d, 0x86, 0xe3, 0xc9, 0x69, 0xaf, 0xc1, 0x9e, 0xd0,
-	0xde, 0x8a, 0x0d, 0xce, 0xde, 0x9f, 0xbf, 0x1b, 0x5d, 0x8c, 0x86, 0x3d, 0xd2, 0x3f, 0xfb, 0x39,
-	0x8f, 0xc8, 0xcd, 0x3c, 0x22, 0xbf, 0xe7, 0x11, 0xf9, 0xb1, 0x88, 0x1a, 0x37, 0x8b, 0xa8, 0xf1,
-	0x6b, 0x11, 0x35, 0x3e, 0xbe, 0x9e, 0x02, 0xce, 0xaa, 0x3c, 0x95, 0xb6, 0x38, 0x29, 0x9d, 0x55,
-	0x95, 0x44, 0x2f, 0x61, 0xe7, 0x6e, 0xaf, 0x6b, 0x33, 0x7e, 0x2b, 0xb5, 0xcf, 0xdb, 0xe1, 0x6e,
-	0x5f, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x26, 0xfd, 0x48, 0x0b, 0xe7, 0x02, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x10, 0xc7, 0xe3, 0x24, 0x84, 0x2f, 0x43, 0xc8, 0x17, 0x96, 0x02, 0x7b, 0xa8, 0x22, 0x2b, 0x27,
+	0x8b, 0xa2, 0x50, 0xd1, 0xf6, 0x01, 0x92, 0x38, 0x05, 0x4b, 0x34, 0x20, 0x43, 0x85, 0xd4, 0x0b,
+	0x75, 0xbc, 0xdb, 0x64, 0x55, 0xe2, 0x75, 0x77, 0x37, 0x85, 0x9e, 0xfb, 0x02, 0x7d, 0x8d, 0x3e,
+	0x46, 0x6f, 0x3d, 0x72, 0xec, 0xb1, 0x82, 0x17, 0xa9, 0xb2, 0x76, 0x62, 0xc7, 0x49, 0x51, 0x25,
+	0x6e, 0x3b, 0xff, 0x99, 0x59, 0xed, 0xcc, 0xfc, 0x46, 0x0b, 0x7b, 0x2c, 0xf8, 0x40, 0x05, 0x0d,
+	0x7c, 0xba, 0x9f, 0x9c, 0xfa, 0x82, 0x91, 0x01, 0xbd, 0x54, 0xc2, 0x0b, 0xa4, 0xe7, 0x2b, 0xc6,
+	0x83, 0x66, 0x28, 0xb8, 0xe2, 0x68, 0x73, 0x16, 0xd3, 0x9c, 0x9d, 0x1a, 0x5f, 0x8b, 0xb0, 0xd1,
+	0xd6, 0x19, 0xe7, 0x49, 0x02, 0xaa, 0x42, 0x9e, 0x11, 0x6c, 0x98, 0x86, 0x55, 0x76, 0xf3, 0x8c,
+	0x20, 0x13, 0xd6, 0xb8, 0x60, 0x03, 0x16, 0x74, 0x86, 0x1e, 0x0b, 0x70, 0x5e, 0x3b, 0xd2, 0x12,
+	0xb2, 0xe0, 0x7f, 0x9f, 0x07, 0x4a, 0x78, 0xbe, 0x6a, 0x11, 0x22, 0xa8, 0x94, 0xb8, 0xa0, 0xa3,
+	0xb2, 0x32, 0x6a, 0x40, 0x85, 0x5f, 0x07, 0x54, 0x4c, 0xc3, 0x8a, 0x3a, 0x6c, 0x4e, 0x43, 0xdb,
+	0x50, 0xf2, 0x46, 0x7c, 0x1c, 0x28, 0xbc, 0xa2, 0xbd, 0xb1, 0x85, 0x9e, 0x42, 0x59, 0x50, 0x9f,
+	0x85, 0x8c, 0x06, 0x0a, 0x97, 0xb4, 0x2b, 0x11, 0x26, 0xaf, 0xec, 0x5f, 0x71, 0xff, 0xe3, 0x11,
+	0x65, 0x83, 0xa1, 0xc2, 0xab, 0xa6, 0x61, 0x15, 0xdc, 0xb4, 0x34, 0xc9, 0x57, 0x6c, 0x44, 0xa5,
+	0xf2, 0x46, 0x21, 0xfe, 0x4f, 0xfb, 0x13, 0x01, 0xd9, 0x50, 0x92, 0xca, 0x53, 0x63, 0x89, 0xcb,
+	0xa6, 0x61, 0x55, 0x0f, 0xf6, 0x9a, 0x4b, 0x3a, 0xd6, 0x5c, 0xe8, 0xd6, 0x99, 0xce, 0x71, 0xe3,
+	0x5c, 0x54, 0x07, 0xf8, 0xec, 0x5d, 0x31, 0xe2, 0x29, 0x2e, 0x24, 0x06, 0xb3, 0x60, 0x95, 0xdd,
+	0x94, 0x32, 0xe9, 0x54, 0x6c, 0x31, 0x1e, 0x74, 0x74, 0x91, 0x6b, 0xa6, 0x61, 0xad, 0xbb, 0x59,
+	0x79, 0x56, 0x4f, 0x6f, 0x3c, 0xea, 0x53, 0x81, 0x2b, 0x51, 0xd7, 0x53, 0xd2, 0xa4, 0x97, 0x82,
+	0xfa, 0x94, 0x85, 0xca, 0x09, 0x08, 0xbd, 0xc1, 0xeb, 0x51, 0x2f, 0xd3, 0x5a, 0x2a, 0x46, 0xba,
+	0x9c, 0x2b, 0x5c, 0x9d, 0x8b, 0xd1, 0x5a, 0xe3, 0x7b, 0x01, 0x6a, 0x51, 0x5d, 0x17, 0x4c, 0x0d,
+	0x89, 0xf0, 0xae, 0xbd, 0xab, 0x14, 0x04, 0x45, 0x0d, 0x01, 0x86, 0x55, 0x5f, 0xd0, 0x49, 0x11,
+	0x31, 0x00, 0x53, 0x13, 0xed, 0x42, 0x8d, 0x50, 0xa9, 0x58, 0x10, 0x3d, 0x5e, 0x33, 0x12, 0x4d,
+	0x7f, 0x41, 0x5f, 0x06, 0x4a, 0x71, 0x39, 0x28, 0x73, 0xc3, 0x5e, 0xc9, 0x0e, 0x3b, 0x41, 0xa4,
+	0x34, 0x87, 0xc8, 0x63, 0x21, 0xc0, 0xb0, 0x4a, 0x43, 0xee, 0x0f, 0x1d, 0xa2, 0x29, 0x28, 0xba,
+	0x53, 0x33, 0x7a, 0xcf, 0xa7, 0x31, 0x95, 0xca, 0x21, 0x18, 0x4c, 0xc3, 0xaa, 0xb8, 0x89, 0x80,
+	0x3a, 0x33, 0x78, 0xd6, 0x34, 0x3c, 0xcf, 0x1e, 0x80, 0x27, 0x69, 0x72, 0x86, 0x9d, 0x06, 0x54,
+	0xfc, 0xeb, 0x83, 0xe7, 0x9d, 0xb8, 0x13, 0xf1, 0xc8, 0xe7, 0xb4, 0xc6, 0x8f, 0xfc, 0x74, 0x63,
+	0xdb, 0x51, 0x51, 0x1e, 0xa1, 0x22, 0xbb, 0xa1, 0xc6, 0xe2, 0x86, 0x66, 0x68, 0xca, 0x3f, 0x44,
+	0x53, 0x44, 0x4a, 0x61, 0x91, 0x94, 0x0c, 0xdd, 0xc5, 0x7f, 0xa1, 0x7b, 0x65, 0x39, 0xdd, 0xc9,
+	0xb6, 0x95, 0x1e, 0xb1, 0x6d, 0x2f, 0x61, 0xcb, 0x53, 0x8a, 0x4a, 0x45, 0x49, 0x4b, 0xb5, 0x17,
+	0x06, 0xbf, 0xdc, 0xb9, 0xdb, 0x81, 0x9d, 0xbf, 0x5c, 0x8c, 0x10, 0x54, 0xdb, 0xae, 0x63, 0x1f,
+	0x76, 0x2f, 0x4f, 0xbb, 0x3d, 0xdb, 0xe9, 0x1d, 0xd6, 0x72, 0xe8, 0x09, 0xd4, 0x62, 0xad, 0x73,
+	0xf2, 0xe6, 0xf4, 0xb8, 0x7b, 0xde, 0xb5, 0x6b, 0xc6, 0xee, 0x7b, 0xd8, 0x5e, 0x3e, 0x4e, 0xb4,
+	0x0d, 0xe8, 0xc2, 0x39, 0x3f, 0xb2, 0xdd, 0xd6, 0x45, 0xeb, 0x38, 0x75, 0xcf, 0x16, 0x6c, 0xa4,
+	0xf4, 0x33, 0xe7, 0xb0, 0x37, 0xb9, 0x08, 0xed, 0xc0, 0x66, 0x4a, 0x76, 0xbb, 0xaf, 0xdf, 0xf6,
+	0xec, 0xae, 0x5d, 0xcb, 0xb7, 0x4f, 0x7e, 0xde, 0xd5, 0x8d, 0xdb, 0xbb, 0xba, 0xf1, 0xfb, 0xae,
+	0x6e, 0x7c, 0xbb, 0xaf, 0xe7, 0x6e, 0xef, 0xeb, 0xb9, 0x5f, 0xf7, 0xf5, 0xdc, 0xbb, 0x57, 0x03,
+	0xa6, 0x86, 0xe3, 0x7e, 0xd3, 0xe7, 0xa3, 0xfd, 0x50, 0x70, 0x32, 0xf6, 0x95, 0xf4, 0x59, 0xe6,
+	0x27, 0xb8, 0x49, 0x9d, 0xd5, 0x97, 0x90, 0xca, 0x7e, 0x49, 0xff, 0x04, 0x2f, 0xfe, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0xe4, 0x1d, 0xb7, 0xe9, 0x39, 0x06, 0x00, 0x00,
 }
 
 func (m *BridgeTransaction) Marshal() (dAtA []byte, err error) {
@@ -350,87 +626,786 @@ func (m *BridgeTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBridgeTransaction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBridgeTransaction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BridgeWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BridgeTransaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BridgeWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
-	}
-	l = len(m.OriginChain)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
+	if len(m.Cw20Contract) > 0 {
+		i -= len(m.Cw20Contract)
+		copy(dAtA[i:], m.Cw20Contract)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.Cw20Contract)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
+	if m.EpochId != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x40
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovBridgeTransaction(uint64(m.BlockHeight))
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovBridgeTransaction(uint64(m.Timestamp))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Status != 0 {
-		n += 1 + sovBridgeTransaction(uint64(m.Status))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovBridgeTransaction(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestedAtBlockHeight != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.AttestedAtBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidationCount != 0 {
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(m.ValidationCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginChain) > 0 {
+		i -= len(m.OriginChain)
+		copy(dAtA[i:], m.OriginChain)
+		i = encodeVarintBridgeTransaction(dAtA, i, uint64(len(m.OriginChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridgeTransaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridgeTransaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.Timestamp))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.Status))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovBridgeTransaction(uint64(l))
+		}
+	}
+	if m.ValidationCount != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.ValidationCount))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.ReceiptIndex)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.Timestamp))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.EpochId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.Status))
+	}
+	l = len(m.Cw20Contract)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovBridgeTransaction(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovBridgeTransaction(uint64(l))
+		}
+	}
+	if m.ValidationCount != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.ValidationCount))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.Status))
+	}
+	if m.AttestedAtBlockHeight != 0 {
+		n += 1 + sovBridgeTransaction(uint64(m.AttestedAtBlockHeight))
+	}
+	return n
+}
+
+func sovBridgeTransaction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridgeTransaction(x uint64) (n int) {
+	return sovBridgeTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeTransactionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationCount", wireType)
+			}
+			m.ValidationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.ValidationCount != 0 {
-		n += 1 + sovBridgeTransaction(uint64(m.ValidationCount))
-	}
-	l = len(m.BlockNumber)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
-	}
-	l = len(m.ReceiptIndex)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
-	}
-	l = len(m.ReceiptsRoot)
-	if l > 0 {
-		n += 1 + l + sovBridgeTransaction(uint64(l))
-	}
-	return n
-}
 
-func sovBridgeTransaction(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBridgeTransaction(x uint64) (n int) {
-	return sovBridgeTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
+func (m *BridgeWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -453,17 +1428,17 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridgeTransaction
@@ -473,27 +1448,14 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBridgeTransaction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBridgeTransaction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -521,11 +1483,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -553,11 +1515,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -585,11 +1547,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -617,11 +1579,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -649,7 +1611,7 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
@@ -690,6 +1652,59 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestId == nil {
+				m.RequestId = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -703,14 +1718,14 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BridgeTransactionStatus(b&0x7F) << shift
+				m.Status |= BridgeWithdrawalStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw20Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -738,13 +1753,63 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.Cw20Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationCount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValidationCount = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridgeTransaction
@@ -754,12 +1819,25 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidationCount |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -791,9 +1869,9 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -821,11 +1899,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -853,8 +1931,65 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationCount", wireType)
+			}
+			m.ValidationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeTransactionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedAtBlockHeight", wireType)
+			}
+			m.AttestedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedAtBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridgeTransaction(dAtA[iNdEx:])
@@ -32,6 +32,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimRewards{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchClaimRewards{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitPocBatch{},
 	)
@@ -80,6 +83,36 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDummyTrainingTask{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAbandonInference{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBridgeWithdraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAttestBridgeBlock{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBridgeDepositProof{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateDeveloperSubKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeDeveloperSubKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFinishTrainingTask{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelTrainingTask{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitTrainingCheckpoint{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParticipantRegion{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// CheckRequest returns an error when the sub-key may not start an inference of model with up to
+// maxTokens completion tokens at blockTime (unix seconds)
+func (sk *DeveloperSubKey) CheckRequest(blockTime int64, model string, maxTokens uint64) error {
+	if sk.Revoked {
+		return errorsmod.Wrapf(ErrDeveloperSubKeyNotAllowed, "sub-key %s is revoked", sk.Name)
+	}
+	if sk.ExpiresAt > 0 && blockTime >= sk.ExpiresAt {
+		return errorsmod.Wrapf(ErrDeveloperSubKeyNotAllowed, "sub-key %s expired at %d", sk.Name, sk.ExpiresAt)
+	}
+	if len(sk.AllowedModels) > 0 && !slices.Contains(sk.AllowedModels, model) {
+		return errorsmod.Wrapf(ErrDeveloperSubKeyNotAllowed, "model %s is not allowed for sub-key %s", model, sk.Name)
+	}
+	if sk.MaxTokens > 0 && maxTokens > sk.MaxTokens {
+		return errorsmod.Wrapf(ErrDeveloperSubKeyNotAllowed, "max_tokens %d above the %d allowed for sub-key %s", maxTokens, sk.MaxTokens, sk.Name)
+	}
+	return nil
+}
+
+// SpentIn returns the coins escrowed with the sub-key in epoch
+func (sk *DeveloperSubKey) SpentIn(epoch uint64) uint64 {
+	if sk.SpentEpoch != epoch {
+		return 0
+	}
+	return sk.SpentCoins
+}
+
+// Charge adds amount to the spending of epoch, unless it would go over max_coins_per_epoch
+func (sk *DeveloperSubKey) Charge(epoch uint64, amount uint64) error {
+	spent := sk.SpentIn(epoch)
+	if sk.MaxCoinsPerEpoch > 0 && spent+amount > sk.MaxCoinsPerEpoch {
+		return errorsmod.Wrapf(ErrDeveloperSubKeyNotAllowed, "sub-key %s spent %d of %d coins this epoch, %d more requested",
+			sk.Name, spent, sk.MaxCoinsPerEpoch, amount)
+	}
+	sk.SpentEpoch = epoch
+	sk.SpentCoins = spent + amount
+	return nil
+}
+
+// Release gives back refunded coins. Refunds of an earlier epoch are ignored, its spending no
+// longer counts.
+func (sk *DeveloperSubKey) Release(epoch uint64, amount uint64) {
+	if sk.SpentEpoch != epoch {
+		return
+	}
+	sk.SpentCoins -= min(amount, sk.SpentCoins)
+}
@@ -47,4 +47,10 @@ var (
 	ErrEpochNotFound                           = sdkerrors.Register(ModuleName, 1136, "epoch not found")
 	ErrIllegalState                            = sdkerrors.Register(ModuleName, 1137, "illegal state for the operation requested")
 	ErrInvalidValidationThreshold              = sdkerrors.Register(ModuleName, 1138, "validation threshold must be in [0, 100] range")
+	ErrInferenceAbandoned                      = sdkerrors.Register(ModuleName, 1139, "inference was abandoned by the transfer agent")
+	ErrDeveloperSubKeyNotFound                 = sdkerrors.Register(ModuleName, 1140, "developer sub-key not found")
+	ErrDeveloperSubKeyNotAllowed               = sdkerrors.Register(ModuleName, 1141, "request not allowed for the developer sub-key")
+	ErrTrainingTaskFinished                    = sdkerrors.Register(ModuleName, 1142, "training task already finished")
+	ErrTrainingCheckpointMismatch              = sdkerrors.Register(ModuleName, 1143, "training checkpoint does not match the registered one")
+	ErrTrainingCheckpointAlreadySigned         = sdkerrors.Register(ModuleName, 1144, "training checkpoint already signed by the participant")
 )
@@ -31,6 +31,9 @@ const (
 	InferenceStatus_INVALIDATED InferenceStatus = 3
 	InferenceStatus_VOTING      InferenceStatus = 4
 	InferenceStatus_EXPIRED     InferenceStatus = 5
+	// The transfer agent couldn't reach the assigned executor and released the escrow.
+	// The inference can be started again with another executor.
+	InferenceStatus_ABANDONED InferenceStatus = 6
 )
 
 var InferenceStatus_name = map[int32]string{
@@ -40,6 +43,7 @@ var InferenceStatus_name = map[int32]string{
 	3: "INVALIDATED",
 	4: "VOTING",
 	5: "EXPIRED",
+	6: "ABANDONED",
 }
 
 var InferenceStatus_value = map[string]int32{
@@ -49,6 +53,7 @@ var InferenceStatus_value = map[string]int32{
 	"INVALIDATED": 3,
 	"VOTING":      4,
 	"EXPIRED":     5,
+	"ABANDONED":   6,
 }
 
 func (x InferenceStatus) String() string {
@@ -59,6 +64,35 @@ func (InferenceStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ce060d6da7916311, []int{0}
 }
 
+// InferenceKind identifies the OpenAI-compatible API an inference was served through.
+type InferenceKind int32
+
+const (
+	InferenceKind_CHAT_COMPLETION InferenceKind = 0
+	InferenceKind_TEXT_COMPLETION InferenceKind = 1
+	InferenceKind_EMBEDDING       InferenceKind = 2
+)
+
+var InferenceKind_name = map[int32]string{
+	0: "CHAT_COMPLETION",
+	1: "TEXT_COMPLETION",
+	2: "EMBEDDING",
+}
+
+var InferenceKind_value = map[string]int32{
+	"CHAT_COMPLETION": 0,
+	"TEXT_COMPLETION": 1,
+	"EMBEDDING":       2,
+}
+
+func (x InferenceKind) String() string {
+	return proto.EnumName(InferenceKind_name, int32(x))
+}
+
+func (InferenceKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce060d6da7916311, []int{1}
+}
+
 type ProposalDetails struct {
 	ReValidatePolicyId uint64 `protobuf:"varint,1,opt,name=re_validate_policy_id,json=reValidatePolicyId,proto3" json:"re_validate_policy_id,omitempty"`
 	InvalidatePolicyId uint64 `protobuf:"varint,2,opt,name=invalidate_policy_id,json=invalidatePolicyId,proto3" json:"invalidate_policy_id,omitempty"`
@@ -152,6 +186,20 @@ type Inference struct {
 	ExecutionSignature       string           `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
 	OriginalPrompt           string           `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
 	PerTokenPrice            uint64           `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
+	Kind                     InferenceKind    `protobuf:"varint,33,opt,name=kind,proto3,enum=inference.inference.InferenceKind" json:"kind,omitempty"`
+	// Executors the inference was assigned to and then abandoned by the transfer agent
+	AbandonedExecutors []string `protobuf:"bytes,34,rep,name=abandoned_executors,json=abandonedExecutors,proto3" json:"abandoned_executors,omitempty"`
+	// Set instead of original_prompt when payloads are kept off-chain. The developer, transfer
+	// agent and executor signatures are then made over this hash.
+	OriginalPromptHash string `protobuf:"bytes,35,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"`
+	// SHA-256 of the response payload kept off-chain, response_hash only covers the content
+	ResponsePayloadHash string `protobuf:"bytes,36,opt,name=response_payload_hash,json=responsePayloadHash,proto3" json:"response_payload_hash,omitempty"`
+	// Base URL of the executor's payload store, where payloads can be fetched by hash
+	PayloadLocator string `protobuf:"bytes,37,opt,name=payload_locator,json=payloadLocator,proto3" json:"payload_locator,omitempty"`
+	// Developer sub-key the request was signed with, its epoch spending is adjusted on refunds
+	SubKey string `protobuf:"bytes,38,opt,name=sub_key,json=subKey,proto3" json:"sub_key,omitempty"`
+	// Epoch whose sub-key spending the escrow was charged to, refunds are given back to it
+	SubKeyEpoch uint64 `protobuf:"varint,39,opt,name=sub_key_epoch,json=subKeyEpoch,proto3" json:"sub_key_epoch,omitempty"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -411,8 +459,58 @@ func (m *Inference) GetPerTokenPrice() uint64 {
 	return 0
 }
 
+func (m *Inference) GetKind() InferenceKind {
+	if m != nil {
+		return m.Kind
+	}
+	return InferenceKind_CHAT_COMPLETION
+}
+
+func (m *Inference) GetAbandonedExecutors() []string {
+	if m != nil {
+		return m.AbandonedExecutors
+	}
+	return nil
+}
+
+func (m *Inference) GetOriginalPromptHash() string {
+	if m != nil {
+		return m.OriginalPromptHash
+	}
+	return ""
+}
+
+func (m *Inference) GetResponsePayloadHash() string {
+	if m != nil {
+		return m.ResponsePayloadHash
+	}
+	return ""
+}
+
+func (m *Inference) GetPayloadLocator() string {
+	if m != nil {
+		return m.PayloadLocator
+	}
+	return ""
+}
+
+func (m *Inference) GetSubKey() string {
+	if m != nil {
+		return m.SubKey
+	}
+	return ""
+}
+
+func (m *Inference) GetSubKeyEpoch() uint64 {
+	if m != nil {
+		return m.SubKeyEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("inference.inference.InferenceStatus", InferenceStatus_name, InferenceStatus_value)
+	proto.RegisterEnum("inference.inference.InferenceKind", InferenceKind_name, InferenceKind_value)
 	proto.RegisterType((*ProposalDetails)(nil), "inference.inference.ProposalDetails")
 	proto.RegisterType((*Inference)(nil), "inference.inference.Inference")
 }
@@ -422,63 +520,75 @@ func init() {
 }
 
 var fileDescriptor_ce060d6da7916311 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xdb, 0x6e, 0xdb, 0xc6,
-	0x16, 0x35, 0xe3, 0xab, 0xb6, 0xae, 0x1e, 0xc9, 0x39, 0x93, 0xd3, 0x44, 0x51, 0x6e, 0xad, 0x7a,
-	0x93, 0xdb, 0xb4, 0x7d, 0x2b, 0x0a, 0x48, 0x91, 0x1b, 0x13, 0x28, 0x6c, 0x41, 0x12, 0x8c, 0xa2,
-	0x2f, 0xc4, 0x98, 0x9c, 0x48, 0x44, 0x28, 0x0e, 0x3b, 0x33, 0x4a, 0xa5, 0xbf, 0xe8, 0x0f, 0xf4,
-	0x3b, 0xfa, 0x0b, 0x7d, 0xcc, 0x63, 0x1f, 0x0b, 0xfb, 0x47, 0x8a, 0xd9, 0xc3, 0x8b, 0x22, 0xb8,
-	0x6f, 0xe4, 0x5a, 0x6b, 0xef, 0xd9, 0xdc, 0xb3, 0x16, 0x08, 0xcf, 0xc2, 0xf8, 0x0d, 0x97, 0x3c,
-	0xf6, 0xf9, 0xe9, 0x1d, 0x4f, 0xbd, 0x44, 0x0a, 0x2d, 0x48, 0xb3, 0x00, 0xf2, 0xa7, 0xa7, 0x7f,
-	0x38, 0x50, 0x1f, 0x49, 0x91, 0x08, 0xc5, 0xa2, 0x21, 0xd7, 0x2c, 0x8c, 0x14, 0xf9, 0x1a, 0x4e,
-	0x24, 0xf7, 0xde, 0xb1, 0x28, 0x0c, 0x98, 0xe6, 0x5e, 0x22, 0xa2, 0xd0, 0x5f, 0x7b, 0x61, 0x40,
-	0x9d, 0x8e, 0xd3, 0xdd, 0x1b, 0x13, 0xc9, 0xaf, 0x52, 0x6e, 0x84, 0x94, 0x1b, 0x90, 0xaf, 0xa0,
-	0x15, 0xc6, 0x77, 0x54, 0xdc, 0xb3, 0x15, 0x05, 0x97, 0x57, 0xbc, 0x80, 0x5a, 0x2a, 0x63, 0x41,
-	0x20, 0xb9, 0x52, 0x74, 0xb7, 0xe3, 0x74, 0x4b, 0xe3, 0xaa, 0x45, 0xfb, 0x16, 0x7c, 0xfa, 0x27,
-	0x40, 0xc9, 0xcd, 0xa6, 0x25, 0x2d, 0xd8, 0x0f, 0xe3, 0x80, 0xaf, 0x70, 0x92, 0xd2, 0xd8, 0xbe,
-	0x90, 0x27, 0x50, 0xc9, 0x3f, 0x28, 0x3b, 0xb4, 0x34, 0x2e, 0xe7, 0x98, 0x1b, 0x90, 0xc7, 0x50,
-	0x4e, 0xa4, 0x58, 0x24, 0xda, 0x9b, 0x33, 0x35, 0x4f, 0x8f, 0x02, 0x0b, 0x9d, 0x33, 0x35, 0xc7,
-	0x71, 0xac, 0x20, 0x61, 0xeb, 0x48, 0xb0, 0x80, 0xee, 0xa5, 0xe3, 0x20, 0x3a, 0xb2, 0x20, 0x79,
-	0x06, 0x55, 0xc9, 0x55, 0x22, 0x62, 0xc5, 0x6d, 0xa7, 0x7d, 0x54, 0x55, 0x32, 0x10, 0x7b, 0x7d,
-	0x0a, 0x8d, 0x5c, 0x94, 0x75, 0x3b, 0x40, 0x5d, 0x3d, 0xc3, 0xb3, 0x7e, 0x5f, 0x00, 0x49, 0x8f,
-	0xd5, 0xe2, 0x2d, 0x8f, 0x3d, 0x5f, 0x2c, 0x63, 0x4d, 0x0f, 0x71, 0x6b, 0x0d, 0xcb, 0x4c, 0x0d,
-	0xf1, 0xca, 0xe0, 0xe4, 0x5b, 0xb8, 0xef, 0x8b, 0x45, 0x12, 0x71, 0x1d, 0x8a, 0xf8, 0x83, 0x8a,
-	0x23, 0xac, 0x68, 0x15, 0xec, 0x46, 0xd5, 0x13, 0xa8, 0x48, 0xfe, 0xeb, 0x92, 0x2b, 0xcd, 0x03,
-	0xef, 0x7a, 0x4d, 0x4b, 0x76, 0x3d, 0x39, 0x36, 0x58, 0x9b, 0xf5, 0xf0, 0x15, 0xf7, 0x97, 0xa9,
-	0x02, 0xec, 0x7a, 0x32, 0x68, 0xb0, 0x26, 0xdf, 0xc3, 0x81, 0xd2, 0x4c, 0x2f, 0x15, 0x2d, 0x77,
-	0x9c, 0x6e, 0xed, 0xe5, 0xf3, 0xde, 0x1d, 0x66, 0xea, 0xe5, 0x17, 0x35, 0x41, 0xed, 0x38, 0xad,
-	0x31, 0x5f, 0xa9, 0x34, 0x93, 0xda, 0xbb, 0x8e, 0x84, 0xff, 0xd6, 0x9b, 0xf3, 0x70, 0x36, 0xd7,
-	0xb4, 0xd2, 0x71, 0xba, 0xbb, 0xe3, 0x06, 0x32, 0x03, 0x43, 0x9c, 0x23, 0x4e, 0xba, 0xd0, 0xe0,
-	0x71, 0xf0, 0xa1, 0xb6, 0x8a, 0xda, 0x1a, 0x8f, 0x83, 0x4d, 0xe5, 0x4b, 0x38, 0xd9, 0xec, 0xab,
-	0xc3, 0x05, 0x57, 0x9a, 0x2d, 0x12, 0x5a, 0x43, 0x79, 0xb3, 0x68, 0x3d, 0xcd, 0x28, 0xd2, 0x83,
-	0x66, 0xd1, 0xbd, 0xa8, 0xa8, 0x63, 0xc5, 0x71, 0x76, 0x40, 0xa1, 0x6f, 0xc1, 0xfe, 0x42, 0x04,
-	0x3c, 0xa2, 0x0d, 0x6b, 0x39, 0x7c, 0x21, 0x8f, 0x00, 0x16, 0x6c, 0x65, 0xaf, 0x40, 0xd1, 0x63,
-	0xdc, 0x7e, 0x69, 0xc1, 0x56, 0xb8, 0x76, 0x65, 0xf6, 0xc9, 0x7c, 0xbd, 0x64, 0x91, 0xe7, 0x0b,
-	0xa5, 0x29, 0xc1, 0xe6, 0x60, 0xa1, 0x57, 0x42, 0x69, 0xe3, 0x23, 0xae, 0x7c, 0x29, 0x7e, 0xf3,
-	0xd8, 0x02, 0x2f, 0xb0, 0x89, 0x92, 0x8a, 0x05, 0xfb, 0x88, 0x91, 0x4b, 0x30, 0x16, 0xc0, 0x68,
-	0x7a, 0x81, 0xcd, 0x26, 0x6d, 0x75, 0x9c, 0x6e, 0xf9, 0x3f, 0xd6, 0xbf, 0x95, 0xe3, 0x71, 0x3d,
-	0xd9, 0x0a, 0xf6, 0x73, 0xa8, 0xf1, 0x44, 0xf8, 0x73, 0x6f, 0x26, 0xc5, 0x32, 0x31, 0x51, 0x39,
-	0xc1, 0xc9, 0x2b, 0x88, 0xbe, 0x36, 0xa0, 0xcd, 0x0a, 0x53, 0x2a, 0x9c, 0xc5, 0x3c, 0xf0, 0xb4,
-	0xa0, 0xf7, 0xad, 0x19, 0x32, 0x68, 0x2a, 0x8c, 0xa1, 0xb2, 0x38, 0xa3, 0x5d, 0xfe, 0xd7, 0xd9,
-	0x35, 0x86, 0xca, 0xb1, 0xc1, 0xda, 0x48, 0x62, 0x11, 0x70, 0xef, 0x1d, 0x97, 0x2a, 0x14, 0x31,
-	0xa5, 0xd6, 0x73, 0x06, 0xbb, 0xb2, 0x10, 0x79, 0x00, 0x47, 0x76, 0x98, 0x30, 0xa0, 0x0f, 0x70,
-	0x8c, 0x43, 0x7c, 0x77, 0x03, 0xf2, 0x03, 0x3c, 0xb4, 0x54, 0x22, 0x7c, 0xef, 0x0e, 0xe7, 0xfc,
-	0x1f, 0xe5, 0x14, 0x35, 0x23, 0xe1, 0x4f, 0xb6, 0x1d, 0xf4, 0x02, 0x6a, 0x5a, 0xb2, 0x58, 0xbd,
-	0xe1, 0x52, 0xda, 0x11, 0x3f, 0xb2, 0x61, 0xde, 0x40, 0x07, 0x6b, 0xf2, 0x39, 0x1c, 0xa7, 0x21,
-	0xd8, 0x30, 0xc2, 0x43, 0xeb, 0xca, 0x94, 0x28, 0x7c, 0xf0, 0x25, 0x90, 0xac, 0xda, 0x33, 0x9b,
-	0x60, 0x7a, 0x29, 0x39, 0x7d, 0x84, 0x7d, 0x8f, 0x33, 0x66, 0x92, 0x11, 0xe4, 0x14, 0x9a, 0x36,
-	0x3e, 0x26, 0xa9, 0x85, 0xbe, 0x8d, 0x7a, 0x92, 0x53, 0x45, 0xc1, 0x27, 0x50, 0x17, 0x32, 0x9c,
-	0x85, 0x31, 0x8b, 0x3c, 0x1b, 0x7c, 0xfa, 0x18, 0xc5, 0xb5, 0x0c, 0x1e, 0x21, 0x4a, 0x3e, 0x86,
-	0x7a, 0xc2, 0x65, 0x9a, 0xfe, 0x44, 0x86, 0x3e, 0xa7, 0x1d, 0xdc, 0x47, 0x35, 0xe1, 0x12, 0xfd,
-	0x37, 0x32, 0xe0, 0x67, 0x1c, 0xea, 0x5b, 0x79, 0x24, 0x65, 0x38, 0x9c, 0x4c, 0xfb, 0xe3, 0xe9,
-	0xd9, 0xb0, 0xb1, 0x43, 0x2a, 0x70, 0xf4, 0xa3, 0x7b, 0xe1, 0x4e, 0xce, 0xcf, 0x86, 0x0d, 0x87,
-	0x54, 0xa1, 0x74, 0xd5, 0xff, 0xc9, 0x1d, 0xf6, 0x0d, 0x79, 0x8f, 0xd4, 0xa1, 0xec, 0x5e, 0x14,
-	0xc0, 0x2e, 0x01, 0x38, 0xb8, 0xba, 0x9c, 0xba, 0x17, 0xaf, 0x1b, 0x7b, 0xa6, 0xcd, 0xd9, 0xcf,
-	0x23, 0x77, 0x7c, 0x36, 0x6c, 0xec, 0x0f, 0x2e, 0xff, 0xba, 0x69, 0x3b, 0xef, 0x6f, 0xda, 0xce,
-	0x3f, 0x37, 0x6d, 0xe7, 0xf7, 0xdb, 0xf6, 0xce, 0xfb, 0xdb, 0xf6, 0xce, 0xdf, 0xb7, 0xed, 0x9d,
-	0x5f, 0xbe, 0x9b, 0x85, 0x7a, 0xbe, 0xbc, 0xee, 0xf9, 0x62, 0x71, 0x9a, 0x48, 0x11, 0x2c, 0x7d,
-	0xad, 0xfc, 0x70, 0xeb, 0x27, 0xb5, 0xda, 0x78, 0xd6, 0xeb, 0x84, 0xab, 0xeb, 0x03, 0xfc, 0x5b,
-	0x7d, 0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x1f, 0x5d, 0xa2, 0xd4, 0x06, 0x00, 0x00,
+	// 1075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xcb, 0x72, 0x1a, 0x47,
+	0x17, 0xc7, 0x85, 0x2e, 0x48, 0x1c, 0xae, 0x6a, 0x24, 0xbb, 0xfd, 0x7d, 0x36, 0x46, 0x37, 0x8b,
+	0x38, 0x89, 0x94, 0x28, 0x97, 0x55, 0x2a, 0x55, 0x20, 0x88, 0x45, 0x59, 0x06, 0x0a, 0x51, 0x2a,
+	0x57, 0x36, 0x53, 0xcd, 0x4c, 0x5b, 0x4c, 0x09, 0xa6, 0x27, 0xdd, 0x8d, 0x23, 0xde, 0x22, 0x2f,
+	0x90, 0xf7, 0xc9, 0xd2, 0xcb, 0x2c, 0x53, 0xd2, 0x36, 0x0f, 0x91, 0xea, 0xd3, 0x33, 0xdc, 0x4a,
+	0xc9, 0x6e, 0xe6, 0xf7, 0xff, 0x9f, 0xee, 0x33, 0xa7, 0xcf, 0x69, 0x80, 0x03, 0x3f, 0xf8, 0xc0,
+	0x25, 0x0f, 0x5c, 0x7e, 0xfa, 0xc8, 0xd3, 0x49, 0x28, 0x85, 0x16, 0xa4, 0x38, 0x03, 0xd3, 0xa7,
+	0xfd, 0xdf, 0x13, 0x90, 0xef, 0x48, 0x11, 0x0a, 0xc5, 0x86, 0x75, 0xae, 0x99, 0x3f, 0x54, 0xe4,
+	0x6b, 0xd8, 0x95, 0xdc, 0xf9, 0xc8, 0x86, 0xbe, 0xc7, 0x34, 0x77, 0x42, 0x31, 0xf4, 0xdd, 0x89,
+	0xe3, 0x7b, 0x34, 0x51, 0x4e, 0x54, 0xd6, 0xbb, 0x44, 0xf2, 0xeb, 0x48, 0xeb, 0xa0, 0xd4, 0xf4,
+	0xc8, 0x57, 0xb0, 0xe3, 0x07, 0x8f, 0x44, 0xac, 0xda, 0x88, 0x99, 0x36, 0x8d, 0x38, 0x82, 0x5c,
+	0x64, 0x63, 0x9e, 0x27, 0xb9, 0x52, 0x74, 0xad, 0x9c, 0xa8, 0xa4, 0xba, 0x59, 0x4b, 0xab, 0x16,
+	0xee, 0xff, 0x9d, 0x81, 0x54, 0x33, 0xce, 0x96, 0xec, 0xc0, 0x86, 0x1f, 0x78, 0xfc, 0x0e, 0x33,
+	0x49, 0x75, 0xed, 0x0b, 0xd9, 0x83, 0xcc, 0xf4, 0x83, 0xe2, 0x4d, 0x53, 0xdd, 0xf4, 0x94, 0x35,
+	0x3d, 0xf2, 0x12, 0xd2, 0xa1, 0x14, 0xa3, 0x50, 0x3b, 0x03, 0xa6, 0x06, 0xd1, 0x56, 0x60, 0xd1,
+	0x05, 0x53, 0x03, 0x4c, 0xc7, 0x1a, 0x42, 0x36, 0x19, 0x0a, 0xe6, 0xd1, 0xf5, 0x28, 0x1d, 0xa4,
+	0x1d, 0x0b, 0xc9, 0x01, 0x64, 0x25, 0x57, 0xa1, 0x08, 0x14, 0xb7, 0x2b, 0x6d, 0xa0, 0x2b, 0x13,
+	0x43, 0x5c, 0xeb, 0x33, 0x28, 0x4c, 0x4d, 0xf1, 0x6a, 0x49, 0xf4, 0xe5, 0x63, 0x1e, 0xaf, 0xf7,
+	0x05, 0x90, 0x68, 0x5b, 0x2d, 0x6e, 0x79, 0xe0, 0xb8, 0x62, 0x1c, 0x68, 0xba, 0x89, 0x55, 0x2b,
+	0x58, 0xa5, 0x67, 0x84, 0x73, 0xc3, 0xc9, 0xb7, 0xf0, 0xc4, 0x15, 0xa3, 0x70, 0xc8, 0xb5, 0x2f,
+	0x82, 0x85, 0x88, 0x2d, 0x8c, 0xd8, 0x99, 0xa9, 0x73, 0x51, 0x7b, 0x90, 0x91, 0xfc, 0x97, 0x31,
+	0x57, 0x9a, 0x7b, 0x4e, 0x7f, 0x42, 0x53, 0xb6, 0x3c, 0x53, 0x56, 0x9b, 0x98, 0xf2, 0xf0, 0x3b,
+	0xee, 0x8e, 0x23, 0x07, 0xd8, 0xf2, 0xc4, 0xa8, 0x36, 0x21, 0x3f, 0x40, 0x52, 0x69, 0xa6, 0xc7,
+	0x8a, 0xa6, 0xcb, 0x89, 0x4a, 0xee, 0xec, 0xf0, 0xe4, 0x91, 0x66, 0x3a, 0x99, 0x1e, 0xd4, 0x15,
+	0x7a, 0xbb, 0x51, 0x8c, 0xf9, 0x4a, 0xa5, 0x99, 0xd4, 0x4e, 0x7f, 0x28, 0xdc, 0x5b, 0x67, 0xc0,
+	0xfd, 0x9b, 0x81, 0xa6, 0x99, 0x72, 0xa2, 0xb2, 0xd6, 0x2d, 0xa0, 0x52, 0x33, 0xc2, 0x05, 0x72,
+	0x52, 0x81, 0x02, 0x0f, 0xbc, 0x45, 0x6f, 0x16, 0xbd, 0x39, 0x1e, 0x78, 0xf3, 0xce, 0x33, 0xd8,
+	0x9d, 0x5f, 0x57, 0xfb, 0x23, 0xae, 0x34, 0x1b, 0x85, 0x34, 0x87, 0xf6, 0xe2, 0x6c, 0xe9, 0x5e,
+	0x2c, 0x91, 0x13, 0x28, 0xce, 0x56, 0x9f, 0x45, 0xe4, 0x31, 0x62, 0x3b, 0xde, 0x60, 0xe6, 0xdf,
+	0x81, 0x8d, 0x91, 0xf0, 0xf8, 0x90, 0x16, 0x6c, 0xcb, 0xe1, 0x0b, 0x79, 0x01, 0x30, 0x62, 0x77,
+	0xf6, 0x08, 0x14, 0xdd, 0xc6, 0xea, 0xa7, 0x46, 0xec, 0x0e, 0xcb, 0xae, 0x4c, 0x3d, 0x99, 0xab,
+	0xc7, 0x6c, 0xe8, 0xb8, 0x42, 0x69, 0x4a, 0x70, 0x71, 0xb0, 0xe8, 0x5c, 0x28, 0x6d, 0xfa, 0x88,
+	0x2b, 0x57, 0x8a, 0x5f, 0x1d, 0x36, 0xc2, 0x03, 0x2c, 0xa2, 0x25, 0x63, 0x61, 0x15, 0x19, 0x69,
+	0x83, 0x69, 0x01, 0x1c, 0x4d, 0xc7, 0xb3, 0xb3, 0x49, 0x77, 0xca, 0x89, 0x4a, 0xfa, 0x5f, 0xca,
+	0xbf, 0x34, 0xc7, 0xdd, 0x7c, 0xb8, 0x34, 0xd8, 0x87, 0x90, 0xe3, 0xa1, 0x70, 0x07, 0xce, 0x8d,
+	0x14, 0xe3, 0xd0, 0x8c, 0xca, 0x2e, 0x66, 0x9e, 0x41, 0xfa, 0xc6, 0x40, 0x3b, 0x2b, 0x4c, 0x29,
+	0xff, 0x26, 0xe0, 0x9e, 0xa3, 0x05, 0x7d, 0x62, 0x9b, 0x21, 0x46, 0x3d, 0x61, 0x1a, 0x2a, 0x1e,
+	0x67, 0x6c, 0x97, 0xa7, 0xe5, 0x35, 0xd3, 0x50, 0x53, 0x56, 0x9b, 0x18, 0x4b, 0x20, 0x3c, 0xee,
+	0x7c, 0xe4, 0x52, 0xf9, 0x22, 0xa0, 0xd4, 0xf6, 0x9c, 0x61, 0xd7, 0x16, 0x91, 0x67, 0xb0, 0x65,
+	0x93, 0xf1, 0x3d, 0xfa, 0x0c, 0xd3, 0xd8, 0xc4, 0xf7, 0xa6, 0x47, 0x7e, 0x84, 0xe7, 0x56, 0x0a,
+	0x85, 0xeb, 0x3c, 0xd2, 0x39, 0xff, 0x43, 0x3b, 0x45, 0x4f, 0x47, 0xb8, 0x57, 0xcb, 0x1d, 0x74,
+	0x04, 0x39, 0x2d, 0x59, 0xa0, 0x3e, 0x70, 0x29, 0x6d, 0x8a, 0xff, 0xb7, 0xc3, 0x3c, 0x47, 0x6b,
+	0x13, 0xf2, 0x39, 0x6c, 0x47, 0x43, 0x30, 0xd7, 0x08, 0xcf, 0x6d, 0x57, 0x46, 0xc2, 0xac, 0x0f,
+	0xbe, 0x04, 0x12, 0x47, 0x3b, 0xa6, 0x12, 0x4c, 0x8f, 0x25, 0xa7, 0x2f, 0x70, 0xdd, 0xed, 0x58,
+	0xb9, 0x8a, 0x05, 0x72, 0x0a, 0x45, 0x3b, 0x3e, 0x66, 0x52, 0x67, 0xfe, 0x12, 0xfa, 0xc9, 0x54,
+	0x9a, 0x05, 0x1c, 0x43, 0x5e, 0x48, 0xff, 0xc6, 0x0f, 0xd8, 0xd0, 0xb1, 0x83, 0x4f, 0x5f, 0xa2,
+	0x39, 0x17, 0xe3, 0x0e, 0x52, 0xf2, 0x0a, 0xf2, 0x21, 0x97, 0xd1, 0xf4, 0x87, 0xd2, 0x77, 0x39,
+	0x2d, 0x63, 0x3d, 0xb2, 0x21, 0x97, 0xd8, 0x7f, 0x1d, 0x03, 0xc9, 0xf7, 0xb0, 0x7e, 0xeb, 0x07,
+	0x1e, 0xdd, 0xc3, 0x81, 0xdd, 0xff, 0xef, 0x81, 0x7d, 0xeb, 0x07, 0x5e, 0x17, 0xfd, 0x26, 0x73,
+	0xd6, 0x67, 0x81, 0x27, 0xcc, 0xf9, 0xdb, 0x44, 0x85, 0x54, 0x74, 0x1f, 0x0f, 0x99, 0x4c, 0xa5,
+	0x46, 0xac, 0x98, 0xbb, 0x7f, 0x29, 0x73, 0x7b, 0x35, 0x1e, 0xd8, 0x6f, 0x5d, 0x4c, 0x1f, 0x2f,
+	0xc8, 0x33, 0xf3, 0x03, 0xb3, 0x78, 0x41, 0xda, 0x90, 0x43, 0x0c, 0x29, 0x2e, 0xdd, 0x92, 0x18,
+	0x73, 0x0c, 0xf9, 0xd8, 0x3a, 0x14, 0x2e, 0xd3, 0x42, 0xd2, 0x23, 0x5b, 0x9f, 0x08, 0x5f, 0x5a,
+	0x4a, 0x9e, 0xc2, 0xa6, 0x1a, 0xf7, 0x9d, 0x5b, 0x3e, 0xa1, 0xaf, 0xd0, 0x90, 0x54, 0xe3, 0xfe,
+	0x5b, 0x3e, 0x21, 0xfb, 0x90, 0x8d, 0x04, 0x07, 0x3b, 0x87, 0x1e, 0x63, 0xd9, 0xd2, 0x56, 0x6e,
+	0x18, 0xf4, 0x5a, 0x43, 0x7e, 0xe9, 0x12, 0x23, 0x69, 0xd8, 0xbc, 0xea, 0x55, 0xbb, 0xbd, 0x46,
+	0xbd, 0xb0, 0x42, 0x32, 0xb0, 0xf5, 0x53, 0xb3, 0xd5, 0xbc, 0xba, 0x68, 0xd4, 0x0b, 0x09, 0x92,
+	0x85, 0xd4, 0x75, 0xf5, 0xb2, 0x59, 0xaf, 0x1a, 0x71, 0x95, 0xe4, 0x21, 0xdd, 0x6c, 0xcd, 0xc0,
+	0x1a, 0x01, 0x48, 0x5e, 0xb7, 0x7b, 0xcd, 0xd6, 0x9b, 0xc2, 0xba, 0x59, 0xa6, 0xf1, 0xbe, 0xd3,
+	0xec, 0x36, 0xea, 0x85, 0x0d, 0x13, 0x58, 0xad, 0x55, 0x5b, 0xf5, 0x76, 0xab, 0x51, 0x2f, 0x24,
+	0x5f, 0x5f, 0x40, 0x76, 0xe1, 0x24, 0x48, 0x11, 0xf2, 0xe7, 0x17, 0xd5, 0x9e, 0x73, 0xde, 0x7e,
+	0xd7, 0xb9, 0x6c, 0xf4, 0x9a, 0xed, 0x56, 0x61, 0xc5, 0xc0, 0x5e, 0xe3, 0xfd, 0x02, 0xc4, 0x14,
+	0x1a, 0xef, 0x6a, 0x8d, 0x7a, 0xdd, 0xec, 0xb2, 0x5a, 0x6b, 0xff, 0x71, 0x5f, 0x4a, 0x7c, 0xba,
+	0x2f, 0x25, 0xfe, 0xba, 0x2f, 0x25, 0x7e, 0x7b, 0x28, 0xad, 0x7c, 0x7a, 0x28, 0xad, 0xfc, 0xf9,
+	0x50, 0x5a, 0xf9, 0xf9, 0xbb, 0x1b, 0x5f, 0x0f, 0xc6, 0xfd, 0x13, 0x57, 0x8c, 0x4e, 0x43, 0x29,
+	0xbc, 0xb1, 0xab, 0x95, 0xeb, 0x2f, 0xfd, 0x65, 0xb8, 0x9b, 0x7b, 0xd6, 0x93, 0x90, 0xab, 0x7e,
+	0x12, 0xff, 0x3b, 0x7c, 0xf3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x76, 0xa5, 0x60, 0x62,
+	0x08, 0x00, 0x00,
 }
 
 func (m *ProposalDetails) Marshal() (dAtA []byte, err error) {
@@ -541,6 +651,67 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SubKeyEpoch != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.SubKeyEpoch))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.SubKey) > 0 {
+		i -= len(m.SubKey)
+		copy(dAtA[i:], m.SubKey)
+		i = encodeVarintInference(dAtA, i, uint64(len(m.SubKey)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.PayloadLocator) > 0 {
+		i -= len(m.PayloadLocator)
+		copy(dAtA[i:], m.PayloadLocator)
+		i = encodeVarintInference(dAtA, i, uint64(len(m.PayloadLocator)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ResponsePayloadHash) > 0 {
+		i -= len(m.ResponsePayloadHash)
+		copy(dAtA[i:], m.ResponsePayloadHash)
+		i = encodeVarintInference(dAtA, i, uint64(len(m.ResponsePayloadHash)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.OriginalPromptHash) > 0 {
+		i -= len(m.OriginalPromptHash)
+		copy(dAtA[i:], m.OriginalPromptHash)
+		i = encodeVarintInference(dAtA, i, uint64(len(m.OriginalPromptHash)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.AbandonedExecutors) > 0 {
+		for iNdEx := len(m.AbandonedExecutors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AbandonedExecutors[iNdEx])
+			copy(dAtA[i:], m.AbandonedExecutors[iNdEx])
+			i = encodeVarintInference(dAtA, i, uint64(len(m.AbandonedExecutors[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.PerTokenPrice != 0 {
 		i = encodeVarintInference(dAtA, i, uint64(m.PerTokenPrice))
 		i--
@@ -930,6 +1101,34 @@ func (m *Inference) Size() (n int) {
 	if m.PerTokenPrice != 0 {
 		n += 2 + sovInference(uint64(m.PerTokenPrice))
 	}
+	if m.Kind != 0 {
+		n += 2 + sovInference(uint64(m.Kind))
+	}
+	if len(m.AbandonedExecutors) > 0 {
+		for _, s := range m.AbandonedExecutors {
+			l = len(s)
+			n += 2 + l + sovInference(uint64(l))
+		}
+	}
+	l = len(m.OriginalPromptHash)
+	if l > 0 {
+		n += 2 + l + sovInference(uint64(l))
+	}
+	l = len(m.ResponsePayloadHash)
+	if l > 0 {
+		n += 2 + l + sovInference(uint64(l))
+	}
+	l = len(m.PayloadLocator)
+	if l > 0 {
+		n += 2 + l + sovInference(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 2 + l + sovInference(uint64(l))
+	}
+	if m.SubKeyEpoch != 0 {
+		n += 2 + sovInference(uint64(m.SubKeyEpoch))
+	}
 	return n
 }
 
@@ -1921,6 +2120,204 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= InferenceKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbandonedExecutors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbandonedExecutors = append(m.AbandonedExecutors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsePayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadLocator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadLocator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeyEpoch", wireType)
+			}
+			m.SubKeyEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubKeyEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInference(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package types
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,15 +24,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type InferenceValidationDetails struct {
-	EpochId              uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	InferenceId          string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
-	ExecutorId           string `protobuf:"bytes,3,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
-	ExecutorReputation   int32  `protobuf:"varint,4,opt,name=executor_reputation,json=executorReputation,proto3" json:"executor_reputation,omitempty"`
-	TrafficBasis         uint64 `protobuf:"varint,5,opt,name=traffic_basis,json=trafficBasis,proto3" json:"traffic_basis,omitempty"`
-	ExecutorPower        uint64 `protobuf:"varint,6,opt,name=executor_power,json=executorPower,proto3" json:"executor_power,omitempty"`
-	Model                string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
-	TotalPower           uint64 `protobuf:"varint,8,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
-	CreatedAtBlockHeight int64  `protobuf:"varint,9,opt,name=created_at_block_height,json=createdAtBlockHeight,proto3" json:"created_at_block_height,omitempty"`
+	EpochId              uint64                   `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	InferenceId          string                   `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	ExecutorId           string                   `protobuf:"bytes,3,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	ExecutorReputation   int32                    `protobuf:"varint,4,opt,name=executor_reputation,json=executorReputation,proto3" json:"executor_reputation,omitempty"`
+	TrafficBasis         uint64                   `protobuf:"varint,5,opt,name=traffic_basis,json=trafficBasis,proto3" json:"traffic_basis,omitempty"`
+	ExecutorPower        uint64                   `protobuf:"varint,6,opt,name=executor_power,json=executorPower,proto3" json:"executor_power,omitempty"`
+	Model                string                   `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	TotalPower           uint64                   `protobuf:"varint,8,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	CreatedAtBlockHeight int64                    `protobuf:"varint,9,opt,name=created_at_block_height,json=createdAtBlockHeight,proto3" json:"created_at_block_height,omitempty"`
+	Validations          []*ValidationDiagnostics `protobuf:"bytes,10,rep,name=validations,proto3" json:"validations,omitempty"`
 }
 
 func (m *InferenceValidationDetails) Reset()         { *m = InferenceValidationDetails{} }
@@ -130,8 +132,133 @@ func (m *InferenceValidationDetails) GetCreatedAtBlockHeight() int64 {
 	return 0
 }
 
+func (m *InferenceValidationDetails) GetValidations() []*ValidationDiagnostics {
+	if m != nil {
+		return m.Validations
+	}
+	return nil
+}
+
+// ValidationDiagnostics summarizes how a validator reached its verdict on an inference.
+type ValidationDiagnostics struct {
+	Validator           string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Strategy            ValidationStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=inference.inference.ValidationStrategy" json:"strategy,omitempty"`
+	Value               float64            `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Passed              bool               `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	ComparedTokens      uint32             `protobuf:"varint,5,opt,name=compared_tokens,json=comparedTokens,proto3" json:"compared_tokens,omitempty"`
+	MismatchedTokens    uint32             `protobuf:"varint,6,opt,name=mismatched_tokens,json=mismatchedTokens,proto3" json:"mismatched_tokens,omitempty"`
+	MaxPositionDistance float64            `protobuf:"fixed64,7,opt,name=max_position_distance,json=maxPositionDistance,proto3" json:"max_position_distance,omitempty"`
+	Reason              string             `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReportHash          string             `protobuf:"bytes,9,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	ReportLocator       string             `protobuf:"bytes,10,opt,name=report_locator,json=reportLocator,proto3" json:"report_locator,omitempty"`
+}
+
+func (m *ValidationDiagnostics) Reset()         { *m = ValidationDiagnostics{} }
+func (m *ValidationDiagnostics) String() string { return proto.CompactTextString(m) }
+func (*ValidationDiagnostics) ProtoMessage()    {}
+func (*ValidationDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caf29ad96621edf5, []int{1}
+}
+func (m *ValidationDiagnostics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationDiagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationDiagnostics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidationDiagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationDiagnostics.Merge(m, src)
+}
+func (m *ValidationDiagnostics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationDiagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationDiagnostics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationDiagnostics proto.InternalMessageInfo
+
+func (m *ValidationDiagnostics) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidationDiagnostics) GetStrategy() ValidationStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return ValidationStrategy_DEFAULT_VALIDATION
+}
+
+func (m *ValidationDiagnostics) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ValidationDiagnostics) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *ValidationDiagnostics) GetComparedTokens() uint32 {
+	if m != nil {
+		return m.ComparedTokens
+	}
+	return 0
+}
+
+func (m *ValidationDiagnostics) GetMismatchedTokens() uint32 {
+	if m != nil {
+		return m.MismatchedTokens
+	}
+	return 0
+}
+
+func (m *ValidationDiagnostics) GetMaxPositionDistance() float64 {
+	if m != nil {
+		return m.MaxPositionDistance
+	}
+	return 0
+}
+
+func (m *ValidationDiagnostics) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ValidationDiagnostics) GetReportHash() string {
+	if m != nil {
+		return m.ReportHash
+	}
+	return ""
+}
+
+func (m *ValidationDiagnostics) GetReportLocator() string {
+	if m != nil {
+		return m.ReportLocator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InferenceValidationDetails)(nil), "inference.inference.InferenceValidationDetails")
+	proto.RegisterType((*ValidationDiagnostics)(nil), "inference.inference.ValidationDiagnostics")
 }
 
 func init() {
@@ -139,29 +266,43 @@ func init() {
 }
 
 var fileDescriptor_caf29ad96621edf5 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0xeb, 0xfe, 0xd7, 0x6d, 0x19, 0xdc, 0x4a, 0x04, 0x86, 0x10, 0x40, 0x48, 0x99, 0xda,
-	0x01, 0x95, 0x9d, 0x8a, 0x81, 0x4c, 0xa0, 0x0c, 0x0c, 0x2c, 0x91, 0x63, 0xbb, 0x8d, 0x45, 0x5a,
-	0x47, 0xce, 0x0d, 0x94, 0xb7, 0xe0, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0x56, 0xe2, 0x39, 0x50, 0xdc,
-	0x26, 0x20, 0xc4, 0x76, 0xef, 0x77, 0xce, 0xb1, 0x8f, 0x74, 0xf1, 0x95, 0x5c, 0xce, 0x84, 0x16,
-	0x4b, 0x26, 0xc6, 0xff, 0x4c, 0xc1, 0x33, 0x8d, 0x25, 0xa7, 0x20, 0xd5, 0x32, 0xe0, 0x02, 0xa8,
-	0x8c, 0xd3, 0x51, 0xa2, 0x15, 0x28, 0x32, 0x28, 0x3d, 0xa3, 0x72, 0x3a, 0xfb, 0xaa, 0xe2, 0x63,
-	0xaf, 0xd8, 0x1e, 0xca, 0xe8, 0xcd, 0x2e, 0x49, 0x8e, 0x70, 0x5b, 0x24, 0x8a, 0x45, 0x81, 0xe4,
-	0x16, 0x72, 0x90, 0x5b, 0xf7, 0x5b, 0x66, 0xf7, 0x38, 0x39, 0xc5, 0xbd, 0x9f, 0x4f, 0x25, 0xb7,
-	0xaa, 0x0e, 0x72, 0x3b, 0x7e, 0xb7, 0x64, 0x1e, 0x27, 0x27, 0xb8, 0x2b, 0x56, 0x82, 0x65, 0xa0,
-	0x74, 0xee, 0xa8, 0x19, 0x07, 0x2e, 0x90, 0xc7, 0xc9, 0x18, 0x0f, 0x4a, 0x83, 0x16, 0x49, 0x06,
-	0xe6, 0x73, 0xab, 0xee, 0x20, 0xb7, 0xe1, 0x93, 0x42, 0xf2, 0x4b, 0x85, 0x9c, 0xe3, 0x3e, 0x68,
-	0x3a, 0x9b, 0x49, 0x16, 0x84, 0x34, 0x95, 0xa9, 0xd5, 0x30, 0xa5, 0x7a, 0x7b, 0x38, 0xcd, 0x19,
-	0xb9, 0xc0, 0x07, 0xe5, 0xab, 0x89, 0x7a, 0x11, 0xda, 0x6a, 0x1a, 0x57, 0xbf, 0xa0, 0xf7, 0x39,
-	0x24, 0x43, 0xdc, 0x58, 0x28, 0x2e, 0x62, 0xab, 0x65, 0x7a, 0xed, 0x96, 0xbc, 0x33, 0x28, 0xa0,
-	0xf1, 0x3e, 0xd9, 0x36, 0x49, 0x6c, 0xd0, 0x2e, 0x36, 0xc1, 0x87, 0x4c, 0x0b, 0x0a, 0x82, 0x07,
-	0x14, 0x82, 0x30, 0x56, 0xec, 0x29, 0x88, 0x84, 0x9c, 0x47, 0x60, 0x75, 0x1c, 0xe4, 0xd6, 0xfc,
-	0xe1, 0x5e, 0xbe, 0x86, 0x69, 0x2e, 0xde, 0x1a, 0x6d, 0x7a, 0xf7, 0xbe, 0xb1, 0xd1, 0x7a, 0x63,
-	0xa3, 0xcf, 0x8d, 0x8d, 0xde, 0xb6, 0x76, 0x65, 0xbd, 0xb5, 0x2b, 0x1f, 0x5b, 0xbb, 0xf2, 0x38,
-	0x99, 0x4b, 0x88, 0xb2, 0x70, 0xc4, 0xd4, 0x62, 0x9c, 0x68, 0xc5, 0x33, 0x06, 0x29, 0x93, 0x7f,
-	0xee, 0xbb, 0xfa, 0x35, 0xc3, 0x6b, 0x22, 0xd2, 0xb0, 0x69, 0xae, 0x7a, 0xf9, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0x00, 0x9b, 0xbf, 0x00, 0x0f, 0x02, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xba, 0x76, 0xcd, 0xeb, 0x5a, 0xc0, 0xdd, 0x20, 0x4c, 0x28, 0x2b, 0x43, 0x68,
+	0x15, 0x48, 0xad, 0x54, 0x34, 0xee, 0x94, 0x1d, 0x56, 0x69, 0x12, 0x93, 0x41, 0x1c, 0xb8, 0x44,
+	0xae, 0xed, 0x36, 0xd6, 0x92, 0x38, 0xb2, 0xdd, 0xd1, 0x7d, 0x0b, 0xc4, 0x67, 0xe1, 0x43, 0x70,
+	0xdc, 0x91, 0x23, 0x6a, 0xbf, 0x08, 0x8a, 0x93, 0xa6, 0x15, 0xaa, 0xc4, 0xcd, 0xef, 0xff, 0xff,
+	0xbd, 0x97, 0x27, 0xff, 0x63, 0x78, 0x27, 0x92, 0x29, 0x57, 0x3c, 0xa1, 0x7c, 0xb0, 0xe3, 0x14,
+	0xdc, 0x92, 0x48, 0x30, 0x62, 0x84, 0x4c, 0x02, 0xc6, 0x0d, 0x11, 0x91, 0xee, 0xa7, 0x4a, 0x1a,
+	0x89, 0x3a, 0x25, 0xd3, 0x2f, 0x4f, 0xc7, 0x27, 0xbb, 0x86, 0xc5, 0x92, 0xf1, 0x28, 0xef, 0x3a,
+	0xfd, 0x59, 0x85, 0xe3, 0xf1, 0xda, 0xf9, 0x52, 0xce, 0xbe, 0xc8, 0x47, 0xa3, 0x67, 0xd0, 0xe0,
+	0xa9, 0xa4, 0x61, 0x20, 0x98, 0xe7, 0x74, 0x9d, 0xde, 0x1e, 0xde, 0xb7, 0xf5, 0x98, 0xa1, 0x17,
+	0x70, 0xb0, 0xd9, 0x4a, 0x30, 0xef, 0x41, 0xd7, 0xe9, 0xb9, 0xb8, 0x59, 0x6a, 0x63, 0x86, 0x4e,
+	0xa0, 0xc9, 0x17, 0x9c, 0xce, 0x8d, 0x54, 0x19, 0x51, 0xb5, 0x04, 0xac, 0xa5, 0x31, 0x43, 0x03,
+	0xe8, 0x94, 0x80, 0xe2, 0xe9, 0xdc, 0xd8, 0x8f, 0x7b, 0x7b, 0x5d, 0xa7, 0x57, 0xc3, 0x68, 0x6d,
+	0xe1, 0xd2, 0x41, 0x2f, 0xa1, 0x65, 0x14, 0x99, 0x4e, 0x05, 0x0d, 0x26, 0x44, 0x0b, 0xed, 0xd5,
+	0xec, 0x52, 0x07, 0x85, 0x38, 0xca, 0x34, 0xf4, 0x0a, 0xda, 0xe5, 0xd4, 0x54, 0x7e, 0xe3, 0xca,
+	0xab, 0x5b, 0xaa, 0xb5, 0x56, 0xaf, 0x33, 0x11, 0x1d, 0x42, 0xcd, 0xde, 0x84, 0xb7, 0x6f, 0xf7,
+	0xca, 0x8b, 0x6c, 0x67, 0x23, 0x0d, 0x89, 0x8a, 0xce, 0x86, 0xed, 0x04, 0x2b, 0xe5, 0x6d, 0xe7,
+	0xf0, 0x94, 0x2a, 0x4e, 0x0c, 0x67, 0x01, 0x31, 0xc1, 0x24, 0x92, 0xf4, 0x26, 0x08, 0xb9, 0x98,
+	0x85, 0xc6, 0x73, 0xbb, 0x4e, 0xaf, 0x8a, 0x0f, 0x0b, 0xfb, 0xbd, 0x19, 0x65, 0xe6, 0xa5, 0xf5,
+	0xd0, 0x15, 0x34, 0x37, 0xd1, 0x69, 0x0f, 0xba, 0xd5, 0x5e, 0x73, 0xf8, 0xba, 0xbf, 0x23, 0xb4,
+	0xfe, 0x56, 0x0c, 0x82, 0xcc, 0x12, 0xa9, 0x8d, 0xa0, 0x1a, 0x6f, 0xb7, 0x9f, 0xfe, 0xa8, 0xc2,
+	0xd1, 0x4e, 0x0c, 0x3d, 0x07, 0xb7, 0x00, 0xa5, 0xb2, 0x91, 0xb9, 0x78, 0x23, 0xa0, 0x0f, 0xd0,
+	0xd0, 0x46, 0x11, 0xc3, 0x67, 0x77, 0x36, 0xb0, 0xf6, 0xf0, 0xec, 0x3f, 0x2b, 0x7c, 0x2a, 0x70,
+	0x5c, 0x36, 0x66, 0x17, 0x77, 0x4b, 0xa2, 0x39, 0xb7, 0x81, 0x3a, 0x38, 0x2f, 0xd0, 0x13, 0xa8,
+	0xa7, 0x44, 0x6b, 0xce, 0x6c, 0x7c, 0x0d, 0x5c, 0x54, 0xe8, 0x0c, 0x1e, 0x52, 0x19, 0xa7, 0x44,
+	0x71, 0x16, 0x18, 0x79, 0xc3, 0x93, 0x3c, 0xb4, 0x16, 0x6e, 0xaf, 0xe5, 0xcf, 0x56, 0x45, 0x6f,
+	0xe0, 0x71, 0x2c, 0x74, 0x4c, 0x0c, 0x0d, 0x37, 0x68, 0xdd, 0xa2, 0x8f, 0x36, 0x46, 0x01, 0x0f,
+	0xe1, 0x28, 0x26, 0x8b, 0x20, 0x95, 0x5a, 0xe4, 0x6f, 0x41, 0x68, 0x43, 0x12, 0xca, 0x6d, 0x98,
+	0x0e, 0xee, 0xc4, 0x64, 0x71, 0x5d, 0x78, 0x17, 0x85, 0x95, 0x6d, 0xa8, 0x38, 0xd1, 0x32, 0xb1,
+	0xa9, 0xba, 0xb8, 0xa8, 0xb2, 0xc8, 0x15, 0x4f, 0xa5, 0x32, 0x41, 0x48, 0x74, 0x68, 0x53, 0x74,
+	0x31, 0xe4, 0xd2, 0x25, 0xd1, 0x61, 0xf6, 0x43, 0x15, 0x40, 0x24, 0xa9, 0xbd, 0x58, 0xb0, 0x4c,
+	0x2b, 0x57, 0xaf, 0x72, 0x71, 0xf4, 0xf1, 0xd7, 0xd2, 0x77, 0xee, 0x97, 0xbe, 0xf3, 0x67, 0xe9,
+	0x3b, 0xdf, 0x57, 0x7e, 0xe5, 0x7e, 0xe5, 0x57, 0x7e, 0xaf, 0xfc, 0xca, 0xd7, 0xf3, 0x99, 0x30,
+	0xe1, 0x7c, 0xd2, 0xa7, 0x32, 0x1e, 0xa4, 0x4a, 0xb2, 0x39, 0x35, 0x9a, 0x8a, 0x7f, 0x9e, 0xe5,
+	0x62, 0xeb, 0x6c, 0xee, 0x52, 0xae, 0x27, 0x75, 0xfb, 0x46, 0xdf, 0xfe, 0x0d, 0x00, 0x00, 0xff,
+	0xff, 0x10, 0x8b, 0xf2, 0x85, 0x13, 0x04, 0x00, 0x00,
 }
 
 func (m *InferenceValidationDetails) Marshal() (dAtA []byte, err error) {
@@ -184,6 +325,20 @@ func (m *InferenceValidationDetails) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.CreatedAtBlockHeight != 0 {
 		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(m.CreatedAtBlockHeight))
 		i--
@@ -238,6 +393,94 @@ func (m *InferenceValidationDetails) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidationDiagnostics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationDiagnostics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationDiagnostics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReportLocator) > 0 {
+		i -= len(m.ReportLocator)
+		copy(dAtA[i:], m.ReportLocator)
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(len(m.ReportLocator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxPositionDistance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxPositionDistance))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.MismatchedTokens != 0 {
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(m.MismatchedTokens))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ComparedTokens != 0 {
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(m.ComparedTokens))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintInferenceValidationDetails(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInferenceValidationDetails(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInferenceValidationDetails(v)
 	base := offset
@@ -285,6 +528,55 @@ func (m *InferenceValidationDetails) Size() (n int) {
 	if m.CreatedAtBlockHeight != 0 {
 		n += 1 + sovInferenceValidationDetails(uint64(m.CreatedAtBlockHeight))
 	}
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovInferenceValidationDetails(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidationDiagnostics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovInferenceValidationDetails(uint64(l))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovInferenceValidationDetails(uint64(m.Strategy))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Passed {
+		n += 2
+	}
+	if m.ComparedTokens != 0 {
+		n += 1 + sovInferenceValidationDetails(uint64(m.ComparedTokens))
+	}
+	if m.MismatchedTokens != 0 {
+		n += 1 + sovInferenceValidationDetails(uint64(m.MismatchedTokens))
+	}
+	if m.MaxPositionDistance != 0 {
+		n += 9
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovInferenceValidationDetails(uint64(l))
+	}
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovInferenceValidationDetails(uint64(l))
+	}
+	l = len(m.ReportLocator)
+	if l > 0 {
+		n += 1 + l + sovInferenceValidationDetails(uint64(l))
+	}
 	return n
 }
 
@@ -533,6 +825,317 @@ func (m *InferenceValidationDetails) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validations = append(m.Validations, &ValidationDiagnostics{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInferenceValidationDetails(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidationDiagnostics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInferenceValidationDetails
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidationDiagnostics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidationDiagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= ValidationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTokens", wireType)
+			}
+			m.ComparedTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComparedTokens |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MismatchedTokens", wireType)
+			}
+			m.MismatchedTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MismatchedTokens |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionDistance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxPositionDistance = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportLocator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceValidationDetails
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceValidationDetails
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportLocator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInferenceValidationDetails(dAtA[iNdEx:])
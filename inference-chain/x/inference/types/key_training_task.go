@@ -49,6 +49,14 @@ func TrainingTaskAllKVRecordsKey(taskId uint64) []byte {
 	return StringKey(fmt.Sprintf("TrainingTask/sync/%d/store", taskId))
 }
 
+func TrainingCheckpointKey(taskId uint64, outerStep int32) []byte {
+	return StringKey(fmt.Sprintf("TrainingTask/sync/%d/checkpoint/%d/value", taskId, outerStep))
+}
+
+func TrainingTaskAllCheckpointsKey(taskId uint64) []byte {
+	return StringKey(fmt.Sprintf("TrainingTask/sync/%d/checkpoint", taskId))
+}
+
 func TrainingTaskNodeEpochActivityKey(taskId uint64, outerStep int32, participant string, nodeId string) []byte {
 	return StringKey(fmt.Sprintf("TrainingTask/sync/%d/heartbeat/%d/%s/%s", taskId, outerStep, participant, nodeId))
 }
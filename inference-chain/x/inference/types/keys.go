@@ -39,6 +39,19 @@ var (
 	TopMinerPrefix                   = collections.NewPrefix(16)
 	PartialUpgradePrefix             = collections.NewPrefix(17)
 	EpochPerformanceSummaryPrefix    = collections.NewPrefix(18)
+	InferencesByEpochPrefix          = collections.NewPrefix(19)
+	InferencePruningCutoffPrefix     = collections.NewPrefix(20)
+	InferencePruningCursorPrefix     = collections.NewPrefix(21)
+	SettleAmountsByEpochPrefix       = collections.NewPrefix(22)
+	BridgeWithdrawalsPrefix          = collections.NewPrefix(23)
+	BridgeWithdrawalSequencePrefix   = collections.NewPrefix(24)
+	BridgeBlockAttestationsPrefix    = collections.NewPrefix(25)
+	BridgeBlockHeadersPrefix         = collections.NewPrefix(26)
+	DeveloperSubKeysPrefix           = collections.NewPrefix(27)
+	PendingBridgeWithdrawalsPrefix   = collections.NewPrefix(28)
+	BridgeWithdrawalCursorPrefix     = collections.NewPrefix(29)
+	BridgeAttestationsByHeightPrefix = collections.NewPrefix(30)
+	SettleAmountsEpochIndexPrefix    = collections.NewPrefix(31)
 	ParamsKey                        = []byte("p_inference")
 )
 
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAttestBridgeBlock{}
+
+func NewMsgAttestBridgeBlock(validator string, originChain string, blockNumber string, receiptsRoot string) *MsgAttestBridgeBlock {
+	return &MsgAttestBridgeBlock{
+		Validator:    validator,
+		OriginChain:  originChain,
+		BlockNumber:  blockNumber,
+		ReceiptsRoot: receiptsRoot,
+	}
+}
+
+var reReceiptsRoot = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+func (msg *MsgAttestBridgeBlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if len(msg.OriginChain) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "originChain is required")
+	}
+	if !reCanonicalUint.MatchString(msg.BlockNumber) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "blockNumber must be a base-10 unsigned integer string without leading zeros")
+	}
+	if !reReceiptsRoot.MatchString(msg.ReceiptsRoot) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "receiptsRoot must be a 0x-prefixed 32-byte hex string")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBatchClaimRewards{}
+
+// MaxClaimsPerBatch bounds the signature checks and payouts of a single message
+const MaxClaimsPerBatch = 32
+
+func NewMsgBatchClaimRewards(creator string, claims []RewardClaim) *MsgBatchClaimRewards {
+	return &MsgBatchClaimRewards{Creator: creator, Claims: claims}
+}
+
+func (msg *MsgBatchClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Claims) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "claims must not be empty")
+	}
+	if len(msg.Claims) > MaxClaimsPerBatch {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d claims per message", MaxClaimsPerBatch)
+	}
+	seen := make(map[uint64]bool, len(msg.Claims))
+	for _, claim := range msg.Claims {
+		if claim.EpochIndex == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "epoch_index must be > 0")
+		}
+		if seen[claim.EpochIndex] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "epoch %d is claimed twice", claim.EpochIndex)
+		}
+		seen[claim.EpochIndex] = true
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchClaimRewards_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBatchClaimRewards
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBatchClaimRewards{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no claims",
+			msg: MsgBatchClaimRewards{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate epoch",
+			msg: MsgBatchClaimRewards{
+				Creator: sample.AccAddress(),
+				Claims:  []RewardClaim{{Seed: 1, EpochIndex: 3}, {Seed: 2, EpochIndex: 3}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many claims",
+			msg: MsgBatchClaimRewards{
+				Creator: sample.AccAddress(),
+				Claims:  tooManyClaims(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgBatchClaimRewards{
+				Creator: sample.AccAddress(),
+				Claims:  []RewardClaim{{Seed: 1, EpochIndex: 3}, {Seed: 2, EpochIndex: 4}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func tooManyClaims() []RewardClaim {
+	claims := make([]RewardClaim, MaxClaimsPerBatch+1)
+	for i := range claims {
+		claims[i] = RewardClaim{Seed: int64(i), EpochIndex: uint64(i + 1)}
+	}
+	return claims
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBridgeDepositProof{}
+
+// MaxReceiptsPerDepositProof bounds the proof verification work of a single message
+const MaxReceiptsPerDepositProof = 100
+
+// reCanonicalUint matches base-10 unsigned integers without leading zeros, so every block and
+// receipt has exactly one spelling in the store keys
+var reCanonicalUint = regexp.MustCompile(`^(0|[1-9][0-9]*)$`)
+
+func NewMsgBridgeDepositProof(creator string, originChain string, blockNumber string, receipts []ReceiptProof) *MsgBridgeDepositProof {
+	return &MsgBridgeDepositProof{
+		Creator:     creator,
+		OriginChain: originChain,
+		BlockNumber: blockNumber,
+		Receipts:    receipts,
+	}
+}
+
+func (msg *MsgBridgeDepositProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.OriginChain) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "originChain is required")
+	}
+	if !reCanonicalUint.MatchString(msg.BlockNumber) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "blockNumber must be a base-10 unsigned integer string without leading zeros")
+	}
+	if len(msg.Receipts) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one receipt is required")
+	}
+	if len(msg.Receipts) > MaxReceiptsPerDepositProof {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d receipts per message", MaxReceiptsPerDepositProof)
+	}
+	seen := make(map[string]bool, len(msg.Receipts))
+	for _, receipt := range msg.Receipts {
+		if !reCanonicalUint.MatchString(receipt.ReceiptIndex) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "receiptIndex must be a base-10 unsigned integer string without leading zeros")
+		}
+		if seen[receipt.ReceiptIndex] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate receiptIndex %s", receipt.ReceiptIndex)
+		}
+		seen[receipt.ReceiptIndex] = true
+		if len(receipt.Receipt) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "receipt is required")
+		}
+		if len(receipt.Proof) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proof is required")
+		}
+	}
+	return nil
+}
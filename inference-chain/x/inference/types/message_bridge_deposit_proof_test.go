@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBridgeDepositProof_ValidateBasic(t *testing.T) {
+	receipt := ReceiptProof{ReceiptIndex: "3", Receipt: []byte{0x02, 0xC0}, Proof: [][]byte{{0xC0}}}
+	tests := []struct {
+		name string
+		msg  MsgBridgeDepositProof
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  MsgBridgeDepositProof{Creator: "invalid_address", OriginChain: "ethereum", BlockNumber: "1", Receipts: []ReceiptProof{receipt}},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg:  MsgBridgeDepositProof{Creator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "1", Receipts: []ReceiptProof{receipt}},
+		}, {
+			name: "no receipts",
+			msg:  MsgBridgeDepositProof{Creator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "1"},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate receipt",
+			msg:  MsgBridgeDepositProof{Creator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "1", Receipts: []ReceiptProof{receipt, receipt}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "receipt index with leading zeros",
+			msg: MsgBridgeDepositProof{Creator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "1", Receipts: []ReceiptProof{
+				{ReceiptIndex: "03", Receipt: []byte{0x02, 0xC0}, Proof: [][]byte{{0xC0}}},
+			}},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "block number with leading zeros",
+			msg:  MsgBridgeDepositProof{Creator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "01", Receipts: []ReceiptProof{receipt}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing proof",
+			msg: MsgBridgeDepositProof{Creator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "1", Receipts: []ReceiptProof{
+				{ReceiptIndex: "3", Receipt: []byte{0x02, 0xC0}},
+			}},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAttestBridgeBlock_ValidateBasic(t *testing.T) {
+	root := "0x" + "ab12cd34ab12cd34ab12cd34ab12cd34ab12cd34ab12cd34ab12cd34ab12cd34"
+	require.NoError(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "10", ReceiptsRoot: root}).ValidateBasic())
+	require.ErrorIs(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "10", ReceiptsRoot: "0xroot"}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "x", ReceiptsRoot: root}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "010", ReceiptsRoot: root}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"math/big"
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBridgeWithdraw{}
+
+func NewMsgBridgeWithdraw(creator string, destinationChain string, contractAddress string, recipient string, amount string) *MsgBridgeWithdraw {
+	return &MsgBridgeWithdraw{
+		Creator:          creator,
+		DestinationChain: destinationChain,
+		ContractAddress:  contractAddress,
+		Recipient:        recipient,
+		Amount:           amount,
+	}
+}
+
+var reEthAddress = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// maxUint256 bounds the amount, it is signed as a uint256 for the destination chain contract
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+func (msg *MsgBridgeWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.DestinationChain) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "destinationChain is required")
+	}
+	// both addresses are signed as 20-byte values, so only EVM addresses are supported
+	if !reEthAddress.MatchString(msg.ContractAddress) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contractAddress must be a 0x-prefixed 20-byte hex address")
+	}
+	if !reEthAddress.MatchString(msg.Recipient) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recipient must be a 0x-prefixed 20-byte hex address")
+	}
+	if !reDigits.MatchString(msg.Amount) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be a base-10 unsigned integer string")
+	}
+	bi, ok := new(big.Int).SetString(msg.Amount, 10)
+	if !ok || bi.Sign() <= 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be > 0")
+	}
+	if bi.Cmp(maxUint256) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must fit in uint256")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBridgeWithdraw_ValidateBasic(t *testing.T) {
+	const (
+		contract  = "0x1111111111111111111111111111111111111111"
+		recipient = "0xAbCdEf0123456789aBcDeF0123456789abCDEF01"
+	)
+	tests := []struct {
+		name string
+		msg  MsgBridgeWithdraw
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  MsgBridgeWithdraw{Creator: "invalid_address", DestinationChain: "ethereum", ContractAddress: contract, Recipient: recipient, Amount: "100"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg:  MsgBridgeWithdraw{Creator: sample.AccAddress(), DestinationChain: "ethereum", ContractAddress: contract, Recipient: recipient, Amount: "100"},
+		}, {
+			name: "missing destination chain",
+			msg:  MsgBridgeWithdraw{Creator: sample.AccAddress(), ContractAddress: contract, Recipient: recipient, Amount: "100"},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "recipient not an evm address",
+			msg:  MsgBridgeWithdraw{Creator: sample.AccAddress(), DestinationChain: "ethereum", ContractAddress: contract, Recipient: "0xowner", Amount: "100"},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero amount",
+			msg:  MsgBridgeWithdraw{Creator: sample.AccAddress(), DestinationChain: "ethereum", ContractAddress: contract, Recipient: recipient, Amount: "0"},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "amount above uint256",
+			msg:  MsgBridgeWithdraw{Creator: sample.AccAddress(), DestinationChain: "ethereum", ContractAddress: contract, Recipient: recipient, Amount: "1" + strings.Repeat("0", 78)},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -19,5 +19,11 @@ func (msg *MsgCreateTrainingTask) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.OuterStepPayout > msg.Budget {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "outer step payout %d exceeds budget %d", msg.OuterStepPayout, msg.Budget)
+	}
+	if msg.Budget > 0 && msg.OuterStepPayout == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "outer step payout must be set for a task with a budget")
+	}
 	return nil
 }
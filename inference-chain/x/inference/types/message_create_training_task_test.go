@@ -25,6 +25,28 @@ func TestMsgCreateTrainingTask_ValidateBasic(t *testing.T) {
 			msg: MsgCreateTrainingTask{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid budget",
+			msg: MsgCreateTrainingTask{
+				Creator:         sample.AccAddress(),
+				Budget:          1000,
+				OuterStepPayout: 100,
+			},
+		}, {
+			name: "outer step payout above budget",
+			msg: MsgCreateTrainingTask{
+				Creator:         sample.AccAddress(),
+				Budget:          100,
+				OuterStepPayout: 1000,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "budget without outer step payout",
+			msg: MsgCreateTrainingTask{
+				Creator: sample.AccAddress(),
+				Budget:  100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
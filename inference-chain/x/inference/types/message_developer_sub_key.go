@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/base64"
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateDeveloperSubKey{}
+var _ sdk.Msg = &MsgRevokeDeveloperSubKey{}
+
+// MaxSubKeyAllowedModels bounds the model list stored with each sub-key
+const MaxSubKeyAllowedModels = 32
+
+// reSubKeyName keeps names usable as an HTTP header value and a REST path segment
+var reSubKeyName = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+func NewMsgCreateDeveloperSubKey(creator string, name string, pubKey string) *MsgCreateDeveloperSubKey {
+	return &MsgCreateDeveloperSubKey{
+		Creator: creator,
+		Name:    name,
+		PubKey:  pubKey,
+	}
+}
+
+func (msg *MsgCreateDeveloperSubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !reSubKeyName.MatchString(msg.Name) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "name must be 1-64 letters, digits, '_', '.' or '-'")
+	}
+	// Requests are verified as secp256k1 signatures, like the ones of account keys
+	pubKey, err := base64.StdEncoding.DecodeString(msg.PubKey)
+	if err != nil || len(pubKey) != 33 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pub_key must be a base64 compressed secp256k1 public key")
+	}
+	if len(msg.AllowedModels) > MaxSubKeyAllowedModels {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d allowed models", MaxSubKeyAllowedModels)
+	}
+	for _, model := range msg.AllowedModels {
+		if model == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed model must not be empty")
+		}
+	}
+	if msg.ExpiresAt < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expires_at must not be negative")
+	}
+	return nil
+}
+
+func NewMsgRevokeDeveloperSubKey(creator string, name string) *MsgRevokeDeveloperSubKey {
+	return &MsgRevokeDeveloperSubKey{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+func (msg *MsgRevokeDeveloperSubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !reSubKeyName.MatchString(msg.Name) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid name")
+	}
+	return nil
+}
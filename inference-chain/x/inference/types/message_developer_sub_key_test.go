@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateDeveloperSubKey_ValidateBasic(t *testing.T) {
+	pubKey := base64.StdEncoding.EncodeToString(append([]byte{0x02}, make([]byte, 32)...))
+	tests := []struct {
+		name string
+		msg  MsgCreateDeveloperSubKey
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  MsgCreateDeveloperSubKey{Creator: "invalid_address", Name: "service-a", PubKey: pubKey},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg:  MsgCreateDeveloperSubKey{Creator: sample.AccAddress(), Name: "service-a", PubKey: pubKey, MaxCoinsPerEpoch: 1000, AllowedModels: []string{"Qwen/Qwen2.5-7B-Instruct"}, MaxTokens: 512},
+		}, {
+			name: "name with a space",
+			msg:  MsgCreateDeveloperSubKey{Creator: sample.AccAddress(), Name: "service a", PubKey: pubKey},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "name too long",
+			msg:  MsgCreateDeveloperSubKey{Creator: sample.AccAddress(), Name: strings.Repeat("a", 65), PubKey: pubKey},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "pub key of the wrong size",
+			msg:  MsgCreateDeveloperSubKey{Creator: sample.AccAddress(), Name: "service-a", PubKey: base64.StdEncoding.EncodeToString(make([]byte, 32))},
+			err:  sdkerrors.ErrInvalidPubKey,
+		}, {
+			name: "empty allowed model",
+			msg:  MsgCreateDeveloperSubKey{Creator: sample.AccAddress(), Name: "service-a", PubKey: pubKey, AllowedModels: []string{""}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative expiry",
+			msg:  MsgCreateDeveloperSubKey{Creator: sample.AccAddress(), Name: "service-a", PubKey: pubKey, ExpiresAt: -1},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRevokeDeveloperSubKey_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, (&MsgRevokeDeveloperSubKey{Creator: "invalid_address", Name: "service-a"}).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, (&MsgRevokeDeveloperSubKey{Creator: sample.AccAddress()}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, (&MsgRevokeDeveloperSubKey{Creator: sample.AccAddress(), Name: "service-a"}).ValidateBasic())
+}
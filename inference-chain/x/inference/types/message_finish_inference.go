@@ -42,14 +42,27 @@ func (msg *MsgFinishInference) ValidateBasic() error {
 	if strings.TrimSpace(msg.ResponseHash) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "response_hash is required")
 	}
-	if strings.TrimSpace(msg.ResponsePayload) == "" {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "response_payload is required")
-	}
 	if strings.TrimSpace(msg.Model) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "model is required")
 	}
-	if strings.TrimSpace(msg.OriginalPrompt) == "" {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "original_prompt is required")
+	if msg.PayloadsOffChain() {
+		// Only hashes go on chain, validators fetch the payloads from the locator
+		if msg.ResponsePayload != "" || msg.OriginalPrompt != "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "response_payload and original_prompt must be empty when original_prompt_hash is set")
+		}
+		if strings.TrimSpace(msg.ResponsePayloadHash) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "response_payload_hash is required")
+		}
+		if strings.TrimSpace(msg.PayloadLocator) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payload_locator is required")
+		}
+	} else {
+		if strings.TrimSpace(msg.ResponsePayload) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "response_payload is required")
+		}
+		if strings.TrimSpace(msg.OriginalPrompt) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "original_prompt is required")
+		}
 	}
 	// request_timestamp must be > 0
 	if msg.RequestTimestamp <= 0 {
@@ -66,5 +79,24 @@ func (msg *MsgFinishInference) ValidateBasic() error {
 	if err := utils.ValidateBase64RSig64("inference_id", strings.TrimSpace(msg.InferenceId)); err != nil {
 		return err
 	}
+	if _, ok := InferenceKind_name[int32(msg.Kind)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown inference kind %d", msg.Kind)
+	}
+	if msg.SubKey != "" && !reSubKeyName.MatchString(msg.SubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid sub_key")
+	}
 	return nil
 }
+
+// PayloadsOffChain reports whether the prompt and response are only committed to by their hashes
+func (msg *MsgFinishInference) PayloadsOffChain() bool {
+	return strings.TrimSpace(msg.OriginalPromptHash) != ""
+}
+
+// SignedPayload is what the developer, transfer agent and executor signatures cover
+func (msg *MsgFinishInference) SignedPayload() string {
+	if msg.PayloadsOffChain() {
+		return msg.OriginalPromptHash
+	}
+	return msg.OriginalPrompt
+}
@@ -52,6 +52,40 @@ func TestMsgFinishInference_ValidateBasic(t *testing.T) {
 				PromptTokenCount:     0,
 				CompletionTokenCount: 0,
 			},
+		}, {
+			name: "payloads off-chain",
+			msg: MsgFinishInference{
+				Creator:             sample.AccAddress(),
+				ExecutedBy:          sample.AccAddress(),
+				TransferredBy:       sample.AccAddress(),
+				RequestedBy:         sample.AccAddress(),
+				InferenceId:         base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				ResponseHash:        "rh",
+				OriginalPromptHash:  "oph",
+				ResponsePayloadHash: "rph",
+				PayloadLocator:      "http://executor:9000/v1/payloads",
+				Model:               "m",
+				RequestTimestamp:    1,
+				TransferSignature:   base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				ExecutorSignature:   base64.StdEncoding.EncodeToString(make([]byte, 64)),
+			},
+		}, {
+			name: "payloads off-chain without locator",
+			msg: MsgFinishInference{
+				Creator:             sample.AccAddress(),
+				ExecutedBy:          sample.AccAddress(),
+				TransferredBy:       sample.AccAddress(),
+				RequestedBy:         sample.AccAddress(),
+				InferenceId:         base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				ResponseHash:        "rh",
+				OriginalPromptHash:  "oph",
+				ResponsePayloadHash: "rph",
+				Model:               "m",
+				RequestTimestamp:    1,
+				TransferSignature:   base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				ExecutorSignature:   base64.StdEncoding.EncodeToString(make([]byte, 64)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
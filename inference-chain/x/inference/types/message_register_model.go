@@ -26,5 +26,8 @@ func (msg *MsgRegisterModel) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposedBy address (%s)", err)
 	}
+	if _, ok := ValidationStrategy_name[int32(msg.ValidationStrategy)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown validation strategy %d", msg.ValidationStrategy)
+	}
 	return nil
 }
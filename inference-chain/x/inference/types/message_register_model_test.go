@@ -31,6 +31,25 @@ func TestMsgRegisterModel_ValidateBasic(t *testing.T) {
 				Id:                  "model-1",
 				ValidationThreshold: &Decimal{Value: 85, Exponent: -2},
 			},
+		}, {
+			name: "valid validation strategy",
+			msg: MsgRegisterModel{
+				Authority:           sample.AccAddress(),
+				ProposedBy:          sample.AccAddress(),
+				Id:                  "model-1",
+				ValidationThreshold: &Decimal{Value: 85, Exponent: -2},
+				ValidationStrategy:  ValidationStrategy_JS_DIVERGENCE,
+			},
+		}, {
+			name: "unknown validation strategy",
+			msg: MsgRegisterModel{
+				Authority:           sample.AccAddress(),
+				ProposedBy:          sample.AccAddress(),
+				Id:                  "model-1",
+				ValidationThreshold: &Decimal{Value: 85, Exponent: -2},
+				ValidationStrategy:  ValidationStrategy(42),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -43,11 +43,18 @@ func (msg *MsgStartInference) ValidateBasic() error {
 	if strings.TrimSpace(msg.PromptHash) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "prompt_hash is required")
 	}
-	if strings.TrimSpace(msg.PromptPayload) == "" {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "prompt_payload is required")
-	}
-	if strings.TrimSpace(msg.OriginalPrompt) == "" {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "original_prompt is required")
+	if msg.PayloadsOffChain() {
+		// Only hashes go on chain, the payloads stay with the transfer agent and executor
+		if msg.PromptPayload != "" || msg.OriginalPrompt != "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "prompt_payload and original_prompt must be empty when original_prompt_hash is set")
+		}
+	} else {
+		if strings.TrimSpace(msg.PromptPayload) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "prompt_payload is required")
+		}
+		if strings.TrimSpace(msg.OriginalPrompt) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "original_prompt is required")
+		}
 	}
 	// request_timestamp must be > 0
 	if msg.RequestTimestamp <= 0 {
@@ -57,5 +64,24 @@ func (msg *MsgStartInference) ValidateBasic() error {
 	if err := utils.ValidateBase64RSig64("transfer_signature", strings.TrimSpace(msg.TransferSignature)); err != nil {
 		return err
 	}
+	if _, ok := InferenceKind_name[int32(msg.Kind)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown inference kind %d", msg.Kind)
+	}
+	if msg.SubKey != "" && !reSubKeyName.MatchString(msg.SubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid sub_key")
+	}
 	return nil
 }
+
+// PayloadsOffChain reports whether the prompt is only committed to by its hash
+func (msg *MsgStartInference) PayloadsOffChain() bool {
+	return strings.TrimSpace(msg.OriginalPromptHash) != ""
+}
+
+// SignedPayload is what the developer, transfer agent and executor signatures cover
+func (msg *MsgStartInference) SignedPayload() string {
+	if msg.PayloadsOffChain() {
+		return msg.OriginalPromptHash
+	}
+	return msg.OriginalPrompt
+}
@@ -46,6 +46,36 @@ func TestMsgStartInference_ValidateBasic(t *testing.T) {
 				RequestTimestamp:  1,
 				TransferSignature: base64.StdEncoding.EncodeToString(make([]byte, 64)),
 			},
+		}, {
+			name: "payloads off-chain",
+			msg: MsgStartInference{
+				Creator:            sample.AccAddress(),
+				RequestedBy:        sample.AccAddress(),
+				AssignedTo:         sample.AccAddress(),
+				InferenceId:        base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				PromptHash:         "hash",
+				OriginalPromptHash: "orig_hash",
+				Model:              "model-x",
+				NodeVersion:        "v1",
+				RequestTimestamp:   1,
+				TransferSignature:  base64.StdEncoding.EncodeToString(make([]byte, 64)),
+			},
+		}, {
+			name: "payload together with original prompt hash",
+			msg: MsgStartInference{
+				Creator:            sample.AccAddress(),
+				RequestedBy:        sample.AccAddress(),
+				AssignedTo:         sample.AccAddress(),
+				InferenceId:        base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				PromptHash:         "hash",
+				PromptPayload:      "payload",
+				OriginalPromptHash: "orig_hash",
+				Model:              "model-x",
+				NodeVersion:        "v1",
+				RequestTimestamp:   1,
+				TransferSignature:  base64.StdEncoding.EncodeToString(make([]byte, 64)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -44,5 +44,27 @@ func (msg *MsgSubmitNewParticipant) ValidateBasic() error {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid worker key: %s", err)
 		}
 	}
+	// region optional; if provided, must be a short lowercase identifier
+	if err := validateRegion(msg.Region); err != nil {
+		return err
+	}
+	return nil
+}
+
+const maxRegionLength = 32
+
+// validateRegion checks the optional self-declared region: short, lowercase letters, digits and dashes
+func validateRegion(region string) error {
+	if region == "" {
+		return nil
+	}
+	if len(region) > maxRegionLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "region must be at most %d characters", maxRegionLength)
+	}
+	for _, r := range region {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid region %q: only lowercase letters, digits and '-' are allowed", region)
+		}
+	}
 	return nil
 }
@@ -33,6 +33,25 @@ func TestMsgSubmitNewParticipant_ValidateBasic(t *testing.T) {
 				ValidatorKey: sample.ValidED25519ValidatorKey(),
 				WorkerKey:    sample.ValidED25519ValidatorKey(),
 			},
+		}, {
+			name: "valid participant with region",
+			msg: MsgSubmitNewParticipant{
+				Creator:      validCreator,
+				Url:          "https://example.com",
+				ValidatorKey: sample.ValidED25519ValidatorKey(),
+				WorkerKey:    sample.ValidED25519ValidatorKey(),
+				Region:       "asia-east",
+			},
+		}, {
+			name: "invalid region",
+			msg: MsgSubmitNewParticipant{
+				Creator:      validCreator,
+				Url:          "https://example.com",
+				ValidatorKey: sample.ValidED25519ValidatorKey(),
+				WorkerKey:    sample.ValidED25519ValidatorKey(),
+				Region:       "Asia East",
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 
@@ -61,5 +61,9 @@ func (msg *MsgSubmitNewUnfundedParticipant) ValidateBasic() error {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid worker key: %s", err)
 		}
 	}
+	// region optional; if provided, must be a short lowercase identifier
+	if err := validateRegion(msg.Region); err != nil {
+		return err
+	}
 	return nil
 }
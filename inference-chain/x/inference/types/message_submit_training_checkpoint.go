@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitTrainingCheckpoint{}
+
+// Every signature stores its own hash and uri on chain, so both are bounded
+const (
+	MaxCheckpointContentHashLength = 128
+	MaxCheckpointStorageUriLength  = 512
+)
+
+func NewMsgSubmitTrainingCheckpoint(creator string, taskId uint64, outerStep int32, contentHash string, storageUri string, nodeIds []string) *MsgSubmitTrainingCheckpoint {
+	return &MsgSubmitTrainingCheckpoint{
+		Creator:     creator,
+		TaskId:      taskId,
+		OuterStep:   outerStep,
+		ContentHash: contentHash,
+		StorageUri:  storageUri,
+		NodeIds:     nodeIds,
+	}
+}
+
+func (msg *MsgSubmitTrainingCheckpoint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.OuterStep < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid outer step %d", msg.OuterStep)
+	}
+	if msg.ContentHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "content hash is required")
+	}
+	if len(msg.ContentHash) > MaxCheckpointContentHashLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "content hash longer than %d", MaxCheckpointContentHashLength)
+	}
+	if msg.StorageUri == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "storage uri is required")
+	}
+	if len(msg.StorageUri) > MaxCheckpointStorageUriLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "storage uri longer than %d", MaxCheckpointStorageUriLength)
+	}
+	if len(msg.NodeIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one producing node is required")
+	}
+	seen := make(map[string]bool, len(msg.NodeIds))
+	for _, nodeId := range msg.NodeIds {
+		if seen[nodeId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate node id %s", nodeId)
+		}
+		seen[nodeId] = true
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitTrainingCheckpoint_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitTrainingCheckpoint
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "negative outer step",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				OuterStep:   -1,
+				ContentHash: "hash",
+				StorageUri:  "s3://bucket/checkpoint",
+				NodeIds:     []string{"node1"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing content hash",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:    sample.AccAddress(),
+				TaskId:     1,
+				StorageUri: "s3://bucket/checkpoint",
+				NodeIds:    []string{"node1"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing storage uri",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				ContentHash: "hash",
+				NodeIds:     []string{"node1"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no producing nodes",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				ContentHash: "hash",
+				StorageUri:  "s3://bucket/checkpoint",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "content hash too long",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				ContentHash: strings.Repeat("a", MaxCheckpointContentHashLength+1),
+				StorageUri:  "s3://bucket/checkpoint",
+				NodeIds:     []string{"node1"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "storage uri too long",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				ContentHash: "hash",
+				StorageUri:  "s3://" + strings.Repeat("a", MaxCheckpointStorageUriLength),
+				NodeIds:     []string{"node1"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate node ids",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				ContentHash: "hash",
+				StorageUri:  "s3://bucket/checkpoint",
+				NodeIds:     []string{"node1", "node1"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid checkpoint",
+			msg: MsgSubmitTrainingCheckpoint{
+				Creator:     sample.AccAddress(),
+				TaskId:      1,
+				OuterStep:   2,
+				ContentHash: "hash",
+				StorageUri:  "s3://bucket/checkpoint",
+				NodeIds:     []string{"node1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
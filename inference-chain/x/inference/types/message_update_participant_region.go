@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateParticipantRegion{}
+
+func NewMsgUpdateParticipantRegion(creator string, region string) *MsgUpdateParticipantRegion {
+	return &MsgUpdateParticipantRegion{
+		Creator: creator,
+		Region:  region,
+	}
+}
+
+func (msg *MsgUpdateParticipantRegion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return validateRegion(msg.Region)
+}
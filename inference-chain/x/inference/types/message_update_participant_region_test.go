@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParticipantRegion_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateParticipantRegion
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateParticipantRegion{
+				Creator: "invalid_address",
+				Region:  "eu-west",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid region",
+			msg: MsgUpdateParticipantRegion{
+				Creator: sample.AccAddress(),
+				Region:  "EU West",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid region",
+			msg: MsgUpdateParticipantRegion{
+				Creator: sample.AccAddress(),
+				Region:  "eu-west",
+			},
+		}, {
+			name: "cleared region",
+			msg: MsgUpdateParticipantRegion{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,6 +10,13 @@ import (
 
 var _ sdk.Msg = &MsgValidation{}
 
+// Diagnostics are stored on chain with every validation, so their free-form fields are bounded
+const (
+	MaxDiagnosticsReasonLength        = 256
+	MaxDiagnosticsReportLocatorLength = 512
+	MaxDiagnosticsReportHashLength    = 128
+)
+
 func NewMsgValidation(creator string, id string, inferenceId string, responsePayload string, responseHash string, value float64) *MsgValidation {
 	return &MsgValidation{
 		Creator:         creator,
@@ -44,5 +51,25 @@ func (msg *MsgValidation) ValidateBasic() error {
 	if msg.Value < 0 || msg.Value > 1 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "value must be in [0,1]")
 	}
+	if msg.Diagnostics != nil {
+		if len(msg.Diagnostics.Reason) > MaxDiagnosticsReasonLength {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "diagnostics.reason longer than %d", MaxDiagnosticsReasonLength)
+		}
+		if len(msg.Diagnostics.ReportLocator) > MaxDiagnosticsReportLocatorLength {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "diagnostics.report_locator longer than %d", MaxDiagnosticsReportLocatorLength)
+		}
+		if len(msg.Diagnostics.ReportHash) > MaxDiagnosticsReportHashLength {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "diagnostics.report_hash longer than %d", MaxDiagnosticsReportHashLength)
+		}
+	}
+	// the report is kept off-chain, both its hash and where to fetch it are needed
+	if msg.Diagnostics != nil && (msg.Diagnostics.ReportHash != "" || msg.Diagnostics.ReportLocator != "") {
+		if strings.TrimSpace(msg.Diagnostics.ReportHash) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "diagnostics.report_hash is required with report_locator")
+		}
+		if strings.TrimSpace(msg.Diagnostics.ReportLocator) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "diagnostics.report_locator is required with report_hash")
+		}
+	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -35,6 +36,60 @@ func TestMsgValidation_ValidateBasic(t *testing.T) {
 				ResponseHash:    "hash",
 				Value:           0.5,
 			},
+		}, {
+			name: "report with locator",
+			msg: MsgValidation{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       0.5,
+				Diagnostics: &ValidationDiagnostics{
+					Strategy:      ValidationStrategy_LOGPROB_SIMILARITY,
+					ReportHash:    "rh",
+					ReportLocator: "http://validator:9000/v1/payloads",
+				},
+			},
+		}, {
+			name: "report without locator",
+			msg: MsgValidation{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       0.5,
+				Diagnostics: &ValidationDiagnostics{ReportHash: "rh"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "reason too long",
+			msg: MsgValidation{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       0.5,
+				Diagnostics: &ValidationDiagnostics{Reason: strings.Repeat("r", MaxDiagnosticsReasonLength+1)},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "report locator too long",
+			msg: MsgValidation{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       0.5,
+				Diagnostics: &ValidationDiagnostics{
+					ReportHash:    "rh",
+					ReportLocator: "http://validator:9000/" + strings.Repeat("p", MaxDiagnosticsReportLocatorLength),
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "report hash too long",
+			msg: MsgValidation{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       0.5,
+				Diagnostics: &ValidationDiagnostics{
+					ReportHash:    strings.Repeat("h", MaxDiagnosticsReportHashLength+1),
+					ReportLocator: "http://validator:9000/v1/payloads",
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -22,20 +22,57 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ValidationStrategy decides how a validator compares its rerun of an inference with the original.
+// Every strategy yields a similarity in [0, 1] that is checked against the model's validation_threshold.
+type ValidationStrategy int32
+
+const (
+	ValidationStrategy_DEFAULT_VALIDATION ValidationStrategy = 0
+	ValidationStrategy_LOGPROB_SIMILARITY ValidationStrategy = 1
+	ValidationStrategy_EXACT_TOKEN_MATCH  ValidationStrategy = 2
+	ValidationStrategy_JS_DIVERGENCE      ValidationStrategy = 3
+	ValidationStrategy_EMBEDDING_COSINE   ValidationStrategy = 4
+)
+
+var ValidationStrategy_name = map[int32]string{
+	0: "DEFAULT_VALIDATION",
+	1: "LOGPROB_SIMILARITY",
+	2: "EXACT_TOKEN_MATCH",
+	3: "JS_DIVERGENCE",
+	4: "EMBEDDING_COSINE",
+}
+
+var ValidationStrategy_value = map[string]int32{
+	"DEFAULT_VALIDATION": 0,
+	"LOGPROB_SIMILARITY": 1,
+	"EXACT_TOKEN_MATCH":  2,
+	"JS_DIVERGENCE":      3,
+	"EMBEDDING_COSINE":   4,
+}
+
+func (x ValidationStrategy) String() string {
+	return proto.EnumName(ValidationStrategy_name, int32(x))
+}
+
+func (ValidationStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d7b516097210bdb1, []int{0}
+}
+
 type Model struct {
-	ProposedBy             string   `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
-	Id                     string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	UnitsOfComputePerToken uint64   `protobuf:"varint,3,opt,name=units_of_compute_per_token,json=unitsOfComputePerToken,proto3" json:"units_of_compute_per_token,omitempty"`
-	ContextWindow          uint64   `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
-	Quantization           string   `protobuf:"bytes,5,opt,name=quantization,proto3" json:"quantization,omitempty"`
-	CoinsPerInputToken     uint64   `protobuf:"varint,6,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
-	CoinsPerOutputToken    uint64   `protobuf:"varint,7,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
-	HfRepo                 string   `protobuf:"bytes,8,opt,name=hf_repo,json=hfRepo,proto3" json:"hf_repo,omitempty"`
-	HfCommit               string   `protobuf:"bytes,9,opt,name=hf_commit,json=hfCommit,proto3" json:"hf_commit,omitempty"`
-	ModelArgs              []string `protobuf:"bytes,10,rep,name=model_args,json=modelArgs,proto3" json:"model_args,omitempty"`
-	VRam                   uint64   `protobuf:"varint,11,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
-	ThroughputPerNonce     uint64   `protobuf:"varint,12,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
-	ValidationThreshold    *Decimal `protobuf:"bytes,13,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	ProposedBy             string             `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	Id                     string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UnitsOfComputePerToken uint64             `protobuf:"varint,3,opt,name=units_of_compute_per_token,json=unitsOfComputePerToken,proto3" json:"units_of_compute_per_token,omitempty"`
+	ContextWindow          uint64             `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
+	Quantization           string             `protobuf:"bytes,5,opt,name=quantization,proto3" json:"quantization,omitempty"`
+	CoinsPerInputToken     uint64             `protobuf:"varint,6,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
+	CoinsPerOutputToken    uint64             `protobuf:"varint,7,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
+	HfRepo                 string             `protobuf:"bytes,8,opt,name=hf_repo,json=hfRepo,proto3" json:"hf_repo,omitempty"`
+	HfCommit               string             `protobuf:"bytes,9,opt,name=hf_commit,json=hfCommit,proto3" json:"hf_commit,omitempty"`
+	ModelArgs              []string           `protobuf:"bytes,10,rep,name=model_args,json=modelArgs,proto3" json:"model_args,omitempty"`
+	VRam                   uint64             `protobuf:"varint,11,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
+	ThroughputPerNonce     uint64             `protobuf:"varint,12,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
+	ValidationThreshold    *Decimal           `protobuf:"bytes,13,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	ValidationStrategy     ValidationStrategy `protobuf:"varint,14,opt,name=validation_strategy,json=validationStrategy,proto3,enum=inference.inference.ValidationStrategy" json:"validation_strategy,omitempty"`
 }
 
 func (m *Model) Reset()         { *m = Model{} }
@@ -162,44 +199,60 @@ func (m *Model) GetValidationThreshold() *Decimal {
 	return nil
 }
 
+func (m *Model) GetValidationStrategy() ValidationStrategy {
+	if m != nil {
+		return m.ValidationStrategy
+	}
+	return ValidationStrategy_DEFAULT_VALIDATION
+}
+
 func init() {
+	proto.RegisterEnum("inference.inference.ValidationStrategy", ValidationStrategy_name, ValidationStrategy_value)
 	proto.RegisterType((*Model)(nil), "inference.inference.Model")
 }
 
 func init() { proto.RegisterFile("inference/inference/model.proto", fileDescriptor_d7b516097210bdb1) }
 
 var fileDescriptor_d7b516097210bdb1 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc7, 0x9b, 0xae, 0xed, 0x16, 0x77, 0xdb, 0xc1, 0xdd, 0x6f, 0x3f, 0x6b, 0x40, 0x16, 0x4d,
-	0x42, 0xea, 0x29, 0x03, 0x26, 0x2e, 0xdc, 0x18, 0x5c, 0x38, 0x40, 0xab, 0x68, 0x12, 0x12, 0x17,
-	0x2b, 0x4d, 0x9c, 0xc6, 0xa2, 0xf1, 0x33, 0xb6, 0xd3, 0xad, 0xfc, 0x15, 0x1c, 0xf8, 0xa3, 0x38,
-	0xee, 0xc8, 0x11, 0xb5, 0xff, 0x08, 0xca, 0xcb, 0xba, 0x00, 0xda, 0xed, 0xe9, 0xfb, 0xf5, 0xc7,
-	0xef, 0xeb, 0xe7, 0x47, 0x4e, 0xa5, 0xca, 0x85, 0x11, 0x2a, 0x15, 0xe7, 0x6d, 0x55, 0x42, 0x26,
-	0x16, 0x91, 0x36, 0xe0, 0x80, 0x8e, 0xee, 0xe5, 0xe8, 0xbe, 0x3a, 0x09, 0x1f, 0xa2, 0x74, 0x62,
-	0x92, 0xd2, 0x36, 0xd8, 0xd9, 0xf7, 0x1e, 0xe9, 0xbf, 0xaf, 0xaf, 0xa1, 0xa7, 0x64, 0xa8, 0x0d,
-	0x68, 0xb0, 0x22, 0xe3, 0xb3, 0x15, 0xf3, 0x42, 0x6f, 0xec, 0xc7, 0x64, 0x2b, 0x5d, 0xae, 0xe8,
-	0x21, 0xe9, 0xca, 0x8c, 0x75, 0x51, 0xef, 0xca, 0x8c, 0xbe, 0x22, 0x27, 0x95, 0x92, 0xce, 0x72,
-	0xc8, 0x79, 0x0a, 0xa5, 0xae, 0x9c, 0xe0, 0x5a, 0x18, 0xee, 0xe0, 0xb3, 0x50, 0x6c, 0x27, 0xf4,
-	0xc6, 0xbd, 0xf8, 0x18, 0x4f, 0x4c, 0xf2, 0x37, 0x8d, 0x3f, 0x15, 0xe6, 0xaa, 0x76, 0xe9, 0x53,
-	0x72, 0x98, 0x82, 0x72, 0xe2, 0xc6, 0xf1, 0x6b, 0xa9, 0x32, 0xb8, 0x66, 0x3d, 0x3c, 0x7f, 0x70,
-	0xa7, 0x7e, 0x44, 0x91, 0x9e, 0x91, 0xfd, 0x2f, 0x55, 0xa2, 0x9c, 0xfc, 0x9a, 0x38, 0x09, 0x8a,
-	0xf5, 0xb1, 0xf9, 0x5f, 0x1a, 0x7d, 0x4e, 0xfe, 0x4b, 0x41, 0x2a, 0x8b, 0xbd, 0xa5, 0xd2, 0x95,
-	0xbb, 0x4b, 0x30, 0xc0, 0x1b, 0x29, 0x9a, 0x53, 0x61, 0xde, 0xd5, 0x56, 0xd3, 0xfd, 0x82, 0x1c,
-	0xb7, 0x08, 0x54, 0xae, 0x65, 0x76, 0x91, 0x19, 0x6d, 0x99, 0x09, 0x7a, 0x0d, 0xf4, 0x3f, 0xd9,
-	0x2d, 0x72, 0x6e, 0x84, 0x06, 0xb6, 0x87, 0x31, 0x06, 0x45, 0x1e, 0x0b, 0x0d, 0xf4, 0x11, 0xf1,
-	0x0b, 0x9c, 0x40, 0x29, 0x1d, 0xf3, 0xd1, 0xda, 0x2b, 0xea, 0x17, 0x97, 0xd2, 0xd1, 0x27, 0x84,
-	0xe0, 0x2f, 0xf1, 0xc4, 0xcc, 0x2d, 0x23, 0xe1, 0xce, 0xd8, 0x8f, 0x7d, 0x54, 0x5e, 0x9b, 0xb9,
-	0xa5, 0x23, 0xd2, 0x5f, 0x72, 0x93, 0x94, 0x6c, 0x88, 0x8d, 0x7b, 0xcb, 0x38, 0x29, 0xe9, 0x33,
-	0x72, 0xe4, 0x0a, 0x03, 0xd5, 0xbc, 0xa8, 0x83, 0xd5, 0x19, 0x15, 0xa8, 0x54, 0xb0, 0xfd, 0xe6,
-	0x41, 0xad, 0x37, 0x15, 0xe6, 0x43, 0xed, 0xd0, 0x09, 0x39, 0x5a, 0x26, 0x0b, 0x99, 0xe1, 0x44,
-	0xb8, 0x2b, 0x8c, 0xb0, 0x05, 0x2c, 0x32, 0x76, 0x10, 0x7a, 0xe3, 0xe1, 0x8b, 0xc7, 0xd1, 0x03,
-	0xbb, 0x11, 0xbd, 0x15, 0xa9, 0x2c, 0x93, 0x45, 0x3c, 0x6a, 0xc9, 0xab, 0x2d, 0x78, 0x39, 0xf9,
-	0xb1, 0x0e, 0xbc, 0xdb, 0x75, 0xe0, 0xfd, 0x5a, 0x07, 0xde, 0xb7, 0x4d, 0xd0, 0xb9, 0xdd, 0x04,
-	0x9d, 0x9f, 0x9b, 0xa0, 0xf3, 0xe9, 0xe5, 0x5c, 0xba, 0xa2, 0x9a, 0x45, 0x29, 0x94, 0xe7, 0xda,
-	0x40, 0x56, 0xa5, 0xce, 0xa6, 0xf2, 0x9f, 0x15, 0xbb, 0xf9, 0xa3, 0x76, 0x2b, 0x2d, 0xec, 0x6c,
-	0x80, 0xeb, 0x76, 0xf1, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x33, 0x3f, 0xc3, 0xc8, 0x02, 0x00,
-	0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x4e, 0xdb, 0x4c,
+	0x14, 0xc5, 0xe3, 0x10, 0xfe, 0xe4, 0x02, 0x51, 0x98, 0x00, 0x9f, 0xc5, 0xd7, 0x86, 0x08, 0xa9,
+	0x6a, 0xd4, 0x45, 0x68, 0x41, 0xdd, 0x74, 0xe7, 0x24, 0x2e, 0x75, 0x9b, 0xc4, 0x91, 0xe3, 0x52,
+	0xda, 0xcd, 0xc8, 0xd8, 0x93, 0x78, 0xd4, 0x78, 0xc6, 0x1d, 0x8f, 0x03, 0xe9, 0xba, 0x0f, 0xd0,
+	0xc7, 0xea, 0x92, 0x65, 0x97, 0x15, 0xec, 0xfb, 0x0c, 0x95, 0x27, 0x40, 0x68, 0x61, 0x77, 0xf5,
+	0x3b, 0xe7, 0xdc, 0x7b, 0x67, 0xec, 0x81, 0x5d, 0xca, 0x86, 0x44, 0x10, 0xe6, 0x93, 0xfd, 0x79,
+	0x15, 0xf1, 0x80, 0x8c, 0x1b, 0xb1, 0xe0, 0x92, 0xa3, 0xca, 0x2d, 0x6e, 0xdc, 0x56, 0x3b, 0xb5,
+	0x87, 0x52, 0xb1, 0x27, 0xbc, 0x28, 0x99, 0xc5, 0xf6, 0x7e, 0x17, 0x60, 0xb1, 0x9b, 0xb5, 0x41,
+	0xbb, 0xb0, 0x1a, 0x0b, 0x1e, 0xf3, 0x84, 0x04, 0xf8, 0x74, 0xaa, 0x6b, 0x35, 0xad, 0x5e, 0x74,
+	0xe0, 0x06, 0x35, 0xa7, 0xa8, 0x04, 0x79, 0x1a, 0xe8, 0x79, 0xc5, 0xf3, 0x34, 0x40, 0xaf, 0x60,
+	0x27, 0x65, 0x54, 0x26, 0x98, 0x0f, 0xb1, 0xcf, 0xa3, 0x38, 0x95, 0x04, 0xc7, 0x44, 0x60, 0xc9,
+	0x3f, 0x13, 0xa6, 0x2f, 0xd4, 0xb4, 0x7a, 0xc1, 0xd9, 0x56, 0x0e, 0x7b, 0xd8, 0x9a, 0xe9, 0x7d,
+	0x22, 0xdc, 0x4c, 0x45, 0x4f, 0xa0, 0xe4, 0x73, 0x26, 0xc9, 0xb9, 0xc4, 0x67, 0x94, 0x05, 0xfc,
+	0x4c, 0x2f, 0x28, 0xff, 0xfa, 0x35, 0xfd, 0xa0, 0x20, 0xda, 0x83, 0xb5, 0x2f, 0xa9, 0xc7, 0x24,
+	0xfd, 0xea, 0x49, 0xca, 0x99, 0xbe, 0xa8, 0x86, 0xff, 0xc5, 0xd0, 0x0b, 0xd8, 0xf2, 0x39, 0x65,
+	0x89, 0x9a, 0x4d, 0x59, 0x9c, 0xca, 0xeb, 0x0d, 0x96, 0x54, 0x47, 0xa4, 0xc4, 0x3e, 0x11, 0x56,
+	0x26, 0xcd, 0xa6, 0x1f, 0xc2, 0xf6, 0x3c, 0xc2, 0x53, 0x39, 0xcf, 0x2c, 0xab, 0x4c, 0xe5, 0x26,
+	0x63, 0x2b, 0x6d, 0x16, 0xfa, 0x0f, 0x96, 0xc3, 0x21, 0x16, 0x24, 0xe6, 0xfa, 0x8a, 0x5a, 0x63,
+	0x29, 0x1c, 0x3a, 0x24, 0xe6, 0xe8, 0x7f, 0x28, 0x86, 0xea, 0x06, 0x22, 0x2a, 0xf5, 0xa2, 0x92,
+	0x56, 0xc2, 0xec, 0xc4, 0x11, 0x95, 0xe8, 0x31, 0x80, 0xfa, 0x4a, 0xd8, 0x13, 0xa3, 0x44, 0x87,
+	0xda, 0x42, 0xbd, 0xe8, 0x14, 0x15, 0x31, 0xc4, 0x28, 0x41, 0x15, 0x58, 0x9c, 0x60, 0xe1, 0x45,
+	0xfa, 0xaa, 0x1a, 0x5c, 0x98, 0x38, 0x5e, 0x84, 0x9e, 0xc3, 0xa6, 0x0c, 0x05, 0x4f, 0x47, 0x61,
+	0xb6, 0x58, 0xb6, 0x23, 0xe3, 0xcc, 0x27, 0xfa, 0xda, 0xec, 0x40, 0x73, 0xad, 0x4f, 0x44, 0x2f,
+	0x53, 0x90, 0x0d, 0x9b, 0x13, 0x6f, 0x4c, 0x03, 0x75, 0x23, 0x58, 0x86, 0x82, 0x24, 0x21, 0x1f,
+	0x07, 0xfa, 0x7a, 0x4d, 0xab, 0xaf, 0x1e, 0x3c, 0x6a, 0x3c, 0xf0, 0x6f, 0x34, 0xda, 0xc4, 0xa7,
+	0x91, 0x37, 0x76, 0x2a, 0xf3, 0xa4, 0x7b, 0x13, 0x44, 0x27, 0x70, 0x07, 0xe3, 0x44, 0x0a, 0x4f,
+	0x92, 0xd1, 0x54, 0x2f, 0xd5, 0xb4, 0x7a, 0xe9, 0xe0, 0xe9, 0x83, 0xfd, 0x8e, 0x6f, 0xfd, 0x83,
+	0x6b, 0xbb, 0x83, 0x26, 0xf7, 0xd8, 0xb3, 0x6f, 0x1a, 0xa0, 0xfb, 0x56, 0xb4, 0x0d, 0xa8, 0x6d,
+	0xbe, 0x36, 0xde, 0x77, 0x5c, 0x7c, 0x6c, 0x74, 0xac, 0xb6, 0xe1, 0x5a, 0x76, 0xaf, 0x9c, 0xcb,
+	0x78, 0xc7, 0x3e, 0xea, 0x3b, 0x76, 0x13, 0x0f, 0xac, 0xae, 0xd5, 0x31, 0x1c, 0xcb, 0xfd, 0x58,
+	0xd6, 0xd0, 0x16, 0x6c, 0x98, 0x27, 0x46, 0xcb, 0xc5, 0xae, 0xfd, 0xce, 0xec, 0xe1, 0xae, 0xe1,
+	0xb6, 0xde, 0x94, 0xf3, 0x68, 0x03, 0xd6, 0xdf, 0x0e, 0x70, 0xdb, 0x3a, 0x36, 0x9d, 0x23, 0xb3,
+	0xd7, 0x32, 0xcb, 0x0b, 0x68, 0x13, 0xca, 0x66, 0xb7, 0x69, 0xb6, 0xdb, 0x56, 0xef, 0x08, 0xb7,
+	0xec, 0x81, 0xd5, 0x33, 0xcb, 0x85, 0xa6, 0xfd, 0xe3, 0xb2, 0xaa, 0x5d, 0x5c, 0x56, 0xb5, 0x5f,
+	0x97, 0x55, 0xed, 0xfb, 0x55, 0x35, 0x77, 0x71, 0x55, 0xcd, 0xfd, 0xbc, 0xaa, 0xe6, 0x3e, 0xbd,
+	0x1c, 0x51, 0x19, 0xa6, 0xa7, 0x0d, 0x9f, 0x47, 0xfb, 0xb1, 0xe0, 0x41, 0xea, 0xcb, 0xc4, 0xa7,
+	0xff, 0xbc, 0xa1, 0xf3, 0x3b, 0xb5, 0x9c, 0xc6, 0x24, 0x39, 0x5d, 0x52, 0xef, 0xe9, 0xf0, 0x4f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x4f, 0x50, 0xa3, 0xa9, 0x03, 0x00, 0x00,
 }
 
 func (m *Model) Marshal() (dAtA []byte, err error) {
@@ -222,6 +275,11 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidationStrategy != 0 {
+		i = encodeVarintModel(dAtA, i, uint64(m.ValidationStrategy))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.ValidationThreshold != nil {
 		{
 			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
@@ -376,6 +434,9 @@ func (m *Model) Size() (n int) {
 		l = m.ValidationThreshold.Size()
 		n += 1 + l + sovModel(uint64(l))
 	}
+	if m.ValidationStrategy != 0 {
+		n += 1 + sovModel(uint64(m.ValidationStrategy))
+	}
 	return n
 }
 
@@ -756,6 +817,25 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationStrategy", wireType)
+			}
+			m.ValidationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationStrategy |= ValidationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -17,9 +17,10 @@ var (
 	KeyBaseWeightRatio                   = []byte("BaseWeightRatio")
 	KeyCollateralPerWeightUnit           = []byte("CollateralPerWeightUnit")
 	// Vesting parameter keys for TokenomicsParams
-	KeyWorkVestingPeriod     = []byte("WorkVestingPeriod")
-	KeyRewardVestingPeriod   = []byte("RewardVestingPeriod")
-	KeyTopMinerVestingPeriod = []byte("TopMinerVestingPeriod")
+	KeyWorkVestingPeriod      = []byte("WorkVestingPeriod")
+	KeyRewardVestingPeriod    = []byte("RewardVestingPeriod")
+	KeyTopMinerVestingPeriod  = []byte("TopMinerVestingPeriod")
+	KeyClaimGracePeriodEpochs = []byte("ClaimGracePeriodEpochs")
 	// Bitcoin reward parameter keys
 	KeyUseBitcoinRewards          = []byte("UseBitcoinRewards")
 	KeyInitialEpochReward         = []byte("InitialEpochReward")
@@ -86,6 +87,7 @@ func DefaultParams() Params {
 		CollateralParams:     DefaultCollateralParams(),
 		BitcoinRewardParams:  DefaultBitcoinRewardParams(),
 		DynamicPricingParams: DefaultDynamicPricingParams(),
+		TrainingParams:       DefaultTrainingParams(),
 	}
 }
 
@@ -101,7 +103,8 @@ func DefaultEpochParams() *EpochParams {
 		PocValidationDuration:          6,
 		SetNewValidatorsDelay:          1,
 		InferenceValidationCutoff:      0,
-		InferencePruningEpochThreshold: 2, // Number of epochs after which inferences can be pruned
+		InferencePruningEpochThreshold: 2,    // Number of epochs after which inferences can be pruned
+		InferencePruningMaxPerBlock:    1000, // Number of inferences pruning looks at per block
 	}
 }
 
@@ -142,6 +145,7 @@ func DefaultTokenomicsParams() *TokenomicsParams {
 		WorkVestingPeriod:        0, // Default: no vesting (production: 180, E2E tests: 2)
 		RewardVestingPeriod:      0, // Default: no vesting (production: 180, E2E tests: 2)
 		TopMinerVestingPeriod:    0, // Default: no vesting (production: 180, E2E tests: 2)
+		ClaimGracePeriodEpochs:   4,
 	}
 }
 
@@ -181,6 +185,23 @@ func DefaultDynamicPricingParams() *DynamicPricingParams {
 	}
 }
 
+func DefaultTrainingParams() *TrainingParams {
+	return &TrainingParams{
+		AssignmentDeadlineBlocks:      100,
+		StartDeadlineBlocks:           100,
+		NodeReassignmentTimeoutBlocks: 90,
+		DeadlineCheckIntervalBlocks:   10,
+	}
+}
+
+// TrainingParamsOrDefault returns the training params, or the defaults on chains whose params predate them
+func (p Params) TrainingParamsOrDefault() *TrainingParams {
+	if p.TrainingParams == nil {
+		return DefaultTrainingParams()
+	}
+	return p.TrainingParams
+}
+
 // ParamSetPairs get the params.ParamSet: Pretty sure this is deprecated
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{}
@@ -204,6 +225,7 @@ func (p *TokenomicsParams) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyWorkVestingPeriod, &p.WorkVestingPeriod, validateVestingPeriod),
 		paramtypes.NewParamSetPair(KeyRewardVestingPeriod, &p.RewardVestingPeriod, validateVestingPeriod),
 		paramtypes.NewParamSetPair(KeyTopMinerVestingPeriod, &p.TopMinerVestingPeriod, validateVestingPeriod),
+		paramtypes.NewParamSetPair(KeyClaimGracePeriodEpochs, &p.ClaimGracePeriodEpochs, validateEpoch),
 	}
 }
 
@@ -315,9 +337,68 @@ func (p Params) Validate() error {
 	if err := p.DynamicPricingParams.Validate(); err != nil {
 		return err
 	}
+	if p.BridgeParams != nil {
+		if err := p.BridgeParams.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.TrainingParams != nil {
+		if err := p.TrainingParams.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (p *TrainingParams) Validate() error {
+	// All of them are block counts, and a task can't be given zero blocks for anything
+	deadlines := []struct {
+		name   string
+		blocks int64
+	}{
+		{"assignment deadline", p.AssignmentDeadlineBlocks},
+		{"start deadline", p.StartDeadlineBlocks},
+		{"node reassignment timeout", p.NodeReassignmentTimeoutBlocks},
+		{"deadline check interval", p.DeadlineCheckIntervalBlocks},
+	}
+	for _, deadline := range deadlines {
+		if deadline.blocks <= 0 {
+			return fmt.Errorf("training %s must be positive", deadline.name)
+		}
+	}
+	return nil
+}
+
+func (p *BridgeParams) Validate() error {
+	chains := make(map[string]bool, len(p.Contracts))
+	for _, contract := range p.Contracts {
+		if contract.Chain == "" {
+			return fmt.Errorf("bridge contract chain cannot be empty")
+		}
+		if chains[contract.Chain] {
+			return fmt.Errorf("duplicate bridge contract for chain %s", contract.Chain)
+		}
+		chains[contract.Chain] = true
+		if !reEthAddress.MatchString(contract.Address) {
+			return fmt.Errorf("bridge contract address for chain %s must be a 0x-prefixed 20-byte hex address", contract.Chain)
+		}
+	}
+	return nil
+}
+
+// ContractFor returns the bridge contract address of the chain
+func (p *BridgeParams) ContractFor(chain string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	for _, contract := range p.Contracts {
+		if contract.Chain == chain {
+			return contract.Address, true
+		}
+	}
+	return "", false
+}
+
 func (p *ValidationParams) Validate() error {
 	// Check for nil Decimal fields first
 	if p.FalsePositiveRate == nil {
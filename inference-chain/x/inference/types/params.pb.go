@@ -35,6 +35,8 @@ type Params struct {
 	BitcoinRewardParams   *BitcoinRewardParams   `protobuf:"bytes,6,opt,name=bitcoin_reward_params,json=bitcoinRewardParams,proto3" json:"bitcoin_reward_params,omitempty"`
 	DynamicPricingParams  *DynamicPricingParams  `protobuf:"bytes,7,opt,name=dynamic_pricing_params,json=dynamicPricingParams,proto3" json:"dynamic_pricing_params,omitempty"`
 	BandwidthLimitsParams *BandwidthLimitsParams `protobuf:"bytes,8,opt,name=bandwidth_limits_params,json=bandwidthLimitsParams,proto3" json:"bandwidth_limits_params,omitempty"`
+	BridgeParams          *BridgeParams          `protobuf:"bytes,9,opt,name=bridge_params,json=bridgeParams,proto3" json:"bridge_params,omitempty"`
+	TrainingParams        *TrainingParams        `protobuf:"bytes,10,opt,name=training_params,json=trainingParams,proto3" json:"training_params,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -126,6 +128,20 @@ func (m *Params) GetBandwidthLimitsParams() *BandwidthLimitsParams {
 	return nil
 }
 
+func (m *Params) GetBridgeParams() *BridgeParams {
+	if m != nil {
+		return m.BridgeParams
+	}
+	return nil
+}
+
+func (m *Params) GetTrainingParams() *TrainingParams {
+	if m != nil {
+		return m.TrainingParams
+	}
+	return nil
+}
+
 type GenesisOnlyParams struct {
 	TotalSupply                             int64    `protobuf:"varint,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
 	OriginatorSupply                        int64    `protobuf:"varint,2,opt,name=originator_supply,json=originatorSupply,proto3" json:"originator_supply,omitempty"`
@@ -299,6 +315,8 @@ type TokenomicsParams struct {
 	WorkVestingPeriod        uint64   `protobuf:"varint,6,opt,name=work_vesting_period,json=workVestingPeriod,proto3" json:"work_vesting_period,omitempty"`
 	RewardVestingPeriod      uint64   `protobuf:"varint,7,opt,name=reward_vesting_period,json=rewardVestingPeriod,proto3" json:"reward_vesting_period,omitempty"`
 	TopMinerVestingPeriod    uint64   `protobuf:"varint,8,opt,name=top_miner_vesting_period,json=topMinerVestingPeriod,proto3" json:"top_miner_vesting_period,omitempty"`
+	// Epochs an unclaimed settle amount stays claimable after its own epoch, it is burned afterwards
+	ClaimGracePeriodEpochs uint64 `protobuf:"varint,9,opt,name=claim_grace_period_epochs,json=claimGracePeriodEpochs,proto3" json:"claim_grace_period_epochs,omitempty"`
 }
 
 func (m *TokenomicsParams) Reset()         { *m = TokenomicsParams{} }
@@ -390,6 +408,13 @@ func (m *TokenomicsParams) GetTopMinerVestingPeriod() uint64 {
 	return 0
 }
 
+func (m *TokenomicsParams) GetClaimGracePeriodEpochs() uint64 {
+	if m != nil {
+		return m.ClaimGracePeriodEpochs
+	}
+	return 0
+}
+
 type EpochParams struct {
 	EpochLength                    int64  `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
 	EpochMultiplier                int64  `protobuf:"varint,2,opt,name=epoch_multiplier,json=epochMultiplier,proto3" json:"epoch_multiplier,omitempty"`
@@ -402,6 +427,8 @@ type EpochParams struct {
 	SetNewValidatorsDelay          int64  `protobuf:"varint,9,opt,name=set_new_validators_delay,json=setNewValidatorsDelay,proto3" json:"set_new_validators_delay,omitempty"`
 	InferenceValidationCutoff      int64  `protobuf:"varint,10,opt,name=inference_validation_cutoff,json=inferenceValidationCutoff,proto3" json:"inference_validation_cutoff,omitempty"`
 	InferencePruningEpochThreshold uint64 `protobuf:"varint,11,opt,name=inference_pruning_epoch_threshold,json=inferencePruningEpochThreshold,proto3" json:"inference_pruning_epoch_threshold,omitempty"`
+	// Bounds how many inferences pruning looks at per block, 0 uses the module default
+	InferencePruningMaxPerBlock uint64 `protobuf:"varint,12,opt,name=inference_pruning_max_per_block,json=inferencePruningMaxPerBlock,proto3" json:"inference_pruning_max_per_block,omitempty"`
 }
 
 func (m *EpochParams) Reset()         { *m = EpochParams{} }
@@ -514,6 +541,13 @@ func (m *EpochParams) GetInferencePruningEpochThreshold() uint64 {
 	return 0
 }
 
+func (m *EpochParams) GetInferencePruningMaxPerBlock() uint64 {
+	if m != nil {
+		return m.InferencePruningMaxPerBlock
+	}
+	return 0
+}
+
 type ValidationParams struct {
 	FalsePositiveRate           *Decimal `protobuf:"bytes,1,opt,name=false_positive_rate,json=falsePositiveRate,proto3" json:"false_positive_rate,omitempty"`
 	MinRampUpMeasurements       int32    `protobuf:"varint,2,opt,name=min_ramp_up_measurements,json=minRampUpMeasurements,proto3" json:"min_ramp_up_measurements,omitempty"`
@@ -1145,6 +1179,177 @@ func (m *BandwidthLimitsParams) GetKbPerOutputToken() *Decimal {
 	return nil
 }
 
+type TrainingParams struct {
+	// Blocks an assigner has to assign a task once it claimed it
+	AssignmentDeadlineBlocks int64 `protobuf:"varint,1,opt,name=assignment_deadline_blocks,json=assignmentDeadlineBlocks,proto3" json:"assignment_deadline_blocks,omitempty"`
+	// Blocks the assignees have to join the first outer step once a task is assigned
+	StartDeadlineBlocks int64 `protobuf:"varint,2,opt,name=start_deadline_blocks,json=startDeadlineBlocks,proto3" json:"start_deadline_blocks,omitempty"`
+	// Blocks without heartbeats after which an assigned node is considered gone and its slot is reassigned
+	NodeReassignmentTimeoutBlocks int64 `protobuf:"varint,3,opt,name=node_reassignment_timeout_blocks,json=nodeReassignmentTimeoutBlocks,proto3" json:"node_reassignment_timeout_blocks,omitempty"`
+	// Training task deadlines are checked once every this many blocks
+	DeadlineCheckIntervalBlocks int64 `protobuf:"varint,4,opt,name=deadline_check_interval_blocks,json=deadlineCheckIntervalBlocks,proto3" json:"deadline_check_interval_blocks,omitempty"`
+}
+
+func (m *TrainingParams) Reset()         { *m = TrainingParams{} }
+func (m *TrainingParams) String() string { return proto.CompactTextString(m) }
+func (*TrainingParams) ProtoMessage()    {}
+func (*TrainingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cf34332021bbe94, []int{11}
+}
+func (m *TrainingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainingParams.Merge(m, src)
+}
+func (m *TrainingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainingParams proto.InternalMessageInfo
+
+func (m *TrainingParams) GetAssignmentDeadlineBlocks() int64 {
+	if m != nil {
+		return m.AssignmentDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *TrainingParams) GetStartDeadlineBlocks() int64 {
+	if m != nil {
+		return m.StartDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *TrainingParams) GetNodeReassignmentTimeoutBlocks() int64 {
+	if m != nil {
+		return m.NodeReassignmentTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *TrainingParams) GetDeadlineCheckIntervalBlocks() int64 {
+	if m != nil {
+		return m.DeadlineCheckIntervalBlocks
+	}
+	return 0
+}
+
+type BridgeParams struct {
+	// contracts are the bridge contracts whose deposit events can be proven against attested receipts roots
+	Contracts []*BridgeContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (m *BridgeParams) Reset()         { *m = BridgeParams{} }
+func (m *BridgeParams) String() string { return proto.CompactTextString(m) }
+func (*BridgeParams) ProtoMessage()    {}
+func (*BridgeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cf34332021bbe94, []int{12}
+}
+func (m *BridgeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeParams.Merge(m, src)
+}
+func (m *BridgeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeParams proto.InternalMessageInfo
+
+func (m *BridgeParams) GetContracts() []*BridgeContract {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type BridgeContract struct {
+	// chain is the origin chain name used in bridge messages, e.g. "ethereum"
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	// address is the 0x-prefixed address of the bridge contract on that chain
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *BridgeContract) Reset()         { *m = BridgeContract{} }
+func (m *BridgeContract) String() string { return proto.CompactTextString(m) }
+func (*BridgeContract) ProtoMessage()    {}
+func (*BridgeContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cf34332021bbe94, []int{13}
+}
+func (m *BridgeContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeContract.Merge(m, src)
+}
+func (m *BridgeContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeContract proto.InternalMessageInfo
+
+func (m *BridgeContract) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.inference.Params")
 	proto.RegisterType((*GenesisOnlyParams)(nil), "inference.inference.GenesisOnlyParams")
@@ -1157,152 +1362,169 @@ func init() {
 	proto.RegisterType((*BitcoinRewardParams)(nil), "inference.inference.BitcoinRewardParams")
 	proto.RegisterType((*DynamicPricingParams)(nil), "inference.inference.DynamicPricingParams")
 	proto.RegisterType((*BandwidthLimitsParams)(nil), "inference.inference.BandwidthLimitsParams")
+	proto.RegisterType((*TrainingParams)(nil), "inference.inference.TrainingParams")
+	proto.RegisterType((*BridgeParams)(nil), "inference.inference.BridgeParams")
+	proto.RegisterType((*BridgeContract)(nil), "inference.inference.BridgeContract")
 }
 
 func init() { proto.RegisterFile("inference/inference/params.proto", fileDescriptor_3cf34332021bbe94) }
 
 var fileDescriptor_3cf34332021bbe94 = []byte{
-	// 2229 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4f, 0x73, 0x1b, 0xb7,
-	0x15, 0x37, 0x2d, 0xca, 0xb2, 0x20, 0xbb, 0xa2, 0x40, 0x52, 0xa2, 0x64, 0x59, 0x96, 0xdd, 0x66,
-	0xea, 0xd8, 0x8d, 0xdc, 0x3a, 0x4d, 0xd3, 0x49, 0x1a, 0x4f, 0x65, 0x49, 0x76, 0x34, 0xb1, 0x62,
-	0x76, 0x65, 0x2b, 0x53, 0x8f, 0x67, 0x76, 0xc0, 0x5d, 0x90, 0xc4, 0x68, 0x17, 0xd8, 0x00, 0x58,
-	0x51, 0xf4, 0x47, 0x68, 0x2f, 0xfd, 0x08, 0xfd, 0x08, 0xfe, 0x04, 0x3d, 0xf7, 0x98, 0xe9, 0xf4,
-	0xd0, 0x5b, 0x3b, 0xf6, 0xa1, 0xbd, 0xf7, 0xd0, 0xe9, 0x2d, 0x83, 0x07, 0xec, 0x1f, 0x52, 0xb4,
-	0xcc, 0x5c, 0x34, 0xcb, 0xf7, 0xde, 0xef, 0x87, 0x3f, 0xef, 0xe1, 0xbd, 0x07, 0x08, 0x6d, 0x32,
-	0xde, 0xa5, 0x92, 0xf2, 0x80, 0xde, 0x2b, 0xbe, 0x12, 0x22, 0x49, 0xac, 0xb6, 0x12, 0x29, 0xb4,
-	0xc0, 0xf5, 0x5c, 0xbe, 0x95, 0x7f, 0xad, 0x2d, 0x91, 0x98, 0x71, 0x71, 0x0f, 0xfe, 0x5a, 0xbb,
-	0xb5, 0x46, 0x4f, 0xf4, 0x04, 0x7c, 0xde, 0x33, 0x5f, 0x4e, 0xba, 0x1a, 0x08, 0x15, 0x0b, 0xe5,
-	0x5b, 0x85, 0xfd, 0x61, 0x55, 0xb7, 0xfe, 0x36, 0x8b, 0x2e, 0xb5, 0x61, 0x24, 0xbc, 0x83, 0xae,
-	0xd0, 0x44, 0x04, 0x7d, 0xdf, 0x8e, 0xdc, 0xaa, 0x6c, 0x56, 0x6e, 0x2f, 0xdc, 0xdf, 0xdc, 0x9a,
-	0x30, 0xf4, 0xd6, 0x9e, 0x31, 0xb4, 0x38, 0x6f, 0x81, 0x16, 0x3f, 0xb0, 0x87, 0x96, 0x4e, 0x48,
-	0xc4, 0x42, 0xa2, 0x99, 0xe0, 0x19, 0xd3, 0x45, 0x60, 0xfa, 0x60, 0x22, 0xd3, 0x51, 0x6e, 0xed,
-	0xe8, 0x6a, 0x27, 0x63, 0x12, 0xfc, 0x05, 0x42, 0x89, 0x08, 0x32, 0xb2, 0x19, 0x20, 0xdb, 0x98,
-	0x48, 0xd6, 0x16, 0x81, 0x63, 0x99, 0x4f, 0xb2, 0x4f, 0x33, 0x25, 0x2d, 0x8e, 0x29, 0x17, 0x31,
-	0x0b, 0x54, 0xc6, 0x52, 0x3d, 0x67, 0x4a, 0xcf, 0x72, 0xeb, 0x6c, 0x4a, 0x7a, 0x4c, 0x62, 0x38,
-	0x03, 0x11, 0x45, 0x44, 0x53, 0x49, 0xa2, 0x8c, 0x73, 0xf6, 0x1c, 0xce, 0x9d, 0xdc, 0x3a, 0xe3,
-	0x0c, 0xc6, 0x24, 0xf8, 0x25, 0x6a, 0x76, 0x98, 0x0e, 0x04, 0xe3, 0xbe, 0xa4, 0x03, 0x22, 0xc3,
-	0x8c, 0xf7, 0x12, 0xf0, 0xde, 0x9e, 0xc8, 0xfb, 0xd0, 0x22, 0x3c, 0x00, 0x38, 0xea, 0x7a, 0xe7,
-	0xac, 0x10, 0xfb, 0x68, 0x39, 0x1c, 0x72, 0x12, 0xb3, 0xc0, 0x4f, 0x24, 0x0b, 0x18, 0xef, 0x65,
-	0xf4, 0x73, 0x40, 0xff, 0xe1, 0x44, 0xfa, 0x5d, 0x0b, 0x69, 0x5b, 0x84, 0xe3, 0x6f, 0x84, 0x13,
-	0xa4, 0xb8, 0x83, 0x56, 0x3a, 0x84, 0x87, 0x03, 0x16, 0xea, 0xbe, 0x1f, 0xb1, 0x98, 0xe9, 0x7c,
-	0xb3, 0x2f, 0xc3, 0x08, 0x77, 0x26, 0x2f, 0x20, 0xc3, 0x3c, 0x01, 0x88, 0x1b, 0xa2, 0xd9, 0x99,
-	0x24, 0xfe, 0xec, 0x83, 0xff, 0xfc, 0xf9, 0x46, 0xe5, 0x0f, 0xff, 0x7e, 0x7d, 0x67, 0xbd, 0x38,
-	0x27, 0xa7, 0xa5, 0x33, 0x63, 0xcd, 0x6e, 0xbd, 0x9e, 0x43, 0x4b, 0x8f, 0x29, 0xa7, 0x8a, 0xa9,
-	0xa7, 0x3c, 0x1a, 0xba, 0x09, 0xde, 0x44, 0x57, 0xb4, 0xd0, 0x24, 0xf2, 0x55, 0x9a, 0x24, 0xd1,
-	0x10, 0xe2, 0x7b, 0xc6, 0x5b, 0x00, 0xd9, 0x21, 0x88, 0xf0, 0x5d, 0xb4, 0x24, 0x24, 0xeb, 0x31,
-	0x4e, 0xb4, 0x90, 0x99, 0xdd, 0x45, 0xb0, 0xab, 0x15, 0x0a, 0x67, 0x7c, 0xc7, 0xc4, 0x55, 0x92,
-	0xf9, 0x8a, 0xc4, 0x22, 0xe5, 0x1a, 0xa2, 0x73, 0xc6, 0x5b, 0xd4, 0x22, 0xb1, 0xbb, 0xbf, 0x0d,
-	0x62, 0xfc, 0x4b, 0xb4, 0xac, 0x34, 0xe1, 0xa1, 0xb1, 0x1c, 0x05, 0x54, 0x01, 0xd0, 0xc8, 0xb4,
-	0x23, 0xa8, 0xcf, 0xd1, 0x5a, 0x22, 0xa9, 0x39, 0xb6, 0x3d, 0x49, 0xe2, 0x98, 0x86, 0xbe, 0x22,
-	0x11, 0xcd, 0x90, 0xb3, 0x80, 0x5c, 0x49, 0x24, 0x6d, 0xe7, 0x06, 0x87, 0x24, 0xa2, 0x0e, 0x7c,
-	0x03, 0x2d, 0x14, 0xd3, 0xb3, 0x41, 0x34, 0xeb, 0xa1, 0x7c, 0x62, 0xb0, 0x1f, 0x76, 0x85, 0x7e,
-	0x68, 0x82, 0x1b, 0xe2, 0x60, 0xde, 0x5b, 0xb0, 0xb2, 0x5d, 0x23, 0x1a, 0x5b, 0x62, 0x42, 0x25,
-	0x13, 0x21, 0x78, 0xb3, 0xbc, 0xc4, 0x36, 0x88, 0xf1, 0xcf, 0x10, 0x2e, 0xdb, 0x92, 0xa1, 0x48,
-	0xb5, 0x6a, 0xcd, 0xdb, 0xcd, 0x2b, 0x8c, 0xad, 0x1c, 0x3f, 0x40, 0xeb, 0x67, 0xad, 0xcd, 0x08,
-	0x7e, 0xcc, 0x38, 0x95, 0x2d, 0x04, 0xb8, 0xd6, 0x38, 0xae, 0x4d, 0xe5, 0x81, 0xd1, 0xe3, 0x4f,
-	0xd0, 0x4a, 0x09, 0x1f, 0x93, 0x53, 0x3f, 0x4c, 0x25, 0x24, 0x8d, 0xd6, 0x82, 0xdd, 0xd1, 0x1c,
-	0x7a, 0x40, 0x4e, 0x77, 0x9d, 0x0e, 0x07, 0xe8, 0x86, 0xb1, 0x65, 0x3c, 0x64, 0x27, 0x2c, 0x4c,
-	0xcd, 0xd9, 0x15, 0x03, 0x2a, 0xcd, 0xc0, 0x01, 0xe5, 0x9a, 0xf4, 0x68, 0xeb, 0x0a, 0x04, 0xeb,
-	0xfa, 0xe4, 0xe3, 0x40, 0x03, 0x16, 0x93, 0xc8, 0x5b, 0x8f, 0xc9, 0xe9, 0x7e, 0xce, 0xd1, 0x36,
-	0x14, 0xed, 0x9c, 0x01, 0xff, 0x1a, 0xb5, 0x7a, 0x36, 0xfa, 0xfc, 0x5e, 0x4a, 0x64, 0xc8, 0x08,
-	0xf7, 0x29, 0x27, 0x9d, 0x88, 0x86, 0xad, 0xab, 0x9b, 0x95, 0xdb, 0x97, 0xbd, 0x65, 0xa7, 0x7f,
-	0xec, 0xd4, 0x7b, 0x56, 0x8b, 0x5f, 0xa2, 0xbb, 0x67, 0x90, 0x9c, 0xea, 0x81, 0x90, 0xc7, 0x7e,
-	0x4c, 0x74, 0x2a, 0x99, 0x1e, 0xfa, 0xba, 0x2f, 0xa9, 0xea, 0x8b, 0x28, 0x6c, 0xfd, 0x08, 0x56,
-	0xfa, 0xd3, 0x31, 0xb2, 0xaf, 0x2d, 0xe0, 0xc0, 0xd9, 0x3f, 0xcb, 0xcc, 0xf1, 0x4b, 0x74, 0xed,
-	0x0c, 0x7b, 0x9c, 0x46, 0x9a, 0x25, 0x11, 0xa3, 0xb2, 0xb5, 0x38, 0xc5, 0xc2, 0x57, 0xc7, 0xc6,
-	0x3a, 0xc8, 0xe1, 0xf8, 0x37, 0x68, 0xed, 0x0c, 0x3b, 0x09, 0x43, 0x49, 0x95, 0xa2, 0xaa, 0x55,
-	0xdb, 0x9c, 0xb9, 0x3d, 0xef, 0xb5, 0xc6, 0xe0, 0xdb, 0x99, 0xfe, 0xd6, 0x3f, 0xab, 0xa8, 0x36,
-	0x9e, 0x77, 0xf1, 0x0b, 0xb4, 0xa6, 0xd2, 0x8e, 0x62, 0xe1, 0xd0, 0x97, 0x34, 0x4c, 0x03, 0xa8,
-	0x29, 0x8c, 0x6b, 0x2a, 0x4f, 0x48, 0xe4, 0xea, 0xd3, 0xf9, 0xf3, 0x6d, 0x39, 0xbc, 0x97, 0xc1,
-	0xf7, 0x1d, 0x1a, 0x1f, 0xa1, 0xd6, 0x59, 0x6e, 0x77, 0xb2, 0x2e, 0x4e, 0xc1, 0xbc, 0x3c, 0xce,
-	0xec, 0x8e, 0xdd, 0x0b, 0xb4, 0x16, 0xa4, 0x52, 0x52, 0xae, 0xfd, 0x8c, 0xbf, 0x14, 0x5c, 0x33,
-	0xd3, 0xcc, 0xd9, 0xe1, 0x0f, 0x2d, 0xbc, 0x14, 0x58, 0xbf, 0x47, 0x6b, 0xe5, 0x8c, 0x13, 0x45,
-	0x62, 0x40, 0x43, 0xbf, 0x4b, 0x58, 0x94, 0x4a, 0xea, 0x4a, 0xda, 0xf9, 0xdc, 0x2b, 0x45, 0x62,
-	0xb2, 0xe8, 0x47, 0x16, 0x8c, 0xbf, 0x40, 0xd7, 0x0c, 0x35, 0x1c, 0x3e, 0xdf, 0x54, 0xdb, 0x6f,
-	0x53, 0x12, 0xb1, 0x2e, 0x0b, 0xec, 0x99, 0x9a, 0xcd, 0x8f, 0x23, 0x1c, 0xbf, 0xb6, 0x08, 0x7e,
-	0x57, 0xd6, 0xe3, 0x2d, 0x54, 0x87, 0x20, 0x3d, 0xa1, 0x4a, 0x43, 0x69, 0xb1, 0xa9, 0xc2, 0x24,
-	0x9d, 0xaa, 0xb7, 0x64, 0x54, 0x47, 0x56, 0xe3, 0x92, 0xc5, 0x7d, 0xd4, 0x74, 0xab, 0x18, 0x43,
-	0xcc, 0x01, 0xa2, 0x6e, 0x95, 0xa3, 0x98, 0x4f, 0x51, 0xab, 0x98, 0xe2, 0x18, 0xec, 0x32, 0xc0,
-	0x9a, 0xd9, 0xfc, 0x46, 0x80, 0x9f, 0x55, 0x4d, 0xd5, 0xb8, 0xf5, 0xf7, 0x2a, 0x5a, 0x28, 0xb5,
-	0x2d, 0x26, 0xfd, 0xd9, 0x76, 0x27, 0xa2, 0xbc, 0xa7, 0xfb, 0x59, 0x39, 0x00, 0xd9, 0x13, 0x10,
-	0xe1, 0x0f, 0x51, 0xcd, 0x9a, 0x94, 0x4e, 0x89, 0xad, 0x06, 0x8b, 0x20, 0x2f, 0x45, 0xff, 0x0d,
-	0x64, 0x91, 0xbe, 0xea, 0xb3, 0x6e, 0x56, 0x06, 0x10, 0x88, 0x0e, 0x8d, 0x04, 0xff, 0x16, 0x5d,
-	0x0f, 0x69, 0x97, 0xa4, 0x91, 0xf6, 0x53, 0xce, 0xb4, 0x2f, 0xba, 0x7e, 0x20, 0xe2, 0x24, 0xd5,
-	0x14, 0xea, 0x31, 0x75, 0x85, 0x60, 0xd5, 0x19, 0x3d, 0xe7, 0x4c, 0x3f, 0xed, 0xee, 0x58, 0x0b,
-	0x53, 0x68, 0xa9, 0x49, 0xb0, 0xc6, 0x31, 0xca, 0x84, 0x42, 0x91, 0xed, 0xac, 0x67, 0x6a, 0x89,
-	0x08, 0x0e, 0x8d, 0x22, 0xcf, 0x74, 0xf7, 0x51, 0xd3, 0x58, 0xd3, 0xd3, 0xa0, 0x4f, 0x78, 0x19,
-	0x70, 0x09, 0x00, 0xf5, 0x44, 0x04, 0x7b, 0x4e, 0x97, 0x63, 0x7e, 0x8e, 0x1a, 0x06, 0x53, 0x6a,
-	0xe0, 0x42, 0x1a, 0x91, 0x21, 0x38, 0x65, 0xc6, 0x33, 0xa3, 0x17, 0xdd, 0xda, 0xae, 0xd1, 0xe0,
-	0x5f, 0xa1, 0x95, 0x71, 0x44, 0x36, 0x8e, 0x2d, 0x13, 0xcd, 0x51, 0x50, 0x36, 0xd2, 0xa7, 0xa8,
-	0xa5, 0xa8, 0xf6, 0x39, 0x1d, 0x64, 0x58, 0x21, 0x95, 0x1b, 0xcd, 0x96, 0x8c, 0xa6, 0xa2, 0xfa,
-	0x6b, 0x3a, 0x38, 0xca, 0xb5, 0x76, 0xc0, 0x07, 0xe8, 0x5a, 0x1e, 0xd5, 0xe5, 0x61, 0x83, 0x54,
-	0x8b, 0x6e, 0xd7, 0x95, 0x8d, 0xd5, 0xdc, 0xa4, 0x18, 0x7a, 0x07, 0x0c, 0xf0, 0x3e, 0xba, 0x59,
-	0xe0, 0x13, 0x99, 0x72, 0x13, 0x44, 0xd6, 0x73, 0x45, 0x5e, 0x5d, 0x80, 0x68, 0xda, 0xc8, 0x0d,
-	0xdb, 0xd6, 0x0e, 0xa2, 0x27, 0x4f, 0xa7, 0x2e, 0xac, 0xfe, 0x3f, 0x87, 0x6a, 0xe3, 0x3d, 0x2c,
-	0x7e, 0x82, 0xea, 0x5d, 0x12, 0x29, 0xea, 0x27, 0x42, 0x31, 0xcd, 0x4e, 0xa8, 0x2f, 0x89, 0xa6,
-	0x53, 0x65, 0xac, 0x25, 0x00, 0xb6, 0x1d, 0xce, 0x23, 0x9a, 0x9a, 0xcd, 0x8a, 0x4d, 0x53, 0x48,
-	0xe2, 0xc4, 0x4f, 0x13, 0x3f, 0xa6, 0x44, 0xa5, 0x92, 0xc6, 0x94, 0x6b, 0xdb, 0x5a, 0xcf, 0x7a,
-	0xcd, 0x98, 0x71, 0x8f, 0xc4, 0xc9, 0xf3, 0xe4, 0xa0, 0xa4, 0xc4, 0x9f, 0x23, 0x94, 0x10, 0xa5,
-	0xcc, 0x3e, 0xa5, 0xd3, 0xe5, 0x9e, 0x79, 0x63, 0x7f, 0x64, 0xcc, 0xb1, 0x87, 0x96, 0xcd, 0xa8,
-	0xa5, 0x3d, 0x26, 0x27, 0x54, 0x9a, 0x24, 0x36, 0x4d, 0xa2, 0x69, 0xc4, 0x8c, 0x17, 0xdb, 0xb2,
-	0x6d, 0x91, 0xc0, 0x49, 0x4e, 0x27, 0x71, 0xce, 0x4e, 0xc5, 0x49, 0x4e, 0xcf, 0x72, 0xde, 0x45,
-	0x4b, 0xf4, 0x34, 0x61, 0x36, 0xb0, 0xfc, 0x4e, 0x24, 0x82, 0x63, 0xe5, 0x82, 0xbc, 0x56, 0x28,
-	0x1e, 0x82, 0x1c, 0xdf, 0x42, 0x57, 0xc1, 0xd9, 0xca, 0xd7, 0xc2, 0x74, 0x0d, 0x2e, 0xb4, 0xed,
-	0xd9, 0x55, 0xcf, 0xc4, 0x01, 0x39, 0xc5, 0x3b, 0x68, 0xa3, 0x9b, 0x46, 0x51, 0x79, 0x96, 0x5a,
-	0x92, 0x6e, 0x97, 0x05, 0x59, 0x94, 0xd9, 0xd0, 0xbe, 0x66, 0xac, 0x8a, 0xf9, 0x3c, 0xb3, 0x36,
-	0x2e, 0xce, 0xce, 0xee, 0x5e, 0x9f, 0x44, 0xdd, 0x81, 0x0b, 0xef, 0x1f, 0xb6, 0x7b, 0x5f, 0x5a,
-	0x24, 0xde, 0x46, 0xd7, 0xc7, 0x38, 0xc7, 0xe6, 0x65, 0xa3, 0x7f, 0x6d, 0x04, 0x3c, 0x61, 0x5a,
-	0x4a, 0x95, 0x4a, 0x52, 0x86, 0x5d, 0x98, 0x6e, 0x5a, 0x4a, 0x15, 0xf5, 0xc8, 0x71, 0xb6, 0x51,
-	0x13, 0x38, 0x25, 0xfd, 0x36, 0xa5, 0x0a, 0xba, 0x38, 0x4e, 0x22, 0x3d, 0x9c, 0xaa, 0x93, 0xaa,
-	0x1b, 0xa8, 0xe7, 0x90, 0x6d, 0x0b, 0xc4, 0xbf, 0x40, 0x0d, 0xcd, 0x62, 0xaa, 0xb4, 0x89, 0xf8,
-	0xc2, 0x87, 0xd0, 0x3c, 0xcd, 0x78, 0xf5, 0x5c, 0xb7, 0x97, 0xab, 0x4c, 0x14, 0x14, 0x10, 0x12,
-	0x9e, 0x10, 0x1e, 0x50, 0xd7, 0x1f, 0xd5, 0x72, 0xc5, 0xb6, 0x95, 0x9b, 0x5c, 0x6c, 0x0a, 0x44,
-	0x4c, 0x34, 0x0d, 0xf3, 0xab, 0x0a, 0x95, 0x36, 0x78, 0xfc, 0xe3, 0x0e, 0xb4, 0x42, 0x55, 0x6f,
-	0x35, 0x37, 0x72, 0x97, 0x10, 0x2a, 0x21, 0x8c, 0xbe, 0xea, 0xb8, 0xb3, 0xff, 0x97, 0x0a, 0x9a,
-	0xcf, 0xaf, 0x9c, 0xf8, 0x23, 0x84, 0xb3, 0x0c, 0x1f, 0x32, 0xb3, 0xe7, 0xa9, 0xd9, 0x84, 0x0a,
-	0x1c, 0xd0, 0x25, 0xa7, 0xd9, 0xcd, 0x15, 0xe6, 0x4a, 0x50, 0xf2, 0xa4, 0x22, 0x71, 0x12, 0x51,
-	0x5f, 0xb1, 0x57, 0xd4, 0x9d, 0xe9, 0x46, 0xa1, 0x3d, 0x04, 0xe5, 0x21, 0x7b, 0x45, 0xf1, 0x23,
-	0xb4, 0x69, 0x12, 0x6e, 0x48, 0x34, 0x79, 0x67, 0xfa, 0x9a, 0x81, 0xd9, 0xaf, 0x27, 0x22, 0xd8,
-	0x25, 0x9a, 0x9c, 0x97, 0xbc, 0xb6, 0xd1, 0x9c, 0x73, 0x04, 0x6e, 0xa0, 0x59, 0x9b, 0x26, 0x6c,
-	0x1d, 0xb4, 0x3f, 0xf0, 0x1a, 0xba, 0x4c, 0x4f, 0x13, 0xc1, 0xa9, 0xeb, 0x8a, 0x66, 0xbd, 0xfc,
-	0xb7, 0xa3, 0xf8, 0x63, 0x15, 0xd5, 0xc6, 0x2f, 0xb7, 0x26, 0xcc, 0x54, 0x44, 0x54, 0xdf, 0xef,
-	0x4a, 0x92, 0x75, 0x6d, 0xb0, 0x9c, 0xa9, 0x52, 0x60, 0x03, 0xb0, 0x8f, 0x1c, 0x74, 0xdf, 0x22,
-	0xf1, 0x33, 0xb4, 0x32, 0xc6, 0x19, 0x8a, 0x01, 0x37, 0xbe, 0x9d, 0xaa, 0x5f, 0x6b, 0x8e, 0x90,
-	0xee, 0x3a, 0x28, 0x8e, 0xd1, 0x4f, 0x32, 0x1a, 0xdf, 0x84, 0x22, 0x0d, 0xcb, 0x67, 0x63, 0x74,
-	0x4f, 0xdf, 0x37, 0xc4, 0xcd, 0x8c, 0xe9, 0x00, 0x88, 0x8a, 0x83, 0x52, 0xb4, 0xe0, 0x1f, 0xa3,
-	0xe5, 0x9e, 0x24, 0xa6, 0xf4, 0x40, 0x6b, 0xe2, 0x53, 0x1e, 0x5a, 0xf7, 0x41, 0x52, 0xad, 0x7a,
-	0x75, 0xd0, 0xda, 0xbe, 0x65, 0x8f, 0x87, 0xe0, 0x34, 0xfc, 0x25, 0x5a, 0xea, 0x10, 0x45, 0xfd,
-	0x01, 0x65, 0xbd, 0xbe, 0xf6, 0x21, 0xe2, 0xa7, 0x4a, 0x98, 0x8b, 0x06, 0xf6, 0x0d, 0xa0, 0x3c,
-	0x03, 0x32, 0x0d, 0x64, 0xf9, 0xd9, 0x82, 0xca, 0x8c, 0xd3, 0xb4, 0x24, 0xee, 0x9d, 0xe1, 0x3d,
-	0x0d, 0x64, 0xe9, 0xd9, 0x82, 0x4a, 0xcb, 0x6d, 0x7a, 0x15, 0x17, 0x0d, 0xff, 0x9d, 0x41, 0xf5,
-	0x09, 0x4f, 0x12, 0xa6, 0x3f, 0x4c, 0x15, 0xf5, 0x47, 0xdf, 0x37, 0xec, 0x13, 0xd3, 0x65, 0x6f,
-	0x29, 0x55, 0x74, 0x04, 0xa4, 0x4c, 0x27, 0xc2, 0x38, 0xd3, 0x8c, 0x44, 0x2e, 0xba, 0x2d, 0x02,
-	0x3c, 0x5d, 0xf5, 0xb0, 0xd3, 0xc1, 0xf6, 0x58, 0x88, 0xa9, 0x75, 0x21, 0x0d, 0xc8, 0xd0, 0x56,
-	0xda, 0xa9, 0x6a, 0x1d, 0xd8, 0x43, 0x85, 0xfd, 0x31, 0xba, 0x9a, 0xdd, 0x5d, 0xca, 0xde, 0xb8,
-	0xe2, 0x84, 0xd6, 0x0d, 0x47, 0xa8, 0x95, 0x6a, 0x16, 0xb1, 0x57, 0xae, 0xd2, 0x08, 0x9e, 0x2a,
-	0xbf, 0x4b, 0x02, 0x2d, 0xe4, 0x54, 0xde, 0x58, 0x2e, 0xa1, 0x1f, 0x1a, 0xf0, 0x23, 0xc0, 0x1a,
-	0xa7, 0x40, 0xbd, 0x09, 0x84, 0xad, 0x68, 0xa3, 0xcc, 0x53, 0x39, 0xc5, 0xe0, 0x77, 0x1c, 0xbc,
-	0x4c, 0xed, 0xa3, 0xeb, 0x09, 0x91, 0xb0, 0x8d, 0x93, 0xd9, 0xe7, 0xa6, 0x60, 0x5f, 0x73, 0x14,
-	0x13, 0x06, 0x70, 0x5e, 0x7f, 0x5d, 0x45, 0x8d, 0x49, 0x2f, 0x45, 0x66, 0x69, 0x4a, 0x93, 0x0e,
-	0x8b, 0xcc, 0xbd, 0xf5, 0x95, 0xe0, 0xd4, 0x8f, 0xe0, 0xba, 0xdd, 0x11, 0x29, 0x9f, 0x2e, 0x17,
-	0xac, 0xe4, 0xf8, 0x17, 0x82, 0xd3, 0x27, 0x06, 0xfd, 0xd0, 0x80, 0x27, 0x50, 0xa7, 0x49, 0x92,
-	0x53, 0x5f, 0xfc, 0xc1, 0xd4, 0xcf, 0x0d, 0xda, 0x52, 0x3f, 0x46, 0x35, 0x68, 0xc9, 0x7d, 0x1a,
-	0x11, 0xa5, 0x59, 0xc0, 0xf4, 0x70, 0xaa, 0x80, 0x5a, 0x04, 0xd4, 0x5e, 0x0e, 0x32, 0xcd, 0x6a,
-	0x39, 0x62, 0x06, 0x8c, 0x87, 0x62, 0x50, 0x74, 0xc8, 0x36, 0xc8, 0x56, 0x4b, 0x26, 0xdf, 0x80,
-	0x45, 0xde, 0x25, 0x7f, 0x84, 0xea, 0xa6, 0xe0, 0x9b, 0x65, 0xc1, 0x0b, 0xa4, 0xbb, 0x29, 0xcc,
-	0x02, 0xae, 0x16, 0x33, 0xde, 0xa6, 0x12, 0x2e, 0xcd, 0xf6, 0x82, 0x70, 0x0f, 0x35, 0x20, 0x4f,
-	0x8c, 0xdb, 0xbb, 0x5b, 0x98, 0xd1, 0x8d, 0x02, 0xde, 0x9d, 0x8d, 0xe6, 0xde, 0x9d, 0x8d, 0x1e,
-	0xa0, 0xf5, 0x11, 0xd0, 0xf8, 0x68, 0xf6, 0x2a, 0xd6, 0x2a, 0x41, 0x47, 0x06, 0x75, 0x21, 0xf3,
-	0xbf, 0x0a, 0x6a, 0x4e, 0x7c, 0xfa, 0x7b, 0x7f, 0x71, 0xae, 0xbc, 0xa7, 0x38, 0xe3, 0x7d, 0x84,
-	0x8f, 0x3b, 0x80, 0x61, 0x3c, 0x49, 0xb5, 0x9d, 0xdd, 0x54, 0x21, 0xb1, 0x78, 0xdc, 0x69, 0x53,
-	0xb9, 0x6f, 0x50, 0x30, 0x63, 0xfc, 0x15, 0xaa, 0x3b, 0x2a, 0x91, 0xea, 0x82, 0x6b, 0x9a, 0x68,
-	0xa8, 0x01, 0xd7, 0x53, 0x80, 0x01, 0x99, 0x5d, 0xf9, 0xc3, 0xa7, 0x7f, 0x7d, 0xb3, 0x51, 0xf9,
-	0xee, 0xcd, 0x46, 0xe5, 0x5f, 0x6f, 0x36, 0x2a, 0x7f, 0x7a, 0xbb, 0x71, 0xe1, 0xbb, 0xb7, 0x1b,
-	0x17, 0xfe, 0xf1, 0x76, 0xe3, 0xc2, 0x8b, 0x4f, 0x7a, 0x4c, 0xf7, 0xd3, 0xce, 0x56, 0x20, 0xe2,
-	0x7b, 0x89, 0x14, 0x61, 0x1a, 0x68, 0x15, 0xb0, 0xb1, 0x7f, 0x0b, 0x94, 0x9f, 0x3b, 0xf5, 0x30,
-	0xa1, 0xaa, 0x73, 0x09, 0x5e, 0xf2, 0x3f, 0xfe, 0x3e, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xa1, 0x0c,
-	0x14, 0x46, 0x18, 0x00, 0x00,
+	// 2460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x95, 0x59, 0x49, 0x73, 0xdc, 0xc6,
+	0x15, 0x0e, 0x39, 0x5c, 0xc4, 0x26, 0x25, 0x0e, 0x31, 0x43, 0x72, 0xb8, 0x48, 0xa2, 0xe4, 0xb8,
+	0x6c, 0xcb, 0x31, 0x95, 0xd8, 0x71, 0x16, 0x27, 0x51, 0x85, 0xe2, 0x66, 0x95, 0x45, 0x6b, 0x0c,
+	0x52, 0x52, 0x45, 0x95, 0x2a, 0x54, 0x0f, 0xd0, 0x33, 0xd3, 0x45, 0x0c, 0x00, 0x63, 0xe1, 0xa2,
+	0x9f, 0x90, 0x5c, 0x72, 0xca, 0x21, 0xa7, 0xfc, 0x04, 0xfd, 0x82, 0x9c, 0x73, 0xf4, 0x31, 0xc7,
+	0x54, 0x7c, 0x88, 0xef, 0x39, 0xa4, 0x72, 0xcb, 0x7b, 0xaf, 0xbb, 0x01, 0x0c, 0x38, 0x92, 0xc6,
+	0x07, 0xaa, 0x06, 0xfd, 0xde, 0xf7, 0xf5, 0xf6, 0xd6, 0x16, 0xdb, 0x92, 0x41, 0x57, 0xc4, 0x22,
+	0x70, 0xc5, 0xfd, 0xe2, 0x57, 0xc4, 0x63, 0x3e, 0x48, 0xb6, 0xa3, 0x38, 0x4c, 0x43, 0xab, 0x91,
+	0x8f, 0x6f, 0xe7, 0xbf, 0xd6, 0x97, 0xf8, 0x40, 0x06, 0xe1, 0x7d, 0xfa, 0x57, 0xe9, 0xad, 0x37,
+	0x7b, 0x61, 0x2f, 0xa4, 0x9f, 0xf7, 0xf1, 0x97, 0x1e, 0x5d, 0x73, 0xc3, 0x64, 0x10, 0x26, 0x8e,
+	0x12, 0xa8, 0x0f, 0x25, 0xba, 0xfb, 0xdd, 0x0c, 0x9b, 0x69, 0xd3, 0x4c, 0xd6, 0x2e, 0x5b, 0x10,
+	0x51, 0xe8, 0xf6, 0x1d, 0x35, 0x73, 0x6b, 0x62, 0x6b, 0xe2, 0xfd, 0xf9, 0x8f, 0xb7, 0xb6, 0x47,
+	0x4c, 0xbd, 0xbd, 0x8f, 0x8a, 0x0a, 0x67, 0xcf, 0x8b, 0xe2, 0xc3, 0xb2, 0xd9, 0xd2, 0x19, 0xf7,
+	0xa5, 0xc7, 0x53, 0x19, 0x06, 0x86, 0x69, 0x92, 0x98, 0xde, 0x1d, 0xc9, 0xf4, 0x2c, 0xd7, 0xd6,
+	0x74, 0xf5, 0xb3, 0xca, 0x88, 0xf5, 0x1b, 0xc6, 0x60, 0x06, 0x43, 0x56, 0x23, 0xb2, 0x5b, 0x23,
+	0xc9, 0xda, 0xa1, 0xab, 0x59, 0xe6, 0x22, 0xf3, 0x13, 0x97, 0x94, 0x86, 0xa7, 0x22, 0x08, 0x07,
+	0xd2, 0x4d, 0x0c, 0xcb, 0xd4, 0x1b, 0x96, 0x74, 0x92, 0x6b, 0x9b, 0x25, 0xa5, 0x95, 0x11, 0xe4,
+	0x74, 0x43, 0xdf, 0xe7, 0xa9, 0x88, 0xb9, 0x6f, 0x38, 0xa7, 0xdf, 0xc0, 0xb9, 0x9b, 0x6b, 0x1b,
+	0x4e, 0xb7, 0x32, 0x62, 0xfd, 0x9e, 0x2d, 0x77, 0x64, 0xea, 0x86, 0x32, 0x70, 0x62, 0x71, 0xce,
+	0x63, 0xcf, 0xf0, 0xce, 0x10, 0xef, 0xfb, 0x23, 0x79, 0x1f, 0x2a, 0x84, 0x4d, 0x00, 0x4d, 0xdd,
+	0xe8, 0x5c, 0x1d, 0xb4, 0x1c, 0xb6, 0xe2, 0x5d, 0x06, 0x60, 0x2b, 0x70, 0x90, 0xb1, 0x74, 0x65,
+	0xd0, 0x33, 0xf4, 0xb3, 0x44, 0xff, 0xc1, 0x48, 0xfa, 0x3d, 0x05, 0x69, 0x2b, 0x84, 0xe6, 0x6f,
+	0x7a, 0x23, 0x46, 0xad, 0x0e, 0x5b, 0xed, 0xf0, 0xc0, 0x3b, 0x97, 0x5e, 0xda, 0x77, 0x7c, 0x39,
+	0x90, 0x69, 0x7e, 0xd8, 0xd7, 0x68, 0x86, 0x7b, 0xa3, 0x37, 0x60, 0x30, 0x8f, 0x09, 0xa2, 0xa7,
+	0x58, 0xee, 0x8c, 0x1a, 0xb6, 0x0e, 0xd8, 0xf5, 0x4e, 0x2c, 0xbd, 0x9e, 0x30, 0xcc, 0x73, 0xc4,
+	0x7c, 0x67, 0x34, 0x33, 0x69, 0x6a, 0xc2, 0x85, 0x4e, 0xe9, 0xcb, 0x7a, 0xcc, 0x16, 0xd3, 0x98,
+	0xcb, 0xa0, 0x74, 0x0a, 0x8c, 0x98, 0xde, 0x19, 0x6d, 0x10, 0x5a, 0x57, 0x73, 0xdd, 0x48, 0x87,
+	0xbe, 0x3f, 0x7b, 0xf7, 0xbb, 0xbf, 0xde, 0x9e, 0xf8, 0xc3, 0xbf, 0x5f, 0xdd, 0xdb, 0x2c, 0xbc,
+	0xf7, 0xa2, 0xe4, 0xc9, 0x4a, 0xed, 0xee, 0xab, 0x59, 0xb6, 0x74, 0x28, 0x02, 0x91, 0xc8, 0xe4,
+	0x49, 0xe0, 0x5f, 0xea, 0xa5, 0xdc, 0x61, 0x0b, 0x69, 0x98, 0x82, 0x11, 0x25, 0x59, 0x14, 0xf9,
+	0x97, 0xe4, 0x75, 0x35, 0x7b, 0x9e, 0xc6, 0x8e, 0x69, 0xc8, 0xfa, 0x90, 0x2d, 0x85, 0xb1, 0xec,
+	0xc9, 0x80, 0xa7, 0x61, 0x6c, 0xf4, 0x26, 0x49, 0xaf, 0x5e, 0x08, 0xb4, 0xf2, 0x3d, 0xb4, 0xf6,
+	0xc8, 0x58, 0x10, 0x1f, 0x84, 0x59, 0x90, 0x92, 0xcf, 0xd4, 0xec, 0x45, 0x10, 0x28, 0x9b, 0xd8,
+	0xa1, 0x61, 0xeb, 0xa7, 0x6c, 0x25, 0x49, 0xe1, 0xa0, 0x51, 0x73, 0x18, 0x30, 0x45, 0x80, 0xa6,
+	0x91, 0x0e, 0xa1, 0x7e, 0xc5, 0xd6, 0xa3, 0x58, 0x60, 0x30, 0xe9, 0xc1, 0x0e, 0x06, 0xc2, 0x73,
+	0x12, 0xee, 0x0b, 0x83, 0x9c, 0x26, 0xe4, 0x2a, 0x68, 0xb4, 0x73, 0x85, 0x63, 0x90, 0x6b, 0xf0,
+	0x6d, 0x36, 0x5f, 0x2c, 0x4f, 0x99, 0xf6, 0xb4, 0xcd, 0xf2, 0x85, 0xd1, 0x79, 0xa8, 0x1d, 0x3a,
+	0x1e, 0xba, 0x1c, 0x59, 0xe7, 0x9c, 0x3d, 0xaf, 0xc6, 0xf6, 0x70, 0xa8, 0xb2, 0xc5, 0x48, 0xc4,
+	0x32, 0xf4, 0xc8, 0xc6, 0xca, 0x5b, 0x6c, 0xd3, 0xb0, 0xf5, 0x23, 0x66, 0x95, 0x75, 0xf9, 0x65,
+	0x98, 0xa5, 0xca, 0x6c, 0x6a, 0xe8, 0xd6, 0x46, 0x59, 0x8d, 0x5b, 0x0f, 0xd8, 0xe6, 0x55, 0x6d,
+	0x9c, 0xc1, 0x81, 0x18, 0x2b, 0x62, 0x32, 0x92, 0x9a, 0xdd, 0xaa, 0xe2, 0x60, 0xae, 0x23, 0x94,
+	0x5b, 0x9f, 0xb2, 0xd5, 0x12, 0x7e, 0xc0, 0x2f, 0x1c, 0x2f, 0x8b, 0x29, 0x94, 0xb5, 0xe6, 0xd5,
+	0x89, 0xe6, 0xd0, 0x23, 0x7e, 0xb1, 0xa7, 0x65, 0x96, 0xcb, 0x6e, 0xa3, 0xae, 0x0c, 0x3c, 0x79,
+	0x26, 0xbd, 0x0c, 0x23, 0x4a, 0x78, 0x0e, 0x93, 0xc2, 0xc4, 0xae, 0x08, 0x52, 0xde, 0x13, 0xad,
+	0x05, 0x32, 0xcf, 0xcd, 0xd1, 0x4e, 0x2a, 0x5c, 0x39, 0xe0, 0xbe, 0xbd, 0x09, 0x24, 0x8f, 0x72,
+	0x8e, 0x36, 0x52, 0xb4, 0x73, 0x06, 0xeb, 0x17, 0xac, 0xd5, 0x53, 0xd6, 0xe7, 0xf4, 0x32, 0x58,
+	0x80, 0xe4, 0x81, 0x23, 0x02, 0xde, 0xf1, 0x85, 0xd7, 0xba, 0x0e, 0xec, 0xd7, 0xec, 0x15, 0x2d,
+	0x3f, 0xd4, 0xe2, 0x7d, 0x25, 0x85, 0xc0, 0xf4, 0xe1, 0x15, 0x64, 0x20, 0xd2, 0xf3, 0x30, 0x3e,
+	0x85, 0x3d, 0xa6, 0x59, 0x2c, 0xd3, 0x4b, 0x27, 0xed, 0xc7, 0x22, 0xe9, 0x87, 0xbe, 0xd7, 0xba,
+	0x41, 0x3b, 0x7d, 0xaf, 0x42, 0xf6, 0xa5, 0x02, 0x1c, 0x69, 0xfd, 0x13, 0xa3, 0x0e, 0xec, 0x1b,
+	0x57, 0xd8, 0x07, 0x99, 0x9f, 0xca, 0xc8, 0x97, 0x70, 0xe4, 0x8b, 0x63, 0x6c, 0x7c, 0xad, 0x32,
+	0xd7, 0x51, 0x0e, 0xb7, 0x7e, 0xcd, 0xd6, 0xaf, 0xb0, 0x73, 0xcf, 0x83, 0xc9, 0x13, 0x91, 0xb4,
+	0xea, 0x5b, 0x35, 0x30, 0xae, 0x56, 0x05, 0xbe, 0x63, 0xe4, 0x77, 0xff, 0x32, 0xcd, 0xea, 0xd5,
+	0x6c, 0x60, 0xbd, 0x60, 0xeb, 0x49, 0xd6, 0x49, 0xa4, 0x77, 0x09, 0x17, 0xed, 0x65, 0x2e, 0x65,
+	0x3a, 0x19, 0x40, 0x28, 0x87, 0xcc, 0xa5, 0xb3, 0xe6, 0x9b, 0xd7, 0xdb, 0xd2, 0x78, 0xdb, 0xc0,
+	0x1f, 0x69, 0xb4, 0xf5, 0x8c, 0xb5, 0xae, 0x72, 0x6b, 0xcf, 0x9a, 0x1c, 0x83, 0x79, 0xa5, 0xca,
+	0xac, 0xdd, 0x0e, 0xd6, 0xec, 0x66, 0x31, 0xe8, 0xa6, 0x8e, 0xe1, 0x2f, 0x19, 0x57, 0x6d, 0x9c,
+	0x35, 0x6b, 0xfc, 0xb1, 0x82, 0x97, 0x0c, 0xeb, 0x77, 0x6c, 0xbd, 0x1c, 0x71, 0x7c, 0x1f, 0x0c,
+	0xcf, 0x73, 0xba, 0x5c, 0xfa, 0x59, 0x2c, 0x74, 0xa2, 0x7d, 0x33, 0xf7, 0x6a, 0x11, 0x98, 0x14,
+	0xfa, 0x40, 0x81, 0x21, 0xf3, 0x6f, 0x20, 0x35, 0x39, 0x9f, 0x83, 0x35, 0xc0, 0xd7, 0x60, 0xd8,
+	0xb2, 0x2b, 0x5d, 0xe5, 0x53, 0xd3, 0xb9, 0x3b, 0x92, 0xfb, 0x41, 0xf6, 0xff, 0xaa, 0x2c, 0xb7,
+	0xb6, 0x59, 0x83, 0x8c, 0xf4, 0x4c, 0x24, 0x29, 0x85, 0x7a, 0x15, 0x2a, 0x30, 0xe8, 0x4c, 0xd9,
+	0x4b, 0x28, 0x7a, 0xa6, 0x24, 0x3a, 0x58, 0x7c, 0xcc, 0x96, 0xf5, 0x2e, 0x2a, 0x88, 0x59, 0x42,
+	0x34, 0x94, 0x70, 0x18, 0xf3, 0x73, 0xd6, 0x2a, 0x96, 0x58, 0x81, 0x5d, 0x23, 0xd8, 0xb2, 0x59,
+	0xdf, 0x30, 0xf0, 0x97, 0x6c, 0xcd, 0xf5, 0xb9, 0x1c, 0x38, 0x10, 0x24, 0x5d, 0xa1, 0x21, 0x0e,
+	0xd5, 0x52, 0x2a, 0x40, 0x4d, 0xd9, 0x2b, 0xa4, 0x70, 0x88, 0x72, 0x05, 0xa2, 0xb2, 0x2b, 0xf9,
+	0x6c, 0x0a, 0x13, 0x0e, 0x1a, 0xe7, 0x7c, 0xa9, 0x0e, 0xc3, 0xc8, 0xa9, 0xea, 0x37, 0x5f, 0x04,
+	0xbd, 0xb4, 0x6f, 0x32, 0x09, 0x8d, 0x3d, 0xa6, 0x21, 0xeb, 0x03, 0x56, 0x57, 0x2a, 0x25, 0x07,
+	0x53, 0x89, 0x64, 0x91, 0xc6, 0x4b, 0x8e, 0x03, 0x81, 0x5a, 0xa9, 0x26, 0x7d, 0xd9, 0x35, 0x19,
+	0x84, 0xd1, 0xd0, 0x31, 0x8e, 0x58, 0xbf, 0x65, 0x37, 0x3d, 0xd1, 0xe5, 0x80, 0x70, 0xb2, 0x40,
+	0xa6, 0x4e, 0xd8, 0x75, 0xdc, 0x70, 0x10, 0x65, 0xa9, 0xa0, 0x02, 0x43, 0xe8, 0x1c, 0xb2, 0xa6,
+	0x95, 0x9e, 0x82, 0xce, 0x93, 0xee, 0xae, 0xd2, 0xc0, 0xca, 0x41, 0x60, 0x6c, 0xc6, 0x3b, 0x4d,
+	0xd0, 0x8a, 0x8a, 0x40, 0xa9, 0x2e, 0xb5, 0x0e, 0x92, 0x63, 0x14, 0xe4, 0x41, 0x12, 0x2e, 0x07,
+	0xb5, 0xc5, 0x85, 0xdb, 0xe7, 0x41, 0x19, 0x30, 0x43, 0x80, 0x06, 0x08, 0xf7, 0xb5, 0x2c, 0xc7,
+	0xfc, 0x98, 0x35, 0x11, 0x53, 0xaa, 0x48, 0x3d, 0xe1, 0xf3, 0x4b, 0xba, 0xcf, 0x9a, 0x8d, 0xb3,
+	0x17, 0xe5, 0xe7, 0x1e, 0x4a, 0xac, 0x9f, 0xb1, 0xd5, 0x2a, 0xc2, 0xcc, 0xa3, 0x32, 0xcc, 0xf2,
+	0x30, 0xc8, 0xcc, 0x04, 0x66, 0x90, 0x88, 0x14, 0xc2, 0xe2, 0xb9, 0xc1, 0x86, 0x71, 0xa2, 0x67,
+	0x53, 0xd9, 0x66, 0x19, 0xe4, 0x5f, 0x8a, 0xf3, 0x67, 0xb9, 0x54, 0x4d, 0xf8, 0x80, 0x6d, 0xe4,
+	0x0e, 0x51, 0x9e, 0xd6, 0xcd, 0xd2, 0xb0, 0xdb, 0xd5, 0x19, 0x67, 0x2d, 0x57, 0x29, 0xa6, 0xde,
+	0x25, 0x05, 0xeb, 0x11, 0xbb, 0x53, 0xe0, 0xa3, 0x38, 0xa3, 0x9a, 0x46, 0xdd, 0x5c, 0x11, 0x92,
+	0xe7, 0xc9, 0x9c, 0x6e, 0xe5, 0x8a, 0x6d, 0xa5, 0x47, 0xd6, 0x53, 0x44, 0xe2, 0x3d, 0x76, 0xfb,
+	0x2a, 0x15, 0x26, 0x26, 0x4c, 0x80, 0x1d, 0x3f, 0x74, 0x4f, 0x29, 0x0d, 0x4d, 0xd9, 0x1b, 0x55,
+	0x22, 0x48, 0x66, 0x60, 0xa0, 0x0f, 0x51, 0x45, 0x1b, 0xe7, 0xff, 0x66, 0x59, 0xbd, 0x5a, 0xda,
+	0x43, 0xd9, 0xd5, 0xe8, 0x72, 0x3f, 0x01, 0xf2, 0x30, 0x91, 0xa9, 0x3c, 0x13, 0x0e, 0x9c, 0x9e,
+	0x18, 0x2b, 0x64, 0x2e, 0x11, 0xb0, 0xad, 0x71, 0x36, 0xc0, 0xf0, 0xc8, 0x07, 0x58, 0x2b, 0xf3,
+	0x41, 0xe4, 0x64, 0xe0, 0x81, 0x82, 0x27, 0x10, 0x33, 0x06, 0x10, 0x95, 0x54, 0xc7, 0x31, 0x6d,
+	0x2f, 0x83, 0xdc, 0x06, 0xf1, 0xd3, 0xe8, 0xa8, 0x24, 0x84, 0x02, 0x86, 0x45, 0x3c, 0x49, 0xf0,
+	0xb4, 0xb3, 0xf1, 0x82, 0xdf, 0x1c, 0xea, 0x3f, 0x43, 0x75, 0xa8, 0xfc, 0x57, 0x70, 0xd6, 0xd2,
+	0x4d, 0xf1, 0x33, 0x28, 0xe2, 0x7b, 0xe3, 0x45, 0xba, 0x26, 0x60, 0x8b, 0x63, 0xd9, 0x51, 0x48,
+	0xe2, 0x84, 0x63, 0x1e, 0xc1, 0x39, 0x3d, 0x16, 0x27, 0xbf, 0xb8, 0xca, 0x09, 0x45, 0xa3, 0xb8,
+	0x88, 0xa4, 0x32, 0x4f, 0x75, 0x7b, 0x89, 0x76, 0x95, 0x7a, 0x21, 0xa0, 0x2b, 0x4b, 0xac, 0xbb,
+	0xec, 0xba, 0x0a, 0x3c, 0x4e, 0x1a, 0xe2, 0x8d, 0x6b, 0x07, 0x51, 0x11, 0x20, 0x39, 0x09, 0xe1,
+	0x7e, 0xa1, 0x3d, 0xbc, 0xd5, 0xcd, 0x7c, 0xbf, 0xbc, 0x4a, 0xa8, 0x83, 0xbb, 0x10, 0x6c, 0x8d,
+	0xad, 0x2a, 0x07, 0xd9, 0x40, 0xad, 0x62, 0x3d, 0x27, 0x4a, 0x47, 0x5b, 0xeb, 0xd5, 0xd3, 0xeb,
+	0x73, 0xbf, 0x7b, 0xae, 0x9d, 0xe4, 0xfb, 0x9d, 0xde, 0xe7, 0x0a, 0x69, 0xed, 0xb0, 0x9b, 0x15,
+	0xce, 0xca, 0xba, 0x94, 0x0f, 0xad, 0x0f, 0x81, 0x47, 0x2c, 0x2b, 0x49, 0x4a, 0x39, 0xd1, 0x60,
+	0xe7, 0xc7, 0x5b, 0x56, 0x92, 0x14, 0x09, 0x51, 0x73, 0xb6, 0xd9, 0x32, 0x71, 0xc6, 0xe2, 0xeb,
+	0x0c, 0x02, 0x3f, 0x92, 0x07, 0xdc, 0x4f, 0x2f, 0xc7, 0x2a, 0xe5, 0x1a, 0x08, 0xb5, 0x35, 0xb2,
+	0xad, 0x80, 0xd6, 0x4f, 0x58, 0x33, 0x95, 0x03, 0x18, 0x41, 0x8b, 0x2f, 0xee, 0x90, 0xaa, 0x37,
+	0x08, 0x80, 0xb9, 0x6c, 0x3f, 0x17, 0xa1, 0x15, 0x14, 0x10, 0xee, 0x9d, 0x71, 0x98, 0x44, 0x17,
+	0x68, 0xf5, 0x5c, 0xb0, 0xa3, 0xc6, 0x31, 0xa2, 0x63, 0x86, 0x82, 0x92, 0x0e, 0xf2, 0xb7, 0xe9,
+	0xe0, 0x8c, 0xeb, 0x3b, 0xa7, 0x1d, 0xaa, 0xc5, 0xa6, 0xec, 0xb5, 0x5c, 0x49, 0xf7, 0x66, 0xda,
+	0xf3, 0xbf, 0xe8, 0x68, 0xdf, 0xff, 0xdb, 0x04, 0x9b, 0xcb, 0x3b, 0x71, 0xeb, 0x23, 0x66, 0x99,
+	0x3c, 0xe1, 0x49, 0x3c, 0xf3, 0x0c, 0x0f, 0x61, 0x82, 0x1c, 0x74, 0x49, 0x4b, 0xf6, 0x72, 0x01,
+	0xf6, 0x24, 0xa5, 0x9b, 0x4c, 0x60, 0x79, 0xd0, 0x5b, 0x24, 0xf2, 0xa5, 0xd0, 0x3e, 0xdd, 0x2c,
+	0xa4, 0xc7, 0x24, 0x3c, 0x06, 0x19, 0x34, 0x86, 0x5b, 0x18, 0xb6, 0x61, 0x9c, 0xbf, 0x36, 0x08,
+	0xd6, 0x68, 0xf5, 0x9b, 0x30, 0xba, 0x07, 0x6a, 0x23, 0x43, 0xa0, 0xde, 0xc0, 0x0e, 0x9b, 0xd5,
+	0x17, 0x61, 0x35, 0xd9, 0xb4, 0x0a, 0x13, 0x2a, 0x9b, 0xaa, 0x0f, 0x6b, 0x9d, 0x5d, 0x83, 0xf3,
+	0x0f, 0x03, 0xa1, 0xcb, 0xb2, 0x69, 0x3b, 0xff, 0xd6, 0x14, 0x7f, 0x9c, 0x62, 0xf5, 0x6a, 0xcf,
+	0x8f, 0x66, 0x96, 0xf8, 0x3c, 0xe9, 0x3b, 0x5d, 0x48, 0xe9, 0xba, 0x6c, 0xa4, 0xed, 0x8c, 0x15,
+	0x02, 0x9b, 0x84, 0x3d, 0xd0, 0xd0, 0x47, 0x0a, 0x69, 0x9d, 0xb0, 0xd5, 0x0a, 0xa7, 0x17, 0x9e,
+	0x07, 0x78, 0xb7, 0x63, 0x15, 0x8c, 0xcb, 0x43, 0xa4, 0x7b, 0x1a, 0x6a, 0x0d, 0xd8, 0x0f, 0x0d,
+	0x8d, 0x83, 0xa6, 0x28, 0xbc, 0xb2, 0x6f, 0x0c, 0x9f, 0xe9, 0xdb, 0xa6, 0xb8, 0x63, 0x98, 0x8e,
+	0x88, 0xa8, 0x70, 0x94, 0x22, 0xf3, 0x7c, 0xc2, 0x56, 0x86, 0xab, 0xa0, 0x40, 0x57, 0x42, 0x14,
+	0x54, 0xa1, 0xf2, 0xea, 0x95, 0x6a, 0xa0, 0x40, 0x95, 0x41, 0xd6, 0xe7, 0x6c, 0xa9, 0xc3, 0x21,
+	0x99, 0x9c, 0x0b, 0xd9, 0xeb, 0xa7, 0x0e, 0x59, 0xfc, 0x58, 0x01, 0x73, 0x11, 0x61, 0xcf, 0x09,
+	0x65, 0x23, 0x08, 0x2b, 0xd8, 0xf2, 0x6b, 0x0e, 0x98, 0xbc, 0xe6, 0xc4, 0xc2, 0x46, 0x3f, 0xbf,
+	0xbc, 0xa5, 0x82, 0x2d, 0xbd, 0xe6, 0x88, 0x58, 0x71, 0x63, 0xc5, 0xa3, 0xad, 0xe1, 0x3f, 0x35,
+	0xd6, 0x18, 0xf1, 0x52, 0x83, 0x05, 0x6a, 0x06, 0x3b, 0x18, 0x7e, 0xf6, 0x51, 0x2f, 0x6f, 0xd7,
+	0xec, 0x25, 0x10, 0x0d, 0x81, 0x12, 0xac, 0x67, 0x24, 0xb0, 0x4a, 0x58, 0xa5, 0xb2, 0x6e, 0x85,
+	0xa0, 0x9b, 0x9e, 0xb2, 0x2d, 0x2d, 0xa3, 0xe3, 0x51, 0x10, 0xcc, 0x75, 0x9e, 0x70, 0xf9, 0xa5,
+	0xca, 0xb4, 0x63, 0xe5, 0x3a, 0xd2, 0xa7, 0x0c, 0xfb, 0x0e, 0xbb, 0x6e, 0x9a, 0xa7, 0xf2, 0x6d,
+	0x2c, 0xe8, 0x41, 0x75, 0x0d, 0xd0, 0xb2, 0x64, 0xa9, 0xf4, 0xe5, 0x4b, 0x9d, 0x69, 0xc2, 0x20,
+	0x4b, 0xa0, 0xfa, 0x77, 0xa1, 0xc4, 0x19, 0xeb, 0x36, 0x56, 0x4a, 0xe8, 0x87, 0x08, 0x3e, 0x20,
+	0x2c, 0x5e, 0x0a, 0xe5, 0x1b, 0x37, 0x54, 0x19, 0x6d, 0x98, 0x79, 0xac, 0x4b, 0x41, 0xfc, 0xae,
+	0x86, 0x97, 0xa9, 0x1d, 0x76, 0x33, 0xe2, 0x31, 0x1d, 0xe3, 0x68, 0xf6, 0xd9, 0x31, 0xd8, 0xd7,
+	0x35, 0xc5, 0x88, 0x09, 0xf4, 0xad, 0xbf, 0x9a, 0x62, 0xcd, 0x51, 0x0f, 0x68, 0xb8, 0x35, 0x08,
+	0xbc, 0x1d, 0xd8, 0x36, 0x34, 0xce, 0x2f, 0x21, 0x6c, 0x38, 0x3e, 0xf5, 0xfb, 0x1d, 0xe8, 0xd5,
+	0xc6, 0x8b, 0x05, 0xab, 0x39, 0xfe, 0x05, 0xc0, 0x1f, 0x23, 0xfa, 0x21, 0x82, 0x47, 0x50, 0x67,
+	0x51, 0x94, 0x53, 0x4f, 0x7e, 0x6f, 0xea, 0xa7, 0x88, 0x56, 0xd4, 0x87, 0xac, 0x4e, 0x85, 0xbd,
+	0x03, 0x75, 0x2b, 0x64, 0x00, 0x17, 0x34, 0xc6, 0x32, 0xa8, 0x45, 0x42, 0xed, 0xe7, 0x20, 0x2c,
+	0x79, 0xcb, 0x16, 0x73, 0x2e, 0x03, 0x88, 0x10, 0x45, 0x9d, 0xad, 0x8c, 0x6c, 0xad, 0xa4, 0xf2,
+	0x9c, 0x34, 0xf2, 0x5a, 0xfb, 0x23, 0xd6, 0xc0, 0x84, 0x8f, 0xdb, 0xa2, 0x87, 0x59, 0xdd, 0x6f,
+	0x4c, 0x13, 0xae, 0x0e, 0x22, 0x70, 0x41, 0xea, 0xda, 0x55, 0x9b, 0x71, 0x9f, 0x35, 0x29, 0x4e,
+	0x54, 0xf5, 0x75, 0x1b, 0x88, 0xb2, 0x61, 0xc0, 0xeb, 0xa3, 0xd1, 0xec, 0xeb, 0xa3, 0xd1, 0x03,
+	0xb6, 0x39, 0x04, 0xaa, 0xce, 0xa6, 0x7a, 0xc1, 0x56, 0x09, 0x3a, 0x34, 0xa9, 0x36, 0x99, 0xff,
+	0x4e, 0xb0, 0xe5, 0x91, 0x2f, 0xa2, 0x6f, 0x4f, 0xce, 0x13, 0x6f, 0x49, 0xce, 0xd0, 0x29, 0x58,
+	0xa7, 0x1d, 0xc2, 0xc8, 0x00, 0x9a, 0x30, 0xb5, 0xba, 0xb1, 0x4c, 0x62, 0xf1, 0xb4, 0x03, 0x3c,
+	0x8f, 0x10, 0x45, 0x2b, 0xb6, 0xbe, 0x60, 0x0d, 0x4d, 0x15, 0x66, 0x69, 0xc1, 0x35, 0x8e, 0x35,
+	0xd4, 0x89, 0xeb, 0x09, 0xc1, 0x88, 0x4c, 0xef, 0xfc, 0xcf, 0x93, 0xec, 0xc6, 0xf0, 0x3b, 0x2b,
+	0xbe, 0xdd, 0x40, 0xd9, 0x2d, 0x7b, 0x01, 0x96, 0xed, 0xd0, 0x4b, 0x71, 0xcf, 0x87, 0x36, 0xda,
+	0xd4, 0xb2, 0x2a, 0x21, 0xb7, 0x0a, 0x8d, 0x3d, 0xad, 0xa0, 0x6b, 0x5a, 0xe8, 0x17, 0xc1, 0x92,
+	0xe3, 0xab, 0x40, 0xd5, 0xf0, 0x36, 0x48, 0x58, 0xc1, 0x1c, 0xb2, 0xad, 0x20, 0xf4, 0xa0, 0x2d,
+	0x11, 0xa5, 0x89, 0x31, 0x75, 0xc1, 0x36, 0x0d, 0x5c, 0x75, 0xc2, 0x37, 0x51, 0xcf, 0x2e, 0xa9,
+	0x9d, 0x28, 0x2d, 0x4d, 0x04, 0xc5, 0x72, 0x3e, 0xad, 0xdb, 0x17, 0x70, 0x43, 0xe6, 0x81, 0xc8,
+	0xd0, 0xa8, 0xee, 0x78, 0xc3, 0x68, 0xed, 0xa2, 0x92, 0x79, 0x06, 0x52, 0x24, 0xfa, 0x60, 0x9e,
+	0xb3, 0x85, 0xf2, 0x4b, 0x36, 0x94, 0xbb, 0x73, 0x6e, 0x18, 0xa4, 0x98, 0xb1, 0xf1, 0x10, 0x6a,
+	0xaf, 0x7d, 0xb5, 0x56, 0xa8, 0x5d, 0xad, 0x6b, 0x17, 0x28, 0x4d, 0x7c, 0xc0, 0x6e, 0x0c, 0xab,
+	0x60, 0xb1, 0x03, 0x0d, 0xb4, 0x0c, 0xe8, 0x6c, 0xe7, 0x6c, 0xf5, 0x61, 0xb5, 0xd8, 0xac, 0x7e,
+	0x31, 0xa3, 0xa3, 0x9b, 0xb3, 0xcd, 0xa7, 0xe2, 0x79, 0xf8, 0xe4, 0xef, 0xff, 0xba, 0x35, 0xf1,
+	0x0d, 0xfc, 0xfd, 0x13, 0xfe, 0xfe, 0xf4, 0xed, 0xad, 0x1f, 0x7c, 0x03, 0x7f, 0xff, 0x80, 0xbf,
+	0x17, 0x9f, 0xf6, 0x64, 0xda, 0xcf, 0x3a, 0xdb, 0x6e, 0x38, 0xb8, 0x1f, 0xc5, 0x21, 0x3e, 0x49,
+	0x25, 0xae, 0xac, 0xfc, 0x3f, 0x57, 0xf9, 0xa5, 0x3c, 0xbd, 0x8c, 0x44, 0xd2, 0x99, 0xa1, 0xff,
+	0x9a, 0xfa, 0xe4, 0xff, 0x0d, 0x1e, 0x12, 0x91, 0x17, 0x1b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1348,6 +1570,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.BandwidthLimitsParams.Equal(that1.BandwidthLimitsParams) {
 		return false
 	}
+	if !this.BridgeParams.Equal(that1.BridgeParams) {
+		return false
+	}
+	if !this.TrainingParams.Equal(that1.TrainingParams) {
+		return false
+	}
 	return true
 }
 func (this *TokenomicsParams) Equal(that interface{}) bool {
@@ -1393,6 +1621,9 @@ func (this *TokenomicsParams) Equal(that interface{}) bool {
 	if this.TopMinerVestingPeriod != that1.TopMinerVestingPeriod {
 		return false
 	}
+	if this.ClaimGracePeriodEpochs != that1.ClaimGracePeriodEpochs {
+		return false
+	}
 	return true
 }
 func (this *EpochParams) Equal(that interface{}) bool {
@@ -1447,6 +1678,9 @@ func (this *EpochParams) Equal(that interface{}) bool {
 	if this.InferencePruningEpochThreshold != that1.InferencePruningEpochThreshold {
 		return false
 	}
+	if this.InferencePruningMaxPerBlock != that1.InferencePruningMaxPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidationParams) Equal(that interface{}) bool {
@@ -1728,6 +1962,95 @@ func (this *BandwidthLimitsParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TrainingParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TrainingParams)
+	if !ok {
+		that2, ok := that.(TrainingParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AssignmentDeadlineBlocks != that1.AssignmentDeadlineBlocks {
+		return false
+	}
+	if this.StartDeadlineBlocks != that1.StartDeadlineBlocks {
+		return false
+	}
+	if this.NodeReassignmentTimeoutBlocks != that1.NodeReassignmentTimeoutBlocks {
+		return false
+	}
+	if this.DeadlineCheckIntervalBlocks != that1.DeadlineCheckIntervalBlocks {
+		return false
+	}
+	return true
+}
+func (this *BridgeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BridgeParams)
+	if !ok {
+		that2, ok := that.(BridgeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Contracts) != len(that1.Contracts) {
+		return false
+	}
+	for i := range this.Contracts {
+		if !this.Contracts[i].Equal(that1.Contracts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BridgeContract) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BridgeContract)
+	if !ok {
+		that2, ok := that.(BridgeContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Chain != that1.Chain {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1748,6 +2071,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrainingParams != nil {
+		{
+			size, err := m.TrainingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BridgeParams != nil {
+		{
+			size, err := m.BridgeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.BandwidthLimitsParams != nil {
 		{
 			size, err := m.BandwidthLimitsParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1997,6 +2344,11 @@ func (m *TokenomicsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimGracePeriodEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClaimGracePeriodEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.TopMinerVestingPeriod != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TopMinerVestingPeriod))
 		i--
@@ -2088,6 +2440,11 @@ func (m *EpochParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InferencePruningMaxPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InferencePruningMaxPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.InferencePruningEpochThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.InferencePruningEpochThreshold))
 		i--
@@ -2679,30 +3036,147 @@ func (m *BandwidthLimitsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TrainingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TrainingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrainingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochParams != nil {
-		l = m.EpochParams.Size()
-		n += 1 + l + sovParams(uint64(l))
-	}
-	if m.ValidationParams != nil {
-		l = m.ValidationParams.Size()
-		n += 1 + l + sovParams(uint64(l))
+	if m.DeadlineCheckIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeadlineCheckIntervalBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NodeReassignmentTimeoutBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NodeReassignmentTimeoutBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartDeadlineBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StartDeadlineBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AssignmentDeadlineBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AssignmentDeadlineBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochParams != nil {
+		l = m.EpochParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ValidationParams != nil {
+		l = m.ValidationParams.Size()
+		n += 1 + l + sovParams(uint64(l))
 	}
 	if m.PocParams != nil {
 		l = m.PocParams.Size()
@@ -2728,6 +3202,14 @@ func (m *Params) Size() (n int) {
 		l = m.BandwidthLimitsParams.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.BridgeParams != nil {
+		l = m.BridgeParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.TrainingParams != nil {
+		l = m.TrainingParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -2828,6 +3310,9 @@ func (m *TokenomicsParams) Size() (n int) {
 	if m.TopMinerVestingPeriod != 0 {
 		n += 1 + sovParams(uint64(m.TopMinerVestingPeriod))
 	}
+	if m.ClaimGracePeriodEpochs != 0 {
+		n += 1 + sovParams(uint64(m.ClaimGracePeriodEpochs))
+	}
 	return n
 }
 
@@ -2870,6 +3355,9 @@ func (m *EpochParams) Size() (n int) {
 	if m.InferencePruningEpochThreshold != 0 {
 		n += 1 + sovParams(uint64(m.InferencePruningEpochThreshold))
 	}
+	if m.InferencePruningMaxPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.InferencePruningMaxPerBlock))
+	}
 	return n
 }
 
@@ -3089,6 +3577,59 @@ func (m *BandwidthLimitsParams) Size() (n int) {
 	return n
 }
 
+func (m *TrainingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssignmentDeadlineBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AssignmentDeadlineBlocks))
+	}
+	if m.StartDeadlineBlocks != 0 {
+		n += 1 + sovParams(uint64(m.StartDeadlineBlocks))
+	}
+	if m.NodeReassignmentTimeoutBlocks != 0 {
+		n += 1 + sovParams(uint64(m.NodeReassignmentTimeoutBlocks))
+	}
+	if m.DeadlineCheckIntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DeadlineCheckIntervalBlocks))
+	}
+	return n
+}
+
+func (m *BridgeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3412,6 +3953,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgeParams == nil {
+				m.BridgeParams = &BridgeParams{}
+			}
+			if err := m.BridgeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrainingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrainingParams == nil {
+				m.TrainingParams = &TrainingParams{}
+			}
+			if err := m.TrainingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4097,6 +4710,25 @@ func (m *TokenomicsParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimGracePeriodEpochs", wireType)
+			}
+			m.ClaimGracePeriodEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimGracePeriodEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4356,6 +4988,25 @@ func (m *EpochParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferencePruningMaxPerBlock", wireType)
+			}
+			m.InferencePruningMaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InferencePruningMaxPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5921,6 +6572,330 @@ func (m *BandwidthLimitsParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrainingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentDeadlineBlocks", wireType)
+			}
+			m.AssignmentDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignmentDeadlineBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDeadlineBlocks", wireType)
+			}
+			m.StartDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDeadlineBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeReassignmentTimeoutBlocks", wireType)
+			}
+			m.NodeReassignmentTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeReassignmentTimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineCheckIntervalBlocks", wireType)
+			}
+			m.DeadlineCheckIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineCheckIntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, &BridgeContract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
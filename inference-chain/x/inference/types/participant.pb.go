@@ -71,6 +71,8 @@ type Participant struct {
 	WorkerPublicKey              string             `protobuf:"bytes,12,opt,name=worker_public_key,json=workerPublicKey,proto3" json:"worker_public_key,omitempty"`
 	EpochsCompleted              uint32             `protobuf:"varint,13,opt,name=epochs_completed,json=epochsCompleted,proto3" json:"epochs_completed,omitempty"`
 	CurrentEpochStats            *CurrentEpochStats `protobuf:"bytes,14,opt,name=current_epoch_stats,json=currentEpochStats,proto3" json:"current_epoch_stats,omitempty"`
+	// Self-declared coarse location, e.g. "asia" or "eu-west", used as an executor selection hint
+	Region string `protobuf:"bytes,15,opt,name=region,proto3" json:"region,omitempty"`
 }
 
 func (m *Participant) Reset()         { *m = Participant{} }
@@ -204,6 +206,13 @@ func (m *Participant) GetCurrentEpochStats() *CurrentEpochStats {
 	return nil
 }
 
+func (m *Participant) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
 type CurrentEpochStats struct {
 	InferenceCount        uint64 `protobuf:"varint,1,opt,name=inference_count,json=inferenceCount,proto3" json:"inference_count,omitempty"`
 	MissedRequests        uint64 `protobuf:"varint,2,opt,name=missed_requests,json=missedRequests,proto3" json:"missed_requests,omitempty"`
@@ -307,48 +316,49 @@ func init() {
 }
 
 var fileDescriptor_d2bc555767052d80 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x5d, 0x6f, 0xd3, 0x3c,
-	0x14, 0xc7, 0x9b, 0x2d, 0x6d, 0xb7, 0x93, 0xbe, 0xba, 0x7b, 0xa6, 0x48, 0xcf, 0xa3, 0x3c, 0xdd,
-	0xd0, 0xa0, 0xec, 0xa2, 0x13, 0xa0, 0xdd, 0x22, 0x6d, 0x5d, 0x81, 0x08, 0x28, 0x55, 0xf6, 0x22,
-	0xc4, 0x4d, 0x94, 0x3a, 0x66, 0x35, 0x4b, 0x93, 0x60, 0x3b, 0x7b, 0xf9, 0x16, 0x7c, 0x20, 0x3e,
-	0x00, 0x97, 0xbb, 0xe4, 0x12, 0x6d, 0xe2, 0x7b, 0x20, 0xdb, 0x6d, 0x5a, 0x36, 0xb8, 0xf3, 0xf9,
-	0xfd, 0xff, 0xe7, 0xf8, 0xd8, 0xc7, 0x32, 0x6c, 0xd1, 0xf8, 0x23, 0x61, 0x24, 0xc6, 0x64, 0x67,
-	0xbe, 0x4a, 0x03, 0x26, 0x28, 0xa6, 0x69, 0x10, 0x8b, 0x6e, 0xca, 0x12, 0x91, 0xa0, 0x56, 0x2e,
-	0x76, 0xf3, 0xd5, 0xe6, 0x4f, 0x13, 0xac, 0xe1, 0xdc, 0x8a, 0xd6, 0xa0, 0x48, 0xe3, 0x90, 0x5c,
-	0xda, 0x46, 0xdb, 0xe8, 0xac, 0x7a, 0x3a, 0x40, 0x36, 0x94, 0x83, 0x30, 0x64, 0x84, 0x73, 0x7b,
-	0x49, 0xf1, 0x59, 0x88, 0xd6, 0xa1, 0x74, 0x41, 0xe8, 0xe9, 0x58, 0xd8, 0xcb, 0x6d, 0xa3, 0x53,
-	0xf4, 0xa6, 0x11, 0xfa, 0x17, 0x56, 0x3f, 0x25, 0x34, 0xf6, 0x05, 0x9d, 0x10, 0xdb, 0x6c, 0x1b,
-	0x9d, 0x65, 0x6f, 0x45, 0x82, 0x23, 0x3a, 0x21, 0xe8, 0x7f, 0xb0, 0x94, 0x38, 0xd6, 0x99, 0x45,
-	0x25, 0x83, 0x44, 0xaf, 0x74, 0x76, 0x17, 0x5a, 0x51, 0xc0, 0x85, 0x9f, 0xf7, 0xa9, 0xeb, 0x94,
-	0x94, 0xb1, 0x29, 0x25, 0x77, 0xa6, 0xa8, 0x82, 0x0f, 0xa0, 0x3a, 0xb7, 0x66, 0x2c, 0xb2, 0xcb,
-	0xaa, 0xcb, 0x4a, 0x0e, 0x8f, 0x59, 0x84, 0x9e, 0x43, 0x89, 0x8b, 0x40, 0x64, 0xdc, 0x5e, 0x69,
-	0x1b, 0x9d, 0xda, 0xd3, 0x87, 0xdd, 0x3f, 0x5c, 0x48, 0x77, 0xe1, 0x32, 0x0e, 0x95, 0xdb, 0x9b,
-	0x66, 0xa1, 0x0d, 0xa8, 0x60, 0xd9, 0xf5, 0x28, 0x88, 0x82, 0x18, 0x13, 0x7b, 0x55, 0x75, 0x63,
-	0x49, 0xb6, 0xaf, 0x91, 0xec, 0xe3, 0x3c, 0x88, 0x68, 0x18, 0x88, 0x84, 0xf9, 0x67, 0xe4, 0xca,
-	0x06, 0xdd, 0x47, 0x0e, 0x5f, 0x93, 0x2b, 0x74, 0x00, 0x0e, 0x4e, 0x62, 0x4e, 0x70, 0x26, 0xe8,
-	0x39, 0xf1, 0x69, 0xac, 0xd4, 0xf9, 0x59, 0xb9, 0x6d, 0xa9, 0xca, 0xff, 0x2d, 0xb8, 0x5c, 0x6d,
-	0xca, 0x4f, 0xcd, 0xd1, 0x36, 0x34, 0x2f, 0x12, 0x76, 0x46, 0x98, 0x9f, 0x66, 0xa3, 0x88, 0x62,
-	0xb5, 0x5d, 0x45, 0x6d, 0x57, 0xd7, 0xc2, 0x50, 0x71, 0xb9, 0xe3, 0x63, 0x68, 0x90, 0x34, 0xc1,
-	0x63, 0xee, 0xe3, 0x64, 0x92, 0x46, 0x44, 0x90, 0xd0, 0xae, 0xb6, 0x8d, 0x4e, 0xd5, 0xab, 0x6b,
-	0xde, 0x9b, 0x61, 0x74, 0x02, 0x2d, 0x9c, 0x31, 0x46, 0x62, 0xe1, 0x2b, 0xc9, 0x97, 0x87, 0xe7,
-	0x76, 0xad, 0x6d, 0x74, 0xac, 0xbf, 0xdc, 0x58, 0x4f, 0xfb, 0xfb, 0xd2, 0x2e, 0xaf, 0x8c, 0x7b,
-	0x4d, 0x7c, 0x17, 0x6d, 0x7e, 0x5d, 0x82, 0xe6, 0x3d, 0x23, 0x7a, 0x04, 0xf5, 0xf9, 0xdc, 0x70,
-	0x92, 0xc5, 0x42, 0xbd, 0x3b, 0xd3, 0xab, 0xe5, 0xb8, 0x27, 0xa9, 0x34, 0x4e, 0x28, 0xe7, 0x24,
-	0xf4, 0x19, 0xf9, 0x9c, 0x11, 0x2e, 0xf4, 0x43, 0x34, 0xbd, 0x9a, 0xc6, 0xde, 0x94, 0xca, 0x21,
-	0x91, 0x80, 0xc5, 0x24, 0xf4, 0xe5, 0x5c, 0xb8, 0x7a, 0x95, 0xa6, 0x67, 0x69, 0xd6, 0x93, 0x08,
-	0x6d, 0x41, 0x8d, 0x91, 0x8b, 0x80, 0x85, 0xb9, 0xc9, 0x54, 0xa6, 0xea, 0x8c, 0x6a, 0xdb, 0x06,
-	0x54, 0x46, 0xd9, 0x42, 0xa5, 0xa2, 0xae, 0xa4, 0x99, 0xb6, 0x3c, 0x81, 0xb5, 0xe9, 0x64, 0xc9,
-	0x6f, 0xf3, 0x2b, 0x29, 0x6b, 0x2b, 0xd7, 0x16, 0xc6, 0xb6, 0x0b, 0xeb, 0xd3, 0x81, 0xdf, 0x4d,
-	0x2a, 0xab, 0xa4, 0x7f, 0x16, 0xd4, 0x79, 0xda, 0xf6, 0x7b, 0x68, 0xde, 0x7b, 0x98, 0xa8, 0x0e,
-	0xd6, 0xf1, 0xe0, 0x70, 0xd8, 0xef, 0xb9, 0x2f, 0xdc, 0xfe, 0x41, 0xa3, 0x80, 0x00, 0x4a, 0x7b,
-	0xbd, 0x23, 0xf7, 0xa4, 0xdf, 0x30, 0x50, 0x05, 0x56, 0xdc, 0xc1, 0x34, 0x5a, 0x42, 0x16, 0x94,
-	0xdd, 0xc1, 0xc9, 0xde, 0x1b, 0xf7, 0xa0, 0xb1, 0x2c, 0x03, 0x6f, 0xef, 0xed, 0xd0, 0x1d, 0xbc,
-	0x6c, 0x98, 0xfb, 0xef, 0xbe, 0xdd, 0x38, 0xc6, 0xf5, 0x8d, 0x63, 0xfc, 0xb8, 0x71, 0x8c, 0x2f,
-	0xb7, 0x4e, 0xe1, 0xfa, 0xd6, 0x29, 0x7c, 0xbf, 0x75, 0x0a, 0x1f, 0x76, 0x4f, 0xa9, 0x18, 0x67,
-	0xa3, 0x2e, 0x4e, 0x26, 0x3b, 0x29, 0x4b, 0xc2, 0x0c, 0x0b, 0x8e, 0xe9, 0x9d, 0x6f, 0xe6, 0x72,
-	0x61, 0x2d, 0xae, 0x52, 0xc2, 0x47, 0x25, 0xf5, 0xdb, 0x3c, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x33, 0x32, 0xac, 0xfa, 0x96, 0x04, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0x36, 0x97, 0xf6, 0x38, 0xd7, 0x49, 0xbf, 0xca, 0xd2, 0xf7, 0xc9, 0x5f, 0x5a,
+	0x54, 0x08, 0x5d, 0xa4, 0x02, 0xd4, 0x2d, 0x52, 0x9b, 0x06, 0xb0, 0x80, 0x10, 0xb9, 0x17, 0x21,
+	0x36, 0x96, 0x33, 0x1e, 0x9a, 0xa1, 0x8e, 0x6d, 0x66, 0xc6, 0xbd, 0xbc, 0x05, 0x0f, 0xc4, 0x03,
+	0xb0, 0xec, 0x92, 0x25, 0x6a, 0x9f, 0x03, 0x09, 0xcd, 0x8c, 0xe3, 0x84, 0x16, 0x76, 0x3e, 0xbf,
+	0xff, 0xff, 0x9c, 0x39, 0x33, 0xe7, 0xc8, 0xb0, 0x45, 0xa3, 0x8f, 0x84, 0x91, 0x08, 0x93, 0x9d,
+	0xf9, 0x57, 0xe2, 0x33, 0x41, 0x31, 0x4d, 0xfc, 0x48, 0xf4, 0x12, 0x16, 0x8b, 0x18, 0xb5, 0x73,
+	0xb1, 0x97, 0x7f, 0x6d, 0xfe, 0x2c, 0x82, 0x39, 0x9a, 0x5b, 0xd1, 0x1a, 0x94, 0x68, 0x14, 0x90,
+	0x4b, 0xcb, 0xe8, 0x18, 0xdd, 0x55, 0x57, 0x07, 0xc8, 0x82, 0x8a, 0x1f, 0x04, 0x8c, 0x70, 0x6e,
+	0x2d, 0x29, 0x3e, 0x0b, 0xd1, 0x3a, 0x94, 0x2f, 0x08, 0x3d, 0x9d, 0x08, 0x6b, 0xb9, 0x63, 0x74,
+	0x4b, 0x6e, 0x16, 0xa1, 0x7f, 0x61, 0xf5, 0x53, 0x4c, 0x23, 0x4f, 0xd0, 0x29, 0xb1, 0x8a, 0x1d,
+	0xa3, 0xbb, 0xec, 0xae, 0x48, 0x70, 0x44, 0xa7, 0x04, 0xfd, 0x0f, 0xa6, 0x12, 0x27, 0x3a, 0xb3,
+	0xa4, 0x64, 0x90, 0xe8, 0x95, 0xce, 0xee, 0x41, 0x3b, 0xf4, 0xb9, 0xf0, 0xf2, 0x3e, 0x75, 0x9d,
+	0xb2, 0x32, 0xb6, 0xa4, 0xe4, 0xcc, 0x14, 0x55, 0xf0, 0x01, 0xd4, 0xe6, 0xd6, 0x94, 0x85, 0x56,
+	0x45, 0x75, 0x59, 0xcd, 0xe1, 0x31, 0x0b, 0xd1, 0x73, 0x28, 0x73, 0xe1, 0x8b, 0x94, 0x5b, 0x2b,
+	0x1d, 0xa3, 0x5b, 0x7f, 0xfa, 0xb0, 0xf7, 0x87, 0x07, 0xe9, 0x2d, 0x3c, 0xc6, 0xa1, 0x72, 0xbb,
+	0x59, 0x16, 0xda, 0x80, 0x2a, 0x96, 0x5d, 0x8f, 0xfd, 0xd0, 0x8f, 0x30, 0xb1, 0x56, 0x55, 0x37,
+	0xa6, 0x64, 0xfb, 0x1a, 0xc9, 0x3e, 0xce, 0xfd, 0x90, 0x06, 0xbe, 0x88, 0x99, 0x77, 0x46, 0xae,
+	0x2c, 0xd0, 0x7d, 0xe4, 0xf0, 0x35, 0xb9, 0x42, 0x07, 0x60, 0xe3, 0x38, 0xe2, 0x04, 0xa7, 0x82,
+	0x9e, 0x13, 0x8f, 0x46, 0x4a, 0x9d, 0xdf, 0x95, 0x5b, 0xa6, 0xaa, 0xfc, 0xdf, 0x82, 0xcb, 0xd1,
+	0xa6, 0xfc, 0xd6, 0x1c, 0x6d, 0x43, 0xeb, 0x22, 0x66, 0x67, 0x84, 0x79, 0x49, 0x3a, 0x0e, 0x29,
+	0x56, 0xc7, 0x55, 0xd5, 0x71, 0x0d, 0x2d, 0x8c, 0x14, 0x97, 0x27, 0x3e, 0x86, 0x26, 0x49, 0x62,
+	0x3c, 0xe1, 0x1e, 0x8e, 0xa7, 0x49, 0x48, 0x04, 0x09, 0xac, 0x5a, 0xc7, 0xe8, 0xd6, 0xdc, 0x86,
+	0xe6, 0xfd, 0x19, 0x46, 0x27, 0xd0, 0xc6, 0x29, 0x63, 0x24, 0x12, 0x9e, 0x92, 0x3c, 0x79, 0x79,
+	0x6e, 0xd5, 0x3b, 0x46, 0xd7, 0xfc, 0xcb, 0x8b, 0xf5, 0xb5, 0x7f, 0x20, 0xed, 0xf2, 0xc9, 0xb8,
+	0xdb, 0xc2, 0x77, 0x91, 0xdc, 0x13, 0x46, 0x4e, 0x69, 0x1c, 0x59, 0x0d, 0xd5, 0x63, 0x16, 0x6d,
+	0x7e, 0x5d, 0x82, 0xd6, 0xbd, 0x02, 0xe8, 0x11, 0x34, 0xe6, 0xf3, 0xc4, 0x71, 0x1a, 0x09, 0xb5,
+	0x8f, 0x45, 0xb7, 0x9e, 0xe3, 0xbe, 0xa4, 0xd2, 0x38, 0xa5, 0x9c, 0x93, 0xc0, 0x63, 0xe4, 0x73,
+	0x4a, 0xb8, 0xd0, 0x0b, 0x5a, 0x74, 0xeb, 0x1a, 0xbb, 0x19, 0x95, 0xc3, 0x23, 0x3e, 0x8b, 0x48,
+	0xe0, 0xc9, 0x79, 0x71, 0xb5, 0xad, 0x45, 0xd7, 0xd4, 0xac, 0x2f, 0x11, 0xda, 0x82, 0x3a, 0x23,
+	0x17, 0x3e, 0x0b, 0x72, 0x53, 0x51, 0x99, 0x6a, 0x33, 0xaa, 0x6d, 0x1b, 0x50, 0x1d, 0xa7, 0x0b,
+	0x95, 0x4a, 0xba, 0x92, 0x66, 0xda, 0xf2, 0x04, 0xd6, 0xb2, 0x89, 0x93, 0xdf, 0xe6, 0x5a, 0x56,
+	0xd6, 0x76, 0xae, 0x2d, 0x8c, 0x73, 0x17, 0xd6, 0xb3, 0x45, 0xb8, 0x9b, 0x54, 0x51, 0x49, 0xff,
+	0x2c, 0xa8, 0xf3, 0xb4, 0xed, 0xf7, 0xd0, 0xba, 0xb7, 0xb0, 0xa8, 0x01, 0xe6, 0xf1, 0xf0, 0x70,
+	0x34, 0xe8, 0x3b, 0x2f, 0x9c, 0xc1, 0x41, 0xb3, 0x80, 0x00, 0xca, 0x7b, 0xfd, 0x23, 0xe7, 0x64,
+	0xd0, 0x34, 0x50, 0x15, 0x56, 0x9c, 0x61, 0x16, 0x2d, 0x21, 0x13, 0x2a, 0xce, 0xf0, 0x64, 0xef,
+	0x8d, 0x73, 0xd0, 0x5c, 0x96, 0x81, 0xbb, 0xf7, 0x76, 0xe4, 0x0c, 0x5f, 0x36, 0x8b, 0xfb, 0xef,
+	0xbe, 0xdd, 0xd8, 0xc6, 0xf5, 0x8d, 0x6d, 0xfc, 0xb8, 0xb1, 0x8d, 0x2f, 0xb7, 0x76, 0xe1, 0xfa,
+	0xd6, 0x2e, 0x7c, 0xbf, 0xb5, 0x0b, 0x1f, 0x76, 0x4f, 0xa9, 0x98, 0xa4, 0xe3, 0x1e, 0x8e, 0xa7,
+	0x3b, 0x09, 0x8b, 0x83, 0x14, 0x0b, 0x8e, 0xe9, 0x9d, 0xdf, 0xcf, 0xe5, 0xc2, 0xb7, 0xb8, 0x4a,
+	0x08, 0x1f, 0x97, 0xd5, 0x5f, 0xe8, 0xd9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x98, 0x53,
+	0xeb, 0xae, 0x04, 0x00, 0x00,
 }
 
 func (m *Participant) Marshal() (dAtA []byte, err error) {
@@ -371,6 +381,13 @@ func (m *Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintParticipant(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.CurrentEpochStats != nil {
 		{
 			size, err := m.CurrentEpochStats.MarshalToSizedBuffer(dAtA[:i])
@@ -584,6 +601,10 @@ func (m *Participant) Size() (n int) {
 		l = m.CurrentEpochStats.Size()
 		n += 1 + l + sovParticipant(uint64(l))
 	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovParticipant(uint64(l))
+	}
 	return n
 }
 
@@ -1000,6 +1021,38 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParticipant(dAtA[iNdEx:])
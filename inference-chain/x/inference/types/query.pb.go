@@ -589,6 +589,10 @@ func (m *QueryInferenceParticipantResponse) GetBalance() int64 {
 
 type QueryGetRandomExecutorRequest struct {
 	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Addresses that must not be selected, e.g. executors that already failed the request
+	Exclude []string `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// Executors declaring this region are preferred, the others are used only when none of them is available
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
 }
 
 func (m *QueryGetRandomExecutorRequest) Reset()         { *m = QueryGetRandomExecutorRequest{} }
@@ -631,6 +635,20 @@ func (m *QueryGetRandomExecutorRequest) GetModel() string {
 	return ""
 }
 
+func (m *QueryGetRandomExecutorRequest) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+func (m *QueryGetRandomExecutorRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
 type QueryGetRandomExecutorResponse struct {
 	Executor Participant `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor"`
 }
@@ -675,6 +693,163 @@ func (m *QueryGetRandomExecutorResponse) GetExecutor() Participant {
 	return Participant{}
 }
 
+type QueryExecutorCandidatesRequest struct {
+	Model   string   `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Exclude []string `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	Region  string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (m *QueryExecutorCandidatesRequest) Reset()         { *m = QueryExecutorCandidatesRequest{} }
+func (m *QueryExecutorCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorCandidatesRequest) ProtoMessage()    {}
+func (*QueryExecutorCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{14}
+}
+func (m *QueryExecutorCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorCandidatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutorCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorCandidatesRequest.Merge(m, src)
+}
+func (m *QueryExecutorCandidatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutorCandidatesRequest proto.InternalMessageInfo
+
+func (m *QueryExecutorCandidatesRequest) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *QueryExecutorCandidatesRequest) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+func (m *QueryExecutorCandidatesRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type ExecutorCandidate struct {
+	Executor Participant `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor"`
+	// Weight of the executor in the model sub-group, random selection is proportional to it
+	Weight int64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *ExecutorCandidate) Reset()         { *m = ExecutorCandidate{} }
+func (m *ExecutorCandidate) String() string { return proto.CompactTextString(m) }
+func (*ExecutorCandidate) ProtoMessage()    {}
+func (*ExecutorCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{15}
+}
+func (m *ExecutorCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorCandidate.Merge(m, src)
+}
+func (m *ExecutorCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorCandidate proto.InternalMessageInfo
+
+func (m *ExecutorCandidate) GetExecutor() Participant {
+	if m != nil {
+		return m.Executor
+	}
+	return Participant{}
+}
+
+func (m *ExecutorCandidate) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type QueryExecutorCandidatesResponse struct {
+	Candidates []ExecutorCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates"`
+}
+
+func (m *QueryExecutorCandidatesResponse) Reset()         { *m = QueryExecutorCandidatesResponse{} }
+func (m *QueryExecutorCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorCandidatesResponse) ProtoMessage()    {}
+func (*QueryExecutorCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{16}
+}
+func (m *QueryExecutorCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorCandidatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutorCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorCandidatesResponse.Merge(m, src)
+}
+func (m *QueryExecutorCandidatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutorCandidatesResponse proto.InternalMessageInfo
+
+func (m *QueryExecutorCandidatesResponse) GetCandidates() []ExecutorCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
 type QueryGetEpochGroupDataRequest struct {
 	EpochIndex uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
 	ModelId    string `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
@@ -684,7 +859,7 @@ func (m *QueryGetEpochGroupDataRequest) Reset()         { *m = QueryGetEpochGrou
 func (m *QueryGetEpochGroupDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochGroupDataRequest) ProtoMessage()    {}
 func (*QueryGetEpochGroupDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{14}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{17}
 }
 func (m *QueryGetEpochGroupDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +910,7 @@ func (m *QueryGetEpochGroupDataResponse) Reset()         { *m = QueryGetEpochGro
 func (m *QueryGetEpochGroupDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochGroupDataResponse) ProtoMessage()    {}
 func (*QueryGetEpochGroupDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{15}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{18}
 }
 func (m *QueryGetEpochGroupDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +954,7 @@ func (m *QueryAllEpochGroupDataRequest) Reset()         { *m = QueryAllEpochGrou
 func (m *QueryAllEpochGroupDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochGroupDataRequest) ProtoMessage()    {}
 func (*QueryAllEpochGroupDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{16}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{19}
 }
 func (m *QueryAllEpochGroupDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,7 +999,7 @@ func (m *QueryAllEpochGroupDataResponse) Reset()         { *m = QueryAllEpochGro
 func (m *QueryAllEpochGroupDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochGroupDataResponse) ProtoMessage()    {}
 func (*QueryAllEpochGroupDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{17}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{20}
 }
 func (m *QueryAllEpochGroupDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1050,7 @@ func (m *QueryGetSettleAmountRequest) Reset()         { *m = QueryGetSettleAmoun
 func (m *QueryGetSettleAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSettleAmountRequest) ProtoMessage()    {}
 func (*QueryGetSettleAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{18}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{21}
 }
 func (m *QueryGetSettleAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1094,7 @@ func (m *QueryGetSettleAmountResponse) Reset()         { *m = QueryGetSettleAmou
 func (m *QueryGetSettleAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSettleAmountResponse) ProtoMessage()    {}
 func (*QueryGetSettleAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{19}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{22}
 }
 func (m *QueryGetSettleAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1138,7 @@ func (m *QueryAllSettleAmountRequest) Reset()         { *m = QueryAllSettleAmoun
 func (m *QueryAllSettleAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSettleAmountRequest) ProtoMessage()    {}
 func (*QueryAllSettleAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{20}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{23}
 }
 func (m *QueryAllSettleAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1183,7 @@ func (m *QueryAllSettleAmountResponse) Reset()         { *m = QueryAllSettleAmou
 func (m *QueryAllSettleAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSettleAmountResponse) ProtoMessage()    {}
 func (*QueryAllSettleAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{21}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{24}
 }
 func (m *QueryAllSettleAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1051,6 +1226,164 @@ func (m *QueryAllSettleAmountResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryPendingClaimsRequest struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryPendingClaimsRequest) Reset()         { *m = QueryPendingClaimsRequest{} }
+func (m *QueryPendingClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingClaimsRequest) ProtoMessage()    {}
+func (*QueryPendingClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{25}
+}
+func (m *QueryPendingClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingClaimsRequest.Merge(m, src)
+}
+func (m *QueryPendingClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingClaimsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingClaimsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+type PendingClaim struct {
+	SettleAmount SettleAmount `protobuf:"bytes,1,opt,name=settle_amount,json=settleAmount,proto3" json:"settle_amount"`
+	// The amount is burned when this epoch is settled
+	ExpiresAtEpoch uint64 `protobuf:"varint,2,opt,name=expires_at_epoch,json=expiresAtEpoch,proto3" json:"expires_at_epoch,omitempty"`
+	// The amount is burned by the next settlement
+	Expiring bool `protobuf:"varint,3,opt,name=expiring,proto3" json:"expiring,omitempty"`
+}
+
+func (m *PendingClaim) Reset()         { *m = PendingClaim{} }
+func (m *PendingClaim) String() string { return proto.CompactTextString(m) }
+func (*PendingClaim) ProtoMessage()    {}
+func (*PendingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{26}
+}
+func (m *PendingClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingClaim.Merge(m, src)
+}
+func (m *PendingClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingClaim proto.InternalMessageInfo
+
+func (m *PendingClaim) GetSettleAmount() SettleAmount {
+	if m != nil {
+		return m.SettleAmount
+	}
+	return SettleAmount{}
+}
+
+func (m *PendingClaim) GetExpiresAtEpoch() uint64 {
+	if m != nil {
+		return m.ExpiresAtEpoch
+	}
+	return 0
+}
+
+func (m *PendingClaim) GetExpiring() bool {
+	if m != nil {
+		return m.Expiring
+	}
+	return false
+}
+
+type QueryPendingClaimsResponse struct {
+	Claims            []PendingClaim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	CurrentEpochIndex uint64         `protobuf:"varint,2,opt,name=current_epoch_index,json=currentEpochIndex,proto3" json:"current_epoch_index,omitempty"`
+}
+
+func (m *QueryPendingClaimsResponse) Reset()         { *m = QueryPendingClaimsResponse{} }
+func (m *QueryPendingClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingClaimsResponse) ProtoMessage()    {}
+func (*QueryPendingClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{27}
+}
+func (m *QueryPendingClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingClaimsResponse.Merge(m, src)
+}
+func (m *QueryPendingClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingClaimsResponse) GetClaims() []PendingClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *QueryPendingClaimsResponse) GetCurrentEpochIndex() uint64 {
+	if m != nil {
+		return m.CurrentEpochIndex
+	}
+	return 0
+}
+
 type QueryGetEpochGroupValidationsRequest struct {
 	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
 	EpochIndex  uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
@@ -1060,7 +1393,7 @@ func (m *QueryGetEpochGroupValidationsRequest) Reset()         { *m = QueryGetEp
 func (m *QueryGetEpochGroupValidationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochGroupValidationsRequest) ProtoMessage()    {}
 func (*QueryGetEpochGroupValidationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{22}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{28}
 }
 func (m *QueryGetEpochGroupValidationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1444,7 @@ func (m *QueryGetEpochGroupValidationsResponse) Reset()         { *m = QueryGetE
 func (m *QueryGetEpochGroupValidationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochGroupValidationsResponse) ProtoMessage()    {}
 func (*QueryGetEpochGroupValidationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{23}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{29}
 }
 func (m *QueryGetEpochGroupValidationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1155,7 +1488,7 @@ func (m *QueryAllEpochGroupValidationsRequest) Reset()         { *m = QueryAllEp
 func (m *QueryAllEpochGroupValidationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochGroupValidationsRequest) ProtoMessage()    {}
 func (*QueryAllEpochGroupValidationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{24}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{30}
 }
 func (m *QueryAllEpochGroupValidationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1200,7 +1533,7 @@ func (m *QueryAllEpochGroupValidationsResponse) Reset()         { *m = QueryAllE
 func (m *QueryAllEpochGroupValidationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochGroupValidationsResponse) ProtoMessage()    {}
 func (*QueryAllEpochGroupValidationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{25}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{31}
 }
 func (m *QueryAllEpochGroupValidationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1251,7 +1584,7 @@ func (m *QueryPocBatchesForStageRequest) Reset()         { *m = QueryPocBatchesF
 func (m *QueryPocBatchesForStageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPocBatchesForStageRequest) ProtoMessage()    {}
 func (*QueryPocBatchesForStageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{26}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{32}
 }
 func (m *QueryPocBatchesForStageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1295,7 +1628,7 @@ func (m *QueryPocBatchesForStageResponse) Reset()         { *m = QueryPocBatches
 func (m *QueryPocBatchesForStageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPocBatchesForStageResponse) ProtoMessage()    {}
 func (*QueryPocBatchesForStageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{27}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{33}
 }
 func (m *QueryPocBatchesForStageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,7 +1675,7 @@ func (m *PoCBatchesWithParticipants) Reset()         { *m = PoCBatchesWithPartic
 func (m *PoCBatchesWithParticipants) String() string { return proto.CompactTextString(m) }
 func (*PoCBatchesWithParticipants) ProtoMessage()    {}
 func (*PoCBatchesWithParticipants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{28}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{34}
 }
 func (m *PoCBatchesWithParticipants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1406,7 +1739,7 @@ func (m *QueryGetCurrentEpochRequest) Reset()         { *m = QueryGetCurrentEpoc
 func (m *QueryGetCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCurrentEpochRequest) ProtoMessage()    {}
 func (*QueryGetCurrentEpochRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{29}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{35}
 }
 func (m *QueryGetCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1777,7 @@ func (m *QueryGetCurrentEpochResponse) Reset()         { *m = QueryGetCurrentEpo
 func (m *QueryGetCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCurrentEpochResponse) ProtoMessage()    {}
 func (*QueryGetCurrentEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{30}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{36}
 }
 func (m *QueryGetCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,7 +1820,7 @@ func (m *QueryGetTokenomicsDataRequest) Reset()         { *m = QueryGetTokenomic
 func (m *QueryGetTokenomicsDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTokenomicsDataRequest) ProtoMessage()    {}
 func (*QueryGetTokenomicsDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{31}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{37}
 }
 func (m *QueryGetTokenomicsDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1524,7 +1857,7 @@ func (m *QueryGetTokenomicsDataResponse) Reset()         { *m = QueryGetTokenomi
 func (m *QueryGetTokenomicsDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTokenomicsDataResponse) ProtoMessage()    {}
 func (*QueryGetTokenomicsDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{32}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{38}
 }
 func (m *QueryGetTokenomicsDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1572,7 +1905,7 @@ func (m *QueryGetUnitOfComputePriceProposalRequest) String() string {
 }
 func (*QueryGetUnitOfComputePriceProposalRequest) ProtoMessage() {}
 func (*QueryGetUnitOfComputePriceProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{33}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{39}
 }
 func (m *QueryGetUnitOfComputePriceProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1621,7 +1954,7 @@ func (m *QueryGetUnitOfComputePriceProposalResponse) String() string {
 }
 func (*QueryGetUnitOfComputePriceProposalResponse) ProtoMessage() {}
 func (*QueryGetUnitOfComputePriceProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{34}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{40}
 }
 func (m *QueryGetUnitOfComputePriceProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,7 +2004,7 @@ func (m *QueryCurrentEpochGroupDataRequest) Reset()         { *m = QueryCurrentE
 func (m *QueryCurrentEpochGroupDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochGroupDataRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochGroupDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{35}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{41}
 }
 func (m *QueryCurrentEpochGroupDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +2041,7 @@ func (m *QueryCurrentEpochGroupDataResponse) Reset()         { *m = QueryCurrent
 func (m *QueryCurrentEpochGroupDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochGroupDataResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochGroupDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{36}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{42}
 }
 func (m *QueryCurrentEpochGroupDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1751,7 +2084,7 @@ func (m *QueryPreviousEpochGroupDataRequest) Reset()         { *m = QueryPreviou
 func (m *QueryPreviousEpochGroupDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPreviousEpochGroupDataRequest) ProtoMessage()    {}
 func (*QueryPreviousEpochGroupDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{37}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{43}
 }
 func (m *QueryPreviousEpochGroupDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1788,7 +2121,7 @@ func (m *QueryPreviousEpochGroupDataResponse) Reset()         { *m = QueryPrevio
 func (m *QueryPreviousEpochGroupDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPreviousEpochGroupDataResponse) ProtoMessage()    {}
 func (*QueryPreviousEpochGroupDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{38}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{44}
 }
 func (m *QueryPreviousEpochGroupDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2164,7 @@ func (m *QueryModelsAllRequest) Reset()         { *m = QueryModelsAllRequest{} }
 func (m *QueryModelsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModelsAllRequest) ProtoMessage()    {}
 func (*QueryModelsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{39}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{45}
 }
 func (m *QueryModelsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1868,7 +2201,7 @@ func (m *QueryModelsAllResponse) Reset()         { *m = QueryModelsAllResponse{}
 func (m *QueryModelsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModelsAllResponse) ProtoMessage()    {}
 func (*QueryModelsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{40}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{46}
 }
 func (m *QueryModelsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1912,7 +2245,7 @@ func (m *QueryGetTopMinerRequest) Reset()         { *m = QueryGetTopMinerRequest
 func (m *QueryGetTopMinerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTopMinerRequest) ProtoMessage()    {}
 func (*QueryGetTopMinerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{41}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{47}
 }
 func (m *QueryGetTopMinerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1956,7 +2289,7 @@ func (m *QueryGetTopMinerResponse) Reset()         { *m = QueryGetTopMinerRespon
 func (m *QueryGetTopMinerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTopMinerResponse) ProtoMessage()    {}
 func (*QueryGetTopMinerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{42}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{48}
 }
 func (m *QueryGetTopMinerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2000,7 +2333,7 @@ func (m *QueryAllTopMinerRequest) Reset()         { *m = QueryAllTopMinerRequest
 func (m *QueryAllTopMinerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTopMinerRequest) ProtoMessage()    {}
 func (*QueryAllTopMinerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{43}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{49}
 }
 func (m *QueryAllTopMinerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2378,7 @@ func (m *QueryAllTopMinerResponse) Reset()         { *m = QueryAllTopMinerRespon
 func (m *QueryAllTopMinerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTopMinerResponse) ProtoMessage()    {}
 func (*QueryAllTopMinerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{44}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{50}
 }
 func (m *QueryAllTopMinerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2097,7 +2430,7 @@ func (m *QueryGetInferenceTimeoutRequest) Reset()         { *m = QueryGetInferen
 func (m *QueryGetInferenceTimeoutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInferenceTimeoutRequest) ProtoMessage()    {}
 func (*QueryGetInferenceTimeoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{45}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{51}
 }
 func (m *QueryGetInferenceTimeoutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2148,7 +2481,7 @@ func (m *QueryGetInferenceTimeoutResponse) Reset()         { *m = QueryGetInfere
 func (m *QueryGetInferenceTimeoutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInferenceTimeoutResponse) ProtoMessage()    {}
 func (*QueryGetInferenceTimeoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{46}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{52}
 }
 func (m *QueryGetInferenceTimeoutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2192,7 +2525,7 @@ func (m *QueryAllInferenceTimeoutRequest) Reset()         { *m = QueryAllInferen
 func (m *QueryAllInferenceTimeoutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInferenceTimeoutRequest) ProtoMessage()    {}
 func (*QueryAllInferenceTimeoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{47}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{53}
 }
 func (m *QueryAllInferenceTimeoutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2237,7 +2570,7 @@ func (m *QueryAllInferenceTimeoutResponse) Reset()         { *m = QueryAllInfere
 func (m *QueryAllInferenceTimeoutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInferenceTimeoutResponse) ProtoMessage()    {}
 func (*QueryAllInferenceTimeoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{48}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{54}
 }
 func (m *QueryAllInferenceTimeoutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2293,7 +2626,7 @@ func (m *QueryGetInferenceValidationDetailsRequest) String() string {
 }
 func (*QueryGetInferenceValidationDetailsRequest) ProtoMessage() {}
 func (*QueryGetInferenceValidationDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{49}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{55}
 }
 func (m *QueryGetInferenceValidationDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2348,7 +2681,7 @@ func (m *QueryGetInferenceValidationDetailsResponse) String() string {
 }
 func (*QueryGetInferenceValidationDetailsResponse) ProtoMessage() {}
 func (*QueryGetInferenceValidationDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{50}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{56}
 }
 func (m *QueryGetInferenceValidationDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2729,7 @@ func (m *QueryAllInferenceValidationDetailsRequest) String() string {
 }
 func (*QueryAllInferenceValidationDetailsRequest) ProtoMessage() {}
 func (*QueryAllInferenceValidationDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{51}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{57}
 }
 func (m *QueryAllInferenceValidationDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2445,7 +2778,7 @@ func (m *QueryAllInferenceValidationDetailsResponse) String() string {
 }
 func (*QueryAllInferenceValidationDetailsResponse) ProtoMessage() {}
 func (*QueryAllInferenceValidationDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{52}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{58}
 }
 func (m *QueryAllInferenceValidationDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2501,7 +2834,7 @@ func (m *QueryGetInferenceValidationParametersRequest) String() string {
 }
 func (*QueryGetInferenceValidationParametersRequest) ProtoMessage() {}
 func (*QueryGetInferenceValidationParametersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{53}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{59}
 }
 func (m *QueryGetInferenceValidationParametersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2892,7 @@ func (m *QueryGetInferenceValidationParametersResponse) String() string {
 }
 func (*QueryGetInferenceValidationParametersResponse) ProtoMessage() {}
 func (*QueryGetInferenceValidationParametersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{54}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{60}
 }
 func (m *QueryGetInferenceValidationParametersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2627,7 +2960,7 @@ func (m *QueryGetEpochPerformanceSummaryRequest) Reset() {
 func (m *QueryGetEpochPerformanceSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochPerformanceSummaryRequest) ProtoMessage()    {}
 func (*QueryGetEpochPerformanceSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{55}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{61}
 }
 func (m *QueryGetEpochPerformanceSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2680,7 +3013,7 @@ func (m *QueryGetEpochPerformanceSummaryResponse) Reset() {
 func (m *QueryGetEpochPerformanceSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochPerformanceSummaryResponse) ProtoMessage()    {}
 func (*QueryGetEpochPerformanceSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{56}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{62}
 }
 func (m *QueryGetEpochPerformanceSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2726,7 +3059,7 @@ func (m *QueryAllEpochPerformanceSummaryRequest) Reset() {
 func (m *QueryAllEpochPerformanceSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochPerformanceSummaryRequest) ProtoMessage()    {}
 func (*QueryAllEpochPerformanceSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{57}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{63}
 }
 func (m *QueryAllEpochPerformanceSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2773,7 +3106,7 @@ func (m *QueryAllEpochPerformanceSummaryResponse) Reset() {
 func (m *QueryAllEpochPerformanceSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochPerformanceSummaryResponse) ProtoMessage()    {}
 func (*QueryAllEpochPerformanceSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{58}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{64}
 }
 func (m *QueryAllEpochPerformanceSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2824,7 +3157,7 @@ func (m *QueryTrainingTaskRequest) Reset()         { *m = QueryTrainingTaskReque
 func (m *QueryTrainingTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingTaskRequest) ProtoMessage()    {}
 func (*QueryTrainingTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{59}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{65}
 }
 func (m *QueryTrainingTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2868,7 +3201,7 @@ func (m *QueryTrainingTaskResponse) Reset()         { *m = QueryTrainingTaskResp
 func (m *QueryTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingTaskResponse) ProtoMessage()    {}
 func (*QueryTrainingTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{60}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{66}
 }
 func (m *QueryTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2912,7 +3245,7 @@ func (m *QueryHardwareNodesRequest) Reset()         { *m = QueryHardwareNodesReq
 func (m *QueryHardwareNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHardwareNodesRequest) ProtoMessage()    {}
 func (*QueryHardwareNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{61}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{67}
 }
 func (m *QueryHardwareNodesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3289,7 @@ func (m *QueryHardwareNodesResponse) Reset()         { *m = QueryHardwareNodesRe
 func (m *QueryHardwareNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHardwareNodesResponse) ProtoMessage()    {}
 func (*QueryHardwareNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{62}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{68}
 }
 func (m *QueryHardwareNodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2999,7 +3332,7 @@ func (m *QueryHardwareNodesAllRequest) Reset()         { *m = QueryHardwareNodes
 func (m *QueryHardwareNodesAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHardwareNodesAllRequest) ProtoMessage()    {}
 func (*QueryHardwareNodesAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{63}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{69}
 }
 func (m *QueryHardwareNodesAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3036,7 +3369,7 @@ func (m *QueryHardwareNodesAllResponse) Reset()         { *m = QueryHardwareNode
 func (m *QueryHardwareNodesAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHardwareNodesAllResponse) ProtoMessage()    {}
 func (*QueryHardwareNodesAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{64}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{70}
 }
 func (m *QueryHardwareNodesAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3079,7 +3412,7 @@ func (m *QueryQueuedTrainingTasksRequest) Reset()         { *m = QueryQueuedTrai
 func (m *QueryQueuedTrainingTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQueuedTrainingTasksRequest) ProtoMessage()    {}
 func (*QueryQueuedTrainingTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{65}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{71}
 }
 func (m *QueryQueuedTrainingTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3116,7 +3449,7 @@ func (m *QueryQueuedTrainingTasksResponse) Reset()         { *m = QueryQueuedTra
 func (m *QueryQueuedTrainingTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQueuedTrainingTasksResponse) ProtoMessage()    {}
 func (*QueryQueuedTrainingTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{66}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{72}
 }
 func (m *QueryQueuedTrainingTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3159,7 +3492,7 @@ func (m *QueryTrainingTaskAllRequest) Reset()         { *m = QueryTrainingTaskAl
 func (m *QueryTrainingTaskAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingTaskAllRequest) ProtoMessage()    {}
 func (*QueryTrainingTaskAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{67}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{73}
 }
 func (m *QueryTrainingTaskAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3196,7 +3529,7 @@ func (m *QueryTrainingTaskAllResponse) Reset()         { *m = QueryTrainingTaskA
 func (m *QueryTrainingTaskAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingTaskAllResponse) ProtoMessage()    {}
 func (*QueryTrainingTaskAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{68}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{74}
 }
 func (m *QueryTrainingTaskAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3242,7 +3575,7 @@ func (m *QueryGetParticipantCurrentStatsRequest) Reset() {
 func (m *QueryGetParticipantCurrentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetParticipantCurrentStatsRequest) ProtoMessage()    {}
 func (*QueryGetParticipantCurrentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{69}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{75}
 }
 func (m *QueryGetParticipantCurrentStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3622,7 @@ func (m *QueryGetParticipantCurrentStatsResponse) Reset() {
 func (m *QueryGetParticipantCurrentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetParticipantCurrentStatsResponse) ProtoMessage()    {}
 func (*QueryGetParticipantCurrentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{70}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{76}
 }
 func (m *QueryGetParticipantCurrentStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3676,7 @@ func (m *QueryGetAllParticipantCurrentStatsRequest) String() string {
 }
 func (*QueryGetAllParticipantCurrentStatsRequest) ProtoMessage() {}
 func (*QueryGetAllParticipantCurrentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{71}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{77}
 }
 func (m *QueryGetAllParticipantCurrentStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3386,7 +3719,7 @@ func (m *QueryGetAllParticipantCurrentStatsResponse) String() string {
 }
 func (*QueryGetAllParticipantCurrentStatsResponse) ProtoMessage() {}
 func (*QueryGetAllParticipantCurrentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{72}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{78}
 }
 func (m *QueryGetAllParticipantCurrentStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3446,7 +3779,7 @@ func (m *ParticipantCurrentStats) Reset()         { *m = ParticipantCurrentStats
 func (m *ParticipantCurrentStats) String() string { return proto.CompactTextString(m) }
 func (*ParticipantCurrentStats) ProtoMessage()    {}
 func (*ParticipantCurrentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{73}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{79}
 }
 func (m *ParticipantCurrentStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3509,7 +3842,7 @@ func (m *ParticipantFullStats) Reset()         { *m = ParticipantFullStats{} }
 func (m *ParticipantFullStats) String() string { return proto.CompactTextString(m) }
 func (*ParticipantFullStats) ProtoMessage()    {}
 func (*ParticipantFullStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{74}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{80}
 }
 func (m *ParticipantFullStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3587,7 +3920,7 @@ func (m *QueryParticipantsFullStatsRequest) Reset()         { *m = QueryParticip
 func (m *QueryParticipantsFullStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParticipantsFullStatsRequest) ProtoMessage()    {}
 func (*QueryParticipantsFullStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{75}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{81}
 }
 func (m *QueryParticipantsFullStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3624,7 +3957,7 @@ func (m *QueryParticipantsFullStatsResponse) Reset()         { *m = QueryPartici
 func (m *QueryParticipantsFullStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParticipantsFullStatsResponse) ProtoMessage()    {}
 func (*QueryParticipantsFullStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{76}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{82}
 }
 func (m *QueryParticipantsFullStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3672,7 +4005,7 @@ func (m *QueryStatsByTimePeriodByDeveloperRequest) Reset() {
 func (m *QueryStatsByTimePeriodByDeveloperRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStatsByTimePeriodByDeveloperRequest) ProtoMessage()    {}
 func (*QueryStatsByTimePeriodByDeveloperRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{77}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{83}
 }
 func (m *QueryStatsByTimePeriodByDeveloperRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3734,7 +4067,7 @@ func (m *QueryStatsByTimePeriodByDeveloperResponse) String() string {
 }
 func (*QueryStatsByTimePeriodByDeveloperResponse) ProtoMessage() {}
 func (*QueryStatsByTimePeriodByDeveloperResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{78}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{84}
 }
 func (m *QueryStatsByTimePeriodByDeveloperResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3783,7 +4116,7 @@ func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) String() string {
 }
 func (*QueryStatsByDeveloperAndEpochBackwardsRequest) ProtoMessage() {}
 func (*QueryStatsByDeveloperAndEpochBackwardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{79}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{85}
 }
 func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3838,7 +4171,7 @@ func (m *QueryInferencesAndTokensStatsByEpochsBackwardsRequest) String() string
 }
 func (*QueryInferencesAndTokensStatsByEpochsBackwardsRequest) ProtoMessage() {}
 func (*QueryInferencesAndTokensStatsByEpochsBackwardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{80}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{86}
 }
 func (m *QueryInferencesAndTokensStatsByEpochsBackwardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3887,7 +4220,7 @@ func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) String() string {
 }
 func (*QueryInferencesAndTokensStatsByTimePeriodRequest) ProtoMessage() {}
 func (*QueryInferencesAndTokensStatsByTimePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{81}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{87}
 }
 func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3943,7 +4276,7 @@ func (m *QueryInferencesAndTokensStatsByModelsRequest) String() string {
 }
 func (*QueryInferencesAndTokensStatsByModelsRequest) ProtoMessage() {}
 func (*QueryInferencesAndTokensStatsByModelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{82}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{88}
 }
 func (m *QueryInferencesAndTokensStatsByModelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3996,7 +4329,7 @@ func (m *ModelStats) Reset()         { *m = ModelStats{} }
 func (m *ModelStats) String() string { return proto.CompactTextString(m) }
 func (*ModelStats) ProtoMessage()    {}
 func (*ModelStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{83}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{89}
 }
 func (m *ModelStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4058,7 +4391,7 @@ func (m *QueryInferencesAndTokensStatsByModelsResponse) String() string {
 }
 func (*QueryInferencesAndTokensStatsByModelsResponse) ProtoMessage() {}
 func (*QueryInferencesAndTokensStatsByModelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{84}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{90}
 }
 func (m *QueryInferencesAndTokensStatsByModelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4437,7 @@ func (m *QueryInferencesAndTokensStatsResponse) Reset()         { *m = QueryInfe
 func (m *QueryInferencesAndTokensStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInferencesAndTokensStatsResponse) ProtoMessage()    {}
 func (*QueryInferencesAndTokensStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{85}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{91}
 }
 func (m *QueryInferencesAndTokensStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4161,7 +4494,7 @@ func (m *QueryCountAllParticipantsRequest) Reset()         { *m = QueryCountAllP
 func (m *QueryCountAllParticipantsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCountAllParticipantsRequest) ProtoMessage()    {}
 func (*QueryCountAllParticipantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{86}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{92}
 }
 func (m *QueryCountAllParticipantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4198,7 +4531,7 @@ func (m *QueryCountAllParticipantsResponse) Reset()         { *m = QueryCountAll
 func (m *QueryCountAllParticipantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCountAllParticipantsResponse) ProtoMessage()    {}
 func (*QueryCountAllParticipantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{87}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{93}
 }
 func (m *QueryCountAllParticipantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4241,7 +4574,7 @@ func (m *QueryDebugStatsRequest) Reset()         { *m = QueryDebugStatsRequest{}
 func (m *QueryDebugStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDebugStatsRequest) ProtoMessage()    {}
 func (*QueryDebugStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{88}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{94}
 }
 func (m *QueryDebugStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4279,7 +4612,7 @@ func (m *QueryDebugStatsResponse) Reset()         { *m = QueryDebugStatsResponse
 func (m *QueryDebugStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDebugStatsResponse) ProtoMessage()    {}
 func (*QueryDebugStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{89}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{95}
 }
 func (m *QueryDebugStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4335,7 +4668,7 @@ func (m *QueryDebugStatsResponse_TemporaryTimeStat) String() string {
 }
 func (*QueryDebugStatsResponse_TemporaryTimeStat) ProtoMessage() {}
 func (*QueryDebugStatsResponse_TemporaryTimeStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{89, 0}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{95, 0}
 }
 func (m *QueryDebugStatsResponse_TemporaryTimeStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4391,7 +4724,7 @@ func (m *QueryDebugStatsResponse_TemporaryEpochStat) String() string {
 }
 func (*QueryDebugStatsResponse_TemporaryEpochStat) ProtoMessage() {}
 func (*QueryDebugStatsResponse_TemporaryEpochStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{89, 1}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{95, 1}
 }
 func (m *QueryDebugStatsResponse_TemporaryEpochStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4443,7 +4776,7 @@ func (m *QueryGetMinimumValidationAverageRequest) Reset() {
 func (m *QueryGetMinimumValidationAverageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMinimumValidationAverageRequest) ProtoMessage()    {}
 func (*QueryGetMinimumValidationAverageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{90}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{96}
 }
 func (m *QueryGetMinimumValidationAverageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4484,7 +4817,7 @@ func (m *QueryGetMinimumValidationAverageResponse) Reset() {
 func (m *QueryGetMinimumValidationAverageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMinimumValidationAverageResponse) ProtoMessage()    {}
 func (*QueryGetMinimumValidationAverageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{91}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{97}
 }
 func (m *QueryGetMinimumValidationAverageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4541,7 +4874,7 @@ func (m *QueryInProgressTrainingTasksRequest) Reset()         { *m = QueryInProg
 func (m *QueryInProgressTrainingTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInProgressTrainingTasksRequest) ProtoMessage()    {}
 func (*QueryInProgressTrainingTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{92}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{98}
 }
 func (m *QueryInProgressTrainingTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4578,7 +4911,7 @@ func (m *QueryInProgressTrainingTasksResponse) Reset()         { *m = QueryInPro
 func (m *QueryInProgressTrainingTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInProgressTrainingTasksResponse) ProtoMessage()    {}
 func (*QueryInProgressTrainingTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{93}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{99}
 }
 func (m *QueryInProgressTrainingTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4622,7 +4955,7 @@ func (m *QueryGetPartialUpgradeRequest) Reset()         { *m = QueryGetPartialUp
 func (m *QueryGetPartialUpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPartialUpgradeRequest) ProtoMessage()    {}
 func (*QueryGetPartialUpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{94}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{100}
 }
 func (m *QueryGetPartialUpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4666,7 +4999,7 @@ func (m *QueryGetPartialUpgradeResponse) Reset()         { *m = QueryGetPartialU
 func (m *QueryGetPartialUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPartialUpgradeResponse) ProtoMessage()    {}
 func (*QueryGetPartialUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{95}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{101}
 }
 func (m *QueryGetPartialUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4710,7 +5043,7 @@ func (m *QueryAllPartialUpgradeRequest) Reset()         { *m = QueryAllPartialUp
 func (m *QueryAllPartialUpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPartialUpgradeRequest) ProtoMessage()    {}
 func (*QueryAllPartialUpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{96}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{102}
 }
 func (m *QueryAllPartialUpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4755,7 +5088,7 @@ func (m *QueryAllPartialUpgradeResponse) Reset()         { *m = QueryAllPartialU
 func (m *QueryAllPartialUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPartialUpgradeResponse) ProtoMessage()    {}
 func (*QueryAllPartialUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{97}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{103}
 }
 func (m *QueryAllPartialUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4807,7 +5140,7 @@ func (m *QueryTrainingKvRecordRequest) Reset()         { *m = QueryTrainingKvRec
 func (m *QueryTrainingKvRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingKvRecordRequest) ProtoMessage()    {}
 func (*QueryTrainingKvRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{98}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{104}
 }
 func (m *QueryTrainingKvRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4858,7 +5191,7 @@ func (m *QueryTrainingKvRecordResponse) Reset()         { *m = QueryTrainingKvRe
 func (m *QueryTrainingKvRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingKvRecordResponse) ProtoMessage()    {}
 func (*QueryTrainingKvRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{99}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{105}
 }
 func (m *QueryTrainingKvRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4902,7 +5235,7 @@ func (m *QueryListTrainingKvRecordKeysRequest) Reset()         { *m = QueryListT
 func (m *QueryListTrainingKvRecordKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListTrainingKvRecordKeysRequest) ProtoMessage()    {}
 func (*QueryListTrainingKvRecordKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{100}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{106}
 }
 func (m *QueryListTrainingKvRecordKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4946,7 +5279,7 @@ func (m *QueryListTrainingKvRecordKeysResponse) Reset()         { *m = QueryList
 func (m *QueryListTrainingKvRecordKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListTrainingKvRecordKeysResponse) ProtoMessage()    {}
 func (*QueryListTrainingKvRecordKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{101}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{107}
 }
 func (m *QueryListTrainingKvRecordKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4982,6 +5315,191 @@ func (m *QueryListTrainingKvRecordKeysResponse) GetKeys() []string {
 	return nil
 }
 
+type QueryTrainingCheckpointRequest struct {
+	TaskId    uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OuterStep int32  `protobuf:"varint,2,opt,name=outer_step,json=outerStep,proto3" json:"outer_step,omitempty"`
+}
+
+func (m *QueryTrainingCheckpointRequest) Reset()         { *m = QueryTrainingCheckpointRequest{} }
+func (m *QueryTrainingCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrainingCheckpointRequest) ProtoMessage()    {}
+func (*QueryTrainingCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{108}
+}
+func (m *QueryTrainingCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrainingCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrainingCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrainingCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrainingCheckpointRequest.Merge(m, src)
+}
+func (m *QueryTrainingCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrainingCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrainingCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrainingCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryTrainingCheckpointRequest) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *QueryTrainingCheckpointRequest) GetOuterStep() int32 {
+	if m != nil {
+		return m.OuterStep
+	}
+	return 0
+}
+
+type QueryTrainingCheckpointResponse struct {
+	Checkpoint *TrainingCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *QueryTrainingCheckpointResponse) Reset()         { *m = QueryTrainingCheckpointResponse{} }
+func (m *QueryTrainingCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrainingCheckpointResponse) ProtoMessage()    {}
+func (*QueryTrainingCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{109}
+}
+func (m *QueryTrainingCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrainingCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrainingCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrainingCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrainingCheckpointResponse.Merge(m, src)
+}
+func (m *QueryTrainingCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrainingCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrainingCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrainingCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryTrainingCheckpointResponse) GetCheckpoint() *TrainingCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+type QueryTrainingCheckpointsRequest struct {
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *QueryTrainingCheckpointsRequest) Reset()         { *m = QueryTrainingCheckpointsRequest{} }
+func (m *QueryTrainingCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrainingCheckpointsRequest) ProtoMessage()    {}
+func (*QueryTrainingCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{110}
+}
+func (m *QueryTrainingCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrainingCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrainingCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrainingCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrainingCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryTrainingCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrainingCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrainingCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrainingCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryTrainingCheckpointsRequest) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type QueryTrainingCheckpointsResponse struct {
+	// Ordered by outer step
+	Checkpoints []*TrainingCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (m *QueryTrainingCheckpointsResponse) Reset()         { *m = QueryTrainingCheckpointsResponse{} }
+func (m *QueryTrainingCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrainingCheckpointsResponse) ProtoMessage()    {}
+func (*QueryTrainingCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{111}
+}
+func (m *QueryTrainingCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrainingCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrainingCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrainingCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrainingCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryTrainingCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrainingCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrainingCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrainingCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryTrainingCheckpointsResponse) GetCheckpoints() []*TrainingCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 type QueryTrainingBarrierRequest struct {
 	Req *GetBarrierStatusRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
 }
@@ -4990,7 +5508,7 @@ func (m *QueryTrainingBarrierRequest) Reset()         { *m = QueryTrainingBarrie
 func (m *QueryTrainingBarrierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingBarrierRequest) ProtoMessage()    {}
 func (*QueryTrainingBarrierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{102}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{112}
 }
 func (m *QueryTrainingBarrierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5034,7 +5552,7 @@ func (m *QueryTrainingBarrierResponse) Reset()         { *m = QueryTrainingBarri
 func (m *QueryTrainingBarrierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingBarrierResponse) ProtoMessage()    {}
 func (*QueryTrainingBarrierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{103}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{113}
 }
 func (m *QueryTrainingBarrierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5078,7 +5596,7 @@ func (m *QueryTrainingAliveNodesRequest) Reset()         { *m = QueryTrainingAli
 func (m *QueryTrainingAliveNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingAliveNodesRequest) ProtoMessage()    {}
 func (*QueryTrainingAliveNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{104}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{114}
 }
 func (m *QueryTrainingAliveNodesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5122,7 +5640,7 @@ func (m *QueryTrainingAliveNodesResponse) Reset()         { *m = QueryTrainingAl
 func (m *QueryTrainingAliveNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTrainingAliveNodesResponse) ProtoMessage()    {}
 func (*QueryTrainingAliveNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{105}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{115}
 }
 func (m *QueryTrainingAliveNodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5168,7 +5686,7 @@ func (m *QueryGetBridgeTransactionRequest) Reset()         { *m = QueryGetBridge
 func (m *QueryGetBridgeTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBridgeTransactionRequest) ProtoMessage()    {}
 func (*QueryGetBridgeTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{106}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{116}
 }
 func (m *QueryGetBridgeTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5226,7 +5744,7 @@ func (m *QueryGetBridgeTransactionResponse) Reset()         { *m = QueryGetBridg
 func (m *QueryGetBridgeTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBridgeTransactionResponse) ProtoMessage()    {}
 func (*QueryGetBridgeTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{107}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{117}
 }
 func (m *QueryGetBridgeTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5270,7 +5788,7 @@ func (m *QueryAllBridgeTransactionsRequest) Reset()         { *m = QueryAllBridg
 func (m *QueryAllBridgeTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBridgeTransactionsRequest) ProtoMessage()    {}
 func (*QueryAllBridgeTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{108}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{118}
 }
 func (m *QueryAllBridgeTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5315,7 +5833,7 @@ func (m *QueryAllBridgeTransactionsResponse) Reset()         { *m = QueryAllBrid
 func (m *QueryAllBridgeTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBridgeTransactionsResponse) ProtoMessage()    {}
 func (*QueryAllBridgeTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{109}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{119}
 }
 func (m *QueryAllBridgeTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5358,6 +5876,225 @@ func (m *QueryAllBridgeTransactionsResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
+type QueryGetBridgeBlockHeaderRequest struct {
+	OriginChain string `protobuf:"bytes,1,opt,name=origin_chain,json=originChain,proto3" json:"origin_chain,omitempty"`
+	BlockNumber string `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *QueryGetBridgeBlockHeaderRequest) Reset()         { *m = QueryGetBridgeBlockHeaderRequest{} }
+func (m *QueryGetBridgeBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBridgeBlockHeaderRequest) ProtoMessage()    {}
+func (*QueryGetBridgeBlockHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{120}
+}
+func (m *QueryGetBridgeBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBridgeBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBridgeBlockHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBridgeBlockHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBridgeBlockHeaderRequest.Merge(m, src)
+}
+func (m *QueryGetBridgeBlockHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBridgeBlockHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBridgeBlockHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBridgeBlockHeaderRequest proto.InternalMessageInfo
+
+func (m *QueryGetBridgeBlockHeaderRequest) GetOriginChain() string {
+	if m != nil {
+		return m.OriginChain
+	}
+	return ""
+}
+
+func (m *QueryGetBridgeBlockHeaderRequest) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
+}
+
+type QueryGetBridgeBlockHeaderResponse struct {
+	Header BridgeBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+}
+
+func (m *QueryGetBridgeBlockHeaderResponse) Reset()         { *m = QueryGetBridgeBlockHeaderResponse{} }
+func (m *QueryGetBridgeBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBridgeBlockHeaderResponse) ProtoMessage()    {}
+func (*QueryGetBridgeBlockHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{121}
+}
+func (m *QueryGetBridgeBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBridgeBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBridgeBlockHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBridgeBlockHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBridgeBlockHeaderResponse.Merge(m, src)
+}
+func (m *QueryGetBridgeBlockHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBridgeBlockHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBridgeBlockHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBridgeBlockHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryGetBridgeBlockHeaderResponse) GetHeader() BridgeBlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return BridgeBlockHeader{}
+}
+
+type QueryGetBridgeWithdrawalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetBridgeWithdrawalRequest) Reset()         { *m = QueryGetBridgeWithdrawalRequest{} }
+func (m *QueryGetBridgeWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBridgeWithdrawalRequest) ProtoMessage()    {}
+func (*QueryGetBridgeWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{122}
+}
+func (m *QueryGetBridgeWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBridgeWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBridgeWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBridgeWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBridgeWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryGetBridgeWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBridgeWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBridgeWithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBridgeWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryGetBridgeWithdrawalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetBridgeWithdrawalResponse carries what a destination chain contract needs to release
+// the funds: the abi.encodePacked payload, its keccak256 hash and the aggregated BLS signature.
+// The signature is empty until the threshold group has signed.
+type QueryGetBridgeWithdrawalResponse struct {
+	Withdrawal    BridgeWithdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
+	SigningStatus string           `protobuf:"bytes,2,opt,name=signing_status,json=signingStatus,proto3" json:"signing_status,omitempty"`
+	EncodedData   []byte           `protobuf:"bytes,3,opt,name=encoded_data,json=encodedData,proto3" json:"encoded_data,omitempty"`
+	MessageHash   []byte           `protobuf:"bytes,4,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	Signature     []byte           `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *QueryGetBridgeWithdrawalResponse) Reset()         { *m = QueryGetBridgeWithdrawalResponse{} }
+func (m *QueryGetBridgeWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBridgeWithdrawalResponse) ProtoMessage()    {}
+func (*QueryGetBridgeWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{123}
+}
+func (m *QueryGetBridgeWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBridgeWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBridgeWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBridgeWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBridgeWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryGetBridgeWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBridgeWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBridgeWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBridgeWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryGetBridgeWithdrawalResponse) GetWithdrawal() BridgeWithdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return BridgeWithdrawal{}
+}
+
+func (m *QueryGetBridgeWithdrawalResponse) GetSigningStatus() string {
+	if m != nil {
+		return m.SigningStatus
+	}
+	return ""
+}
+
+func (m *QueryGetBridgeWithdrawalResponse) GetEncodedData() []byte {
+	if m != nil {
+		return m.EncodedData
+	}
+	return nil
+}
+
+func (m *QueryGetBridgeWithdrawalResponse) GetMessageHash() []byte {
+	if m != nil {
+		return m.MessageHash
+	}
+	return nil
+}
+
+func (m *QueryGetBridgeWithdrawalResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type QueryEpochInfoRequest struct {
 }
 
@@ -5365,7 +6102,7 @@ func (m *QueryEpochInfoRequest) Reset()         { *m = QueryEpochInfoRequest{} }
 func (m *QueryEpochInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochInfoRequest) ProtoMessage()    {}
 func (*QueryEpochInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{110}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{124}
 }
 func (m *QueryEpochInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5404,7 +6141,7 @@ func (m *QueryEpochInfoResponse) Reset()         { *m = QueryEpochInfoResponse{}
 func (m *QueryEpochInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochInfoResponse) ProtoMessage()    {}
 func (*QueryEpochInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{111}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{125}
 }
 func (m *QueryEpochInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5462,7 +6199,7 @@ func (m *QueryCountPoCbatchesAtHeightRequest) Reset()         { *m = QueryCountP
 func (m *QueryCountPoCbatchesAtHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCountPoCbatchesAtHeightRequest) ProtoMessage()    {}
 func (*QueryCountPoCbatchesAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{112}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{126}
 }
 func (m *QueryCountPoCbatchesAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5506,7 +6243,7 @@ func (m *QueryCountPoCbatchesAtHeightResponse) Reset()         { *m = QueryCount
 func (m *QueryCountPoCbatchesAtHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCountPoCbatchesAtHeightResponse) ProtoMessage()    {}
 func (*QueryCountPoCbatchesAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{113}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{127}
 }
 func (m *QueryCountPoCbatchesAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5552,7 +6289,7 @@ func (m *QueryCountPoCvalidationsAtHeightRequest) Reset() {
 func (m *QueryCountPoCvalidationsAtHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCountPoCvalidationsAtHeightRequest) ProtoMessage()    {}
 func (*QueryCountPoCvalidationsAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{114}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{128}
 }
 func (m *QueryCountPoCvalidationsAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5598,7 +6335,7 @@ func (m *QueryCountPoCvalidationsAtHeightResponse) Reset() {
 func (m *QueryCountPoCvalidationsAtHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCountPoCvalidationsAtHeightResponse) ProtoMessage()    {}
 func (*QueryCountPoCvalidationsAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{115}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{129}
 }
 func (m *QueryCountPoCvalidationsAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5643,7 +6380,7 @@ func (m *QueryGetModelPerTokenPriceRequest) Reset()         { *m = QueryGetModel
 func (m *QueryGetModelPerTokenPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetModelPerTokenPriceRequest) ProtoMessage()    {}
 func (*QueryGetModelPerTokenPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{116}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{130}
 }
 func (m *QueryGetModelPerTokenPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5688,7 +6425,7 @@ func (m *QueryGetModelPerTokenPriceResponse) Reset()         { *m = QueryGetMode
 func (m *QueryGetModelPerTokenPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetModelPerTokenPriceResponse) ProtoMessage()    {}
 func (*QueryGetModelPerTokenPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{117}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{131}
 }
 func (m *QueryGetModelPerTokenPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5738,7 +6475,7 @@ func (m *QueryGetAllModelPerTokenPricesRequest) Reset()         { *m = QueryGetA
 func (m *QueryGetAllModelPerTokenPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllModelPerTokenPricesRequest) ProtoMessage()    {}
 func (*QueryGetAllModelPerTokenPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{118}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{132}
 }
 func (m *QueryGetAllModelPerTokenPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5776,7 +6513,7 @@ func (m *ModelPrice) Reset()         { *m = ModelPrice{} }
 func (m *ModelPrice) String() string { return proto.CompactTextString(m) }
 func (*ModelPrice) ProtoMessage()    {}
 func (*ModelPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{119}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{133}
 }
 func (m *ModelPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5829,7 +6566,7 @@ func (m *QueryGetAllModelPerTokenPricesResponse) Reset() {
 func (m *QueryGetAllModelPerTokenPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllModelPerTokenPricesResponse) ProtoMessage()    {}
 func (*QueryGetAllModelPerTokenPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{120}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{134}
 }
 func (m *QueryGetAllModelPerTokenPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5873,7 +6610,7 @@ func (m *QueryGetModelCapacityRequest) Reset()         { *m = QueryGetModelCapac
 func (m *QueryGetModelCapacityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetModelCapacityRequest) ProtoMessage()    {}
 func (*QueryGetModelCapacityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{121}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{135}
 }
 func (m *QueryGetModelCapacityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5918,7 +6655,7 @@ func (m *QueryGetModelCapacityResponse) Reset()         { *m = QueryGetModelCapa
 func (m *QueryGetModelCapacityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetModelCapacityResponse) ProtoMessage()    {}
 func (*QueryGetModelCapacityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{122}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{136}
 }
 func (m *QueryGetModelCapacityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5968,7 +6705,7 @@ func (m *QueryGetAllModelCapacitiesRequest) Reset()         { *m = QueryGetAllMo
 func (m *QueryGetAllModelCapacitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllModelCapacitiesRequest) ProtoMessage()    {}
 func (*QueryGetAllModelCapacitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{123}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{137}
 }
 func (m *QueryGetAllModelCapacitiesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6005,7 +6742,7 @@ func (m *QueryGetAllModelCapacitiesResponse) Reset()         { *m = QueryGetAllM
 func (m *QueryGetAllModelCapacitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllModelCapacitiesResponse) ProtoMessage()    {}
 func (*QueryGetAllModelCapacitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{124}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{138}
 }
 func (m *QueryGetAllModelCapacitiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6050,7 +6787,7 @@ func (m *ModelCapacity) Reset()         { *m = ModelCapacity{} }
 func (m *ModelCapacity) String() string { return proto.CompactTextString(m) }
 func (*ModelCapacity) ProtoMessage()    {}
 func (*ModelCapacity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{125}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{139}
 }
 func (m *ModelCapacity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6102,7 +6839,7 @@ func (m *QueryGranteesByMessageTypeRequest) Reset()         { *m = QueryGrantees
 func (m *QueryGranteesByMessageTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGranteesByMessageTypeRequest) ProtoMessage()    {}
 func (*QueryGranteesByMessageTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{126}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{140}
 }
 func (m *QueryGranteesByMessageTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6154,7 +6891,7 @@ func (m *Grantee) Reset()         { *m = Grantee{} }
 func (m *Grantee) String() string { return proto.CompactTextString(m) }
 func (*Grantee) ProtoMessage()    {}
 func (*Grantee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{127}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{141}
 }
 func (m *Grantee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6205,7 +6942,7 @@ func (m *QueryGranteesByMessageTypeResponse) Reset()         { *m = QueryGrantee
 func (m *QueryGranteesByMessageTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGranteesByMessageTypeResponse) ProtoMessage()    {}
 func (*QueryGranteesByMessageTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{128}
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{142}
 }
 func (m *QueryGranteesByMessageTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6241,98 +6978,297 @@ func (m *QueryGranteesByMessageTypeResponse) GetGrantees() []*Grantee {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "inference.inference.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "inference.inference.QueryParamsResponse")
-	proto.RegisterType((*QueryGetInferenceRequest)(nil), "inference.inference.QueryGetInferenceRequest")
-	proto.RegisterType((*QueryGetInferenceResponse)(nil), "inference.inference.QueryGetInferenceResponse")
-	proto.RegisterType((*QueryAllInferenceRequest)(nil), "inference.inference.QueryAllInferenceRequest")
-	proto.RegisterType((*QueryAllInferenceResponse)(nil), "inference.inference.QueryAllInferenceResponse")
-	proto.RegisterType((*QueryGetParticipantRequest)(nil), "inference.inference.QueryGetParticipantRequest")
-	proto.RegisterType((*QueryGetParticipantResponse)(nil), "inference.inference.QueryGetParticipantResponse")
-	proto.RegisterType((*QueryAllParticipantRequest)(nil), "inference.inference.QueryAllParticipantRequest")
-	proto.RegisterType((*QueryAllParticipantResponse)(nil), "inference.inference.QueryAllParticipantResponse")
-	proto.RegisterType((*QueryInferenceParticipantRequest)(nil), "inference.inference.QueryInferenceParticipantRequest")
-	proto.RegisterType((*QueryInferenceParticipantResponse)(nil), "inference.inference.QueryInferenceParticipantResponse")
-	proto.RegisterType((*QueryGetRandomExecutorRequest)(nil), "inference.inference.QueryGetRandomExecutorRequest")
-	proto.RegisterType((*QueryGetRandomExecutorResponse)(nil), "inference.inference.QueryGetRandomExecutorResponse")
-	proto.RegisterType((*QueryGetEpochGroupDataRequest)(nil), "inference.inference.QueryGetEpochGroupDataRequest")
-	proto.RegisterType((*QueryGetEpochGroupDataResponse)(nil), "inference.inference.QueryGetEpochGroupDataResponse")
-	proto.RegisterType((*QueryAllEpochGroupDataRequest)(nil), "inference.inference.QueryAllEpochGroupDataRequest")
-	proto.RegisterType((*QueryAllEpochGroupDataResponse)(nil), "inference.inference.QueryAllEpochGroupDataResponse")
-	proto.RegisterType((*QueryGetSettleAmountRequest)(nil), "inference.inference.QueryGetSettleAmountRequest")
-	proto.RegisterType((*QueryGetSettleAmountResponse)(nil), "inference.inference.QueryGetSettleAmountResponse")
-	proto.RegisterType((*QueryAllSettleAmountRequest)(nil), "inference.inference.QueryAllSettleAmountRequest")
-	proto.RegisterType((*QueryAllSettleAmountResponse)(nil), "inference.inference.QueryAllSettleAmountResponse")
-	proto.RegisterType((*QueryGetEpochGroupValidationsRequest)(nil), "inference.inference.QueryGetEpochGroupValidationsRequest")
-	proto.RegisterType((*QueryGetEpochGroupValidationsResponse)(nil), "inference.inference.QueryGetEpochGroupValidationsResponse")
-	proto.RegisterType((*QueryAllEpochGroupValidationsRequest)(nil), "inference.inference.QueryAllEpochGroupValidationsRequest")
-	proto.RegisterType((*QueryAllEpochGroupValidationsResponse)(nil), "inference.inference.QueryAllEpochGroupValidationsResponse")
-	proto.RegisterType((*QueryPocBatchesForStageRequest)(nil), "inference.inference.QueryPocBatchesForStageRequest")
-	proto.RegisterType((*QueryPocBatchesForStageResponse)(nil), "inference.inference.QueryPocBatchesForStageResponse")
-	proto.RegisterType((*PoCBatchesWithParticipants)(nil), "inference.inference.PoCBatchesWithParticipants")
-	proto.RegisterType((*QueryGetCurrentEpochRequest)(nil), "inference.inference.QueryGetCurrentEpochRequest")
-	proto.RegisterType((*QueryGetCurrentEpochResponse)(nil), "inference.inference.QueryGetCurrentEpochResponse")
-	proto.RegisterType((*QueryGetTokenomicsDataRequest)(nil), "inference.inference.QueryGetTokenomicsDataRequest")
-	proto.RegisterType((*QueryGetTokenomicsDataResponse)(nil), "inference.inference.QueryGetTokenomicsDataResponse")
-	proto.RegisterType((*QueryGetUnitOfComputePriceProposalRequest)(nil), "inference.inference.QueryGetUnitOfComputePriceProposalRequest")
-	proto.RegisterType((*QueryGetUnitOfComputePriceProposalResponse)(nil), "inference.inference.QueryGetUnitOfComputePriceProposalResponse")
-	proto.RegisterType((*QueryCurrentEpochGroupDataRequest)(nil), "inference.inference.QueryCurrentEpochGroupDataRequest")
-	proto.RegisterType((*QueryCurrentEpochGroupDataResponse)(nil), "inference.inference.QueryCurrentEpochGroupDataResponse")
-	proto.RegisterType((*QueryPreviousEpochGroupDataRequest)(nil), "inference.inference.QueryPreviousEpochGroupDataRequest")
-	proto.RegisterType((*QueryPreviousEpochGroupDataResponse)(nil), "inference.inference.QueryPreviousEpochGroupDataResponse")
-	proto.RegisterType((*QueryModelsAllRequest)(nil), "inference.inference.QueryModelsAllRequest")
-	proto.RegisterType((*QueryModelsAllResponse)(nil), "inference.inference.QueryModelsAllResponse")
-	proto.RegisterType((*QueryGetTopMinerRequest)(nil), "inference.inference.QueryGetTopMinerRequest")
-	proto.RegisterType((*QueryGetTopMinerResponse)(nil), "inference.inference.QueryGetTopMinerResponse")
-	proto.RegisterType((*QueryAllTopMinerRequest)(nil), "inference.inference.QueryAllTopMinerRequest")
-	proto.RegisterType((*QueryAllTopMinerResponse)(nil), "inference.inference.QueryAllTopMinerResponse")
-	proto.RegisterType((*QueryGetInferenceTimeoutRequest)(nil), "inference.inference.QueryGetInferenceTimeoutRequest")
-	proto.RegisterType((*QueryGetInferenceTimeoutResponse)(nil), "inference.inference.QueryGetInferenceTimeoutResponse")
-	proto.RegisterType((*QueryAllInferenceTimeoutRequest)(nil), "inference.inference.QueryAllInferenceTimeoutRequest")
-	proto.RegisterType((*QueryAllInferenceTimeoutResponse)(nil), "inference.inference.QueryAllInferenceTimeoutResponse")
-	proto.RegisterType((*QueryGetInferenceValidationDetailsRequest)(nil), "inference.inference.QueryGetInferenceValidationDetailsRequest")
-	proto.RegisterType((*QueryGetInferenceValidationDetailsResponse)(nil), "inference.inference.QueryGetInferenceValidationDetailsResponse")
-	proto.RegisterType((*QueryAllInferenceValidationDetailsRequest)(nil), "inference.inference.QueryAllInferenceValidationDetailsRequest")
-	proto.RegisterType((*QueryAllInferenceValidationDetailsResponse)(nil), "inference.inference.QueryAllInferenceValidationDetailsResponse")
-	proto.RegisterType((*QueryGetInferenceValidationParametersRequest)(nil), "inference.inference.QueryGetInferenceValidationParametersRequest")
-	proto.RegisterType((*QueryGetInferenceValidationParametersResponse)(nil), "inference.inference.QueryGetInferenceValidationParametersResponse")
-	proto.RegisterType((*QueryGetEpochPerformanceSummaryRequest)(nil), "inference.inference.QueryGetEpochPerformanceSummaryRequest")
-	proto.RegisterType((*QueryGetEpochPerformanceSummaryResponse)(nil), "inference.inference.QueryGetEpochPerformanceSummaryResponse")
-	proto.RegisterType((*QueryAllEpochPerformanceSummaryRequest)(nil), "inference.inference.QueryAllEpochPerformanceSummaryRequest")
-	proto.RegisterType((*QueryAllEpochPerformanceSummaryResponse)(nil), "inference.inference.QueryAllEpochPerformanceSummaryResponse")
-	proto.RegisterType((*QueryTrainingTaskRequest)(nil), "inference.inference.QueryTrainingTaskRequest")
-	proto.RegisterType((*QueryTrainingTaskResponse)(nil), "inference.inference.QueryTrainingTaskResponse")
-	proto.RegisterType((*QueryHardwareNodesRequest)(nil), "inference.inference.QueryHardwareNodesRequest")
-	proto.RegisterType((*QueryHardwareNodesResponse)(nil), "inference.inference.QueryHardwareNodesResponse")
-	proto.RegisterType((*QueryHardwareNodesAllRequest)(nil), "inference.inference.QueryHardwareNodesAllRequest")
-	proto.RegisterType((*QueryHardwareNodesAllResponse)(nil), "inference.inference.QueryHardwareNodesAllResponse")
-	proto.RegisterType((*QueryQueuedTrainingTasksRequest)(nil), "inference.inference.QueryQueuedTrainingTasksRequest")
-	proto.RegisterType((*QueryQueuedTrainingTasksResponse)(nil), "inference.inference.QueryQueuedTrainingTasksResponse")
-	proto.RegisterType((*QueryTrainingTaskAllRequest)(nil), "inference.inference.QueryTrainingTaskAllRequest")
-	proto.RegisterType((*QueryTrainingTaskAllResponse)(nil), "inference.inference.QueryTrainingTaskAllResponse")
-	proto.RegisterType((*QueryGetParticipantCurrentStatsRequest)(nil), "inference.inference.QueryGetParticipantCurrentStatsRequest")
-	proto.RegisterType((*QueryGetParticipantCurrentStatsResponse)(nil), "inference.inference.QueryGetParticipantCurrentStatsResponse")
-	proto.RegisterType((*QueryGetAllParticipantCurrentStatsRequest)(nil), "inference.inference.QueryGetAllParticipantCurrentStatsRequest")
-	proto.RegisterType((*QueryGetAllParticipantCurrentStatsResponse)(nil), "inference.inference.QueryGetAllParticipantCurrentStatsResponse")
-	proto.RegisterType((*ParticipantCurrentStats)(nil), "inference.inference.ParticipantCurrentStats")
-	proto.RegisterType((*ParticipantFullStats)(nil), "inference.inference.ParticipantFullStats")
-	proto.RegisterType((*QueryParticipantsFullStatsRequest)(nil), "inference.inference.QueryParticipantsFullStatsRequest")
-	proto.RegisterType((*QueryParticipantsFullStatsResponse)(nil), "inference.inference.QueryParticipantsFullStatsResponse")
-	proto.RegisterType((*QueryStatsByTimePeriodByDeveloperRequest)(nil), "inference.inference.QueryStatsByTimePeriodByDeveloperRequest")
-	proto.RegisterType((*QueryStatsByTimePeriodByDeveloperResponse)(nil), "inference.inference.QueryStatsByTimePeriodByDeveloperResponse")
-	proto.RegisterType((*QueryStatsByDeveloperAndEpochBackwardsRequest)(nil), "inference.inference.QueryStatsByDeveloperAndEpochBackwardsRequest")
-	proto.RegisterType((*QueryInferencesAndTokensStatsByEpochsBackwardsRequest)(nil), "inference.inference.QueryInferencesAndTokensStatsByEpochsBackwardsRequest")
-	proto.RegisterType((*QueryInferencesAndTokensStatsByTimePeriodRequest)(nil), "inference.inference.QueryInferencesAndTokensStatsByTimePeriodRequest")
-	proto.RegisterType((*QueryInferencesAndTokensStatsByModelsRequest)(nil), "inference.inference.QueryInferencesAndTokensStatsByModelsRequest")
-	proto.RegisterType((*ModelStats)(nil), "inference.inference.ModelStats")
-	proto.RegisterType((*QueryInferencesAndTokensStatsByModelsResponse)(nil), "inference.inference.QueryInferencesAndTokensStatsByModelsResponse")
-	proto.RegisterType((*QueryInferencesAndTokensStatsResponse)(nil), "inference.inference.QueryInferencesAndTokensStatsResponse")
-	proto.RegisterType((*QueryCountAllParticipantsRequest)(nil), "inference.inference.QueryCountAllParticipantsRequest")
-	proto.RegisterType((*QueryCountAllParticipantsResponse)(nil), "inference.inference.QueryCountAllParticipantsResponse")
-	proto.RegisterType((*QueryDebugStatsRequest)(nil), "inference.inference.QueryDebugStatsRequest")
-	proto.RegisterType((*QueryDebugStatsResponse)(nil), "inference.inference.QueryDebugStatsResponse")
-	proto.RegisterType((*QueryDebugStatsResponse_TemporaryTimeStat)(nil), "inference.inference.QueryDebugStatsResponse.TemporaryTimeStat")
+type QueryGetDeveloperSubKeyRequest struct {
+	Developer string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryGetDeveloperSubKeyRequest) Reset()         { *m = QueryGetDeveloperSubKeyRequest{} }
+func (m *QueryGetDeveloperSubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDeveloperSubKeyRequest) ProtoMessage()    {}
+func (*QueryGetDeveloperSubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{143}
+}
+func (m *QueryGetDeveloperSubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDeveloperSubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDeveloperSubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDeveloperSubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDeveloperSubKeyRequest.Merge(m, src)
+}
+func (m *QueryGetDeveloperSubKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDeveloperSubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDeveloperSubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDeveloperSubKeyRequest proto.InternalMessageInfo
+
+func (m *QueryGetDeveloperSubKeyRequest) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *QueryGetDeveloperSubKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueryGetDeveloperSubKeyResponse struct {
+	SubKey DeveloperSubKey `protobuf:"bytes,1,opt,name=sub_key,json=subKey,proto3" json:"sub_key"`
+	// Coins escrowed with the key in the current epoch, spent_coins may be from an earlier epoch
+	SpentThisEpoch uint64 `protobuf:"varint,2,opt,name=spent_this_epoch,json=spentThisEpoch,proto3" json:"spent_this_epoch,omitempty"`
+}
+
+func (m *QueryGetDeveloperSubKeyResponse) Reset()         { *m = QueryGetDeveloperSubKeyResponse{} }
+func (m *QueryGetDeveloperSubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDeveloperSubKeyResponse) ProtoMessage()    {}
+func (*QueryGetDeveloperSubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{144}
+}
+func (m *QueryGetDeveloperSubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDeveloperSubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDeveloperSubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDeveloperSubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDeveloperSubKeyResponse.Merge(m, src)
+}
+func (m *QueryGetDeveloperSubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDeveloperSubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDeveloperSubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDeveloperSubKeyResponse proto.InternalMessageInfo
+
+func (m *QueryGetDeveloperSubKeyResponse) GetSubKey() DeveloperSubKey {
+	if m != nil {
+		return m.SubKey
+	}
+	return DeveloperSubKey{}
+}
+
+func (m *QueryGetDeveloperSubKeyResponse) GetSpentThisEpoch() uint64 {
+	if m != nil {
+		return m.SpentThisEpoch
+	}
+	return 0
+}
+
+type QueryDeveloperSubKeysRequest struct {
+	Developer string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+}
+
+func (m *QueryDeveloperSubKeysRequest) Reset()         { *m = QueryDeveloperSubKeysRequest{} }
+func (m *QueryDeveloperSubKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperSubKeysRequest) ProtoMessage()    {}
+func (*QueryDeveloperSubKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{145}
+}
+func (m *QueryDeveloperSubKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperSubKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperSubKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperSubKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperSubKeysRequest.Merge(m, src)
+}
+func (m *QueryDeveloperSubKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperSubKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperSubKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperSubKeysRequest proto.InternalMessageInfo
+
+func (m *QueryDeveloperSubKeysRequest) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+type QueryDeveloperSubKeysResponse struct {
+	SubKeys []DeveloperSubKey `protobuf:"bytes,1,rep,name=sub_keys,json=subKeys,proto3" json:"sub_keys"`
+}
+
+func (m *QueryDeveloperSubKeysResponse) Reset()         { *m = QueryDeveloperSubKeysResponse{} }
+func (m *QueryDeveloperSubKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperSubKeysResponse) ProtoMessage()    {}
+func (*QueryDeveloperSubKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{146}
+}
+func (m *QueryDeveloperSubKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperSubKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperSubKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperSubKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperSubKeysResponse.Merge(m, src)
+}
+func (m *QueryDeveloperSubKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperSubKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperSubKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperSubKeysResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperSubKeysResponse) GetSubKeys() []DeveloperSubKey {
+	if m != nil {
+		return m.SubKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "inference.inference.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "inference.inference.QueryParamsResponse")
+	proto.RegisterType((*QueryGetInferenceRequest)(nil), "inference.inference.QueryGetInferenceRequest")
+	proto.RegisterType((*QueryGetInferenceResponse)(nil), "inference.inference.QueryGetInferenceResponse")
+	proto.RegisterType((*QueryAllInferenceRequest)(nil), "inference.inference.QueryAllInferenceRequest")
+	proto.RegisterType((*QueryAllInferenceResponse)(nil), "inference.inference.QueryAllInferenceResponse")
+	proto.RegisterType((*QueryGetParticipantRequest)(nil), "inference.inference.QueryGetParticipantRequest")
+	proto.RegisterType((*QueryGetParticipantResponse)(nil), "inference.inference.QueryGetParticipantResponse")
+	proto.RegisterType((*QueryAllParticipantRequest)(nil), "inference.inference.QueryAllParticipantRequest")
+	proto.RegisterType((*QueryAllParticipantResponse)(nil), "inference.inference.QueryAllParticipantResponse")
+	proto.RegisterType((*QueryInferenceParticipantRequest)(nil), "inference.inference.QueryInferenceParticipantRequest")
+	proto.RegisterType((*QueryInferenceParticipantResponse)(nil), "inference.inference.QueryInferenceParticipantResponse")
+	proto.RegisterType((*QueryGetRandomExecutorRequest)(nil), "inference.inference.QueryGetRandomExecutorRequest")
+	proto.RegisterType((*QueryGetRandomExecutorResponse)(nil), "inference.inference.QueryGetRandomExecutorResponse")
+	proto.RegisterType((*QueryExecutorCandidatesRequest)(nil), "inference.inference.QueryExecutorCandidatesRequest")
+	proto.RegisterType((*ExecutorCandidate)(nil), "inference.inference.ExecutorCandidate")
+	proto.RegisterType((*QueryExecutorCandidatesResponse)(nil), "inference.inference.QueryExecutorCandidatesResponse")
+	proto.RegisterType((*QueryGetEpochGroupDataRequest)(nil), "inference.inference.QueryGetEpochGroupDataRequest")
+	proto.RegisterType((*QueryGetEpochGroupDataResponse)(nil), "inference.inference.QueryGetEpochGroupDataResponse")
+	proto.RegisterType((*QueryAllEpochGroupDataRequest)(nil), "inference.inference.QueryAllEpochGroupDataRequest")
+	proto.RegisterType((*QueryAllEpochGroupDataResponse)(nil), "inference.inference.QueryAllEpochGroupDataResponse")
+	proto.RegisterType((*QueryGetSettleAmountRequest)(nil), "inference.inference.QueryGetSettleAmountRequest")
+	proto.RegisterType((*QueryGetSettleAmountResponse)(nil), "inference.inference.QueryGetSettleAmountResponse")
+	proto.RegisterType((*QueryAllSettleAmountRequest)(nil), "inference.inference.QueryAllSettleAmountRequest")
+	proto.RegisterType((*QueryAllSettleAmountResponse)(nil), "inference.inference.QueryAllSettleAmountResponse")
+	proto.RegisterType((*QueryPendingClaimsRequest)(nil), "inference.inference.QueryPendingClaimsRequest")
+	proto.RegisterType((*PendingClaim)(nil), "inference.inference.PendingClaim")
+	proto.RegisterType((*QueryPendingClaimsResponse)(nil), "inference.inference.QueryPendingClaimsResponse")
+	proto.RegisterType((*QueryGetEpochGroupValidationsRequest)(nil), "inference.inference.QueryGetEpochGroupValidationsRequest")
+	proto.RegisterType((*QueryGetEpochGroupValidationsResponse)(nil), "inference.inference.QueryGetEpochGroupValidationsResponse")
+	proto.RegisterType((*QueryAllEpochGroupValidationsRequest)(nil), "inference.inference.QueryAllEpochGroupValidationsRequest")
+	proto.RegisterType((*QueryAllEpochGroupValidationsResponse)(nil), "inference.inference.QueryAllEpochGroupValidationsResponse")
+	proto.RegisterType((*QueryPocBatchesForStageRequest)(nil), "inference.inference.QueryPocBatchesForStageRequest")
+	proto.RegisterType((*QueryPocBatchesForStageResponse)(nil), "inference.inference.QueryPocBatchesForStageResponse")
+	proto.RegisterType((*PoCBatchesWithParticipants)(nil), "inference.inference.PoCBatchesWithParticipants")
+	proto.RegisterType((*QueryGetCurrentEpochRequest)(nil), "inference.inference.QueryGetCurrentEpochRequest")
+	proto.RegisterType((*QueryGetCurrentEpochResponse)(nil), "inference.inference.QueryGetCurrentEpochResponse")
+	proto.RegisterType((*QueryGetTokenomicsDataRequest)(nil), "inference.inference.QueryGetTokenomicsDataRequest")
+	proto.RegisterType((*QueryGetTokenomicsDataResponse)(nil), "inference.inference.QueryGetTokenomicsDataResponse")
+	proto.RegisterType((*QueryGetUnitOfComputePriceProposalRequest)(nil), "inference.inference.QueryGetUnitOfComputePriceProposalRequest")
+	proto.RegisterType((*QueryGetUnitOfComputePriceProposalResponse)(nil), "inference.inference.QueryGetUnitOfComputePriceProposalResponse")
+	proto.RegisterType((*QueryCurrentEpochGroupDataRequest)(nil), "inference.inference.QueryCurrentEpochGroupDataRequest")
+	proto.RegisterType((*QueryCurrentEpochGroupDataResponse)(nil), "inference.inference.QueryCurrentEpochGroupDataResponse")
+	proto.RegisterType((*QueryPreviousEpochGroupDataRequest)(nil), "inference.inference.QueryPreviousEpochGroupDataRequest")
+	proto.RegisterType((*QueryPreviousEpochGroupDataResponse)(nil), "inference.inference.QueryPreviousEpochGroupDataResponse")
+	proto.RegisterType((*QueryModelsAllRequest)(nil), "inference.inference.QueryModelsAllRequest")
+	proto.RegisterType((*QueryModelsAllResponse)(nil), "inference.inference.QueryModelsAllResponse")
+	proto.RegisterType((*QueryGetTopMinerRequest)(nil), "inference.inference.QueryGetTopMinerRequest")
+	proto.RegisterType((*QueryGetTopMinerResponse)(nil), "inference.inference.QueryGetTopMinerResponse")
+	proto.RegisterType((*QueryAllTopMinerRequest)(nil), "inference.inference.QueryAllTopMinerRequest")
+	proto.RegisterType((*QueryAllTopMinerResponse)(nil), "inference.inference.QueryAllTopMinerResponse")
+	proto.RegisterType((*QueryGetInferenceTimeoutRequest)(nil), "inference.inference.QueryGetInferenceTimeoutRequest")
+	proto.RegisterType((*QueryGetInferenceTimeoutResponse)(nil), "inference.inference.QueryGetInferenceTimeoutResponse")
+	proto.RegisterType((*QueryAllInferenceTimeoutRequest)(nil), "inference.inference.QueryAllInferenceTimeoutRequest")
+	proto.RegisterType((*QueryAllInferenceTimeoutResponse)(nil), "inference.inference.QueryAllInferenceTimeoutResponse")
+	proto.RegisterType((*QueryGetInferenceValidationDetailsRequest)(nil), "inference.inference.QueryGetInferenceValidationDetailsRequest")
+	proto.RegisterType((*QueryGetInferenceValidationDetailsResponse)(nil), "inference.inference.QueryGetInferenceValidationDetailsResponse")
+	proto.RegisterType((*QueryAllInferenceValidationDetailsRequest)(nil), "inference.inference.QueryAllInferenceValidationDetailsRequest")
+	proto.RegisterType((*QueryAllInferenceValidationDetailsResponse)(nil), "inference.inference.QueryAllInferenceValidationDetailsResponse")
+	proto.RegisterType((*QueryGetInferenceValidationParametersRequest)(nil), "inference.inference.QueryGetInferenceValidationParametersRequest")
+	proto.RegisterType((*QueryGetInferenceValidationParametersResponse)(nil), "inference.inference.QueryGetInferenceValidationParametersResponse")
+	proto.RegisterType((*QueryGetEpochPerformanceSummaryRequest)(nil), "inference.inference.QueryGetEpochPerformanceSummaryRequest")
+	proto.RegisterType((*QueryGetEpochPerformanceSummaryResponse)(nil), "inference.inference.QueryGetEpochPerformanceSummaryResponse")
+	proto.RegisterType((*QueryAllEpochPerformanceSummaryRequest)(nil), "inference.inference.QueryAllEpochPerformanceSummaryRequest")
+	proto.RegisterType((*QueryAllEpochPerformanceSummaryResponse)(nil), "inference.inference.QueryAllEpochPerformanceSummaryResponse")
+	proto.RegisterType((*QueryTrainingTaskRequest)(nil), "inference.inference.QueryTrainingTaskRequest")
+	proto.RegisterType((*QueryTrainingTaskResponse)(nil), "inference.inference.QueryTrainingTaskResponse")
+	proto.RegisterType((*QueryHardwareNodesRequest)(nil), "inference.inference.QueryHardwareNodesRequest")
+	proto.RegisterType((*QueryHardwareNodesResponse)(nil), "inference.inference.QueryHardwareNodesResponse")
+	proto.RegisterType((*QueryHardwareNodesAllRequest)(nil), "inference.inference.QueryHardwareNodesAllRequest")
+	proto.RegisterType((*QueryHardwareNodesAllResponse)(nil), "inference.inference.QueryHardwareNodesAllResponse")
+	proto.RegisterType((*QueryQueuedTrainingTasksRequest)(nil), "inference.inference.QueryQueuedTrainingTasksRequest")
+	proto.RegisterType((*QueryQueuedTrainingTasksResponse)(nil), "inference.inference.QueryQueuedTrainingTasksResponse")
+	proto.RegisterType((*QueryTrainingTaskAllRequest)(nil), "inference.inference.QueryTrainingTaskAllRequest")
+	proto.RegisterType((*QueryTrainingTaskAllResponse)(nil), "inference.inference.QueryTrainingTaskAllResponse")
+	proto.RegisterType((*QueryGetParticipantCurrentStatsRequest)(nil), "inference.inference.QueryGetParticipantCurrentStatsRequest")
+	proto.RegisterType((*QueryGetParticipantCurrentStatsResponse)(nil), "inference.inference.QueryGetParticipantCurrentStatsResponse")
+	proto.RegisterType((*QueryGetAllParticipantCurrentStatsRequest)(nil), "inference.inference.QueryGetAllParticipantCurrentStatsRequest")
+	proto.RegisterType((*QueryGetAllParticipantCurrentStatsResponse)(nil), "inference.inference.QueryGetAllParticipantCurrentStatsResponse")
+	proto.RegisterType((*ParticipantCurrentStats)(nil), "inference.inference.ParticipantCurrentStats")
+	proto.RegisterType((*ParticipantFullStats)(nil), "inference.inference.ParticipantFullStats")
+	proto.RegisterType((*QueryParticipantsFullStatsRequest)(nil), "inference.inference.QueryParticipantsFullStatsRequest")
+	proto.RegisterType((*QueryParticipantsFullStatsResponse)(nil), "inference.inference.QueryParticipantsFullStatsResponse")
+	proto.RegisterType((*QueryStatsByTimePeriodByDeveloperRequest)(nil), "inference.inference.QueryStatsByTimePeriodByDeveloperRequest")
+	proto.RegisterType((*QueryStatsByTimePeriodByDeveloperResponse)(nil), "inference.inference.QueryStatsByTimePeriodByDeveloperResponse")
+	proto.RegisterType((*QueryStatsByDeveloperAndEpochBackwardsRequest)(nil), "inference.inference.QueryStatsByDeveloperAndEpochBackwardsRequest")
+	proto.RegisterType((*QueryInferencesAndTokensStatsByEpochsBackwardsRequest)(nil), "inference.inference.QueryInferencesAndTokensStatsByEpochsBackwardsRequest")
+	proto.RegisterType((*QueryInferencesAndTokensStatsByTimePeriodRequest)(nil), "inference.inference.QueryInferencesAndTokensStatsByTimePeriodRequest")
+	proto.RegisterType((*QueryInferencesAndTokensStatsByModelsRequest)(nil), "inference.inference.QueryInferencesAndTokensStatsByModelsRequest")
+	proto.RegisterType((*ModelStats)(nil), "inference.inference.ModelStats")
+	proto.RegisterType((*QueryInferencesAndTokensStatsByModelsResponse)(nil), "inference.inference.QueryInferencesAndTokensStatsByModelsResponse")
+	proto.RegisterType((*QueryInferencesAndTokensStatsResponse)(nil), "inference.inference.QueryInferencesAndTokensStatsResponse")
+	proto.RegisterType((*QueryCountAllParticipantsRequest)(nil), "inference.inference.QueryCountAllParticipantsRequest")
+	proto.RegisterType((*QueryCountAllParticipantsResponse)(nil), "inference.inference.QueryCountAllParticipantsResponse")
+	proto.RegisterType((*QueryDebugStatsRequest)(nil), "inference.inference.QueryDebugStatsRequest")
+	proto.RegisterType((*QueryDebugStatsResponse)(nil), "inference.inference.QueryDebugStatsResponse")
+	proto.RegisterType((*QueryDebugStatsResponse_TemporaryTimeStat)(nil), "inference.inference.QueryDebugStatsResponse.TemporaryTimeStat")
 	proto.RegisterType((*QueryDebugStatsResponse_TemporaryEpochStat)(nil), "inference.inference.QueryDebugStatsResponse.TemporaryEpochStat")
 	proto.RegisterType((*QueryGetMinimumValidationAverageRequest)(nil), "inference.inference.QueryGetMinimumValidationAverageRequest")
 	proto.RegisterType((*QueryGetMinimumValidationAverageResponse)(nil), "inference.inference.QueryGetMinimumValidationAverageResponse")
@@ -6346,6 +7282,10 @@ func init() {
 	proto.RegisterType((*QueryTrainingKvRecordResponse)(nil), "inference.inference.QueryTrainingKvRecordResponse")
 	proto.RegisterType((*QueryListTrainingKvRecordKeysRequest)(nil), "inference.inference.QueryListTrainingKvRecordKeysRequest")
 	proto.RegisterType((*QueryListTrainingKvRecordKeysResponse)(nil), "inference.inference.QueryListTrainingKvRecordKeysResponse")
+	proto.RegisterType((*QueryTrainingCheckpointRequest)(nil), "inference.inference.QueryTrainingCheckpointRequest")
+	proto.RegisterType((*QueryTrainingCheckpointResponse)(nil), "inference.inference.QueryTrainingCheckpointResponse")
+	proto.RegisterType((*QueryTrainingCheckpointsRequest)(nil), "inference.inference.QueryTrainingCheckpointsRequest")
+	proto.RegisterType((*QueryTrainingCheckpointsResponse)(nil), "inference.inference.QueryTrainingCheckpointsResponse")
 	proto.RegisterType((*QueryTrainingBarrierRequest)(nil), "inference.inference.QueryTrainingBarrierRequest")
 	proto.RegisterType((*QueryTrainingBarrierResponse)(nil), "inference.inference.QueryTrainingBarrierResponse")
 	proto.RegisterType((*QueryTrainingAliveNodesRequest)(nil), "inference.inference.QueryTrainingAliveNodesRequest")
@@ -6354,6 +7294,10 @@ func init() {
 	proto.RegisterType((*QueryGetBridgeTransactionResponse)(nil), "inference.inference.QueryGetBridgeTransactionResponse")
 	proto.RegisterType((*QueryAllBridgeTransactionsRequest)(nil), "inference.inference.QueryAllBridgeTransactionsRequest")
 	proto.RegisterType((*QueryAllBridgeTransactionsResponse)(nil), "inference.inference.QueryAllBridgeTransactionsResponse")
+	proto.RegisterType((*QueryGetBridgeBlockHeaderRequest)(nil), "inference.inference.QueryGetBridgeBlockHeaderRequest")
+	proto.RegisterType((*QueryGetBridgeBlockHeaderResponse)(nil), "inference.inference.QueryGetBridgeBlockHeaderResponse")
+	proto.RegisterType((*QueryGetBridgeWithdrawalRequest)(nil), "inference.inference.QueryGetBridgeWithdrawalRequest")
+	proto.RegisterType((*QueryGetBridgeWithdrawalResponse)(nil), "inference.inference.QueryGetBridgeWithdrawalResponse")
 	proto.RegisterType((*QueryEpochInfoRequest)(nil), "inference.inference.QueryEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "inference.inference.QueryEpochInfoResponse")
 	proto.RegisterType((*QueryCountPoCbatchesAtHeightRequest)(nil), "inference.inference.QueryCountPoCbatchesAtHeightRequest")
@@ -6373,355 +7317,405 @@ func init() {
 	proto.RegisterType((*QueryGranteesByMessageTypeRequest)(nil), "inference.inference.QueryGranteesByMessageTypeRequest")
 	proto.RegisterType((*Grantee)(nil), "inference.inference.Grantee")
 	proto.RegisterType((*QueryGranteesByMessageTypeResponse)(nil), "inference.inference.QueryGranteesByMessageTypeResponse")
+	proto.RegisterType((*QueryGetDeveloperSubKeyRequest)(nil), "inference.inference.QueryGetDeveloperSubKeyRequest")
+	proto.RegisterType((*QueryGetDeveloperSubKeyResponse)(nil), "inference.inference.QueryGetDeveloperSubKeyResponse")
+	proto.RegisterType((*QueryDeveloperSubKeysRequest)(nil), "inference.inference.QueryDeveloperSubKeysRequest")
+	proto.RegisterType((*QueryDeveloperSubKeysResponse)(nil), "inference.inference.QueryDeveloperSubKeysResponse")
 }
 
 func init() { proto.RegisterFile("inference/inference/query.proto", fileDescriptor_cf0cfe3b0e1cc5bd) }
 
 var fileDescriptor_cf0cfe3b0e1cc5bd = []byte{
-	// 5477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5d, 0x6d, 0x6c, 0x1c, 0x49,
-	0x5a, 0xde, 0xf6, 0x38, 0x59, 0xbb, 0xe2, 0x38, 0x76, 0x6d, 0xb2, 0x76, 0x26, 0x89, 0x93, 0xad,
-	0x24, 0x9b, 0xc4, 0x9b, 0x78, 0xf2, 0x9d, 0xcd, 0x26, 0x71, 0x62, 0x3b, 0x9f, 0x9b, 0x4d, 0xd6,
-	0x99, 0x7c, 0xec, 0x47, 0x6e, 0xb7, 0xe9, 0x99, 0x29, 0x8f, 0x5b, 0xee, 0x99, 0x9e, 0xed, 0xee,
-	0x49, 0x62, 0x8c, 0x0f, 0x81, 0xf8, 0x09, 0x82, 0xd3, 0xfd, 0x40, 0x02, 0xfe, 0x20, 0x40, 0x5a,
-	0x24, 0x7e, 0xdc, 0x49, 0x1c, 0x68, 0x8f, 0xe5, 0xe3, 0x90, 0x56, 0x5a, 0x71, 0xd2, 0xe9, 0xe0,
-	0xd0, 0x0a, 0x21, 0x74, 0x1c, 0xbb, 0x27, 0x40, 0x80, 0x38, 0x04, 0x12, 0x12, 0xbf, 0x38, 0x75,
-	0xd5, 0x5b, 0xfd, 0xdd, 0xd5, 0xdd, 0xe3, 0xd9, 0x3f, 0x91, 0xbb, 0xba, 0xea, 0xad, 0xf7, 0x79,
-	0xab, 0xba, 0xea, 0xad, 0xb7, 0xde, 0x67, 0x82, 0xf6, 0xea, 0xed, 0x25, 0x6a, 0xd1, 0x76, 0x9d,
-	0x56, 0xfc, 0xbf, 0x3e, 0xe8, 0x52, 0x6b, 0x75, 0xa6, 0x63, 0x99, 0x8e, 0x89, 0x5f, 0xf0, 0x8a,
-	0x67, 0xbc, 0xbf, 0xca, 0xe3, 0x5a, 0x4b, 0x6f, 0x9b, 0x15, 0xf6, 0x2f, 0xaf, 0x57, 0xde, 0xde,
-	0x34, 0x9b, 0x26, 0xfb, 0xb3, 0xe2, 0xfe, 0x05, 0xa5, 0xbb, 0x9b, 0xa6, 0xd9, 0x34, 0x68, 0x45,
-	0xeb, 0xe8, 0x15, 0xad, 0xdd, 0x36, 0x1d, 0xcd, 0xd1, 0xcd, 0xb6, 0x0d, 0x6f, 0xa7, 0xeb, 0xa6,
-	0xdd, 0x32, 0xed, 0x4a, 0x4d, 0xb3, 0xa1, 0xd3, 0xca, 0x93, 0x13, 0x35, 0xea, 0x68, 0x27, 0x2a,
-	0x1d, 0xad, 0xa9, 0xb7, 0x59, 0x65, 0xa8, 0xbb, 0x2f, 0x49, 0xd1, 0x8e, 0x66, 0x69, 0x2d, 0x21,
-	0x6d, 0x7f, 0x52, 0x0d, 0x5f, 0x7b, 0x5e, 0xe9, 0x60, 0x8a, 0x18, 0x47, 0xaf, 0xeb, 0x1d, 0xad,
-	0xed, 0x08, 0xcd, 0x92, 0xaa, 0xd1, 0x8e, 0x59, 0x5f, 0x56, 0x9b, 0x96, 0xd9, 0xed, 0xa8, 0x0d,
-	0xcd, 0xd1, 0xa0, 0xee, 0xa1, 0xa4, 0xba, 0x36, 0x75, 0x1c, 0x83, 0xaa, 0x5a, 0xcb, 0xec, 0x7a,
-	0x42, 0x4f, 0x64, 0x09, 0x7d, 0xa2, 0x19, 0x7a, 0x23, 0x64, 0x21, 0x92, 0xa8, 0xae, 0x59, 0xaf,
-	0x69, 0x4e, 0x7d, 0x19, 0xea, 0x1c, 0x49, 0xaa, 0xe3, 0x98, 0x2b, 0xb4, 0x6d, 0xb6, 0xf4, 0xba,
-	0x1d, 0x54, 0x35, 0xb1, 0x6a, 0xb7, 0xad, 0x3b, 0xaa, 0xb9, 0xa4, 0xd6, 0xcd, 0x56, 0xa7, 0xeb,
-	0x08, 0x43, 0x25, 0x4e, 0x8c, 0x96, 0xd9, 0xa0, 0x86, 0xcc, 0xdc, 0x8e, 0xd9, 0x51, 0x5b, 0x7a,
-	0x9b, 0x5a, 0x50, 0x69, 0x2a, 0x38, 0xc2, 0x62, 0x6c, 0xeb, 0xa6, 0x2e, 0x46, 0xf5, 0x15, 0xe9,
-	0x98, 0xa9, 0x8e, 0xde, 0xa2, 0x66, 0x57, 0xd8, 0xef, 0xac, 0xbc, 0xb2, 0x6f, 0x3d, 0xb5, 0x41,
-	0x1d, 0x4d, 0x37, 0x84, 0x11, 0x4f, 0xa5, 0xdb, 0xbd, 0x43, 0xad, 0x25, 0xd3, 0x6a, 0x69, 0x6e,
-	0x7b, 0xbb, 0xdb, 0x6a, 0x69, 0x62, 0xde, 0x27, 0x8f, 0xaa, 0x63, 0x69, 0x7a, 0x5b, 0x6f, 0x37,
-	0x55, 0x47, 0xb3, 0x57, 0xa0, 0xe2, 0xd1, 0xcc, 0x8a, 0xaa, 0xbd, 0xda, 0xae, 0xcb, 0xc4, 0x2e,
-	0x6b, 0x56, 0xe3, 0xa9, 0x66, 0x51, 0xb5, 0x6d, 0x36, 0xa8, 0x6c, 0xa8, 0xd8, 0x44, 0xd5, 0x0c,
-	0xb5, 0xdb, 0x69, 0x5a, 0x9a, 0x57, 0xf5, 0xe5, 0xa4, 0xaa, 0x6d, 0xea, 0x3c, 0x35, 0xad, 0x95,
-	0xa0, 0xc8, 0x44, 0x4d, 0x6b, 0x96, 0xde, 0x68, 0x52, 0xd5, 0xb1, 0xb4, 0xb6, 0xad, 0xd5, 0x03,
-	0x1f, 0x5c, 0xa2, 0x02, 0xb6, 0xa3, 0x39, 0xb6, 0xda, 0xa0, 0x4f, 0xa8, 0x61, 0x76, 0xbc, 0x51,
-	0xde, 0x9b, 0x6a, 0x60, 0x5e, 0x81, 0x6c, 0x47, 0xf8, 0x9e, 0xfb, 0x79, 0x2f, 0xb2, 0xef, 0xb5,
-	0x4a, 0x3f, 0xe8, 0x52, 0xdb, 0x21, 0x0f, 0xd1, 0x0b, 0xa1, 0x52, 0xbb, 0x63, 0xb6, 0x6d, 0x8a,
-	0x67, 0xd1, 0x66, 0xfe, 0x5d, 0x4f, 0x2a, 0xfb, 0x94, 0xc3, 0x5b, 0x4e, 0xee, 0x9a, 0x49, 0x58,
-	0x82, 0x66, 0x78, 0xa3, 0xf9, 0xe1, 0x4f, 0x7f, 0xb8, 0xf7, 0xb9, 0x0f, 0xff, 0xe5, 0x1b, 0xd3,
-	0x4a, 0x15, 0x5a, 0x91, 0xe3, 0x68, 0x92, 0x89, 0xbd, 0x41, 0x9d, 0x5b, 0xa2, 0x3a, 0x74, 0x89,
-	0xb7, 0xa3, 0x4d, 0x7a, 0xbb, 0x41, 0x9f, 0x31, 0xd1, 0xc3, 0x55, 0xfe, 0x40, 0x54, 0xb4, 0x33,
-	0xa1, 0x05, 0xa8, 0x33, 0x8f, 0x86, 0xbd, 0x5e, 0x41, 0xa3, 0xa9, 0x44, 0x8d, 0xbc, 0xa6, 0xf3,
-	0x83, 0xae, 0x52, 0x55, 0xbf, 0x19, 0xa9, 0x81, 0x4a, 0x73, 0x86, 0x11, 0x53, 0xe9, 0x3a, 0x42,
-	0xfe, 0x62, 0x07, 0x1d, 0xbc, 0x3c, 0xc3, 0xbf, 0x9b, 0x19, 0xf7, 0xbb, 0x99, 0xe1, 0xcb, 0x31,
-	0x7c, 0x3d, 0x33, 0x8b, 0x5a, 0x53, 0xb4, 0xad, 0x06, 0x5a, 0x92, 0x0f, 0x15, 0x40, 0x11, 0xee,
-	0x24, 0x19, 0x45, 0xa9, 0x07, 0x14, 0xf8, 0x46, 0x48, 0xd3, 0x01, 0xa6, 0xe9, 0xa1, 0x4c, 0x4d,
-	0xb9, 0x02, 0x21, 0x55, 0x4f, 0xa2, 0xb2, 0xb0, 0xf7, 0xa2, 0xbf, 0xf4, 0xca, 0xc7, 0xa8, 0x89,
-	0x76, 0x25, 0xb6, 0x01, 0x7c, 0x37, 0xd1, 0x96, 0xc0, 0x2a, 0x0e, 0x66, 0xdc, 0x97, 0x36, 0x73,
-	0x44, 0x3d, 0xc0, 0x18, 0x6c, 0x4a, 0x1a, 0xa0, 0xdc, 0x9c, 0x61, 0x24, 0x28, 0xd7, 0xaf, 0xd1,
-	0xfa, 0x81, 0x02, 0x78, 0xa2, 0xdd, 0xa4, 0xe1, 0x29, 0xf5, 0x88, 0xa7, 0x6f, 0xa3, 0x86, 0x5f,
-	0x42, 0x23, 0x35, 0xc3, 0xac, 0xaf, 0xa8, 0xcb, 0x54, 0x6f, 0x2e, 0x3b, 0x93, 0xa5, 0x7d, 0xca,
-	0xe1, 0x52, 0x75, 0x0b, 0x2b, 0xbb, 0xc9, 0x8a, 0xc8, 0x45, 0xb4, 0x8f, 0x81, 0xf2, 0x26, 0x51,
-	0x82, 0x05, 0x27, 0xd1, 0xf3, 0x5a, 0xa3, 0x61, 0x51, 0xdb, 0x86, 0x01, 0x16, 0x8f, 0xe4, 0x21,
-	0x7a, 0x49, 0xd2, 0x1a, 0x0c, 0xf3, 0x22, 0xda, 0xdc, 0xe9, 0xd6, 0x56, 0xe8, 0x2a, 0xb4, 0x86,
-	0x27, 0x57, 0x6c, 0x4d, 0x33, 0xdc, 0x85, 0x9c, 0x61, 0x2c, 0x55, 0xc5, 0x23, 0x39, 0x83, 0xf6,
-	0x88, 0x99, 0x53, 0xd5, 0xda, 0x0d, 0xb3, 0x75, 0xed, 0x19, 0xad, 0x77, 0x1d, 0xd3, 0x0a, 0x4c,
-	0x38, 0xb6, 0xb1, 0x89, 0x09, 0xc7, 0x1e, 0x48, 0x03, 0x4d, 0xa5, 0x35, 0xf3, 0xbe, 0xa9, 0x21,
-	0x0a, 0x65, 0x05, 0x27, 0x9c, 0xd7, 0x8e, 0x3c, 0xf6, 0x95, 0xbb, 0xe6, 0x2e, 0x98, 0x37, 0x5c,
-	0x47, 0xe0, 0xaa, 0xe6, 0x68, 0x42, 0xb9, 0xbd, 0x68, 0x0b, 0xdf, 0xaa, 0xfc, 0x6f, 0x62, 0xb0,
-	0x8a, 0x58, 0xd1, 0x2d, 0xb7, 0x04, 0xef, 0x44, 0x43, 0x4c, 0x61, 0x55, 0x6f, 0x30, 0xe4, 0xc3,
-	0xd5, 0xe7, 0xd9, 0xf3, 0xad, 0x06, 0xe9, 0xfa, 0x10, 0xa2, 0xc2, 0x01, 0xc2, 0x7d, 0x34, 0x16,
-	0xf5, 0x6a, 0x00, 0xca, 0xfe, 0x44, 0x28, 0x61, 0x31, 0x80, 0x66, 0x94, 0x86, 0x4a, 0x49, 0x13,
-	0x30, 0xcd, 0x19, 0x46, 0x32, 0xa6, 0x7e, 0x7d, 0x44, 0x7f, 0xa6, 0x00, 0xc0, 0x84, 0x9e, 0xa4,
-	0x00, 0x4b, 0x1b, 0x02, 0xd8, 0xbf, 0x85, 0xf0, 0xb2, 0xbf, 0xa8, 0xdd, 0x67, 0x0e, 0xe3, 0x1c,
-	0xf3, 0x17, 0x85, 0x9d, 0xf6, 0xc5, 0x17, 0xb5, 0xe1, 0xf0, 0x62, 0x65, 0xa0, 0xdd, 0xc9, 0x02,
-	0x00, 0xfe, 0x1b, 0x68, 0x6b, 0xc8, 0x13, 0x05, 0x63, 0xbf, 0x94, 0x88, 0x3d, 0x28, 0x01, 0x90,
-	0x8f, 0xd8, 0x81, 0x32, 0x42, 0xfd, 0x35, 0x2b, 0x49, 0xdd, 0x7e, 0x0d, 0xeb, 0xb7, 0x14, 0x40,
-	0x15, 0xeb, 0x27, 0x1d, 0x55, 0xa9, 0x67, 0x54, 0xfd, 0x1b, 0x4d, 0x1d, 0x1d, 0x88, 0x7f, 0x6e,
-	0x8f, 0x7c, 0x9f, 0x3e, 0xf7, 0xb0, 0x46, 0x3f, 0xfa, 0x81, 0xe8, 0x47, 0x4f, 0xbe, 0xa6, 0xa0,
-	0x83, 0x19, 0x7d, 0x81, 0xad, 0x96, 0xd1, 0x44, 0xca, 0x11, 0x03, 0x46, 0x68, 0x3a, 0xe3, 0x3b,
-	0x08, 0x08, 0x05, 0xf3, 0xed, 0xa0, 0x49, 0x2f, 0x49, 0x1b, 0xe0, 0x87, 0x3e, 0xc6, 0x04, 0xf8,
-	0xfd, 0x9a, 0x26, 0x7f, 0x2f, 0x6c, 0x90, 0xde, 0x61, 0x1e, 0x1b, 0x94, 0xfa, 0x68, 0x83, 0xfe,
-	0xcd, 0xa5, 0x05, 0x58, 0xd9, 0x16, 0xcd, 0xfa, 0xbc, 0x7b, 0xd6, 0xa3, 0xf6, 0x75, 0xd3, 0xba,
-	0xef, 0xf8, 0xa6, 0x88, 0x6d, 0xc7, 0x4a, 0x7c, 0x3b, 0xee, 0xa2, 0xbd, 0xa9, 0x42, 0xc0, 0x34,
-	0x55, 0x34, 0xdc, 0x31, 0xeb, 0x2a, 0x3b, 0x4f, 0x82, 0x31, 0x2a, 0xc9, 0x9b, 0x98, 0xb9, 0x00,
-	0x32, 0xde, 0xd2, 0x9d, 0xe5, 0xc0, 0x96, 0x26, 0x2c, 0x32, 0xd4, 0x81, 0x5e, 0xc8, 0x47, 0x0a,
-	0x2a, 0xa7, 0x57, 0xcf, 0x31, 0xfd, 0x27, 0xd0, 0xf3, 0x9d, 0x6e, 0x4d, 0x75, 0x37, 0xf9, 0x01,
-	0x6f, 0x93, 0xbf, 0x4d, 0x57, 0xf1, 0x14, 0xda, 0xb2, 0x4c, 0x9f, 0xa9, 0xe2, 0x65, 0x89, 0xbd,
-	0x1c, 0x5e, 0xa6, 0xcf, 0x16, 0xf9, 0xfb, 0x2b, 0x41, 0x34, 0x83, 0x0c, 0xcd, 0x1e, 0x29, 0x9a,
-	0x98, 0xee, 0x7b, 0xfc, 0x15, 0x79, 0xa1, 0x6b, 0x59, 0xb4, 0xcd, 0x3f, 0x2f, 0x71, 0x64, 0x39,
-	0xed, 0xaf, 0xb7, 0xe1, 0xd7, 0x60, 0xce, 0xed, 0x68, 0x13, 0x9b, 0x18, 0xb0, 0x4f, 0xf3, 0x07,
-	0xb2, 0xd7, 0xdf, 0xe4, 0x1f, 0x78, 0xe7, 0xf2, 0xc0, 0x86, 0x48, 0x1c, 0x7f, 0xa3, 0x8e, 0x56,
-	0xf0, 0xc6, 0x69, 0x5b, 0xe4, 0x48, 0x2f, 0xdd, 0xa7, 0xc3, 0x52, 0xc4, 0x36, 0xe6, 0x84, 0x4a,
-	0xc9, 0x1d, 0x74, 0x44, 0xf4, 0xfa, 0xb0, 0xad, 0x3b, 0x6f, 0x2e, 0x2d, 0xf0, 0x08, 0xc0, 0xa2,
-	0xa5, 0xd7, 0xe9, 0xa2, 0x65, 0x76, 0x4c, 0x5b, 0x33, 0xf2, 0xef, 0x45, 0x5f, 0x57, 0xd0, 0x74,
-	0x1e, 0x79, 0x80, 0xe8, 0x36, 0x1a, 0xea, 0x40, 0x19, 0x40, 0x49, 0x9e, 0x78, 0x12, 0x51, 0x9e,
-	0x00, 0xd7, 0xfb, 0x6b, 0xd0, 0x25, 0xad, 0x6b, 0x38, 0xb0, 0x58, 0x8a, 0x47, 0xb2, 0x1f, 0x9c,
-	0xca, 0xe0, 0x70, 0x45, 0x1d, 0x12, 0xb2, 0x8a, 0x88, 0xac, 0xd2, 0x97, 0xe9, 0x2c, 0x1d, 0x80,
-	0xae, 0x17, 0x2d, 0xfa, 0x44, 0x37, 0xbb, 0x76, 0xb2, 0x82, 0x3f, 0x8b, 0xf6, 0x4b, 0x6b, 0x7d,
-	0x99, 0x1a, 0x4e, 0xa0, 0x1d, 0xac, 0xef, 0x3b, 0xae, 0x57, 0x69, 0xcf, 0x19, 0x62, 0x4a, 0x90,
-	0x45, 0xf4, 0x62, 0xf4, 0x05, 0xe8, 0x71, 0xd6, 0xf7, 0xa8, 0xdd, 0x6f, 0xb0, 0x9c, 0xd8, 0x39,
-	0x6b, 0x06, 0x7d, 0x82, 0xcf, 0x7d, 0x0a, 0x4d, 0xf8, 0xdf, 0x41, 0xe7, 0x8e, 0xde, 0xa6, 0x56,
-	0xf6, 0xb1, 0xe1, 0x2b, 0xfe, 0x79, 0xdf, 0x6f, 0x04, 0x8a, 0x5c, 0x41, 0xc3, 0x5e, 0x48, 0x0a,
-	0x2c, 0xb1, 0x27, 0xe5, 0x83, 0xe1, 0x2d, 0xc5, 0x82, 0xe0, 0xc0, 0x33, 0xd1, 0x40, 0xa5, 0x39,
-	0xc3, 0x88, 0xaa, 0xd4, 0xaf, 0x8d, 0xec, 0xf7, 0x14, 0x3f, 0x3c, 0x90, 0x85, 0xa0, 0x54, 0x18,
-	0x41, 0xff, 0xf6, 0x24, 0x13, 0xb6, 0x93, 0x60, 0x98, 0xe4, 0x01, 0x8f, 0xd0, 0x09, 0x93, 0x4c,
-	0xa3, 0x31, 0xfa, 0xac, 0xa3, 0x5b, 0xac, 0xc1, 0x4d, 0x7f, 0x63, 0x1a, 0xac, 0xc6, 0xca, 0xdd,
-	0x15, 0xc5, 0xd3, 0xfe, 0x96, 0x38, 0xbb, 0x04, 0x8b, 0xc8, 0xcf, 0xc1, 0x71, 0x32, 0xb1, 0x43,
-	0xb0, 0xcf, 0xdb, 0x68, 0x3c, 0x16, 0x2f, 0x84, 0xb1, 0x38, 0x28, 0x0f, 0x70, 0x80, 0x24, 0xb0,
-	0xd7, 0x98, 0x1e, 0x29, 0x27, 0x3a, 0xc0, 0x0d, 0xc6, 0x53, 0x22, 0x70, 0xfb, 0x35, 0x03, 0x3e,
-	0x51, 0x00, 0x69, 0x62, 0x5f, 0x72, 0xa4, 0xa5, 0x0d, 0x23, 0xed, 0xdf, 0x0c, 0x69, 0xfa, 0x3b,
-	0x8a, 0xd7, 0xb9, 0xef, 0x1e, 0x5d, 0xe5, 0x51, 0xd9, 0xc0, 0x17, 0xcd, 0x3d, 0xda, 0x06, 0x4c,
-	0x11, 0xf1, 0x98, 0x63, 0x66, 0xfc, 0x4e, 0x60, 0xaf, 0x91, 0xf5, 0x04, 0xa6, 0xeb, 0xa2, 0xb2,
-	0x9e, 0x5a, 0x4b, 0xba, 0xfb, 0xa4, 0x0b, 0x07, 0x6b, 0x4a, 0x04, 0x13, 0x1b, 0xcc, 0x11, 0x1c,
-	0xd5, 0x54, 0x73, 0xf4, 0x6b, 0x2e, 0xfd, 0x87, 0x30, 0x4d, 0x46, 0xaf, 0x39, 0x4d, 0x53, 0xfa,
-	0x52, 0x4c, 0xd3, 0xbf, 0x29, 0xf7, 0x3e, 0x3a, 0x2a, 0x99, 0x08, 0x2c, 0x4a, 0x4c, 0x1d, 0x6a,
-	0x79, 0x66, 0x1e, 0x43, 0x25, 0xbd, 0xc1, 0x81, 0x0d, 0x57, 0xdd, 0x3f, 0xf1, 0x6e, 0x34, 0x6c,
-	0xf1, 0x97, 0xd4, 0x82, 0xb9, 0xe6, 0x17, 0x90, 0xaf, 0x0d, 0xa0, 0x63, 0x39, 0x3b, 0x00, 0x8b,
-	0x1e, 0x42, 0xdb, 0xe0, 0x84, 0x61, 0x5a, 0x6a, 0xc7, 0x7c, 0x0a, 0x3b, 0xcf, 0x60, 0x75, 0xd4,
-	0x2b, 0x5e, 0x74, 0x4b, 0xf1, 0x41, 0x34, 0x5a, 0xe7, 0x0e, 0x87, 0xf0, 0xe1, 0xb9, 0xef, 0xb2,
-	0x15, 0x4a, 0x61, 0x9d, 0xbc, 0xe5, 0xfa, 0x36, 0x7c, 0x38, 0x4a, 0x3d, 0x0d, 0x47, 0x55, 0xb4,
-	0xc7, 0xd7, 0x5c, 0xab, 0x0b, 0x85, 0x27, 0x07, 0x25, 0xab, 0x64, 0x04, 0xa1, 0x5d, 0x0d, 0x34,
-	0x24, 0x26, 0x7a, 0x39, 0x74, 0xf8, 0x5c, 0xf4, 0x6f, 0x51, 0xee, 0xf3, 0x4b, 0x94, 0xdc, 0xd1,
-	0xab, 0x03, 0x68, 0x6b, 0xc0, 0x87, 0xf4, 0x3e, 0xf6, 0x70, 0x21, 0xf9, 0x75, 0x05, 0x1d, 0xca,
-	0xec, 0x11, 0xcc, 0x6f, 0xc0, 0x61, 0x2f, 0x5e, 0x05, 0x3e, 0xaa, 0xa3, 0xe9, 0xae, 0x50, 0xbc,
-	0x0d, 0x4c, 0xe5, 0x34, 0x91, 0xa4, 0x03, 0xa6, 0x10, 0x67, 0xd0, 0x74, 0x53, 0xf4, 0xeb, 0xfb,
-	0xfe, 0x91, 0xb0, 0x85, 0xac, 0xcb, 0x3c, 0xb6, 0x28, 0xf5, 0xd9, 0x16, 0xfd, 0xfb, 0xa6, 0xa7,
-	0xc1, 0x1f, 0x7a, 0x00, 0xb7, 0x68, 0x0f, 0x34, 0x7b, 0x45, 0x98, 0x71, 0x14, 0x0d, 0xe8, 0x62,
-	0xc3, 0x18, 0xd0, 0x1b, 0xa4, 0x0a, 0xb7, 0x1e, 0xe1, 0xba, 0x80, 0xff, 0x0c, 0x1a, 0x74, 0x34,
-	0x7b, 0x45, 0x1a, 0xf5, 0x0a, 0x35, 0x64, 0xd5, 0xc9, 0x25, 0x90, 0x79, 0x13, 0xee, 0xe5, 0xee,
-	0x9a, 0x0d, 0x9a, 0x3f, 0x7a, 0x43, 0x1e, 0xc1, 0x0d, 0x42, 0xa4, 0x39, 0xe8, 0xf4, 0x2a, 0xda,
-	0xd4, 0x76, 0x0b, 0x40, 0x29, 0x92, 0xa8, 0x54, 0xb8, 0x29, 0x6f, 0x40, 0xa6, 0xe0, 0xf0, 0x19,
-	0x7a, 0x19, 0xf0, 0xc7, 0xdf, 0x81, 0x63, 0x66, 0xfc, 0x7d, 0xbc, 0xeb, 0x52, 0xb1, 0xae, 0x5f,
-	0x02, 0x5f, 0xe8, 0x5e, 0x97, 0x76, 0x69, 0x23, 0x68, 0x32, 0xef, 0x36, 0xef, 0x31, 0xb8, 0x30,
-	0x89, 0x55, 0x40, 0x81, 0x73, 0x68, 0x93, 0x6b, 0x60, 0x5b, 0x1a, 0xb0, 0x0b, 0x0d, 0x08, 0xaf,
-	0xef, 0x1d, 0xcb, 0x83, 0xef, 0x02, 0xc8, 0xdf, 0x02, 0xcb, 0xc4, 0x5e, 0x6f, 0xb4, 0xdf, 0xbb,
-	0xfe, 0x4a, 0x17, 0x88, 0x61, 0xc0, 0x31, 0xf1, 0xbe, 0xa3, 0x39, 0xde, 0xb4, 0x88, 0x2d, 0x64,
-	0x4a, 0xd2, 0x42, 0xa6, 0xf9, 0xeb, 0x58, 0xaa, 0x3c, 0xff, 0xa2, 0xe3, 0x69, 0xd0, 0x81, 0x86,
-	0x27, 0x3c, 0x85, 0x90, 0x45, 0x3b, 0x5d, 0xc7, 0xff, 0xca, 0x36, 0x55, 0x03, 0x25, 0xe4, 0x15,
-	0xdf, 0x07, 0x0b, 0xdf, 0x2d, 0x25, 0x68, 0x4d, 0xfe, 0x36, 0xe0, 0x47, 0xc9, 0x6a, 0x7b, 0x81,
-	0xb4, 0x9d, 0x01, 0x3c, 0xaa, 0xd8, 0xbd, 0xd8, 0xad, 0xb0, 0x74, 0x45, 0x49, 0x13, 0x3c, 0xd1,
-	0x49, 0x7e, 0x11, 0x8b, 0x6e, 0x0d, 0xc4, 0xa2, 0x5b, 0x78, 0x27, 0x1a, 0x82, 0xbd, 0xa5, 0x01,
-	0x77, 0x51, 0xc2, 0x81, 0x24, 0xcf, 0xd0, 0x44, 0x4a, 0x8f, 0xee, 0xa6, 0x1b, 0x84, 0xa0, 0x27,
-	0x0f, 0x54, 0xc0, 0xfa, 0x03, 0x12, 0xeb, 0x97, 0x62, 0xd6, 0xff, 0xe6, 0x00, 0xda, 0x1e, 0xe8,
-	0xfa, 0x7a, 0xd7, 0x30, 0x78, 0xbf, 0x87, 0xd0, 0x36, 0xad, 0x5e, 0x37, 0xbb, 0x6d, 0x47, 0x0d,
-	0x9f, 0x63, 0x47, 0xa1, 0x78, 0x8e, 0x97, 0xe2, 0x23, 0x68, 0xcc, 0xec, 0x50, 0x8b, 0x79, 0x0f,
-	0xa2, 0x26, 0xdf, 0x14, 0xb7, 0x89, 0x72, 0x51, 0x35, 0x43, 0x19, 0x7c, 0x01, 0x95, 0xa9, 0x66,
-	0xb5, 0x69, 0x43, 0xad, 0x9b, 0x7a, 0xdb, 0xf6, 0xc6, 0x8b, 0x87, 0xa8, 0x06, 0x19, 0xb0, 0x09,
-	0x5e, 0x63, 0xc1, 0xad, 0x10, 0x0c, 0x7f, 0xe0, 0x4b, 0x68, 0x97, 0x45, 0x9f, 0x6a, 0x56, 0xc3,
-	0x6b, 0x6e, 0x68, 0x0e, 0xb5, 0x45, 0xeb, 0x4d, 0xac, 0xf5, 0xa4, 0xa8, 0xc2, 0xda, 0xbf, 0xc1,
-	0x2a, 0xf0, 0xe6, 0x47, 0x20, 0x14, 0x61, 0xb3, 0xbc, 0x12, 0x83, 0x3a, 0xb4, 0x31, 0xb9, 0x79,
-	0x9f, 0x72, 0x78, 0x6b, 0x75, 0x1b, 0x2f, 0x5f, 0x10, 0xc5, 0x5e, 0x88, 0x26, 0x18, 0x25, 0xf4,
-	0x0c, 0x27, 0x66, 0xea, 0x57, 0x45, 0x9c, 0x24, 0xb9, 0x92, 0x77, 0x46, 0xc2, 0x81, 0x71, 0xb4,
-	0x43, 0x33, 0xf3, 0x48, 0xd6, 0xcc, 0xf4, 0xc5, 0x8d, 0x07, 0x85, 0xb0, 0x22, 0xf2, 0x55, 0x74,
-	0x98, 0xf5, 0xcf, 0x9e, 0xe6, 0x57, 0xdd, 0xa3, 0xd3, 0x22, 0xb5, 0x74, 0xb3, 0x31, 0xbf, 0x7a,
-	0x55, 0xa4, 0x43, 0x88, 0xb5, 0x60, 0x37, 0x1a, 0xf6, 0x52, 0x24, 0x60, 0x94, 0xfd, 0x02, 0xbc,
-	0x0b, 0x0d, 0xbb, 0xa7, 0x37, 0x75, 0xc9, 0x32, 0x5b, 0x30, 0xaf, 0x87, 0xdc, 0x82, 0xeb, 0x96,
-	0xd9, 0xc2, 0x13, 0xe8, 0x79, 0xf6, 0xd2, 0x31, 0x61, 0x4e, 0x6f, 0x76, 0x1f, 0x1f, 0x98, 0xc4,
-	0x80, 0xcf, 0x5a, 0xde, 0x3f, 0x98, 0xe1, 0x32, 0xda, 0x94, 0x8d, 0xdc, 0x6b, 0x16, 0x10, 0x59,
-	0xe5, 0xed, 0xc8, 0x32, 0x38, 0xbd, 0xf0, 0xca, 0xab, 0x3a, 0xd7, 0x6e, 0xb0, 0xe1, 0x9d, 0xd7,
-	0xea, 0x2b, 0xee, 0xc0, 0xdb, 0xf9, 0x20, 0x8b, 0x4f, 0xd5, 0x56, 0xc5, 0x8a, 0xc5, 0x3f, 0x55,
-	0xfb, 0x2e, 0xa9, 0xa2, 0x33, 0xe1, 0x4b, 0x5f, 0x7b, 0xae, 0xdd, 0x60, 0xe1, 0x4b, 0x1b, 0x3a,
-	0x67, 0x1d, 0xda, 0xb1, 0x1e, 0x83, 0x32, 0x95, 0xb0, 0xcc, 0x65, 0x74, 0x3c, 0x43, 0xa6, 0x6f,
-	0x3e, 0x21, 0xae, 0xb7, 0x51, 0x69, 0xc0, 0xe9, 0x23, 0xbd, 0x27, 0x1e, 0x1c, 0xdb, 0x58, 0x2f,
-	0x2a, 0x42, 0x4c, 0x0c, 0x5f, 0x49, 0x12, 0xaf, 0xab, 0x5d, 0xc9, 0x9a, 0xae, 0xb2, 0x08, 0xaf,
-	0x2d, 0x24, 0x6b, 0x3a, 0x57, 0xc5, 0x5d, 0x28, 0xbc, 0x71, 0xb7, 0xc5, 0x42, 0xe1, 0x97, 0x10,
-	0x1b, 0x86, 0x3b, 0x1b, 0x86, 0x77, 0xf5, 0x3d, 0xc2, 0x53, 0x81, 0x58, 0xe7, 0x62, 0x9e, 0xed,
-	0x4d, 0x8f, 0xf3, 0xf1, 0xef, 0x6a, 0x0b, 0x6b, 0xc4, 0x65, 0x91, 0xdf, 0x10, 0xf7, 0x37, 0x69,
-	0xbd, 0x7a, 0xbd, 0x85, 0xb0, 0x29, 0x52, 0x6c, 0x03, 0x51, 0x6c, 0xf8, 0x34, 0x7a, 0x51, 0xab,
-	0x3b, 0x5d, 0xcd, 0x50, 0xfd, 0x42, 0xb5, 0x6e, 0xda, 0x22, 0x81, 0x61, 0x3b, 0x7f, 0xeb, 0x2b,
-	0xb1, 0x60, 0xda, 0x0e, 0x21, 0xe0, 0xcd, 0x2c, 0xb0, 0xa5, 0x39, 0xb4, 0x33, 0x7a, 0x4b, 0xd2,
-	0x79, 0x11, 0x5a, 0x4e, 0xac, 0xe3, 0xdf, 0x08, 0x38, 0xa6, 0x03, 0x31, 0xee, 0x52, 0x95, 0x3f,
-	0x90, 0x49, 0x08, 0x9d, 0x5e, 0xa5, 0xb5, 0x6e, 0x33, 0xb4, 0xce, 0x7d, 0xaf, 0x04, 0x01, 0xc7,
-	0xe0, 0x2b, 0x90, 0x55, 0x43, 0x5b, 0xb9, 0xd5, 0x6b, 0xab, 0x2c, 0x00, 0x04, 0x66, 0x9f, 0x4d,
-	0x34, 0x7b, 0x8a, 0x90, 0x99, 0x07, 0xb4, 0xd5, 0x31, 0x2d, 0xcd, 0x62, 0xdf, 0x80, 0xfb, 0x0a,
-	0x46, 0x85, 0x7f, 0x14, 0x98, 0xa2, 0x51, 0xaf, 0x0f, 0xbe, 0xd2, 0x0f, 0xb0, 0x4e, 0x2e, 0xf7,
-	0xd6, 0x09, 0xfb, 0x78, 0x59, 0x2f, 0x23, 0x76, 0xe0, 0x73, 0x2e, 0x5b, 0x68, 0x3c, 0xa6, 0x48,
-	0xc6, 0x22, 0xe2, 0x2d, 0x6a, 0x03, 0xbd, 0x2d, 0x6a, 0x65, 0x07, 0xe1, 0xb8, 0x5e, 0x19, 0x9d,
-	0x5e, 0x09, 0x77, 0x3a, 0x9d, 0xab, 0x53, 0x7e, 0x27, 0x04, 0x4b, 0xe9, 0x11, 0xdf, 0xe5, 0xbb,
-	0xa3, 0xb7, 0xf5, 0x56, 0xb7, 0xe5, 0x9f, 0xad, 0xe7, 0x9e, 0x50, 0xcb, 0x3f, 0xe6, 0x91, 0x6f,
-	0x28, 0xb0, 0xc9, 0x48, 0xeb, 0xc2, 0x64, 0xd8, 0x8f, 0xb6, 0x3a, 0x96, 0xb6, 0xb4, 0xa4, 0xd7,
-	0xd5, 0x9a, 0x66, 0xeb, 0x36, 0xb8, 0x89, 0x23, 0x50, 0x38, 0xef, 0x96, 0xe1, 0x8b, 0xa8, 0xdc,
-	0xe2, 0x82, 0x82, 0xe9, 0x91, 0x1a, 0x17, 0x05, 0x6e, 0xc5, 0x64, 0x2b, 0xa5, 0xab, 0xc4, 0x8c,
-	0x9f, 0xc1, 0xf0, 0x15, 0xe3, 0x41, 0xb8, 0x98, 0xb8, 0xd5, 0x5e, 0xb4, 0xcc, 0xa6, 0xeb, 0x95,
-	0x24, 0x1e, 0x0e, 0x54, 0xb8, 0x1b, 0x4e, 0xad, 0xb6, 0x51, 0x47, 0xfd, 0x9c, 0x7f, 0xc5, 0xb6,
-	0xc8, 0x93, 0x24, 0x1f, 0xf2, 0x1c, 0x49, 0xb1, 0xf2, 0xbe, 0x88, 0x36, 0x2f, 0x87, 0xdc, 0x69,
-	0xfe, 0x44, 0x6c, 0xff, 0xea, 0x2d, 0xda, 0x10, 0x74, 0xba, 0x07, 0x1e, 0xa3, 0xf7, 0x46, 0x7a,
-	0xa5, 0x12, 0x16, 0x22, 0xae, 0x54, 0xc2, 0x02, 0x82, 0x19, 0x32, 0xc9, 0xda, 0xf6, 0x2b, 0x58,
-	0xf0, 0x71, 0x20, 0x43, 0xa6, 0x00, 0xbc, 0xd2, 0x86, 0xe0, 0xf5, 0x2f, 0x10, 0x70, 0x33, 0x72,
-	0xae, 0xbb, 0xfd, 0xa4, 0x4a, 0xeb, 0xa6, 0xd5, 0x08, 0x0c, 0xaa, 0x3b, 0xfc, 0x5e, 0x04, 0x19,
-	0x9e, 0xf0, 0x18, 0x2a, 0xf9, 0x97, 0xc7, 0xee, 0x9f, 0xa4, 0x06, 0x16, 0x8f, 0x4b, 0x02, 0x33,
-	0xcc, 0xa1, 0xcd, 0x16, 0x2b, 0x01, 0x6b, 0x1f, 0xc9, 0x9c, 0x7a, 0xb7, 0x1f, 0x81, 0x08, 0x68,
-	0x48, 0x66, 0x61, 0x92, 0xbf, 0xa1, 0xdb, 0x4e, 0xb4, 0x9f, 0xdb, 0x74, 0xd5, 0xce, 0xd0, 0x9a,
-	0x5c, 0x80, 0xfd, 0x30, 0xbd, 0x3d, 0xe8, 0x8a, 0xd1, 0xe0, 0x0a, 0x5d, 0x15, 0x41, 0x4c, 0xf6,
-	0x37, 0x79, 0x2f, 0x72, 0x42, 0x9e, 0xd7, 0x2c, 0x4b, 0xf7, 0x5d, 0xd2, 0x59, 0x54, 0xb2, 0xe8,
-	0x07, 0xd2, 0x08, 0xd8, 0x0d, 0xea, 0x40, 0x23, 0x77, 0x19, 0xeb, 0x0a, 0x75, 0xab, 0x6e, 0x43,
-	0xa2, 0x45, 0x46, 0xc2, 0x13, 0xef, 0x99, 0x6f, 0xd0, 0xa2, 0x76, 0x07, 0x06, 0xfb, 0x58, 0xce,
-	0x0e, 0x60, 0xc8, 0x59, 0x53, 0xf2, 0x1e, 0x4c, 0x55, 0xd1, 0xc5, 0x9c, 0xa1, 0x3f, 0x09, 0x87,
-	0x5e, 0x2e, 0x04, 0x41, 0x1c, 0x49, 0xeb, 0x23, 0xd6, 0x4e, 0x20, 0xd8, 0x9b, 0x2a, 0xde, 0xcb,
-	0x3c, 0x0e, 0x82, 0x98, 0xce, 0xd3, 0x41, 0x08, 0xc1, 0xaf, 0x28, 0xfe, 0x85, 0xd5, 0x3c, 0xcb,
-	0xab, 0x7e, 0xe0, 0xa7, 0x55, 0x07, 0xf2, 0x36, 0x4c, 0x4b, 0x6f, 0xea, 0x6d, 0xb5, 0xbe, 0xac,
-	0xe9, 0x6d, 0x11, 0x40, 0xe2, 0x65, 0x0b, 0x6e, 0x91, 0xbf, 0xee, 0xb6, 0xbb, 0xad, 0x9a, 0x17,
-	0x94, 0xe6, 0xeb, 0xee, 0x5d, 0x56, 0xe4, 0xae, 0xfe, 0x16, 0xad, 0x53, 0xbd, 0xe3, 0x40, 0x68,
-	0x95, 0xe7, 0x42, 0x8c, 0x40, 0x21, 0xcf, 0x12, 0xfa, 0x79, 0x70, 0x50, 0x92, 0xd5, 0x01, 0xd0,
-	0xef, 0xa2, 0xf1, 0x5a, 0xf4, 0xa5, 0xb7, 0xe2, 0x24, 0x59, 0x20, 0x26, 0x0a, 0x56, 0x81, 0xb8,
-	0x18, 0xb2, 0x02, 0x0a, 0xcc, 0x19, 0x46, 0xac, 0x55, 0xdf, 0x2f, 0x3e, 0xbe, 0xab, 0xc0, 0x11,
-	0x31, 0xa5, 0x37, 0xc0, 0xfb, 0x15, 0x84, 0x63, 0x8a, 0x8a, 0xfd, 0xa6, 0x18, 0xe0, 0x04, 0x39,
-	0xfd, 0x5b, 0xfa, 0xc4, 0xad, 0xfb, 0x35, 0x1e, 0x2b, 0x5f, 0x32, 0xc5, 0x56, 0xfa, 0x27, 0x0a,
-	0xf8, 0x8e, 0x81, 0x37, 0x00, 0x2d, 0x3b, 0x25, 0x08, 0x9f, 0xf7, 0x92, 0xeb, 0x07, 0xb2, 0x93,
-	0xeb, 0x39, 0x4c, 0x68, 0x80, 0x17, 0xd0, 0x48, 0x28, 0x02, 0x50, 0x62, 0x02, 0xca, 0xe9, 0x11,
-	0x64, 0x91, 0x8d, 0x6c, 0xf8, 0x61, 0x01, 0x72, 0x03, 0xfc, 0x05, 0xe6, 0x33, 0x2f, 0x9a, 0x0b,
-	0x35, 0x9e, 0x24, 0x34, 0x07, 0x97, 0x1d, 0x81, 0x20, 0x6b, 0x40, 0x6b, 0x38, 0xdf, 0x45, 0x52,
-	0x8d, 0x0f, 0xc8, 0x05, 0xf9, 0xfe, 0x77, 0xdd, 0xcb, 0x7c, 0x1c, 0xac, 0xf2, 0x07, 0x72, 0x1b,
-	0x9c, 0x32, 0xd1, 0x3a, 0x90, 0x12, 0x56, 0x5c, 0x95, 0x2b, 0xe0, 0xb5, 0x49, 0x85, 0x49, 0xd5,
-	0x99, 0xf5, 0x3f, 0x54, 0x76, 0x38, 0x5a, 0xa4, 0x16, 0x3b, 0xdd, 0xb0, 0x74, 0x97, 0xc0, 0x81,
-	0xd7, 0x4b, 0xf4, 0x55, 0xc2, 0x89, 0xbe, 0x8b, 0x30, 0xf3, 0x53, 0xda, 0xfb, 0x7d, 0x77, 0xdc,
-	0x02, 0xd1, 0x37, 0x7b, 0x70, 0x4b, 0x97, 0xcc, 0x6e, 0x9b, 0xdf, 0xbc, 0x0c, 0x55, 0xf9, 0x03,
-	0x39, 0xe4, 0xe7, 0x17, 0xce, 0x19, 0x46, 0x5c, 0xa8, 0xe7, 0xd9, 0x5d, 0x82, 0xb3, 0x29, 0x2b,
-	0x95, 0xe8, 0xe8, 0xf7, 0x3e, 0x10, 0xe8, 0x9d, 0x58, 0x7e, 0x80, 0x35, 0xad, 0x1f, 0x2f, 0x23,
-	0x7e, 0x84, 0x8b, 0x66, 0x0d, 0x73, 0x1c, 0x39, 0x59, 0x7b, 0x31, 0x07, 0x5b, 0x5e, 0x89, 0x4d,
-	0xce, 0xfb, 0x49, 0x5c, 0xac, 0xe2, 0x82, 0xd6, 0xd1, 0xea, 0xba, 0xb3, 0x9a, 0xc3, 0xd0, 0xf7,
-	0x7c, 0x37, 0x33, 0xd2, 0x14, 0xb4, 0x2c, 0xa3, 0xa1, 0x3a, 0x94, 0x81, 0x99, 0xbd, 0xe7, 0x14,
-	0x4b, 0xef, 0xf7, 0xc7, 0x5e, 0x58, 0x00, 0xa4, 0xea, 0xbe, 0x95, 0x57, 0xfd, 0x01, 0x4e, 0xaa,
-	0xe4, 0xa7, 0xff, 0x70, 0xc5, 0xeb, 0xde, 0x3b, 0x69, 0xa8, 0x3f, 0x04, 0x01, 0x2c, 0xb5, 0xad,
-	0x15, 0x16, 0x4e, 0xae, 0xa3, 0xad, 0xa1, 0x7a, 0xb2, 0x31, 0x0e, 0xa2, 0x1f, 0x08, 0xa3, 0x27,
-	0x4f, 0x04, 0x4e, 0x4b, 0x6b, 0x3b, 0x94, 0xda, 0xf3, 0xab, 0x77, 0xa8, 0x6d, 0x6b, 0x4d, 0xfa,
-	0x60, 0xb5, 0xe3, 0xcd, 0xf1, 0x43, 0x68, 0x5b, 0x93, 0xbd, 0xb7, 0xa2, 0x51, 0x52, 0x28, 0x16,
-	0xa1, 0xcf, 0xc3, 0x68, 0xac, 0xc5, 0x9b, 0xab, 0xce, 0x6a, 0x87, 0xaa, 0x5d, 0xcb, 0x80, 0x6d,
-	0x72, 0xb4, 0xe5, 0x8b, 0x7d, 0x68, 0x19, 0xe4, 0x22, 0x7a, 0x1e, 0xba, 0x4c, 0xcf, 0x21, 0x4a,
-	0xcd, 0x38, 0x24, 0xef, 0x0b, 0xc3, 0x27, 0x6b, 0xed, 0x5d, 0xac, 0x0c, 0x35, 0xa1, 0x02, 0x18,
-	0x7c, 0x77, 0xb2, 0xf3, 0xc0, 0x2b, 0x55, 0xbd, 0xda, 0x27, 0x7f, 0xb3, 0x8e, 0x36, 0xb1, 0x0e,
-	0xf0, 0xaf, 0x2a, 0x68, 0x33, 0x5f, 0x77, 0xf1, 0xa1, 0xf4, 0xb3, 0x76, 0x88, 0x41, 0x55, 0x3e,
-	0x9c, 0x5d, 0x91, 0x6b, 0x48, 0x4e, 0xfe, 0xe2, 0x0f, 0x7e, 0xfc, 0xf5, 0x81, 0xa3, 0x78, 0xba,
-	0xd2, 0xb1, 0xcc, 0x46, 0xb7, 0xee, 0xd8, 0x75, 0x3d, 0x8d, 0x49, 0x07, 0xb4, 0x4a, 0xfc, 0xfb,
-	0x0a, 0x1a, 0xf6, 0xc2, 0x22, 0xf8, 0x58, 0x7a, 0x5f, 0x09, 0x4c, 0xab, 0xf2, 0x4c, 0xde, 0xea,
-	0xa0, 0xe0, 0x25, 0xa6, 0xe0, 0x39, 0x7c, 0x26, 0x8f, 0x82, 0xfe, 0x5f, 0x6b, 0xcc, 0xfb, 0x59,
-	0xc7, 0xbf, 0xab, 0xa0, 0x11, 0x4f, 0xe8, 0x9c, 0x61, 0xc8, 0xd4, 0x4d, 0x60, 0x61, 0xc9, 0xd4,
-	0x4d, 0xe2, 0x53, 0x91, 0x33, 0x4c, 0xdd, 0x0a, 0x3e, 0x56, 0x48, 0x5d, 0xfc, 0x4d, 0x05, 0x6d,
-	0x09, 0x84, 0x89, 0x70, 0x45, 0x6a, 0xa5, 0x38, 0x7b, 0xa6, 0x7c, 0x3c, 0x7f, 0x03, 0xd0, 0xf4,
-	0x32, 0xd3, 0xf4, 0x3c, 0x3e, 0x97, 0x73, 0xe4, 0x85, 0x00, 0xcf, 0xb4, 0x7f, 0xa0, 0xa0, 0xd1,
-	0x80, 0x60, 0xd7, 0xb8, 0x15, 0xa9, 0xb5, 0x8a, 0xa9, 0x9d, 0x4c, 0x80, 0x22, 0xe7, 0x98, 0xda,
-	0x27, 0x70, 0xa5, 0xa0, 0xda, 0xf8, 0x6f, 0x14, 0xb4, 0x3d, 0x89, 0x41, 0x84, 0xcf, 0xa4, 0xeb,
-	0x20, 0xe1, 0x2b, 0x95, 0xcf, 0x16, 0x6d, 0x06, 0x00, 0x6e, 0x33, 0x00, 0xd7, 0xf0, 0x42, 0xa1,
-	0x19, 0xa2, 0x86, 0x46, 0x00, 0x96, 0xa7, 0x75, 0xfc, 0xa7, 0x0a, 0x1a, 0x8f, 0x11, 0x91, 0xf0,
-	0x49, 0xe9, 0x64, 0x48, 0x24, 0x3b, 0x95, 0x4f, 0x15, 0x6a, 0xd3, 0xcb, 0x1c, 0x6a, 0x52, 0x47,
-	0xb5, 0x98, 0x1c, 0x55, 0xd0, 0x9c, 0xf0, 0x27, 0x0a, 0x1a, 0x0d, 0x27, 0x9b, 0x66, 0x28, 0x9f,
-	0x98, 0x06, 0x9b, 0xa1, 0x7c, 0x72, 0x52, 0x2c, 0x79, 0x9d, 0x29, 0x7f, 0x15, 0xcf, 0xe7, 0x51,
-	0x3e, 0x9a, 0x3e, 0x5b, 0x59, 0x0b, 0xe4, 0xaf, 0xac, 0xe3, 0x6f, 0x2b, 0x68, 0x3c, 0xdc, 0x8d,
-	0xfb, 0x39, 0x9c, 0x94, 0xce, 0xee, 0xc2, 0x50, 0x52, 0xd9, 0x4c, 0xe4, 0x22, 0x83, 0x72, 0x16,
-	0x9f, 0xee, 0x05, 0x0a, 0xfe, 0x58, 0x41, 0x23, 0x41, 0x36, 0x0c, 0x96, 0x2f, 0x26, 0x09, 0x14,
-	0x9f, 0xf2, 0x89, 0x02, 0x2d, 0x40, 0xe7, 0x1b, 0x4c, 0xe7, 0x39, 0x7c, 0x39, 0x8f, 0xce, 0x21,
-	0x5a, 0x4f, 0x65, 0x2d, 0xf0, 0x31, 0xac, 0xbb, 0x6b, 0xe7, 0xb6, 0x60, 0x0f, 0xae, 0xe5, 0xe5,
-	0xeb, 0x4a, 0x41, 0x04, 0x29, 0x74, 0x23, 0x72, 0x9e, 0x21, 0x38, 0x85, 0x4f, 0x14, 0x46, 0x80,
-	0x7f, 0xa2, 0xa0, 0x1d, 0x89, 0x34, 0x12, 0x7c, 0x3e, 0xe7, 0x54, 0x8e, 0x13, 0x68, 0xca, 0xaf,
-	0xf5, 0xd2, 0x14, 0xb0, 0xa8, 0x0c, 0xcb, 0x3b, 0xf8, 0xad, 0xa2, 0x33, 0x28, 0x70, 0xa8, 0x09,
-	0x8f, 0x4b, 0xe4, 0x0b, 0xf9, 0x4c, 0x41, 0x93, 0x89, 0x2a, 0xb8, 0xc3, 0x75, 0x3e, 0xe7, 0xa4,
-	0x2f, 0x06, 0x3a, 0x8b, 0xff, 0x43, 0x16, 0x18, 0xe8, 0x4b, 0xf8, 0xc2, 0x06, 0x40, 0xe3, 0xbf,
-	0x56, 0x10, 0x8e, 0x13, 0x69, 0xb0, 0xe4, 0x3b, 0x4e, 0xe5, 0xee, 0x94, 0x4f, 0x17, 0x6b, 0x04,
-	0x30, 0x16, 0x19, 0x8c, 0xd7, 0xf1, 0xcd, 0x5c, 0x5b, 0xa2, 0xe0, 0xc1, 0x50, 0x5b, 0x5d, 0x32,
-	0x2d, 0xd5, 0x76, 0x45, 0x55, 0xd6, 0x82, 0xf1, 0x81, 0x75, 0xfc, 0x2d, 0x05, 0x6d, 0x8b, 0x50,
-	0x59, 0x32, 0x16, 0x85, 0x04, 0x52, 0x4c, 0xc6, 0xa2, 0x90, 0xc4, 0x93, 0x29, 0xe6, 0xed, 0xb9,
-	0x1b, 0x4a, 0x28, 0x75, 0x01, 0xff, 0x91, 0x82, 0x46, 0xc3, 0x14, 0x97, 0x8c, 0xed, 0x24, 0x91,
-	0x76, 0x93, 0xb1, 0x9d, 0x24, 0x33, 0x71, 0xc8, 0x05, 0xa6, 0xfa, 0x19, 0x7c, 0x2a, 0x8f, 0xea,
-	0x11, 0xce, 0x0e, 0xfe, 0x2f, 0x05, 0xed, 0x91, 0xd2, 0x63, 0xf0, 0xac, 0x54, 0xa7, 0x4c, 0x9e,
-	0x4e, 0xf9, 0x72, 0xcf, 0xed, 0x01, 0xdf, 0x5d, 0x86, 0xef, 0x26, 0xbe, 0x9e, 0x77, 0x68, 0x22,
-	0xbf, 0x1f, 0xc2, 0xcf, 0xe7, 0xaa, 0x47, 0xcd, 0xf9, 0x9e, 0x82, 0x76, 0x24, 0xf2, 0x6a, 0xb0,
-	0xc4, 0xb3, 0x92, 0xb1, 0x75, 0xca, 0xe7, 0x0a, 0xb7, 0x03, 0x68, 0x57, 0x19, 0xb4, 0x59, 0x7c,
-	0x31, 0x0f, 0xb4, 0xd0, 0x8c, 0x0b, 0x6e, 0xa3, 0xbf, 0xa5, 0xa0, 0x61, 0x8f, 0xf2, 0x82, 0xa7,
-	0xd3, 0x95, 0x89, 0x12, 0x66, 0xca, 0xaf, 0xe4, 0xaa, 0x0b, 0xca, 0x9e, 0x65, 0xca, 0x1e, 0xc7,
-	0x33, 0x79, 0x94, 0xe5, 0xd7, 0xf0, 0xaa, 0x66, 0x18, 0xf8, 0x43, 0x05, 0x0d, 0x09, 0x2e, 0x08,
-	0x3e, 0x9a, 0x31, 0xc3, 0x43, 0x84, 0x96, 0xf2, 0xb1, 0x9c, 0xb5, 0x7b, 0xf1, 0x0a, 0x3d, 0x12,
-	0x4b, 0xc0, 0xab, 0xfd, 0x6d, 0x05, 0x6d, 0x11, 0x52, 0x5d, 0x5b, 0x1e, 0x95, 0xae, 0xf1, 0x05,
-	0xb4, 0x4d, 0x20, 0xd2, 0x14, 0x3b, 0xb1, 0x79, 0xda, 0xe2, 0x7f, 0x54, 0xd0, 0x58, 0x94, 0x48,
-	0x81, 0x4f, 0xe7, 0x3b, 0xdc, 0x86, 0xd9, 0x22, 0xe5, 0x33, 0x05, 0x5b, 0x81, 0xe2, 0xef, 0x33,
-	0xc5, 0xdf, 0xc6, 0x8f, 0x8a, 0x1d, 0x24, 0x80, 0x21, 0x52, 0x59, 0x8b, 0x12, 0x6f, 0xd6, 0xdd,
-	0x93, 0x9d, 0x47, 0xa5, 0x58, 0xc7, 0xdf, 0x51, 0xd0, 0x0b, 0xd1, 0xce, 0xdd, 0xd1, 0x38, 0x9d,
-	0xef, 0x48, 0x9c, 0x1f, 0xa4, 0x84, 0xdc, 0xd2, 0xe3, 0xf1, 0x5f, 0x80, 0xc4, 0xff, 0xaf, 0xa0,
-	0x72, 0x7a, 0x02, 0x7c, 0xc6, 0xa2, 0x9a, 0xc9, 0xcd, 0xc8, 0x58, 0x54, 0xb3, 0x59, 0x16, 0xe4,
-	0x67, 0x18, 0xbc, 0x77, 0xf1, 0xdb, 0xc5, 0xe0, 0xc5, 0x7f, 0xd2, 0x08, 0xbc, 0xad, 0x5b, 0x8d,
-	0xe8, 0x28, 0xfe, 0x9b, 0x82, 0xf6, 0xa4, 0x2b, 0xe2, 0x8e, 0xe7, 0x6c, 0xbe, 0x91, 0xe9, 0xc5,
-	0x08, 0xb9, 0xa8, 0x26, 0xe4, 0x26, 0x33, 0xc2, 0x3c, 0xbe, 0xb2, 0x51, 0x23, 0xe0, 0x5f, 0x1e,
-	0x40, 0xfb, 0xb2, 0xf8, 0x18, 0x78, 0xae, 0xe8, 0xa0, 0xc5, 0xc8, 0x22, 0xe5, 0xf9, 0x8d, 0x88,
-	0x00, 0xd4, 0x1a, 0x43, 0xfd, 0x18, 0xbf, 0x93, 0x77, 0x3f, 0x4d, 0x44, 0xee, 0xb3, 0x2f, 0x2a,
-	0x6b, 0x7a, 0xc3, 0x5e, 0xaf, 0xac, 0x79, 0x14, 0x95, 0x75, 0xfc, 0x7f, 0x0a, 0x9a, 0x48, 0xc9,
-	0xd9, 0xc7, 0x17, 0xb2, 0x0f, 0x0b, 0xa9, 0x9c, 0x85, 0xf2, 0xc5, 0xde, 0x1a, 0x03, 0xf2, 0x3a,
-	0x43, 0xfe, 0x1e, 0x7e, 0x9c, 0xdf, 0xed, 0x4e, 0xf8, 0x3d, 0xae, 0xf0, 0xf9, 0x22, 0x74, 0xf6,
-	0x70, 0xe7, 0xfd, 0x0f, 0x15, 0x54, 0x4e, 0x51, 0xc4, 0x9d, 0xf4, 0x17, 0xb2, 0x8f, 0x0d, 0x3d,
-	0xc1, 0xcf, 0x26, 0x5f, 0x90, 0x6b, 0x0c, 0xfe, 0x65, 0x7c, 0x69, 0x43, 0xf0, 0xdd, 0xfd, 0x7c,
-	0x24, 0x98, 0x76, 0x20, 0x8b, 0x6c, 0x26, 0x10, 0x26, 0x64, 0x91, 0xcd, 0x24, 0xce, 0x44, 0xb1,
-	0xd3, 0x6e, 0xe8, 0x77, 0xce, 0xf0, 0xb7, 0x15, 0xb4, 0x35, 0x44, 0x1f, 0xc0, 0x92, 0xce, 0x93,
-	0xc8, 0x15, 0xe5, 0x4a, 0xee, 0xfa, 0xbd, 0xac, 0x29, 0xa1, 0xdf, 0x59, 0x8b, 0x1c, 0x63, 0xf1,
-	0x47, 0x0a, 0x1a, 0x8b, 0x32, 0x27, 0xf0, 0x89, 0x9c, 0xfa, 0x04, 0x9c, 0xbc, 0x93, 0x45, 0x9a,
-	0x00, 0x8a, 0x59, 0x86, 0xe2, 0x55, 0x7c, 0xb6, 0x38, 0x0a, 0xe6, 0xf3, 0x7d, 0xa2, 0xb0, 0x9f,
-	0x52, 0x8b, 0xf2, 0x2e, 0x64, 0x5b, 0x78, 0x3a, 0x93, 0x43, 0xb6, 0x85, 0x4b, 0xc8, 0x1d, 0x64,
-	0x8e, 0x81, 0xb8, 0x80, 0xcf, 0xe7, 0x01, 0xf1, 0x01, 0x13, 0xa4, 0x86, 0xe6, 0x8f, 0xcd, 0xce,
-	0xa3, 0x11, 0x0e, 0x87, 0xec, 0x3c, 0x9a, 0xcc, 0x06, 0x91, 0x9d, 0x47, 0x53, 0x08, 0x22, 0xc5,
-	0xdc, 0x8f, 0xf0, 0x8f, 0xfb, 0xb9, 0xf6, 0xff, 0x6f, 0x05, 0x95, 0xd3, 0x29, 0x1d, 0x19, 0x6b,
-	0xb0, 0x9c, 0xa2, 0x91, 0xb1, 0x06, 0x67, 0x30, 0x36, 0xc8, 0x3b, 0x0c, 0xd8, 0x7d, 0x7c, 0x2f,
-	0xef, 0xee, 0x93, 0xca, 0xef, 0x88, 0xad, 0xbc, 0x3f, 0xe1, 0x67, 0xd9, 0x74, 0xda, 0x48, 0x86,
-	0xdb, 0x95, 0xc9, 0x4e, 0xc9, 0x70, 0xbb, 0xb2, 0xf9, 0x2a, 0xe4, 0x0e, 0x43, 0x7f, 0x03, 0x5f,
-	0xcb, 0x8b, 0x5e, 0x33, 0x8c, 0x74, 0x0b, 0xe0, 0xef, 0x2a, 0x68, 0x32, 0x6c, 0x73, 0x9f, 0x82,
-	0x20, 0x3b, 0xcd, 0xca, 0x88, 0x0d, 0xb2, 0xd3, 0xac, 0x94, 0xeb, 0x50, 0x6c, 0xd1, 0x88, 0xb3,
-	0x22, 0xf0, 0x7f, 0x2a, 0x68, 0xb7, 0x8c, 0x4d, 0x80, 0x2f, 0xa5, 0x6b, 0x96, 0x83, 0x05, 0x51,
-	0x9e, 0xed, 0xb5, 0x39, 0xe0, 0x7b, 0x93, 0xe1, 0xbb, 0x85, 0x6f, 0xe4, 0xc1, 0xe7, 0x65, 0xec,
-	0x56, 0xd6, 0xbc, 0x3f, 0xd7, 0x2b, 0xa1, 0x6c, 0x69, 0xfc, 0x0b, 0x03, 0x88, 0xa4, 0x11, 0x1a,
-	0x7c, 0x7e, 0x01, 0x9e, 0xcf, 0xd4, 0x3b, 0x93, 0x0e, 0x21, 0x8b, 0x56, 0x66, 0x65, 0xbb, 0x93,
-	0xc7, 0x0c, 0xf7, 0x43, 0x7c, 0xbf, 0x0f, 0xb8, 0x81, 0x21, 0x51, 0xf3, 0xc0, 0xfd, 0xa5, 0x82,
-	0xc6, 0x79, 0x9e, 0x4a, 0xf0, 0x27, 0x79, 0x24, 0x2b, 0xbe, 0x24, 0x01, 0x5e, 0x76, 0x35, 0x26,
-	0xcb, 0x89, 0xef, 0x7d, 0xe6, 0x56, 0x58, 0xba, 0x0c, 0xfe, 0x58, 0x41, 0x93, 0x7e, 0xd2, 0x79,
-	0x38, 0xfb, 0x1a, 0xbf, 0x92, 0x2f, 0x51, 0x9d, 0x23, 0x38, 0x5a, 0x24, 0xab, 0xbd, 0x98, 0x47,
-	0xd7, 0x70, 0xdb, 0x07, 0xc6, 0xc7, 0x5d, 0x5a, 0xf8, 0x87, 0xf7, 0x4b, 0x03, 0xe8, 0x48, 0x6e,
-	0xba, 0x0b, 0x7e, 0xbd, 0xf8, 0x54, 0x4a, 0xe3, 0xcc, 0x6c, 0x68, 0x5a, 0xf6, 0xf8, 0x39, 0xa6,
-	0x4f, 0xc5, 0x7f, 0x55, 0xd0, 0x81, 0x3c, 0x0c, 0x1d, 0x7c, 0xad, 0x17, 0x0b, 0xc4, 0x18, 0x3e,
-	0x1b, 0x02, 0x5f, 0xc8, 0x3f, 0xf0, 0x46, 0xda, 0x5b, 0x79, 0xfe, 0x5d, 0x41, 0xfb, 0xb2, 0xb8,
-	0x35, 0xb2, 0xf3, 0x6a, 0x4e, 0x7a, 0x91, 0xec, 0xbc, 0x9a, 0x97, 0xda, 0x43, 0xae, 0x30, 0xa8,
-	0xaf, 0xe1, 0x57, 0x0b, 0xc4, 0x1d, 0xc3, 0x68, 0xff, 0x59, 0x41, 0xbb, 0x24, 0x0c, 0x06, 0x2c,
-	0xf7, 0x68, 0x32, 0x48, 0x12, 0xe5, 0x4b, 0x3d, 0xb6, 0xee, 0xe5, 0x36, 0xd8, 0x75, 0x09, 0xd2,
-	0xf9, 0x13, 0xf8, 0x33, 0x05, 0x4d, 0xa4, 0x30, 0x1a, 0xf0, 0xab, 0xb2, 0xa1, 0x90, 0x71, 0x25,
-	0xca, 0xe7, 0x7b, 0x68, 0x09, 0xe0, 0xae, 0x33, 0x70, 0x57, 0xf0, 0x6c, 0xbe, 0x08, 0x8b, 0xda,
-	0x01, 0x69, 0x51, 0x3f, 0xfc, 0xcf, 0x45, 0xca, 0x87, 0x9f, 0xd3, 0x7f, 0x32, 0xdb, 0x0d, 0x8d,
-	0xb2, 0x18, 0x32, 0xee, 0x57, 0x92, 0xf9, 0x08, 0xc5, 0x82, 0xf4, 0x91, 0x1f, 0xc4, 0xae, 0xac,
-	0x89, 0x9b, 0xad, 0x8f, 0x14, 0x34, 0x1e, 0xee, 0x20, 0xfb, 0xa2, 0xbe, 0x30, 0x88, 0x54, 0x52,
-	0x45, 0xb1, 0x4b, 0xa2, 0x08, 0x08, 0xfc, 0x4f, 0x0a, 0x1a, 0x8f, 0xe5, 0x1c, 0x63, 0x79, 0xf4,
-	0x38, 0x2d, 0xdd, 0x5c, 0xb6, 0x47, 0xcb, 0xd2, 0xc2, 0xc9, 0x7b, 0x0c, 0xc1, 0x5b, 0xf8, 0x61,
-	0x25, 0xdf, 0xaf, 0x86, 0x57, 0xd6, 0x82, 0x59, 0xed, 0xeb, 0xe2, 0xa6, 0x91, 0x67, 0xb0, 0xb3,
-	0x70, 0x55, 0x20, 0x5b, 0x7d, 0x1d, 0xff, 0xb1, 0x82, 0x70, 0x3c, 0x49, 0x5b, 0xe6, 0x44, 0xcb,
-	0x72, 0xc8, 0x65, 0x4e, 0xb4, 0x34, 0x1b, 0x9c, 0x1c, 0x67, 0x30, 0xa7, 0xf1, 0xe1, 0x9c, 0x30,
-	0x6d, 0xfc, 0xa9, 0x82, 0xc6, 0xa2, 0xec, 0x0c, 0x9c, 0xe3, 0xc8, 0x19, 0xe1, 0xae, 0xc8, 0xe2,
-	0x04, 0x69, 0x24, 0x95, 0x62, 0xe7, 0x19, 0xef, 0x9b, 0x5e, 0x79, 0xa2, 0x72, 0x86, 0x4a, 0x65,
-	0x8d, 0x33, 0x4d, 0xd6, 0x2b, 0x6b, 0x2b, 0x74, 0x75, 0x1d, 0xff, 0x58, 0x41, 0x93, 0x69, 0x64,
-	0x13, 0xd9, 0x5d, 0x7d, 0x06, 0xc1, 0x45, 0xb6, 0xd3, 0x66, 0x71, 0x5b, 0xc8, 0x3d, 0x06, 0xf1,
-	0x36, 0xbe, 0x95, 0x07, 0xa2, 0xa1, 0xdb, 0x8e, 0x1a, 0xc7, 0xa9, 0xae, 0xd0, 0x55, 0xdb, 0x03,
-	0x8b, 0xff, 0x30, 0x10, 0x55, 0x00, 0x02, 0x4a, 0x9e, 0xa8, 0x42, 0x98, 0x41, 0x93, 0x27, 0xaa,
-	0x10, 0x21, 0xc5, 0x14, 0x4b, 0xd7, 0xf1, 0x60, 0xd4, 0x40, 0xc5, 0xef, 0x28, 0x08, 0xc7, 0xc9,
-	0x2a, 0xb2, 0x84, 0x83, 0x54, 0xe6, 0x8c, 0x2c, 0xe1, 0x20, 0x9d, 0x0f, 0x53, 0xcc, 0x15, 0xf0,
-	0xf4, 0xd7, 0x5c, 0x41, 0x3c, 0x38, 0xc5, 0xee, 0x4a, 0x3d, 0x9e, 0x82, 0xec, 0xae, 0x34, 0x4a,
-	0x73, 0x90, 0xdd, 0x95, 0xc6, 0x88, 0x0f, 0xc5, 0xee, 0x4a, 0x45, 0x38, 0x79, 0xc9, 0x74, 0x3d,
-	0x95, 0x89, 0x14, 0x02, 0x81, 0x6c, 0x03, 0x97, 0x93, 0x17, 0x64, 0x1b, 0x78, 0x06, 0x5b, 0x81,
-	0x3c, 0x62, 0x40, 0x16, 0xf1, 0xdd, 0x5c, 0x37, 0xd4, 0xec, 0xd7, 0x24, 0x3a, 0xa6, 0xea, 0x67,
-	0x7a, 0x68, 0xe2, 0xa7, 0xa4, 0x60, 0xfd, 0x85, 0x1b, 0x3f, 0xfc, 0xbf, 0x0a, 0xda, 0x25, 0xa1,
-	0x27, 0xc8, 0x5c, 0xb2, 0x6c, 0x8a, 0x84, 0xcc, 0x25, 0xcb, 0xc1, 0x89, 0x20, 0xef, 0x32, 0xd0,
-	0x0f, 0x70, 0xb5, 0x20, 0xe8, 0x80, 0xcc, 0x54, 0xe0, 0x9f, 0x29, 0x68, 0x47, 0x22, 0x2b, 0x02,
-	0xcb, 0x37, 0xc6, 0x54, 0x1a, 0x86, 0x6c, 0xab, 0x91, 0xd2, 0x2f, 0xc8, 0x1b, 0x0c, 0xe6, 0x75,
-	0x7c, 0x35, 0xb7, 0x63, 0xad, 0x76, 0xa8, 0xc5, 0x69, 0xef, 0x3c, 0xa9, 0xa2, 0xb2, 0x26, 0x12,
-	0xef, 0xd7, 0xf1, 0x3f, 0x28, 0x68, 0x67, 0x2a, 0x69, 0x02, 0xbf, 0x96, 0x15, 0x39, 0x4b, 0x67,
-	0x74, 0x94, 0x2f, 0xf4, 0xd4, 0xb6, 0x17, 0x0f, 0xd4, 0x3d, 0x28, 0x25, 0x02, 0xb5, 0xf1, 0x5f,
-	0x28, 0x68, 0x2c, 0x4a, 0xb2, 0xc0, 0x27, 0xb2, 0x4d, 0x1f, 0xe1, 0x72, 0x94, 0x4f, 0x16, 0x69,
-	0xd2, 0xcb, 0x31, 0x3f, 0x44, 0xb8, 0x58, 0x0d, 0x8e, 0xd0, 0x5f, 0xf1, 0xa9, 0x17, 0xe7, 0x6b,
-	0x64, 0x4c, 0xbd, 0x54, 0x16, 0x48, 0xc6, 0xd4, 0x4b, 0x27, 0x86, 0x14, 0x5b, 0xc8, 0xfd, 0x51,
-	0xf1, 0x69, 0x24, 0xf8, 0x7f, 0x5c, 0x30, 0x49, 0x1c, 0x08, 0x29, 0x18, 0x09, 0xd5, 0x43, 0x0a,
-	0x46, 0x46, 0xb6, 0x20, 0x3a, 0x03, 0x53, 0xc7, 0x5a, 0xae, 0x13, 0x1c, 0x88, 0x72, 0x0f, 0xa7,
-	0x41, 0xc2, 0x48, 0x65, 0x2d, 0xc2, 0x33, 0x59, 0xaf, 0xac, 0x45, 0x09, 0x25, 0xeb, 0xf3, 0x6f,
-	0x7e, 0xfa, 0xf9, 0x94, 0xf2, 0xfd, 0xcf, 0xa7, 0x94, 0x1f, 0x7d, 0x3e, 0xa5, 0xfc, 0xda, 0x17,
-	0x53, 0xcf, 0x7d, 0xff, 0x8b, 0xa9, 0xe7, 0xfe, 0xee, 0x8b, 0xa9, 0xe7, 0xde, 0x3d, 0xd3, 0xd4,
-	0x9d, 0xe5, 0x6e, 0x6d, 0xa6, 0x6e, 0xb6, 0xd2, 0xd5, 0x78, 0x16, 0xdc, 0x1e, 0x57, 0x3b, 0xd4,
-	0xae, 0x6d, 0x66, 0xff, 0x1f, 0xce, 0xa9, 0x9f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x31, 0xe8, 0xb6,
-	0x76, 0x77, 0x6b, 0x00, 0x00,
+	// 6220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5d, 0x7b, 0x8c, 0x1c, 0x47,
+	0x5a, 0x4f, 0xef, 0xac, 0xed, 0xdd, 0xf2, 0x7a, 0xbd, 0x5b, 0x71, 0xe2, 0xcd, 0xd8, 0x5e, 0x3b,
+	0x65, 0x3b, 0x7e, 0xc4, 0xde, 0x89, 0xdf, 0x71, 0xfc, 0xdc, 0x5d, 0x3f, 0xe3, 0xd8, 0x59, 0x8f,
+	0xed, 0x3c, 0x2f, 0x69, 0x7a, 0x67, 0x6a, 0x67, 0x9a, 0xed, 0xe9, 0x9e, 0x74, 0xf7, 0xd8, 0x5e,
+	0x96, 0x3d, 0x04, 0xe2, 0x4f, 0x4e, 0xdc, 0xe9, 0xfe, 0x40, 0x42, 0xfc, 0xc3, 0x4b, 0x0a, 0x12,
+	0x7f, 0xdc, 0x49, 0x1c, 0x28, 0x10, 0xe0, 0x0e, 0x29, 0x52, 0xc4, 0x49, 0xd1, 0xc1, 0xa1, 0x80,
+	0x10, 0x3a, 0x8e, 0x24, 0x02, 0x04, 0x88, 0x43, 0x20, 0x21, 0xc1, 0x3f, 0xa0, 0xae, 0xfa, 0xaa,
+	0xdf, 0x5d, 0xdd, 0x3d, 0x3b, 0xf9, 0xc7, 0xda, 0xa9, 0xa9, 0xef, 0xab, 0xef, 0xf7, 0xd5, 0xeb,
+	0xab, 0xaf, 0xea, 0x37, 0x46, 0xbb, 0x75, 0x73, 0x89, 0xda, 0xd4, 0x6c, 0xd0, 0x5a, 0xf0, 0xd7,
+	0x7b, 0x3d, 0x6a, 0xaf, 0xcc, 0x74, 0x6d, 0xcb, 0xb5, 0xf0, 0x93, 0x7e, 0xf1, 0x8c, 0xff, 0x57,
+	0x75, 0x52, 0xeb, 0xe8, 0xa6, 0x55, 0x63, 0xff, 0xf2, 0x7a, 0xd5, 0x6d, 0x2d, 0xab, 0x65, 0xb1,
+	0x3f, 0x6b, 0xde, 0x5f, 0x50, 0xba, 0xb3, 0x65, 0x59, 0x2d, 0x83, 0xd6, 0xb4, 0xae, 0x5e, 0xd3,
+	0x4c, 0xd3, 0x72, 0x35, 0x57, 0xb7, 0x4c, 0x07, 0xbe, 0x3d, 0xdc, 0xb0, 0x9c, 0x8e, 0xe5, 0xd4,
+	0x16, 0x35, 0x07, 0x1a, 0xad, 0x3d, 0x3c, 0xb6, 0x48, 0x5d, 0xed, 0x58, 0xad, 0xab, 0xb5, 0x74,
+	0x93, 0x55, 0x86, 0xba, 0x7b, 0xd2, 0x0c, 0xed, 0x6a, 0xb6, 0xd6, 0x11, 0xda, 0xf6, 0xa6, 0xd5,
+	0x08, 0xac, 0xe7, 0x95, 0xf6, 0x67, 0xa8, 0x71, 0xf5, 0x86, 0xde, 0xd5, 0x4c, 0x57, 0x58, 0x96,
+	0x56, 0x8d, 0x76, 0xad, 0x46, 0x5b, 0x6d, 0xd9, 0x56, 0xaf, 0xab, 0x36, 0x35, 0x57, 0x83, 0xba,
+	0x07, 0xd2, 0xea, 0x3a, 0xd4, 0x75, 0x0d, 0xaa, 0x6a, 0x1d, 0xab, 0xe7, 0x2b, 0x3d, 0x96, 0xa7,
+	0xf4, 0xa1, 0x66, 0xe8, 0xcd, 0x88, 0x87, 0x48, 0xaa, 0xb9, 0x56, 0x63, 0x51, 0x73, 0x1b, 0x6d,
+	0xa8, 0x73, 0x28, 0xad, 0x8e, 0x6b, 0x2d, 0x53, 0xd3, 0xea, 0xe8, 0x0d, 0x27, 0x6c, 0x6a, 0x6a,
+	0xd5, 0x9e, 0xa9, 0xbb, 0xaa, 0xb5, 0xa4, 0x36, 0xac, 0x4e, 0xb7, 0xe7, 0x0a, 0x47, 0xa5, 0x0e,
+	0x8c, 0x8e, 0xd5, 0xa4, 0x86, 0xcc, 0xdd, 0xae, 0xd5, 0x55, 0x3b, 0xba, 0x49, 0x6d, 0xa8, 0x34,
+	0x1d, 0xee, 0x61, 0xd1, 0xb7, 0x0d, 0x4b, 0x17, 0xbd, 0xfa, 0xbc, 0xb4, 0xcf, 0x54, 0x57, 0xef,
+	0x50, 0xab, 0x27, 0xfc, 0x77, 0x5a, 0x5e, 0x39, 0xf0, 0x9e, 0xda, 0xa4, 0xae, 0xa6, 0x1b, 0xc2,
+	0x89, 0x27, 0xb2, 0xfd, 0xde, 0xa5, 0xf6, 0x92, 0x65, 0x77, 0x34, 0x4f, 0xde, 0xe9, 0x75, 0x3a,
+	0x9a, 0x18, 0xf7, 0xe9, 0xbd, 0xea, 0xda, 0x9a, 0x6e, 0xea, 0x66, 0x4b, 0x75, 0x35, 0x67, 0x19,
+	0x2a, 0x1e, 0xc9, 0xad, 0xa8, 0x3a, 0x2b, 0x66, 0x43, 0xa6, 0xb6, 0xad, 0xd9, 0xcd, 0x47, 0x9a,
+	0x4d, 0x55, 0xd3, 0x6a, 0x52, 0x59, 0x57, 0xb1, 0x81, 0xaa, 0x19, 0x6a, 0xaf, 0xdb, 0xb2, 0x35,
+	0xbf, 0xea, 0x73, 0x69, 0x55, 0x4d, 0xea, 0x3e, 0xb2, 0xec, 0xe5, 0xb0, 0xca, 0x54, 0x4b, 0x17,
+	0x6d, 0xbd, 0xd9, 0xa2, 0xaa, 0x6b, 0x6b, 0xa6, 0xa3, 0x35, 0x42, 0x13, 0x2e, 0xd5, 0x00, 0xc7,
+	0xd5, 0x5c, 0x47, 0x6d, 0xd2, 0x87, 0xd4, 0xb0, 0xba, 0x7e, 0x2f, 0xa7, 0xf6, 0xa2, 0x5f, 0x49,
+	0x75, 0x7a, 0x8b, 0xea, 0x32, 0x5d, 0x91, 0x0d, 0x2c, 0xd6, 0x1b, 0xbc, 0x02, 0xd9, 0x86, 0xf0,
+	0x5d, 0x6f, 0x2d, 0x58, 0x60, 0x93, 0xbb, 0x4e, 0xdf, 0xeb, 0x51, 0xc7, 0x25, 0x0f, 0xd0, 0x93,
+	0x91, 0x52, 0xa7, 0x6b, 0x99, 0x0e, 0xc5, 0x17, 0xd1, 0x46, 0xbe, 0x08, 0x4c, 0x29, 0x7b, 0x94,
+	0x83, 0x9b, 0x8f, 0xef, 0x98, 0x49, 0x59, 0xaf, 0x66, 0xb8, 0xd0, 0xdc, 0xe8, 0xc7, 0x3f, 0xda,
+	0xfd, 0xc4, 0xfb, 0xff, 0xf4, 0xad, 0xc3, 0x4a, 0x1d, 0xa4, 0xc8, 0x0b, 0x68, 0x8a, 0xa9, 0xbd,
+	0x4e, 0xdd, 0x9b, 0xa2, 0x3a, 0x34, 0x89, 0xb7, 0xa1, 0x0d, 0xba, 0xd9, 0xa4, 0x8f, 0x99, 0xea,
+	0xd1, 0x3a, 0xff, 0x40, 0x54, 0xf4, 0x4c, 0x8a, 0x04, 0x98, 0x33, 0x87, 0x46, 0xfd, 0x56, 0xc1,
+	0xa2, 0xe9, 0x54, 0x8b, 0x7c, 0xd1, 0xb9, 0x61, 0xcf, 0xa8, 0x7a, 0x20, 0x46, 0x16, 0xc1, 0xa4,
+	0x59, 0xc3, 0x48, 0x98, 0x74, 0x0d, 0xa1, 0x60, 0x65, 0x84, 0x06, 0x9e, 0x9b, 0xe1, 0x93, 0x6c,
+	0xc6, 0x9b, 0x64, 0x33, 0x7c, 0xed, 0x86, 0xa9, 0x36, 0xb3, 0xa0, 0xb5, 0x84, 0x6c, 0x3d, 0x24,
+	0x49, 0xde, 0x57, 0x00, 0x45, 0xb4, 0x91, 0x74, 0x14, 0x95, 0x3e, 0x50, 0xe0, 0xeb, 0x11, 0x4b,
+	0x87, 0x98, 0xa5, 0x07, 0x72, 0x2d, 0xe5, 0x06, 0x44, 0x4c, 0x3d, 0x8e, 0xaa, 0xc2, 0xdf, 0x0b,
+	0xc1, 0x3a, 0x2d, 0xef, 0xa3, 0x16, 0xda, 0x91, 0x2a, 0x03, 0xf8, 0x6e, 0xa0, 0xcd, 0xa1, 0x25,
+	0x1f, 0xdc, 0xb8, 0x27, 0x6b, 0xe4, 0x88, 0x7a, 0x80, 0x31, 0x2c, 0x4a, 0x9a, 0x60, 0xdc, 0xac,
+	0x61, 0xa4, 0x18, 0x37, 0xa8, 0xde, 0xfa, 0xa1, 0x02, 0x78, 0xe2, 0xcd, 0x64, 0xe1, 0xa9, 0xf4,
+	0x89, 0x67, 0x60, 0xbd, 0x86, 0x9f, 0x45, 0x63, 0x8b, 0x86, 0xd5, 0x58, 0x56, 0xdb, 0x54, 0x6f,
+	0xb5, 0xdd, 0xa9, 0xca, 0x1e, 0xe5, 0x60, 0xa5, 0xbe, 0x99, 0x95, 0xdd, 0x60, 0x45, 0xe4, 0x3c,
+	0xda, 0xc3, 0x40, 0xf9, 0x83, 0x28, 0xc5, 0x83, 0x53, 0x68, 0x93, 0xd6, 0x6c, 0xda, 0xd4, 0x71,
+	0xa0, 0x83, 0xc5, 0x47, 0xf2, 0x00, 0x3d, 0x2b, 0x91, 0x06, 0xc7, 0x3c, 0x8d, 0x36, 0x76, 0x7b,
+	0x8b, 0xcb, 0x74, 0x05, 0xa4, 0xe1, 0x93, 0xa7, 0x76, 0x51, 0x33, 0xbc, 0x55, 0x9f, 0x61, 0xac,
+	0xd4, 0xc5, 0x47, 0xd2, 0x42, 0xbb, 0xc4, 0xc8, 0xa9, 0x6b, 0x66, 0xd3, 0xea, 0x5c, 0x7d, 0x4c,
+	0x1b, 0x3d, 0xd7, 0xb2, 0x43, 0x03, 0x8e, 0xed, 0x82, 0x62, 0xc0, 0xb1, 0x0f, 0x9e, 0x42, 0xfa,
+	0xb8, 0x61, 0xf4, 0x9a, 0x9e, 0xc2, 0x8a, 0x67, 0x27, 0x7c, 0xf4, 0x4c, 0xb0, 0x69, 0xcb, 0xf3,
+	0x66, 0x85, 0x9b, 0xc0, 0x3f, 0x91, 0x26, 0x9a, 0xce, 0x6a, 0xc8, 0x9f, 0x85, 0x23, 0x14, 0xca,
+	0x4a, 0x0e, 0x51, 0x5f, 0x8e, 0xb4, 0xa1, 0x15, 0xa1, 0x7c, 0x5e, 0x33, 0x9b, 0xde, 0x26, 0x49,
+	0x9d, 0x41, 0xe3, 0xb1, 0xd0, 0x64, 0xa2, 0x91, 0x41, 0x40, 0xf0, 0x1a, 0x7c, 0xc4, 0xc7, 0x10,
+	0xef, 0x2a, 0xf8, 0x44, 0x2c, 0xb4, 0x3b, 0x13, 0x1a, 0x78, 0xf0, 0x15, 0x84, 0x1a, 0x7e, 0x29,
+	0x4c, 0x8b, 0xe7, 0x52, 0x0d, 0x48, 0x28, 0x01, 0x33, 0x42, 0xf2, 0xe4, 0xed, 0x60, 0x68, 0x5c,
+	0xf5, 0xb6, 0xab, 0xeb, 0x5e, 0xcc, 0x76, 0x45, 0x73, 0x35, 0xe1, 0xca, 0xdd, 0x68, 0x33, 0x8f,
+	0x2a, 0x82, 0x15, 0x69, 0xb8, 0x8e, 0x58, 0xd1, 0x4d, 0xaf, 0x04, 0x3f, 0x83, 0x46, 0x98, 0x7b,
+	0x55, 0xbd, 0xc9, 0xc0, 0x8c, 0xd6, 0x37, 0xb1, 0xcf, 0x37, 0x9b, 0xa4, 0x17, 0x0c, 0x87, 0xb8,
+	0x72, 0x00, 0x73, 0x0f, 0x4d, 0xc4, 0x03, 0x50, 0xf0, 0xe9, 0xde, 0x74, 0x48, 0x11, 0x35, 0x80,
+	0x67, 0x9c, 0x46, 0x4a, 0xfd, 0xe1, 0x3e, 0x6b, 0x18, 0xe9, 0x98, 0x06, 0xb5, 0x84, 0xfd, 0x89,
+	0x02, 0x00, 0x53, 0x5a, 0x92, 0x02, 0xac, 0xac, 0x0b, 0xe0, 0xe0, 0xb6, 0xa1, 0x4b, 0xc1, 0x96,
+	0x72, 0x8f, 0xc5, 0xf6, 0xb3, 0x2c, 0xb4, 0x17, 0x7e, 0xda, 0x93, 0xdc, 0x52, 0x46, 0xa3, 0x5b,
+	0x85, 0x81, 0x76, 0xa6, 0x2b, 0xf0, 0x07, 0xeb, 0x96, 0xc8, 0xa1, 0x01, 0x9c, 0xfd, 0x6c, 0x2a,
+	0xf6, 0xb0, 0x06, 0x40, 0x3e, 0xe6, 0x84, 0xca, 0x08, 0x0d, 0x76, 0x8c, 0x34, 0x73, 0x07, 0xd5,
+	0xad, 0xdf, 0x51, 0x00, 0x55, 0xa2, 0x9d, 0x6c, 0x54, 0x95, 0xbe, 0x51, 0x0d, 0xae, 0x37, 0x2f,
+	0x40, 0xf8, 0xb3, 0x40, 0xcd, 0xa6, 0x6e, 0xb6, 0xe6, 0x0d, 0x4d, 0xf7, 0x43, 0xcd, 0x02, 0x7d,
+	0xf9, 0x1b, 0x0a, 0x1a, 0x0b, 0x8b, 0x0e, 0xb6, 0xf3, 0xf0, 0x41, 0x34, 0x41, 0x1f, 0x77, 0x75,
+	0x9b, 0x3a, 0xaa, 0xe6, 0xaa, 0x6c, 0x44, 0x33, 0xb0, 0xc3, 0xf5, 0x71, 0x28, 0x9f, 0xe5, 0xab,
+	0x04, 0xae, 0x7a, 0x0b, 0x6c, 0x57, 0xb7, 0x75, 0xb3, 0xc5, 0xd6, 0xe3, 0x91, 0xba, 0xff, 0x99,
+	0x7c, 0x4d, 0x81, 0xe0, 0x24, 0x06, 0x12, 0x7a, 0xe6, 0x12, 0xda, 0xd8, 0x60, 0x25, 0xd2, 0x2e,
+	0x09, 0xcb, 0x82, 0xad, 0x20, 0x86, 0x67, 0xd0, 0x93, 0x8d, 0x9e, 0x6d, 0x53, 0x13, 0x4c, 0x84,
+	0x65, 0x8f, 0x1b, 0x3a, 0x09, 0x5f, 0x5d, 0xf5, 0x57, 0x3f, 0xa2, 0xa3, 0x7d, 0xc9, 0x25, 0xee,
+	0xb5, 0xe0, 0xc8, 0x5b, 0xd8, 0xfd, 0xf1, 0x85, 0x76, 0x28, 0xbe, 0xd0, 0x92, 0x6f, 0x28, 0x68,
+	0x7f, 0x4e, 0x5b, 0xe0, 0x85, 0x36, 0xda, 0x9e, 0x71, 0x02, 0x87, 0x2e, 0x3c, 0x9c, 0xb3, 0xf6,
+	0x84, 0x94, 0x82, 0x7f, 0x9e, 0xa2, 0x69, 0x5f, 0x12, 0x13, 0xe0, 0x47, 0x16, 0xc0, 0x14, 0xf8,
+	0x83, 0x9a, 0x9a, 0x7f, 0x2b, 0x7c, 0x90, 0xdd, 0x60, 0x11, 0x1f, 0x54, 0x06, 0xe8, 0x83, 0xc1,
+	0xcd, 0xdf, 0x79, 0xd8, 0x4d, 0x16, 0xac, 0xc6, 0x9c, 0xe6, 0x36, 0xda, 0xd4, 0xb9, 0x66, 0xd9,
+	0xf7, 0xdc, 0xc0, 0x15, 0x89, 0x00, 0x54, 0x49, 0x06, 0xa0, 0x3d, 0x88, 0x20, 0xd2, 0x94, 0x80,
+	0x6b, 0xea, 0x68, 0xb4, 0x6b, 0x35, 0x54, 0x96, 0x6e, 0x01, 0x67, 0xd4, 0xd2, 0xe7, 0x89, 0x35,
+	0x0f, 0x3a, 0x5e, 0xd7, 0xdd, 0x76, 0x28, 0x9e, 0x11, 0x1e, 0x19, 0xe9, 0x42, 0x2b, 0xe4, 0x03,
+	0x05, 0x55, 0xb3, 0xab, 0x17, 0x18, 0xfe, 0xdb, 0xd1, 0xa6, 0x2e, 0x3f, 0x52, 0x43, 0x14, 0xe1,
+	0x85, 0xb5, 0xb7, 0xe8, 0x0a, 0x9e, 0x46, 0x9b, 0xdb, 0xf4, 0xb1, 0x2a, 0xbe, 0xe4, 0x01, 0xda,
+	0x68, 0x9b, 0x3e, 0x5e, 0xe0, 0xdf, 0x5f, 0x0e, 0xa3, 0x19, 0x66, 0x68, 0x76, 0x49, 0xd1, 0x24,
+	0x6c, 0xdf, 0x15, 0xec, 0x82, 0xf3, 0xa1, 0x09, 0x2e, 0x0e, 0xe9, 0x27, 0x83, 0x3d, 0x2e, 0xfa,
+	0x35, 0xb8, 0x73, 0x1b, 0xda, 0xc0, 0x57, 0x33, 0x1e, 0x1b, 0xf1, 0x0f, 0x64, 0x77, 0x10, 0x58,
+	0xdd, 0xf7, 0xd3, 0x56, 0xa1, 0x20, 0x84, 0xb8, 0x41, 0x70, 0x14, 0xaf, 0xe0, 0xf7, 0xd3, 0xd6,
+	0x58, 0xc6, 0x4b, 0x1a, 0x1b, 0x45, 0xb5, 0x88, 0xd0, 0xc1, 0x8d, 0x94, 0x92, 0xdb, 0xe8, 0x90,
+	0x68, 0xf5, 0x81, 0xa9, 0xbb, 0xaf, 0x2e, 0xcd, 0xf3, 0x04, 0xd9, 0x82, 0xad, 0x37, 0xe8, 0x82,
+	0x6d, 0x75, 0x2d, 0x47, 0x33, 0x8a, 0xef, 0x19, 0xdf, 0x54, 0xd0, 0xe1, 0x22, 0xfa, 0x00, 0xd1,
+	0x2d, 0x34, 0xd2, 0x85, 0x32, 0x80, 0x92, 0x3e, 0xf0, 0x24, 0xaa, 0x7c, 0x05, 0x5e, 0x38, 0xdf,
+	0xa4, 0x4b, 0x5a, 0xcf, 0x70, 0x61, 0xb1, 0x14, 0x1f, 0xc9, 0x5e, 0x38, 0x46, 0x85, 0xbb, 0x2b,
+	0x1e, 0x04, 0x92, 0x15, 0x44, 0x64, 0x95, 0xbe, 0xcc, 0x00, 0x75, 0x1f, 0x34, 0xbd, 0x60, 0xd3,
+	0x87, 0xba, 0xd5, 0x73, 0xd2, 0x0d, 0xfc, 0x19, 0xb4, 0x57, 0x5a, 0xeb, 0xcb, 0xb4, 0x70, 0x3b,
+	0x7a, 0x8a, 0xb5, 0x7d, 0xdb, 0x8b, 0xe4, 0x9d, 0x59, 0x43, 0x0c, 0x09, 0xb2, 0x80, 0x9e, 0x8e,
+	0x7f, 0x01, 0x76, 0x9c, 0x0e, 0xce, 0x5c, 0xde, 0x1c, 0xac, 0xa6, 0x36, 0xce, 0xc4, 0xa0, 0x4d,
+	0x5e, 0x9d, 0x9c, 0x40, 0xdb, 0x83, 0x79, 0xd0, 0xbd, 0xad, 0x9b, 0xd4, 0xce, 0x3f, 0x28, 0x7f,
+	0x25, 0xc8, 0x70, 0x05, 0x42, 0x60, 0xc8, 0x65, 0x34, 0xea, 0x67, 0x6c, 0xc1, 0x13, 0xbb, 0x32,
+	0x26, 0x0c, 0x97, 0x14, 0x0b, 0x82, 0x0b, 0x9f, 0x89, 0x06, 0x26, 0xcd, 0x1a, 0x46, 0xdc, 0xa4,
+	0x41, 0x6d, 0x64, 0xbf, 0xad, 0x04, 0x09, 0xb1, 0x3c, 0x04, 0x95, 0xd2, 0x08, 0x06, 0xb7, 0x27,
+	0x89, 0x03, 0x69, 0x38, 0x31, 0x78, 0x9f, 0x27, 0xb0, 0x85, 0x4b, 0x0e, 0x43, 0x60, 0xc7, 0x04,
+	0x6e, 0x04, 0x1b, 0xd3, 0x70, 0x3d, 0x51, 0xee, 0xad, 0x28, 0xbe, 0xf5, 0x37, 0xc5, 0x79, 0x31,
+	0x5c, 0x44, 0x7e, 0x16, 0x12, 0x28, 0xa9, 0x0d, 0x82, 0x7f, 0xde, 0x40, 0x93, 0x89, 0x74, 0x3a,
+	0xf4, 0xc5, 0x7e, 0x79, 0x4a, 0x0f, 0x34, 0x81, 0xbf, 0x26, 0xf4, 0x58, 0x39, 0xd1, 0x01, 0x6e,
+	0x38, 0x83, 0x18, 0x83, 0x3b, 0xa8, 0x11, 0xf0, 0x91, 0x02, 0x48, 0x53, 0xdb, 0x92, 0x23, 0xad,
+	0xac, 0x1b, 0xe9, 0xe0, 0x46, 0x48, 0x2b, 0xd8, 0x51, 0xfc, 0xc6, 0x83, 0xf0, 0xe8, 0x0a, 0xbf,
+	0xb4, 0x08, 0xcd, 0x68, 0x1e, 0xd1, 0x36, 0x61, 0x88, 0x88, 0x8f, 0x05, 0x46, 0xc6, 0x6f, 0x86,
+	0xf6, 0x1a, 0x59, 0x4b, 0xe0, 0xba, 0x1e, 0xaa, 0xea, 0x99, 0xb5, 0xa4, 0xbb, 0x4f, 0xb6, 0x72,
+	0xf0, 0xa6, 0x44, 0x31, 0x71, 0xc0, 0x1d, 0xe1, 0x5e, 0xcd, 0x74, 0xc7, 0xa0, 0xc6, 0xd2, 0xbf,
+	0x09, 0xd7, 0xe4, 0xb4, 0x5a, 0xd0, 0x35, 0x95, 0x2f, 0xc5, 0x35, 0x83, 0x1b, 0x72, 0xef, 0xa2,
+	0x23, 0x92, 0x81, 0xc0, 0xee, 0x45, 0xa8, 0x4b, 0x6d, 0xdf, 0xcd, 0x13, 0xa8, 0xa2, 0x37, 0x39,
+	0xb0, 0xd1, 0xba, 0xf7, 0x27, 0xde, 0x89, 0x46, 0x6d, 0xfe, 0x25, 0xb5, 0x61, 0xac, 0x05, 0x05,
+	0xe4, 0x1b, 0x43, 0xe8, 0x68, 0xc1, 0x06, 0xc0, 0xa3, 0x07, 0xd0, 0x56, 0x38, 0x61, 0x58, 0xb6,
+	0xda, 0xb5, 0x1e, 0xc1, 0xce, 0x33, 0x5c, 0x1f, 0xf7, 0x8b, 0x17, 0xbc, 0x52, 0xbc, 0x1f, 0x8d,
+	0x8b, 0xf3, 0x65, 0x3b, 0x48, 0x00, 0x0e, 0xd7, 0xb7, 0x40, 0x29, 0xac, 0x93, 0x37, 0xbd, 0xd8,
+	0x86, 0x77, 0x47, 0xa5, 0xaf, 0xee, 0xa8, 0x0b, 0x79, 0x7c, 0xd5, 0xf3, 0xba, 0x30, 0x78, 0x6a,
+	0x58, 0xb2, 0x4a, 0xc6, 0x10, 0x3a, 0xf5, 0x90, 0x20, 0xb1, 0xd0, 0x73, 0x91, 0xc3, 0xe7, 0x42,
+	0x70, 0xc9, 0x78, 0x8f, 0xdf, 0x31, 0x16, 0xce, 0x18, 0xee, 0x43, 0x5b, 0x42, 0x31, 0xa4, 0x3f,
+	0xd9, 0xa3, 0x85, 0xe4, 0x57, 0x14, 0x74, 0x20, 0xb7, 0x45, 0x70, 0xbf, 0x01, 0x87, 0xbd, 0x64,
+	0x15, 0x98, 0x54, 0x47, 0xb2, 0x43, 0xa1, 0xa4, 0x0c, 0x0c, 0xe5, 0x2c, 0x95, 0xa4, 0x0b, 0xae,
+	0x10, 0x67, 0xd0, 0x6c, 0x57, 0x0c, 0x6a, 0x7e, 0xff, 0x58, 0xf8, 0x42, 0xd6, 0x64, 0x11, 0x5f,
+	0x54, 0x06, 0xec, 0x8b, 0xc1, 0xcd, 0xe9, 0xc3, 0x10, 0x0f, 0xdd, 0x87, 0x4b, 0xe6, 0xfb, 0x9a,
+	0xb3, 0x2c, 0xdc, 0x38, 0x8e, 0x86, 0x74, 0xb1, 0x61, 0x0c, 0xe9, 0x4d, 0x52, 0x87, 0x44, 0x57,
+	0xb4, 0x2e, 0xe0, 0x3f, 0x85, 0x86, 0x5d, 0xcd, 0x59, 0x96, 0x26, 0xab, 0x22, 0x82, 0xac, 0xba,
+	0x9f, 0x3c, 0xbb, 0x01, 0xd7, 0xd6, 0x77, 0xac, 0x26, 0x2d, 0x91, 0x3c, 0x7b, 0x0d, 0xd2, 0x52,
+	0x31, 0x71, 0xb0, 0xe9, 0x45, 0xb4, 0xc1, 0xf4, 0x0a, 0xc0, 0x28, 0x92, 0x6a, 0x54, 0x54, 0x94,
+	0x0b, 0x90, 0x69, 0x38, 0x7c, 0x46, 0xbe, 0x0c, 0xc5, 0xe3, 0x6f, 0xc2, 0x31, 0x33, 0xf9, 0x7d,
+	0xb2, 0xe9, 0x4a, 0xb9, 0xa6, 0x9f, 0x85, 0x58, 0xe8, 0x6e, 0x8f, 0xf6, 0x68, 0x33, 0xec, 0x32,
+	0xff, 0xfe, 0xfa, 0x6d, 0x08, 0x61, 0x52, 0xab, 0x80, 0x01, 0x67, 0xd0, 0x06, 0xcf, 0xc1, 0xf2,
+	0x8c, 0x5c, 0xa4, 0x43, 0x78, 0x7d, 0xff, 0x58, 0x1e, 0xfe, 0x2e, 0x84, 0xfc, 0x75, 0xf0, 0x4c,
+	0xe2, 0xeb, 0xf5, 0xb6, 0x7b, 0x27, 0x58, 0xe9, 0x42, 0x39, 0x0c, 0x38, 0x26, 0xde, 0x73, 0x35,
+	0xd7, 0x1f, 0x16, 0x89, 0x85, 0x4c, 0x49, 0x5b, 0xc8, 0xb4, 0x60, 0x1d, 0xcb, 0xd4, 0x17, 0x5c,
+	0xed, 0x3d, 0x0a, 0x07, 0xd0, 0xf0, 0x09, 0x4f, 0x23, 0x64, 0xd3, 0x6e, 0xcf, 0x0d, 0x66, 0xd9,
+	0x86, 0x7a, 0xa8, 0x84, 0x3c, 0x1f, 0xc4, 0x60, 0xd1, 0xdb, 0xd4, 0x14, 0xab, 0xc9, 0x5f, 0x85,
+	0xe2, 0x28, 0x59, 0x6d, 0x3f, 0x91, 0xf6, 0x4c, 0x08, 0x8f, 0x2a, 0x76, 0x2f, 0xf6, 0x68, 0x42,
+	0xba, 0xa2, 0x64, 0x29, 0xde, 0xde, 0x4d, 0xff, 0x22, 0x91, 0xdd, 0x1a, 0x4a, 0x64, 0xb7, 0xf0,
+	0x33, 0x68, 0x04, 0xf6, 0x96, 0x26, 0xdc, 0xbe, 0x8a, 0x00, 0x92, 0x3c, 0x46, 0xdb, 0x33, 0x5a,
+	0xf4, 0x36, 0xdd, 0x30, 0x04, 0x3d, 0xbd, 0xa3, 0x62, 0x97, 0x72, 0x59, 0xde, 0xaf, 0x24, 0xbc,
+	0xff, 0xed, 0x21, 0xb4, 0x2d, 0xd4, 0xf4, 0xb5, 0x9e, 0x61, 0xf0, 0x76, 0x0f, 0xa0, 0xad, 0x5a,
+	0xa3, 0x61, 0xf5, 0x4c, 0x57, 0x8d, 0x9e, 0x63, 0xc7, 0xa1, 0x78, 0x96, 0x97, 0xe2, 0x43, 0x68,
+	0xc2, 0xea, 0x52, 0x9b, 0x45, 0x0f, 0xa2, 0x26, 0xdf, 0x14, 0xb7, 0x8a, 0x72, 0x51, 0x35, 0xc7,
+	0x18, 0x7c, 0x0e, 0x55, 0xa9, 0x66, 0x9b, 0xb4, 0xa9, 0x36, 0x2c, 0xdd, 0x74, 0xd4, 0x48, 0x36,
+	0x9b, 0x6d, 0xff, 0xc3, 0xf5, 0xed, 0xbc, 0xc6, 0xbc, 0x57, 0x21, 0x9c, 0xfe, 0xc0, 0x17, 0xd0,
+	0x0e, 0x9b, 0x3e, 0xd2, 0xec, 0xa6, 0x2f, 0x6e, 0x68, 0x2e, 0x75, 0x84, 0xf4, 0x06, 0x26, 0x3d,
+	0x25, 0xaa, 0x30, 0xf9, 0x57, 0x58, 0x05, 0x2e, 0x7e, 0x08, 0x52, 0x11, 0x0e, 0x7b, 0x76, 0x65,
+	0x50, 0x97, 0x36, 0xa7, 0x36, 0xee, 0x51, 0x0e, 0x6e, 0xa9, 0x6f, 0xe5, 0xe5, 0xf3, 0xa2, 0xd8,
+	0x4f, 0xd1, 0x84, 0xb3, 0x84, 0xbe, 0xe3, 0xc4, 0x48, 0xfd, 0xaa, 0xc8, 0x93, 0xa4, 0x57, 0xf2,
+	0xcf, 0x48, 0x38, 0xd4, 0x8f, 0x4e, 0x64, 0x64, 0x1e, 0xca, 0x1b, 0x99, 0x81, 0xba, 0xc9, 0xb0,
+	0x12, 0x56, 0x44, 0xbe, 0x8a, 0x0e, 0xb2, 0xf6, 0xd9, 0xa7, 0xb9, 0x15, 0xef, 0xe8, 0xb4, 0x40,
+	0x6d, 0xdd, 0x6a, 0xce, 0xad, 0x5c, 0x11, 0x0f, 0x81, 0xc4, 0x5a, 0xb0, 0x13, 0x8d, 0xfa, 0x8f,
+	0x83, 0xa0, 0x97, 0x83, 0x02, 0xbc, 0x03, 0x8d, 0x7a, 0xa7, 0x37, 0x75, 0xc9, 0xb6, 0x3a, 0x30,
+	0xae, 0x47, 0xbc, 0x82, 0x6b, 0xb6, 0xd5, 0xc1, 0xdb, 0xd1, 0x26, 0xf6, 0xa5, 0x6b, 0xc1, 0x98,
+	0xde, 0xe8, 0x7d, 0xbc, 0x6f, 0x11, 0x03, 0xa6, 0xb5, 0xbc, 0x7d, 0xff, 0xea, 0x63, 0x43, 0x3e,
+	0x72, 0x5f, 0x2c, 0xa4, 0xb2, 0xce, 0xe5, 0x48, 0x1b, 0x82, 0x5e, 0xf8, 0xca, 0xaf, 0x3a, 0x6b,
+	0x36, 0x59, 0xf7, 0xce, 0x69, 0x8d, 0x65, 0xaf, 0xe3, 0x9d, 0x62, 0x90, 0xc5, 0x54, 0x75, 0x54,
+	0xb1, 0x62, 0xf1, 0xa9, 0xea, 0xdc, 0x21, 0x75, 0x74, 0x2a, 0xfa, 0xcc, 0xc1, 0x99, 0x35, 0x9b,
+	0x2c, 0x7d, 0xe9, 0x40, 0xe3, 0xac, 0x41, 0x27, 0xd1, 0x62, 0x58, 0xa7, 0x12, 0xd5, 0xd9, 0x46,
+	0x2f, 0xe4, 0xe8, 0x0c, 0xdc, 0x27, 0xd4, 0xf5, 0xd7, 0x2b, 0x4d, 0x38, 0x7d, 0x64, 0xb7, 0xc4,
+	0x93, 0x63, 0xeb, 0x6b, 0x45, 0x45, 0x88, 0xa9, 0xe1, 0x2b, 0x49, 0xfa, 0x83, 0x86, 0x1d, 0x68,
+	0x54, 0xd3, 0x55, 0x96, 0xe1, 0x75, 0x84, 0x66, 0x4d, 0xe7, 0xa6, 0x78, 0x0b, 0x85, 0xdf, 0xef,
+	0x8e, 0x58, 0x28, 0x82, 0x12, 0xe2, 0x40, 0x77, 0xe7, 0xc3, 0xf0, 0x9f, 0x6e, 0x8c, 0xf1, 0x97,
+	0x72, 0xac, 0x71, 0x31, 0xce, 0x76, 0x67, 0xe7, 0xf9, 0xf8, 0xbc, 0xda, 0xcc, 0x84, 0xb8, 0x2e,
+	0xf2, 0xab, 0xe2, 0xfe, 0x26, 0xab, 0x55, 0xbf, 0xb5, 0x08, 0x36, 0x45, 0x8a, 0x6d, 0x28, 0x8e,
+	0x0d, 0x9f, 0x44, 0x4f, 0x6b, 0x0d, 0xb7, 0xa7, 0x19, 0x6a, 0x50, 0xa8, 0x36, 0x2c, 0x47, 0x3c,
+	0xd9, 0xd9, 0xc6, 0xbf, 0x0d, 0x8c, 0x98, 0xb7, 0x1c, 0x97, 0x10, 0x88, 0x66, 0xe6, 0xd9, 0xd2,
+	0x1c, 0xd9, 0x19, 0xfd, 0x25, 0xe9, 0xac, 0x48, 0x2d, 0xa7, 0xd6, 0x09, 0x6e, 0x04, 0x5c, 0xcb,
+	0x85, 0x1c, 0x77, 0xa5, 0xce, 0x3f, 0x90, 0x29, 0x48, 0x9d, 0x5e, 0xa1, 0x8b, 0xbd, 0x56, 0x64,
+	0x9d, 0xfb, 0xa4, 0x02, 0x09, 0xc7, 0xf0, 0x57, 0xa0, 0x6b, 0x11, 0x6d, 0xe1, 0x5e, 0x5f, 0x5c,
+	0x61, 0x09, 0x20, 0x70, 0xfb, 0xc5, 0x54, 0xb7, 0x67, 0x28, 0x99, 0xb9, 0x4f, 0x3b, 0x5d, 0xcb,
+	0xd6, 0x6c, 0x36, 0x07, 0xbc, 0xaf, 0xa0, 0x57, 0xf8, 0xa4, 0xc0, 0x14, 0x8d, 0xfb, 0x6d, 0x88,
+	0x8b, 0x59, 0xaf, 0x91, 0x4b, 0xfd, 0x35, 0xc2, 0x26, 0x2f, 0x6b, 0x65, 0xcc, 0x09, 0x4d, 0xe7,
+	0xaa, 0x8d, 0x26, 0x13, 0x86, 0xe4, 0x2c, 0x22, 0xfe, 0xa2, 0x36, 0xd4, 0xdf, 0xa2, 0x56, 0x75,
+	0x11, 0x4e, 0xda, 0x95, 0xd3, 0xe8, 0xe5, 0x68, 0xa3, 0x87, 0x0b, 0x35, 0xca, 0xef, 0x84, 0x60,
+	0x29, 0x3d, 0x14, 0x84, 0x7c, 0xb7, 0x75, 0x53, 0xef, 0xf4, 0x3a, 0xc1, 0xd9, 0x7a, 0xf6, 0x21,
+	0xb5, 0x83, 0x63, 0x1e, 0xf9, 0x96, 0x02, 0x9b, 0x8c, 0xb4, 0x2e, 0x0c, 0x86, 0xbd, 0x68, 0x8b,
+	0x6b, 0x6b, 0x4b, 0x4b, 0x7a, 0x43, 0x5d, 0xd4, 0x1c, 0xdd, 0x81, 0x30, 0x71, 0x0c, 0x0a, 0xe7,
+	0xbc, 0x32, 0x7c, 0x1e, 0x55, 0x3b, 0x5c, 0x51, 0xf8, 0xf5, 0xb0, 0xc6, 0x55, 0x41, 0x58, 0x31,
+	0xd5, 0xc9, 0x68, 0x2a, 0xf5, 0x8d, 0xdb, 0x70, 0xf4, 0x8a, 0x71, 0x3f, 0x5c, 0x4c, 0xdc, 0x34,
+	0x17, 0x6c, 0xab, 0xe5, 0x45, 0x25, 0xa9, 0x87, 0x03, 0x15, 0xee, 0x86, 0x33, 0xab, 0xad, 0x37,
+	0x50, 0x3f, 0x13, 0x5c, 0xb1, 0x2d, 0xf0, 0x37, 0xc4, 0x0f, 0xf8, 0x13, 0x62, 0xb1, 0xf2, 0x3e,
+	0x8d, 0x36, 0xb6, 0x23, 0xe1, 0x34, 0xff, 0x44, 0x9c, 0xe0, 0xea, 0x2d, 0x2e, 0x08, 0x36, 0xdd,
+	0x85, 0x88, 0xd1, 0xff, 0x46, 0x7a, 0xa5, 0x12, 0x55, 0x22, 0xae, 0x54, 0xa2, 0x0a, 0xc2, 0xaf,
+	0x92, 0xd2, 0xad, 0x1d, 0x54, 0xb2, 0xe0, 0xc3, 0xd0, 0xab, 0xa4, 0x12, 0xf0, 0x2a, 0xeb, 0x82,
+	0x37, 0xb8, 0x44, 0xc0, 0x8d, 0xd8, 0xb9, 0xee, 0xd6, 0xc3, 0x3a, 0x6d, 0x58, 0x76, 0x33, 0xd4,
+	0xa9, 0x5e, 0xf7, 0xfb, 0x19, 0x64, 0xf8, 0x84, 0x27, 0x50, 0x25, 0xb8, 0x3c, 0xf6, 0xfe, 0x24,
+	0x8b, 0xe0, 0xf1, 0xa4, 0x26, 0x70, 0xc3, 0x2c, 0xda, 0x68, 0xb3, 0x12, 0xf0, 0xf6, 0xa1, 0xdc,
+	0xa1, 0x77, 0xeb, 0x35, 0x50, 0x01, 0x82, 0xe4, 0x22, 0x0c, 0xf2, 0x57, 0x74, 0xc7, 0x8d, 0xb7,
+	0x73, 0x8b, 0xae, 0x38, 0x39, 0x56, 0x93, 0x73, 0xb0, 0x1f, 0x66, 0xcb, 0x83, 0xad, 0x18, 0x0d,
+	0x2f, 0xd3, 0x15, 0x91, 0xc4, 0x64, 0x7f, 0x93, 0x37, 0xa0, 0xa3, 0x85, 0xe0, 0x7c, 0x9b, 0x36,
+	0x96, 0xbb, 0x96, 0x1e, 0x3c, 0x89, 0xf2, 0xc2, 0x0b, 0xcd, 0x59, 0x56, 0xf5, 0xb8, 0xb7, 0x76,
+	0x21, 0x64, 0xf5, 0x5c, 0x6a, 0xab, 0x8e, 0x4b, 0xbb, 0xb0, 0x83, 0x8e, 0xb2, 0x92, 0x7b, 0x2e,
+	0xed, 0x92, 0x9f, 0x86, 0xb3, 0x7f, 0x9a, 0x66, 0x30, 0xe8, 0x3a, 0x42, 0x0d, 0xbf, 0x14, 0x1c,
+	0x78, 0x40, 0xea, 0xc0, 0x90, 0x92, 0x90, 0x28, 0x79, 0x29, 0xb3, 0x2d, 0x27, 0x0f, 0x06, 0xe9,
+	0xc0, 0x96, 0x9d, 0x2a, 0x0b, 0x86, 0xde, 0x44, 0x9b, 0x83, 0xd6, 0xc4, 0x2a, 0x53, 0xd8, 0xd2,
+	0xb0, 0x2c, 0x79, 0x27, 0x96, 0x92, 0x98, 0xd3, 0x6c, 0x5b, 0x0f, 0xce, 0x00, 0x17, 0x51, 0xc5,
+	0xa6, 0xef, 0x49, 0x53, 0x8e, 0xd7, 0xa9, 0x0b, 0x42, 0xde, 0xbe, 0xd1, 0x13, 0x08, 0xeb, 0x9e,
+	0x20, 0xd1, 0x62, 0x43, 0xdf, 0x57, 0xef, 0x8f, 0xd7, 0x61, 0x9b, 0x3a, 0x5d, 0x98, 0x5d, 0x47,
+	0x0b, 0x36, 0x00, 0x73, 0x8c, 0x89, 0x92, 0x77, 0x62, 0x43, 0x66, 0xd6, 0xd0, 0x1f, 0x46, 0x73,
+	0x5d, 0xe7, 0xc2, 0x20, 0x0e, 0x65, 0xb5, 0x91, 0x90, 0x13, 0x08, 0x76, 0x67, 0xaa, 0xf7, 0xc9,
+	0x0d, 0x61, 0x10, 0x87, 0x8b, 0x34, 0x10, 0x41, 0xf0, 0x35, 0x25, 0xb8, 0x21, 0x9c, 0x63, 0x3c,
+	0x8f, 0xfb, 0x01, 0xcd, 0x23, 0xf4, 0x50, 0xc6, 0xb2, 0xf5, 0x96, 0x6e, 0xaa, 0x8d, 0xb6, 0xa6,
+	0x9b, 0x22, 0x63, 0xc7, 0xcb, 0xe6, 0xbd, 0xa2, 0x60, 0xa3, 0x33, 0x7b, 0x9d, 0x45, 0xff, 0x16,
+	0x80, 0x6f, 0x74, 0x77, 0x58, 0x91, 0xb7, 0xdd, 0xda, 0xb4, 0x41, 0xf5, 0xae, 0x0b, 0xb9, 0x6c,
+	0xfe, 0xf8, 0x64, 0x0c, 0x0a, 0xf9, 0xb3, 0xac, 0x9f, 0x83, 0x88, 0x30, 0xdd, 0x1c, 0x00, 0xfd,
+	0x16, 0x9a, 0x5c, 0x8c, 0x7f, 0xe9, 0x2f, 0xf1, 0x69, 0x1e, 0x48, 0xa8, 0x82, 0x65, 0x37, 0xa9,
+	0x86, 0x2c, 0x83, 0x01, 0xb3, 0x86, 0x91, 0x90, 0x1a, 0xf8, 0x4d, 0xd3, 0xf7, 0x15, 0x38, 0x93,
+	0x67, 0xb4, 0x06, 0x78, 0xbf, 0x82, 0x70, 0xc2, 0x50, 0xf9, 0x63, 0xe5, 0x2c, 0xc0, 0x29, 0x7a,
+	0x06, 0xb7, 0xd7, 0xb4, 0xe3, 0x43, 0x69, 0x8e, 0x87, 0x39, 0x5a, 0x33, 0x98, 0xd4, 0x03, 0x19,
+	0x4a, 0x44, 0x8f, 0x8f, 0x92, 0x48, 0x4b, 0xe0, 0xb5, 0x2b, 0x5e, 0xbc, 0xe2, 0x95, 0x14, 0x18,
+	0x1a, 0x21, 0x79, 0xf1, 0x84, 0x91, 0xcb, 0x92, 0x63, 0xc1, 0x95, 0x3d, 0xaf, 0xfa, 0xba, 0xee,
+	0xb6, 0x9b, 0xb6, 0xf6, 0x28, 0x78, 0xd8, 0x13, 0x4f, 0xa8, 0xff, 0x6f, 0x62, 0x4e, 0x85, 0x65,
+	0xfc, 0xc7, 0x3b, 0xe8, 0x91, 0x5f, 0x2a, 0xbd, 0x6e, 0x8f, 0xab, 0x10, 0xef, 0xce, 0x03, 0x71,
+	0xbc, 0x1f, 0x8d, 0x3b, 0x7a, 0x8b, 0xb1, 0xc9, 0x1c, 0xb6, 0x4e, 0x89, 0x4b, 0x20, 0x28, 0xe5,
+	0x8b, 0x97, 0xe7, 0x59, 0x6a, 0x36, 0xac, 0x26, 0x6d, 0xf2, 0x87, 0x2d, 0xde, 0x04, 0x1c, 0xab,
+	0x6f, 0x86, 0x32, 0xf6, 0x18, 0xfa, 0x59, 0x34, 0xd6, 0xa1, 0x8e, 0xa3, 0xb5, 0xa8, 0xda, 0xd6,
+	0x1c, 0x9e, 0xe2, 0x1a, 0xab, 0x6f, 0x86, 0xb2, 0x1b, 0x9a, 0xd3, 0xf6, 0xc2, 0x7d, 0x4f, 0xad,
+	0xe6, 0xf6, 0x6c, 0xca, 0x92, 0x58, 0x63, 0xf5, 0xa0, 0xc0, 0x7f, 0xeb, 0x02, 0xaf, 0x3a, 0x97,
+	0x2c, 0x11, 0xc0, 0xfe, 0x91, 0x02, 0x27, 0xb6, 0xd0, 0x37, 0xe0, 0x8b, 0xfc, 0x87, 0x78, 0xf8,
+	0xac, 0x4f, 0xe2, 0x1a, 0xca, 0x27, 0x71, 0x41, 0x0f, 0x72, 0x01, 0x3c, 0x8f, 0xc6, 0x22, 0x79,
+	0xb7, 0x0a, 0x53, 0x50, 0xcd, 0xbe, 0xb7, 0x11, 0xac, 0x17, 0x23, 0x48, 0xc6, 0x91, 0xeb, 0x10,
+	0xa5, 0xb3, 0x93, 0xea, 0x82, 0x35, 0xbf, 0xc8, 0x9f, 0xe6, 0xcd, 0xc2, 0x15, 0x63, 0xe8, 0x6a,
+	0x23, 0x64, 0x35, 0x64, 0x55, 0x62, 0x94, 0x96, 0x7d, 0x72, 0x45, 0xc1, 0xa9, 0xb7, 0xe1, 0x3f,
+	0x13, 0x1e, 0xae, 0xf3, 0x0f, 0xe4, 0x16, 0x1c, 0x85, 0x84, 0x74, 0xe8, 0x21, 0x66, 0x79, 0x53,
+	0x2e, 0xc3, 0x59, 0x49, 0xaa, 0x4c, 0x6a, 0xce, 0xc5, 0x60, 0x1e, 0xb2, 0x94, 0xc4, 0x02, 0xb5,
+	0x59, 0x4e, 0x81, 0x3d, 0x32, 0x0b, 0xa5, 0x99, 0x7c, 0x4a, 0x83, 0x12, 0xa5, 0x34, 0x2c, 0xc0,
+	0xf2, 0x97, 0x21, 0x1f, 0xb4, 0xdd, 0xf5, 0x0a, 0x44, 0xdb, 0xec, 0x83, 0x57, 0xba, 0x64, 0xf5,
+	0x4c, 0x7e, 0xdf, 0x39, 0x52, 0xe7, 0x1f, 0xc8, 0x81, 0xe0, 0x55, 0xef, 0xac, 0x61, 0x24, 0x95,
+	0xfa, 0xe7, 0xa9, 0x0b, 0x90, 0x11, 0x62, 0xa5, 0x12, 0x1b, 0x83, 0xd6, 0x87, 0x42, 0xad, 0x13,
+	0x3b, 0xb8, 0xd6, 0xc8, 0x6a, 0xc7, 0x67, 0x5e, 0x8d, 0x71, 0xd5, 0x4c, 0xb0, 0x40, 0xa2, 0x87,
+	0xc9, 0x8b, 0x31, 0xd8, 0xf1, 0x4b, 0x1c, 0x72, 0x36, 0x78, 0x3a, 0xc9, 0x2a, 0xce, 0x6b, 0x5d,
+	0xad, 0xa1, 0xbb, 0x2b, 0x05, 0x1c, 0x7d, 0x37, 0x38, 0xdc, 0xc5, 0x44, 0xc1, 0xca, 0x2a, 0x1a,
+	0x69, 0x40, 0x19, 0xb8, 0xd9, 0xff, 0x9c, 0xe1, 0xe9, 0xbd, 0x41, 0xdf, 0x0b, 0x0f, 0x80, 0x56,
+	0x3d, 0xf0, 0xf2, 0x4a, 0xd0, 0xc1, 0x69, 0x95, 0x82, 0x47, 0x77, 0xdc, 0xf0, 0x86, 0xff, 0x9d,
+	0xf4, 0x82, 0x2d, 0x02, 0x01, 0x3c, 0xb5, 0xb5, 0x13, 0x55, 0x4e, 0xae, 0xa1, 0x2d, 0x91, 0x7a,
+	0xb2, 0x3e, 0x0e, 0xa3, 0x1f, 0x8a, 0xa2, 0x27, 0x0f, 0x05, 0x4e, 0x5b, 0x33, 0x5d, 0x4a, 0x9d,
+	0xb9, 0x95, 0xdb, 0x7c, 0x31, 0xbc, 0xbf, 0xd2, 0xf5, 0xc7, 0xf8, 0x01, 0xb4, 0xb5, 0xc5, 0xbe,
+	0xb7, 0xe3, 0x77, 0x13, 0x50, 0x2c, 0x2e, 0x1c, 0x0e, 0xa2, 0x09, 0xb1, 0xbe, 0xba, 0x2b, 0x5d,
+	0xaa, 0xf6, 0x6c, 0x03, 0xd6, 0xea, 0xf1, 0x4e, 0xa0, 0xf6, 0x81, 0x6d, 0x90, 0xf3, 0x68, 0x13,
+	0x34, 0x99, 0xfd, 0x72, 0x2f, 0xf3, 0x9d, 0x2f, 0x79, 0x57, 0x38, 0x3e, 0xdd, 0x6a, 0xff, 0x3a,
+	0x73, 0xa4, 0x05, 0x15, 0xc0, 0xe1, 0x3b, 0xd3, 0x23, 0x48, 0x5e, 0xa9, 0xee, 0xd7, 0x26, 0xf5,
+	0xe0, 0xd0, 0x1f, 0xe4, 0x6e, 0x58, 0xd3, 0xc5, 0xf2, 0xd9, 0x18, 0x0d, 0x9b, 0x5a, 0x47, 0x24,
+	0x50, 0xd8, 0xdf, 0xe4, 0xeb, 0x4a, 0xb0, 0xd7, 0x26, 0x94, 0x82, 0xc5, 0xf3, 0x68, 0x13, 0x70,
+	0x85, 0x61, 0xcf, 0xdc, 0x97, 0x93, 0x4f, 0x62, 0xe2, 0x62, 0x47, 0x70, 0xf8, 0x23, 0xe7, 0x83,
+	0x68, 0xc2, 0xe9, 0x52, 0xd3, 0x55, 0xdd, 0xb6, 0xee, 0x44, 0xc9, 0x13, 0xac, 0xfc, 0x7e, 0x5b,
+	0xe7, 0xef, 0x43, 0xc9, 0x79, 0x98, 0x72, 0x31, 0x7d, 0xc5, 0x92, 0xf6, 0x64, 0x09, 0x66, 0x5d,
+	0x52, 0x1a, 0xd0, 0x5c, 0x45, 0x23, 0x80, 0x46, 0xf8, 0xbf, 0x0c, 0x9c, 0x4d, 0x1c, 0x8e, 0x73,
+	0xfc, 0x0b, 0x0b, 0x6d, 0x60, 0x0d, 0xe1, 0x5f, 0x56, 0xd0, 0x46, 0xbe, 0x09, 0xe2, 0x03, 0xd9,
+	0xe9, 0xc6, 0x08, 0x6d, 0xba, 0x7a, 0x30, 0xbf, 0x22, 0x37, 0x97, 0x1c, 0xff, 0x85, 0x1f, 0x7e,
+	0xf1, 0xcd, 0xa1, 0x23, 0xf8, 0x70, 0xad, 0x6b, 0x5b, 0xcd, 0x5e, 0xc3, 0x75, 0x1a, 0x7a, 0x16,
+	0xd7, 0x1e, 0x7e, 0x78, 0x01, 0xff, 0x8e, 0x82, 0x46, 0xfd, 0xcc, 0x30, 0x3e, 0x9a, 0xdd, 0x56,
+	0x0a, 0xbd, 0xba, 0x3a, 0x53, 0xb4, 0x3a, 0x18, 0x78, 0x81, 0x19, 0x78, 0x06, 0x9f, 0x2a, 0x62,
+	0x60, 0xf0, 0xd7, 0x2a, 0x3b, 0x8f, 0xac, 0xe1, 0xdf, 0x52, 0xd0, 0x98, 0xaf, 0x74, 0xd6, 0x30,
+	0x64, 0xe6, 0xa6, 0x50, 0xaf, 0x65, 0xe6, 0xa6, 0x91, 0xa8, 0xc9, 0x29, 0x66, 0x6e, 0x0d, 0x1f,
+	0x2d, 0x65, 0x2e, 0xfe, 0xb6, 0x82, 0x36, 0x87, 0x32, 0xe5, 0xb8, 0x26, 0xf5, 0x52, 0x92, 0x32,
+	0x5b, 0x7d, 0xa1, 0xb8, 0x00, 0x58, 0x7a, 0x89, 0x59, 0x7a, 0x16, 0x9f, 0x29, 0xd8, 0xf3, 0x42,
+	0x81, 0xef, 0xda, 0xdf, 0x55, 0xd0, 0x78, 0x48, 0xb1, 0xe7, 0xdc, 0x9a, 0xd4, 0x5b, 0xe5, 0xcc,
+	0x4e, 0x67, 0x3d, 0x93, 0x33, 0xcc, 0xec, 0x63, 0xb8, 0x56, 0xd2, 0x6c, 0xfc, 0x97, 0x0a, 0xda,
+	0x96, 0x46, 0x1b, 0xc6, 0xa7, 0xb2, 0x6d, 0x90, 0x90, 0x94, 0xab, 0xa7, 0xcb, 0x8a, 0x01, 0x80,
+	0x5b, 0x0c, 0xc0, 0x55, 0x3c, 0x5f, 0x6a, 0x84, 0xa8, 0x91, 0x1e, 0x80, 0xbd, 0x62, 0x0d, 0xff,
+	0xb1, 0x82, 0x26, 0x13, 0x5c, 0x62, 0x7c, 0x5c, 0x3a, 0x18, 0x52, 0x19, 0xce, 0xd5, 0x13, 0xa5,
+	0x64, 0xfa, 0x19, 0x43, 0x2d, 0xea, 0xaa, 0x36, 0xd3, 0xa3, 0xfa, 0x34, 0xdf, 0xef, 0x2a, 0x08,
+	0x27, 0xa9, 0xbc, 0x58, 0x62, 0x4c, 0x26, 0xa7, 0xb9, 0x7a, 0xb2, 0x9c, 0x50, 0x3f, 0x10, 0x84,
+	0xdd, 0x6a, 0x40, 0x10, 0xc6, 0x1f, 0x29, 0x68, 0x3c, 0x4a, 0x19, 0xc8, 0xf1, 0x7f, 0x2a, 0x99,
+	0x21, 0xc7, 0xff, 0xe9, 0xd4, 0x06, 0xf2, 0x32, 0x33, 0xfe, 0x0a, 0x9e, 0x2b, 0x64, 0x7c, 0x8c,
+	0x04, 0x51, 0x5b, 0x0d, 0xbd, 0x42, 0x5c, 0xc3, 0x7f, 0xa8, 0xa0, 0xc9, 0x68, 0x33, 0xde, 0x8c,
+	0x3e, 0x2e, 0x9d, 0xa0, 0xa5, 0xa1, 0x64, 0xf2, 0x80, 0xc9, 0x79, 0x06, 0xe5, 0x34, 0x3e, 0xd9,
+	0x0f, 0x14, 0xfc, 0xa1, 0x82, 0xc6, 0xc2, 0x04, 0x4b, 0x2c, 0x5f, 0x0f, 0x53, 0xc8, 0xb1, 0xd5,
+	0x63, 0x25, 0x24, 0xc0, 0xe6, 0xeb, 0xcc, 0xe6, 0x59, 0x7c, 0xa9, 0x88, 0xcd, 0x11, 0xa6, 0x68,
+	0x6d, 0x35, 0x34, 0x9f, 0xd7, 0xbc, 0xe5, 0x7f, 0x6b, 0xb8, 0x05, 0xcf, 0xf3, 0xf2, 0xa5, 0xb1,
+	0x24, 0x82, 0x0c, 0xa2, 0x2e, 0x39, 0xcb, 0x10, 0x9c, 0xc0, 0xc7, 0x4a, 0x23, 0xf0, 0xc6, 0xcb,
+	0x96, 0x08, 0xc7, 0x14, 0x4b, 0xf6, 0xca, 0x34, 0xc6, 0x6d, 0xb5, 0x56, 0xb8, 0x3e, 0x58, 0x7b,
+	0x83, 0x59, 0x3b, 0x87, 0x2f, 0x17, 0x5a, 0xfb, 0xb9, 0x0a, 0x95, 0xf3, 0x56, 0x63, 0x0e, 0xff,
+	0x89, 0x82, 0x9e, 0x4a, 0x65, 0x32, 0xe2, 0xb3, 0x05, 0xe7, 0x61, 0x92, 0xc3, 0x59, 0x7d, 0xa9,
+	0x1f, 0x51, 0x80, 0xa6, 0x32, 0x68, 0x6f, 0xe2, 0xd7, 0xcb, 0x0e, 0xff, 0xd0, 0x09, 0x3f, 0x8a,
+	0x31, 0x36, 0xbd, 0x3f, 0x55, 0xd0, 0x54, 0xaa, 0x09, 0xde, 0x58, 0x3b, 0x5b, 0x70, 0xc6, 0x96,
+	0x03, 0x9d, 0x47, 0x41, 0x25, 0xf3, 0x0c, 0xf4, 0x05, 0x7c, 0x6e, 0x1d, 0xa0, 0xf1, 0x5f, 0x28,
+	0x08, 0x27, 0xb9, 0x9c, 0xb2, 0x2d, 0x24, 0x93, 0x3e, 0x2a, 0xdb, 0x42, 0xb2, 0xe9, 0xa2, 0x64,
+	0x81, 0xc1, 0x78, 0x19, 0xdf, 0x28, 0x34, 0x2c, 0x05, 0x15, 0x93, 0x3a, 0xea, 0x92, 0x65, 0xab,
+	0x8e, 0xa7, 0xaa, 0xb6, 0x1a, 0x4e, 0x96, 0xad, 0xe1, 0xef, 0x28, 0x68, 0x6b, 0x8c, 0x4d, 0x99,
+	0xb3, 0xa2, 0xa5, 0xf0, 0x32, 0x73, 0x56, 0xb4, 0x34, 0xaa, 0x66, 0xb9, 0x68, 0xdb, 0xdb, 0xd0,
+	0x23, 0xaf, 0xe7, 0xf0, 0xef, 0x2b, 0x68, 0x3c, 0xca, 0xb2, 0xcc, 0xd9, 0x0b, 0x53, 0x99, 0x9f,
+	0x39, 0x7b, 0x61, 0x3a, 0x19, 0x94, 0x9c, 0x63, 0xa6, 0x9f, 0xc2, 0x27, 0x8a, 0x98, 0x1e, 0xa3,
+	0x8d, 0xe2, 0xff, 0x50, 0xd0, 0x2e, 0x29, 0x43, 0x13, 0x5f, 0x94, 0xda, 0x94, 0x4b, 0x15, 0xad,
+	0x5e, 0xea, 0x5b, 0x1e, 0xf0, 0xdd, 0x61, 0xf8, 0x6e, 0xe0, 0x6b, 0x45, 0xbb, 0x26, 0xf6, 0x0b,
+	0x6f, 0x3c, 0x59, 0xa5, 0xfa, 0xec, 0xd0, 0x4f, 0x14, 0xf4, 0x54, 0x2a, 0xb5, 0x13, 0x4b, 0x22,
+	0x5b, 0x19, 0x61, 0xb4, 0x7a, 0xa6, 0xb4, 0x1c, 0x40, 0xbb, 0xc2, 0xa0, 0x5d, 0xc4, 0xe7, 0x8b,
+	0x40, 0x8b, 0xfe, 0xfa, 0x40, 0x28, 0x06, 0xf8, 0x35, 0x05, 0x8d, 0xfa, 0xac, 0x4b, 0x7c, 0x38,
+	0xdb, 0x98, 0x38, 0x67, 0xb3, 0xfa, 0x7c, 0xa1, 0xba, 0x60, 0xec, 0x69, 0x66, 0xec, 0x0b, 0x78,
+	0xa6, 0x88, 0xb1, 0xfc, 0x25, 0x98, 0xaa, 0x19, 0x06, 0x7e, 0x5f, 0x41, 0x23, 0x82, 0x8e, 0x88,
+	0x8f, 0xe4, 0x8c, 0xf0, 0x08, 0xa7, 0xb2, 0x7a, 0xb4, 0x60, 0xed, 0x7e, 0x42, 0x5a, 0x9f, 0x47,
+	0x19, 0x3a, 0x55, 0xfc, 0xba, 0x82, 0x36, 0x0b, 0xad, 0x9e, 0x2f, 0x8f, 0x48, 0xd7, 0xf8, 0x12,
+	0xd6, 0xa6, 0x70, 0x39, 0xcb, 0x9d, 0x98, 0x7d, 0x6b, 0xf1, 0xdf, 0x2b, 0x68, 0x22, 0xce, 0xe5,
+	0xc3, 0x27, 0x8b, 0x25, 0x17, 0xa2, 0x84, 0xc5, 0xea, 0xa9, 0x92, 0x52, 0x60, 0xf8, 0xbb, 0xcc,
+	0xf0, 0x37, 0xf0, 0x6b, 0xe5, 0x0e, 0x72, 0x40, 0x52, 0xac, 0xad, 0xc6, 0xb9, 0x9f, 0x6b, 0xde,
+	0xc9, 0xda, 0x67, 0xf3, 0xad, 0xe1, 0xef, 0x29, 0xe8, 0xc9, 0x78, 0xe3, 0x5e, 0x6f, 0x9c, 0x2c,
+	0x96, 0x92, 0x28, 0x0e, 0x52, 0xc2, 0xaf, 0xec, 0x33, 0xfd, 0x22, 0x40, 0xe2, 0xff, 0x53, 0x50,
+	0x35, 0x9b, 0x83, 0x95, 0xb3, 0xa8, 0xe6, 0xd2, 0x03, 0x73, 0x16, 0xd5, 0x7c, 0xa2, 0x1f, 0xf9,
+	0x29, 0x06, 0xef, 0x2d, 0xfc, 0x46, 0x39, 0x78, 0xc9, 0x1f, 0x9d, 0x84, 0x68, 0xeb, 0x66, 0x33,
+	0xde, 0x8b, 0xff, 0xa2, 0xa0, 0x5d, 0xd9, 0x86, 0x78, 0xfd, 0x79, 0xb1, 0x58, 0xcf, 0xf4, 0xe3,
+	0x84, 0x42, 0x6c, 0xc7, 0x72, 0x61, 0xb5, 0xcc, 0x09, 0xf8, 0x97, 0x86, 0xd0, 0x9e, 0x3c, 0x4a,
+	0x20, 0x9e, 0x2d, 0xdb, 0x69, 0x09, 0xbe, 0x62, 0x75, 0x6e, 0x3d, 0x2a, 0x00, 0xb5, 0xc6, 0x50,
+	0xbf, 0x8d, 0xdf, 0x2c, 0xba, 0x9f, 0xa6, 0x22, 0x0f, 0x08, 0x80, 0xb5, 0x55, 0xbd, 0xe9, 0xac,
+	0xd5, 0x56, 0x7d, 0x96, 0xe4, 0x1a, 0xfe, 0x1f, 0x05, 0x6d, 0xcf, 0xa0, 0x8d, 0xe1, 0x73, 0xf9,
+	0x87, 0x85, 0x4c, 0xda, 0x5c, 0xf5, 0x7c, 0x7f, 0xc2, 0x80, 0xbc, 0xc1, 0x90, 0xbf, 0x83, 0xdf,
+	0x2e, 0x1e, 0x76, 0xa7, 0xfc, 0x62, 0x6a, 0xf4, 0x7c, 0x11, 0x39, 0x7b, 0x78, 0xe3, 0xfe, 0x47,
+	0x0a, 0xaa, 0x66, 0x18, 0xe2, 0x0d, 0xfa, 0x73, 0xf9, 0xc7, 0x86, 0xbe, 0xe0, 0xe7, 0xf3, 0xff,
+	0xc8, 0x55, 0x06, 0xff, 0x12, 0xbe, 0xb0, 0x2e, 0xf8, 0xde, 0x7e, 0x3e, 0x16, 0x7e, 0xf9, 0x26,
+	0xcb, 0x2c, 0xa7, 0x70, 0xf6, 0x64, 0x99, 0xe5, 0x34, 0xda, 0x5e, 0xb9, 0xa3, 0x7a, 0xe4, 0x97,
+	0x68, 0xd9, 0x51, 0x3d, 0xc2, 0x60, 0x93, 0x1d, 0xd5, 0xd3, 0xf8, 0x7d, 0xb2, 0xa3, 0x7a, 0x2a,
+	0xa1, 0xaf, 0xdc, 0x9a, 0x12, 0xf9, 0x25, 0xdc, 0xf8, 0x51, 0xfd, 0x03, 0x05, 0x4d, 0xc4, 0xc9,
+	0x7b, 0xf8, 0x58, 0x41, 0x7b, 0x42, 0x41, 0xde, 0xf1, 0x32, 0x22, 0x80, 0xe2, 0x22, 0x43, 0xf1,
+	0x22, 0x3e, 0x5d, 0x1e, 0x05, 0x8b, 0xf9, 0x3e, 0x52, 0xd8, 0xef, 0xd7, 0xc6, 0xa9, 0x7f, 0xb2,
+	0x2d, 0x3c, 0x9b, 0x4c, 0x28, 0xdb, 0xc2, 0x25, 0xfc, 0x42, 0x32, 0xcb, 0x40, 0x9c, 0xc3, 0x67,
+	0x8b, 0x80, 0x78, 0x8f, 0x29, 0x52, 0x23, 0xe3, 0xc7, 0x61, 0xe7, 0xd1, 0x18, 0x8d, 0x50, 0x76,
+	0x1e, 0x4d, 0x27, 0x24, 0xca, 0xce, 0xa3, 0x19, 0x1c, 0xc5, 0x72, 0xe1, 0x47, 0xf4, 0xe7, 0x97,
+	0x3d, 0xff, 0xff, 0xa7, 0x82, 0xaa, 0xd9, 0xac, 0xc2, 0x9c, 0x35, 0x58, 0xce, 0x12, 0xcc, 0x59,
+	0x83, 0x73, 0x48, 0x83, 0xe4, 0x4d, 0x06, 0xec, 0x1e, 0xbe, 0x5b, 0x74, 0xf7, 0xc9, 0xa4, 0x18,
+	0x26, 0x56, 0xde, 0x9f, 0xf0, 0xb3, 0x6c, 0x36, 0x73, 0x31, 0x27, 0xec, 0xca, 0x25, 0x48, 0xe6,
+	0x84, 0x5d, 0xf9, 0x94, 0x49, 0x72, 0x9b, 0xa1, 0xbf, 0x8e, 0xaf, 0x16, 0x45, 0xaf, 0x19, 0x46,
+	0xb6, 0x07, 0xf0, 0xf7, 0x15, 0x34, 0x15, 0xf5, 0x79, 0xc0, 0x82, 0x93, 0x9d, 0x66, 0x65, 0xdc,
+	0x3a, 0xd9, 0x69, 0x56, 0x4a, 0xb7, 0x2b, 0xb7, 0x68, 0x24, 0x89, 0x79, 0xf8, 0xdf, 0x15, 0xb4,
+	0x53, 0x46, 0x68, 0xc3, 0x17, 0xb2, 0x2d, 0x2b, 0x40, 0xc4, 0xab, 0x5e, 0xec, 0x57, 0x1c, 0xf0,
+	0xbd, 0xca, 0xf0, 0xdd, 0xc4, 0xd7, 0x8b, 0xe0, 0xf3, 0x6f, 0xce, 0x6b, 0xab, 0xfe, 0x9f, 0x6b,
+	0xb5, 0x08, 0x61, 0x07, 0xff, 0xfc, 0x10, 0x22, 0x59, 0x9c, 0xba, 0x80, 0xe2, 0x86, 0xe7, 0x72,
+	0xed, 0xce, 0x65, 0xe4, 0xc9, 0xb2, 0x95, 0x79, 0x84, 0x2b, 0xf2, 0x36, 0xc3, 0xfd, 0x00, 0xdf,
+	0x1b, 0x00, 0x6e, 0x20, 0xe9, 0x2d, 0xfa, 0xe0, 0xfe, 0x4c, 0x41, 0x93, 0xfc, 0xd1, 0x56, 0xf8,
+	0x57, 0xe1, 0x24, 0x2b, 0xbe, 0x84, 0x83, 0x25, 0xbb, 0x9a, 0x94, 0xd1, 0xb2, 0xfa, 0x1f, 0xb9,
+	0x35, 0xf6, 0x76, 0x0c, 0x7f, 0xa8, 0xa0, 0xa9, 0x80, 0xf7, 0x14, 0x25, 0x00, 0xe1, 0xe7, 0x8b,
+	0x71, 0xa5, 0x38, 0x82, 0x23, 0x65, 0x88, 0x55, 0xe5, 0x22, 0xba, 0xa6, 0x27, 0x1f, 0xea, 0x1f,
+	0x6f, 0x69, 0xe1, 0x13, 0xef, 0x17, 0x87, 0xd0, 0xa1, 0xc2, 0x8c, 0x4b, 0xfc, 0x72, 0xf9, 0xa1,
+	0x94, 0x45, 0xdb, 0x5c, 0xd7, 0xb0, 0xec, 0x73, 0x3a, 0x66, 0x0f, 0xc5, 0x7f, 0x56, 0xd0, 0xbe,
+	0x22, 0x24, 0x51, 0x7c, 0xb5, 0x1f, 0x0f, 0x24, 0x48, 0xa6, 0xeb, 0x02, 0x5f, 0x2a, 0x3e, 0xf0,
+	0x7b, 0xda, 0x5f, 0x79, 0xfe, 0x55, 0x41, 0x7b, 0xf2, 0xe8, 0x9d, 0xb2, 0xf3, 0x6a, 0x41, 0x86,
+	0xab, 0xec, 0xbc, 0x5a, 0x94, 0x5d, 0x4a, 0x2e, 0x33, 0xa8, 0x2f, 0xe1, 0x17, 0x4b, 0xe4, 0x1d,
+	0xa3, 0x68, 0xff, 0x51, 0x41, 0x3b, 0x24, 0x24, 0x3a, 0x2c, 0x8f, 0x68, 0x72, 0x78, 0x7a, 0xd5,
+	0x0b, 0x7d, 0x4a, 0xf7, 0x73, 0x95, 0xed, 0x85, 0x04, 0xd9, 0x14, 0x3e, 0xfc, 0xa9, 0x82, 0xb6,
+	0x67, 0x90, 0xea, 0xf0, 0x8b, 0xb2, 0xae, 0x90, 0xd1, 0xf5, 0xaa, 0x67, 0xfb, 0x90, 0x04, 0x70,
+	0xd7, 0x18, 0xb8, 0xcb, 0xf8, 0x62, 0xb1, 0x0c, 0x8b, 0xda, 0x05, 0x6d, 0xf1, 0x38, 0xfc, 0x4f,
+	0xc5, 0x93, 0x9b, 0x80, 0x56, 0x76, 0x3c, 0x3f, 0x0c, 0x8d, 0x13, 0xe9, 0x72, 0xee, 0x57, 0xd2,
+	0x29, 0x71, 0xe5, 0x92, 0xf4, 0xb1, 0xff, 0xb2, 0xa4, 0xb6, 0x2a, 0x6e, 0xb6, 0x3e, 0x50, 0xd0,
+	0x64, 0xb4, 0x81, 0xfc, 0x57, 0x06, 0xa5, 0x41, 0x64, 0xf2, 0xfa, 0xca, 0x5d, 0x12, 0xc5, 0x40,
+	0xe0, 0x7f, 0x50, 0xd0, 0x64, 0x82, 0x85, 0x81, 0xe5, 0xd9, 0xe3, 0x2c, 0x02, 0x8e, 0x6c, 0x8f,
+	0x96, 0x11, 0x65, 0xc8, 0x3b, 0x0c, 0xc1, 0xeb, 0xf8, 0x41, 0xad, 0xd8, 0xff, 0xeb, 0x52, 0x5b,
+	0x0d, 0x93, 0x33, 0xd6, 0xc4, 0x4d, 0x23, 0x27, 0x62, 0xb0, 0x74, 0x55, 0x88, 0xbf, 0xb3, 0x86,
+	0xff, 0x40, 0x41, 0x38, 0x49, 0x5b, 0x91, 0x05, 0xd1, 0x32, 0x56, 0x8d, 0x2c, 0x88, 0x96, 0xf2,
+	0x63, 0xc8, 0x0b, 0x0c, 0xe6, 0x61, 0x7c, 0xb0, 0x20, 0x4c, 0x76, 0x08, 0x98, 0x4c, 0x30, 0x3f,
+	0x0a, 0xf5, 0x4e, 0x92, 0xd3, 0x52, 0xa8, 0x77, 0x52, 0x08, 0x2a, 0xa1, 0x17, 0x21, 0x12, 0xb3,
+	0x59, 0x0f, 0xc8, 0xfb, 0x05, 0xff, 0x9e, 0x82, 0x26, 0xe2, 0x2c, 0x91, 0x9c, 0xeb, 0x8d, 0x0c,
+	0x2e, 0x4b, 0xf5, 0x54, 0x49, 0x29, 0x80, 0x72, 0x82, 0x41, 0x39, 0x8a, 0x9f, 0x97, 0x41, 0x09,
+	0x08, 0x2b, 0xb5, 0x55, 0xbd, 0xb9, 0x86, 0x3f, 0x56, 0xd0, 0x44, 0x9c, 0xa5, 0x89, 0x0b, 0x9c,
+	0xfb, 0x63, 0x1c, 0x56, 0x59, 0xb2, 0x26, 0x8b, 0xac, 0x5a, 0xee, 0x50, 0xe9, 0x2f, 0xac, 0xcb,
+	0x0f, 0x55, 0xce, 0x54, 0xad, 0xad, 0x72, 0xca, 0xe4, 0x5a, 0x6d, 0x75, 0x99, 0xae, 0xac, 0xe1,
+	0x2f, 0x14, 0x34, 0x95, 0x45, 0x3a, 0x95, 0x3d, 0x98, 0xc8, 0x21, 0xba, 0xca, 0xc2, 0x9d, 0x3c,
+	0x8e, 0x2b, 0xb9, 0xcb, 0x20, 0xde, 0xc2, 0x37, 0x8b, 0x40, 0x34, 0x74, 0xc7, 0x55, 0x93, 0x38,
+	0xd9, 0xd3, 0x64, 0x1f, 0x2c, 0xfe, 0x6b, 0x05, 0xe1, 0x24, 0xab, 0x53, 0xf6, 0x7c, 0x22, 0x93,
+	0x4c, 0x2b, 0x7b, 0x3e, 0x91, 0xcd, 0x93, 0x25, 0xaf, 0x31, 0x50, 0x0b, 0xf8, 0x4e, 0xa9, 0x7e,
+	0x0b, 0x58, 0xa7, 0x1c, 0x8b, 0xaa, 0x7b, 0x3d, 0x17, 0x50, 0x76, 0xd7, 0xf0, 0x27, 0x0a, 0x7a,
+	0x32, 0x85, 0xf6, 0x8a, 0x4b, 0x59, 0x59, 0x24, 0xf9, 0x26, 0xe1, 0xd6, 0x96, 0x0b, 0x6b, 0x52,
+	0xc0, 0x39, 0x01, 0x3a, 0x6f, 0x4d, 0xd8, 0x1a, 0x63, 0xbe, 0x16, 0xc9, 0xc2, 0x45, 0x39, 0xb8,
+	0x45, 0xb2, 0x70, 0x31, 0x5a, 0x6d, 0xb9, 0xb7, 0x79, 0x3e, 0x88, 0x45, 0x30, 0xf1, 0x7b, 0xa1,
+	0x11, 0x16, 0x90, 0x56, 0x8b, 0x8c, 0xb0, 0x04, 0x87, 0xb6, 0xc8, 0x08, 0x4b, 0xf2, 0x62, 0xcb,
+	0x85, 0xce, 0xbe, 0xfd, 0x9a, 0xa7, 0x88, 0x27, 0x73, 0xd9, 0xdb, 0x02, 0x9f, 0xe4, 0x26, 0x7b,
+	0x5b, 0x10, 0xe7, 0xc8, 0xc9, 0xde, 0x16, 0x24, 0x58, 0x73, 0xe5, 0xde, 0x16, 0x88, 0xeb, 0x97,
+	0x25, 0xcb, 0x8b, 0xec, 0xb7, 0x67, 0xb0, 0xcf, 0x64, 0x01, 0xaf, 0x9c, 0xf9, 0x26, 0x0b, 0x78,
+	0x73, 0xa8, 0x6e, 0xe5, 0xe6, 0x34, 0xff, 0x01, 0xb0, 0xae, 0xa5, 0x06, 0x2f, 0xa3, 0x34, 0xf1,
+	0xeb, 0x9f, 0xb0, 0x2f, 0xc2, 0x0d, 0x39, 0xfe, 0x6f, 0x05, 0xed, 0x90, 0x70, 0xdb, 0x64, 0x47,
+	0x98, 0x7c, 0x7e, 0x9d, 0xec, 0x08, 0x53, 0x80, 0x50, 0x47, 0xde, 0x62, 0xa0, 0xef, 0xe3, 0x7a,
+	0x49, 0xd0, 0x21, 0x9d, 0x99, 0xc0, 0x3f, 0x55, 0xd0, 0x53, 0xa9, 0x94, 0x3a, 0x2c, 0x0f, 0x55,
+	0x32, 0x39, 0x7c, 0xb2, 0xd0, 0x4c, 0xca, 0xdd, 0x23, 0xaf, 0x30, 0x98, 0xd7, 0xf0, 0x95, 0xc2,
+	0x07, 0x51, 0xb5, 0x4b, 0x6d, 0xfe, 0x4b, 0x45, 0xfc, 0x11, 0x52, 0x6d, 0x55, 0xb0, 0xb6, 0xd6,
+	0xf0, 0xdf, 0x29, 0xe8, 0x99, 0x4c, 0xc6, 0x1d, 0x7e, 0x29, 0x2f, 0xd3, 0x9c, 0x4d, 0x07, 0xac,
+	0x9e, 0xeb, 0x4b, 0xb6, 0x9f, 0x13, 0x9b, 0x66, 0x18, 0x6a, 0x2a, 0x50, 0x07, 0x7f, 0x57, 0x41,
+	0x13, 0x71, 0x86, 0x1e, 0x3e, 0x96, 0xef, 0xfa, 0x18, 0x11, 0xb0, 0x7a, 0xbc, 0x8c, 0x48, 0x3f,
+	0x69, 0xb1, 0x08, 0x5b, 0x6f, 0x25, 0xdc, 0x43, 0x7f, 0xce, 0x87, 0x5e, 0x92, 0xec, 0x97, 0x33,
+	0xf4, 0x32, 0x29, 0x84, 0x39, 0x43, 0x2f, 0x9b, 0x55, 0x58, 0x6e, 0x21, 0x0f, 0x7a, 0x25, 0xe0,
+	0x20, 0xe2, 0xff, 0xf2, 0xc0, 0xa4, 0x11, 0xe8, 0xa4, 0x60, 0x24, 0x3c, 0x41, 0x29, 0x18, 0x19,
+	0x53, 0x8f, 0xe8, 0x0c, 0x4c, 0x03, 0x6b, 0x85, 0x32, 0x1e, 0xa0, 0x4a, 0x5d, 0x5c, 0x51, 0xc3,
+	0x6c, 0xc3, 0xda, 0x6a, 0x8c, 0xa4, 0xb8, 0x56, 0x5b, 0x8d, 0xb3, 0x11, 0x59, 0x28, 0xb4, 0x35,
+	0x46, 0x38, 0xc3, 0xf2, 0x24, 0x40, 0x3a, 0x03, 0xb0, 0x7a, 0xb2, 0x9c, 0x50, 0x3f, 0x0f, 0x64,
+	0x13, 0xff, 0x83, 0x68, 0x24, 0x83, 0xbe, 0x6a, 0x6a, 0x1d, 0xba, 0x86, 0x3f, 0x52, 0xd0, 0x44,
+	0x9c, 0x82, 0x27, 0x9b, 0x56, 0x19, 0x64, 0x3f, 0xd9, 0xb4, 0xca, 0x62, 0xf8, 0x95, 0x0b, 0xe9,
+	0x12, 0x68, 0x9c, 0x30, 0x9c, 0xb9, 0x57, 0x3f, 0xfe, 0x6c, 0x5a, 0xf9, 0xc1, 0x67, 0xd3, 0xca,
+	0x8f, 0x3f, 0x9b, 0x56, 0xbe, 0xfe, 0xf9, 0xf4, 0x13, 0x3f, 0xf8, 0x7c, 0xfa, 0x89, 0xbf, 0xf9,
+	0x7c, 0xfa, 0x89, 0xb7, 0x4e, 0xb5, 0x74, 0xb7, 0xdd, 0x5b, 0x9c, 0x69, 0x58, 0x9d, 0xec, 0x76,
+	0x1e, 0x87, 0xe3, 0x96, 0x95, 0x2e, 0x75, 0x16, 0x37, 0xb2, 0xff, 0x4d, 0xf5, 0xc4, 0xff, 0x07,
+	0x00, 0x00, 0xff, 0xff, 0x7a, 0x6f, 0x3c, 0xe2, 0xe2, 0x79, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6748,12 +7742,16 @@ type QueryClient interface {
 	InferenceParticipant(ctx context.Context, in *QueryInferenceParticipantRequest, opts ...grpc.CallOption) (*QueryInferenceParticipantResponse, error)
 	// Queries a list of GetRandomExecutor items.
 	GetRandomExecutor(ctx context.Context, in *QueryGetRandomExecutorRequest, opts ...grpc.CallOption) (*QueryGetRandomExecutorResponse, error)
+	// Queries the executors a random executor would be selected from, with their weights
+	ExecutorCandidates(ctx context.Context, in *QueryExecutorCandidatesRequest, opts ...grpc.CallOption) (*QueryExecutorCandidatesResponse, error)
 	// Queries a list of EpochGroupData items.
 	EpochGroupData(ctx context.Context, in *QueryGetEpochGroupDataRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupDataResponse, error)
 	EpochGroupDataAll(ctx context.Context, in *QueryAllEpochGroupDataRequest, opts ...grpc.CallOption) (*QueryAllEpochGroupDataResponse, error)
 	// Queries a list of SettleAmount items.
 	SettleAmount(ctx context.Context, in *QueryGetSettleAmountRequest, opts ...grpc.CallOption) (*QueryGetSettleAmountResponse, error)
 	SettleAmountAll(ctx context.Context, in *QueryAllSettleAmountRequest, opts ...grpc.CallOption) (*QueryAllSettleAmountResponse, error)
+	// Queries the unclaimed settle amounts of a participant and when they expire
+	PendingClaims(ctx context.Context, in *QueryPendingClaimsRequest, opts ...grpc.CallOption) (*QueryPendingClaimsResponse, error)
 	// Queries a list of EpochGroupValidations items.
 	EpochGroupValidations(ctx context.Context, in *QueryGetEpochGroupValidationsRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupValidationsResponse, error)
 	EpochGroupValidationsAll(ctx context.Context, in *QueryAllEpochGroupValidationsRequest, opts ...grpc.CallOption) (*QueryAllEpochGroupValidationsResponse, error)
@@ -6817,10 +7815,18 @@ type QueryClient interface {
 	BridgeTransaction(ctx context.Context, in *QueryGetBridgeTransactionRequest, opts ...grpc.CallOption) (*QueryGetBridgeTransactionResponse, error)
 	// Queries all bridge transactions
 	BridgeTransactions(ctx context.Context, in *QueryAllBridgeTransactionsRequest, opts ...grpc.CallOption) (*QueryAllBridgeTransactionsResponse, error)
+	// Queries the attested receipts root of an origin chain block
+	BridgeBlockHeader(ctx context.Context, in *QueryGetBridgeBlockHeaderRequest, opts ...grpc.CallOption) (*QueryGetBridgeBlockHeaderResponse, error)
+	// Queries a bridge withdrawal with its signed payload
+	BridgeWithdrawal(ctx context.Context, in *QueryGetBridgeWithdrawalRequest, opts ...grpc.CallOption) (*QueryGetBridgeWithdrawalResponse, error)
 	// Queries a list of TrainingKvRecord items.
 	TrainingKvRecord(ctx context.Context, in *QueryTrainingKvRecordRequest, opts ...grpc.CallOption) (*QueryTrainingKvRecordResponse, error)
 	// Queries a list of ListTrainingKvRecordKeys items.
 	ListTrainingKvRecordKeys(ctx context.Context, in *QueryListTrainingKvRecordKeysRequest, opts ...grpc.CallOption) (*QueryListTrainingKvRecordKeysResponse, error)
+	// Queries the checkpoint of a training task produced at an outer step
+	TrainingCheckpoint(ctx context.Context, in *QueryTrainingCheckpointRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointResponse, error)
+	// Queries all checkpoints of a training task
+	TrainingCheckpoints(ctx context.Context, in *QueryTrainingCheckpointsRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointsResponse, error)
 	// Queries a list of TrainingBarrier items.
 	TrainingBarrier(ctx context.Context, in *QueryTrainingBarrierRequest, opts ...grpc.CallOption) (*QueryTrainingBarrierResponse, error)
 	// Queries a list of TrainingAliveNodes items.
@@ -6838,6 +7844,10 @@ type QueryClient interface {
 	GetAllModelCapacities(ctx context.Context, in *QueryGetAllModelCapacitiesRequest, opts ...grpc.CallOption) (*QueryGetAllModelCapacitiesResponse, error)
 	// Queries all authz grantees with specific message type for an account
 	GranteesByMessageType(ctx context.Context, in *QueryGranteesByMessageTypeRequest, opts ...grpc.CallOption) (*QueryGranteesByMessageTypeResponse, error)
+	// Queries a developer sub-key with its spending in the current epoch
+	DeveloperSubKey(ctx context.Context, in *QueryGetDeveloperSubKeyRequest, opts ...grpc.CallOption) (*QueryGetDeveloperSubKeyResponse, error)
+	// Queries all sub-keys of a developer
+	DeveloperSubKeys(ctx context.Context, in *QueryDeveloperSubKeysRequest, opts ...grpc.CallOption) (*QueryDeveloperSubKeysResponse, error)
 }
 
 type queryClient struct {
@@ -6911,6 +7921,15 @@ func (c *queryClient) GetRandomExecutor(ctx context.Context, in *QueryGetRandomE
 	return out, nil
 }
 
+func (c *queryClient) ExecutorCandidates(ctx context.Context, in *QueryExecutorCandidatesRequest, opts ...grpc.CallOption) (*QueryExecutorCandidatesResponse, error) {
+	out := new(QueryExecutorCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/ExecutorCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EpochGroupData(ctx context.Context, in *QueryGetEpochGroupDataRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupDataResponse, error) {
 	out := new(QueryGetEpochGroupDataResponse)
 	err := c.cc.Invoke(ctx, "/inference.inference.Query/EpochGroupData", in, out, opts...)
@@ -6947,6 +7966,15 @@ func (c *queryClient) SettleAmountAll(ctx context.Context, in *QueryAllSettleAmo
 	return out, nil
 }
 
+func (c *queryClient) PendingClaims(ctx context.Context, in *QueryPendingClaimsRequest, opts ...grpc.CallOption) (*QueryPendingClaimsResponse, error) {
+	out := new(QueryPendingClaimsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/PendingClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EpochGroupValidations(ctx context.Context, in *QueryGetEpochGroupValidationsRequest, opts ...grpc.CallOption) (*QueryGetEpochGroupValidationsResponse, error) {
 	out := new(QueryGetEpochGroupValidationsResponse)
 	err := c.cc.Invoke(ctx, "/inference.inference.Query/EpochGroupValidations", in, out, opts...)
@@ -7289,6 +8317,24 @@ func (c *queryClient) BridgeTransactions(ctx context.Context, in *QueryAllBridge
 	return out, nil
 }
 
+func (c *queryClient) BridgeBlockHeader(ctx context.Context, in *QueryGetBridgeBlockHeaderRequest, opts ...grpc.CallOption) (*QueryGetBridgeBlockHeaderResponse, error) {
+	out := new(QueryGetBridgeBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/BridgeBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeWithdrawal(ctx context.Context, in *QueryGetBridgeWithdrawalRequest, opts ...grpc.CallOption) (*QueryGetBridgeWithdrawalResponse, error) {
+	out := new(QueryGetBridgeWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/BridgeWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TrainingKvRecord(ctx context.Context, in *QueryTrainingKvRecordRequest, opts ...grpc.CallOption) (*QueryTrainingKvRecordResponse, error) {
 	out := new(QueryTrainingKvRecordResponse)
 	err := c.cc.Invoke(ctx, "/inference.inference.Query/TrainingKvRecord", in, out, opts...)
@@ -7307,6 +8353,24 @@ func (c *queryClient) ListTrainingKvRecordKeys(ctx context.Context, in *QueryLis
 	return out, nil
 }
 
+func (c *queryClient) TrainingCheckpoint(ctx context.Context, in *QueryTrainingCheckpointRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointResponse, error) {
+	out := new(QueryTrainingCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/TrainingCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrainingCheckpoints(ctx context.Context, in *QueryTrainingCheckpointsRequest, opts ...grpc.CallOption) (*QueryTrainingCheckpointsResponse, error) {
+	out := new(QueryTrainingCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/TrainingCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TrainingBarrier(ctx context.Context, in *QueryTrainingBarrierRequest, opts ...grpc.CallOption) (*QueryTrainingBarrierResponse, error) {
 	out := new(QueryTrainingBarrierResponse)
 	err := c.cc.Invoke(ctx, "/inference.inference.Query/TrainingBarrier", in, out, opts...)
@@ -7397,26 +8461,48 @@ func (c *queryClient) GranteesByMessageType(ctx context.Context, in *QueryGrante
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Inference items.
-	Inference(context.Context, *QueryGetInferenceRequest) (*QueryGetInferenceResponse, error)
-	InferenceAll(context.Context, *QueryAllInferenceRequest) (*QueryAllInferenceResponse, error)
-	// Queries a list of Participant items.
-	Participant(context.Context, *QueryGetParticipantRequest) (*QueryGetParticipantResponse, error)
-	ParticipantAll(context.Context, *QueryAllParticipantRequest) (*QueryAllParticipantResponse, error)
-	// Queries a list of InferenceParticipant items.
+func (c *queryClient) DeveloperSubKey(ctx context.Context, in *QueryGetDeveloperSubKeyRequest, opts ...grpc.CallOption) (*QueryGetDeveloperSubKeyResponse, error) {
+	out := new(QueryGetDeveloperSubKeyResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/DeveloperSubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperSubKeys(ctx context.Context, in *QueryDeveloperSubKeysRequest, opts ...grpc.CallOption) (*QueryDeveloperSubKeysResponse, error) {
+	out := new(QueryDeveloperSubKeysResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/DeveloperSubKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Inference items.
+	Inference(context.Context, *QueryGetInferenceRequest) (*QueryGetInferenceResponse, error)
+	InferenceAll(context.Context, *QueryAllInferenceRequest) (*QueryAllInferenceResponse, error)
+	// Queries a list of Participant items.
+	Participant(context.Context, *QueryGetParticipantRequest) (*QueryGetParticipantResponse, error)
+	ParticipantAll(context.Context, *QueryAllParticipantRequest) (*QueryAllParticipantResponse, error)
+	// Queries a list of InferenceParticipant items.
 	InferenceParticipant(context.Context, *QueryInferenceParticipantRequest) (*QueryInferenceParticipantResponse, error)
 	// Queries a list of GetRandomExecutor items.
 	GetRandomExecutor(context.Context, *QueryGetRandomExecutorRequest) (*QueryGetRandomExecutorResponse, error)
+	// Queries the executors a random executor would be selected from, with their weights
+	ExecutorCandidates(context.Context, *QueryExecutorCandidatesRequest) (*QueryExecutorCandidatesResponse, error)
 	// Queries a list of EpochGroupData items.
 	EpochGroupData(context.Context, *QueryGetEpochGroupDataRequest) (*QueryGetEpochGroupDataResponse, error)
 	EpochGroupDataAll(context.Context, *QueryAllEpochGroupDataRequest) (*QueryAllEpochGroupDataResponse, error)
 	// Queries a list of SettleAmount items.
 	SettleAmount(context.Context, *QueryGetSettleAmountRequest) (*QueryGetSettleAmountResponse, error)
 	SettleAmountAll(context.Context, *QueryAllSettleAmountRequest) (*QueryAllSettleAmountResponse, error)
+	// Queries the unclaimed settle amounts of a participant and when they expire
+	PendingClaims(context.Context, *QueryPendingClaimsRequest) (*QueryPendingClaimsResponse, error)
 	// Queries a list of EpochGroupValidations items.
 	EpochGroupValidations(context.Context, *QueryGetEpochGroupValidationsRequest) (*QueryGetEpochGroupValidationsResponse, error)
 	EpochGroupValidationsAll(context.Context, *QueryAllEpochGroupValidationsRequest) (*QueryAllEpochGroupValidationsResponse, error)
@@ -7480,10 +8566,18 @@ type QueryServer interface {
 	BridgeTransaction(context.Context, *QueryGetBridgeTransactionRequest) (*QueryGetBridgeTransactionResponse, error)
 	// Queries all bridge transactions
 	BridgeTransactions(context.Context, *QueryAllBridgeTransactionsRequest) (*QueryAllBridgeTransactionsResponse, error)
+	// Queries the attested receipts root of an origin chain block
+	BridgeBlockHeader(context.Context, *QueryGetBridgeBlockHeaderRequest) (*QueryGetBridgeBlockHeaderResponse, error)
+	// Queries a bridge withdrawal with its signed payload
+	BridgeWithdrawal(context.Context, *QueryGetBridgeWithdrawalRequest) (*QueryGetBridgeWithdrawalResponse, error)
 	// Queries a list of TrainingKvRecord items.
 	TrainingKvRecord(context.Context, *QueryTrainingKvRecordRequest) (*QueryTrainingKvRecordResponse, error)
 	// Queries a list of ListTrainingKvRecordKeys items.
 	ListTrainingKvRecordKeys(context.Context, *QueryListTrainingKvRecordKeysRequest) (*QueryListTrainingKvRecordKeysResponse, error)
+	// Queries the checkpoint of a training task produced at an outer step
+	TrainingCheckpoint(context.Context, *QueryTrainingCheckpointRequest) (*QueryTrainingCheckpointResponse, error)
+	// Queries all checkpoints of a training task
+	TrainingCheckpoints(context.Context, *QueryTrainingCheckpointsRequest) (*QueryTrainingCheckpointsResponse, error)
 	// Queries a list of TrainingBarrier items.
 	TrainingBarrier(context.Context, *QueryTrainingBarrierRequest) (*QueryTrainingBarrierResponse, error)
 	// Queries a list of TrainingAliveNodes items.
@@ -7501,6 +8595,10 @@ type QueryServer interface {
 	GetAllModelCapacities(context.Context, *QueryGetAllModelCapacitiesRequest) (*QueryGetAllModelCapacitiesResponse, error)
 	// Queries all authz grantees with specific message type for an account
 	GranteesByMessageType(context.Context, *QueryGranteesByMessageTypeRequest) (*QueryGranteesByMessageTypeResponse, error)
+	// Queries a developer sub-key with its spending in the current epoch
+	DeveloperSubKey(context.Context, *QueryGetDeveloperSubKeyRequest) (*QueryGetDeveloperSubKeyResponse, error)
+	// Queries all sub-keys of a developer
+	DeveloperSubKeys(context.Context, *QueryDeveloperSubKeysRequest) (*QueryDeveloperSubKeysResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -7528,6 +8626,9 @@ func (*UnimplementedQueryServer) InferenceParticipant(ctx context.Context, req *
 func (*UnimplementedQueryServer) GetRandomExecutor(ctx context.Context, req *QueryGetRandomExecutorRequest) (*QueryGetRandomExecutorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRandomExecutor not implemented")
 }
+func (*UnimplementedQueryServer) ExecutorCandidates(ctx context.Context, req *QueryExecutorCandidatesRequest) (*QueryExecutorCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutorCandidates not implemented")
+}
 func (*UnimplementedQueryServer) EpochGroupData(ctx context.Context, req *QueryGetEpochGroupDataRequest) (*QueryGetEpochGroupDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochGroupData not implemented")
 }
@@ -7540,6 +8641,9 @@ func (*UnimplementedQueryServer) SettleAmount(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) SettleAmountAll(ctx context.Context, req *QueryAllSettleAmountRequest) (*QueryAllSettleAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SettleAmountAll not implemented")
 }
+func (*UnimplementedQueryServer) PendingClaims(ctx context.Context, req *QueryPendingClaimsRequest) (*QueryPendingClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingClaims not implemented")
+}
 func (*UnimplementedQueryServer) EpochGroupValidations(ctx context.Context, req *QueryGetEpochGroupValidationsRequest) (*QueryGetEpochGroupValidationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochGroupValidations not implemented")
 }
@@ -7654,12 +8758,24 @@ func (*UnimplementedQueryServer) BridgeTransaction(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) BridgeTransactions(ctx context.Context, req *QueryAllBridgeTransactionsRequest) (*QueryAllBridgeTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeTransactions not implemented")
 }
+func (*UnimplementedQueryServer) BridgeBlockHeader(ctx context.Context, req *QueryGetBridgeBlockHeaderRequest) (*QueryGetBridgeBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeBlockHeader not implemented")
+}
+func (*UnimplementedQueryServer) BridgeWithdrawal(ctx context.Context, req *QueryGetBridgeWithdrawalRequest) (*QueryGetBridgeWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeWithdrawal not implemented")
+}
 func (*UnimplementedQueryServer) TrainingKvRecord(ctx context.Context, req *QueryTrainingKvRecordRequest) (*QueryTrainingKvRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrainingKvRecord not implemented")
 }
 func (*UnimplementedQueryServer) ListTrainingKvRecordKeys(ctx context.Context, req *QueryListTrainingKvRecordKeysRequest) (*QueryListTrainingKvRecordKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTrainingKvRecordKeys not implemented")
 }
+func (*UnimplementedQueryServer) TrainingCheckpoint(ctx context.Context, req *QueryTrainingCheckpointRequest) (*QueryTrainingCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) TrainingCheckpoints(ctx context.Context, req *QueryTrainingCheckpointsRequest) (*QueryTrainingCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingCheckpoints not implemented")
+}
 func (*UnimplementedQueryServer) TrainingBarrier(ctx context.Context, req *QueryTrainingBarrierRequest) (*QueryTrainingBarrierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrainingBarrier not implemented")
 }
@@ -7690,6 +8806,12 @@ func (*UnimplementedQueryServer) GetAllModelCapacities(ctx context.Context, req
 func (*UnimplementedQueryServer) GranteesByMessageType(ctx context.Context, req *QueryGranteesByMessageTypeRequest) (*QueryGranteesByMessageTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteesByMessageType not implemented")
 }
+func (*UnimplementedQueryServer) DeveloperSubKey(ctx context.Context, req *QueryGetDeveloperSubKeyRequest) (*QueryGetDeveloperSubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperSubKey not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperSubKeys(ctx context.Context, req *QueryDeveloperSubKeysRequest) (*QueryDeveloperSubKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperSubKeys not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -7821,6 +8943,24 @@ func _Query_GetRandomExecutor_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExecutorCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutorCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/ExecutorCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutorCandidates(ctx, req.(*QueryExecutorCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EpochGroupData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetEpochGroupDataRequest)
 	if err := dec(in); err != nil {
@@ -7893,6 +9033,24 @@ func _Query_SettleAmountAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/PendingClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingClaims(ctx, req.(*QueryPendingClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EpochGroupValidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetEpochGroupValidationsRequest)
 	if err := dec(in); err != nil {
@@ -8577,6 +9735,42 @@ func _Query_BridgeTransactions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BridgeBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBridgeBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/BridgeBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeBlockHeader(ctx, req.(*QueryGetBridgeBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBridgeWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/BridgeWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeWithdrawal(ctx, req.(*QueryGetBridgeWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTrainingKvRecordRequest)
 	if err := dec(in); err != nil {
@@ -8613,6 +9807,42 @@ func _Query_ListTrainingKvRecordKeys_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TrainingCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrainingCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrainingCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/TrainingCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrainingCheckpoint(ctx, req.(*QueryTrainingCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrainingCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrainingCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrainingCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/TrainingCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrainingCheckpoints(ctx, req.(*QueryTrainingCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TrainingBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTrainingBarrierRequest)
 	if err := dec(in); err != nil {
@@ -8793,6 +10023,42 @@ func _Query_GranteesByMessageType_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeveloperSubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDeveloperSubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperSubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/DeveloperSubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperSubKey(ctx, req.(*QueryGetDeveloperSubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperSubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperSubKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperSubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/DeveloperSubKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperSubKeys(ctx, req.(*QueryDeveloperSubKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.inference.Query",
@@ -8826,6 +10092,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRandomExecutor",
 			Handler:    _Query_GetRandomExecutor_Handler,
 		},
+		{
+			MethodName: "ExecutorCandidates",
+			Handler:    _Query_ExecutorCandidates_Handler,
+		},
 		{
 			MethodName: "EpochGroupData",
 			Handler:    _Query_EpochGroupData_Handler,
@@ -8842,6 +10112,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SettleAmountAll",
 			Handler:    _Query_SettleAmountAll_Handler,
 		},
+		{
+			MethodName: "PendingClaims",
+			Handler:    _Query_PendingClaims_Handler,
+		},
 		{
 			MethodName: "EpochGroupValidations",
 			Handler:    _Query_EpochGroupValidations_Handler,
@@ -8994,6 +10268,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeTransactions",
 			Handler:    _Query_BridgeTransactions_Handler,
 		},
+		{
+			MethodName: "BridgeBlockHeader",
+			Handler:    _Query_BridgeBlockHeader_Handler,
+		},
+		{
+			MethodName: "BridgeWithdrawal",
+			Handler:    _Query_BridgeWithdrawal_Handler,
+		},
 		{
 			MethodName: "TrainingKvRecord",
 			Handler:    _Query_TrainingKvRecord_Handler,
@@ -9002,6 +10284,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTrainingKvRecordKeys",
 			Handler:    _Query_ListTrainingKvRecordKeys_Handler,
 		},
+		{
+			MethodName: "TrainingCheckpoint",
+			Handler:    _Query_TrainingCheckpoint_Handler,
+		},
+		{
+			MethodName: "TrainingCheckpoints",
+			Handler:    _Query_TrainingCheckpoints_Handler,
+		},
 		{
 			MethodName: "TrainingBarrier",
 			Handler:    _Query_TrainingBarrier_Handler,
@@ -9042,6 +10332,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteesByMessageType",
 			Handler:    _Query_GranteesByMessageType_Handler,
 		},
+		{
+			MethodName: "DeveloperSubKey",
+			Handler:    _Query_DeveloperSubKey_Handler,
+		},
+		{
+			MethodName: "DeveloperSubKeys",
+			Handler:    _Query_DeveloperSubKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/query.proto",
@@ -9487,6 +10785,22 @@ func (m *QueryGetRandomExecutorRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Exclude) > 0 {
+		for iNdEx := len(m.Exclude) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exclude[iNdEx])
+			copy(dAtA[i:], m.Exclude[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Exclude[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Model) > 0 {
 		i -= len(m.Model)
 		copy(dAtA[i:], m.Model)
@@ -9530,7 +10844,7 @@ func (m *QueryGetRandomExecutorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEpochGroupDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryExecutorCandidatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9540,32 +10854,43 @@ func (m *QueryGetEpochGroupDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetEpochGroupDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExecutorCandidatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEpochGroupDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExecutorCandidatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ModelId) > 0 {
-		i -= len(m.ModelId)
-		copy(dAtA[i:], m.ModelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModelId)))
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Region)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochIndex))
+	if len(m.Exclude) > 0 {
+		for iNdEx := len(m.Exclude) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exclude[iNdEx])
+			copy(dAtA[i:], m.Exclude[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Exclude[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Model)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEpochGroupDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExecutorCandidate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9575,18 +10900,23 @@ func (m *QueryGetEpochGroupDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetEpochGroupDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutorCandidate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEpochGroupDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecutorCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
-		size, err := m.EpochGroupData.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -9598,7 +10928,7 @@ func (m *QueryGetEpochGroupDataResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllEpochGroupDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryExecutorCandidatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9608,32 +10938,34 @@ func (m *QueryAllEpochGroupDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllEpochGroupDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExecutorCandidatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllEpochGroupDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExecutorCandidatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllEpochGroupDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetEpochGroupDataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9643,46 +10975,32 @@ func (m *QueryAllEpochGroupDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllEpochGroupDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetEpochGroupDataRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllEpochGroupDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetEpochGroupDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModelId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.EpochGroupData) > 0 {
-		for iNdEx := len(m.EpochGroupData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochGroupData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.EpochIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetSettleAmountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetEpochGroupDataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9692,21 +11010,138 @@ func (m *QueryGetSettleAmountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetSettleAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetEpochGroupDataResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetSettleAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetEpochGroupDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
-		i--
+	{
+		size, err := m.EpochGroupData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochGroupDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochGroupDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochGroupDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochGroupDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochGroupDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochGroupDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochGroupData) > 0 {
+		for iNdEx := len(m.EpochGroupData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochGroupData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettleAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettleAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettleAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -9829,6 +11264,126 @@ func (m *QueryAllSettleAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiring {
+		i--
+		if m.Expiring {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiresAtEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAtEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SettleAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpochIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetEpochGroupValidationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12684,7 +14239,7 @@ func (m *QueryListTrainingKvRecordKeysResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTrainingBarrierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTrainingCheckpointRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12694,32 +14249,30 @@ func (m *QueryTrainingBarrierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTrainingBarrierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTrainingCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTrainingBarrierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTrainingCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.OuterStep != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OuterStep))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTrainingBarrierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTrainingCheckpointResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12729,19 +14282,19 @@ func (m *QueryTrainingBarrierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTrainingBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTrainingCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTrainingBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTrainingCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.Checkpoint != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -12749,12 +14302,12 @@ func (m *QueryTrainingBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTrainingAliveNodesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTrainingCheckpointsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12764,19 +14317,154 @@ func (m *QueryTrainingAliveNodesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTrainingAliveNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTrainingCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTrainingAliveNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTrainingCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+	if m.TaskId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrainingCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrainingCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrainingCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrainingBarrierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrainingBarrierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrainingBarrierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrainingBarrierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrainingBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrainingBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrainingAliveNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrainingAliveNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrainingAliveNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -12985,7 +14673,7 @@ func (m *QueryAllBridgeTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetBridgeBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12995,20 +14683,34 @@ func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginChain) > 0 {
+		i -= len(m.OriginChain)
+		copy(dAtA[i:], m.OriginChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginChain)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetBridgeBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13018,28 +14720,18 @@ func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.LatestEpoch.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -13047,16 +14739,11 @@ func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCountPoCbatchesAtHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetBridgeWithdrawalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13066,25 +14753,25 @@ func (m *QueryCountPoCbatchesAtHeightRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryCountPoCbatchesAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCountPoCbatchesAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCountPoCbatchesAtHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13094,25 +14781,58 @@ func (m *QueryCountPoCbatchesAtHeightResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryCountPoCbatchesAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCountPoCbatchesAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetBridgeWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
+	}
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EncodedData) > 0 {
+		i -= len(m.EncodedData)
+		copy(dAtA[i:], m.EncodedData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncodedData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SigningStatus) > 0 {
+		i -= len(m.SigningStatus)
+		copy(dAtA[i:], m.SigningStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigningStatus)))
+		i--
+		dAtA[i] = 0x12
 	}
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCountPoCvalidationsAtHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13122,25 +14842,20 @@ func (m *QueryCountPoCvalidationsAtHeightRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryCountPoCvalidationsAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCountPoCvalidationsAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCountPoCvalidationsAtHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13150,25 +14865,45 @@ func (m *QueryCountPoCvalidationsAtHeightResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryCountPoCvalidationsAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCountPoCvalidationsAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+	{
+		size, err := m.LatestEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetModelPerTokenPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCountPoCbatchesAtHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13178,22 +14913,134 @@ func (m *QueryGetModelPerTokenPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetModelPerTokenPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCountPoCbatchesAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetModelPerTokenPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCountPoCbatchesAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ModelId) > 0 {
-		i -= len(m.ModelId)
-		copy(dAtA[i:], m.ModelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModelId)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCountPoCbatchesAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCountPoCbatchesAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCountPoCbatchesAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCountPoCvalidationsAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCountPoCvalidationsAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCountPoCvalidationsAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCountPoCvalidationsAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCountPoCvalidationsAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCountPoCvalidationsAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetModelPerTokenPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetModelPerTokenPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetModelPerTokenPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModelId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -13605,6 +15452,148 @@ func (m *QueryGranteesByMessageTypeResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetDeveloperSubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDeveloperSubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDeveloperSubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDeveloperSubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDeveloperSubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDeveloperSubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpentThisEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpentThisEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperSubKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperSubKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperSubKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperSubKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperSubKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperSubKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubKeys) > 0 {
+		for iNdEx := len(m.SubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -13790,6 +15779,16 @@ func (m *QueryGetRandomExecutorRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Exclude) > 0 {
+		for _, s := range m.Exclude {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -13804,31 +15803,83 @@ func (m *QueryGetRandomExecutorResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetEpochGroupDataRequest) Size() (n int) {
+func (m *QueryExecutorCandidatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EpochIndex != 0 {
-		n += 1 + sovQuery(uint64(m.EpochIndex))
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.ModelId)
+	if len(m.Exclude) > 0 {
+		for _, s := range m.Exclude {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Region)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetEpochGroupDataResponse) Size() (n int) {
+func (m *ExecutorCandidate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.EpochGroupData.Size()
+	l = m.Executor.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Weight != 0 {
+		n += 1 + sovQuery(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *QueryExecutorCandidatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetEpochGroupDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovQuery(uint64(m.EpochIndex))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochGroupDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochGroupData.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryAllEpochGroupDataRequest) Size() (n int) {
@@ -13919,6 +15970,54 @@ func (m *QueryAllSettleAmountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SettleAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ExpiresAtEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAtEpoch))
+	}
+	if m.Expiring {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPendingClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentEpochIndex != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochIndex))
+	}
+	return n
+}
+
 func (m *QueryGetEpochGroupValidationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15092,6 +17191,61 @@ func (m *QueryListTrainingKvRecordKeysResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTrainingCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovQuery(uint64(m.TaskId))
+	}
+	if m.OuterStep != 0 {
+		n += 1 + sovQuery(uint64(m.OuterStep))
+	}
+	return n
+}
+
+func (m *QueryTrainingCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrainingCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovQuery(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *QueryTrainingCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryTrainingBarrierRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15208,6 +17362,73 @@ func (m *QueryAllBridgeTransactionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetBridgeBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBridgeBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBridgeWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetBridgeWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.SigningStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EncodedData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryEpochInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15466,6 +17687,65 @@ func (m *QueryGranteesByMessageTypeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetDeveloperSubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDeveloperSubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SpentThisEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.SpentThisEpoch))
+	}
+	return n
+}
+
+func (m *QueryDeveloperSubKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperSubKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubKeys) > 0 {
+		for _, e := range m.SubKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -16610,16 +18890,80 @@ func (m *QueryGetRandomExecutorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Model = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -16714,7 +19058,7 @@ func (m *QueryGetRandomExecutorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExecutorCandidatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16737,17 +19081,17 @@ func (m *QueryGetEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochGroupDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExecutorCandidatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExecutorCandidatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
 			}
-			m.EpochIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16757,14 +19101,27 @@ func (m *QueryGetEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16792,7 +19149,39 @@ func (m *QueryGetEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelId = string(dAtA[iNdEx:postIndex])
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16815,7 +19204,7 @@ func (m *QueryGetEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutorCandidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16838,15 +19227,15 @@ func (m *QueryGetEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochGroupDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorCandidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16873,10 +19262,29 @@ func (m *QueryGetEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochGroupData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -16898,7 +19306,7 @@ func (m *QueryGetEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExecutorCandidatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16921,15 +19329,15 @@ func (m *QueryAllEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochGroupDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExecutorCandidatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExecutorCandidatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16956,10 +19364,8 @@ func (m *QueryAllEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candidates = append(m.Candidates, ExecutorCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16984,7 +19390,7 @@ func (m *QueryAllEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17007,17 +19413,17 @@ func (m *QueryAllEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochGroupDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochGroupDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var msglen int
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -17027,31 +19433,16 @@ func (m *QueryAllEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EpochGroupData = append(m.EpochGroupData, EpochGroupData{})
-			if err := m.EpochGroupData[len(m.EpochGroupData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -17061,27 +19452,23 @@ func (m *QueryAllEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17104,7 +19491,7 @@ func (m *QueryAllEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSettleAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17127,17 +19514,17 @@ func (m *QueryGetSettleAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSettleAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochGroupDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSettleAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -17147,23 +19534,24 @@ func (m *QueryGetSettleAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			if err := m.EpochGroupData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17186,7 +19574,7 @@ func (m *QueryGetSettleAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSettleAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17209,15 +19597,15 @@ func (m *QueryGetSettleAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSettleAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochGroupDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSettleAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettleAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17244,7 +19632,10 @@ func (m *QueryGetSettleAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SettleAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17269,7 +19660,7 @@ func (m *QueryGetSettleAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSettleAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17292,15 +19683,15 @@ func (m *QueryAllSettleAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSettleAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochGroupDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSettleAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17327,100 +19718,14 @@ func (m *QueryAllSettleAmountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochGroupData = append(m.EpochGroupData, EpochGroupData{})
+			if err := m.EpochGroupData[len(m.EpochGroupData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllSettleAmountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSettleAmountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSettleAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettleAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SettleAmount = append(m.SettleAmount, SettleAmount{})
-			if err := m.SettleAmount[len(m.SettleAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17475,7 +19780,7 @@ func (m *QueryAllSettleAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSettleAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17498,10 +19803,10 @@ func (m *QueryGetEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochGroupValidationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSettleAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochGroupValidationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSettleAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17536,25 +19841,6 @@ func (m *QueryGetEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
-			}
-			m.EpochIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -17576,7 +19862,7 @@ func (m *QueryGetEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSettleAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17599,15 +19885,15 @@ func (m *QueryGetEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochGroupValidationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSettleAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochGroupValidationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSettleAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupValidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17634,7 +19920,7 @@ func (m *QueryGetEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochGroupValidations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettleAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17659,7 +19945,7 @@ func (m *QueryGetEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSettleAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17682,10 +19968,10 @@ func (m *QueryAllEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochGroupValidationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSettleAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochGroupValidationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSettleAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17745,7 +20031,7 @@ func (m *QueryAllEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSettleAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17768,15 +20054,15 @@ func (m *QueryAllEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochGroupValidationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSettleAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochGroupValidationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSettleAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupValidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17803,8 +20089,8 @@ func (m *QueryAllEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochGroupValidations = append(m.EpochGroupValidations, EpochGroupValidations{})
-			if err := m.EpochGroupValidations[len(m.EpochGroupValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SettleAmount = append(m.SettleAmount, SettleAmount{})
+			if err := m.SettleAmount[len(m.SettleAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17865,7 +20151,7 @@ func (m *QueryAllEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPocBatchesForStageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingClaimsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17888,17 +20174,17 @@ func (m *QueryPocBatchesForStageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPocBatchesForStageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingClaimsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPocBatchesForStageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -17908,11 +20194,24 @@ func (m *QueryPocBatchesForStageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -17934,7 +20233,7 @@ func (m *QueryPocBatchesForStageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPocBatchesForStageResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17957,15 +20256,15 @@ func (m *QueryPocBatchesForStageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPocBatchesForStageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPocBatchesForStageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17992,11 +20291,49 @@ func (m *QueryPocBatchesForStageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PocBatch = append(m.PocBatch, PoCBatchesWithParticipants{})
-			if err := m.PocBatch[len(m.PocBatch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettleAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtEpoch", wireType)
+			}
+			m.ExpiresAtEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiring", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expiring = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18018,7 +20355,7 @@ func (m *QueryPocBatchesForStageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingClaimsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18041,17 +20378,17 @@ func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoCBatchesWithParticipants: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingClaimsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoCBatchesWithParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -18061,29 +20398,31 @@ func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.Claims = append(m.Claims, PendingClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.CurrentEpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -18093,38 +20432,75 @@ func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentEpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HexPubKey", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochGroupValidationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochGroupValidationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
 				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
@@ -18141,13 +20517,13 @@ func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HexPubKey = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBatch", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var msglen int
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -18157,26 +20533,11 @@ func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PocBatch = append(m.PocBatch, PoCBatch{})
-			if err := m.PocBatch[len(m.PocBatch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18198,7 +20559,7 @@ func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18221,12 +20582,45 @@ func (m *QueryGetCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochGroupValidationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochGroupValidationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupValidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochGroupValidations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18248,7 +20642,7 @@ func (m *QueryGetCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochGroupValidationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18271,17 +20665,17 @@ func (m *QueryGetCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochGroupValidationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochGroupValidationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -18291,61 +20685,28 @@ func (m *QueryGetCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetTokenomicsDataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenomicsDataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenomicsDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18367,7 +20728,7 @@ func (m *QueryGetTokenomicsDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenomicsDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochGroupValidationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18390,15 +20751,15 @@ func (m *QueryGetTokenomicsDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenomicsDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochGroupValidationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenomicsDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochGroupValidationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenomicsData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupValidations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18425,7 +20786,44 @@ func (m *QueryGetTokenomicsDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenomicsData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochGroupValidations = append(m.EpochGroupValidations, EpochGroupValidations{})
+			if err := m.EpochGroupValidations[len(m.EpochGroupValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18450,7 +20848,7 @@ func (m *QueryGetTokenomicsDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUnitOfComputePriceProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPocBatchesForStageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18473,17 +20871,17 @@ func (m *QueryGetUnitOfComputePriceProposalRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPocBatchesForStageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPocBatchesForStageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -18493,24 +20891,11 @@ func (m *QueryGetUnitOfComputePriceProposalRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18532,7 +20917,7 @@ func (m *QueryGetUnitOfComputePriceProposalRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPocBatchesForStageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18555,15 +20940,15 @@ func (m *QueryGetUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPocBatchesForStageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPocBatchesForStageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18590,32 +20975,11 @@ func (m *QueryGetUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &UnitOfComputePriceProposal{}
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PocBatch = append(m.PocBatch, PoCBatchesWithParticipants{})
+			if err := m.PocBatch[len(m.PocBatch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
-			}
-			m.Default = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Default |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18637,7 +21001,7 @@ func (m *QueryGetUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryCurrentEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
+func (m *PoCBatchesWithParticipants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18660,65 +21024,111 @@ func (m *QueryCurrentEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochGroupDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoCBatchesWithParticipants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoCBatchesWithParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCurrentEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HexPubKey", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochGroupDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HexPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18745,7 +21155,8 @@ func (m *QueryCurrentEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochGroupData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PocBatch = append(m.PocBatch, PoCBatch{})
+			if err := m.PocBatch[len(m.PocBatch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18770,7 +21181,7 @@ func (m *QueryCurrentEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPreviousEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18793,10 +21204,10 @@ func (m *QueryPreviousEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPreviousEpochGroupDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCurrentEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPreviousEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18820,7 +21231,7 @@ func (m *QueryPreviousEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPreviousEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18843,17 +21254,17 @@ func (m *QueryPreviousEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPreviousEpochGroupDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCurrentEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPreviousEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -18863,25 +21274,11 @@ func (m *QueryPreviousEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EpochGroupData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18903,7 +21300,7 @@ func (m *QueryPreviousEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModelsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTokenomicsDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18926,10 +21323,10 @@ func (m *QueryModelsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModelsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenomicsDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModelsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenomicsDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18953,7 +21350,7 @@ func (m *QueryModelsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModelsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTokenomicsDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18976,15 +21373,15 @@ func (m *QueryModelsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModelsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenomicsDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModelsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenomicsDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenomicsData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19011,8 +21408,7 @@ func (m *QueryModelsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Model = append(m.Model, Model{})
-			if err := m.Model[len(m.Model)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenomicsData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19037,7 +21433,7 @@ func (m *QueryModelsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTopMinerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetUnitOfComputePriceProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19060,15 +21456,15 @@ func (m *QueryGetTopMinerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTopMinerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTopMinerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19096,7 +21492,7 @@ func (m *QueryGetTopMinerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19119,7 +21515,7 @@ func (m *QueryGetTopMinerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTopMinerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19142,15 +21538,15 @@ func (m *QueryGetTopMinerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTopMinerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTopMinerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopMiner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19177,10 +21573,32 @@ func (m *QueryGetTopMinerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TopMiner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proposal == nil {
+				m.Proposal = &UnitOfComputePriceProposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			m.Default = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Default |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -19202,7 +21620,7 @@ func (m *QueryGetTopMinerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTopMinerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19225,15 +21643,65 @@ func (m *QueryAllTopMinerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTopMinerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochGroupDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTopMinerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochGroupDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19260,10 +21728,7 @@ func (m *QueryAllTopMinerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochGroupData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19288,7 +21753,7 @@ func (m *QueryAllTopMinerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTopMinerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPreviousEpochGroupDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19311,49 +21776,65 @@ func (m *QueryAllTopMinerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTopMinerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreviousEpochGroupDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTopMinerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreviousEpochGroupDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopMiner", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousEpochGroupDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopMiner = append(m.TopMiner, TopMiner{})
-			if err := m.TopMiner[len(m.TopMiner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousEpochGroupDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousEpochGroupDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGroupData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19380,10 +21861,7 @@ func (m *QueryAllTopMinerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochGroupData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19408,7 +21886,7 @@ func (m *QueryAllTopMinerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModelsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19431,63 +21909,12 @@ func (m *QueryGetInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInferenceTimeoutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModelsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInferenceTimeoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModelsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
-			}
-			m.ExpirationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -19509,7 +21936,7 @@ func (m *QueryGetInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModelsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19532,15 +21959,15 @@ func (m *QueryGetInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInferenceTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModelsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInferenceTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModelsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19567,7 +21994,8 @@ func (m *QueryGetInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InferenceTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Model = append(m.Model, Model{})
+			if err := m.Model[len(m.Model)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19592,7 +22020,7 @@ func (m *QueryGetInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTopMinerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19615,17 +22043,17 @@ func (m *QueryAllInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInferenceTimeoutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTopMinerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInferenceTimeoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTopMinerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -19635,27 +22063,23 @@ func (m *QueryAllInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19678,7 +22102,7 @@ func (m *QueryAllInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTopMinerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19701,15 +22125,15 @@ func (m *QueryAllInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInferenceTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTopMinerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInferenceTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTopMinerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopMiner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19736,17 +22160,186 @@ func (m *QueryAllInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceTimeout = append(m.InferenceTimeout, InferenceTimeout{})
-			if err := m.InferenceTimeout[len(m.InferenceTimeout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopMiner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTopMinerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTopMinerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTopMinerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTopMinerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTopMinerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTopMinerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopMiner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopMiner = append(m.TopMiner, TopMiner{})
+			if err := m.TopMiner[len(m.TopMiner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
@@ -19798,7 +22391,7 @@ func (m *QueryAllInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19821,17 +22414,17 @@ func (m *QueryGetInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInferenceTimeoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInferenceTimeoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
-			m.EpochId = 0
+			m.ExpirationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -19841,7 +22434,7 @@ func (m *QueryGetInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19899,7 +22492,7 @@ func (m *QueryGetInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19922,15 +22515,15 @@ func (m *QueryGetInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInferenceTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInferenceTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceValidationDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19957,7 +22550,7 @@ func (m *QueryGetInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InferenceValidationDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InferenceTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19982,7 +22575,7 @@ func (m *QueryGetInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryAllInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInferenceTimeoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20005,10 +22598,10 @@ func (m *QueryAllInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInferenceTimeoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInferenceTimeoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20068,7 +22661,7 @@ func (m *QueryAllInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInferenceTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20091,15 +22684,15 @@ func (m *QueryAllInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInferenceTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInferenceTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceValidationDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20126,8 +22719,8 @@ func (m *QueryAllInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceValidationDetails = append(m.InferenceValidationDetails, InferenceValidationDetails{})
-			if err := m.InferenceValidationDetails[len(m.InferenceValidationDetails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InferenceTimeout = append(m.InferenceTimeout, InferenceTimeout{})
+			if err := m.InferenceTimeout[len(m.InferenceTimeout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20188,7 +22781,7 @@ func (m *QueryAllInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetInferenceValidationParametersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20211,17 +22804,17 @@ func (m *QueryGetInferenceValidationParametersRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationParametersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationParametersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			var stringLen uint64
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -20231,27 +22824,14 @@ func (m *QueryGetInferenceValidationParametersRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20279,7 +22859,7 @@ func (m *QueryGetInferenceValidationParametersRequest) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20302,7 +22882,7 @@ func (m *QueryGetInferenceValidationParametersRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryGetInferenceValidationParametersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20325,17 +22905,17 @@ func (m *QueryGetInferenceValidationParametersResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationParametersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInferenceValidationParametersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInferenceValidationDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceValidationDetails", wireType)
 			}
-			m.ValidatorPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -20345,79 +22925,7 @@ func (m *QueryGetInferenceValidationParametersResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPower |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
-			}
-			m.CurrentHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Details = append(m.Details, &InferenceValidationDetails{})
-			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20432,10 +22940,7 @@ func (m *QueryGetInferenceValidationParametersResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parameters == nil {
-				m.Parameters = &ValidationParams{}
-			}
-			if err := m.Parameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InferenceValidationDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20460,7 +22965,7 @@ func (m *QueryGetInferenceValidationParametersResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryGetEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInferenceValidationDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20483,36 +22988,17 @@ func (m *QueryGetEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
-			}
-			m.EpochIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -20522,23 +23008,27 @@ func (m *QueryGetEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20561,7 +23051,7 @@ func (m *QueryGetEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInferenceValidationDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20584,15 +23074,15 @@ func (m *QueryGetEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInferenceValidationDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochPerformanceSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceValidationDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20619,61 +23109,12 @@ func (m *QueryGetEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochPerformanceSummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InferenceValidationDetails = append(m.InferenceValidationDetails, InferenceValidationDetails{})
+			if err := m.InferenceValidationDetails[len(m.InferenceValidationDetails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -20703,7 +23144,7 @@ func (m *QueryAllEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -20730,7 +23171,7 @@ func (m *QueryAllEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInferenceValidationParametersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20753,17 +23194,17 @@ func (m *QueryAllEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInferenceValidationParametersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInferenceValidationParametersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochPerformanceSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -20773,31 +23214,29 @@ func (m *QueryAllEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochPerformanceSummary = append(m.EpochPerformanceSummary, EpochPerformanceSummary{})
-			if err := m.EpochPerformanceSummary[len(m.EpochPerformanceSummary)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -20807,27 +23246,23 @@ func (m *QueryAllEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20850,7 +23285,7 @@ func (m *QueryAllEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInferenceValidationParametersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20873,17 +23308,17 @@ func (m *QueryTrainingTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInferenceValidationParametersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInferenceValidationParametersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
 			}
-			m.Id = 0
+			m.ValidatorPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -20893,64 +23328,67 @@ func (m *QueryTrainingTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ValidatorPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTrainingTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Details = append(m.Details, &InferenceValidationDetails{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20977,10 +23415,10 @@ func (m *QueryTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
+			if m.Parameters == nil {
+				m.Parameters = &ValidationParams{}
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21005,7 +23443,7 @@ func (m *QueryTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHardwareNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21028,15 +23466,34 @@ func (m *QueryHardwareNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHardwareNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHardwareNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21064,7 +23521,7 @@ func (m *QueryHardwareNodesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.ParticipantId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21087,7 +23544,7 @@ func (m *QueryHardwareNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHardwareNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21110,15 +23567,15 @@ func (m *QueryHardwareNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHardwareNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHardwareNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochPerformanceSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPerformanceSummary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21145,10 +23602,7 @@ func (m *QueryHardwareNodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nodes == nil {
-				m.Nodes = &HardwareNodes{}
-			}
-			if err := m.Nodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochPerformanceSummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21173,7 +23627,7 @@ func (m *QueryHardwareNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHardwareNodesAllRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochPerformanceSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21196,12 +23650,48 @@ func (m *QueryHardwareNodesAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHardwareNodesAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHardwareNodesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -21223,7 +23713,7 @@ func (m *QueryHardwareNodesAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHardwareNodesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochPerformanceSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21246,15 +23736,15 @@ func (m *QueryHardwareNodesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHardwareNodesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHardwareNodesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochPerformanceSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPerformanceSummary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21281,8 +23771,44 @@ func (m *QueryHardwareNodesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &HardwareNodes{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochPerformanceSummary = append(m.EpochPerformanceSummary, EpochPerformanceSummary{})
+			if err := m.EpochPerformanceSummary[len(m.EpochPerformanceSummary)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21307,7 +23833,7 @@ func (m *QueryHardwareNodesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQueuedTrainingTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21330,12 +23856,31 @@ func (m *QueryQueuedTrainingTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQueuedTrainingTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQueuedTrainingTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -21357,7 +23902,7 @@ func (m *QueryQueuedTrainingTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQueuedTrainingTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21380,15 +23925,15 @@ func (m *QueryQueuedTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQueuedTrainingTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQueuedTrainingTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21415,8 +23960,10 @@ func (m *QueryQueuedTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &TrainingTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21441,7 +23988,7 @@ func (m *QueryQueuedTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingTaskAllRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHardwareNodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21464,12 +24011,44 @@ func (m *QueryTrainingTaskAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingTaskAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHardwareNodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingTaskAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHardwareNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -21491,7 +24070,7 @@ func (m *QueryTrainingTaskAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingTaskAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHardwareNodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21514,15 +24093,15 @@ func (m *QueryTrainingTaskAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingTaskAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHardwareNodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingTaskAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHardwareNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21549,8 +24128,10 @@ func (m *QueryTrainingTaskAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &TrainingTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Nodes == nil {
+				m.Nodes = &HardwareNodes{}
+			}
+			if err := m.Nodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21575,7 +24156,7 @@ func (m *QueryTrainingTaskAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHardwareNodesAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21598,44 +24179,12 @@ func (m *QueryGetParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHardwareNodesAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHardwareNodesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParticipantId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -21657,7 +24206,7 @@ func (m *QueryGetParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHardwareNodesAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21680,17 +24229,17 @@ func (m *QueryGetParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHardwareNodesAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHardwareNodesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -21700,30 +24249,26 @@ func (m *QueryGetParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Reputation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reputation |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &HardwareNodes{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -21745,7 +24290,7 @@ func (m *QueryGetParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTrainingTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21768,10 +24313,10 @@ func (m *QueryGetAllParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTrainingTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTrainingTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21795,7 +24340,7 @@ func (m *QueryGetAllParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetAllParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21818,15 +24363,15 @@ func (m *QueryGetAllParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTrainingTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTrainingTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantCurrentStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21853,49 +24398,61 @@ func (m *QueryGetAllParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantCurrentStats = append(m.ParticipantCurrentStats, &ParticipantCurrentStats{})
-			if err := m.ParticipantCurrentStats[len(m.ParticipantCurrentStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &TrainingTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EpochId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrainingTaskAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrainingTaskAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrainingTaskAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -21917,7 +24474,7 @@ func (m *QueryGetAllParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *ParticipantCurrentStats) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingTaskAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21940,17 +24497,17 @@ func (m *ParticipantCurrentStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantCurrentStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingTaskAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantCurrentStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingTaskAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -21960,62 +24517,26 @@ func (m *ParticipantCurrentStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			m.Weight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
-			}
-			m.Reputation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reputation |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Tasks = append(m.Tasks, &TrainingTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -22037,7 +24558,7 @@ func (m *ParticipantCurrentStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22060,15 +24581,15 @@ func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantFullStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantFullStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22096,83 +24617,63 @@ func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.ParticipantId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
-			}
-			m.Reputation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reputation |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarnedCoinsCurrentEpoch", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EarnedCoinsCurrentEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EarnedCoinsCurrentEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetParticipantCurrentStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardedCoinsLatestEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.RewardedCoinsLatestEpoch = 0
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22182,16 +24683,16 @@ func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardedCoinsLatestEpoch |= uint64(b&0x7F) << shift
+				m.Weight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochsCompleted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
 			}
-			m.EpochsCompleted = 0
+			m.Reputation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22201,7 +24702,7 @@ func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochsCompleted |= uint32(b&0x7F) << shift
+				m.Reputation |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22227,7 +24728,7 @@ func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParticipantsFullStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllParticipantCurrentStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22250,10 +24751,10 @@ func (m *QueryParticipantsFullStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParticipantsFullStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParticipantsFullStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22277,7 +24778,7 @@ func (m *QueryParticipantsFullStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParticipantsFullStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllParticipantCurrentStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22300,15 +24801,15 @@ func (m *QueryParticipantsFullStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParticipantsFullStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParticipantsFullStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllParticipantCurrentStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantCurrentStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22335,11 +24836,49 @@ func (m *QueryParticipantsFullStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantsStats = append(m.ParticipantsStats, &ParticipantFullStats{})
-			if err := m.ParticipantsStats[len(m.ParticipantsStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ParticipantCurrentStats = append(m.ParticipantCurrentStats, &ParticipantCurrentStats{})
+			if err := m.ParticipantCurrentStats[len(m.ParticipantCurrentStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -22361,7 +24900,7 @@ func (m *QueryParticipantsFullStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error {
+func (m *ParticipantCurrentStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22384,15 +24923,15 @@ func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantCurrentStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantCurrentStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22420,13 +24959,13 @@ func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Developer = string(dAtA[iNdEx:postIndex])
+			m.ParticipantId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.TimeFrom = 0
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22436,16 +24975,16 @@ func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeFrom |= int64(b&0x7F) << shift
+				m.Weight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
 			}
-			m.TimeTo = 0
+			m.Reputation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22455,7 +24994,7 @@ func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeTo |= int64(b&0x7F) << shift
+				m.Reputation |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22481,7 +25020,7 @@ func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryStatsByTimePeriodByDeveloperResponse) Unmarshal(dAtA []byte) error {
+func (m *ParticipantFullStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22504,17 +25043,17 @@ func (m *QueryStatsByTimePeriodByDeveloperResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantFullStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantFullStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22524,79 +25063,27 @@ func (m *QueryStatsByTimePeriodByDeveloperResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stats = append(m.Stats, &DeveloperStatsByTime{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByDeveloperAndEpochBackwardsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByDeveloperAndEpochBackwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22624,13 +25111,13 @@ func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Developer = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochsN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
 			}
-			m.EpochsN = 0
+			m.Reputation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22640,7 +25127,64 @@ func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochsN |= int32(b&0x7F) << shift
+				m.Reputation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnedCoinsCurrentEpoch", wireType)
+			}
+			m.EarnedCoinsCurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarnedCoinsCurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardedCoinsLatestEpoch", wireType)
+			}
+			m.RewardedCoinsLatestEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardedCoinsLatestEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsCompleted", wireType)
+			}
+			m.EpochsCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsCompleted |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22666,7 +25210,7 @@ func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryInferencesAndTokensStatsByEpochsBackwardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantsFullStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22689,31 +25233,12 @@ func (m *QueryInferencesAndTokensStatsByEpochsBackwardsRequest) Unmarshal(dAtA [
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByEpochsBackwardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantsFullStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByEpochsBackwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantsFullStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochsN", wireType)
-			}
-			m.EpochsN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochsN |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -22735,7 +25260,7 @@ func (m *QueryInferencesAndTokensStatsByEpochsBackwardsRequest) Unmarshal(dAtA [
 	}
 	return nil
 }
-func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantsFullStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22758,17 +25283,17 @@ func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByTimePeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantsFullStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByTimePeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantsFullStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeFrom", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsStats", wireType)
 			}
-			m.TimeFrom = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22778,30 +25303,26 @@ func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeFrom |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeTo", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.TimeTo = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeTo |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantsStats = append(m.ParticipantsStats, &ParticipantFullStats{})
+			if err := m.ParticipantsStats[len(m.ParticipantsStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -22823,7 +25344,7 @@ func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *QueryInferencesAndTokensStatsByModelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStatsByTimePeriodByDeveloperRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22846,12 +25367,44 @@ func (m *QueryInferencesAndTokensStatsByModelsRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeFrom", wireType)
@@ -22911,7 +25464,7 @@ func (m *QueryInferencesAndTokensStatsByModelsRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *ModelStats) Unmarshal(dAtA []byte) error {
+func (m *QueryStatsByTimePeriodByDeveloperResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22934,17 +25487,17 @@ func (m *ModelStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModelStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModelStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatsByTimePeriodByDeveloperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22954,29 +25507,113 @@ func (m *ModelStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
+			m.Stats = append(m.Stats, &DeveloperStatsByTime{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByDeveloperAndEpochBackwardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByDeveloperAndEpochBackwardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByDeveloperAndEpochBackwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AiTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsN", wireType)
 			}
-			m.AiTokens = 0
+			m.EpochsN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -22986,16 +25623,154 @@ func (m *ModelStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AiTokens |= int64(b&0x7F) << shift
+				m.EpochsN |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInferencesAndTokensStatsByEpochsBackwardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByEpochsBackwardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByEpochsBackwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsN", wireType)
+			}
+			m.EpochsN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsN |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInferencesAndTokensStatsByTimePeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByTimePeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByTimePeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeFrom", wireType)
+			}
+			m.TimeFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeFrom |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeTo", wireType)
 			}
-			m.Inferences = 0
+			m.TimeTo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23005,7 +25780,7 @@ func (m *ModelStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inferences |= int32(b&0x7F) << shift
+				m.TimeTo |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23031,7 +25806,1381 @@ func (m *ModelStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInferencesAndTokensStatsByModelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInferencesAndTokensStatsByModelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeFrom", wireType)
+			}
+			m.TimeFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeFrom |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeTo", wireType)
+			}
+			m.TimeTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeTo |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModelStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModelStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModelStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AiTokens", wireType)
+			}
+			m.AiTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AiTokens |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			m.Inferences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inferences |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInferencesAndTokensStatsByModelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsModels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsModels = append(m.StatsModels, &ModelStats{})
+			if err := m.StatsModels[len(m.StatsModels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInferencesAndTokensStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInferencesAndTokensStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AiTokens", wireType)
+			}
+			m.AiTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AiTokens |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			m.Inferences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inferences |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualInferencesCost", wireType)
+			}
+			m.ActualInferencesCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActualInferencesCost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCountAllParticipantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountAllParticipantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountAllParticipantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCountAllParticipantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountAllParticipantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountAllParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebugStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebugStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebugStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebugStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebugStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebugStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsByTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsByTime = append(m.StatsByTime, &QueryDebugStatsResponse_TemporaryTimeStat{})
+			if err := m.StatsByTime[len(m.StatsByTime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsByEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsByEpoch = append(m.StatsByEpoch, &QueryDebugStatsResponse_TemporaryEpochStat{})
+			if err := m.StatsByEpoch[len(m.StatsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebugStatsResponse_TemporaryTimeStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemporaryTimeStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemporaryTimeStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &DeveloperStatsByTime{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebugStatsResponse_TemporaryEpochStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemporaryEpochStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemporaryEpochStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &DeveloperStatsByEpoch{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinimumValidationAverageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinimumValidationAverageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinimumValidationAverageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinimumValidationAverageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinimumValidationAverageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinimumValidationAverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficBasis", wireType)
+			}
+			m.TrafficBasis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficBasis |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumValidationAverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumValidationAverage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInProgressTrainingTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInProgressTrainingTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInProgressTrainingTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInProgressTrainingTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInProgressTrainingTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInProgressTrainingTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &TrainingTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPartialUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPartialUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPartialUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23054,15 +27203,15 @@ func (m *QueryInferencesAndTokensStatsByModelsResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPartialUpgradeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsByModelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPartialUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsModels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23089,8 +27238,10 @@ func (m *QueryInferencesAndTokensStatsByModelsResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatsModels = append(m.StatsModels, &ModelStats{})
-			if err := m.StatsModels[len(m.StatsModels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23115,7 +27266,7 @@ func (m *QueryInferencesAndTokensStatsByModelsResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryInferencesAndTokensStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23138,17 +27289,17 @@ func (m *QueryInferencesAndTokensStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPartialUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAndTokensStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AiTokens", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialUpgrade", wireType)
 			}
-			m.AiTokens = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23158,35 +27309,31 @@ func (m *QueryInferencesAndTokensStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AiTokens |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Inferences = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Inferences |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualInferencesCost", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ActualInferencesCost = 0
+			m.PartialUpgrade = append(m.PartialUpgrade, PartialUpgrade{})
+			if err := m.PartialUpgrade[len(m.PartialUpgrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23196,61 +27343,28 @@ func (m *QueryInferencesAndTokensStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActualInferencesCost |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCountAllParticipantsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountAllParticipantsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountAllParticipantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -23272,7 +27386,7 @@ func (m *QueryCountAllParticipantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountAllParticipantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingKvRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23295,17 +27409,17 @@ func (m *QueryCountAllParticipantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountAllParticipantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingKvRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountAllParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingKvRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.Total = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23315,61 +27429,43 @@ func (m *QueryCountAllParticipantsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDebugStatsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebugStatsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebugStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -23391,7 +27487,7 @@ func (m *QueryDebugStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebugStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23414,15 +27510,15 @@ func (m *QueryDebugStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebugStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingKvRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebugStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsByTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23449,16 +27545,68 @@ func (m *QueryDebugStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatsByTime = append(m.StatsByTime, &QueryDebugStatsResponse_TemporaryTimeStat{})
-			if err := m.StatsByTime[len(m.StatsByTime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Record == nil {
+				m.Record = &TrainingTaskKVRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsByEpoch", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTrainingKvRecordKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23468,26 +27616,11 @@ func (m *QueryDebugStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StatsByEpoch = append(m.StatsByEpoch, &QueryDebugStatsResponse_TemporaryEpochStat{})
-			if err := m.StatsByEpoch[len(m.StatsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -23509,7 +27642,7 @@ func (m *QueryDebugStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebugStatsResponse_TemporaryTimeStat) Unmarshal(dAtA []byte) error {
+func (m *QueryListTrainingKvRecordKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23532,15 +27665,15 @@ func (m *QueryDebugStatsResponse_TemporaryTimeStat) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemporaryTimeStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemporaryTimeStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23568,41 +27701,7 @@ func (m *QueryDebugStatsResponse_TemporaryTimeStat) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Developer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stats = append(m.Stats, &DeveloperStatsByTime{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23625,7 +27724,7 @@ func (m *QueryDebugStatsResponse_TemporaryTimeStat) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDebugStatsResponse_TemporaryEpochStat) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23648,17 +27747,17 @@ func (m *QueryDebugStatsResponse_TemporaryEpochStat) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemporaryEpochStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemporaryEpochStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23668,29 +27767,16 @@ func (m *QueryDebugStatsResponse_TemporaryEpochStat) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Developer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterStep", wireType)
 			}
-			var msglen int
+			m.OuterStep = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23700,26 +27786,11 @@ func (m *QueryDebugStatsResponse_TemporaryEpochStat) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OuterStep |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stats = append(m.Stats, &DeveloperStatsByEpoch{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -23741,7 +27812,7 @@ func (m *QueryDebugStatsResponse_TemporaryEpochStat) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetMinimumValidationAverageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23764,12 +27835,48 @@ func (m *QueryGetMinimumValidationAverageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinimumValidationAverageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinimumValidationAverageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &TrainingCheckpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -23791,7 +27898,7 @@ func (m *QueryGetMinimumValidationAverageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinimumValidationAverageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23814,68 +27921,17 @@ func (m *QueryGetMinimumValidationAverageResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinimumValidationAverageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinimumValidationAverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficBasis", wireType)
-			}
-			m.TrafficBasis = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TrafficBasis |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumValidationAverage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MinimumValidationAverage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.BlockHeight = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -23885,7 +27941,7 @@ func (m *QueryGetMinimumValidationAverageResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23911,7 +27967,7 @@ func (m *QueryGetMinimumValidationAverageResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryInProgressTrainingTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23934,12 +27990,46 @@ func (m *QueryInProgressTrainingTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInProgressTrainingTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInProgressTrainingTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, &TrainingCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -23961,7 +28051,7 @@ func (m *QueryInProgressTrainingTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInProgressTrainingTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingBarrierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23984,15 +28074,15 @@ func (m *QueryInProgressTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInProgressTrainingTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingBarrierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInProgressTrainingTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingBarrierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24019,8 +28109,10 @@ func (m *QueryInProgressTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &TrainingTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Req == nil {
+				m.Req = &GetBarrierStatusRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24045,7 +28137,7 @@ func (m *QueryInProgressTrainingTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingBarrierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24068,17 +28160,17 @@ func (m *QueryGetPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPartialUpgradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingBarrierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPartialUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -24088,11 +28180,28 @@ func (m *QueryGetPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resp == nil {
+				m.Resp = &GetBarrierStatusResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -24114,7 +28223,7 @@ func (m *QueryGetPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingAliveNodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24137,15 +28246,15 @@ func (m *QueryGetPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPartialUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingAliveNodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingAliveNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24172,7 +28281,10 @@ func (m *QueryGetPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PartialUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Req == nil {
+				m.Req = &GetAliveNodesRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24197,7 +28309,7 @@ func (m *QueryGetPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTrainingAliveNodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24220,15 +28332,15 @@ func (m *QueryAllPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPartialUpgradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTrainingAliveNodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPartialUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTrainingAliveNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24255,10 +28367,10 @@ func (m *QueryAllPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Resp == nil {
+				m.Resp = &GetAliveNodesResponse{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24283,7 +28395,7 @@ func (m *QueryAllPartialUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24306,17 +28418,17 @@ func (m *QueryAllPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPartialUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -24326,31 +28438,29 @@ func (m *QueryAllPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartialUpgrade = append(m.PartialUpgrade, PartialUpgrade{})
-			if err := m.PartialUpgrade[len(m.PartialUpgrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -24360,27 +28470,55 @@ func (m *QueryAllPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24403,7 +28541,7 @@ func (m *QueryAllPartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingKvRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeTransactionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24426,36 +28564,17 @@ func (m *QueryTrainingKvRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingKvRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeTransactionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingKvRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeTransaction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -24465,23 +28584,24 @@ func (m *QueryTrainingKvRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.BridgeTransaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24504,7 +28624,7 @@ func (m *QueryTrainingKvRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBridgeTransactionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24527,15 +28647,15 @@ func (m *QueryTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingKvRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBridgeTransactionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBridgeTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24562,10 +28682,10 @@ func (m *QueryTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &TrainingTaskKVRecord{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24590,7 +28710,7 @@ func (m *QueryTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListTrainingKvRecordKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBridgeTransactionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24613,17 +28733,17 @@ func (m *QueryListTrainingKvRecordKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBridgeTransactionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBridgeTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeTransactions", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -24633,11 +28753,62 @@ func (m *QueryListTrainingKvRecordKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeTransactions = append(m.BridgeTransactions, BridgeTransaction{})
+			if err := m.BridgeTransactions[len(m.BridgeTransactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -24659,7 +28830,7 @@ func (m *QueryListTrainingKvRecordKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListTrainingKvRecordKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24682,15 +28853,15 @@ func (m *QueryListTrainingKvRecordKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeBlockHeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListTrainingKvRecordKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24718,7 +28889,39 @@ func (m *QueryListTrainingKvRecordKeysResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24741,7 +28944,7 @@ func (m *QueryListTrainingKvRecordKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingBarrierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24764,15 +28967,15 @@ func (m *QueryTrainingBarrierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingBarrierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeBlockHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingBarrierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24799,10 +29002,7 @@ func (m *QueryTrainingBarrierRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &GetBarrierStatusRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24827,7 +29027,7 @@ func (m *QueryTrainingBarrierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingBarrierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24850,17 +29050,17 @@ func (m *QueryTrainingBarrierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingBarrierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeWithdrawalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -24870,28 +29070,11 @@ func (m *QueryTrainingBarrierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &GetBarrierStatusResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -24913,7 +29096,7 @@ func (m *QueryTrainingBarrierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingAliveNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24936,15 +29119,15 @@ func (m *QueryTrainingAliveNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingAliveNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingAliveNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24971,13 +29154,144 @@ func (m *QueryTrainingAliveNodesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &GetAliveNodesRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedData = append(m.EncodedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedData == nil {
+				m.EncodedData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -24999,7 +29313,7 @@ func (m *QueryTrainingAliveNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrainingAliveNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25022,48 +29336,12 @@ func (m *QueryTrainingAliveNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrainingAliveNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrainingAliveNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &GetAliveNodesResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25085,7 +29363,7 @@ func (m *QueryTrainingAliveNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25108,17 +29386,17 @@ func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBridgeTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBridgeTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25128,29 +29406,16 @@ func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25160,29 +29425,30 @@ func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpoch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25192,23 +29458,24 @@ func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
+			if err := m.LatestEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25231,7 +29498,7 @@ func (m *QueryGetBridgeTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBridgeTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCountPoCbatchesAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25254,17 +29521,17 @@ func (m *QueryGetBridgeTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBridgeTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBridgeTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeTransaction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25274,25 +29541,11 @@ func (m *QueryGetBridgeTransactionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BridgeTransaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25314,7 +29567,7 @@ func (m *QueryGetBridgeTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBridgeTransactionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCountPoCbatchesAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25337,17 +29590,17 @@ func (m *QueryAllBridgeTransactionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBridgeTransactionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBridgeTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25357,28 +29610,11 @@ func (m *QueryAllBridgeTransactionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25400,7 +29636,7 @@ func (m *QueryAllBridgeTransactionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBridgeTransactionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCountPoCvalidationsAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25423,51 +29659,17 @@ func (m *QueryAllBridgeTransactionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBridgeTransactionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBridgeTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeTransactions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BridgeTransactions = append(m.BridgeTransactions, BridgeTransaction{})
-			if err := m.BridgeTransactions[len(m.BridgeTransactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25477,28 +29679,11 @@ func (m *QueryAllBridgeTransactionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25520,7 +29705,7 @@ func (m *QueryAllBridgeTransactionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCountPoCvalidationsAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25543,12 +29728,31 @@ func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25570,7 +29774,7 @@ func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetModelPerTokenPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25589,73 +29793,21 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetModelPerTokenPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetModelPerTokenPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25665,24 +29817,23 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25705,7 +29856,7 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountPoCbatchesAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetModelPerTokenPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25728,17 +29879,17 @@ func (m *QueryCountPoCbatchesAtHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetModelPerTokenPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetModelPerTokenPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.BlockHeight = 0
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25748,11 +29899,31 @@ func (m *QueryCountPoCbatchesAtHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int32(b&0x7F) << shift
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Found = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25774,7 +29945,7 @@ func (m *QueryCountPoCbatchesAtHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountPoCbatchesAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllModelPerTokenPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25797,31 +29968,12 @@ func (m *QueryCountPoCbatchesAtHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountPoCbatchesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25843,7 +29995,7 @@ func (m *QueryCountPoCbatchesAtHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountPoCvalidationsAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *ModelPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25866,17 +30018,49 @@ func (m *QueryCountPoCvalidationsAtHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModelPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModelPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.BlockHeight = 0
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25886,7 +30070,7 @@ func (m *QueryCountPoCvalidationsAtHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int32(b&0x7F) << shift
+				m.Price |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -25912,7 +30096,7 @@ func (m *QueryCountPoCvalidationsAtHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountPoCvalidationsAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllModelPerTokenPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25935,17 +30119,17 @@ func (m *QueryCountPoCvalidationsAtHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountPoCvalidationsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelPrices", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -25955,11 +30139,26 @@ func (m *QueryCountPoCvalidationsAtHeightResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelPrices = append(m.ModelPrices, ModelPrice{})
+			if err := m.ModelPrices[len(m.ModelPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -25981,7 +30180,7 @@ func (m *QueryCountPoCvalidationsAtHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetModelPerTokenPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetModelCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26004,10 +30203,10 @@ func (m *QueryGetModelPerTokenPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetModelPerTokenPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetModelCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetModelPerTokenPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetModelCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26063,7 +30262,7 @@ func (m *QueryGetModelPerTokenPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetModelPerTokenPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetModelCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26086,17 +30285,17 @@ func (m *QueryGetModelPerTokenPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetModelPerTokenPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetModelCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetModelPerTokenPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetModelCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			m.Price = 0
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26106,7 +30305,7 @@ func (m *QueryGetModelPerTokenPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.Capacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -26152,7 +30351,7 @@ func (m *QueryGetModelPerTokenPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllModelPerTokenPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllModelCapacitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26175,10 +30374,10 @@ func (m *QueryGetAllModelPerTokenPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllModelCapacitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllModelCapacitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26202,7 +30401,7 @@ func (m *QueryGetAllModelPerTokenPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModelPrice) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllModelCapacitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26225,17 +30424,17 @@ func (m *ModelPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModelPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllModelCapacitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModelPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllModelCapacitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelCapacities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26245,43 +30444,26 @@ func (m *ModelPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			m.Price = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ModelCapacities = append(m.ModelCapacities, ModelCapacity{})
+			if err := m.ModelCapacities[len(m.ModelCapacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -26303,7 +30485,7 @@ func (m *ModelPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllModelPerTokenPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *ModelCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26326,17 +30508,17 @@ func (m *QueryGetAllModelPerTokenPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModelCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllModelPerTokenPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModelCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26346,26 +30528,43 @@ func (m *QueryGetAllModelPerTokenPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelPrices = append(m.ModelPrices, ModelPrice{})
-			if err := m.ModelPrices[len(m.ModelPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -26387,7 +30586,7 @@ func (m *QueryGetAllModelPerTokenPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetModelCapacityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteesByMessageTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26410,15 +30609,15 @@ func (m *QueryGetModelCapacityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetModelCapacityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteesByMessageTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetModelCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteesByMessageTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26446,7 +30645,39 @@ func (m *QueryGetModelCapacityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelId = string(dAtA[iNdEx:postIndex])
+			m.GranterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26469,7 +30700,7 @@ func (m *QueryGetModelCapacityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetModelCapacityResponse) Unmarshal(dAtA []byte) error {
+func (m *Grantee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26492,17 +30723,17 @@ func (m *QueryGetModelCapacityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetModelCapacityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Grantee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetModelCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Grantee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Capacity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26512,16 +30743,29 @@ func (m *QueryGetModelCapacityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26531,62 +30775,24 @@ func (m *QueryGetModelCapacityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Found = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetAllModelCapacitiesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllModelCapacitiesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllModelCapacitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -26608,7 +30814,7 @@ func (m *QueryGetAllModelCapacitiesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllModelCapacitiesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteesByMessageTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26631,15 +30837,15 @@ func (m *QueryGetAllModelCapacitiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllModelCapacitiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteesByMessageTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllModelCapacitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteesByMessageTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelCapacities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26666,8 +30872,8 @@ func (m *QueryGetAllModelCapacitiesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelCapacities = append(m.ModelCapacities, ModelCapacity{})
-			if err := m.ModelCapacities[len(m.ModelCapacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grantees = append(m.Grantees, &Grantee{})
+			if err := m.Grantees[len(m.Grantees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26692,7 +30898,7 @@ func (m *QueryGetAllModelCapacitiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModelCapacity) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDeveloperSubKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26715,15 +30921,15 @@ func (m *ModelCapacity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModelCapacity: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDeveloperSubKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModelCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDeveloperSubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26751,13 +30957,13 @@ func (m *ModelCapacity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelId = string(dAtA[iNdEx:postIndex])
+			m.Developer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Capacity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26767,11 +30973,24 @@ func (m *ModelCapacity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -26793,7 +31012,7 @@ func (m *ModelCapacity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteesByMessageTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDeveloperSubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26816,17 +31035,17 @@ func (m *QueryGranteesByMessageTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteesByMessageTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDeveloperSubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteesByMessageTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDeveloperSubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GranterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26836,29 +31055,30 @@ func (m *QueryGranteesByMessageTypeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GranterAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentThisEpoch", wireType)
 			}
-			var stringLen uint64
+			m.SpentThisEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -26868,24 +31088,11 @@ func (m *QueryGranteesByMessageTypeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpentThisEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -26907,7 +31114,7 @@ func (m *QueryGranteesByMessageTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grantee) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperSubKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26930,47 +31137,15 @@ func (m *Grantee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grantee: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperSubKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grantee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperSubKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26998,7 +31173,7 @@ func (m *Grantee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.Developer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27021,7 +31196,7 @@ func (m *Grantee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteesByMessageTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperSubKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27044,15 +31219,15 @@ func (m *QueryGranteesByMessageTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteesByMessageTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperSubKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteesByMessageTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperSubKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27079,8 +31254,8 @@ func (m *QueryGranteesByMessageTypeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantees = append(m.Grantees, &Grantee{})
-			if err := m.Grantees[len(m.Grantees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubKeys = append(m.SubKeys, DeveloperSubKey{})
+			if err := m.SubKeys[len(m.SubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28,6 +28,9 @@ type SettleAmount struct {
 	WorkCoins     uint64 `protobuf:"varint,3,opt,name=work_coins,json=workCoins,proto3" json:"work_coins,omitempty"`
 	EpochIndex    uint64 `protobuf:"varint,4,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
 	SeedSignature string `protobuf:"bytes,5,opt,name=seed_signature,json=seedSignature,proto3" json:"seed_signature,omitempty"`
+	// delegator_rewards are the parts of reward_coins owed to the delegators of the participant's
+	// collateral, split by the delegations at settlement
+	DelegatorRewards []*DelegatorReward `protobuf:"bytes,6,rep,name=delegator_rewards,json=delegatorRewards,proto3" json:"delegator_rewards,omitempty"`
 }
 
 func (m *SettleAmount) Reset()         { *m = SettleAmount{} }
@@ -98,8 +101,68 @@ func (m *SettleAmount) GetSeedSignature() string {
 	return ""
 }
 
+func (m *SettleAmount) GetDelegatorRewards() []*DelegatorReward {
+	if m != nil {
+		return m.DelegatorRewards
+	}
+	return nil
+}
+
+type DelegatorReward struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount    uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *DelegatorReward) Reset()         { *m = DelegatorReward{} }
+func (m *DelegatorReward) String() string { return proto.CompactTextString(m) }
+func (*DelegatorReward) ProtoMessage()    {}
+func (*DelegatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b05d6309beaf448e, []int{1}
+}
+func (m *DelegatorReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorReward.Merge(m, src)
+}
+func (m *DelegatorReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorReward proto.InternalMessageInfo
+
+func (m *DelegatorReward) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *DelegatorReward) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SettleAmount)(nil), "inference.inference.SettleAmount")
+	proto.RegisterType((*DelegatorReward)(nil), "inference.inference.DelegatorReward")
 }
 
 func init() {
@@ -107,24 +170,28 @@ func init() {
 }
 
 var fileDescriptor_b05d6309beaf448e = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd0, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x05, 0xe0, 0xf8, 0xff, 0x0b, 0x52, 0x6f, 0x0a, 0x83, 0x59, 0xb2, 0x60, 0x02, 0x12, 0xa2,
-	0x53, 0x3a, 0x20, 0x1e, 0x00, 0x98, 0x98, 0x90, 0xda, 0x8d, 0x25, 0x72, 0x9d, 0x4b, 0x6b, 0x41,
-	0x6d, 0xcb, 0xbe, 0x51, 0xcb, 0x5b, 0xf0, 0x3c, 0x3c, 0x01, 0x63, 0x47, 0x46, 0x94, 0xbc, 0x08,
-	0x8a, 0x0b, 0xa5, 0x62, 0x3b, 0xfa, 0xce, 0x19, 0xae, 0x2e, 0x5c, 0x68, 0xf3, 0x88, 0x1e, 0x8d,
-	0xc2, 0xd1, 0x6f, 0x0a, 0x48, 0xf4, 0x8c, 0xa5, 0x5c, 0xd8, 0xda, 0x50, 0xe1, 0xbc, 0x25, 0xcb,
-	0x8f, 0xb6, 0x75, 0xb1, 0x4d, 0x67, 0x6f, 0x0c, 0x06, 0x93, 0x38, 0xbe, 0x8e, 0x5b, 0x9e, 0x43,
-	0xea, 0xa4, 0x27, 0xad, 0xb4, 0x93, 0x86, 0x32, 0x96, 0xb3, 0x61, 0x7f, 0xbc, 0x4b, 0xfc, 0x14,
-	0x06, 0x1e, 0x97, 0xd2, 0x57, 0xa5, 0xb2, 0xda, 0x84, 0xec, 0x5f, 0xce, 0x86, 0xbd, 0x71, 0xba,
-	0xb1, 0xdb, 0x8e, 0xf8, 0x31, 0xc0, 0xd2, 0xfa, 0xa7, 0xef, 0xc1, 0xff, 0x38, 0xe8, 0x77, 0xb2,
-	0xa9, 0x4f, 0x20, 0x45, 0x67, 0xd5, 0xbc, 0xd4, 0xa6, 0xc2, 0x55, 0xd6, 0x8b, 0x3d, 0x44, 0xba,
-	0xeb, 0x84, 0x9f, 0xc3, 0x61, 0x40, 0xac, 0xca, 0xa0, 0x67, 0x46, 0x52, 0xed, 0x31, 0xdb, 0x8b,
-	0x77, 0x1c, 0x74, 0x3a, 0xf9, 0xc1, 0x9b, 0xfb, 0xf7, 0x46, 0xb0, 0x75, 0x23, 0xd8, 0x67, 0x23,
-	0xd8, 0x6b, 0x2b, 0x92, 0x75, 0x2b, 0x92, 0x8f, 0x56, 0x24, 0x0f, 0x57, 0x33, 0x4d, 0xf3, 0x7a,
-	0x5a, 0x28, 0xbb, 0x18, 0x39, 0x6f, 0xab, 0x5a, 0x51, 0x50, 0xfa, 0xcf, 0x93, 0x56, 0x3b, 0x99,
-	0x5e, 0x1c, 0x86, 0xe9, 0x7e, 0xfc, 0xd4, 0xe5, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x44,
-	0x66, 0x57, 0x54, 0x01, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcb, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x29, 0x20, 0x09, 0xa7, 0x78, 0x1b, 0x13, 0xd3, 0x85, 0xd6, 0x4a, 0x34, 0xb2, 0x2a,
+	0x89, 0xc6, 0x07, 0xf0, 0x92, 0x18, 0x57, 0xc6, 0xb2, 0x73, 0xd3, 0x94, 0xe9, 0x11, 0x26, 0xc2,
+	0x4c, 0x33, 0x33, 0x0d, 0xf8, 0x10, 0x26, 0x3e, 0x96, 0x4b, 0x96, 0x2e, 0x0d, 0xbc, 0x88, 0xe9,
+	0xb4, 0x14, 0x24, 0xee, 0xce, 0x7c, 0xff, 0x97, 0xcc, 0xcc, 0x7f, 0xe0, 0x82, 0xf1, 0x57, 0x94,
+	0xc8, 0x29, 0x76, 0x57, 0x93, 0x42, 0xad, 0x47, 0x18, 0x46, 0x63, 0x91, 0x72, 0xed, 0x27, 0x52,
+	0x68, 0x41, 0x0e, 0xca, 0xd8, 0x2f, 0xa7, 0xf6, 0x47, 0x15, 0x5a, 0x3d, 0x23, 0xdf, 0x18, 0x97,
+	0x78, 0x60, 0x27, 0x91, 0xd4, 0x8c, 0xb2, 0x24, 0xe2, 0xda, 0xb1, 0x3c, 0xab, 0xd3, 0x0c, 0xd6,
+	0x11, 0x39, 0x85, 0x96, 0xc4, 0x49, 0x24, 0xe3, 0x90, 0x0a, 0xc6, 0x95, 0x53, 0xf5, 0xac, 0x4e,
+	0x3d, 0xb0, 0x73, 0x76, 0x97, 0x21, 0x72, 0x0c, 0x30, 0x11, 0xf2, 0xad, 0x10, 0x6a, 0x46, 0x68,
+	0x66, 0x24, 0x8f, 0x4f, 0xc0, 0xc6, 0x44, 0xd0, 0x61, 0xc8, 0x78, 0x8c, 0x53, 0xa7, 0x6e, 0x72,
+	0x30, 0xe8, 0x31, 0x23, 0xe4, 0x1c, 0x76, 0x14, 0x62, 0x1c, 0x2a, 0x36, 0xe0, 0x91, 0x4e, 0x25,
+	0x3a, 0x5b, 0xe6, 0x1d, 0xdb, 0x19, 0xed, 0x2d, 0x21, 0x79, 0x86, 0xfd, 0x18, 0x47, 0x38, 0x88,
+	0xb4, 0x90, 0x61, 0x7e, 0xbf, 0x72, 0x1a, 0x5e, 0xad, 0x63, 0x5f, 0x9e, 0xf9, 0xff, 0xfc, 0xd6,
+	0xbf, 0x5f, 0xda, 0x81, 0x91, 0x83, 0xbd, 0xf8, 0x2f, 0x50, 0xed, 0x07, 0xd8, 0xdd, 0x90, 0xc8,
+	0x11, 0x34, 0x4b, 0xad, 0xe8, 0x63, 0x05, 0xc8, 0x21, 0x34, 0xf2, 0x96, 0x8b, 0x1e, 0x8a, 0xd3,
+	0xed, 0xd3, 0xd7, 0xdc, 0xb5, 0x66, 0x73, 0xd7, 0xfa, 0x99, 0xbb, 0xd6, 0xe7, 0xc2, 0xad, 0xcc,
+	0x16, 0x6e, 0xe5, 0x7b, 0xe1, 0x56, 0x5e, 0xae, 0x07, 0x4c, 0x0f, 0xd3, 0xbe, 0x4f, 0xc5, 0xb8,
+	0x9b, 0x48, 0x11, 0xa7, 0x54, 0x2b, 0xca, 0x36, 0x16, 0x38, 0x5d, 0x9b, 0xf5, 0x7b, 0x82, 0xaa,
+	0xdf, 0x30, 0x5b, 0xbc, 0xfa, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x01, 0x5c, 0x96, 0xb7, 0xf0, 0x01,
+	0x00, 0x00,
 }
 
 func (m *SettleAmount) Marshal() (dAtA []byte, err error) {
@@ -147,6 +214,20 @@ func (m *SettleAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorRewards) > 0 {
+		for iNdEx := len(m.DelegatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettleAmount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SeedSignature) > 0 {
 		i -= len(m.SeedSignature)
 		copy(dAtA[i:], m.SeedSignature)
@@ -179,6 +260,41 @@ func (m *SettleAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintSettleAmount(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintSettleAmount(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSettleAmount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSettleAmount(v)
 	base := offset
@@ -213,6 +329,28 @@ func (m *SettleAmount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSettleAmount(uint64(l))
 	}
+	if len(m.DelegatorRewards) > 0 {
+		for _, e := range m.DelegatorRewards {
+			l = e.Size()
+			n += 1 + l + sovSettleAmount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovSettleAmount(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovSettleAmount(uint64(m.Amount))
+	}
 	return n
 }
 
@@ -372,6 +510,141 @@ func (m *SettleAmount) Unmarshal(dAtA []byte) error {
 			}
 			m.SeedSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettleAmount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRewards = append(m.DelegatorRewards, &DelegatorReward{})
+			if err := m.DelegatorRewards[len(m.DelegatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettleAmount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettleAmount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettleAmount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettleAmount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettleAmount(dAtA[iNdEx:])
@@ -34,6 +34,17 @@ type TrainingTask struct {
 	Config                         *TrainingConfig              `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
 	Assignees                      []*TrainingTaskAssignee      `protobuf:"bytes,10,rep,name=assignees,proto3" json:"assignees,omitempty"`
 	Epoch                          *EpochInfo                   `protobuf:"bytes,11,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Budget                         uint64                       `protobuf:"varint,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	OuterStepPayout                uint64                       `protobuf:"varint,13,opt,name=outer_step_payout,json=outerStepPayout,proto3" json:"outer_step_payout,omitempty"`
+	PaidOut                        uint64                       `protobuf:"varint,14,opt,name=paid_out,json=paidOut,proto3" json:"paid_out,omitempty"`
+	Refunded                       uint64                       `protobuf:"varint,15,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// Outer steps before this one have been paid for
+	NextPayableOuterStep int32 `protobuf:"varint,16,opt,name=next_payable_outer_step,json=nextPayableOuterStep,proto3" json:"next_payable_outer_step,omitempty"`
+	Cancelled            bool  `protobuf:"varint,17,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Hardware of the assigned nodes that stopped sending heartbeats, waiting to be assigned to other nodes
+	ReplacementResources []*TrainingHardwareResources `protobuf:"bytes,18,rep,name=replacement_resources,json=replacementResources,proto3" json:"replacement_resources,omitempty"`
+	// Height the latest replacement nodes were assigned at
+	NodesReplacedAtBlockHeight uint64 `protobuf:"varint,19,opt,name=nodes_replaced_at_block_height,json=nodesReplacedAtBlockHeight,proto3" json:"nodes_replaced_at_block_height,omitempty"`
 }
 
 func (m *TrainingTask) Reset()         { *m = TrainingTask{} }
@@ -146,6 +157,236 @@ func (m *TrainingTask) GetEpoch() *EpochInfo {
 	return nil
 }
 
+func (m *TrainingTask) GetBudget() uint64 {
+	if m != nil {
+		return m.Budget
+	}
+	return 0
+}
+
+func (m *TrainingTask) GetOuterStepPayout() uint64 {
+	if m != nil {
+		return m.OuterStepPayout
+	}
+	return 0
+}
+
+func (m *TrainingTask) GetPaidOut() uint64 {
+	if m != nil {
+		return m.PaidOut
+	}
+	return 0
+}
+
+func (m *TrainingTask) GetRefunded() uint64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *TrainingTask) GetNextPayableOuterStep() int32 {
+	if m != nil {
+		return m.NextPayableOuterStep
+	}
+	return 0
+}
+
+func (m *TrainingTask) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *TrainingTask) GetReplacementResources() []*TrainingHardwareResources {
+	if m != nil {
+		return m.ReplacementResources
+	}
+	return nil
+}
+
+func (m *TrainingTask) GetNodesReplacedAtBlockHeight() uint64 {
+	if m != nil {
+		return m.NodesReplacedAtBlockHeight
+	}
+	return 0
+}
+
+// A model checkpoint produced at the end of an outer step, accepted once a quorum of assignees signed
+// the same content hash
+type TrainingCheckpoint struct {
+	TaskId    uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OuterStep int32  `protobuf:"varint,2,opt,name=outer_step,json=outerStep,proto3" json:"outer_step,omitempty"`
+	// The accepted content hash and where to fetch it, empty until the quorum is reached
+	ContentHash          string                         `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	StorageUri           string                         `protobuf:"bytes,4,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+	Signatures           []*TrainingCheckpointSignature `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	CreatedAtBlockHeight int64                          `protobuf:"varint,6,opt,name=created_at_block_height,json=createdAtBlockHeight,proto3" json:"created_at_block_height,omitempty"`
+	// Zero until more than half of the assignees signed the same content hash
+	QuorumReachedAtBlockHeight int64 `protobuf:"varint,7,opt,name=quorum_reached_at_block_height,json=quorumReachedAtBlockHeight,proto3" json:"quorum_reached_at_block_height,omitempty"`
+}
+
+func (m *TrainingCheckpoint) Reset()         { *m = TrainingCheckpoint{} }
+func (m *TrainingCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TrainingCheckpoint) ProtoMessage()    {}
+func (*TrainingCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d50542a135bda, []int{1}
+}
+func (m *TrainingCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainingCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainingCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainingCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainingCheckpoint.Merge(m, src)
+}
+func (m *TrainingCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainingCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainingCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainingCheckpoint proto.InternalMessageInfo
+
+func (m *TrainingCheckpoint) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *TrainingCheckpoint) GetOuterStep() int32 {
+	if m != nil {
+		return m.OuterStep
+	}
+	return 0
+}
+
+func (m *TrainingCheckpoint) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *TrainingCheckpoint) GetStorageUri() string {
+	if m != nil {
+		return m.StorageUri
+	}
+	return ""
+}
+
+func (m *TrainingCheckpoint) GetSignatures() []*TrainingCheckpointSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *TrainingCheckpoint) GetCreatedAtBlockHeight() int64 {
+	if m != nil {
+		return m.CreatedAtBlockHeight
+	}
+	return 0
+}
+
+func (m *TrainingCheckpoint) GetQuorumReachedAtBlockHeight() int64 {
+	if m != nil {
+		return m.QuorumReachedAtBlockHeight
+	}
+	return 0
+}
+
+type TrainingCheckpointSignature struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// Nodes of the participant that produced the checkpoint
+	NodeIds     []string `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	BlockHeight int64    `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The checkpoint the participant signed
+	ContentHash string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	StorageUri  string `protobuf:"bytes,5,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+}
+
+func (m *TrainingCheckpointSignature) Reset()         { *m = TrainingCheckpointSignature{} }
+func (m *TrainingCheckpointSignature) String() string { return proto.CompactTextString(m) }
+func (*TrainingCheckpointSignature) ProtoMessage()    {}
+func (*TrainingCheckpointSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d50542a135bda, []int{2}
+}
+func (m *TrainingCheckpointSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainingCheckpointSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainingCheckpointSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainingCheckpointSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainingCheckpointSignature.Merge(m, src)
+}
+func (m *TrainingCheckpointSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainingCheckpointSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainingCheckpointSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainingCheckpointSignature proto.InternalMessageInfo
+
+func (m *TrainingCheckpointSignature) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *TrainingCheckpointSignature) GetNodeIds() []string {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *TrainingCheckpointSignature) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TrainingCheckpointSignature) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *TrainingCheckpointSignature) GetStorageUri() string {
+	if m != nil {
+		return m.StorageUri
+	}
+	return ""
+}
+
 type TrainingHardwareResources struct {
 	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
@@ -155,7 +396,7 @@ func (m *TrainingHardwareResources) Reset()         { *m = TrainingHardwareResou
 func (m *TrainingHardwareResources) String() string { return proto.CompactTextString(m) }
 func (*TrainingHardwareResources) ProtoMessage()    {}
 func (*TrainingHardwareResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{1}
+	return fileDescriptor_8a3d50542a135bda, []int{3}
 }
 func (m *TrainingHardwareResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +448,7 @@ func (m *TrainingConfig) Reset()         { *m = TrainingConfig{} }
 func (m *TrainingConfig) String() string { return proto.CompactTextString(m) }
 func (*TrainingConfig) ProtoMessage()    {}
 func (*TrainingConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{2}
+	return fileDescriptor_8a3d50542a135bda, []int{4}
 }
 func (m *TrainingConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +500,7 @@ func (m *TrainingDatasets) Reset()         { *m = TrainingDatasets{} }
 func (m *TrainingDatasets) String() string { return proto.CompactTextString(m) }
 func (*TrainingDatasets) ProtoMessage()    {}
 func (*TrainingDatasets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{3}
+	return fileDescriptor_8a3d50542a135bda, []int{5}
 }
 func (m *TrainingDatasets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +552,7 @@ func (m *TrainingTaskAssignee) Reset()         { *m = TrainingTaskAssignee{} }
 func (m *TrainingTaskAssignee) String() string { return proto.CompactTextString(m) }
 func (*TrainingTaskAssignee) ProtoMessage()    {}
 func (*TrainingTaskAssignee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{4}
+	return fileDescriptor_8a3d50542a135bda, []int{6}
 }
 func (m *TrainingTaskAssignee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +606,7 @@ func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
 func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
 func (*EpochInfo) ProtoMessage()    {}
 func (*EpochInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{5}
+	return fileDescriptor_8a3d50542a135bda, []int{7}
 }
 func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,6 +665,8 @@ func (m *EpochInfo) GetLastEpochIsFinished() bool {
 
 func init() {
 	proto.RegisterType((*TrainingTask)(nil), "inference.inference.TrainingTask")
+	proto.RegisterType((*TrainingCheckpoint)(nil), "inference.inference.TrainingCheckpoint")
+	proto.RegisterType((*TrainingCheckpointSignature)(nil), "inference.inference.TrainingCheckpointSignature")
 	proto.RegisterType((*TrainingHardwareResources)(nil), "inference.inference.TrainingHardwareResources")
 	proto.RegisterType((*TrainingConfig)(nil), "inference.inference.TrainingConfig")
 	proto.RegisterType((*TrainingDatasets)(nil), "inference.inference.TrainingDatasets")
@@ -436,48 +679,68 @@ func init() {
 }
 
 var fileDescriptor_8a3d50542a135bda = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0x1b, 0x39,
-	0x14, 0x67, 0x48, 0x02, 0x99, 0x17, 0x16, 0xed, 0x9a, 0xb0, 0x3b, 0xac, 0xd4, 0x51, 0x1a, 0x54,
-	0x35, 0xbd, 0x84, 0x0a, 0x8a, 0x7a, 0x68, 0x2f, 0xa4, 0xa5, 0x05, 0xf5, 0x50, 0xc9, 0xd0, 0x4b,
-	0xa5, 0x6a, 0xe4, 0x78, 0x9c, 0x8c, 0x05, 0x63, 0x4f, 0xc7, 0x1e, 0xb5, 0xf9, 0x0e, 0x3d, 0xf4,
-	0x63, 0x71, 0xe4, 0xd8, 0x63, 0x05, 0xf7, 0x7e, 0x86, 0xca, 0x8e, 0x67, 0x48, 0x20, 0xe5, 0x66,
-	0xbf, 0xdf, 0x1f, 0xbf, 0x67, 0xbf, 0x67, 0x78, 0xcc, 0xc5, 0x88, 0xe5, 0x4c, 0x50, 0xb6, 0x73,
-	0xb3, 0xd2, 0x39, 0xe1, 0x82, 0x8b, 0x71, 0xa4, 0x89, 0x3a, 0xeb, 0x67, 0xb9, 0xd4, 0x12, 0x6d,
-	0x54, 0x70, 0xbf, 0x5a, 0x75, 0x7f, 0xd5, 0x61, 0xed, 0xd4, 0x91, 0x4f, 0x89, 0x3a, 0x43, 0xeb,
-	0xb0, 0xcc, 0xe3, 0xc0, 0xeb, 0x78, 0xbd, 0x3a, 0x5e, 0xe6, 0x31, 0x7a, 0x08, 0x6b, 0x39, 0xfb,
-	0x5c, 0x30, 0xa5, 0x59, 0x1c, 0x0d, 0x27, 0xc1, 0x72, 0xc7, 0xeb, 0xf9, 0xb8, 0x55, 0xc5, 0x06,
-	0x13, 0xb4, 0x0f, 0xff, 0xd1, 0x9c, 0x11, 0x43, 0x20, 0x3a, 0x1a, 0x9e, 0x4b, 0x7a, 0x16, 0x25,
-	0x8c, 0x8f, 0x13, 0x1d, 0xd4, 0xac, 0x4f, 0xdb, 0xc1, 0x07, 0x7a, 0x60, 0xc0, 0x23, 0x8b, 0xa1,
-	0xff, 0xa1, 0x49, 0x94, 0xe2, 0x63, 0xc1, 0xf2, 0xa0, 0x6e, 0x5d, 0xab, 0x3d, 0x7a, 0x07, 0xdb,
-	0xf4, 0x9c, 0xf0, 0xd4, 0x9e, 0x19, 0x95, 0xe1, 0x3b, 0xf6, 0x0d, 0x6b, 0x1f, 0x3a, 0xea, 0x60,
-	0x72, 0xe0, 0x88, 0xf3, 0x07, 0x3d, 0x87, 0xc0, 0x39, 0xdc, 0x4d, 0x70, 0xc5, 0x3a, 0x6c, 0x96,
-	0xf8, 0x1d, 0xe1, 0x88, 0x0b, 0xae, 0x92, 0x05, 0xc2, 0xd5, 0xa9, 0xb0, 0xc4, 0xe7, 0x85, 0x9f,
-	0x00, 0x25, 0x24, 0x8f, 0xbf, 0x90, 0x9c, 0x45, 0x39, 0x53, 0xb2, 0xc8, 0x29, 0x53, 0x41, 0xb3,
-	0x53, 0xeb, 0xb5, 0x76, 0xfb, 0xfd, 0x05, 0xef, 0xd0, 0x2f, 0xdf, 0xe0, 0xc8, 0xc9, 0x70, 0xa9,
-	0xc2, 0xff, 0x24, 0xb7, 0x43, 0xe8, 0x05, 0xac, 0x50, 0x29, 0x46, 0x7c, 0x1c, 0xf8, 0x1d, 0xaf,
-	0xd7, 0xda, 0xdd, 0xbe, 0xd7, 0xf2, 0x95, 0xa5, 0x62, 0x27, 0x41, 0x6f, 0xc1, 0x77, 0xd5, 0x32,
-	0x15, 0x80, 0x4d, 0xe9, 0xc9, 0xbd, 0x7a, 0xd3, 0x16, 0xee, 0x62, 0x19, 0xbe, 0xd1, 0xa2, 0x67,
-	0xd0, 0x60, 0x99, 0xa4, 0x49, 0xd0, 0xb2, 0x49, 0x84, 0x0b, 0x4d, 0x0e, 0x0d, 0xe3, 0x58, 0x8c,
-	0x24, 0x9e, 0x92, 0xbb, 0x87, 0xb0, 0xf5, 0xc7, 0x5a, 0x11, 0x82, 0xba, 0x9e, 0x64, 0xcc, 0xb6,
-	0x9f, 0x8f, 0xed, 0x1a, 0xb5, 0xa1, 0x41, 0x65, 0x21, 0xb4, 0xed, 0xbc, 0xbf, 0xf0, 0x74, 0xd3,
-	0xfd, 0xe6, 0xc1, 0xfa, 0x7c, 0x81, 0xe8, 0x00, 0x9a, 0x31, 0xd1, 0x44, 0x31, 0xad, 0xac, 0x41,
-	0x6b, 0xf7, 0xd1, 0xbd, 0x75, 0xbd, 0x76, 0x64, 0x5c, 0xc9, 0xcc, 0x83, 0x8b, 0x22, 0x8d, 0x0a,
-	0x49, 0x23, 0xa6, 0x34, 0x4f, 0x89, 0xe6, 0x52, 0x44, 0x4a, 0xb3, 0x4c, 0xb9, 0xe3, 0x37, 0x45,
-	0x91, 0x7e, 0x90, 0xf4, 0xb0, 0x42, 0x4f, 0x0c, 0xd8, 0x7d, 0x09, 0x7f, 0xdf, 0xb6, 0x35, 0x89,
-	0xdb, 0x31, 0x74, 0xd5, 0x4c, 0x37, 0xb6, 0x44, 0xa6, 0xb4, 0x9b, 0x23, 0xbb, 0xee, 0x9e, 0x40,
-	0x7b, 0xd1, 0x65, 0xa3, 0x0e, 0xb4, 0x32, 0x92, 0x6b, 0x4e, 0x79, 0x46, 0x84, 0x76, 0x3e, 0xb3,
-	0x21, 0xb4, 0x05, 0x4d, 0x21, 0x63, 0x16, 0xf1, 0xd8, 0x24, 0x58, 0xeb, 0xf9, 0x78, 0xd5, 0xec,
-	0x8f, 0x63, 0xd5, 0xbd, 0xf0, 0xc0, 0xaf, 0x6e, 0x1f, 0x3d, 0x00, 0x38, 0x27, 0x4a, 0x47, 0xd3,
-	0x17, 0x33, 0x4e, 0x0d, 0xec, 0x9b, 0x88, 0xa5, 0x98, 0x11, 0xbe, 0x81, 0xe7, 0x1b, 0xdd, 0x24,
-	0x5a, 0xc3, 0xed, 0x8a, 0x3b, 0xdb, 0xe7, 0x4f, 0xa1, 0x3d, 0x23, 0xd3, 0x3c, 0x65, 0x4a, 0x93,
-	0x34, 0xb3, 0x63, 0x5f, 0xc3, 0xa8, 0xd2, 0x9c, 0x96, 0x08, 0xda, 0x83, 0x7f, 0x67, 0x14, 0x5c,
-	0x45, 0xe5, 0x00, 0xd9, 0x2f, 0xa0, 0x89, 0x37, 0x2a, 0xcd, 0xb1, 0x7a, 0xe3, 0xa0, 0xc1, 0xfb,
-	0x8b, 0xab, 0xd0, 0xbb, 0xbc, 0x0a, 0xbd, 0x9f, 0x57, 0xa1, 0xf7, 0xfd, 0x3a, 0x5c, 0xba, 0xbc,
-	0x0e, 0x97, 0x7e, 0x5c, 0x87, 0x4b, 0x1f, 0xf7, 0xc7, 0x5c, 0x27, 0xc5, 0xb0, 0x4f, 0x65, 0xba,
-	0x93, 0xe5, 0x32, 0x2e, 0xa8, 0x56, 0x94, 0xdf, 0xfa, 0x0c, 0xbf, 0xce, 0x7e, 0x8c, 0x93, 0x8c,
-	0xa9, 0xe1, 0x8a, 0xfd, 0x11, 0xf7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x93, 0xb0, 0x85,
-	0x3c, 0x05, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x23, 0x35,
+	0x14, 0xee, 0x24, 0x4d, 0x9b, 0xbc, 0x74, 0xbb, 0x5b, 0x37, 0xdd, 0x9d, 0x2d, 0x10, 0x42, 0x56,
+	0x88, 0xc0, 0x21, 0xbb, 0xea, 0xb2, 0xe2, 0x00, 0x97, 0x06, 0x0a, 0xad, 0x38, 0xb4, 0x72, 0xbb,
+	0x17, 0x24, 0x34, 0x72, 0x3c, 0x6e, 0xc6, 0x6a, 0x62, 0xcf, 0xda, 0x1e, 0xb1, 0xf9, 0x0f, 0x1c,
+	0xf8, 0x4b, 0xdc, 0xf6, 0xb8, 0x47, 0x2e, 0x48, 0xa8, 0x3d, 0xf0, 0x37, 0x90, 0x3d, 0xce, 0x64,
+	0xd2, 0xb4, 0x41, 0x82, 0x9b, 0xdf, 0x7b, 0xdf, 0xf7, 0x6c, 0xbf, 0xf7, 0xf9, 0xcd, 0xc0, 0x67,
+	0x5c, 0x5c, 0x32, 0xc5, 0x04, 0x65, 0xcf, 0xe7, 0x2b, 0xa3, 0x08, 0x17, 0x5c, 0x8c, 0x22, 0x43,
+	0xf4, 0x55, 0x3f, 0x55, 0xd2, 0x48, 0xb4, 0x5b, 0x84, 0xfb, 0xc5, 0xaa, 0xfb, 0xf7, 0x26, 0x6c,
+	0x5d, 0x78, 0xf0, 0x05, 0xd1, 0x57, 0x68, 0x1b, 0x2a, 0x3c, 0x0e, 0x83, 0x4e, 0xd0, 0x5b, 0xc7,
+	0x15, 0x1e, 0xa3, 0x4f, 0x60, 0x4b, 0xb1, 0x37, 0x19, 0xd3, 0x86, 0xc5, 0xd1, 0x70, 0x1a, 0x56,
+	0x3a, 0x41, 0xaf, 0x81, 0x9b, 0x85, 0x6f, 0x30, 0x45, 0xaf, 0xe0, 0x09, 0x55, 0x8c, 0x58, 0x00,
+	0x31, 0xd1, 0x70, 0x2c, 0xe9, 0x55, 0x94, 0x30, 0x3e, 0x4a, 0x4c, 0x58, 0x75, 0x79, 0x5a, 0x3e,
+	0x7c, 0x68, 0x06, 0x36, 0x78, 0xec, 0x62, 0x68, 0x1f, 0xea, 0x44, 0x6b, 0x3e, 0x12, 0x4c, 0x85,
+	0xeb, 0x2e, 0x6b, 0x61, 0xa3, 0x1f, 0xe1, 0x19, 0x1d, 0x13, 0x3e, 0x71, 0x7b, 0x46, 0x33, 0xf7,
+	0x52, 0xfa, 0x9a, 0x4b, 0xdf, 0xf6, 0xd0, 0xc1, 0xf4, 0xd0, 0x03, 0x17, 0x37, 0xfa, 0x0a, 0x42,
+	0x9f, 0x61, 0xf9, 0x80, 0x1b, 0x2e, 0xc3, 0xde, 0x2c, 0xbe, 0x44, 0xbc, 0xe4, 0x82, 0xeb, 0xe4,
+	0x0e, 0xe2, 0x66, 0x4e, 0x9c, 0xc5, 0x17, 0x89, 0x3f, 0x03, 0x4a, 0x88, 0x8a, 0x7f, 0x21, 0x8a,
+	0x45, 0x8a, 0x69, 0x99, 0x29, 0xca, 0x74, 0x58, 0xef, 0x54, 0x7b, 0xcd, 0x83, 0x7e, 0xff, 0x8e,
+	0x3e, 0xf4, 0x67, 0x3d, 0x38, 0xf6, 0x34, 0x3c, 0x63, 0xe1, 0x9d, 0xe4, 0xb6, 0x0b, 0x7d, 0x0d,
+	0x1b, 0x54, 0x8a, 0x4b, 0x3e, 0x0a, 0x1b, 0x9d, 0xa0, 0xd7, 0x3c, 0x78, 0xb6, 0x32, 0xe5, 0xb7,
+	0x0e, 0x8a, 0x3d, 0x05, 0xfd, 0x00, 0x0d, 0x7f, 0x5b, 0xa6, 0x43, 0x70, 0x47, 0xfa, 0x7c, 0x25,
+	0xdf, 0xca, 0xc2, 0x17, 0x96, 0xe1, 0x39, 0x17, 0x7d, 0x09, 0x35, 0x96, 0x4a, 0x9a, 0x84, 0x4d,
+	0x77, 0x88, 0xf6, 0x9d, 0x49, 0x8e, 0x2c, 0xe2, 0x44, 0x5c, 0x4a, 0x9c, 0x83, 0xd1, 0x63, 0xd8,
+	0x18, 0x66, 0xf1, 0x88, 0x99, 0x70, 0xcb, 0x55, 0xd0, 0x5b, 0xe8, 0x0b, 0xd8, 0x91, 0x99, 0x61,
+	0x2a, 0xd2, 0x86, 0xa5, 0x51, 0x4a, 0xa6, 0x32, 0x33, 0xe1, 0x03, 0x07, 0x79, 0xe8, 0x02, 0xe7,
+	0x86, 0xa5, 0x67, 0xce, 0x8d, 0x9e, 0x42, 0x3d, 0x25, 0x3c, 0x8e, 0x2c, 0x64, 0xdb, 0x41, 0x36,
+	0xad, 0x7d, 0x9a, 0x39, 0x51, 0x29, 0x76, 0x99, 0x89, 0x98, 0xc5, 0xe1, 0x43, 0x17, 0x2a, 0x6c,
+	0xab, 0x53, 0xc1, 0xde, 0x1a, 0x9b, 0x9c, 0x0c, 0xc7, 0x2c, 0x9a, 0xef, 0x17, 0x3e, 0xea, 0x04,
+	0xbd, 0x1a, 0x6e, 0xd9, 0xf0, 0x59, 0x1e, 0x3d, 0x9d, 0xed, 0x89, 0x3e, 0x84, 0x06, 0x25, 0x82,
+	0xb2, 0xf1, 0x98, 0xc5, 0xe1, 0x4e, 0x27, 0xe8, 0xd5, 0xf1, 0xdc, 0x81, 0x28, 0xec, 0x29, 0x96,
+	0x8e, 0x09, 0x65, 0x13, 0x26, 0x4c, 0xa9, 0xdb, 0xe8, 0x3f, 0x75, 0xbb, 0x55, 0x4a, 0x36, 0x6f,
+	0xf8, 0x00, 0xda, 0x42, 0xc6, 0x4c, 0x47, 0x3e, 0xba, 0x2c, 0xc7, 0x5d, 0x77, 0xd7, 0x7d, 0x87,
+	0xc2, 0x1e, 0xb4, 0xa0, 0xc9, 0xee, 0x9f, 0x15, 0x40, 0x85, 0x24, 0x12, 0x46, 0xaf, 0x52, 0xc9,
+	0x85, 0x41, 0x4f, 0x60, 0xd3, 0xce, 0x88, 0xa8, 0x78, 0xf4, 0x1b, 0xd6, 0x3c, 0x89, 0xd1, 0x47,
+	0x00, 0xa5, 0x02, 0x55, 0x5c, 0x81, 0x1a, 0x45, 0x27, 0xec, 0x5c, 0xa0, 0x52, 0x18, 0x7b, 0xe7,
+	0x84, 0xe8, 0xc4, 0xbd, 0xf4, 0x06, 0x6e, 0x7a, 0xdf, 0x31, 0xd1, 0x09, 0xfa, 0x18, 0x9a, 0xda,
+	0x48, 0x45, 0x46, 0x2c, 0xca, 0x14, 0xf7, 0x6f, 0x1c, 0xbc, 0xeb, 0xb5, 0xe2, 0xe8, 0x0c, 0xc0,
+	0x8a, 0x89, 0x98, 0x4c, 0x31, 0x1d, 0xd6, 0x5c, 0xc1, 0x5e, 0xac, 0xd6, 0x72, 0x71, 0xf0, 0xf3,
+	0x19, 0x11, 0x97, 0x72, 0xac, 0x1a, 0x45, 0xf6, 0xa5, 0x57, 0xef, 0x19, 0x45, 0x03, 0x68, 0xbf,
+	0xc9, 0xa4, 0xca, 0x26, 0x91, 0x62, 0x84, 0xde, 0xf7, 0xdc, 0xab, 0x78, 0x3f, 0x47, 0xe1, 0x1c,
+	0xb4, 0x58, 0xdf, 0xdf, 0x03, 0xf8, 0x60, 0xc5, 0x31, 0x51, 0x07, 0x9a, 0x29, 0x51, 0x86, 0x53,
+	0x9e, 0x12, 0x61, 0x5c, 0xb1, 0x1b, 0xb8, 0xec, 0xb2, 0xb2, 0xb6, 0xfd, 0x8b, 0x78, 0xac, 0xc3,
+	0x4a, 0xa7, 0xda, 0x6b, 0xe0, 0x4d, 0x6b, 0x9f, 0xc4, 0xda, 0x56, 0x7b, 0x69, 0xae, 0x56, 0x71,
+	0x73, 0x58, 0xba, 0xc3, 0xed, 0x86, 0xac, 0xff, 0x6b, 0x43, 0x6a, 0xb7, 0x1b, 0xd2, 0x3d, 0x82,
+	0xa7, 0xf7, 0x4a, 0x13, 0x21, 0x58, 0x37, 0xd3, 0x94, 0xf9, 0x93, 0xbb, 0x35, 0x6a, 0x41, 0x8d,
+	0xca, 0x4c, 0x18, 0xa7, 0x8f, 0x07, 0x38, 0x37, 0xba, 0xbf, 0x06, 0xb0, 0xbd, 0x38, 0x7d, 0xd0,
+	0x21, 0xd4, 0x63, 0x62, 0x88, 0x66, 0x46, 0xbb, 0x04, 0xcd, 0x83, 0x4f, 0x57, 0x36, 0xfa, 0x3b,
+	0x0f, 0xc6, 0x05, 0xcd, 0x4e, 0x63, 0x91, 0x4d, 0xa2, 0x4c, 0xd2, 0x88, 0x69, 0xc3, 0x27, 0xc4,
+	0x70, 0x29, 0x9c, 0x3a, 0xb5, 0xdf, 0x7e, 0x4f, 0x64, 0x93, 0xd7, 0x92, 0x1e, 0x15, 0x51, 0xab,
+	0x54, 0xdd, 0xfd, 0x06, 0x1e, 0xdd, 0x4e, 0x6b, 0x0f, 0xee, 0xbe, 0x91, 0xfe, 0x36, 0xb9, 0xe1,
+	0xae, 0xc8, 0xb4, 0xf1, 0x1f, 0x39, 0xb7, 0xee, 0x9e, 0x43, 0xeb, 0xae, 0x49, 0xf8, 0xbf, 0xfa,
+	0xd9, 0x7d, 0x17, 0x40, 0xa3, 0x18, 0x8d, 0xf6, 0xa9, 0x8d, 0x89, 0x36, 0x51, 0x3e, 0x4e, 0x83,
+	0xfc, 0xa9, 0x59, 0x8f, 0x83, 0x58, 0x51, 0xcf, 0xc3, 0x8b, 0xb2, 0xac, 0xe4, 0xa2, 0x2e, 0xb0,
+	0x65, 0x51, 0xbf, 0x80, 0x56, 0x89, 0x66, 0xf8, 0x84, 0x69, 0x43, 0x26, 0xa9, 0xd7, 0x0e, 0x2a,
+	0x38, 0x17, 0xb3, 0x08, 0x7a, 0x09, 0x8f, 0x4b, 0x0c, 0xae, 0xa3, 0xd9, 0xd7, 0xcd, 0x89, 0xa9,
+	0x8e, 0x77, 0x0b, 0xce, 0x89, 0xfe, 0xde, 0x87, 0x06, 0xa7, 0xef, 0xae, 0xdb, 0xc1, 0xfb, 0xeb,
+	0x76, 0xf0, 0xd7, 0x75, 0x3b, 0xf8, 0xed, 0xa6, 0xbd, 0xf6, 0xfe, 0xa6, 0xbd, 0xf6, 0xc7, 0x4d,
+	0x7b, 0xed, 0xa7, 0x57, 0x23, 0x6e, 0x92, 0x6c, 0xd8, 0xa7, 0x72, 0xf2, 0x3c, 0x55, 0x32, 0xce,
+	0xa8, 0xd1, 0x94, 0xdf, 0xfa, 0x53, 0x79, 0x5b, 0xfe, 0x6b, 0x99, 0xa6, 0x4c, 0x0f, 0x37, 0xdc,
+	0xef, 0xca, 0xcb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xa6, 0x6b, 0x70, 0xd9, 0x08, 0x00,
+	0x00,
 }
 
 func (m *TrainingTask) Marshal() (dAtA []byte, err error) {
@@ -500,6 +763,68 @@ func (m *TrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NodesReplacedAtBlockHeight != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.NodesReplacedAtBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.ReplacementResources) > 0 {
+		for iNdEx := len(m.ReplacementResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplacementResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrainingTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.NextPayableOuterStep != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.NextPayableOuterStep))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Refunded != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PaidOut != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.PaidOut))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.OuterStepPayout != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.OuterStepPayout))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Budget != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Budget))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Epoch != nil {
 		{
 			size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
@@ -594,7 +919,7 @@ func (m *TrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TrainingHardwareResources) Marshal() (dAtA []byte, err error) {
+func (m *TrainingCheckpoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -604,32 +929,68 @@ func (m *TrainingHardwareResources) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrainingHardwareResources) MarshalTo(dAtA []byte) (int, error) {
+func (m *TrainingCheckpoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrainingHardwareResources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TrainingCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Count))
+	if m.QuorumReachedAtBlockHeight != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.QuorumReachedAtBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAtBlockHeight != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.CreatedAtBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrainingTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StorageUri) > 0 {
+		i -= len(m.StorageUri)
+		copy(dAtA[i:], m.StorageUri)
+		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.StorageUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OuterStep != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.OuterStep))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.Type)))
+	if m.TaskId != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TrainingConfig) Marshal() (dAtA []byte, err error) {
+func (m *TrainingCheckpointSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -639,37 +1000,55 @@ func (m *TrainingConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrainingConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *TrainingCheckpointSignature) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrainingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TrainingCheckpointSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumUocEstimationSteps != 0 {
-		i = encodeVarintTrainingTask(dAtA, i, uint64(m.NumUocEstimationSteps))
+	if len(m.StorageUri) > 0 {
+		i -= len(m.StorageUri)
+		copy(dAtA[i:], m.StorageUri)
+		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.StorageUri)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x2a
 	}
-	if m.Datasets != nil {
-		{
-			size, err := m.Datasets.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTrainingTask(dAtA, i, uint64(size))
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NodeIds) > 0 {
+		for iNdEx := len(m.NodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeIds[iNdEx])
+			copy(dAtA[i:], m.NodeIds[iNdEx])
+			i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.NodeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.Participant)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TrainingDatasets) Marshal() (dAtA []byte, err error) {
+func (m *TrainingHardwareResources) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -679,7 +1058,82 @@ func (m *TrainingDatasets) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrainingDatasets) MarshalTo(dAtA []byte) (int, error) {
+func (m *TrainingHardwareResources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrainingHardwareResources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTrainingTask(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrainingConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainingConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrainingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumUocEstimationSteps != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.NumUocEstimationSteps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Datasets != nil {
+		{
+			size, err := m.Datasets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTrainingTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrainingDatasets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainingDatasets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -853,6 +1307,98 @@ func (m *TrainingTask) Size() (n int) {
 		l = m.Epoch.Size()
 		n += 1 + l + sovTrainingTask(uint64(l))
 	}
+	if m.Budget != 0 {
+		n += 1 + sovTrainingTask(uint64(m.Budget))
+	}
+	if m.OuterStepPayout != 0 {
+		n += 1 + sovTrainingTask(uint64(m.OuterStepPayout))
+	}
+	if m.PaidOut != 0 {
+		n += 1 + sovTrainingTask(uint64(m.PaidOut))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovTrainingTask(uint64(m.Refunded))
+	}
+	if m.NextPayableOuterStep != 0 {
+		n += 2 + sovTrainingTask(uint64(m.NextPayableOuterStep))
+	}
+	if m.Cancelled {
+		n += 3
+	}
+	if len(m.ReplacementResources) > 0 {
+		for _, e := range m.ReplacementResources {
+			l = e.Size()
+			n += 2 + l + sovTrainingTask(uint64(l))
+		}
+	}
+	if m.NodesReplacedAtBlockHeight != 0 {
+		n += 2 + sovTrainingTask(uint64(m.NodesReplacedAtBlockHeight))
+	}
+	return n
+}
+
+func (m *TrainingCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTrainingTask(uint64(m.TaskId))
+	}
+	if m.OuterStep != 0 {
+		n += 1 + sovTrainingTask(uint64(m.OuterStep))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovTrainingTask(uint64(l))
+	}
+	l = len(m.StorageUri)
+	if l > 0 {
+		n += 1 + l + sovTrainingTask(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTrainingTask(uint64(l))
+		}
+	}
+	if m.CreatedAtBlockHeight != 0 {
+		n += 1 + sovTrainingTask(uint64(m.CreatedAtBlockHeight))
+	}
+	if m.QuorumReachedAtBlockHeight != 0 {
+		n += 1 + sovTrainingTask(uint64(m.QuorumReachedAtBlockHeight))
+	}
+	return n
+}
+
+func (m *TrainingCheckpointSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTrainingTask(uint64(l))
+	}
+	if len(m.NodeIds) > 0 {
+		for _, s := range m.NodeIds {
+			l = len(s)
+			n += 1 + l + sovTrainingTask(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTrainingTask(uint64(m.BlockHeight))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovTrainingTask(uint64(l))
+	}
+	l = len(m.StorageUri)
+	if l > 0 {
+		n += 1 + l + sovTrainingTask(uint64(l))
+	}
 	return n
 }
 
@@ -1279,6 +1825,595 @@ func (m *TrainingTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			m.Budget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Budget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterStepPayout", wireType)
+			}
+			m.OuterStepPayout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OuterStepPayout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidOut", wireType)
+			}
+			m.PaidOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayableOuterStep", wireType)
+			}
+			m.NextPayableOuterStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPayableOuterStep |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacementResources = append(m.ReplacementResources, &TrainingHardwareResources{})
+			if err := m.ReplacementResources[len(m.ReplacementResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodesReplacedAtBlockHeight", wireType)
+			}
+			m.NodesReplacedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodesReplacedAtBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrainingTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainingCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrainingTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainingCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterStep", wireType)
+			}
+			m.OuterStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OuterStep |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &TrainingCheckpointSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtBlockHeight", wireType)
+			}
+			m.CreatedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAtBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReachedAtBlockHeight", wireType)
+			}
+			m.QuorumReachedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumReachedAtBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrainingTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainingCheckpointSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrainingTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainingCheckpointSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainingCheckpointSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeIds = append(m.NodeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrainingTask(dAtA[iNdEx:])
@@ -23,7 +23,17 @@ data class CollateralParamsWrapper(
 
 data class CollateralModuleParams(
     @SerializedName("unbonding_period_epochs")
-    val unbondingPeriodEpochs: String
+    val unbondingPeriodEpochs: String,
+    @SerializedName("slash_burn_share")
+    val slashBurnShare: String?,
+    @SerializedName("slash_validators_share")
+    val slashValidatorsShare: String?,
+    @SerializedName("slash_community_pool_share")
+    val slashCommunityPoolShare: String?,
+    @SerializedName("min_delegation")
+    val minDelegation: String?,
+    @SerializedName("max_delegators_per_participant")
+    val maxDelegatorsPerParticipant: Long?
 )
 
 data class UnbondingCollateralEntry(